@@ -497,6 +497,12 @@ func (buf *NQuadBuffer) mapToNquads(m map[string]interface{}, op int, parentPred
 					Namespace:   namespace,
 					ObjectValue: &api.Value{Val: &api.Value_DefaultVal{DefaultVal: x.Star}},
 				}
+				// The facets of the deletion can give the position to delete in an ordered list.
+				fts, err := parseScalarFacets(mr.rawFacets, pred+x.FacetDelimeter)
+				if err != nil {
+					return mr, err
+				}
+				nq.Facets = fts
 				// Here we split predicate and lang directive (ex: "name@en"), if needed. With JSON
 				// mutations that's the only way to send language for a value.
 				nq.Predicate, nq.Lang = x.PredicateLang(nq.Predicate)
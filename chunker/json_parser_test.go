@@ -31,6 +31,7 @@ import (
 
 	"github.com/dgraph-io/dgo/v210/protos/api"
 	"github.com/dgraph-io/dgraph/types"
+	"github.com/dgraph-io/dgraph/x"
 	"github.com/stretchr/testify/require"
 )
 
@@ -1018,6 +1019,25 @@ func TestNquadsFromJsonDeleteStar(t *testing.T) {
 	require.Equal(t, expected, fastNQ[0])
 }
 
+func TestNquadsFromJsonDeleteStarFacets(t *testing.T) {
+	json := `{"uid":1000,"steps": null,"steps|dgraph.index": 2}`
+
+	nq, err := Parse([]byte(json), DeleteNquads)
+	require.NoError(t, err)
+	require.Equal(t, 1, len(nq))
+
+	fastNQ, err := FastParse([]byte(json), DeleteNquads)
+	require.NoError(t, err)
+	require.Equal(t, 1, len(fastNQ))
+
+	for _, n := range []*api.NQuad{nq[0], fastNQ[0]} {
+		require.Equal(t, x.Star, n.ObjectValue.GetDefaultVal())
+		require.Equal(t, 1, len(n.Facets))
+		require.Equal(t, x.ListIndexFacet, n.Facets[0].Key)
+		require.Equal(t, api.Facet_INT, n.Facets[0].ValType)
+	}
+}
+
 func TestValInUpsert(t *testing.T) {
 	json := `{"uid":1000, "name": "val(name)"}`
 	nq, err := Parse([]byte(json), SetNquads)
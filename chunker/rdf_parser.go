@@ -363,8 +363,13 @@ var typeMap = map[string]types.TypeID{
 	"xs:boolean":         types.BoolID,
 	"xs:double":          types.FloatID,
 	"xs:float":           types.FloatID,
+	"xs:decimal":         types.DecimalID,
+	"xs:duration":        types.DurationID,
 	"xs:base64Binary":    types.BinaryID,
 	"geo:geojson":        types.GeoID,
+	"geo:wktLiteral":     types.GeoID,
+	"geo:wkbLiteral":     types.GeoID,
+	"rdf:JSON":           types.JSONID,
 	"http://www.w3.org/2001/XMLSchema#string":          types.StringID,
 	"http://www.w3.org/2001/XMLSchema#dateTime":        types.DateTimeID,
 	"http://www.w3.org/2001/XMLSchema#date":            types.DateTimeID,
@@ -374,6 +379,11 @@ var typeMap = map[string]types.TypeID{
 	"http://www.w3.org/2001/XMLSchema#boolean":         types.BoolID,
 	"http://www.w3.org/2001/XMLSchema#double":          types.FloatID,
 	"http://www.w3.org/2001/XMLSchema#float":           types.FloatID,
+	"http://www.w3.org/2001/XMLSchema#decimal":         types.DecimalID,
+	"http://www.w3.org/2001/XMLSchema#duration":        types.DurationID,
 	"http://www.w3.org/2001/XMLSchema#gYear":           types.DateTimeID,
 	"http://www.w3.org/2001/XMLSchema#gYearMonth":      types.DateTimeID,
+	"http://www.opengis.net/ont/geosparql#wktLiteral":  types.GeoID,
+	"http://www.opengis.net/ont/geosparql#wkbLiteral":  types.GeoID,
+	"http://www.w3.org/1999/02/22-rdf-syntax-ns#JSON":  types.JSONID,
 }
@@ -152,6 +152,20 @@ var testNQuads = []struct {
 			ObjectValue: &api.Value{Val: &api.Value_IntVal{IntVal: 13}},
 		},
 	},
+	{
+		input: `_:alice <location> "POINT (1 2)"^^<geo:wktLiteral> .`,
+		nq: api.NQuad{
+			Subject:   "_:alice",
+			Predicate: "location",
+			ObjectId:  "",
+			ObjectValue: &api.Value{Val: &api.Value_GeoVal{GeoVal: []byte{
+				1, 1, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0xf0, 0x3f, 0, 0, 0, 0, 0, 0, 0, 0x40}}},
+		},
+	},
+	{
+		input:       `_:alice <location> "POINT (1 2"^^<geo:wktLiteral> .`,
+		expectedErr: true,
+	},
 	{
 		input: `_:alice <age> "013"^^<xs:integer> .`,
 		nq: api.NQuad{
@@ -81,6 +81,7 @@ func keywordHandler(w http.ResponseWriter, r *http.Request) {
 		"first",
 		"floor",
 		"fulltext",
+		"fulltext_v2",
 		"func",
 		"ge",
 		"gt",
@@ -162,12 +162,25 @@ func queryHandler(w http.ResponseWriter, r *http.Request) {
 		x.SetStatus(w, x.ErrorInvalidRequest, err.Error())
 		return
 	}
+	serializable, err := parseBool(r, "serializable")
+	if err != nil {
+		x.SetStatus(w, x.ErrorInvalidRequest, err.Error())
+		return
+	}
 	startTs, err := parseUint64(r, "startTs")
 	hash := r.URL.Query().Get("hash")
 	if err != nil {
 		x.SetStatus(w, x.ErrorInvalidRequest, err.Error())
 		return
 	}
+	geoFormat := strings.ToLower(r.URL.Query().Get("geoFormat"))
+	switch geoFormat {
+	case "", query.GeoFormatGeoJSON, query.GeoFormatWKT:
+	default:
+		x.SetStatus(w, x.ErrorInvalidRequest, fmt.Sprintf("Invalid geoFormat: %s. "+
+			"Supported formats are geojson and wkt", geoFormat))
+		return
+	}
 
 	body := readRequest(w, r)
 	if body == nil {
@@ -179,7 +192,15 @@ func queryHandler(w http.ResponseWriter, r *http.Request) {
 		Variables map[string]string `json:"variables"`
 	}
 
+	// A request to /query/{name} runs the stored query with that name, with the variables given
+	// in a JSON body.
+	storedQuery := strings.Trim(strings.TrimPrefix(r.URL.Path, "/query"), "/")
+
 	contentType := r.Header.Get("Content-Type")
+	if storedQuery != "" && len(body) == 0 {
+		// A stored query without variables needs no body.
+		contentType, body = "application/json", []byte("{}")
+	}
 	mediaType, contentTypeParams, err := mime.ParseMediaType(contentType)
 	if err != nil {
 		x.SetStatus(w, x.ErrorInvalidRequest, "Invalid Content-Type")
@@ -206,8 +227,15 @@ func queryHandler(w http.ResponseWriter, r *http.Request) {
 	}
 
 	ctx := context.WithValue(r.Context(), query.DebugKey, isDebugMode)
+	ctx = context.WithValue(ctx, query.GeoFormatKey, geoFormat)
 	ctx = x.AttachAccessJwt(ctx, r)
 	ctx = x.AttachRemoteIP(ctx, r)
+	if storedQuery != "" {
+		ctx = x.AttachStoredQuery(ctx, storedQuery)
+	}
+	if serializable {
+		ctx = x.AttachSerializable(ctx)
+	}
 
 	if queryTimeout != 0 {
 		var cancel context.CancelFunc
@@ -222,6 +250,16 @@ func queryHandler(w http.ResponseWriter, r *http.Request) {
 		Hash:    hash,
 	}
 
+	if storedQuery != "" {
+		// A stored upsert is committed right away if asked to, as it is with /mutate.
+		commitNow, err := parseBool(r, "commitNow")
+		if err != nil {
+			x.SetStatus(w, x.ErrorInvalidRequest, err.Error())
+			return
+		}
+		req.CommitNow = commitNow
+	}
+
 	if req.StartTs == 0 {
 		// If be is set, run this as a best-effort query.
 		isBestEffort, err := parseBool(r, "be")
@@ -302,6 +340,24 @@ func mutationHandler(w http.ResponseWriter, r *http.Request) {
 		x.SetStatus(w, x.ErrorInvalidRequest, err.Error())
 		return
 	}
+	ifVersion, err := parseUint64(r, "ifVersion")
+	if err != nil {
+		x.SetStatus(w, x.ErrorInvalidRequest, err.Error())
+		return
+	}
+	serializable, err := parseBool(r, "serializable")
+	if err != nil {
+		x.SetStatus(w, x.ErrorInvalidRequest, err.Error())
+		return
+	}
+	geoFormat := strings.ToLower(r.URL.Query().Get("geoFormat"))
+	switch geoFormat {
+	case "", query.GeoFormatGeoJSON, query.GeoFormatWKT:
+	default:
+		x.SetStatus(w, x.ErrorInvalidRequest, fmt.Sprintf("Invalid geoFormat: %s. "+
+			"Supported formats are geojson and wkt", geoFormat))
+		return
+	}
 	body := readRequest(w, r)
 	if body == nil {
 		return
@@ -411,7 +467,17 @@ func mutationHandler(w http.ResponseWriter, r *http.Request) {
 	req.Hash = hash
 	req.CommitNow = commitNow
 
-	ctx := x.AttachAccessJwt(context.Background(), r)
+	ctx := context.WithValue(context.Background(), query.GeoFormatKey, geoFormat)
+	ctx = x.AttachAccessJwt(ctx, r)
+	if ifVersion > 0 {
+		ctx = x.AttachIfVersion(ctx, ifVersion)
+	}
+	if key := r.URL.Query().Get("idempotencyKey"); key != "" {
+		ctx = x.AttachIdempotencyKey(ctx, key)
+	}
+	if serializable {
+		ctx = x.AttachSerializable(ctx)
+	}
 	resp, err := (&edgraph.Server{}).Query(ctx, req)
 	if err != nil {
 		x.SetStatusWithData(w, x.ErrorInvalidRequest, err.Error())
@@ -475,6 +541,9 @@ func commitHandler(w http.ResponseWriter, r *http.Request) {
 	}
 
 	ctx := x.AttachAccessJwt(context.Background(), r)
+	if key := r.URL.Query().Get("idempotencyKey"); key != "" {
+		ctx = x.AttachIdempotencyKey(ctx, key)
+	}
 	var response map[string]interface{}
 	if abort {
 		response, err = handleAbort(ctx, startTs, hash)
@@ -600,12 +669,30 @@ func alterHandler(w http.ResponseWriter, r *http.Request) {
 	ctx := x.AttachAuthToken(context.Background(), r)
 	ctx = x.AttachAccessJwt(ctx, r)
 	ctx = x.AttachRemoteIP(ctx, r)
-	if _, err := (&edgraph.Server{}).Alter(ctx, op); err != nil {
+	payload, err := (&edgraph.Server{}).Alter(ctx, op)
+	if err != nil {
 		x.SetStatus(w, x.Error, err.Error())
 		return
 	}
+	if len(payload.GetData()) == 0 {
+		writeSuccessResponse(w, r)
+		return
+	}
 
-	writeSuccessResponse(w, r)
+	// The payload holds the IDs of the tasks rebuilding the indexes.
+	data := map[string]interface{}{}
+	if err := json.Unmarshal(payload.Data, &data); err != nil {
+		x.SetStatus(w, x.Error, err.Error())
+		return
+	}
+	data["code"] = x.Success
+	data["message"] = "Done"
+	js, err := json.Marshal(map[string]interface{}{"data": data})
+	if err != nil {
+		x.SetStatus(w, x.Error, err.Error())
+		return
+	}
+	_, _ = x.WriteResponse(w, r, js)
 }
 
 func adminSchemaHandler(w http.ResponseWriter, r *http.Request) {
@@ -255,7 +255,12 @@ func mutationWithTs(inp mutationInp) (mutationResponse, error) {
 	}
 
 	mr.keys = r.Extensions.Txn.Keys
-	mr.preds = r.Extensions.Txn.Preds
+	// Every mutation also touches the versions of its nodes, which the tests don't check.
+	for _, pred := range r.Extensions.Txn.Preds {
+		if !strings.HasSuffix(pred, x.VersionPredicate) {
+			mr.preds = append(mr.preds, pred)
+		}
+	}
 	mr.startTs = r.Extensions.Txn.StartTs
 	mr.hash = r.Extensions.Txn.Hash
 	sort.Strings(mr.preds)
@@ -498,6 +503,123 @@ func TestTransactionBasicNoPreds(t *testing.T) {
 	require.NoError(t, err)
 	require.Equal(t, `{"data":{"balances":[{"name":"Bob","balance":"110"}]}}`, data)
 }
+func TestTransactionDifferentPredsOfNode(t *testing.T) {
+	require.NoError(t, dropAll())
+	require.NoError(t, alterSchema(`name: string @index(exact) .`))
+
+	_, err := mutationWithTs(mutationInp{body: `{ set { _:alice <name> "Alice" . } }`,
+		typ: "application/rdf", commitNow: true})
+	require.NoError(t, err)
+
+	q1 := `{ q(func: eq(name, "Alice")) { uid } }`
+	data, tsInfo1, err := queryWithTs(queryInp{body: q1, typ: "application/dql"})
+	require.NoError(t, err)
+	var r struct {
+		Data struct {
+			Q []struct {
+				Uid string
+			}
+		}
+	}
+	require.NoError(t, json.Unmarshal([]byte(data), &r))
+	require.Len(t, r.Data.Q, 1)
+	uid := r.Data.Q[0].Uid
+	_, tsInfo2, err := queryWithTs(queryInp{body: q1, typ: "application/dql"})
+	require.NoError(t, err)
+
+	// Two concurrent transactions writing different predicates of a node don't conflict over
+	// the version of the node, so both commit.
+	mr1, err := mutationWithTs(mutationInp{
+		body: fmt.Sprintf(`{ set { <%s> <nick> "Ally" . } }`, uid),
+		typ:  "application/rdf", ts: tsInfo1.ts, hash: tsInfo1.hash})
+	require.NoError(t, err)
+	mr2, err := mutationWithTs(mutationInp{
+		body: fmt.Sprintf(`{ set { <%s> <balance> "110" . } }`, uid),
+		typ:  "application/rdf", ts: tsInfo2.ts, hash: tsInfo2.hash})
+	require.NoError(t, err)
+	require.NoError(t, commitWithTs(mr1, false))
+	require.NoError(t, commitWithTs(mr2, false))
+
+	data, _, err = queryWithTs(queryInp{
+		body: `{ q(func: eq(name, "Alice")) { nick balance } }`, typ: "application/dql"})
+	require.NoError(t, err)
+	require.JSONEq(t, `{"data":{"q":[{"nick":"Ally","balance":"110"}]}}`, data)
+}
+
+func TestStrictTypeRequiredFields(t *testing.T) {
+	require.NoError(t, dropAll())
+	require.NoError(t, alterSchema(`
+		name: string @index(exact) .
+		age: int .
+		type Person @strict {
+			name @required
+			age
+		}
+	`))
+
+	// A node missing a @required field is rejected when the transaction commits.
+	_, err := mutationWithTs(mutationInp{
+		body: `{ set { _:a <dgraph.type> "Person" . _:a <age> "3" . } }`,
+		typ:  "application/rdf", commitNow: true})
+	require.Error(t, err)
+	require.Contains(t, err.Error(), "missing the @required predicate name")
+
+	// The @required field can be set by a later mutation of the same transaction.
+	_, tsInfo, err := queryWithTs(queryInp{body: `{ q(func: has(age)) { uid } }`,
+		typ: "application/dql"})
+	require.NoError(t, err)
+	mr1, err := mutationWithTs(mutationInp{
+		body: `{ set { _:a <dgraph.type> "Person" . _:a <age> "3" . } }`,
+		typ:  "application/rdf", ts: tsInfo.ts, hash: tsInfo.hash})
+	require.NoError(t, err)
+	m2 := `
+	upsert {
+	  query {
+	    q(func: type(Person)) {
+	      v as uid
+	    }
+	  }
+	  mutation {
+	    set {
+	      uid(v) <name> "Alice" .
+	    }
+	  }
+	}`
+	mr2, err := mutationWithTs(mutationInp{body: m2, typ: "application/rdf", ts: tsInfo.ts,
+		hash: tsInfo.hash})
+	require.NoError(t, err)
+	mr2.keys = append(mr2.keys, mr1.keys...)
+	mr2.preds = append(mr2.preds, mr1.preds...)
+	require.NoError(t, commitWithTs(mr2, false))
+
+	q1 := `{ q(func: type(Person)) { name age } }`
+	data, _, err := queryWithTs(queryInp{body: q1, typ: "application/dql"})
+	require.NoError(t, err)
+	require.JSONEq(t, `{"data":{"q":[{"name":"Alice","age":3}]}}`, data)
+
+	// Deleting a @required field is rejected too.
+	m3 := `
+	upsert {
+	  query {
+	    q(func: eq(name, "Alice")) {
+	      v as uid
+	    }
+	  }
+	  mutation {
+	    delete {
+	      uid(v) <name> * .
+	    }
+	  }
+	}`
+	_, err = mutationWithTs(mutationInp{body: m3, typ: "application/rdf", commitNow: true})
+	require.Error(t, err)
+	require.Contains(t, err.Error(), "missing the @required predicate name")
+
+	data, _, err = queryWithTs(queryInp{body: q1, typ: "application/dql"})
+	require.NoError(t, err)
+	require.JSONEq(t, `{"data":{"q":[{"name":"Alice","age":3}]}}`, data)
+}
+
 func TestTransactionForCost(t *testing.T) {
 	require.NoError(t, dropAll())
 	require.NoError(t, alterSchema(`name: string @index(term) .`))
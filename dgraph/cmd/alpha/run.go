@@ -208,6 +208,17 @@ they form a Raft group and provide synchronous replication.
 				"worker in a failed state. Use -1 to retry infinitely.").
 		Flag("txn-abort-after", "Abort any pending transactions older than this duration."+
 			" The liveness of a transaction is determined by its last mutation.").
+		Flag("reindex-rate",
+			"The maximum number of posting lists processed per second when rebuilding an index "+
+				"in the background, to leave I/O for the live traffic. Use 0 for no limit.").
+		Flag("idempotency-window",
+			"How long the response of a mutation or commit sent with an idempotency key is "+
+				"remembered. A retry with the same key within it gets the same response, without "+
+				"being applied again. The keys are remembered by the Alpha which got the request, "+
+				"so a retry must be sent to the same Alpha to be deduplicated.").
+		Flag("idempotency-keys",
+			"The maximum number of idempotency keys remembered. The oldest responses are "+
+				"forgotten before the end of the idempotency window once it is reached.").
 		String())
 
 	flag.String("ludicrous", worker.LudicrousDefaults, z.NewSuperFlagHelp(worker.LudicrousDefaults).
@@ -218,6 +229,46 @@ they form a Raft group and provide synchronous replication.
 			"The number of concurrent threads to use in Ludicrous mode.").
 		String())
 
+	flag.String("soft-delete", worker.SoftDeleteDefaults,
+		z.NewSuperFlagHelp(worker.SoftDeleteDefaults).
+			Head("Soft delete options").
+			Flag("enabled",
+				"Set enabled to true to record the deletion of all the predicates of a node as a "+
+					"tombstone, so that the node can be brought back with the undelete admin "+
+					"mutation.").
+			Flag("retention",
+				"How long the deleted versions are kept. Older deletions are purged and can't be "+
+					"undone.").
+			String())
+
+	flag.String("serializable", worker.SerializableDefaults,
+		z.NewSuperFlagHelp(worker.SerializableDefaults).
+			Head("Serializable transaction options").
+			Flag("enabled",
+				"Set enabled to true to allow serializable transactions, which abort when what "+
+					"their queries read was written since they started. Every transaction then "+
+					"sends the keys it writes to Zero. It must be set on all the Alphas.").
+			String())
+
+	flag.String("slow-query", worker.SlowQueryDefaults,
+		z.NewSuperFlagHelp(worker.SlowQueryDefaults).
+			Head("Slow query log options").
+			Flag("output",
+				`[stdout, /path/to/dir] Where the queries and mutations exceeding a threshold are
+			logged. The slow query log is disabled when it is not set.`).
+			Flag("latency",
+				"The latency from which a request is logged as slow. Use 0 for no limit.").
+			Flag("edges",
+				"The number of edges traversed from which a request is logged as slow. Use 0 for "+
+					"no limit.").
+			Flag("compress",
+				"Enables the compression of old slow query logs.").
+			Flag("days",
+				"The number of days slow query logs will be preserved.").
+			Flag("size",
+				"The slow query log max size in MB after which it will be rolled over.").
+			String())
+
 	flag.String("graphql", worker.GraphQLDefaults, z.NewSuperFlagHelp(worker.GraphQLDefaults).
 		Head("GraphQL options").
 		Flag("introspection",
@@ -602,6 +653,7 @@ func setupServer(closer *z.Closer) {
 		if x.HealthCheck() == nil {
 			// Audit is enterprise feature.
 			x.Check(audit.InitAuditorIfNecessary(worker.Config.Audit, worker.EnterpriseEnabled))
+			x.Check(edgraph.InitSlowQueryLog(worker.Config.SlowQuery))
 			break
 		}
 		time.Sleep(500 * time.Millisecond)
@@ -643,6 +695,21 @@ func run() {
 	security := z.NewSuperFlag(Alpha.Conf.GetString("security")).MergeAndCheckDefault(
 		worker.SecurityDefaults)
 	conf := audit.GetAuditConf(Alpha.Conf.GetString("audit"))
+	slowQuery := z.NewSuperFlag(Alpha.Conf.GetString("slow-query")).MergeAndCheckDefault(
+		worker.SlowQueryDefaults)
+	var slowQueryConf *x.LoggerConf
+	if out := slowQuery.GetString("output"); out != "" {
+		if out != "stdout" {
+			out = slowQuery.GetPath("output")
+		}
+		slowQueryConf = &x.LoggerConf{
+			Compress:   slowQuery.GetBool("compress"),
+			Output:     out,
+			Days:       slowQuery.GetInt64("days"),
+			Size:       slowQuery.GetInt64("size"),
+			MessageKey: "kind",
+		}
+	}
 	opts := worker.Options{
 		PostingDir:      Alpha.Conf.GetString("postings"),
 		WALDir:          Alpha.Conf.GetString("wal"),
@@ -653,6 +720,10 @@ func run() {
 		AuthToken:      security.GetString("token"),
 		Audit:          conf,
 		ChangeDataConf: Alpha.Conf.GetString("cdc"),
+
+		SlowQuery:        slowQueryConf,
+		SlowQueryLatency: slowQuery.GetDuration("latency"),
+		SlowQueryEdges:   slowQuery.GetUint64("edges"),
 	}
 
 	keys, err := ee.GetKeys(Alpha.Conf)
@@ -693,6 +764,16 @@ func run() {
 	ludicrous := z.NewSuperFlag(Alpha.Conf.GetString("ludicrous")).MergeAndCheckDefault(
 		worker.LudicrousDefaults)
 	raft := z.NewSuperFlag(Alpha.Conf.GetString("raft")).MergeAndCheckDefault(worker.RaftDefaults)
+	softDelete := z.NewSuperFlag(Alpha.Conf.GetString("soft-delete")).MergeAndCheckDefault(
+		worker.SoftDeleteDefaults)
+	serializable := z.NewSuperFlag(Alpha.Conf.GetString("serializable")).MergeAndCheckDefault(
+		worker.SerializableDefaults)
+	var softDeleteRetention time.Duration
+	if softDelete.GetBool("enabled") {
+		softDeleteRetention = softDelete.GetDuration("retention")
+		x.AssertTruef(softDeleteRetention > 0,
+			"The retention of soft delete must be positive. Got: %v", softDeleteRetention)
+	}
 	x.WorkerConfig = x.WorkerOptions{
 		TmpDir:              Alpha.Conf.GetString("tmp"),
 		ExportPath:          Alpha.Conf.GetString("export"),
@@ -705,6 +786,9 @@ func run() {
 		StartTime:           startTime,
 		Ludicrous:           ludicrous,
 		LudicrousEnabled:    ludicrous.GetBool("enabled"),
+		SoftDelete:          softDelete,
+		SoftDeleteRetention: softDeleteRetention,
+		SerializableEnabled: serializable.GetBool("enabled"),
 		Security:            security,
 		TLSClientConfig:     tlsClientConf,
 		TLSServerConfig:     tlsServerConf,
@@ -732,6 +816,9 @@ func run() {
 	x.Config.LimitNormalizeNode = int(x.Config.Limit.GetInt64("normalize-node"))
 	x.Config.QueryTimeout = x.Config.Limit.GetDuration("query-timeout")
 	x.Config.MaxRetries = x.Config.Limit.GetInt64("max-retries")
+	x.Config.LimitReindexRate = x.Config.Limit.GetInt64("reindex-rate")
+	x.Config.IdempotencyWindow = x.Config.Limit.GetDuration("idempotency-window")
+	x.Config.IdempotencyKeys = int(x.Config.Limit.GetInt64("idempotency-keys"))
 
 	x.Config.GraphQL = z.NewSuperFlag(Alpha.Conf.GetString("graphql")).MergeAndCheckDefault(
 		worker.GraphQLDefaults)
@@ -839,6 +926,7 @@ func run() {
 	glog.Infoln("adminCloser closed.")
 
 	audit.Close()
+	edgraph.CloseSlowQueryLog()
 
 	worker.State.Dispose()
 	x.RemoveCidFile()
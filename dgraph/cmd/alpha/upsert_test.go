@@ -994,6 +994,71 @@ upsert {
 	require.Contains(t, res, "Ashish")
 }
 
+func TestConditionalUpsertBranchesWithSpaces(t *testing.T) {
+	require.NoError(t, dropAll())
+	require.NoError(t, alterSchema(`email: string @index(exact) .`))
+
+	m1 := `
+upsert {
+  query {
+    q(func: eq(email, "email@company.io")) {
+      v as uid
+    }
+  }
+
+  mutation @if (eq(len(v), 1)) {
+    set {
+      uid(v) <name> "Wrong" .
+    }
+  }
+
+  mutation @elseif (eq(len(v), 0)) {
+    set {
+      _:user <email> "email@company.io" .
+      _:user <name> "Ashish" .
+    }
+  }
+}`
+	mr, err := mutationWithTs(mutationInp{body: m1, typ: "application/rdf", commitNow: true})
+	require.NoError(t, err)
+	// Only the @elseif branch runs, as no user has the email yet.
+	require.Equal(t, []string{"email", "name"}, splitPreds(mr.preds))
+
+	q1 := `
+{
+  q(func: has(email)) {
+    name
+  }
+}`
+	res, _, err := queryWithTs(queryInp{body: q1, typ: "application/dql"})
+	require.NoError(t, err)
+	require.JSONEq(t, `{"data":{"q":[{"name":"Ashish"}]}}`, res)
+
+	m2 := `
+upsert {
+  query {
+    q(func: eq(email, "email@company.io")) {
+      v as uid
+    }
+  }
+
+  mutation @if (eq(len(v), 0)) {
+    set {
+      _:user <email> "email@company.io" .
+    }
+  }
+
+  mutation @else @abort ("user exists")
+}`
+	_, err = mutationWithTs(mutationInp{body: m2, typ: "application/rdf", commitNow: true})
+	require.Error(t, err)
+	require.Contains(t, err.Error(), "user exists")
+
+	res, _, err = queryWithTs(queryInp{body: q1, typ: "application/dql"})
+	require.NoError(t, err)
+	require.JSONEq(t, `{"data":{"q":[{"name":"Ashish"}]}}`, res)
+}
+
 func TestConditionalUpsertExample0JSON(t *testing.T) {
 	require.NoError(t, dropAll())
 	require.NoError(t, alterSchema(`email: string @index(exact) .`))
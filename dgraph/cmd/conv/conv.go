@@ -60,6 +60,29 @@ func writeToFile(fpath string, ch chan []byte) error {
 	return w.Flush()
 }
 
+// supportedGeometry returns true if the geometry can be stored and indexed by Dgraph.
+func supportedGeometry(g *geojson.Geometry) bool {
+	if g == nil {
+		return false
+	}
+	switch g.Type {
+	case geojson.GeometryPoint, geojson.GeometryPolygon, geojson.GeometryMultiPolygon,
+		geojson.GeometryLineString, geojson.GeometryMultiLineString:
+		return true
+	case geojson.GeometryCollection:
+		if len(g.Geometries) == 0 {
+			return false
+		}
+		for _, member := range g.Geometries {
+			if !supportedGeometry(member) {
+				return false
+			}
+		}
+		return true
+	}
+	return false
+}
+
 func convertGeoFile(input string, output string) error {
 	fmt.Printf("\nProcessing %s\n\n", input)
 	f, err := os.Open(input)
@@ -100,7 +123,12 @@ func convertGeoFile(input string, output string) error {
 
 	count := 0
 	rdfCount := 0
+	skipped := 0
 	for _, f := range fc.Features {
+		if !supportedGeometry(f.Geometry) {
+			skipped++
+			continue
+		}
 		b, err := json.Marshal(f.Geometry)
 		if err != nil {
 			return err
@@ -127,5 +155,8 @@ func convertGeoFile(input string, output string) error {
 	}
 	close(chb)
 	fmt.Printf("%d features converted. %d rdf's generated\n", count, rdfCount)
+	if skipped > 0 {
+		fmt.Printf("%d features with an unsupported geometry skipped\n", skipped)
+	}
 	return <-che
 }
@@ -85,11 +85,14 @@ func (o *Oracle) hasConflict(src *api.TxnContext) bool {
 		return true
 	}
 	for _, k := range src.Keys {
-		ki, err := strconv.ParseUint(k, 36, 64)
+		ki, check, _, err := x.ParseConflictKey(k)
 		if err != nil {
 			glog.Errorf("Got error while parsing conflict key %q: %v\n", k, err)
 			continue
 		}
+		if !check {
+			continue
+		}
 		if last := o.keyCommit.Get(ki); last > src.StartTs {
 			return true
 		}
@@ -139,13 +142,17 @@ func (o *Oracle) commit(src *api.TxnContext) error {
 	}
 	// We store src.Keys as string to ensure compatibility with all the various language clients we
 	// have. But, really they are just uint64s encoded as strings. We use base 36 during creation of
-	// these keys in FillContext in posting/mvcc.go.
+	// these keys in FillContext in posting/mvcc.go. The keys read by serializable transactions
+	// are only checked.
 	for _, k := range src.Keys {
-		ki, err := strconv.ParseUint(k, 36, 64)
+		ki, _, record, err := x.ParseConflictKey(k)
 		if err != nil {
 			glog.Errorf("Got error while parsing conflict key %q: %v\n", k, err)
 			continue
 		}
+		if !record {
+			continue
+		}
 		o.keyCommit.Set(ki, src.CommitTs) // CommitTs is handed out before calling this func.
 	}
 	return nil
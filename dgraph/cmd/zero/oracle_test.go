@@ -0,0 +1,66 @@
+/*
+ * Copyright 2022 Dgraph Labs, Inc. and Contributors
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package zero
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/dgraph-io/dgo/v210/protos/api"
+	"github.com/dgraph-io/dgraph/x"
+)
+
+func TestOracleSerializableConflicts(t *testing.T) {
+	var o Oracle
+	o.Init()
+	defer o.close()
+
+	// The writer of a posting commits the list it wrote, after the reader started.
+	require.NoError(t, o.commit(&api.TxnContext{
+		StartTs:  10,
+		CommitTs: 12,
+		Keys:     []string{x.ConflictKey(1), x.WrittenConflictKey(2)},
+	}))
+
+	// Written keys don't make another writer of the list conflict.
+	require.False(t, o.hasConflict(&api.TxnContext{
+		StartTs: 11,
+		Keys:    []string{x.ConflictKey(3), x.WrittenConflictKey(2)},
+	}))
+	// A serializable transaction which read the list does.
+	require.True(t, o.hasConflict(&api.TxnContext{
+		StartTs: 11,
+		Keys:    []string{x.ConflictKey(3), x.ReadConflictKey(2)},
+	}))
+	// Unless it started after the commit.
+	require.False(t, o.hasConflict(&api.TxnContext{
+		StartTs: 13,
+		Keys:    []string{x.ReadConflictKey(2)},
+	}))
+
+	// Read keys aren't recorded on commit.
+	require.NoError(t, o.commit(&api.TxnContext{
+		StartTs:  13,
+		CommitTs: 14,
+		Keys:     []string{x.ReadConflictKey(4)},
+	}))
+	require.False(t, o.hasConflict(&api.TxnContext{
+		StartTs: 13,
+		Keys:    []string{x.ReadConflictKey(4), x.ConflictKey(4)},
+	}))
+}
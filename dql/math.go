@@ -20,6 +20,7 @@ import (
 	"bytes"
 	"strconv"
 	"strings"
+	"time"
 
 	"github.com/dgraph-io/dgraph/lex"
 	"github.com/dgraph-io/dgraph/types"
@@ -257,6 +258,14 @@ loop:
 				v, err := strconv.ParseFloat(item.Val, 64)
 				if err != nil {
 					child.Var = item.Val
+					// ISO 8601 durations like P1D or PT30M are constants, so that they can be
+					// added to datetimes.
+					if strings.HasPrefix(item.Val, "P") {
+						if d, err := types.ParseDuration(item.Val); err == nil {
+							child.Var = ""
+							child.Const = types.Val{Tid: types.DurationID, Value: d}
+						}
+					}
 				} else {
 					child.Const = types.Val{
 						Tid:   types.FloatID,
@@ -373,6 +382,8 @@ func (t *MathTree) stringHelper(buf *bytes.Buffer) {
 				t.Const.Value.(float64), 'E', -1, 64))
 		case types.IntID:
 			leafStr, err = buf.WriteString(strconv.FormatInt(t.Const.Value.(int64), 10))
+		case types.DurationID:
+			leafStr, err = buf.WriteString(types.FormatDuration(t.Const.Value.(time.Duration)))
 		}
 		x.Check2(leafStr, err)
 		return
@@ -30,9 +30,22 @@ var (
 	errInvalidUID = errors.New("UID must to be greater than 0")
 )
 
+// Condition is the condition of a mutation in an upsert block, as parsed from its Cond.
+type Condition struct {
+	// Branch is one of BranchIf, BranchElseIf and BranchElse, or empty for a mutation which runs
+	// whatever the other mutations do.
+	Branch string
+	// Filter is the filter of an @if or @elseif branch, like (eq(len(v), 1)).
+	Filter string
+	// Abort is the message of the error which fails the whole request, when the mutation would
+	// have run.
+	Abort string
+}
+
 // Mutation stores the strings corresponding to set and delete operations.
 type Mutation struct {
 	Cond         string
+	Condition    Condition
 	Set          []*api.NQuad
 	Del          []*api.NQuad
 	AllowedPreds []string
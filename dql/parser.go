@@ -37,8 +37,14 @@ const (
 	lenFunc   = "len"
 	countFunc = "count"
 	uidInFunc = "uid_in"
+	// jsonPathFunc extracts a value of json documents, like jsonpath(data, "$.status").
+	jsonPathFunc = "jsonpath"
 )
 
+// DistanceVar is the value variable holding the distance in metres of the results of the
+// nearest function. It is defined by every block using nearest at root.
+const DistanceVar = "distance"
+
 var (
 	errExpandType = "expand is only compatible with type filters"
 )
@@ -185,6 +191,7 @@ type Function struct {
 	IsCount    bool         // gt(count(friends),0)
 	IsValueVar bool         // eq(val(s), 5)
 	IsLenVar   bool         // eq(len(s), 5)
+	JSONPath   string       // eq(jsonpath(data, "$.status"), "active")
 }
 
 // filterOpPrecedence is a map from filterOp (a string) to its precedence.
@@ -231,6 +238,11 @@ func (f *Function) IsPasswordVerifier() bool {
 	return f.Name == "checkpwd"
 }
 
+// IsJSONPath returns true if the function name is "jsonpath".
+func (f *Function) IsJSONPath() bool {
+	return f.Name == jsonPathFunc
+}
+
 // DebugPrint is useful for debugging.
 func (gq *GraphQuery) DebugPrint(prefix string) {
 	glog.Infof("%s[%x %q %q]\n", prefix, gq.UID, gq.Attr, gq.Alias)
@@ -644,6 +656,9 @@ func ParseWithNeedVars(r Request, needVars []string) (res Result, rerr error) {
 		if len(needVars) != 0 {
 			allVars = append(allVars, &Vars{Needs: needVars})
 		}
+		if err := defineDistanceVar(res.Query, allVars); err != nil {
+			return res, err
+		}
 		if err := checkDependency(allVars); err != nil {
 			return res, err
 		}
@@ -679,6 +694,36 @@ func flatten(vl []*Vars) (needs []string, defines []string) {
 	return
 }
 
+// defineDistanceVar adds the distance variable to the variables defined by the block with the
+// nearest function at root. This is only done when the variable is used, as defining a variable
+// that isn't used is an error. The variable can't tell apart the distances of several blocks, so
+// it can't be used along with more than one of them.
+func defineDistanceVar(queries []*GraphQuery, vl []*Vars) error {
+	needs, _ := flatten(vl)
+	var used bool
+	for _, name := range needs {
+		if name == DistanceVar {
+			used = true
+			break
+		}
+	}
+	if !used {
+		return nil
+	}
+	var defined bool
+	for i, gq := range queries {
+		if gq.Func != nil && gq.Func.Name == "nearest" {
+			if defined {
+				return errors.Errorf("The variable %s can't be used along with more than one "+
+					"block with the nearest function", DistanceVar)
+			}
+			vl[i].Defines = append(vl[i].Defines, DistanceVar)
+			defined = true
+		}
+	}
+	return nil
+}
+
 func checkDependency(vl []*Vars) error {
 	needs, defines := flatten(vl)
 
@@ -1620,7 +1665,8 @@ func validFuncName(name string) bool {
 
 	switch name {
 	case "regexp", "anyofterms", "allofterms", "alloftext", "anyoftext",
-		"has", "uid", "uid_in", "anyof", "allof", "type", "match":
+		"has", "uid", "uid_in", "anyof", "allof", "type", "match",
+		"sounds_like", "overlaps", "contains_time", "during":
 		return true
 	}
 	return false
@@ -1726,6 +1772,17 @@ L:
 				case countFunc:
 					function.Attr = nestedFunc.Attr
 					function.IsCount = true
+				case jsonPathFunc:
+					if !IsInequalityFn(function.Name) {
+						return nil, itemInFunc.Errorf("jsonpath function only allowed inside " +
+							"inequality function")
+					}
+					if len(nestedFunc.Args) != 1 {
+						return nil, itemInFunc.Errorf("jsonpath function expects a predicate " +
+							"and a path")
+					}
+					function.Attr = nestedFunc.Attr
+					function.JSONPath = nestedFunc.Args[0].Value
 				case uidFunc:
 					// TODO (Anurag): See if is is possible to support uid(1,2,3) when
 					// uid is nested inside a function like @filter(uid_in(predicate, uid()))
@@ -1741,8 +1798,8 @@ L:
 					function.NeedsVar[0].Typ = UidVar
 					function.Args = append(function.Args, Arg{Value: nestedFunc.NeedsVar[0].Name})
 				default:
-					return nil, itemInFunc.Errorf("Only val/count/len/uid allowed as function "+
-						"within another. Got: %s", nestedFunc.Name)
+					return nil, itemInFunc.Errorf("Only val/count/len/uid/jsonpath allowed as "+
+						"function within another. Got: %s", nestedFunc.Name)
 				}
 				expectArg = false
 				continue
@@ -2995,6 +3052,24 @@ func godeep(it *lex.ItemIterator, gq *GraphQuery) error {
 				gq.Children = append(gq.Children, child)
 				curp = nil
 				continue
+			case valLower == jsonPathFunc:
+				child := &GraphQuery{
+					Args:  make(map[string]string),
+					Var:   varName,
+					Alias: alias,
+				}
+				varName, alias = "", ""
+				it.Prev()
+				if child.Func, err = parseFunction(it, gq); err != nil {
+					return err
+				}
+				if len(child.Func.Args) != 1 {
+					return it.Errorf("jsonpath function expects a predicate and a path")
+				}
+				child.Attr = child.Func.Attr
+				gq.Children = append(gq.Children, child)
+				curp = nil
+				continue
 			case isAggregator(valLower):
 				child := &GraphQuery{
 					Attr:       valueFunc,
@@ -3311,7 +3386,11 @@ func isMathBlock(name string) bool {
 }
 
 func isGeoFunc(name string) bool {
-	return name == "near" || name == "contains" || name == "within" || name == "intersects"
+	switch name {
+	case "near", "nearest", "contains", "within", "intersects":
+		return true
+	}
+	return false
 }
 
 func IsInequalityFn(name string) bool {
@@ -17,10 +17,24 @@
 package dql
 
 import (
+	"strconv"
+	"strings"
+
+	"github.com/pkg/errors"
+
 	"github.com/dgraph-io/dgo/v210/protos/api"
 	"github.com/dgraph-io/dgraph/lex"
 )
 
+// The branches of the conditional mutations of an upsert block. An @if branch starts a chain,
+// which the @elseif and @else branches following it continue. Only the first branch of a chain
+// whose condition holds runs.
+const (
+	BranchIf     = "@if"
+	BranchElseIf = "@elseif"
+	BranchElse   = "@else"
+)
+
 // ParseMutation parses a block into a mutation. Returns an object with a mutation or
 // an upsert block with mutation, otherwise returns nil with an error.
 func ParseMutation(mutation string) (req *api.Request, err error) {
@@ -63,8 +77,9 @@ func ParseMutation(mutation string) (req *api.Request, err error) {
 // parseUpsertBlock parses the upsert block
 func parseUpsertBlock(it *lex.ItemIterator) (*api.Request, error) {
 	var req *api.Request
-	var queryText, condText string
+	var queryText string
 	var queryFound bool
+	var conds []Condition
 
 	// ===>upsert<=== {...}
 	if !it.Next() {
@@ -88,6 +103,9 @@ func parseUpsertBlock(it *lex.ItemIterator) (*api.Request, error) {
 			case !queryFound:
 				return nil, it.Errorf("Query op not found in upsert block")
 			default:
+				if err := CheckBranches(conds); err != nil {
+					return nil, it.Errorf(err.Error())
+				}
 				req.Query = queryText
 				return req, nil
 			}
@@ -109,22 +127,32 @@ func parseUpsertBlock(it *lex.ItemIterator) (*api.Request, error) {
 
 		// upsert { ===>mutation<=== {...} query{...}}
 		case item.Typ == itemUpsertBlockOp && item.Val == "mutation":
-			if !it.Next() {
-				return nil, it.Errorf("Unexpected end of upsert block")
-			}
-
-			// upsert { mutation ===>@if(...)<=== {....} query{...}}
-			item = it.Item()
-			if item.Typ == itemUpsertBlockOpContent {
-				condText = item.Val
+			// upsert { mutation ===>@if(...) @abort(...)<=== {....} query{...}}
+			var directives []string
+			for {
 				if !it.Next() {
 					return nil, it.Errorf("Unexpected end of upsert block")
 				}
+				item = it.Item()
+				if item.Typ != itemUpsertBlockOpContent {
+					break
+				}
+				directives = append(directives, item.Val)
 			}
+			condText := strings.Join(directives, " ")
+			cond, err := ParseCond(condText)
+			if err != nil {
+				return nil, it.Errorf(err.Error())
+			}
+			conds = append(conds, cond)
 
 			// upsert @if(...) ===>{<=== ....}
-			mu, err := parseMutationBlock(it)
-			if err != nil {
+			var mu *api.Mutation
+			if item.Typ != itemLeftCurl && cond.Abort != "" {
+				// A mutation which only aborts the request has no block.
+				it.Prev()
+				mu = &api.Mutation{}
+			} else if mu, err = parseMutationBlock(it); err != nil {
 				return nil, err
 			}
 			mu.Cond = condText
@@ -153,6 +181,82 @@ func parseUpsertBlock(it *lex.ItemIterator) (*api.Request, error) {
 	return nil, it.Errorf("Invalid upsert block")
 }
 
+// ParseCond parses the condition of a mutation, made of an optional @if(...), @elseif(...) or @else
+// branch and an optional @abort("message").
+func ParseCond(cond string) (Condition, error) {
+	var res Condition
+	if strings.TrimSpace(cond) == "" {
+		return res, nil
+	}
+
+	var lexer lex.Lexer
+	lexer.Reset(cond)
+	lexer.Run(lexCondition)
+	if err := lexer.ValidateResult(); err != nil {
+		return res, err
+	}
+
+	it := lexer.NewIterator()
+	for it.Next() {
+		item := it.Item()
+		if item.Typ != itemUpsertBlockOpContent {
+			continue
+		}
+		name, args := item.Val, ""
+		if idx := strings.IndexByte(name, '('); idx >= 0 {
+			name, args = name[:idx], name[idx:]
+		}
+		// The arguments may be separated from the name by spaces, as in @if (eq(len(u), 0)).
+		name = strings.TrimSpace(name)
+
+		switch name {
+		case BranchIf, BranchElseIf, BranchElse:
+			if res.Branch != "" {
+				return res, errors.Errorf("Found %s after %s in the same condition", name,
+					res.Branch)
+			}
+			res.Branch, res.Filter = name, args
+		case "@abort":
+			if res.Abort != "" {
+				return res, errors.Errorf("Found more than one @abort in the same condition")
+			}
+			if len(args) < 2 || args[0] != '(' || args[len(args)-1] != ')' {
+				return res, errors.Errorf("Expected a message in parentheses for @abort, found %s",
+					args)
+			}
+			msg, err := strconv.Unquote(strings.TrimSpace(args[1 : len(args)-1]))
+			if err != nil || msg == "" {
+				return res, errors.Errorf("Expected a message in quotes for @abort, found %s",
+					args)
+			}
+			res.Abort = msg
+		default:
+			return res, errors.Errorf("Expected @if, @elseif, @else or @abort, found %s", name)
+		}
+	}
+	return res, nil
+}
+
+// CheckBranches verifies that every @elseif or @else branch among the conditions of the mutations
+// of a request follows an @if or @elseif branch.
+func CheckBranches(conds []Condition) error {
+	inChain := false
+	for _, cond := range conds {
+		switch cond.Branch {
+		case BranchIf:
+			inChain = true
+		case BranchElseIf, BranchElse:
+			if !inChain {
+				return errors.Errorf("Found %s without an @if before it", cond.Branch)
+			}
+			inChain = cond.Branch == BranchElseIf
+		default:
+			inChain = false
+		}
+	}
+	return nil
+}
+
 // parseMutationBlock parses the mutation block
 func parseMutationBlock(it *lex.ItemIterator) (*api.Mutation, error) {
 	var mu api.Mutation
@@ -2464,6 +2464,61 @@ func TestParseFilter_Geo1(t *testing.T) {
 	require.Equal(t, false, resp.Query[0].Children[0].Filter.Func.Args[1].IsValueVar)
 }
 
+func TestParseNearestDistanceVar(t *testing.T) {
+	query := `
+	{
+		me(func: nearest(loc, [-1.12, 2.0123], 5), orderasc: val(distance)) {
+			name
+			val(distance)
+		}
+	}
+`
+	resp, err := Parse(Request{Str: query})
+	require.NoError(t, err)
+	require.Equal(t, "nearest", resp.Query[0].Func.Name)
+	require.Equal(t, "[-1.12,2.0123]", resp.Query[0].Func.Args[0].Value)
+	require.Equal(t, "5", resp.Query[0].Func.Args[1].Value)
+	require.Equal(t, []string{DistanceVar}, resp.QueryVars[0].Defines)
+
+	// The distance variable is only defined when it is used.
+	query = `
+	{
+		me(func: nearest(loc, [-1.12, 2.0123], 5)) {
+			name
+		}
+	}
+`
+	resp, err = Parse(Request{Str: query})
+	require.NoError(t, err)
+	require.Empty(t, resp.QueryVars[0].Defines)
+
+	query = `
+	{
+		me(func: near(loc, [-1.12, 2.0123], 5), orderasc: val(distance)) {
+			name
+		}
+	}
+`
+	_, err = Parse(Request{Str: query})
+	require.Error(t, err)
+	require.Contains(t, err.Error(), "Some variables are used but not defined")
+
+	// The distances of several nearest blocks can't be told apart.
+	query = `
+	{
+		me(func: nearest(loc, [-1.12, 2.0123], 5), orderasc: val(distance)) {
+			name
+		}
+		you(func: nearest(loc, [3.5, 4.25], 5)) {
+			name
+		}
+	}
+`
+	_, err = Parse(Request{Str: query})
+	require.Error(t, err)
+	require.Contains(t, err.Error(), "more than one block with the nearest function")
+}
+
 func TestParseFilter_Geo2(t *testing.T) {
 	query := `
 	query {
@@ -2718,6 +2773,40 @@ func TestParseCheckPwd(t *testing.T) {
 	require.Equal(t, "password", gq.Query[0].Children[0].Attr)
 }
 
+func TestParseJSONPath(t *testing.T) {
+	query := `{
+		me(func: eq(jsonpath(data, "$.status"), "active", "new")) @filter(gt(jsonpath(data, "$.n"), 2)) {
+			status: jsonpath(data, "$.status")
+			n as jsonpath(data, "$.n")
+			twice: math(n * 2)
+		}
+	}
+`
+	gq, err := Parse(Request{Str: query})
+	require.NoError(t, err)
+	root := gq.Query[0]
+	require.Equal(t, "eq", root.Func.Name)
+	require.Equal(t, "data", root.Func.Attr)
+	require.Equal(t, "$.status", root.Func.JSONPath)
+	require.Equal(t, []Arg{{Value: "active"}, {Value: "new"}}, root.Func.Args)
+	require.Equal(t, "$.n", root.Filter.Func.JSONPath)
+
+	status := root.Children[0]
+	require.Equal(t, "jsonpath", status.Func.Name)
+	require.Equal(t, "data", status.Attr)
+	require.Equal(t, "status", status.Alias)
+	require.Equal(t, "$.status", status.Func.Args[0].Value)
+	require.Equal(t, "n", root.Children[1].Var)
+
+	query = `{
+		me(func: has(data)) @filter(anyofterms(jsonpath(data, "$.status"), "active")) {
+			uid
+		}
+	}`
+	_, err = Parse(Request{Str: query})
+	require.Error(t, err)
+}
+
 func TestParseComments(t *testing.T) {
 	query := `
 	# Something
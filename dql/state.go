@@ -156,19 +156,49 @@ func lexBlockContent(l *lex.Lexer) lex.StateFn {
 	return lexContent(l, leftCurl, rightCurl, lexUpsertBlock)
 }
 
-// lexIfContent lexes the whole of @if directive in a mutation block (covered by small brackets)
+// lexIfContent lexes the whole of a directive of a mutation block, such as @if (covered by small
+// brackets).
 func lexIfContent(l *lex.Lexer) lex.StateFn {
+	return lexCondDirective(l, lexInsideMutation)
+}
+
+// lexCondition lexes the condition of a mutation, made of the directives which come before its
+// block, as given in the cond field of a mutation.
+func lexCondition(l *lex.Lexer) lex.StateFn {
+	l.Mode = lexCondition
+	for {
+		switch r := l.Next(); {
+		case r == at:
+			l.Backup()
+			return lexCondDirective(l, lexCondition)
+		case isSpace(r) || lex.IsEndOfLine(r):
+			l.Ignore()
+		case r == lex.EOF:
+			l.Emit(lex.ItemEOF)
+			return nil
+		default:
+			return l.Errorf("Unrecognized character in condition: %#U", r)
+		}
+	}
+}
+
+// lexCondDirective lexes one of the @if, @elseif, @else and @abort directives of a mutation.
+// All of them but @else take their arguments in small brackets.
+func lexCondDirective(l *lex.Lexer, returnTo lex.StateFn) lex.StateFn {
 	if r := l.Next(); r != at {
 		return l.Errorf("Expected [@], found; [%#U]", r)
 	}
 
 	l.AcceptRun(isNameSuffix)
-	word := l.Input[l.Start:l.Pos]
-	if word != "@if" {
-		return l.Errorf("Expected @if, found [%v]", word)
+	switch word := l.Input[l.Start:l.Pos]; word {
+	case "@if", "@elseif", "@abort":
+		return lexContent(l, '(', ')', returnTo)
+	case "@else":
+		l.Emit(itemUpsertBlockOpContent)
+		return returnTo
+	default:
+		return l.Errorf("Expected @if, @elseif, @else or @abort, found [%v]", word)
 	}
-
-	return lexContent(l, '(', ')', lexInsideMutation)
 }
 
 func lexContent(l *lex.Lexer, leftRune, rightRune rune, returnTo lex.StateFn) lex.StateFn {
@@ -204,6 +234,10 @@ func lexInsideMutation(l *lex.Lexer) lex.StateFn {
 		case r == at:
 			l.Backup()
 			return lexIfContent
+		case l.Depth == 0 && l.BlockDepth > 0 && (isNameBegin(r) || r == rightCurl):
+			// A mutation of an upsert block which only aborts the request has no block.
+			l.Backup()
+			return lexUpsertBlock
 		case r == rightCurl:
 			l.Depth--
 			l.Emit(itemRightCurl)
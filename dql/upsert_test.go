@@ -465,7 +465,7 @@ func TestConditionalUpsertErrWrongIf(t *testing.T) {
 }
 `
 	_, err := ParseMutation(query)
-	require.Contains(t, err.Error(), "Expected @if, found [@fi]")
+	require.Contains(t, err.Error(), "Expected @if, @elseif, @else or @abort, found [@fi]")
 }
 
 func TestMultipleMutation(t *testing.T) {
@@ -531,3 +531,146 @@ upsert {
 	require.NoError(t, err)
 	require.Equal(t, 3, len(req.Mutations))
 }
+
+func TestConditionalUpsertBranches(t *testing.T) {
+	query := `
+upsert {
+  query {
+    u as var(func: eq(email, "alice@example.com"))
+  }
+
+  mutation @if(eq(len(u), 0)) {
+    set {
+      _:alice <email> "alice@example.com" .
+    }
+  }
+
+  mutation @elseif(eq(len(u), 1)) {
+    set {
+      uid(u) <seen> "true" .
+    }
+  }
+
+  mutation @else @abort("more than one user with this email")
+
+  mutation {
+    set {
+      _:log <event> "signup" .
+    }
+  }
+}`
+	req, err := ParseMutation(query)
+	require.NoError(t, err)
+	require.Equal(t, 4, len(req.Mutations))
+	require.Equal(t, `@else @abort("more than one user with this email")`, req.Mutations[2].Cond)
+	require.Empty(t, req.Mutations[2].SetNquads)
+	require.Empty(t, req.Mutations[3].Cond)
+
+	cond, err := ParseCond(req.Mutations[1].Cond)
+	require.NoError(t, err)
+	require.Equal(t, Condition{Branch: BranchElseIf, Filter: "(eq(len(u), 1))"}, cond)
+	cond, err = ParseCond(req.Mutations[2].Cond)
+	require.NoError(t, err)
+	require.Equal(t, Condition{Branch: BranchElse, Abort: "more than one user with this email"},
+		cond)
+}
+
+func TestConditionalUpsertBranchesWithSpaces(t *testing.T) {
+	query := `
+upsert {
+  query {
+    u as var(func: eq(email, "alice@example.com"))
+  }
+
+  mutation @if (eq(len(u), 0)) {
+    set {
+      _:alice <email> "alice@example.com" .
+    }
+  }
+
+  mutation @elseif ( eq(len(u), 1) ) {
+    set {
+      uid(u) <seen> "true" .
+    }
+  }
+
+  mutation @else @abort ( "more than one user with this email" )
+}`
+	req, err := ParseMutation(query)
+	require.NoError(t, err)
+	require.Equal(t, 3, len(req.Mutations))
+
+	cond, err := ParseCond(req.Mutations[0].Cond)
+	require.NoError(t, err)
+	require.Equal(t, Condition{Branch: BranchIf, Filter: "(eq(len(u), 0))"}, cond)
+	cond, err = ParseCond(req.Mutations[1].Cond)
+	require.NoError(t, err)
+	require.Equal(t, Condition{Branch: BranchElseIf, Filter: "( eq(len(u), 1) )"}, cond)
+	cond, err = ParseCond(req.Mutations[2].Cond)
+	require.NoError(t, err)
+	require.Equal(t, Condition{Branch: BranchElse, Abort: "more than one user with this email"},
+		cond)
+}
+
+func TestParseCondWithSpaces(t *testing.T) {
+	tests := []struct {
+		cond string
+		res  Condition
+	}{
+		{` @if (eq(len(u), 0)) `, Condition{Branch: BranchIf, Filter: "(eq(len(u), 0))"}},
+		{`@elseif  (eq(len(u), 1))`, Condition{Branch: BranchElseIf, Filter: "(eq(len(u), 1))"}},
+		{`@if (eq(len(u), 0)) @abort ("user exists")`,
+			Condition{Branch: BranchIf, Filter: "(eq(len(u), 0))", Abort: "user exists"}},
+	}
+	for _, tc := range tests {
+		cond, err := ParseCond(tc.cond)
+		require.NoError(t, err, tc.cond)
+		require.Equal(t, tc.res, cond, tc.cond)
+	}
+}
+
+func TestConditionalUpsertErrElseWithoutIf(t *testing.T) {
+	query := `
+upsert {
+  query {
+    u as var(func: eq(email, "alice@example.com"))
+  }
+
+  mutation {
+    set {
+      _:alice <email> "alice@example.com" .
+    }
+  }
+
+  mutation @else @abort("user exists")
+}`
+	_, err := ParseMutation(query)
+	require.Error(t, err)
+	require.Contains(t, err.Error(), "Found @else without an @if before it")
+}
+
+func TestConditionalUpsertErrNoBlock(t *testing.T) {
+	query := `
+upsert {
+  query {
+    u as var(func: eq(email, "alice@example.com"))
+  }
+
+  mutation @if(eq(len(u), 0))
+}`
+	_, err := ParseMutation(query)
+	require.Error(t, err)
+	require.Contains(t, err.Error(), "Expected { at the start of block")
+}
+
+func TestParseCondErrors(t *testing.T) {
+	for _, cond := range []string{
+		`@if(eq(len(u), 0)) @else`,
+		`@abort(user exists)`,
+		`@abort("a") @abort("b")`,
+		`@unless(eq(len(u), 0))`,
+	} {
+		_, err := ParseCond(cond)
+		require.Error(t, err, cond)
+	}
+}
@@ -23,6 +23,7 @@ import (
 
 	"github.com/dgraph-io/dgo/v210/protos/api"
 	"github.com/dgraph-io/dgraph/dql"
+	"github.com/dgraph-io/dgraph/protos/pb"
 	"github.com/dgraph-io/dgraph/query"
 	"github.com/dgraph-io/dgraph/x"
 	"github.com/dgraph-io/ristretto/z"
@@ -101,3 +102,13 @@ func upsertGuardian(ctx context.Context) error {
 func upsertGroot(ctx context.Context) error {
 	return nil
 }
+
+// authorizeStoredQuery allows anyone to run the stored queries, as there are no groups without ACL.
+func authorizeStoredQuery(ctx context.Context, sq *pb.StoredQuery) error {
+	return nil
+}
+
+// requestUser returns an empty user for the requests, as there are no users without ACL.
+func requestUser(ctx context.Context) string {
+	return ""
+}
@@ -613,6 +613,20 @@ func upsertGroot(ctx context.Context, passwd string) error {
 	return nil
 }
 
+// requestUser returns the id of the user making the request, like a schema change, as found in
+// the accessJwt in the context, or an empty string if ACL is disabled.
+func requestUser(ctx context.Context) string {
+	if !x.WorkerConfig.AclEnabled {
+		return ""
+	}
+	userData, err := extractUserAndGroups(ctx)
+	if err != nil {
+		glog.V(2).Infof("Unable to find the user of the request: %v", err)
+		return ""
+	}
+	return userData.userId
+}
+
 // extract the userId, groupIds from the accessJwt in the context
 func extractUserAndGroups(ctx context.Context) (*userData, error) {
 	accessJwt, err := x.ExtractJwt(ctx)
@@ -881,7 +895,8 @@ func parsePredsFromQuery(dqls []*dql.GraphQuery) predsAndvars {
 		if len(gq.Var) > 0 {
 			varsMap[gq.Var] = gq.Attr
 		}
-		if len(gq.Attr) > 0 && gq.Attr != "uid" && gq.Attr != "expand" && gq.Attr != "val" {
+		if len(gq.Attr) > 0 && gq.Attr != "uid" && gq.Attr != "expand" && gq.Attr != "val" &&
+			gq.Attr != x.VersionField {
 			predsMap[gq.Attr] = struct{}{}
 
 		}
@@ -1161,6 +1176,34 @@ func AuthorizeGuardians(ctx context.Context) error {
 	return nil
 }
 
+// authorizeStoredQuery allows the user to run the stored query if it belongs to one of the groups
+// of the query, or to the guardians. Anyone can run a query which lists no groups.
+func authorizeStoredQuery(ctx context.Context, sq *pb.StoredQuery) error {
+	if !x.WorkerConfig.AclEnabled || len(sq.Groups) == 0 {
+		return nil
+	}
+
+	userData, err := extractUserAndGroups(ctx)
+	switch {
+	case err == x.ErrNoJwt:
+		return status.Error(codes.PermissionDenied, err.Error())
+	case err != nil:
+		return status.Error(codes.Unauthenticated, err.Error())
+	}
+	if x.IsGuardian(userData.groupIds) {
+		return nil
+	}
+	for _, group := range userData.groupIds {
+		for _, allowed := range sq.Groups {
+			if group == allowed {
+				return nil
+			}
+		}
+	}
+	return status.Error(codes.PermissionDenied, fmt.Sprintf("User '%v' is not allowed to run "+
+		"the stored query %s.", userData.userId, x.ParseAttr(sq.Name)))
+}
+
 /*
 addUserFilterToQuery applies makes sure that a user can access only its own
 acl info by applying filter of userid and groupid to acl predicates. A query like
@@ -0,0 +1,230 @@
+/*
+ * Copyright 2022 Dgraph Labs, Inc. and Contributors
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package edgraph
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+	"time"
+
+	"github.com/golang/glog"
+	"github.com/pkg/errors"
+
+	"github.com/dgraph-io/dgo/v210"
+	"github.com/dgraph-io/dgo/v210/protos/api"
+	"github.com/dgraph-io/dgraph/dql"
+	"github.com/dgraph-io/dgraph/protos/pb"
+	"github.com/dgraph-io/dgraph/query"
+	"github.com/dgraph-io/dgraph/worker"
+	"github.com/dgraph-io/dgraph/x"
+)
+
+const (
+	// defaultDeleteBatchSize is the number of nodes deleted in each transaction of a
+	// delete-by-query task, when the request doesn't set it.
+	defaultDeleteBatchSize = 1000
+	// deleteRetryDelay is the delay before retrying a batch which was aborted, doubled after
+	// every abort up to maxDeleteRetryDelay.
+	deleteRetryDelay    = 100 * time.Millisecond
+	maxDeleteRetryDelay = 10 * time.Second
+)
+
+// DeleteByQuery enqueues a task deleting the nodes matched by the root of the query, as with
+// `delete { <uid> * * . }`, in transactions of at most batchSize nodes. The id of the task is
+// returned. Its progress is the number of nodes deleted so far, and it can be cancelled between
+// two transactions.
+func DeleteByQuery(ctx context.Context, q string, batchSize uint64) (uint64, error) {
+	if err := x.HealthCheck(); err != nil {
+		return 0, err
+	}
+	namespace, err := x.ExtractNamespace(ctx)
+	if err != nil {
+		return 0, errors.Wrapf(err, "While deleting by query")
+	}
+	if batchSize == 0 {
+		batchSize = defaultDeleteBatchSize
+	}
+	if limit := uint64(x.Config.LimitMutationsNquad); limit > 0 && batchSize > limit {
+		return 0, errors.Errorf("Batch size %d is more than the limit of %d nquads in a mutation",
+			batchSize, limit)
+	}
+	if _, _, err := parseDeleteQuery(q, batchSize, 0); err != nil {
+		return 0, err
+	}
+
+	return worker.Tasks.Enqueue(&pb.DeleteByQueryRequest{
+		Namespace: namespace,
+		Query:     q,
+		BatchSize: batchSize,
+	})
+}
+
+// parseDeleteQuery parses the query of a delete-by-query task, limited to the given number of
+// nodes with a uid after the given one. The index of the block whose root nodes are deleted is
+// returned along with it.
+func parseDeleteQuery(q string, batchSize, after uint64) (dql.Result, int, error) {
+	res, err := dql.Parse(dql.Request{Str: q})
+	if err != nil {
+		return res, 0, errors.Wrapf(err, "While parsing the query to delete by")
+	}
+	idx := -1
+	for i, gq := range res.Query {
+		if gq.Alias == "var" {
+			continue
+		}
+		if idx >= 0 {
+			return res, 0, errors.Errorf("The query to delete by must have a single block " +
+				"besides its var blocks")
+		}
+		idx = i
+	}
+	if idx < 0 {
+		return res, 0, errors.Errorf("The query to delete by must have a block which isn't " +
+			"a var block")
+	}
+
+	gq := res.Query[idx]
+	for _, arg := range []string{"first", "offset", "after"} {
+		if _, ok := gq.Args[arg]; ok {
+			return res, 0, errors.Errorf("The query to delete by can't use %s. The task goes "+
+				"through the matching nodes in batches by itself", arg)
+		}
+	}
+	if len(gq.Order) > 0 {
+		return res, 0, errors.Errorf("The query to delete by can't be ordered. The task goes " +
+			"through the matching nodes in the order of their uids")
+	}
+	if gq.Args == nil {
+		gq.Args = make(map[string]string)
+	}
+	gq.Args["first"] = strconv.FormatUint(batchSize, 10)
+	if after > 0 {
+		gq.Args["after"] = fmt.Sprintf("%#x", after)
+	}
+	return res, idx, nil
+}
+
+// deleteByQuery runs a delete-by-query task. Every batch is deleted in its own transaction, and
+// the task stops between two batches when the context is cancelled. The batches go through the
+// matching nodes in the order of their uids, so that the nodes which still match once deleted,
+// like nodes without a type, are not found again. A batch which is aborted is retried after a
+// delay.
+func deleteByQuery(ctx context.Context, req *pb.DeleteByQueryRequest,
+	progress func(uint64)) error {
+	// A batch is not cancelled halfway, so that its transaction is committed or aborted.
+	batchCtx := x.AttachNamespace(context.Background(), req.Namespace)
+
+	var deleted, after uint64
+	delay := deleteRetryDelay
+	for {
+		select {
+		case <-ctx.Done():
+			glog.Infof("Delete by query cancelled after deleting %d nodes", deleted)
+			return ctx.Err()
+		default:
+		}
+
+		n, last, err := deleteBatch(batchCtx, req, after)
+		switch {
+		case err == dgo.ErrAborted:
+			// The batch conflicted with another transaction. It is tried again after a while.
+			glog.V(2).Infof("Delete by query aborted after uid %#x. Retrying in %s", after, delay)
+			select {
+			case <-ctx.Done():
+				glog.Infof("Delete by query cancelled after deleting %d nodes", deleted)
+				return ctx.Err()
+			case <-time.After(delay):
+			}
+			if delay *= 2; delay > maxDeleteRetryDelay {
+				delay = maxDeleteRetryDelay
+			}
+			continue
+		case err != nil:
+			return errors.Wrapf(err, "While deleting by query, after deleting %d nodes", deleted)
+		case n == 0:
+			glog.Infof("Delete by query deleted %d nodes", deleted)
+			return nil
+		}
+		deleted += uint64(n)
+		after = last
+		delay = deleteRetryDelay
+		progress(deleted)
+	}
+}
+
+// deleteBatch deletes the next batch of nodes matched by the query of the task, with a uid after
+// the given one. It returns the number of deleted nodes and the last of their uids.
+func deleteBatch(ctx context.Context, req *pb.DeleteByQueryRequest,
+	after uint64) (int, uint64, error) {
+	res, idx, err := parseDeleteQuery(req.Query, req.BatchSize, after)
+	if err != nil {
+		return 0, 0, err
+	}
+
+	startTs := worker.State.GetTimestamp(false)
+	qr := query.Request{
+		Latency:  &query.Latency{},
+		GqlQuery: &res,
+		ReadTs:   startTs,
+	}
+	er, err := qr.Process(ctx)
+	if err != nil {
+		return 0, 0, err
+	}
+	uids := er.Subgraphs[idx].DestUIDs.GetUids()
+	if len(uids) == 0 {
+		return 0, 0, nil
+	}
+	var last uint64
+	for _, uid := range uids {
+		if uid > last {
+			last = uid
+		}
+	}
+
+	gmu := &dql.Mutation{Del: make([]*api.NQuad, 0, len(uids))}
+	for _, uid := range uids {
+		gmu.Del = append(gmu.Del, &api.NQuad{
+			Subject:     fmt.Sprintf("%#x", uid),
+			Predicate:   x.Star,
+			ObjectValue: &api.Value{Val: &api.Value_DefaultVal{DefaultVal: x.Star}},
+		})
+	}
+	edges, err := query.ToDirectedEdges([]*dql.Mutation{gmu}, nil)
+	if err != nil {
+		return 0, 0, err
+	}
+
+	m := &pb.Mutations{StartTs: startTs, Edges: edges}
+	tctx, err := query.ApplyMutations(ctx, m)
+	if err != nil {
+		if tctx == nil {
+			tctx = &api.TxnContext{StartTs: startTs}
+		}
+		tctx.Aborted = true
+		_, _ = worker.CommitOverNetwork(ctx, tctx)
+		if err == x.ErrConflict {
+			err = dgo.ErrAborted
+		}
+		return 0, 0, err
+	}
+	if _, err := worker.CommitOverNetwork(ctx, tctx); err != nil {
+		return 0, 0, err
+	}
+	return len(uids), last, nil
+}
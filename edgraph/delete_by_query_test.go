@@ -0,0 +1,52 @@
+/*
+ * Copyright 2022 Dgraph Labs, Inc. and Contributors
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package edgraph
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestParseDeleteQuery(t *testing.T) {
+	res, idx, err := parseDeleteQuery(`{
+		var(func: has(name)) { n as name }
+		q(func: eq(val(n), "Alice")) { uid }
+	}`, 100, 0)
+	require.NoError(t, err)
+	require.Equal(t, 1, idx)
+	require.Equal(t, "100", res.Query[idx].Args["first"])
+	require.NotContains(t, res.Query[idx].Args, "after")
+
+	// The next batches start after the last node of the previous one.
+	res, idx, err = parseDeleteQuery(`{ q(func: has(name)) { uid } }`, 100, 0x2a)
+	require.NoError(t, err)
+	require.Equal(t, "0x2a", res.Query[idx].Args["after"])
+
+	for _, q := range []string{
+		`{ q(func: has(name), first: 10) { uid } }`,
+		`{ q(func: has(name), offset: 10) { uid } }`,
+		`{ q(func: has(name), after: 0x10) { uid } }`,
+		`{ q(func: has(name), orderasc: name) { uid } }`,
+		`{ a(func: has(name)) { uid } b(func: has(age)) { uid } }`,
+		`{ var(func: has(name)) { uid } }`,
+		`{ q(func: has(name) { uid } }`,
+	} {
+		_, _, err := parseDeleteQuery(q, 100, 0)
+		require.Error(t, err, q)
+	}
+}
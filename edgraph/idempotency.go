@@ -0,0 +1,170 @@
+/*
+ * Copyright 2022 Dgraph Labs, Inc. and Contributors
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package edgraph
+
+import (
+	"context"
+	"fmt"
+	"sync"
+	"time"
+
+	"github.com/dgryski/go-farm"
+	"github.com/pkg/errors"
+
+	"github.com/dgraph-io/dgraph/x"
+)
+
+// Mutations and commits sent with an idempotency key are run once per key. The response of the
+// first request is remembered by the Alpha for the idempotency window, and a retry with the same
+// key gets it back, uids of the blank nodes included, without being applied again. A retry which
+// comes while the first request is still running waits for its response. Failed requests are
+// forgotten, so that they can be retried. The keys are scoped by namespace and user, so that a
+// response is only returned to the user who made the request. At most idempotency-keys responses
+// are remembered, the oldest ones being forgotten first.
+//
+// The keys are remembered by the Alpha which got the request, and aren't replicated, so retries
+// must go to the same Alpha to be deduplicated. A retry sent to another Alpha runs again.
+
+// errIdempotencyKeyReused is returned when a key comes back with a different request.
+var errIdempotencyKeyReused = errors.New("The idempotency key was already used for a " +
+	"different request")
+
+type idempotentResponse struct {
+	// done is closed once the first request with the key is over.
+	done        chan struct{}
+	fingerprint uint64
+	resp        interface{}
+	err         error
+	expiry      time.Time
+}
+
+type idempotencyCache struct {
+	sync.Mutex
+	responses map[string]*idempotentResponse
+	// nextPrune is when the expired responses are removed next.
+	nextPrune time.Time
+}
+
+var idempotentResponses = &idempotencyCache{responses: make(map[string]*idempotentResponse)}
+
+// idempotencyKey returns the key under which the response of the request is remembered, or an
+// empty string if the request has no idempotency key. With ACL, requests without a valid user
+// aren't deduplicated, so that they are rejected when they are authorized.
+func idempotencyKey(ctx context.Context, kind string) (string, error) {
+	key := x.ExtractIdempotencyKey(ctx)
+	if key == "" || x.Config.IdempotencyWindow <= 0 {
+		return "", nil
+	}
+	ns, err := x.ExtractNamespace(ctx)
+	if err != nil {
+		return "", errors.Wrapf(err, "While checking the idempotency key")
+	}
+	user := requestUser(ctx)
+	if x.WorkerConfig.AclEnabled && user == "" {
+		return "", nil
+	}
+	return fmt.Sprintf("%#x-%q-%s-%s", ns, user, kind, key), nil
+}
+
+// do runs fn for the first request with the given key and fingerprint, and returns its response
+// to the later ones during the idempotency window.
+func (c *idempotencyCache) do(ctx context.Context, key string, fingerprint uint64,
+	fn func() (interface{}, error)) (interface{}, error) {
+	now := time.Now()
+	c.Lock()
+	c.prune(now)
+	if r, ok := c.responses[key]; ok && (r.expiry.IsZero() || now.Before(r.expiry)) {
+		c.Unlock()
+		if r.fingerprint != fingerprint {
+			return nil, errIdempotencyKeyReused
+		}
+		select {
+		case <-r.done:
+			return r.resp, r.err
+		case <-ctx.Done():
+			return nil, ctx.Err()
+		}
+	}
+	if !c.makeRoom(now) {
+		// All the remembered requests are still running.
+		c.Unlock()
+		return fn()
+	}
+	r := &idempotentResponse{done: make(chan struct{}), fingerprint: fingerprint}
+	c.responses[key] = r
+	c.Unlock()
+
+	r.resp, r.err = fn()
+	c.Lock()
+	if r.err != nil {
+		delete(c.responses, key)
+	} else {
+		r.expiry = time.Now().Add(x.Config.IdempotencyWindow)
+	}
+	c.Unlock()
+	close(r.done)
+	return r.resp, r.err
+}
+
+// prune removes the expired responses, at most once every half window. The caller must hold the
+// lock.
+func (c *idempotencyCache) prune(now time.Time) {
+	if now.Before(c.nextPrune) {
+		return
+	}
+	for key, r := range c.responses {
+		// Responses of running requests have no expiry yet.
+		if !r.expiry.IsZero() && now.After(r.expiry) {
+			delete(c.responses, key)
+		}
+	}
+	c.nextPrune = now.Add(x.Config.IdempotencyWindow / 2)
+}
+
+// makeRoom forgets the oldest responses when the maximum number of keys is reached, and returns
+// whether there is room for another one. The caller must hold the lock.
+func (c *idempotencyCache) makeRoom(now time.Time) bool {
+	if x.Config.IdempotencyKeys <= 0 || len(c.responses) < x.Config.IdempotencyKeys {
+		return true
+	}
+	c.nextPrune = time.Time{}
+	c.prune(now)
+	for len(c.responses) >= x.Config.IdempotencyKeys {
+		var oldest string
+		var expiry time.Time
+		for key, r := range c.responses {
+			if !r.expiry.IsZero() && (expiry.IsZero() || r.expiry.Before(expiry)) {
+				oldest, expiry = key, r.expiry
+			}
+		}
+		if expiry.IsZero() {
+			return false
+		}
+		delete(c.responses, oldest)
+	}
+	return true
+}
+
+// fingerprintMessage returns the fingerprint of a request, to tell apart two requests sent with
+// the same idempotency key.
+func fingerprintMessage(m interface{ Marshal() ([]byte, error) }) (uint64, error) {
+	data, err := m.Marshal()
+	if err != nil {
+		return 0, err
+	}
+	return farm.Fingerprint64(data), nil
+}
@@ -0,0 +1,127 @@
+/*
+ * Copyright 2022 Dgraph Labs, Inc. and Contributors
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package edgraph
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/pkg/errors"
+	"github.com/stretchr/testify/require"
+
+	"github.com/dgraph-io/dgo/v210/protos/api"
+	"github.com/dgraph-io/dgraph/x"
+)
+
+func TestIdempotencyCache(t *testing.T) {
+	window := x.Config.IdempotencyWindow
+	defer func() { x.Config.IdempotencyWindow = window }()
+	x.Config.IdempotencyWindow = time.Minute
+
+	c := &idempotencyCache{responses: make(map[string]*idempotentResponse)}
+	ctx := context.Background()
+	runs := 0
+	mutate := func() (interface{}, error) {
+		runs++
+		return &api.Response{Uids: map[string]string{"a": "0x1"}}, nil
+	}
+
+	req := &api.Request{Mutations: []*api.Mutation{{SetNquads: []byte(`_:a <name> "A" .`)}}}
+	fp, err := fingerprintMessage(req)
+	require.NoError(t, err)
+	for i := 0; i < 2; i++ {
+		resp, err := c.do(ctx, "key", fp, mutate)
+		require.NoError(t, err)
+		require.Equal(t, "0x1", resp.(*api.Response).Uids["a"])
+	}
+	require.Equal(t, 1, runs)
+
+	// The same key with another request is rejected.
+	req.Mutations[0].SetNquads = []byte(`_:b <name> "B" .`)
+	other, err := fingerprintMessage(req)
+	require.NoError(t, err)
+	require.NotEqual(t, fp, other)
+	_, err = c.do(ctx, "key", other, mutate)
+	require.Equal(t, errIdempotencyKeyReused, err)
+
+	// Failed requests are forgotten, so that they run again when retried.
+	_, err = c.do(ctx, "failed", fp, func() (interface{}, error) {
+		return nil, errors.New("aborted")
+	})
+	require.Error(t, err)
+	_, err = c.do(ctx, "failed", fp, mutate)
+	require.NoError(t, err)
+	require.Equal(t, 2, runs)
+
+	// Expired responses are forgotten too.
+	c.responses["key"].expiry = time.Now().Add(-time.Second)
+	_, err = c.do(ctx, "key", fp, mutate)
+	require.NoError(t, err)
+	require.Equal(t, 3, runs)
+}
+
+func TestIdempotencyCacheLimit(t *testing.T) {
+	window, keys := x.Config.IdempotencyWindow, x.Config.IdempotencyKeys
+	defer func() { x.Config.IdempotencyWindow, x.Config.IdempotencyKeys = window, keys }()
+	x.Config.IdempotencyWindow = time.Minute
+	x.Config.IdempotencyKeys = 2
+
+	c := &idempotencyCache{responses: make(map[string]*idempotentResponse)}
+	ctx := context.Background()
+	runs := 0
+	mutate := func() (interface{}, error) {
+		runs++
+		return &api.Response{}, nil
+	}
+	for _, key := range []string{"a", "b", "c"} {
+		_, err := c.do(ctx, key, 1, mutate)
+		require.NoError(t, err)
+	}
+	require.Len(t, c.responses, 2)
+
+	// The oldest response is forgotten first.
+	_, err := c.do(ctx, "c", 1, mutate)
+	require.NoError(t, err)
+	require.Equal(t, 3, runs)
+	_, err = c.do(ctx, "a", 1, mutate)
+	require.NoError(t, err)
+	require.Equal(t, 4, runs)
+	require.Len(t, c.responses, 2)
+}
+
+func TestIdempotencyKeyScope(t *testing.T) {
+	window := x.Config.IdempotencyWindow
+	defer func() { x.Config.IdempotencyWindow = window }()
+	x.Config.IdempotencyWindow = time.Minute
+
+	ctx := x.AttachIdempotencyKey(x.AttachNamespace(context.Background(), 2), "retry-1")
+	mutate, err := idempotencyKey(ctx, "mutate")
+	require.NoError(t, err)
+	commit, err := idempotencyKey(ctx, "commit")
+	require.NoError(t, err)
+	require.NotEqual(t, mutate, commit)
+
+	other, err := idempotencyKey(x.AttachIdempotencyKey(
+		x.AttachNamespace(context.Background(), 3), "retry-1"), "mutate")
+	require.NoError(t, err)
+	require.NotEqual(t, mutate, other)
+
+	key, err := idempotencyKey(x.AttachNamespace(context.Background(), 2), "mutate")
+	require.NoError(t, err)
+	require.Empty(t, key)
+}
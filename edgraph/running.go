@@ -0,0 +1,295 @@
+/*
+ * Copyright 2022 Dgraph Labs, Inc. and Contributors
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package edgraph
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"strings"
+	"sync"
+	"sync/atomic"
+	"time"
+
+	"github.com/golang/glog"
+	"github.com/pkg/errors"
+
+	"github.com/dgraph-io/dgo/v210"
+	"github.com/dgraph-io/dgo/v210/protos/api"
+	"github.com/dgraph-io/dgraph/posting"
+	"github.com/dgraph-io/dgraph/worker"
+	"github.com/dgraph-io/dgraph/x"
+)
+
+// The queries and mutations served by this Alpha are tracked while they run, so that a runaway
+// request can be found and killed. Killing a request cancels its context and aborts its
+// transaction, unless it is read-only.
+
+const (
+	// maxRequestText is the length after which the text of a running request is cut.
+	maxRequestText = 2048
+	// maxOpenTxnKeys is the number of keys described for an open transaction.
+	maxOpenTxnKeys = 100
+)
+
+// RunningRequest describes a query or mutation being served by this Alpha.
+type RunningRequest struct {
+	Id        uint64
+	Namespace uint64
+	// User is the id of the user who sent the request, empty if ACL is disabled.
+	User string
+	// Text is the query and mutations of the request, cut after maxRequestText bytes.
+	Text string
+	// StartTs is the start timestamp of the transaction of the request, zero until assigned.
+	StartTs  uint64
+	Mutation bool
+	Started  time.Time
+}
+
+type runningRequest struct {
+	info RunningRequest
+	// startTs is set atomically, once the request gets its timestamp.
+	startTs  uint64
+	ctx      context.Context
+	cancel   context.CancelFunc
+	readOnly bool
+}
+
+// describe returns the description of the request, with its user.
+func (r *runningRequest) describe() RunningRequest {
+	info := r.info
+	info.StartTs = atomic.LoadUint64(&r.startTs)
+	info.User = requestUser(r.ctx)
+	return info
+}
+
+type runningRequests struct {
+	sync.Mutex
+	nextId   uint64
+	requests map[uint64]*runningRequest
+}
+
+var running = &runningRequests{requests: make(map[uint64]*runningRequest)}
+
+type runningRequestKey struct{}
+
+// trackRequest registers the request as running until the returned function is called. The
+// returned context is cancelled when the request is killed.
+func trackRequest(ctx context.Context, req *api.Request) (context.Context, func()) {
+	ctx, cancel := context.WithCancel(ctx)
+	ns, _ := x.ExtractNamespace(ctx)
+	r := &runningRequest{
+		info: RunningRequest{
+			Namespace: ns,
+			Text:      requestText(req),
+			Mutation:  len(req.Mutations) > 0,
+			Started:   time.Now(),
+		},
+		startTs:  req.StartTs,
+		cancel:   cancel,
+		readOnly: req.ReadOnly,
+	}
+
+	running.Lock()
+	running.nextId++
+	r.info.Id = running.nextId
+	running.requests[r.info.Id] = r
+	running.Unlock()
+
+	r.ctx = context.WithValue(ctx, runningRequestKey{}, r)
+	return r.ctx, func() {
+		running.Lock()
+		delete(running.requests, r.info.Id)
+		running.Unlock()
+		cancel()
+	}
+}
+
+// setRequestStartTs records the start timestamp assigned to the request running with the context.
+func setRequestStartTs(ctx context.Context, startTs uint64) {
+	if r, ok := ctx.Value(runningRequestKey{}).(*runningRequest); ok {
+		atomic.StoreUint64(&r.startTs, startTs)
+	}
+}
+
+func requestText(req *api.Request) string {
+	var sb strings.Builder
+	sb.WriteString(req.Query)
+	for _, mu := range req.Mutations {
+		for _, part := range [][]byte{mu.SetNquads, mu.DelNquads, mu.SetJson, mu.DeleteJson} {
+			if len(part) > 0 {
+				if sb.Len() > 0 {
+					sb.WriteByte('\n')
+				}
+				sb.Write(part)
+			}
+		}
+		if mu.Cond != "" {
+			sb.WriteString("\n")
+			sb.WriteString(mu.Cond)
+		}
+	}
+	text := sb.String()
+	if len(text) > maxRequestText {
+		text = text[:maxRequestText] + "..."
+	}
+	return text
+}
+
+// visibleNamespace returns whether the caller can see and kill the requests and transactions of
+// the namespace. The guardians of the galaxy see all the namespaces.
+func visibleNamespace(caller, ns uint64) bool {
+	return caller == x.GalaxyNamespace || caller == ns
+}
+
+// RunningRequests returns the queries and mutations being served by this Alpha, sorted by id.
+func RunningRequests(ctx context.Context) ([]RunningRequest, error) {
+	caller, err := x.ExtractNamespace(ctx)
+	if err != nil {
+		return nil, errors.Wrapf(err, "While listing the running requests")
+	}
+
+	running.Lock()
+	reqs := make([]*runningRequest, 0, len(running.requests))
+	for _, r := range running.requests {
+		if visibleNamespace(caller, r.info.Namespace) {
+			reqs = append(reqs, r)
+		}
+	}
+	running.Unlock()
+
+	res := make([]RunningRequest, 0, len(reqs))
+	for _, r := range reqs {
+		res = append(res, r.describe())
+	}
+	sort.Slice(res, func(i, j int) bool { return res[i].Id < res[j].Id })
+	return res, nil
+}
+
+// OpenTransaction describes a transaction with mutations in the group of this Alpha, which is
+// neither committed nor aborted yet.
+type OpenTransaction struct {
+	StartTs    uint64
+	LastUpdate time.Time
+	// Keys describe the first maxOpenTxnKeys posting lists changed by the transaction in the
+	// group, out of NumKeys.
+	Keys    []string
+	NumKeys int
+}
+
+// OpenTransactions returns the transactions pending in the group of this Alpha, sorted by start
+// timestamp.
+func OpenTransactions(ctx context.Context) ([]OpenTransaction, error) {
+	caller, err := x.ExtractNamespace(ctx)
+	if err != nil {
+		return nil, errors.Wrapf(err, "While listing the open transactions")
+	}
+
+	var res []OpenTransaction
+	for _, txn := range posting.Oracle().PendingTxns() {
+		open := OpenTransaction{StartTs: txn.StartTs, LastUpdate: txn.LastUpdate}
+		visible := false
+		for _, key := range txn.Keys {
+			pk, err := x.Parse([]byte(key))
+			if err != nil || len(pk.Attr) == 0 {
+				continue
+			}
+			if !visibleNamespace(caller, x.ParseNamespace(pk.Attr)) {
+				continue
+			}
+			visible = true
+			open.NumKeys++
+			if len(open.Keys) < maxOpenTxnKeys {
+				open.Keys = append(open.Keys, describeKey(pk, caller))
+			}
+		}
+		if visible {
+			res = append(res, open)
+		}
+	}
+	return res, nil
+}
+
+// describeKey returns a readable form of the key of a posting list. The namespace of the
+// predicate is only shown to the guardians of the galaxy.
+func describeKey(pk x.ParsedKey, caller uint64) string {
+	attr := x.ParseAttr(pk.Attr)
+	if caller == x.GalaxyNamespace {
+		attr = fmt.Sprintf("%#x-%s", x.ParseNamespace(pk.Attr), attr)
+	}
+	switch {
+	case pk.IsData():
+		return fmt.Sprintf("data <%s> %#x", attr, pk.Uid)
+	case pk.IsReverse():
+		return fmt.Sprintf("reverse <%s> %#x", attr, pk.Uid)
+	case pk.IsIndex():
+		return fmt.Sprintf("index <%s> %q", attr, pk.Term)
+	case pk.IsCountOrCountRev():
+		return fmt.Sprintf("count <%s> %d", attr, pk.Count)
+	default:
+		return fmt.Sprintf("<%s>", attr)
+	}
+}
+
+// Kill cancels the running request with the given id, and aborts its transaction unless it is
+// read-only. The description of the killed request is returned.
+func Kill(ctx context.Context, id uint64) (RunningRequest, error) {
+	caller, err := x.ExtractNamespace(ctx)
+	if err != nil {
+		return RunningRequest{}, errors.Wrapf(err, "While killing a request")
+	}
+
+	running.Lock()
+	r, ok := running.requests[id]
+	running.Unlock()
+	if !ok || !visibleNamespace(caller, r.info.Namespace) {
+		return RunningRequest{}, errors.Errorf("No running request with id %#x", id)
+	}
+
+	info := r.describe()
+	r.cancel()
+	glog.Infof("Killed request %#x at start ts %d: %s", id, info.StartTs, info.Text)
+	if info.StartTs == 0 || r.readOnly {
+		return info, nil
+	}
+	return info, abortTxn(ctx, info.StartTs)
+}
+
+// AbortTransaction aborts an open transaction in the group of this Alpha.
+func AbortTransaction(ctx context.Context, startTs uint64) error {
+	txns, err := OpenTransactions(ctx)
+	if err != nil {
+		return err
+	}
+	for _, txn := range txns {
+		if txn.StartTs == startTs {
+			glog.Infof("Aborting open transaction at start ts %d", startTs)
+			return abortTxn(ctx, startTs)
+		}
+	}
+	return errors.Errorf("No open transaction at start ts %d", startTs)
+}
+
+func abortTxn(ctx context.Context, startTs uint64) error {
+	// The requests of the transaction which are still running see it aborted when they apply
+	// their mutations or commit.
+	_, err := worker.CommitOverNetwork(ctx, &api.TxnContext{StartTs: startTs, Aborted: true})
+	if err == dgo.ErrAborted {
+		return nil
+	}
+	return errors.Wrapf(err, "While aborting the transaction at start ts %d", startTs)
+}
@@ -0,0 +1,87 @@
+/*
+ * Copyright 2022 Dgraph Labs, Inc. and Contributors
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package edgraph
+
+import (
+	"context"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/dgraph-io/dgo/v210/protos/api"
+	"github.com/dgraph-io/dgraph/x"
+)
+
+func TestRunningRequests(t *testing.T) {
+	galaxy := x.AttachNamespace(context.Background(), x.GalaxyNamespace)
+	tenant := x.AttachNamespace(context.Background(), 7)
+
+	ctx1, untrack1 := trackRequest(tenant, &api.Request{
+		Query:    `{ q(func: has(name)) { name } }`,
+		ReadOnly: true,
+	})
+	defer untrack1()
+	_, untrack2 := trackRequest(galaxy, &api.Request{
+		Mutations: []*api.Mutation{{SetNquads: []byte(`_:a <name> "A" .`)}},
+	})
+	setRequestStartTs(ctx1, 10)
+
+	// A namespace only sees its own requests, and the galaxy sees them all.
+	reqs, err := RunningRequests(tenant)
+	require.NoError(t, err)
+	require.Len(t, reqs, 1)
+	require.Equal(t, uint64(7), reqs[0].Namespace)
+	require.Equal(t, uint64(10), reqs[0].StartTs)
+	require.False(t, reqs[0].Mutation)
+
+	reqs, err = RunningRequests(galaxy)
+	require.NoError(t, err)
+	require.Len(t, reqs, 2)
+	require.Equal(t, `_:a <name> "A" .`, reqs[1].Text)
+	require.True(t, reqs[1].Mutation)
+
+	untrack2()
+	reqs, err = RunningRequests(galaxy)
+	require.NoError(t, err)
+	require.Len(t, reqs, 1)
+
+	// Killing a request cancels its context. The request is read-only, so no transaction is
+	// aborted.
+	_, err = Kill(x.AttachNamespace(context.Background(), 8), reqs[0].Id)
+	require.Error(t, err)
+	require.NoError(t, ctx1.Err())
+	killed, err := Kill(tenant, reqs[0].Id)
+	require.NoError(t, err)
+	require.Equal(t, reqs[0].Id, killed.Id)
+	require.Equal(t, context.Canceled, ctx1.Err())
+}
+
+func TestRequestText(t *testing.T) {
+	text := requestText(&api.Request{
+		Query: `query { v as var(func: eq(email, "a@b.c")) }`,
+		Mutations: []*api.Mutation{{
+			Cond:      `@if(eq(len(v), 0))`,
+			SetNquads: []byte(`_:a <email> "a@b.c" .`),
+		}},
+	})
+	require.Equal(t, `query { v as var(func: eq(email, "a@b.c")) }`+"\n"+
+		`_:a <email> "a@b.c" .`+"\n"+`@if(eq(len(v), 0))`, text)
+
+	text = requestText(&api.Request{Query: strings.Repeat("a", 2*maxRequestText)})
+	require.Len(t, text, maxRequestText+len("..."))
+}
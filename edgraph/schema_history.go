@@ -0,0 +1,376 @@
+/*
+ * Copyright 2022 Dgraph Labs, Inc. and Contributors
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package edgraph
+
+import (
+	"context"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/golang/glog"
+	"github.com/pkg/errors"
+
+	"github.com/dgraph-io/dgo/v210/protos/api"
+	"github.com/dgraph-io/dgraph/protos/pb"
+	"github.com/dgraph-io/dgraph/query"
+	"github.com/dgraph-io/dgraph/schema"
+	"github.com/dgraph-io/dgraph/types"
+	"github.com/dgraph-io/dgraph/worker"
+	"github.com/dgraph-io/dgraph/x"
+)
+
+// RecordGQLSchemaVersion records the given GraphQL schema as a new version in the schema history
+// of the namespace of the request.
+func RecordGQLSchemaVersion(ctx context.Context, gqlSchema string) error {
+	return recordSchemaVersion(ctx, true, gqlSchema)
+}
+
+// recordDQLSchemaVersion records the complete DQL schema of the namespace of the request as a new
+// version in its schema history. The predicates of the given alter are recorded as they were
+// altered, even if their indexes are still being built in the background.
+func recordDQLSchemaVersion(ctx context.Context, alter *schema.ParsedSchema) error {
+	namespace, err := x.ExtractNamespace(ctx)
+	if err != nil {
+		return errors.Wrapf(err, "While recording schema version")
+	}
+	dqlSchema, err := formatDQLSchema(ctx, namespace, alter)
+	if err != nil {
+		return errors.Wrapf(err, "While recording schema version")
+	}
+	return recordSchemaVersion(ctx, false, dqlSchema)
+}
+
+// recordSchemaVersion stores the schema in every group as a new version, numbered with the
+// timestamp at which it is recorded, along with the user who changed it.
+func recordSchemaVersion(ctx context.Context, graphql bool, text string) error {
+	namespace, err := x.ExtractNamespace(ctx)
+	if err != nil {
+		return errors.Wrapf(err, "While recording schema version")
+	}
+
+	startTs := worker.State.GetTimestamp(false)
+	m := &pb.Mutations{
+		StartTs: startTs,
+		SchemaVersions: []*pb.SchemaVersion{{
+			Namespace: namespace,
+			Version:   startTs,
+			Graphql:   graphql,
+			Schema:    text,
+			Author:    requestUser(ctx),
+			CreatedAt: time.Now().Unix(),
+		}},
+	}
+	if _, err := query.ApplyMutations(ctx, m); err != nil {
+		return errors.Wrapf(err, "While recording schema version")
+	}
+	glog.Infof("Recorded schema version %d in namespace %#x", startTs, namespace)
+	return nil
+}
+
+// formatDQLSchema returns the DQL schema of the namespace in the format accepted by alter, with
+// the given alter applied on top of it. The reserved predicates and types are left out.
+func formatDQLSchema(ctx context.Context, namespace uint64,
+	alter *schema.ParsedSchema) (string, error) {
+	nodes, err := worker.GetSchemaOverNetwork(ctx, &pb.SchemaRequest{})
+	if err != nil {
+		return "", err
+	}
+	preds := make(map[string]*pb.SchemaUpdate)
+	for _, node := range nodes {
+		preds[node.Predicate] = schemaNodeToUpdate(node)
+	}
+	typeList, err := worker.GetTypes(ctx, &pb.SchemaRequest{})
+	if err != nil {
+		return "", err
+	}
+	typeMap := make(map[string]*pb.TypeUpdate)
+	for _, typ := range typeList {
+		typeMap[typ.TypeName] = typ
+	}
+	if alter != nil {
+		for _, su := range alter.Preds {
+			preds[su.Predicate] = su
+		}
+		for _, typ := range alter.Types {
+			typeMap[typ.TypeName] = typ
+		}
+	}
+
+	var predNames, typeNames []string
+	for name := range preds {
+		if x.ParseNamespace(name) == namespace && !x.IsReservedPredicate(name) {
+			predNames = append(predNames, name)
+		}
+	}
+	for name := range typeMap {
+		if x.ParseNamespace(name) == namespace && !x.IsReservedType(name) {
+			typeNames = append(typeNames, name)
+		}
+	}
+	sort.Strings(predNames)
+	sort.Strings(typeNames)
+
+	var buf strings.Builder
+	for _, name := range predNames {
+		buf.WriteString(worker.FormatSchemaUpdate(name, preds[name]))
+	}
+	for _, name := range typeNames {
+		buf.WriteString("\n")
+		buf.WriteString(worker.FormatTypeUpdate(name, typeMap[name]))
+	}
+	return buf.String(), nil
+}
+
+func schemaNodeToUpdate(node *pb.SchemaNode) *pb.SchemaUpdate {
+	typ, _ := types.TypeForName(node.Type)
+	su := &pb.SchemaUpdate{
+		Predicate:  node.Predicate,
+		ValueType:  typ.Enum(),
+		Tokenizer:  node.Tokenizer,
+		Count:      node.Count,
+		List:       node.List,
+		Upsert:     node.Upsert,
+		Lang:       node.Lang,
+		NoConflict: node.NoConflict,
+		Ordered:    node.Ordered,
+	}
+	if node.Ttl != "" {
+		su.Ttl, _ = schema.ParseTTL(node.Ttl)
+	}
+	switch {
+	case node.Reverse:
+		su.Directive = pb.SchemaUpdate_REVERSE
+	case node.Index:
+		su.Directive = pb.SchemaUpdate_INDEX
+	}
+	return su
+}
+
+// GetSchemaHistory returns the recorded versions of the DQL and GraphQL schemas of the namespace
+// of the request, oldest first.
+func GetSchemaHistory(ctx context.Context) ([]*pb.SchemaVersion, error) {
+	namespace, err := x.ExtractNamespace(ctx)
+	if err != nil {
+		return nil, errors.Wrapf(err, "While reading schema history")
+	}
+	return worker.GetSchemaVersions(namespace)
+}
+
+// GetSchemaVersion returns the given version of the schema of the namespace of the request.
+func GetSchemaVersion(ctx context.Context, version uint64) (*pb.SchemaVersion, error) {
+	history, err := GetSchemaHistory(ctx)
+	if err != nil {
+		return nil, err
+	}
+	for _, v := range history {
+		if v.Version == version {
+			return v, nil
+		}
+	}
+	return nil, errors.Errorf("Schema version %d not found", version)
+}
+
+// DiffSchemaVersions returns a line by line diff from one version of the schema of the namespace
+// of the request to another. Both versions must be of the same schema, either DQL or GraphQL.
+func DiffSchemaVersions(ctx context.Context, from, to uint64) (string, error) {
+	fromVersion, err := GetSchemaVersion(ctx, from)
+	if err != nil {
+		return "", err
+	}
+	toVersion, err := GetSchemaVersion(ctx, to)
+	if err != nil {
+		return "", err
+	}
+	if fromVersion.Graphql != toVersion.Graphql {
+		return "", errors.Errorf("Schema versions %d and %d are not of the same schema", from, to)
+	}
+	return diffLines(fromVersion.Schema, toVersion.Schema), nil
+}
+
+// RollbackDQLSchema alters the DQL schema of the namespace of the request back to the given
+// version. As for any alter, the indexes of the predicates whose indexes differ are rebuilt. It
+// returns the names of the predicates and types which have no definition in the version, like
+// the ones added after it or created by mutations since. They are kept, unless drop is set, in
+// which case they are dropped, along with the data of the predicates. The rollback is recorded as
+// a new version.
+func RollbackDQLSchema(ctx context.Context, v *pb.SchemaVersion,
+	drop bool) ([]string, []string, error) {
+	if v.Graphql {
+		return nil, nil, errors.Errorf("Schema version %d is not of the DQL schema", v.Version)
+	}
+	if strings.TrimSpace(v.Schema) == "" {
+		return nil, nil, errors.Errorf("Schema version %d is empty and can't be rolled back to",
+			v.Version)
+	}
+	namespace, err := x.ExtractNamespace(ctx)
+	if err != nil {
+		return nil, nil, errors.Wrapf(err, "While rolling back schema")
+	}
+	parsed, err := schema.ParseWithNamespace(v.Schema, namespace)
+	if err != nil {
+		return nil, nil, errors.Wrapf(err, "While parsing schema version %d", v.Version)
+	}
+	nodes, err := worker.GetSchemaOverNetwork(ctx, &pb.SchemaRequest{})
+	if err != nil {
+		return nil, nil, errors.Wrapf(err, "While rolling back schema")
+	}
+	predNames := make([]string, 0, len(nodes))
+	for _, node := range nodes {
+		predNames = append(predNames, node.Predicate)
+	}
+	typeList, err := worker.GetTypes(ctx, &pb.SchemaRequest{})
+	if err != nil {
+		return nil, nil, errors.Wrapf(err, "While rolling back schema")
+	}
+	preds, typs := undefinedIn(namespace, predNames, typeList, parsed)
+
+	// The types are dropped first, so that the version recorded by the last alter is the same as
+	// the one rolled back to.
+	s := &Server{}
+	if drop {
+		if err := dropUndefined(ctx, s, preds, typs); err != nil {
+			return nil, nil, err
+		}
+	}
+	if _, err := s.Alter(ctx, &api.Operation{Schema: v.Schema}); err != nil {
+		return nil, nil, err
+	}
+
+	for i, name := range preds {
+		preds[i] = x.ParseAttr(name)
+	}
+	for i, name := range typs {
+		typs[i] = x.ParseAttr(name)
+	}
+	return preds, typs, nil
+}
+
+// dropUndefined drops the given types, and then the given predicates along with their data.
+func dropUndefined(ctx context.Context, s *Server, preds, typs []string) error {
+	for _, name := range typs {
+		op := &api.Operation{DropOp: api.Operation_TYPE, DropValue: x.ParseAttr(name)}
+		if _, err := s.Alter(ctx, op); err != nil {
+			return errors.Wrapf(err, "While dropping type %s", x.ParseAttr(name))
+		}
+	}
+	for _, name := range preds {
+		op := &api.Operation{DropOp: api.Operation_ATTR, DropValue: x.ParseAttr(name)}
+		if _, err := s.Alter(ctx, op); err != nil {
+			return errors.Wrapf(err, "While dropping predicate %s", x.ParseAttr(name))
+		}
+	}
+	return nil
+}
+
+// undefinedIn returns the predicates and types of the namespace which have no definition in the
+// given version of its schema, leaving out the reserved ones. The types are ordered so that the
+// types extending another type come before it, as a type can't be dropped while it is extended.
+func undefinedIn(namespace uint64, predNames []string, typeList []*pb.TypeUpdate,
+	version *schema.ParsedSchema) ([]string, []string) {
+	inVersion := make(map[string]struct{})
+	for _, su := range version.Preds {
+		inVersion[su.Predicate] = struct{}{}
+	}
+	for _, typ := range version.Types {
+		inVersion[typ.TypeName] = struct{}{}
+	}
+
+	var preds []string
+	for _, name := range predNames {
+		if _, ok := inVersion[name]; ok {
+			continue
+		}
+		if x.ParseNamespace(name) == namespace && !x.IsReservedPredicate(name) {
+			preds = append(preds, name)
+		}
+	}
+	sort.Strings(preds)
+
+	parents := make(map[string]string)
+	for _, typ := range typeList {
+		parents[typ.TypeName] = typ.Extends
+	}
+	// depth is the number of ancestors of the type.
+	depth := func(name string) int {
+		d := 0
+		for parent := parents[name]; parent != "" && d < len(parents); parent = parents[parent] {
+			d++
+		}
+		return d
+	}
+	var typs []string
+	for _, typ := range typeList {
+		if _, ok := inVersion[typ.TypeName]; ok {
+			continue
+		}
+		if x.ParseNamespace(typ.TypeName) == namespace && !x.IsReservedType(typ.TypeName) {
+			typs = append(typs, typ.TypeName)
+		}
+	}
+	sort.Slice(typs, func(i, j int) bool {
+		if di, dj := depth(typs[i]), depth(typs[j]); di != dj {
+			return di > dj
+		}
+		return typs[i] < typs[j]
+	})
+	return preds, typs
+}
+
+// diffLines returns the line by line diff of the two texts, based on their longest common
+// subsequence of lines. The removed lines are prefixed with "-", the added ones with "+" and
+// the unchanged ones with a space.
+func diffLines(from, to string) string {
+	a := strings.Split(strings.TrimSuffix(from, "\n"), "\n")
+	b := strings.Split(strings.TrimSuffix(to, "\n"), "\n")
+
+	// lcs[i][j] is the length of the longest common subsequence of a[i:] and b[j:].
+	lcs := make([][]int, len(a)+1)
+	for i := range lcs {
+		lcs[i] = make([]int, len(b)+1)
+	}
+	for i := len(a) - 1; i >= 0; i-- {
+		for j := len(b) - 1; j >= 0; j-- {
+			switch {
+			case a[i] == b[j]:
+				lcs[i][j] = lcs[i+1][j+1] + 1
+			case lcs[i+1][j] >= lcs[i][j+1]:
+				lcs[i][j] = lcs[i+1][j]
+			default:
+				lcs[i][j] = lcs[i][j+1]
+			}
+		}
+	}
+
+	var buf strings.Builder
+	i, j := 0, 0
+	for i < len(a) || j < len(b) {
+		switch {
+		case i < len(a) && j < len(b) && a[i] == b[j]:
+			buf.WriteString(" " + a[i] + "\n")
+			i++
+			j++
+		case i < len(a) && (j == len(b) || lcs[i+1][j] >= lcs[i][j+1]):
+			buf.WriteString("-" + a[i] + "\n")
+			i++
+		default:
+			buf.WriteString("+" + b[j] + "\n")
+			j++
+		}
+	}
+	return buf.String()
+}
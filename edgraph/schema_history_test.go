@@ -0,0 +1,83 @@
+/*
+ * Copyright 2022 Dgraph Labs, Inc. and Contributors
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package edgraph
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/dgraph-io/dgraph/protos/pb"
+	"github.com/dgraph-io/dgraph/schema"
+	"github.com/dgraph-io/dgraph/x"
+)
+
+func TestDiffLines(t *testing.T) {
+	tests := []struct {
+		from, to, diff string
+	}{
+		{
+			from: "name: string .\n",
+			to:   "name: string .\n",
+			diff: " name: string .\n",
+		},
+		{
+			from: "age: int .\nname: string .\n",
+			to:   "age: int @index(int) .\nname: string .\nnick: string .\n",
+			diff: "-age: int .\n+age: int @index(int) .\n name: string .\n+nick: string .\n",
+		},
+		{
+			from: "age: int .\nname: string .\n",
+			to:   "name: string .\n",
+			diff: "-age: int .\n name: string .\n",
+		},
+	}
+	for _, tc := range tests {
+		require.Equal(t, tc.diff, diffLines(tc.from, tc.to))
+	}
+}
+
+func TestUndefinedIn(t *testing.T) {
+	ns := uint64(0x2)
+	version, err := schema.ParseWithNamespace("name: string .\ntype Person {\n\tname\n}\n", ns)
+	require.NoError(t, err)
+
+	predNames := []string{
+		x.NamespaceAttr(ns, "name"),
+		x.NamespaceAttr(ns, "salary"),
+		x.NamespaceAttr(ns, "age"),
+		x.NamespaceAttr(ns, "dgraph.type"),
+		x.NamespaceAttr(x.GalaxyNamespace, "salary"),
+	}
+	typeList := []*pb.TypeUpdate{
+		{TypeName: x.NamespaceAttr(ns, "Person")},
+		{TypeName: x.NamespaceAttr(ns, "Manager"), Extends: x.NamespaceAttr(ns, "Employee")},
+		{TypeName: x.NamespaceAttr(ns, "Employee"), Extends: x.NamespaceAttr(ns, "Person")},
+		{TypeName: x.NamespaceAttr(ns, "Company")},
+		{TypeName: x.NamespaceAttr(ns, "dgraph.graphql")},
+		{TypeName: x.NamespaceAttr(x.GalaxyNamespace, "Company")},
+	}
+
+	preds, typs := undefinedIn(ns, predNames, typeList, version)
+	require.Equal(t, []string{x.NamespaceAttr(ns, "age"), x.NamespaceAttr(ns, "salary")}, preds)
+	// The types extending another type are dropped before it.
+	require.Equal(t, []string{
+		x.NamespaceAttr(ns, "Manager"),
+		x.NamespaceAttr(ns, "Employee"),
+		x.NamespaceAttr(ns, "Company"),
+	}, typs)
+}
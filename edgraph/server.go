@@ -213,12 +213,22 @@ func UpdateGQLSchema(ctx context.Context, gqlSchema,
 		}
 	}
 
-	return worker.UpdateGQLSchemaOverNetwork(ctx, &pb.UpdateGraphQLSchemaRequest{
+	resp, err := worker.UpdateGQLSchemaOverNetwork(ctx, &pb.UpdateGraphQLSchemaRequest{
 		StartTs:       worker.State.GetTimestamp(false),
 		GraphqlSchema: gqlSchema,
 		DgraphPreds:   parsedDgraphSchema.Preds,
 		DgraphTypes:   parsedDgraphSchema.Types,
 	})
+	if err != nil {
+		return nil, err
+	}
+	// The DQL schema generated from the GraphQL schema changes the DQL schema too.
+	if dgraphSchema != "" {
+		if err := recordDQLSchemaVersion(ctx, parsedDgraphSchema); err != nil {
+			return nil, err
+		}
+	}
+	return resp, nil
 }
 
 // validateAlterOperation validates the given operation for alter.
@@ -502,8 +512,10 @@ func (s *Server) Alter(ctx context.Context, op *api.Operation) (*api.Payload, er
 		}
 
 		// insert a helper record for backup & restore, indicating that drop_attr was done
-		err = InsertDropRecord(ctx, "DROP_ATTR;"+attr)
-		return empty, err
+		if err = InsertDropRecord(ctx, "DROP_ATTR;"+attr); err != nil {
+			return empty, err
+		}
+		return empty, recordDQLSchemaVersion(ctx, nil)
 	}
 
 	if op.DropOp == api.Operation_TYPE {
@@ -517,11 +529,18 @@ func (s *Server) Alter(ctx context.Context, op *api.Operation) (*api.Payload, er
 			return empty, errors.Errorf("type %s is pre-defined and is not allowed to be dropped",
 				op.DropValue)
 		}
+		// Types extended by other types cannot be dropped either, as their fields are inherited.
+		if subTypes := schema.State().SubTypes(dropPred); len(subTypes) > 0 {
+			return empty, errors.Errorf("type %s is extended by type %s and can't be dropped",
+				op.DropValue, x.ParseAttr(subTypes[0]))
+		}
 
 		m.DropOp = pb.Mutations_TYPE
 		m.DropValue = dropPred
-		_, err := query.ApplyMutations(ctx, m)
-		return empty, err
+		if _, err := query.ApplyMutations(ctx, m); err != nil {
+			return empty, err
+		}
+		return empty, recordDQLSchemaVersion(ctx, nil)
 	}
 	result, err := parseSchemaFromAlterOperation(ctx, op)
 	if err == errIndexingInProgress {
@@ -540,13 +559,45 @@ func (s *Server) Alter(ctx context.Context, op *api.Operation) (*api.Payload, er
 	if err != nil {
 		return empty, err
 	}
+	if err := recordDQLSchemaVersion(ctx, result); err != nil {
+		return empty, err
+	}
+	payload := indexTasksPayload(ctx, result.Preds, m.StartTs)
 
 	// wait for indexing to complete or context to be canceled.
 	if err = worker.WaitForIndexing(ctx, !op.RunInBackground); err != nil {
-		return empty, err
+		return payload, err
 	}
 
-	return empty, nil
+	return payload, nil
+}
+
+// indexTasksPayload returns the payload of an alter operation, which holds the IDs of the tasks
+// rebuilding the indexes of the updated predicates, if any. Their progress can be followed, and
+// they can be cancelled, through the admin task API.
+func indexTasksPayload(ctx context.Context, preds []*pb.SchemaUpdate, ts uint64) *api.Payload {
+	var attrs []string
+	for _, su := range preds {
+		// Only the predicates which have an index after the update may need to rebuild it.
+		if len(su.Tokenizer) > 0 || su.Directive == pb.SchemaUpdate_REVERSE || su.Count {
+			attrs = append(attrs, su.Predicate)
+		}
+	}
+	ids := worker.IndexTaskIds(ctx, attrs, ts)
+	if len(ids) == 0 {
+		return &api.Payload{}
+	}
+
+	tasks := make(map[string]string, len(ids))
+	for attr, id := range ids {
+		tasks[x.ParseAttr(attr)] = fmt.Sprintf("%#x", id)
+	}
+	data, err := json.Marshal(map[string]interface{}{"indexTasks": tasks})
+	if err != nil {
+		glog.Errorf("Unable to marshal the index tasks %v: %v", tasks, err)
+		return &api.Payload{}
+	}
+	return &api.Payload{Data: data}
 }
 
 func annotateNamespace(span *otrace.Span, ns uint64) {
@@ -607,16 +658,44 @@ func (s *Server) doMutate(ctx context.Context, qc *queryContext, resp *api.Respo
 			predHints[pred] = hint
 		}
 	}
+	// The mutation can be made for a version of its nodes, failing if any was modified since.
+	ifVersion, err := x.ExtractIfVersion(ctx)
+	if err != nil {
+		return err
+	}
 	m := &pb.Mutations{
 		Edges:   edges,
 		StartTs: qc.req.StartTs,
 		Metadata: &pb.Metadata{
 			PredHints: predHints,
 		},
+		IfVersion: ifVersion,
+	}
+
+	// The triggers called before the commit are given the mutation before it is applied, so that
+	// a veto leaves nothing to undo.
+	events, err := triggerEvents(ctx, qc.req.StartTs, edges, resp.Uids)
+	if err != nil {
+		return err
+	}
+	if err := fireBeforeCommit(ctx, events); err != nil {
+		return err
+	}
+	if !x.WorkerConfig.LudicrousEnabled {
+		// The triggers called after the commit are recorded by the transaction, and delivered
+		// once it commits.
+		if m.TriggerEvents, err = afterCommitEvents(events); err != nil {
+			return err
+		}
 	}
 
 	qc.span.Annotatef(nil, "Applying mutations: %+v", m)
+	// The keys read by the query of a serializable upsert are committed with the mutations.
+	readKeys := resp.Txn.GetKeys()
 	resp.Txn, err = query.ApplyMutations(ctx, m)
+	if resp.Txn != nil {
+		resp.Txn.Keys = x.Unique(append(resp.Txn.Keys, readKeys...))
+	}
 	qc.span.Annotatef(nil, "Txn Context: %+v. Err=%v", resp.Txn, err)
 
 	if x.WorkerConfig.LudicrousEnabled {
@@ -627,6 +706,9 @@ func (s *Server) doMutate(ctx context.Context, qc *queryContext, resp *api.Respo
 		}
 		resp.Txn.Keys = resp.Txn.Keys[:0]
 		resp.Txn.CommitTs = qc.req.StartTs
+		if err == nil {
+			fireAfterCommit(events, qc.req.StartTs)
+		}
 		return err
 	}
 	// calculateMutationMetrics calculate cost for the mutation.
@@ -686,7 +768,7 @@ func (s *Server) doMutate(ctx context.Context, qc *queryContext, resp *api.Respo
 }
 
 // buildUpsertQuery modifies the query to evaluate the
-// @if condition defined in Conditional Upsert.
+// @if and @elseif conditions defined in Conditional Upsert.
 func buildUpsertQuery(qc *queryContext) string {
 	if qc.req.Query == "" || len(qc.gmuList) == 0 {
 		return qc.req.Query
@@ -695,12 +777,12 @@ func buildUpsertQuery(qc *queryContext) string {
 	qc.condVars = make([]string, len(qc.req.Mutations))
 	upsertQuery := strings.TrimSuffix(qc.req.Query, "}")
 	for i, gmu := range qc.gmuList {
-		isCondUpsert := strings.TrimSpace(gmu.Cond) != ""
+		isCondUpsert := gmu.Condition.Filter != ""
 		if isCondUpsert {
 			qc.condVars[i] = "__dgraph__" + strconv.Itoa(i)
 			qc.uidRes[qc.condVars[i]] = nil
-			// @if in upsert is same as @filter in the query
-			cond := strings.Replace(gmu.Cond, "@if", "@filter", 1)
+			// @if and @elseif in upsert are same as @filter in the query
+			cond := "@filter" + gmu.Condition.Filter
 
 			// Add dummy query to evaluate the @if directive, ok to use uid(0) because
 			// dgraph doesn't check for existence of UIDs until we query for other predicates.
@@ -730,16 +812,32 @@ func buildUpsertQuery(qc *queryContext) string {
 // updateMutations updates the mutation and replaces uid(var) and val(var) with
 // their values or a blank node, in case of an upsert.
 // We use the values stored in qc.uidRes and qc.valRes to update the mutation.
+// Of a chain of @if, @elseif and @else branches, only the first branch whose
+// condition is true runs. A branch with @abort fails the request instead.
 func updateMutations(qc *queryContext) error {
+	// taken tells whether a branch of the current chain has run.
+	taken := false
 	for i, condVar := range qc.condVars {
 		gmu := qc.gmuList[i]
-		if condVar != "" {
-			uids, ok := qc.uidRes[condVar]
-			if !(ok && len(uids) == 1) {
-				gmu.Set = nil
-				gmu.Del = nil
-				continue
-			}
+		run := true
+		switch gmu.Condition.Branch {
+		case dql.BranchIf:
+			run = isCondTrue(qc, condVar)
+			taken = run
+		case dql.BranchElseIf:
+			run = !taken && isCondTrue(qc, condVar)
+			taken = taken || run
+		case dql.BranchElse:
+			run = !taken
+			taken = true
+		}
+		if !run {
+			gmu.Set = nil
+			gmu.Del = nil
+			continue
+		}
+		if gmu.Condition.Abort != "" {
+			return status.Error(codes.FailedPrecondition, gmu.Condition.Abort)
 		}
 
 		if err := updateUIDInMutations(gmu, qc); err != nil {
@@ -753,6 +851,12 @@ func updateMutations(qc *queryContext) error {
 	return nil
 }
 
+// isCondTrue returns whether the condition evaluated by the given conditional variable is true.
+func isCondTrue(qc *queryContext, condVar string) bool {
+	uids, ok := qc.uidRes[condVar]
+	return ok && len(uids) == 1
+}
+
 // findMutationVars finds all the variables used in mutation block and stores them
 // qc.uidRes and qc.valRes so that we only look for these variables in query results.
 func findMutationVars(qc *queryContext) []string {
@@ -1149,6 +1253,11 @@ func (s *Server) QueryGraphQL(ctx context.Context, req *api.Request,
 // Query handles queries or mutations
 func (s *Server) Query(ctx context.Context, req *api.Request) (*api.Response, error) {
 	ctx = x.AttachJWTNamespace(ctx)
+	if name := x.ExtractStoredQuery(ctx); name != "" {
+		if err := resolveStoredQuery(ctx, name, req); err != nil {
+			return nil, err
+		}
+	}
 	if x.WorkerConfig.AclEnabled && req.GetStartTs() != 0 {
 		// A fresh StartTs is assigned if it is 0.
 		ns, err := x.ExtractNamespace(ctx)
@@ -1169,7 +1278,25 @@ func (s *Server) Query(ctx context.Context, req *api.Request) (*api.Response, er
 			defer cancel()
 		}
 	}
-	return s.doQuery(ctx, &Request{req: req, doAuth: getAuthMode(ctx)})
+
+	key, err := idempotencyKey(ctx, "mutate")
+	if err != nil {
+		return nil, err
+	}
+	if key == "" || len(req.GetMutations()) == 0 {
+		return s.doQuery(ctx, &Request{req: req, doAuth: getAuthMode(ctx)})
+	}
+	fingerprint, err := fingerprintMessage(req)
+	if err != nil {
+		return nil, err
+	}
+	resp, err := idempotentResponses.do(ctx, key, fingerprint, func() (interface{}, error) {
+		return s.doQuery(ctx, &Request{req: req, doAuth: getAuthMode(ctx)})
+	})
+	if err != nil {
+		return nil, err
+	}
+	return resp.(*api.Response), nil
 }
 
 var pendingQueries int64
@@ -1178,6 +1305,8 @@ var serverOverloadErr = errors.New("429 Too Many Requests. Please throttle your
 
 func Init() {
 	maxPendingQueries = x.Config.Limit.GetInt64("max-pending-queries")
+	worker.RunDeleteByQuery = deleteByQuery
+	worker.CallTrigger = deliverTriggerEvent
 }
 
 func (s *Server) doQuery(ctx context.Context, req *Request) (resp *api.Response, rerr error) {
@@ -1188,6 +1317,9 @@ func (s *Server) doQuery(ctx context.Context, req *Request) (resp *api.Response,
 	if val := atomic.AddInt64(&pendingQueries, 1); val > maxPendingQueries {
 		return nil, serverOverloadErr
 	}
+	// The request can be listed and killed while it runs.
+	ctx, untrack := trackRequest(ctx, req.req)
+	defer untrack()
 
 	isGraphQL, _ := ctx.Value(IsGraphql).(bool)
 	if isGraphQL {
@@ -1197,6 +1329,9 @@ func (s *Server) doQuery(ctx context.Context, req *Request) (resp *api.Response,
 	}
 	l := &query.Latency{}
 	l.Start = time.Now()
+	defer func() {
+		logSlowQuery(ctx, req.req, l, resp, rerr)
+	}()
 
 	if bool(glog.V(3)) || worker.LogDQLRequestEnabled() {
 		glog.Infof("Got a query, DQL form: %+v at %+v", req.req, l.Start.Format(time.RFC3339))
@@ -1289,6 +1424,7 @@ func (s *Server) doQuery(ctx context.Context, req *Request) (resp *api.Response,
 			req.req.StartTs = worker.State.GetTimestamp(false)
 			qc.latency.AssignTimestamp = time.Since(start)
 		}
+		setRequestStartTs(ctx, req.req.StartTs)
 	}
 	if x.WorkerConfig.AclEnabled {
 		ns, err := x.ExtractNamespace(ctx)
@@ -1322,22 +1458,29 @@ func (s *Server) doQuery(ctx context.Context, req *Request) (resp *api.Response,
 		return
 	}
 
+	worker.HideVersionPreds(resp.Txn)
+
 	// TODO(Ahsan): resp.Txn.Preds contain predicates of form gid-namespace|attr.
 	// Remove the namespace from the response.
 	// resp.Txn.Preds = x.ParseAttrList(resp.Txn.Preds)
 
 	// TODO(martinmr): Include Transport as part of the latency. Need to do
 	// this separately since it involves modifying the API protos.
-	resp.Latency = &api.Latency{
+	resp.Latency = toApiLatency(l)
+	md := metadata.Pairs(x.DgraphCostHeader, fmt.Sprint(resp.Metrics.NumUids["_total"]))
+	grpc.SendHeader(ctx, md)
+	return resp, gqlErrs
+}
+
+// toApiLatency returns the latency breakdown of a request, with its total time so far.
+func toApiLatency(l *query.Latency) *api.Latency {
+	return &api.Latency{
 		AssignTimestampNs: uint64(l.AssignTimestamp.Nanoseconds()),
 		ParsingNs:         uint64(l.Parsing.Nanoseconds()),
 		ProcessingNs:      uint64(l.Processing.Nanoseconds()),
 		EncodingNs:        uint64(l.Json.Nanoseconds()),
 		TotalNs:           uint64((time.Since(l.Start)).Nanoseconds()),
 	}
-	md := metadata.Pairs(x.DgraphCostHeader, fmt.Sprint(resp.Metrics.NumUids["_total"]))
-	grpc.SendHeader(ctx, md)
-	return resp, gqlErrs
 }
 
 func processQuery(ctx context.Context, qc *queryContext) (*api.Response, error) {
@@ -1391,9 +1534,32 @@ func processQuery(ctx context.Context, qc *queryContext) (*api.Response, error)
 
 	qr.ReadTs = qc.req.StartTs
 	resp.Txn = &api.TxnContext{StartTs: qc.req.StartTs}
+	setRequestStartTs(ctx, qc.req.StartTs)
+
+	// The queries of a serializable transaction return the conflict keys they read, which the
+	// client sends back on commit along with the keys it wrote.
+	serializable, err := x.ExtractSerializable(ctx)
+	if err != nil {
+		return resp, err
+	}
+	var reads *worker.ReadKeys
+	if serializable {
+		if !x.WorkerConfig.SerializableEnabled {
+			return resp, errors.Errorf("Serializable transactions aren't enabled. Set " +
+				"--serializable \"enabled=true;\" on all the Alphas to use them.")
+		}
+		if qc.req.ReadOnly {
+			return resp, errors.Errorf("A read-only query can't be part of a serializable " +
+				"transaction.")
+		}
+		ctx, reads = worker.WithReadKeys(ctx)
+	}
 
 	// Core processing happens here.
 	er, err := qr.Process(ctx)
+	if reads != nil {
+		resp.Txn.Keys = reads.Keys()
+	}
 
 	if bool(glog.V(3)) || worker.LogDQLRequestEnabled() {
 		glog.Infof("Finished a query that started at: %+v",
@@ -1504,6 +1670,7 @@ func parseRequest(qc *queryContext) error {
 	if len(qc.req.Mutations) > 0 {
 		// parsing mutations
 		qc.gmuList = make([]*dql.Mutation, 0, len(qc.req.Mutations))
+		conds := make([]dql.Condition, 0, len(qc.req.Mutations))
 		for _, mu := range qc.req.Mutations {
 			gmu, err := parseMutationObject(mu, qc)
 			if err != nil {
@@ -1511,6 +1678,10 @@ func parseRequest(qc *queryContext) error {
 			}
 
 			qc.gmuList = append(qc.gmuList, gmu)
+			conds = append(conds, gmu.Condition)
+		}
+		if err := dql.CheckBranches(conds); err != nil {
+			return err
 		}
 
 		qc.uidRes = make(map[string][]string)
@@ -1521,6 +1692,12 @@ func parseRequest(qc *queryContext) error {
 			if len(needVars) > 0 {
 				return errors.Errorf("variables %v not defined", needVars)
 			}
+			for _, cond := range conds {
+				if cond.Abort != "" || cond.Branch == dql.BranchElseIf ||
+					cond.Branch == dql.BranchElse {
+					return errors.Errorf("@elseif, @else and @abort are only allowed in an upsert")
+				}
+			}
 
 			return nil
 		}
@@ -1583,7 +1760,6 @@ func (s *Server) CommitOrAbort(ctx context.Context, tc *api.TxnContext) (*api.Tx
 		return &api.TxnContext{}, err
 	}
 
-	tctx := &api.TxnContext{}
 	if tc.StartTs == 0 {
 		return &api.TxnContext{}, errors.Errorf(
 			"StartTs cannot be zero while committing a transaction")
@@ -1598,6 +1774,28 @@ func (s *Server) CommitOrAbort(ctx context.Context, tc *api.TxnContext) (*api.Tx
 	}
 
 	span.Annotatef(nil, "Txn Context received: %+v", tc)
+	key, err := idempotencyKey(ctx, "commit")
+	if err != nil {
+		return &api.TxnContext{}, err
+	}
+	if key == "" {
+		return commitOrAbort(ctx, tc)
+	}
+	fingerprint, err := fingerprintMessage(tc)
+	if err != nil {
+		return &api.TxnContext{}, err
+	}
+	resp, err := idempotentResponses.do(ctx, key, fingerprint, func() (interface{}, error) {
+		return commitOrAbort(ctx, tc)
+	})
+	if tctx, ok := resp.(*api.TxnContext); ok && tctx != nil {
+		return tctx, err
+	}
+	return &api.TxnContext{}, err
+}
+
+func commitOrAbort(ctx context.Context, tc *api.TxnContext) (*api.TxnContext, error) {
+	tctx := &api.TxnContext{}
 	commitTs, err := worker.CommitOverNetwork(ctx, tc)
 	if err == dgo.ErrAborted {
 		// If err returned is dgo.ErrAborted and tc.Aborted was set, that means the client has
@@ -1677,7 +1875,11 @@ func hasPoormansAuth(ctx context.Context) error {
 // dql.Mutation.Set field. Similarly the 3 fields api.Mutation#DeleteJson, api.Mutation#DelNquads
 // and api.Mutation#Del are merged into the dql.Mutation#Del field.
 func parseMutationObject(mu *api.Mutation, qc *queryContext) (*dql.Mutation, error) {
-	res := &dql.Mutation{Cond: mu.Cond}
+	cond, err := dql.ParseCond(mu.Cond)
+	if err != nil {
+		return nil, err
+	}
+	res := &dql.Mutation{Cond: mu.Cond, Condition: cond}
 
 	if len(mu.SetJson) > 0 {
 		nqs, md, err := chunker.ParseJSON(mu.SetJson, chunker.SetNquads)
@@ -1855,11 +2057,23 @@ func formatTypes(typeList []*pb.TypeUpdate) []map[string]interface{} {
 	for _, typ := range typeList {
 		typeMap := make(map[string]interface{})
 		typeMap["name"] = typ.TypeName
-		fields := make([]map[string]string, len(typ.Fields))
+		if typ.Extends != "" {
+			typeMap["extends"] = typ.Extends
+		}
+		if typ.Strict {
+			typeMap["strict"] = true
+		}
+		if typ.Ttl > 0 {
+			typeMap["ttl"] = schema.FormatTTL(typ.Ttl)
+		}
+		fields := make([]map[string]interface{}, len(typ.Fields))
 
 		for i, field := range typ.Fields {
-			m := make(map[string]string, 1)
+			m := make(map[string]interface{}, 1)
 			m["name"] = field.Predicate
+			if field.NonNullable {
+				m["required"] = true
+			}
 			fields[i] = m
 		}
 		typeMap["fields"] = fields
@@ -0,0 +1,132 @@
+/*
+ * Copyright 2022 Dgraph Labs, Inc. and Contributors
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package edgraph
+
+import (
+	"context"
+	"fmt"
+	"sync"
+
+	"github.com/golang/glog"
+
+	"github.com/dgraph-io/dgo/v210/protos/api"
+	"github.com/dgraph-io/dgraph/query"
+	"github.com/dgraph-io/dgraph/worker"
+	"github.com/dgraph-io/dgraph/x"
+)
+
+// The queries and mutations which take longer than the latency threshold, or traverse more edges
+// than the edge threshold, are logged into a rotating slow query log. The values of the variables
+// are redacted, and so are the mutations, of which only the number is logged.
+
+const (
+	slowQueryFilenameF = "alpha_slow_query_%d_%d.log"
+	redactedValue      = "<redacted>"
+)
+
+var slowQueries struct {
+	sync.RWMutex
+	log *x.Logger
+}
+
+// InitSlowQueryLog starts the slow query log with the given configuration, if any.
+func InitSlowQueryLog(conf *x.LoggerConf) error {
+	if conf == nil {
+		return nil
+	}
+	log, err := x.InitLogger(conf,
+		fmt.Sprintf(slowQueryFilenameF, worker.GroupId(), worker.NodeId()))
+	if err != nil {
+		return err
+	}
+	slowQueries.Lock()
+	slowQueries.log = log
+	slowQueries.Unlock()
+	glog.Infof("Slow query log is enabled, with a latency threshold of %v and an edge "+
+		"threshold of %d", worker.Config.SlowQueryLatency, worker.Config.SlowQueryEdges)
+	return nil
+}
+
+// CloseSlowQueryLog flushes and closes the slow query log.
+func CloseSlowQueryLog() {
+	slowQueries.Lock()
+	defer slowQueries.Unlock()
+	slowQueries.log.Sync()
+	slowQueries.log = nil
+}
+
+// isSlowQuery returns whether a request with the given latency and number of edges exceeds one of
+// the thresholds.
+func isSlowQuery(latency *api.Latency, edges uint64) bool {
+	if t := worker.Config.SlowQueryLatency; t > 0 && latency.TotalNs >= uint64(t.Nanoseconds()) {
+		return true
+	}
+	if t := worker.Config.SlowQueryEdges; t > 0 && edges >= t {
+		return true
+	}
+	return false
+}
+
+// logSlowQuery logs the request if it exceeds one of the thresholds. The response is nil if the
+// request failed before one was made.
+func logSlowQuery(ctx context.Context, req *api.Request, l *query.Latency, resp *api.Response,
+	rerr error) {
+	slowQueries.RLock()
+	defer slowQueries.RUnlock()
+	if slowQueries.log == nil {
+		return
+	}
+
+	latency := toApiLatency(l)
+	edges := resp.GetMetrics().GetNumUids()["_total"]
+	if !isSlowQuery(latency, edges) {
+		return
+	}
+
+	kind := "query"
+	if len(req.Mutations) > 0 {
+		kind = "mutation"
+	}
+	ns, _ := x.ExtractNamespace(ctx)
+	args := []interface{}{
+		"namespace", ns,
+		"user", requestUser(ctx),
+		"query", req.Query,
+		"variables", redactVars(req.Vars),
+		"mutations", len(req.Mutations),
+		"startTs", req.StartTs,
+		"latency", latency,
+		"edges", edges,
+		"resultBytes", len(resp.GetJson()) + len(resp.GetRdf()),
+	}
+	if rerr != nil {
+		args = append(args, "error", rerr.Error())
+	}
+	slowQueries.log.AuditI(kind, args...)
+}
+
+// redactVars returns the variables of a request with their values redacted.
+func redactVars(vars map[string]string) map[string]string {
+	if len(vars) == 0 {
+		return nil
+	}
+	redacted := make(map[string]string, len(vars))
+	for name := range vars {
+		redacted[name] = redactedValue
+	}
+	return redacted
+}
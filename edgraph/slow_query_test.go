@@ -0,0 +1,87 @@
+/*
+ * Copyright 2022 Dgraph Labs, Inc. and Contributors
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package edgraph
+
+import (
+	"context"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/dgraph-io/dgo/v210/protos/api"
+	"github.com/dgraph-io/dgraph/query"
+	"github.com/dgraph-io/dgraph/worker"
+	"github.com/dgraph-io/dgraph/x"
+)
+
+func TestIsSlowQuery(t *testing.T) {
+	config := worker.Config
+	defer func() { worker.Config = config }()
+
+	worker.Config.SlowQueryLatency = time.Second
+	worker.Config.SlowQueryEdges = 0
+	require.False(t, isSlowQuery(&api.Latency{TotalNs: uint64(time.Millisecond)}, 1e9))
+	require.True(t, isSlowQuery(&api.Latency{TotalNs: uint64(2 * time.Second)}, 0))
+
+	worker.Config.SlowQueryLatency = 0
+	worker.Config.SlowQueryEdges = 1000
+	require.False(t, isSlowQuery(&api.Latency{TotalNs: uint64(time.Hour)}, 999))
+	require.True(t, isSlowQuery(&api.Latency{}, 1000))
+}
+
+func TestLogSlowQuery(t *testing.T) {
+	config := worker.Config
+	defer func() { worker.Config = config }()
+	worker.Config.SlowQueryLatency = 0
+	worker.Config.SlowQueryEdges = 10
+
+	dir, err := ioutil.TempDir("", "slow_query")
+	require.NoError(t, err)
+	defer os.RemoveAll(dir)
+	log, err := x.InitLogger(&x.LoggerConf{Output: dir, Size: 1, Days: 1, MessageKey: "kind"},
+		"slow_query.log")
+	require.NoError(t, err)
+	slowQueries.log = log
+
+	ctx := x.AttachNamespace(context.Background(), 7)
+	req := &api.Request{
+		Query: `query q($email: string) { q(func: eq(email, $email)) { uid } }`,
+		Vars:  map[string]string{"$email": "alice@example.com"},
+	}
+	l := &query.Latency{Start: time.Now()}
+	fast := &api.Response{Metrics: &api.Metrics{NumUids: map[string]uint64{"_total": 9}}}
+	slow := &api.Response{
+		Json:    []byte(`{"q":[]}`),
+		Metrics: &api.Metrics{NumUids: map[string]uint64{"_total": 10}},
+	}
+	logSlowQuery(ctx, req, l, fast, nil)
+	logSlowQuery(ctx, req, l, slow, nil)
+	CloseSlowQueryLog()
+
+	data, err := ioutil.ReadFile(filepath.Join(dir, "slow_query.log"))
+	require.NoError(t, err)
+	require.Contains(t, string(data), `"kind":"query"`)
+	require.Contains(t, string(data), `"edges":10`)
+	require.NotContains(t, string(data), `"edges":9`)
+	require.Contains(t, string(data), `"$email":"<redacted>"`)
+	require.NotContains(t, string(data), "alice@example.com")
+	require.Contains(t, string(data), `"resultBytes":8`)
+}
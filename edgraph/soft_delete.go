@@ -0,0 +1,215 @@
+/*
+ * Copyright 2022 Dgraph Labs, Inc. and Contributors
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package edgraph
+
+import (
+	"context"
+	"sort"
+
+	"github.com/golang/glog"
+	"github.com/pkg/errors"
+
+	"github.com/dgraph-io/dgo/v210/protos/api"
+	"github.com/dgraph-io/dgraph/protos/pb"
+	"github.com/dgraph-io/dgraph/query"
+	"github.com/dgraph-io/dgraph/worker"
+	"github.com/dgraph-io/dgraph/x"
+)
+
+var errSoftDeleteDisabled = errors.New("Soft delete is not enabled. Start the alphas with " +
+	"--soft-delete \"enabled=true;\" to record the deletions.")
+
+// GetDeletedNodes returns the tombstones of the nodes deleted in the namespace of the request
+// which can still be brought back, sorted by uid and then by the time of the deletion.
+func GetDeletedNodes(ctx context.Context) ([]*pb.Tombstone, error) {
+	if x.WorkerConfig.SoftDeleteRetention == 0 {
+		return nil, errSoftDeleteDisabled
+	}
+	namespace, err := x.ExtractNamespace(ctx)
+	if err != nil {
+		return nil, errors.Wrapf(err, "While reading the deleted nodes")
+	}
+	return worker.GetTombstones(namespace)
+}
+
+// UndeleteNode brings back the predicates of a node deleted with `delete { <uid> * * . }` as
+// they were at the given timestamp, undoing all the deletions of the node after it. If the
+// timestamp is zero, the latest deletion of the node is undone. The timestamp at which the
+// predicates were read is returned.
+func UndeleteNode(ctx context.Context, uid, before uint64) (uint64, error) {
+	tombstones, err := GetDeletedNodes(ctx)
+	if err != nil {
+		return 0, err
+	}
+	var undone []*pb.Tombstone
+	for _, t := range tombstones {
+		if t.Uid == uid {
+			undone = append(undone, t)
+		}
+	}
+	if len(undone) == 0 {
+		return 0, errors.Errorf("Node %#x has no deletion that can be undone", uid)
+	}
+
+	readTs := before
+	if readTs == 0 {
+		readTs = undone[len(undone)-1].DeletedAt - 1
+	}
+	floor, err := worker.SoftDeleteFloor()
+	if err != nil {
+		return 0, errors.Wrapf(err, "While undeleting node %#x", uid)
+	}
+	if readTs < floor {
+		return 0, errors.Errorf("Timestamp %d is older than the retention window of soft delete,"+
+			" which starts at %d", readTs, floor)
+	}
+
+	preds := make(map[string]struct{})
+	var n int
+	for _, t := range undone {
+		if t.DeletedAt <= readTs {
+			continue
+		}
+		for _, pred := range t.Predicates {
+			preds[pred] = struct{}{}
+		}
+		undone[n] = t
+		n++
+	}
+	undone = undone[:n]
+	if len(undone) == 0 {
+		return 0, errors.Errorf("Node %#x wasn't deleted after timestamp %d", uid, readTs)
+	}
+
+	edges, err := nodeEdgesAt(ctx, undone[0].Namespace, uid, preds, readTs)
+	if err != nil {
+		return 0, errors.Wrapf(err, "While undeleting node %#x", uid)
+	}
+	if len(edges) > 0 {
+		m := &pb.Mutations{StartTs: worker.State.GetTimestamp(false), Edges: edges}
+		tctx, err := query.ApplyMutations(ctx, m)
+		if err != nil {
+			if tctx == nil {
+				tctx = &api.TxnContext{StartTs: m.StartTs}
+			}
+			tctx.Aborted = true
+			_, _ = worker.CommitOverNetwork(ctx, tctx)
+			return 0, errors.Wrapf(err, "While undeleting node %#x", uid)
+		}
+		if _, err := worker.CommitOverNetwork(ctx, tctx); err != nil {
+			return 0, errors.Wrapf(err, "While undeleting node %#x", uid)
+		}
+	}
+
+	if err := worker.RemoveTombstones(ctx, undone); err != nil {
+		return 0, errors.Wrapf(err, "While removing the tombstones of node %#x", uid)
+	}
+	glog.Infof("Undeleted node %#x as of timestamp %d", uid, readTs)
+	return readTs, nil
+}
+
+// nodeEdgesAt returns the edges setting the given predicates of the node to their values at the
+// given timestamp, along with their language tags and facets.
+func nodeEdgesAt(ctx context.Context, namespace, uid uint64, preds map[string]struct{},
+	readTs uint64) ([]*pb.DirectedEdge, error) {
+	attrs := make([]string, 0, len(preds))
+	for pred := range preds {
+		attrs = append(attrs, pred)
+	}
+	sort.Strings(attrs)
+
+	var edges []*pb.DirectedEdge
+	for _, attr := range attrs {
+		res, err := worker.ProcessTaskOverNetwork(ctx, &pb.Query{
+			Attr:       x.NamespaceAttr(namespace, attr),
+			UidList:    &pb.List{Uids: []uint64{uid}},
+			ReadTs:     readTs,
+			ExpandAll:  true,
+			FacetParam: &pb.FacetParams{AllKeys: true},
+		})
+		if err != nil {
+			return nil, errors.Wrapf(err, "cannot read the predicate %s", attr)
+		}
+		var fcs []*pb.Facets
+		if len(res.FacetMatrix) > 0 {
+			fcs = res.FacetMatrix[0].FacetsList
+		}
+		newEdge := func(i int) *pb.DirectedEdge {
+			edge := &pb.DirectedEdge{
+				Entity:    uid,
+				Attr:      attr,
+				Namespace: namespace,
+				Op:        pb.DirectedEdge_SET,
+			}
+			if i < len(fcs) {
+				edge.Facets = fcs[i].Facets
+			}
+			return edge
+		}
+
+		if len(res.UidMatrix) > 0 {
+			for i, dst := range res.UidMatrix[0].Uids {
+				edge := newEdge(i)
+				edge.ValueId = dst
+				edge.ValueType = pb.Posting_UID
+				edges = append(edges, edge)
+			}
+		}
+		if len(res.ValueMatrix) > 0 {
+			var langs []string
+			if len(res.LangMatrix) > 0 {
+				langs = res.LangMatrix[0].Lang
+			}
+			for i, val := range res.ValueMatrix[0].Values {
+				edge := newEdge(i)
+				edge.Value = val.Val
+				edge.ValueType = val.ValType
+				if i < len(langs) {
+					edge.Lang = langs[i]
+				}
+				edges = append(edges, edge)
+			}
+		}
+	}
+	return edges, nil
+}
+
+// PurgeDeletes makes the deletions in the namespace of the request before the given timestamp
+// permanent, by removing their tombstones. If the timestamp is zero, all the deletions are
+// purged. The number of purged deletions is returned. The deleted versions are still kept on disk
+// until they fall out of the retention window, which is shared by all the namespaces, and are
+// discarded along with the other versions then.
+func PurgeDeletes(ctx context.Context, before uint64) (int, error) {
+	tombstones, err := GetDeletedNodes(ctx)
+	if err != nil {
+		return 0, err
+	}
+	var purged []*pb.Tombstone
+	for _, t := range tombstones {
+		if before == 0 || t.DeletedAt < before {
+			purged = append(purged, t)
+		}
+	}
+	if len(purged) == 0 {
+		return 0, nil
+	}
+	if err := worker.RemoveTombstones(ctx, purged); err != nil {
+		return 0, errors.Wrapf(err, "While purging the deletions")
+	}
+	glog.Infof("Purged %d deletions", len(purged))
+	return len(purged), nil
+}
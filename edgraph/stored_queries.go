@@ -0,0 +1,151 @@
+/*
+ * Copyright 2022 Dgraph Labs, Inc. and Contributors
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package edgraph
+
+import (
+	"context"
+	"regexp"
+	"strings"
+
+	"github.com/golang/glog"
+	"github.com/pkg/errors"
+
+	"github.com/dgraph-io/dgo/v210/protos/api"
+	"github.com/dgraph-io/dgraph/dql"
+	"github.com/dgraph-io/dgraph/protos/pb"
+	"github.com/dgraph-io/dgraph/query"
+	"github.com/dgraph-io/dgraph/schema"
+	"github.com/dgraph-io/dgraph/worker"
+	"github.com/dgraph-io/dgraph/x"
+)
+
+// Stored queries are named DQL queries or upsert blocks kept in the cluster. Clients run them by
+// name, over HTTP with /query/{name} or over gRPC with the name in the "stored-query" metadata,
+// and give the values of their variables as they would for the query itself. Each update of a
+// stored query gets a new version, and a query may be restricted to some ACL groups.
+
+// storedQueryName is the format of the names of the stored queries, which are part of the urls
+// used to run them.
+var storedQueryName = regexp.MustCompile(`^[a-zA-Z_][a-zA-Z0-9_.-]*$`)
+
+// UpdateStoredQueries creates, replaces or removes (when given no query) the stored queries in the
+// namespace of the request.
+func UpdateStoredQueries(ctx context.Context, queries []*pb.StoredQuery) error {
+	if len(queries) == 0 {
+		return errors.Errorf("No stored queries were given")
+	}
+	if err := x.HealthCheck(); err != nil {
+		return err
+	}
+	namespace, err := x.ExtractNamespace(ctx)
+	if err != nil {
+		return errors.Wrapf(err, "While updating stored queries")
+	}
+
+	updates := make([]*pb.StoredQuery, 0, len(queries))
+	seen := make(map[string]struct{}, len(queries))
+	for _, sq := range queries {
+		name := strings.TrimSpace(sq.Name)
+		if !storedQueryName.MatchString(name) {
+			return errors.Errorf("Invalid stored query name %q. It must start with a letter or _"+
+				" and contain only letters, digits, _, . and -", name)
+		}
+		if _, ok := seen[name]; ok {
+			return errors.Errorf("Stored query %s is defined more than once", name)
+		}
+		seen[name] = struct{}{}
+
+		update := &pb.StoredQuery{
+			Name:  x.NamespaceAttr(namespace, name),
+			Query: strings.TrimSpace(sq.Query),
+		}
+		for _, group := range sq.Groups {
+			if group = strings.TrimSpace(group); group != "" {
+				update.Groups = append(update.Groups, group)
+			}
+		}
+		if isUpsertBlock(update.Query) {
+			if _, err := dql.ParseMutation(update.Query); err != nil {
+				return errors.Wrapf(err, "While parsing the upsert of stored query %s", name)
+			}
+		}
+		updates = append(updates, update)
+	}
+
+	m := &pb.Mutations{
+		StartTs:       worker.State.GetTimestamp(false),
+		StoredQueries: updates,
+	}
+	if _, err := query.ApplyMutations(ctx, m); err != nil {
+		return err
+	}
+	glog.Infof("Updated %d stored queries in namespace %#x", len(updates), namespace)
+	return nil
+}
+
+// GetStoredQueries returns the stored queries in the namespace of the request, with the names
+// they were given.
+func GetStoredQueries(ctx context.Context) ([]*pb.StoredQuery, error) {
+	namespace, err := x.ExtractNamespace(ctx)
+	if err != nil {
+		return nil, errors.Wrapf(err, "While reading stored queries")
+	}
+	queries := schema.State().StoredQueries(namespace)
+	for _, sq := range queries {
+		sq.Name = x.ParseAttr(sq.Name)
+	}
+	return queries, nil
+}
+
+// resolveStoredQuery fills the request with the query, and the mutations of an upsert, of the
+// stored query with the given name. The request must carry nothing but the variables and the
+// transaction options.
+func resolveStoredQuery(ctx context.Context, name string, req *api.Request) error {
+	if req.Query != "" || len(req.Mutations) > 0 {
+		return errors.Errorf("A request running the stored query %s can't have a query "+
+			"or mutations", name)
+	}
+	namespace, err := x.ExtractNamespace(ctx)
+	if err != nil {
+		return errors.Wrapf(err, "While running stored query %s", name)
+	}
+	sq, ok := schema.State().StoredQuery(x.NamespaceAttr(namespace, name))
+	if !ok {
+		return errors.Errorf("Stored query %s does not exist", name)
+	}
+	if err := authorizeStoredQuery(ctx, sq); err != nil {
+		return err
+	}
+
+	if !isUpsertBlock(sq.Query) {
+		req.Query = sq.Query
+		return nil
+	}
+	upsert, err := dql.ParseMutation(sq.Query)
+	if err != nil {
+		return errors.Wrapf(err, "While parsing the upsert of stored query %s", name)
+	}
+	req.Query = upsert.Query
+	req.Mutations = upsert.Mutations
+	return nil
+}
+
+// isUpsertBlock returns whether the text of a stored query is an upsert block, rather than a
+// query.
+func isUpsertBlock(text string) bool {
+	return strings.HasPrefix(strings.TrimSpace(text), "upsert")
+}
@@ -0,0 +1,53 @@
+/*
+ * Copyright 2022 Dgraph Labs, Inc. and Contributors
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package edgraph
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/dgraph-io/dgo/v210/protos/api"
+)
+
+func TestStoredQueryName(t *testing.T) {
+	for _, name := range []string{"friends", "_all", "friendsOf.v2", "top-10"} {
+		require.True(t, storedQueryName.MatchString(name), name)
+	}
+	for _, name := range []string{"", "10friends", "friends/of", "friends of", ".hidden"} {
+		require.False(t, storedQueryName.MatchString(name), name)
+	}
+}
+
+func TestIsUpsertBlock(t *testing.T) {
+	require.True(t, isUpsertBlock(`
+		upsert {
+			query { v as var(func: eq(email, $email)) }
+			mutation { set { uid(v) <name> "Alice" . } }
+		}`))
+	require.False(t, isUpsertBlock(`query me($name: string) { me(func: eq(name, $name)) { uid } }`))
+	require.False(t, isUpsertBlock(`{ me(func: has(upsert)) { uid } }`))
+}
+
+func TestResolveStoredQueryWithQuery(t *testing.T) {
+	req := &api.Request{Query: `{ me(func: has(name)) { uid } }`}
+	require.Error(t, resolveStoredQuery(context.Background(), "friends", req))
+
+	req = &api.Request{Mutations: []*api.Mutation{{SetNquads: []byte(`_:a <name> "A" .`)}}}
+	require.Error(t, resolveStoredQuery(context.Background(), "friends", req))
+}
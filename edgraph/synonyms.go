@@ -0,0 +1,98 @@
+/*
+ * Copyright 2022 Dgraph Labs, Inc. and Contributors
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package edgraph
+
+import (
+	"context"
+	"strings"
+
+	"github.com/golang/glog"
+	"github.com/pkg/errors"
+
+	"github.com/dgraph-io/dgraph/protos/pb"
+	"github.com/dgraph-io/dgraph/query"
+	"github.com/dgraph-io/dgraph/schema"
+	"github.com/dgraph-io/dgraph/worker"
+	"github.com/dgraph-io/dgraph/x"
+)
+
+// UpdateSynonyms creates, replaces or removes (when given no words) the synonym sets in the
+// namespace of the request. The sets are stored alongside the types in every group and take
+// effect for the next anyofterms and anyoftext queries, without any reindexing.
+func UpdateSynonyms(ctx context.Context, sets []*pb.SynonymUpdate) error {
+	if len(sets) == 0 {
+		return errors.Errorf("No synonym sets were given")
+	}
+	if err := x.HealthCheck(); err != nil {
+		return err
+	}
+	namespace, err := x.ExtractNamespace(ctx)
+	if err != nil {
+		return errors.Wrapf(err, "While updating synonyms")
+	}
+
+	updates := make([]*pb.SynonymUpdate, 0, len(sets))
+	seen := make(map[string]struct{}, len(sets))
+	for _, set := range sets {
+		name := strings.TrimSpace(set.Name)
+		if name == "" {
+			return errors.Errorf("Synonym set name must be specified")
+		}
+		if _, ok := seen[name]; ok {
+			return errors.Errorf("Synonym set %s is defined more than once", name)
+		}
+		seen[name] = struct{}{}
+
+		var words []string
+		for _, word := range set.Words {
+			if word = strings.TrimSpace(word); word != "" {
+				words = append(words, word)
+			}
+		}
+		if len(words) == 1 {
+			return errors.Errorf("Synonym set %s must have at least two words", name)
+		}
+		updates = append(updates, &pb.SynonymUpdate{
+			Name:  x.NamespaceAttr(namespace, name),
+			Words: x.RemoveDuplicates(words),
+		})
+	}
+
+	m := &pb.Mutations{
+		StartTs:  worker.State.GetTimestamp(false),
+		Synonyms: updates,
+	}
+	if _, err := query.ApplyMutations(ctx, m); err != nil {
+		return err
+	}
+	glog.Infof("Updated %d synonym sets in namespace %#x", len(updates), namespace)
+	return nil
+}
+
+// GetSynonyms returns the synonym sets defined in the namespace of the request, with their
+// names stripped of the namespace.
+func GetSynonyms(ctx context.Context) ([]*pb.SynonymUpdate, error) {
+	namespace, err := x.ExtractNamespace(ctx)
+	if err != nil {
+		return nil, errors.Wrapf(err, "While reading synonyms")
+	}
+	sets := schema.State().Synonyms(namespace)
+	for _, set := range sets {
+		set.Name = x.ParseAttr(set.Name)
+	}
+	return sets, nil
+}
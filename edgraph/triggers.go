@@ -0,0 +1,394 @@
+/*
+ * Copyright 2022 Dgraph Labs, Inc. and Contributors
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package edgraph
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"net/http"
+	"net/url"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/dgryski/go-farm"
+	"github.com/golang/glog"
+	"github.com/pkg/errors"
+
+	gqlSchema "github.com/dgraph-io/dgraph/graphql/schema"
+	"github.com/dgraph-io/dgraph/protos/pb"
+	"github.com/dgraph-io/dgraph/query"
+	"github.com/dgraph-io/dgraph/schema"
+	"github.com/dgraph-io/dgraph/types"
+	"github.com/dgraph-io/dgraph/worker"
+	"github.com/dgraph-io/dgraph/x"
+)
+
+// Triggers are webhooks called with the DQL mutations which change a predicate or the nodes of a
+// type. A trigger called before the commit gets the mutation before it is applied, and vetoes it
+// by replying with a status other than 2xx. A trigger called after the commit gets the mutation
+// once its transaction has committed. Its events are written by the transaction and delivered by
+// the leader of group one, which retries them a few times if they fail. In ludicrous mode, where
+// the mutations are committed as they are applied, they are called by the alpha which applied
+// the mutation instead.
+
+const (
+	// triggerTimeout is how long a trigger has to reply.
+	triggerTimeout = 10 * time.Second
+	// triggerAttempts is how many times a trigger called after the commit is called before
+	// giving up.
+	triggerAttempts = 5
+	// triggerRetryDelay is the delay before the first retry, doubled after every attempt.
+	triggerRetryDelay = time.Second
+)
+
+var triggerClient = &http.Client{Timeout: triggerTimeout}
+
+// UpdateTriggers creates, replaces or removes (when given no url) the triggers in the namespace
+// of the request. The triggers are stored in every group and fire for the next mutations.
+func UpdateTriggers(ctx context.Context, triggers []*pb.Trigger) error {
+	if len(triggers) == 0 {
+		return errors.Errorf("No triggers were given")
+	}
+	if err := x.HealthCheck(); err != nil {
+		return err
+	}
+	namespace, err := x.ExtractNamespace(ctx)
+	if err != nil {
+		return errors.Wrapf(err, "While updating triggers")
+	}
+
+	updates := make([]*pb.Trigger, 0, len(triggers))
+	seen := make(map[string]struct{}, len(triggers))
+	for _, tr := range triggers {
+		name := strings.TrimSpace(tr.Name)
+		if name == "" {
+			return errors.Errorf("Trigger name must be specified")
+		}
+		if _, ok := seen[name]; ok {
+			return errors.Errorf("Trigger %s is defined more than once", name)
+		}
+		seen[name] = struct{}{}
+
+		update := &pb.Trigger{
+			Name:         x.NamespaceAttr(namespace, name),
+			Predicate:    strings.TrimSpace(tr.Predicate),
+			TypeName:     strings.TrimSpace(tr.TypeName),
+			Url:          strings.TrimSpace(tr.Url),
+			BeforeCommit: tr.BeforeCommit,
+		}
+		if update.Url != "" {
+			if err := validateTrigger(name, update); err != nil {
+				return err
+			}
+		}
+		updates = append(updates, update)
+	}
+
+	m := &pb.Mutations{
+		StartTs:  worker.State.GetTimestamp(false),
+		Triggers: updates,
+	}
+	if _, err := query.ApplyMutations(ctx, m); err != nil {
+		return err
+	}
+	glog.Infof("Updated %d triggers in namespace %#x", len(updates), namespace)
+	return nil
+}
+
+func validateTrigger(name string, tr *pb.Trigger) error {
+	if (tr.Predicate == "") == (tr.TypeName == "") {
+		return errors.Errorf("Trigger %s must be set on either a predicate or a type", name)
+	}
+	u, err := url.Parse(tr.Url)
+	if err != nil || (u.Scheme != "http" && u.Scheme != "https") || u.Host == "" {
+		return errors.Errorf("Trigger %s has an invalid url %q. It must be an http or https url",
+			name, tr.Url)
+	}
+	return nil
+}
+
+// GetTriggers returns the triggers defined in the namespace of the request, with their names
+// stripped of the namespace.
+func GetTriggers(ctx context.Context) ([]*pb.Trigger, error) {
+	namespace, err := x.ExtractNamespace(ctx)
+	if err != nil {
+		return nil, errors.Wrapf(err, "While reading triggers")
+	}
+	triggers := schema.State().Triggers(namespace)
+	for _, tr := range triggers {
+		tr.Name = x.ParseAttr(tr.Name)
+	}
+	return triggers, nil
+}
+
+type triggerNQuad struct {
+	Subject     string `json:"subject"`
+	Predicate   string `json:"predicate"`
+	ObjectId    string `json:"objectId,omitempty"`
+	ObjectValue string `json:"objectValue,omitempty"`
+	ObjectType  string `json:"objectType,omitempty"`
+	Lang        string `json:"lang,omitempty"`
+}
+
+type triggerPayload struct {
+	Trigger  string `json:"trigger"`
+	Phase    string `json:"phase"`
+	StartTs  uint64 `json:"startTs"`
+	CommitTs uint64 `json:"commitTs,omitempty"`
+	// Uids maps the blank nodes and uid variables of the mutation to the uids they were
+	// assigned.
+	Uids   map[string]string `json:"uids,omitempty"`
+	Set    []triggerNQuad    `json:"set,omitempty"`
+	Delete []triggerNQuad    `json:"delete,omitempty"`
+}
+
+type triggerEvent struct {
+	trigger *pb.Trigger
+	payload *triggerPayload
+}
+
+// triggerEvents returns the events of the triggers of the namespace of the request fired by the
+// edges of the mutation. A trigger on a type fires for the nodes which have the type, either
+// before the mutation or once it is applied.
+func triggerEvents(ctx context.Context, startTs uint64, edges []*pb.DirectedEdge,
+	uids map[string]string) ([]*triggerEvent, error) {
+	namespace, err := x.ExtractNamespace(ctx)
+	if err != nil {
+		return nil, errors.Wrapf(err, "While firing triggers")
+	}
+	triggers := schema.State().Triggers(namespace)
+	if len(triggers) == 0 {
+		return nil, nil
+	}
+
+	var nodeTypes map[uint64]map[string]struct{}
+	for _, tr := range triggers {
+		if tr.TypeName != "" {
+			if nodeTypes, err = mutatedNodeTypes(ctx, namespace, startTs, edges); err != nil {
+				return nil, errors.Wrapf(err, "While firing triggers")
+			}
+			break
+		}
+	}
+
+	var events []*triggerEvent
+	for _, tr := range triggers {
+		payload := &triggerPayload{
+			Trigger: x.ParseAttr(tr.Name),
+			Phase:   "afterCommit",
+			StartTs: startTs,
+			Uids:    uids,
+		}
+		if tr.BeforeCommit {
+			payload.Phase = "beforeCommit"
+		}
+		for _, edge := range edges {
+			var fired bool
+			if tr.Predicate != "" {
+				fired = edge.Attr == tr.Predicate || edge.Attr == x.Star
+			} else {
+				_, fired = nodeTypes[edge.Entity][tr.TypeName]
+			}
+			if !fired {
+				continue
+			}
+			if edge.Op == pb.DirectedEdge_DEL {
+				payload.Delete = append(payload.Delete, triggerNQuadOf(edge))
+			} else {
+				payload.Set = append(payload.Set, triggerNQuadOf(edge))
+			}
+		}
+		if len(payload.Set) > 0 || len(payload.Delete) > 0 {
+			events = append(events, &triggerEvent{trigger: tr, payload: payload})
+		}
+	}
+	return events, nil
+}
+
+// mutatedNodeTypes returns the types of the nodes changed by the edges, both the ones they had
+// at the start of the transaction and the ones added by the edges.
+func mutatedNodeTypes(ctx context.Context, namespace, startTs uint64,
+	edges []*pb.DirectedEdge) (map[uint64]map[string]struct{}, error) {
+	out := make(map[uint64]map[string]struct{})
+	add := func(uid uint64, typeName string) {
+		if out[uid] == nil {
+			out[uid] = make(map[string]struct{})
+		}
+		out[uid][typeName] = struct{}{}
+	}
+
+	var uids []uint64
+	for _, edge := range edges {
+		if _, ok := out[edge.Entity]; !ok {
+			out[edge.Entity] = make(map[string]struct{})
+			uids = append(uids, edge.Entity)
+		}
+		if edge.Attr == "dgraph.type" && edge.Op == pb.DirectedEdge_SET {
+			add(edge.Entity, string(edge.Value))
+		}
+	}
+	sort.Slice(uids, func(i, j int) bool { return uids[i] < uids[j] })
+
+	res, err := worker.ProcessTaskOverNetwork(ctx, &pb.Query{
+		Attr:    x.NamespaceAttr(namespace, "dgraph.type"),
+		UidList: &pb.List{Uids: uids},
+		ReadTs:  startTs,
+	})
+	if err != nil {
+		return nil, err
+	}
+	for i, uid := range uids {
+		if i >= len(res.ValueMatrix) {
+			break
+		}
+		for _, val := range res.ValueMatrix[i].Values {
+			add(uid, string(val.Val))
+		}
+	}
+	return out, nil
+}
+
+func triggerNQuadOf(edge *pb.DirectedEdge) triggerNQuad {
+	nq := triggerNQuad{
+		Subject:   fmt.Sprintf("%#x", edge.Entity),
+		Predicate: edge.Attr,
+		Lang:      edge.Lang,
+	}
+	if edge.Attr == x.Star {
+		nq.Predicate = "*"
+	}
+	switch {
+	case edge.ValueId != 0:
+		nq.ObjectId = fmt.Sprintf("%#x", edge.ValueId)
+	case string(edge.Value) == x.Star:
+		nq.ObjectValue = "*"
+	default:
+		tid := types.TypeID(edge.ValueType)
+		nq.ObjectType = tid.Name()
+		if val, err := types.Convert(types.Val{Tid: tid, Value: edge.Value},
+			types.StringID); err == nil {
+			nq.ObjectValue, _ = val.Value.(string)
+		}
+	}
+	return nq
+}
+
+// fireBeforeCommit calls the triggers called before the commit, in order. The first trigger
+// which fails vetoes the mutation.
+func fireBeforeCommit(ctx context.Context, events []*triggerEvent) error {
+	for _, event := range events {
+		if !event.trigger.BeforeCommit {
+			continue
+		}
+		if err := callTrigger(ctx, event); err != nil {
+			return errors.Wrapf(err, "Trigger %s vetoed the mutation", event.payload.Trigger)
+		}
+	}
+	return nil
+}
+
+// afterCommitEvents returns the events of the triggers called after the commit, to be recorded
+// by the transaction. The payloads lack the commit timestamp, which is set when they are
+// delivered.
+func afterCommitEvents(events []*triggerEvent) ([]*pb.TriggerEvent, error) {
+	var out []*pb.TriggerEvent
+	for _, event := range events {
+		if event.trigger.BeforeCommit {
+			continue
+		}
+		b, err := json.Marshal(event.payload)
+		if err != nil {
+			return nil, errors.Wrapf(err, "while marshalling the payload")
+		}
+		out = append(out, &pb.TriggerEvent{
+			Trigger: event.trigger.Name,
+			Url:     event.trigger.Url,
+			Payload: b,
+			Id:      farm.Fingerprint64(append([]byte(event.trigger.Name), b...)),
+		})
+	}
+	return out, nil
+}
+
+// deliverTriggerEvent calls the trigger of an event recorded by a committed transaction.
+func deliverTriggerEvent(ctx context.Context, ev *pb.TriggerEvent) error {
+	var payload triggerPayload
+	if err := json.Unmarshal(ev.Payload, &payload); err != nil {
+		return errors.Wrapf(err, "while reading the payload of trigger %s", x.ParseAttr(ev.Trigger))
+	}
+	payload.CommitTs = ev.CommitTs
+	return postTrigger(ctx, ev.Url, &payload)
+}
+
+// fireAfterCommit calls the triggers called after the commit in the background, retrying them
+// with an exponential backoff when they fail. It is only used in ludicrous mode.
+func fireAfterCommit(events []*triggerEvent, commitTs uint64) {
+	for _, event := range events {
+		if event.trigger.BeforeCommit {
+			continue
+		}
+		event.payload.CommitTs = commitTs
+		go func(event *triggerEvent) {
+			delay := triggerRetryDelay
+			for attempt := 1; ; attempt++ {
+				err := callTrigger(context.Background(), event)
+				if err == nil {
+					return
+				}
+				if attempt == triggerAttempts {
+					glog.Errorf("Giving up on trigger %s for the commit at %d after %d attempts:"+
+						" %v", event.payload.Trigger, commitTs, attempt, err)
+					return
+				}
+				glog.V(2).Infof("While calling trigger %s: %v. Retrying in %s",
+					event.payload.Trigger, err, delay)
+				time.Sleep(delay)
+				delay *= 2
+			}
+		}(event)
+	}
+}
+
+func callTrigger(ctx context.Context, event *triggerEvent) error {
+	return postTrigger(ctx, event.trigger.Url, event.payload)
+}
+
+func postTrigger(ctx context.Context, target string, payload *triggerPayload) error {
+	b, err := json.Marshal(payload)
+	if err != nil {
+		return errors.Wrapf(err, "while marshalling the payload")
+	}
+	headers := http.Header{}
+	headers.Set("Content-Type", "application/json")
+	resp, err := gqlSchema.MakeHttpRequest(triggerClient, http.MethodPost, target, headers, b)
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+	if resp.StatusCode < 200 || resp.StatusCode >= 300 {
+		body, _ := ioutil.ReadAll(io.LimitReader(resp.Body, 1<<10))
+		if msg := strings.TrimSpace(string(body)); msg != "" {
+			return errors.Errorf("got status %s: %s", resp.Status, msg)
+		}
+		return errors.Errorf("got status %s", resp.Status)
+	}
+	return nil
+}
@@ -0,0 +1,143 @@
+/*
+ * Copyright 2022 Dgraph Labs, Inc. and Contributors
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package edgraph
+
+import (
+	"context"
+	"encoding/json"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/dgraph-io/dgraph/protos/pb"
+	"github.com/dgraph-io/dgraph/types"
+	"github.com/dgraph-io/dgraph/x"
+)
+
+func TestValidateTrigger(t *testing.T) {
+	tests := []struct {
+		trigger pb.Trigger
+		valid   bool
+	}{
+		{trigger: pb.Trigger{Predicate: "name", Url: "http://localhost:8686/hook"}, valid: true},
+		{trigger: pb.Trigger{TypeName: "Person", Url: "https://example.com/hook"}, valid: true},
+		{trigger: pb.Trigger{Url: "http://localhost:8686/hook"}},
+		{trigger: pb.Trigger{Predicate: "name", TypeName: "Person", Url: "http://localhost"}},
+		{trigger: pb.Trigger{Predicate: "name", Url: "ftp://localhost/hook"}},
+		{trigger: pb.Trigger{Predicate: "name", Url: "localhost:8686"}},
+	}
+	for _, tc := range tests {
+		err := validateTrigger("hook", &tc.trigger)
+		if tc.valid {
+			require.NoError(t, err, "%+v", tc.trigger)
+		} else {
+			require.Error(t, err, "%+v", tc.trigger)
+		}
+	}
+}
+
+func TestTriggerNQuadOf(t *testing.T) {
+	require.Equal(t, triggerNQuad{Subject: "0x1", Predicate: "friend", ObjectId: "0x2"},
+		triggerNQuadOf(&pb.DirectedEdge{Entity: 1, Attr: "friend", ValueId: 2,
+			ValueType: pb.Posting_UID}))
+
+	require.Equal(t, triggerNQuad{Subject: "0x1", Predicate: "*", ObjectValue: "*"},
+		triggerNQuadOf(&pb.DirectedEdge{Entity: 1, Attr: x.Star, Value: []byte(x.Star),
+			Op: pb.DirectedEdge_DEL}))
+
+	require.Equal(t, triggerNQuad{Subject: "0xa", Predicate: "name", ObjectValue: "Alice",
+		ObjectType: "string", Lang: "en"},
+		triggerNQuadOf(&pb.DirectedEdge{Entity: 10, Attr: "name", Value: []byte("Alice"),
+			ValueType: pb.Posting_ValType(types.StringID), Lang: "en"}))
+}
+
+// triggerServer records the payloads it gets, and replies with the given status.
+func triggerServer(t *testing.T, status int, payloads chan<- triggerPayload) *httptest.Server {
+	return httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		b, err := ioutil.ReadAll(r.Body)
+		require.NoError(t, err)
+		var payload triggerPayload
+		require.NoError(t, json.Unmarshal(b, &payload))
+		payloads <- payload
+		w.WriteHeader(status)
+	}))
+}
+
+func TestFireBeforeCommit(t *testing.T) {
+	payloads := make(chan triggerPayload, 2)
+	accept := triggerServer(t, http.StatusOK, payloads)
+	defer accept.Close()
+	veto := triggerServer(t, http.StatusConflict, payloads)
+	defer veto.Close()
+
+	event := func(name, url string, beforeCommit bool) *triggerEvent {
+		return &triggerEvent{
+			trigger: &pb.Trigger{Name: x.GalaxyAttr(name), Url: url, BeforeCommit: beforeCommit},
+			payload: &triggerPayload{Trigger: name, Phase: "beforeCommit", StartTs: 5,
+				Set: []triggerNQuad{{Subject: "0x1", Predicate: "name", ObjectValue: "Alice"}}},
+		}
+	}
+
+	// The triggers called after the commit aren't called before it.
+	require.NoError(t, fireBeforeCommit(context.Background(), []*triggerEvent{
+		event("accept", accept.URL, true), event("later", veto.URL, false)}))
+	require.Equal(t, "accept", (<-payloads).Trigger)
+
+	err := fireBeforeCommit(context.Background(), []*triggerEvent{
+		event("accept", accept.URL, true), event("veto", veto.URL, true)})
+	require.Error(t, err)
+	require.Contains(t, err.Error(), "Trigger veto vetoed the mutation")
+	require.Equal(t, "accept", (<-payloads).Trigger)
+	require.Equal(t, "veto", (<-payloads).Trigger)
+}
+
+func TestDeliverTriggerEvent(t *testing.T) {
+	payloads := make(chan triggerPayload, 1)
+	srv := triggerServer(t, http.StatusOK, payloads)
+	defer srv.Close()
+
+	events, err := afterCommitEvents([]*triggerEvent{
+		{
+			trigger: &pb.Trigger{Name: x.GalaxyAttr("before"), Url: srv.URL, BeforeCommit: true},
+			payload: &triggerPayload{Trigger: "before", Phase: "beforeCommit", StartTs: 5},
+		},
+		{
+			trigger: &pb.Trigger{Name: x.GalaxyAttr("after"), Url: srv.URL},
+			payload: &triggerPayload{Trigger: "after", Phase: "afterCommit", StartTs: 5,
+				Uids:   map[string]string{"a": "0x1"},
+				Delete: []triggerNQuad{{Subject: "0x1", Predicate: "*", ObjectValue: "*"}}},
+		},
+	})
+	require.NoError(t, err)
+	require.Len(t, events, 1)
+	require.NotZero(t, events[0].Id)
+
+	// The event gets the commit timestamp of its transaction when it is delivered.
+	events[0].CommitTs = 7
+	require.NoError(t, deliverTriggerEvent(context.Background(), events[0]))
+	payload := <-payloads
+	require.Equal(t, "after", payload.Trigger)
+	require.Equal(t, "afterCommit", payload.Phase)
+	require.Equal(t, uint64(5), payload.StartTs)
+	require.Equal(t, uint64(7), payload.CommitTs)
+	require.Equal(t, map[string]string{"a": "0x1"}, payload.Uids)
+	require.Equal(t, []triggerNQuad{{Subject: "0x1", Predicate: "*", ObjectValue: "*"}},
+		payload.Delete)
+}
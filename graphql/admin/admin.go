@@ -274,6 +274,12 @@ const (
 		kind: TaskKind
 		status: TaskStatus
 		lastUpdated: DateTime
+
+		"""
+		Number of items processed so far, such as the nodes deleted by a DeleteByQuery task,
+		or the percentage done for an Index task.
+		"""
+		progress: UInt64
 	}
 
 	enum TaskStatus {
@@ -281,15 +287,40 @@ const (
 		Running
 		Failed
 		Success
+		Cancelled
 		Unknown
 	}
 
 	enum TaskKind {
 		Backup
 		Export
+		DeleteByQuery
+		Index
 		Unknown
 	}
 
+	type CancelTaskPayload {
+		response: Response
+	}
+
+	input DeleteByQueryInput {
+		"""
+		DQL query whose root nodes are deleted, along with all their predicates. Its var
+		blocks can be used to find them, and it must not use first, offset or after.
+		"""
+		query: String!
+
+		"""
+		Number of nodes deleted in each transaction. Defaults to 1000.
+		"""
+		batchSize: UInt64
+	}
+
+	type DeleteByQueryPayload {
+		response: Response
+		taskId: String
+	}
+
 	input ConfigInput {
 		"""
 		Estimated memory the caches can take. Actual usage by the process would be
@@ -389,6 +420,376 @@ const (
 		response: AssignedIds
 	}
 
+	"""
+	A set of words that are treated as equivalent by the anyofterms and anyoftext functions.
+	"""
+	type SynonymSet {
+		"""
+		Name of the synonym set, unique within the namespace.
+		"""
+		name: String!
+
+		"""
+		Words in the set.
+		"""
+		words: [String!]!
+
+		"""
+		Timestamp at which the set was last changed.
+		"""
+		version: UInt64
+	}
+
+	input SynonymSetInput {
+		"""
+		Name of the synonym set, unique within the namespace.
+		"""
+		name: String!
+
+		"""
+		Words in the set. An empty list removes the set.
+		"""
+		words: [String!]!
+	}
+
+	input UpdateSynonymsInput {
+		sets: [SynonymSetInput!]!
+	}
+
+	type UpdateSynonymsPayload {
+		response: Response
+	}
+
+	enum TriggerPhase {
+		"""
+		The trigger is called with the mutation before it is applied, and vetoes it by replying
+		with a status other than 2xx.
+		"""
+		BEFORE_COMMIT
+
+		"""
+		The trigger is called once the transaction of the mutation has committed, and is
+		retried a few times if it fails.
+		"""
+		AFTER_COMMIT
+	}
+
+	"""
+	A webhook called with the DQL mutations which change a predicate or the nodes of a type.
+	"""
+	type Trigger {
+		"""
+		Name of the trigger, unique within the namespace.
+		"""
+		name: String!
+
+		"""
+		Predicate whose changes fire the trigger.
+		"""
+		predicate: String
+
+		"""
+		Type whose nodes fire the trigger when they are changed.
+		"""
+		type: String
+
+		"""
+		URL to which the mutations are posted.
+		"""
+		url: String!
+
+		phase: TriggerPhase!
+
+		"""
+		Timestamp at which the trigger was last changed.
+		"""
+		version: UInt64
+	}
+
+	input TriggerInput {
+		"""
+		Name of the trigger, unique within the namespace.
+		"""
+		name: String!
+
+		"""
+		Predicate whose changes fire the trigger. Either predicate or type must be set.
+		"""
+		predicate: String
+
+		"""
+		Type whose nodes fire the trigger when they are changed.
+		"""
+		type: String
+
+		"""
+		URL to which the mutations are posted. No URL removes the trigger.
+		"""
+		url: String
+
+		"""
+		When the trigger is called. Defaults to AFTER_COMMIT.
+		"""
+		phase: TriggerPhase
+	}
+
+	input UpdateTriggersInput {
+		triggers: [TriggerInput!]!
+	}
+
+	type UpdateTriggersPayload {
+		response: Response
+	}
+
+	"""
+	A named DQL query or upsert block, which clients run with /query/{name} over HTTP or with
+	the name in the stored-query metadata over gRPC.
+	"""
+	type StoredQuery {
+		"""
+		Name of the query, unique within the namespace.
+		"""
+		name: String!
+
+		"""
+		Text of the DQL query or upsert block.
+		"""
+		query: String!
+
+		"""
+		ACL groups allowed to run the query. Anyone can run a query without groups.
+		"""
+		groups: [String!]
+
+		"""
+		Timestamp at which the query was last changed.
+		"""
+		version: UInt64
+	}
+
+	input StoredQueryInput {
+		"""
+		Name of the query, unique within the namespace.
+		"""
+		name: String!
+
+		"""
+		Text of the DQL query or upsert block. No text removes the stored query.
+		"""
+		query: String
+
+		"""
+		ACL groups allowed to run the query. Anyone can run a query without groups.
+		"""
+		groups: [String!]
+	}
+
+	input UpdateStoredQueriesInput {
+		queries: [StoredQueryInput!]!
+	}
+
+	type UpdateStoredQueriesPayload {
+		response: Response
+	}
+
+	enum SchemaKind {
+		DQL
+		GraphQL
+	}
+
+	"""
+	A recorded version of the DQL or GraphQL schema.
+	"""
+	type SchemaVersion {
+		"""
+		Timestamp at which the version was recorded, unique within the namespace.
+		"""
+		version: UInt64!
+
+		"""
+		Whether this is a version of the DQL or of the GraphQL schema.
+		"""
+		kind: SchemaKind!
+
+		"""
+		The complete schema of the version.
+		"""
+		schema: String!
+
+		"""
+		User who changed the schema, when ACL is enabled.
+		"""
+		author: String
+
+		"""
+		Time at which the schema was changed, in RFC 3339 format.
+		"""
+		createdAt: String!
+	}
+
+	input SchemaDiffInput {
+		from: UInt64!
+		to: UInt64!
+	}
+
+	type SchemaDiff {
+		"""
+		Line by line diff between the two versions. Removed lines start with '-', added lines
+		with '+' and unchanged lines with a space.
+		"""
+		diff: String!
+	}
+
+	input RollbackSchemaInput {
+		version: UInt64!
+
+		"""
+		Drop the predicates, along with their data, and the types which have no definition in
+		the version of the DQL schema. They are kept by default.
+		"""
+		dropUndefined: Boolean
+	}
+
+	type RollbackSchemaPayload {
+		response: Response
+
+		"""
+		Predicates with no definition in the version of the DQL schema, like the ones added
+		after it or created by mutations since. They are dropped only with dropUndefined.
+		"""
+		undefinedPredicates: [String!]
+
+		"""
+		Types with no definition in the version of the DQL schema.
+		"""
+		undefinedTypes: [String!]
+	}
+
+	"""
+	A node deleted with delete { <uid> * * . } while soft delete was enabled.
+	"""
+	type DeletedNode {
+		"""
+		UID of the node, like 0x2a.
+		"""
+		uid: String!
+
+		"""
+		Commit timestamp of the deletion.
+		"""
+		deletedAt: UInt64!
+
+		"""
+		The predicates deleted from the node.
+		"""
+		predicates: [String!]!
+
+		"""
+		Time at which the node was deleted, in RFC 3339 format.
+		"""
+		createdAt: String!
+	}
+
+	input UndeleteInput {
+		"""
+		UID of the deleted node, like 0x2a.
+		"""
+		uid: String!
+
+		"""
+		Bring the node back as it was at this timestamp, undoing all its deletions after it.
+		By default, the latest deletion of the node is undone.
+		"""
+		before: UInt64
+	}
+
+	type UndeletePayload {
+		response: Response
+	}
+
+	input PurgeDeletesInput {
+		"""
+		Purge the deletions committed before this timestamp. By default, all the deletions are
+		purged.
+		"""
+		before: UInt64
+	}
+
+	type PurgeDeletesPayload {
+		response: Response
+	}
+
+	"""
+	A query or mutation being served by this Alpha.
+	"""
+	type RunningRequest {
+		"""
+		Id of the request, to kill it.
+		"""
+		id: String!
+		namespace: UInt64!
+
+		"""
+		User who sent the request, when ACL is enabled.
+		"""
+		user: String
+
+		"""
+		Query and mutations of the request, cut after 2KB.
+		"""
+		text: String!
+
+		"""
+		Start timestamp of the transaction of the request, once assigned.
+		"""
+		startTs: UInt64
+		mutation: Boolean!
+
+		"""
+		Time at which the request started, in RFC 3339 format.
+		"""
+		startedAt: String!
+
+		"""
+		Time the request has been running for, like 1.5s.
+		"""
+		elapsed: String!
+	}
+
+	"""
+	A transaction with mutations in the group of this Alpha, neither committed nor aborted yet.
+	"""
+	type OpenTransaction {
+		startTs: UInt64!
+
+		"""
+		Time of the last mutation of the transaction, in RFC 3339 format.
+		"""
+		lastUpdate: String!
+
+		"""
+		The first 100 posting lists changed by the transaction in the group, out of numKeys.
+		"""
+		keys: [String!]!
+		numKeys: Int!
+	}
+
+	input KillInput {
+		"""
+		Id of a running request to kill.
+		"""
+		id: String
+
+		"""
+		Start timestamp of an open transaction to abort.
+		"""
+		startTs: UInt64
+	}
+
+	type KillPayload {
+		response: Response
+	}
+
 	` + adminTypes + `
 
 	type Query {
@@ -397,6 +798,14 @@ const (
 		state: MembershipState
 		config: Config
 		task(input: TaskInput!): TaskPayload
+		getSynonyms: [SynonymSet]
+		getTriggers: [Trigger]
+		getStoredQueries: [StoredQuery]
+		schemaHistory: [SchemaVersion]
+		schemaDiff(input: SchemaDiffInput!): SchemaDiff
+		deletedNodes: [DeletedNode]
+		runningRequests: [RunningRequest]
+		openTransactions: [OpenTransaction]
 		` + adminQueries + `
 	}
 
@@ -445,6 +854,63 @@ const (
 		"""
 		assign(input: AssignInput!): AssignPayload
 
+		"""
+		Create, replace or remove synonym sets. Changes apply to queries right away and
+		don't require any reindexing.
+		"""
+		updateSynonyms(input: UpdateSynonymsInput!): UpdateSynonymsPayload
+
+		"""
+		Create, replace or remove the triggers of DQL mutations.
+		"""
+		updateTriggers(input: UpdateTriggersInput!): UpdateTriggersPayload
+
+		"""
+		Create, replace or remove stored queries. Clients running a query by name get the
+		new text right away.
+		"""
+		updateStoredQueries(input: UpdateStoredQueriesInput!): UpdateStoredQueriesPayload
+
+		"""
+		Start a task deleting the nodes matched by a query in batches. Its progress is
+		reported by the task query.
+		"""
+		deleteByQuery(input: DeleteByQueryInput!): DeleteByQueryPayload
+
+		"""
+		Cancel a queued or running DeleteByQuery task, or a running Index task. A running
+		DeleteByQuery task stops after its current batch. An Index task is cancelled on all
+		the replicas of the group, and the predicate keeps its previous schema.
+		"""
+		cancelTask(input: TaskInput!): CancelTaskPayload
+
+		"""
+		Re-apply an older version of the DQL or GraphQL schema. The predicates and types which
+		have no definition in that version are kept and, for the DQL schema, reported, unless
+		dropUndefined is set. Indexes that differ are rebuilt. The rollback is recorded as a new
+		version.
+		"""
+		rollbackSchema(input: RollbackSchemaInput!): RollbackSchemaPayload
+
+		"""
+		Bring back a node deleted while soft delete was enabled, within its retention window.
+		"""
+		undelete(input: UndeleteInput!): UndeletePayload
+
+		"""
+		Make the deletions recorded by soft delete permanent, so that they can no longer be
+		undone. Only the records of the deletions are removed. The deleted data stays on disk
+		until it falls out of the retention window of soft delete, as the window is shared by
+		all the namespaces, and is discarded with the other old versions then.
+		"""
+		purgeDeletes(input: PurgeDeletesInput): PurgeDeletesPayload
+
+		"""
+		Kill a request running on this Alpha, cancelling it and aborting its transaction unless
+		it is read-only, or abort an open transaction.
+		"""
+		kill(input: KillInput!): KillPayload
+
 		` + adminMutations + `
 	}
  `
@@ -500,11 +966,19 @@ var (
 		resolve.LoggingMWMutation,
 	}
 	adminQueryMWConfig = map[string]resolve.QueryMiddlewares{
-		"health":       minimalAdminQryMWs, // dgraph checks Guardian auth for health
-		"state":        minimalAdminQryMWs, // dgraph checks Guardian auth for state
-		"config":       gogQryMWs,
-		"listBackups":  gogQryMWs,
-		"getGQLSchema": stdAdminQryMWs,
+		"health":           minimalAdminQryMWs, // dgraph checks Guardian auth for health
+		"state":            minimalAdminQryMWs, // dgraph checks Guardian auth for state
+		"config":           gogQryMWs,
+		"listBackups":      gogQryMWs,
+		"getGQLSchema":     stdAdminQryMWs,
+		"getSynonyms":      stdAdminQryMWs,
+		"getTriggers":      stdAdminQryMWs,
+		"getStoredQueries": stdAdminQryMWs,
+		"schemaHistory":    stdAdminQryMWs,
+		"schemaDiff":       stdAdminQryMWs,
+		"deletedNodes":     stdAdminQryMWs,
+		"runningRequests":  stdAdminQryMWs,
+		"openTransactions": stdAdminQryMWs,
 		// for queries and mutations related to User/Group, dgraph handles Guardian auth,
 		// so no need to apply GuardianAuth Middleware
 		"queryUser":      minimalAdminQryMWs,
@@ -514,21 +988,30 @@ var (
 		"getGroup":       minimalAdminQryMWs,
 	}
 	adminMutationMWConfig = map[string]resolve.MutationMiddlewares{
-		"backup":            gogMutMWs,
-		"config":            gogMutMWs,
-		"draining":          gogMutMWs,
-		"export":            stdAdminMutMWs, // dgraph handles the export for other namespaces by guardian of galaxy
-		"login":             minimalAdminMutMWs,
-		"restore":           gogMutMWs,
-		"shutdown":          gogMutMWs,
-		"removeNode":        gogMutMWs,
-		"moveTablet":        gogMutMWs,
-		"assign":            gogMutMWs,
-		"enterpriseLicense": gogMutMWs,
-		"updateGQLSchema":   stdAdminMutMWs,
-		"addNamespace":      gogAclMutMWs,
-		"deleteNamespace":   gogAclMutMWs,
-		"resetPassword":     gogAclMutMWs,
+		"backup":              gogMutMWs,
+		"config":              gogMutMWs,
+		"draining":            gogMutMWs,
+		"export":              stdAdminMutMWs, // dgraph handles the export for other namespaces by guardian of galaxy
+		"login":               minimalAdminMutMWs,
+		"restore":             gogMutMWs,
+		"shutdown":            gogMutMWs,
+		"removeNode":          gogMutMWs,
+		"moveTablet":          gogMutMWs,
+		"assign":              gogMutMWs,
+		"enterpriseLicense":   gogMutMWs,
+		"updateGQLSchema":     stdAdminMutMWs,
+		"updateSynonyms":      stdAdminMutMWs,
+		"updateTriggers":      stdAdminMutMWs,
+		"updateStoredQueries": stdAdminMutMWs,
+		"deleteByQuery":       stdAdminMutMWs,
+		"cancelTask":          stdAdminMutMWs,
+		"rollbackSchema":      stdAdminMutMWs,
+		"undelete":            stdAdminMutMWs,
+		"purgeDeletes":        stdAdminMutMWs,
+		"kill":                stdAdminMutMWs,
+		"addNamespace":        gogAclMutMWs,
+		"deleteNamespace":     gogAclMutMWs,
+		"resetPassword":       gogAclMutMWs,
 		// for queries and mutations related to User/Group, dgraph handles Guardian auth,
 		// so no need to apply GuardianAuth Middleware
 		"addUser":     minimalAdminMutMWs,
@@ -755,20 +1238,29 @@ func newAdminResolver(
 
 func newAdminResolverFactory() resolve.ResolverFactory {
 	adminMutationResolvers := map[string]resolve.MutationResolverFunc{
-		"addNamespace":      resolveAddNamespace,
-		"backup":            resolveBackup,
-		"config":            resolveUpdateConfig,
-		"deleteNamespace":   resolveDeleteNamespace,
-		"draining":          resolveDraining,
-		"export":            resolveExport,
-		"login":             resolveLogin,
-		"resetPassword":     resolveResetPassword,
-		"restore":           resolveRestore,
-		"shutdown":          resolveShutdown,
-		"removeNode":        resolveRemoveNode,
-		"moveTablet":        resolveMoveTablet,
-		"assign":            resolveAssign,
-		"enterpriseLicense": resolveEnterpriseLicense,
+		"addNamespace":        resolveAddNamespace,
+		"backup":              resolveBackup,
+		"config":              resolveUpdateConfig,
+		"deleteNamespace":     resolveDeleteNamespace,
+		"draining":            resolveDraining,
+		"export":              resolveExport,
+		"login":               resolveLogin,
+		"resetPassword":       resolveResetPassword,
+		"restore":             resolveRestore,
+		"shutdown":            resolveShutdown,
+		"removeNode":          resolveRemoveNode,
+		"moveTablet":          resolveMoveTablet,
+		"assign":              resolveAssign,
+		"enterpriseLicense":   resolveEnterpriseLicense,
+		"updateSynonyms":      resolveUpdateSynonyms,
+		"updateTriggers":      resolveUpdateTriggers,
+		"updateStoredQueries": resolveUpdateStoredQueries,
+		"deleteByQuery":       resolveDeleteByQuery,
+		"cancelTask":          resolveCancelTask,
+		"rollbackSchema":      resolveRollbackSchema,
+		"undelete":            resolveUndelete,
+		"purgeDeletes":        resolvePurgeDeletes,
+		"kill":                resolveKill,
 	}
 
 	rf := resolverFactoryWithErrorMsg(errResolverNotFound).
@@ -789,6 +1281,30 @@ func newAdminResolverFactory() resolve.ResolverFactory {
 		WithQueryResolver("task", func(q schema.Query) resolve.QueryResolver {
 			return resolve.QueryResolverFunc(resolveTask)
 		}).
+		WithQueryResolver("getSynonyms", func(q schema.Query) resolve.QueryResolver {
+			return resolve.QueryResolverFunc(resolveGetSynonyms)
+		}).
+		WithQueryResolver("getTriggers", func(q schema.Query) resolve.QueryResolver {
+			return resolve.QueryResolverFunc(resolveGetTriggers)
+		}).
+		WithQueryResolver("getStoredQueries", func(q schema.Query) resolve.QueryResolver {
+			return resolve.QueryResolverFunc(resolveGetStoredQueries)
+		}).
+		WithQueryResolver("schemaHistory", func(q schema.Query) resolve.QueryResolver {
+			return resolve.QueryResolverFunc(resolveSchemaHistory)
+		}).
+		WithQueryResolver("schemaDiff", func(q schema.Query) resolve.QueryResolver {
+			return resolve.QueryResolverFunc(resolveSchemaDiff)
+		}).
+		WithQueryResolver("deletedNodes", func(q schema.Query) resolve.QueryResolver {
+			return resolve.QueryResolverFunc(resolveDeletedNodes)
+		}).
+		WithQueryResolver("runningRequests", func(q schema.Query) resolve.QueryResolver {
+			return resolve.QueryResolverFunc(resolveRunningRequests)
+		}).
+		WithQueryResolver("openTransactions", func(q schema.Query) resolve.QueryResolver {
+			return resolve.QueryResolverFunc(resolveOpenTransactions)
+		}).
 		WithQueryResolver("getGQLSchema", func(q schema.Query) resolve.QueryResolver {
 			return resolve.QueryResolverFunc(
 				func(ctx context.Context, query schema.Query) *resolve.Resolved {
@@ -0,0 +1,54 @@
+/*
+ * Copyright 2022 Dgraph Labs, Inc. and Contributors
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package admin
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/pkg/errors"
+
+	"github.com/dgraph-io/dgraph/edgraph"
+	"github.com/dgraph-io/dgraph/graphql/resolve"
+	"github.com/dgraph-io/dgraph/graphql/schema"
+)
+
+func resolveDeleteByQuery(ctx context.Context, m schema.Mutation) (*resolve.Resolved, bool) {
+	inputArg, ok := m.ArgValue(schema.InputArgName).(map[string]interface{})
+	if !ok {
+		return resolve.EmptyResult(m,
+			inputArgError(errors.Errorf("can't convert input to map"))), false
+	}
+	query, _ := inputArg["query"].(string)
+	batchSize, err := optionalUint64(inputArg, "batchSize")
+	if err != nil {
+		return resolve.EmptyResult(m, err), false
+	}
+
+	taskId, err := edgraph.DeleteByQuery(ctx, query, batchSize)
+	if err != nil {
+		return resolve.EmptyResult(m, err), false
+	}
+
+	data := response("Success", fmt.Sprintf("Delete by query queued with ID %#x", taskId))
+	data["taskId"] = fmt.Sprintf("%#x", taskId)
+	return resolve.DataResult(
+		m,
+		map[string]interface{}{m.Name(): data},
+		nil,
+	), true
+}
@@ -0,0 +1,126 @@
+/*
+ * Copyright 2022 Dgraph Labs, Inc. and Contributors
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package admin
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"strconv"
+	"time"
+
+	"github.com/pkg/errors"
+
+	"github.com/dgraph-io/dgraph/edgraph"
+	"github.com/dgraph-io/dgraph/graphql/resolve"
+	"github.com/dgraph-io/dgraph/graphql/schema"
+)
+
+func resolveRunningRequests(ctx context.Context, q schema.Query) *resolve.Resolved {
+	reqs, err := edgraph.RunningRequests(ctx)
+	if err != nil {
+		return resolve.EmptyResult(q, err)
+	}
+
+	now := time.Now()
+	res := make([]interface{}, 0, len(reqs))
+	for _, r := range reqs {
+		req := map[string]interface{}{
+			"id":        fmt.Sprintf("%#x", r.Id),
+			"namespace": json.Number(strconv.FormatUint(r.Namespace, 10)),
+			"text":      r.Text,
+			"mutation":  r.Mutation,
+			"startedAt": r.Started.UTC().Format(time.RFC3339),
+			"elapsed":   now.Sub(r.Started).Round(time.Millisecond).String(),
+		}
+		if r.User != "" {
+			req["user"] = r.User
+		}
+		if r.StartTs > 0 {
+			req["startTs"] = json.Number(strconv.FormatUint(r.StartTs, 10))
+		}
+		res = append(res, req)
+	}
+	return resolve.DataResult(q, map[string]interface{}{q.Name(): res}, nil)
+}
+
+func resolveOpenTransactions(ctx context.Context, q schema.Query) *resolve.Resolved {
+	txns, err := edgraph.OpenTransactions(ctx)
+	if err != nil {
+		return resolve.EmptyResult(q, err)
+	}
+
+	res := make([]interface{}, 0, len(txns))
+	for _, txn := range txns {
+		keys := make([]interface{}, 0, len(txn.Keys))
+		for _, key := range txn.Keys {
+			keys = append(keys, key)
+		}
+		res = append(res, map[string]interface{}{
+			"startTs":    json.Number(strconv.FormatUint(txn.StartTs, 10)),
+			"lastUpdate": txn.LastUpdate.UTC().Format(time.RFC3339),
+			"keys":       keys,
+			"numKeys":    txn.NumKeys,
+		})
+	}
+	return resolve.DataResult(q, map[string]interface{}{q.Name(): res}, nil)
+}
+
+func resolveKill(ctx context.Context, m schema.Mutation) (*resolve.Resolved, bool) {
+	inputArg, ok := m.ArgValue(schema.InputArgName).(map[string]interface{})
+	if !ok {
+		return resolve.EmptyResult(m,
+			inputArgError(errors.Errorf("can't convert input to map"))), false
+	}
+	idArg, _ := inputArg["id"].(string)
+	startTs, err := optionalUint64(inputArg, "startTs")
+	if err != nil {
+		return resolve.EmptyResult(m, err), false
+	}
+	if (idArg == "") == (startTs == 0) {
+		return resolve.EmptyResult(m, inputArgError(errors.Errorf(
+			"exactly one of input.id and input.startTs must be given"))), false
+	}
+
+	var msg string
+	if idArg != "" {
+		id, err := strconv.ParseUint(idArg, 0, 64)
+		if err != nil {
+			return resolve.EmptyResult(m,
+				inputArgError(schema.GQLWrapf(err, "can't convert input.id to uint64"))), false
+		}
+		killed, err := edgraph.Kill(ctx, id)
+		if err != nil {
+			return resolve.EmptyResult(m, err), false
+		}
+		msg = fmt.Sprintf("Killed request %#x", id)
+		if killed.StartTs > 0 {
+			msg += fmt.Sprintf(" at start ts %d", killed.StartTs)
+		}
+	} else {
+		if err := edgraph.AbortTransaction(ctx, startTs); err != nil {
+			return resolve.EmptyResult(m, err), false
+		}
+		msg = fmt.Sprintf("Aborted transaction at start ts %d", startTs)
+	}
+
+	return resolve.DataResult(
+		m,
+		map[string]interface{}{m.Name(): response("Success", msg)},
+		nil,
+	), true
+}
@@ -23,6 +23,7 @@ import (
 	"github.com/dgraph-io/dgraph/edgraph"
 	"github.com/dgraph-io/dgraph/graphql/resolve"
 	"github.com/dgraph-io/dgraph/graphql/schema"
+	"github.com/dgraph-io/dgraph/protos/pb"
 	"github.com/dgraph-io/dgraph/query"
 	"github.com/dgraph-io/dgraph/x"
 	"github.com/golang/glog"
@@ -48,19 +49,7 @@ func (usr *updateSchemaResolver) Resolve(ctx context.Context, m schema.Mutation)
 		return resolve.EmptyResult(m, err), false
 	}
 
-	// We just need to validate the schema. Schema is later set in `resetSchema()` when the schema
-	// is returned from badger.
-	schHandler, err := schema.NewHandler(input.Set.Schema, false)
-	if err != nil {
-		return resolve.EmptyResult(m, err), false
-	}
-
-	// we don't need the correct namespace for validation, so passing the Galaxy namespace
-	if _, err = schema.FromString(schHandler.GQLSchema(), x.GalaxyNamespace); err != nil {
-		return resolve.EmptyResult(m, err), false
-	}
-
-	resp, err := edgraph.UpdateGQLSchema(ctx, input.Set.Schema, schHandler.DGSchema())
+	resp, schHandler, err := updateGQLSchema(ctx, input.Set.Schema)
 	if err != nil {
 		return resolve.EmptyResult(m, err), false
 	}
@@ -77,6 +66,32 @@ func (usr *updateSchemaResolver) Resolve(ctx context.Context, m schema.Mutation)
 		nil), true
 }
 
+// updateGQLSchema validates the GraphQL schema, stores it and records it as a new version in the
+// schema history.
+func updateGQLSchema(ctx context.Context,
+	sch string) (*pb.UpdateGraphQLSchemaResponse, schema.Handler, error) {
+	// We just need to validate the schema. Schema is later set in `resetSchema()` when the schema
+	// is returned from badger.
+	schHandler, err := schema.NewHandler(sch, false)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	// we don't need the correct namespace for validation, so passing the Galaxy namespace
+	if _, err = schema.FromString(schHandler.GQLSchema(), x.GalaxyNamespace); err != nil {
+		return nil, nil, err
+	}
+
+	resp, err := edgraph.UpdateGQLSchema(ctx, sch, schHandler.DGSchema())
+	if err != nil {
+		return nil, nil, err
+	}
+	if err := edgraph.RecordGQLSchemaVersion(ctx, sch); err != nil {
+		return nil, nil, err
+	}
+	return resp, schHandler, nil
+}
+
 func (gsr *getSchemaResolver) Resolve(ctx context.Context, q schema.Query) *resolve.Resolved {
 	var data map[string]interface{}
 
@@ -0,0 +1,125 @@
+/*
+ * Copyright 2022 Dgraph Labs, Inc. and Contributors
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package admin
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"strconv"
+	"time"
+
+	"github.com/pkg/errors"
+
+	"github.com/dgraph-io/dgraph/edgraph"
+	"github.com/dgraph-io/dgraph/graphql/resolve"
+	"github.com/dgraph-io/dgraph/graphql/schema"
+)
+
+func resolveSchemaHistory(ctx context.Context, q schema.Query) *resolve.Resolved {
+	history, err := edgraph.GetSchemaHistory(ctx)
+	if err != nil {
+		return resolve.EmptyResult(q, err)
+	}
+
+	res := make([]interface{}, 0, len(history))
+	for _, v := range history {
+		kind := "DQL"
+		if v.Graphql {
+			kind = "GraphQL"
+		}
+		var author interface{}
+		if v.Author != "" {
+			author = v.Author
+		}
+		res = append(res, map[string]interface{}{
+			"version":   json.Number(strconv.FormatUint(v.Version, 10)),
+			"kind":      kind,
+			"schema":    v.Schema,
+			"author":    author,
+			"createdAt": time.Unix(v.CreatedAt, 0).UTC().Format(time.RFC3339),
+		})
+	}
+	return resolve.DataResult(q, map[string]interface{}{q.Name(): res}, nil)
+}
+
+func resolveSchemaDiff(ctx context.Context, q schema.Query) *resolve.Resolved {
+	inputArg, ok := q.ArgValue(schema.InputArgName).(map[string]interface{})
+	if !ok {
+		return resolve.EmptyResult(q, inputArgError(errors.Errorf("can't convert input to map")))
+	}
+	from, err := parseAsUint64(inputArg["from"])
+	if err != nil {
+		return resolve.EmptyResult(q,
+			inputArgError(schema.GQLWrapf(err, "can't convert input.from to uint64")))
+	}
+	to, err := parseAsUint64(inputArg["to"])
+	if err != nil {
+		return resolve.EmptyResult(q,
+			inputArgError(schema.GQLWrapf(err, "can't convert input.to to uint64")))
+	}
+
+	diff, err := edgraph.DiffSchemaVersions(ctx, from, to)
+	if err != nil {
+		return resolve.EmptyResult(q, err)
+	}
+	return resolve.DataResult(q, map[string]interface{}{q.Name(): map[string]interface{}{
+		"diff": diff,
+	}}, nil)
+}
+
+func resolveRollbackSchema(ctx context.Context, m schema.Mutation) (*resolve.Resolved, bool) {
+	inputArg, ok := m.ArgValue(schema.InputArgName).(map[string]interface{})
+	if !ok {
+		return resolve.EmptyResult(m,
+			inputArgError(errors.Errorf("can't convert input to map"))), false
+	}
+	version, err := parseAsUint64(inputArg["version"])
+	if err != nil {
+		return resolve.EmptyResult(m,
+			inputArgError(schema.GQLWrapf(err, "can't convert input.version to uint64"))), false
+	}
+
+	drop, _ := inputArg["dropUndefined"].(bool)
+
+	v, err := edgraph.GetSchemaVersion(ctx, version)
+	if err != nil {
+		return resolve.EmptyResult(m, err), false
+	}
+	var preds, typs []string
+	if v.Graphql {
+		_, _, err = updateGQLSchema(ctx, v.Schema)
+	} else {
+		preds, typs, err = edgraph.RollbackDQLSchema(ctx, v, drop)
+	}
+	if err != nil {
+		return resolve.EmptyResult(m, err), false
+	}
+
+	res := response("Success", fmt.Sprintf("Rolled back the schema to version %d", version))
+	res["undefinedPredicates"] = toInterfaceSlice(preds)
+	res["undefinedTypes"] = toInterfaceSlice(typs)
+	return resolve.DataResult(m, map[string]interface{}{m.Name(): res}, nil), true
+}
+
+func toInterfaceSlice(names []string) []interface{} {
+	out := make([]interface{}, 0, len(names))
+	for _, name := range names {
+		out = append(out, name)
+	}
+	return out
+}
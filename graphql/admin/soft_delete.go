@@ -0,0 +1,118 @@
+/*
+ * Copyright 2022 Dgraph Labs, Inc. and Contributors
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package admin
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"strconv"
+	"time"
+
+	"github.com/pkg/errors"
+
+	"github.com/dgraph-io/dgraph/dql"
+	"github.com/dgraph-io/dgraph/edgraph"
+	"github.com/dgraph-io/dgraph/graphql/resolve"
+	"github.com/dgraph-io/dgraph/graphql/schema"
+)
+
+func resolveDeletedNodes(ctx context.Context, q schema.Query) *resolve.Resolved {
+	tombstones, err := edgraph.GetDeletedNodes(ctx)
+	if err != nil {
+		return resolve.EmptyResult(q, err)
+	}
+
+	res := make([]interface{}, 0, len(tombstones))
+	for _, t := range tombstones {
+		preds := make([]interface{}, 0, len(t.Predicates))
+		for _, pred := range t.Predicates {
+			preds = append(preds, pred)
+		}
+		res = append(res, map[string]interface{}{
+			"uid":        fmt.Sprintf("%#x", t.Uid),
+			"deletedAt":  json.Number(strconv.FormatUint(t.DeletedAt, 10)),
+			"predicates": preds,
+			"createdAt":  time.Unix(t.CreatedAt, 0).UTC().Format(time.RFC3339),
+		})
+	}
+	return resolve.DataResult(q, map[string]interface{}{q.Name(): res}, nil)
+}
+
+func resolveUndelete(ctx context.Context, m schema.Mutation) (*resolve.Resolved, bool) {
+	inputArg, ok := m.ArgValue(schema.InputArgName).(map[string]interface{})
+	if !ok {
+		return resolve.EmptyResult(m,
+			inputArgError(errors.Errorf("can't convert input to map"))), false
+	}
+	uidArg, _ := inputArg["uid"].(string)
+	uid, err := dql.ParseUid(uidArg)
+	if err != nil {
+		return resolve.EmptyResult(m,
+			inputArgError(schema.GQLWrapf(err, "can't convert input.uid to a uid"))), false
+	}
+	before, err := optionalUint64(inputArg, "before")
+	if err != nil {
+		return resolve.EmptyResult(m, err), false
+	}
+
+	readTs, err := edgraph.UndeleteNode(ctx, uid, before)
+	if err != nil {
+		return resolve.EmptyResult(m, err), false
+	}
+
+	return resolve.DataResult(
+		m,
+		map[string]interface{}{m.Name(): response("Success",
+			fmt.Sprintf("Brought back node %#x as it was at timestamp %d", uid, readTs))},
+		nil,
+	), true
+}
+
+func resolvePurgeDeletes(ctx context.Context, m schema.Mutation) (*resolve.Resolved, bool) {
+	inputArg, _ := m.ArgValue(schema.InputArgName).(map[string]interface{})
+	before, err := optionalUint64(inputArg, "before")
+	if err != nil {
+		return resolve.EmptyResult(m, err), false
+	}
+
+	purged, err := edgraph.PurgeDeletes(ctx, before)
+	if err != nil {
+		return resolve.EmptyResult(m, err), false
+	}
+
+	return resolve.DataResult(
+		m,
+		map[string]interface{}{m.Name(): response("Success",
+			fmt.Sprintf("Purged %d deletions", purged))},
+		nil,
+	), true
+}
+
+// optionalUint64 returns the uint64 in the given field of the input, or zero if it isn't
+// set.
+func optionalUint64(inputArg map[string]interface{}, field string) (uint64, error) {
+	val, ok := inputArg[field]
+	if !ok || val == nil {
+		return 0, nil
+	}
+	ts, err := parseAsUint64(val)
+	if err != nil {
+		return 0, inputArgError(schema.GQLWrapf(err, "can't convert input.%s to uint64", field))
+	}
+	return ts, nil
+}
@@ -0,0 +1,101 @@
+/*
+ * Copyright 2022 Dgraph Labs, Inc. and Contributors
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package admin
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"strconv"
+
+	"github.com/dgraph-io/dgraph/edgraph"
+	"github.com/dgraph-io/dgraph/graphql/resolve"
+	"github.com/dgraph-io/dgraph/graphql/schema"
+	"github.com/dgraph-io/dgraph/protos/pb"
+)
+
+type storedQueryInput struct {
+	Name   string
+	Query  string
+	Groups []string
+}
+
+type updateStoredQueriesInput struct {
+	Queries []storedQueryInput
+}
+
+func resolveUpdateStoredQueries(ctx context.Context, m schema.Mutation) (*resolve.Resolved, bool) {
+	input, err := getUpdateStoredQueriesInput(m)
+	if err != nil {
+		return resolve.EmptyResult(m, err), false
+	}
+
+	queries := make([]*pb.StoredQuery, 0, len(input.Queries))
+	for _, sq := range input.Queries {
+		queries = append(queries, &pb.StoredQuery{
+			Name:   sq.Name,
+			Query:  sq.Query,
+			Groups: sq.Groups,
+		})
+	}
+	if err := edgraph.UpdateStoredQueries(ctx, queries); err != nil {
+		return resolve.EmptyResult(m, err), false
+	}
+
+	return resolve.DataResult(
+		m,
+		map[string]interface{}{m.Name(): response("Success",
+			fmt.Sprintf("Updated %d stored queries", len(queries)))},
+		nil,
+	), true
+}
+
+func resolveGetStoredQueries(ctx context.Context, q schema.Query) *resolve.Resolved {
+	queries, err := edgraph.GetStoredQueries(ctx)
+	if err != nil {
+		return resolve.EmptyResult(q, err)
+	}
+
+	res := make([]interface{}, 0, len(queries))
+	for _, sq := range queries {
+		groups := make([]interface{}, 0, len(sq.Groups))
+		for _, group := range sq.Groups {
+			groups = append(groups, group)
+		}
+		res = append(res, map[string]interface{}{
+			"name":    sq.Name,
+			"query":   sq.Query,
+			"groups":  groups,
+			"version": json.Number(strconv.FormatUint(sq.Version, 10)),
+		})
+	}
+	return resolve.DataResult(q, map[string]interface{}{q.Name(): res}, nil)
+}
+
+func getUpdateStoredQueriesInput(m schema.Mutation) (*updateStoredQueriesInput, error) {
+	inputArg := m.ArgValue(schema.InputArgName)
+	inputBytes, err := json.Marshal(inputArg)
+	if err != nil {
+		return nil, inputArgError(err)
+	}
+
+	var input updateStoredQueriesInput
+	if err := json.Unmarshal(inputBytes, &input); err != nil {
+		return nil, inputArgError(err)
+	}
+	return &input, nil
+}
@@ -0,0 +1,95 @@
+/*
+ * Copyright 2022 Dgraph Labs, Inc. and Contributors
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package admin
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"strconv"
+
+	"github.com/dgraph-io/dgraph/edgraph"
+	"github.com/dgraph-io/dgraph/graphql/resolve"
+	"github.com/dgraph-io/dgraph/graphql/schema"
+	"github.com/dgraph-io/dgraph/protos/pb"
+)
+
+type synonymSetInput struct {
+	Name  string
+	Words []string
+}
+
+type updateSynonymsInput struct {
+	Sets []synonymSetInput
+}
+
+func resolveUpdateSynonyms(ctx context.Context, m schema.Mutation) (*resolve.Resolved, bool) {
+	input, err := getUpdateSynonymsInput(m)
+	if err != nil {
+		return resolve.EmptyResult(m, err), false
+	}
+
+	sets := make([]*pb.SynonymUpdate, 0, len(input.Sets))
+	for _, set := range input.Sets {
+		sets = append(sets, &pb.SynonymUpdate{Name: set.Name, Words: set.Words})
+	}
+	if err := edgraph.UpdateSynonyms(ctx, sets); err != nil {
+		return resolve.EmptyResult(m, err), false
+	}
+
+	return resolve.DataResult(
+		m,
+		map[string]interface{}{m.Name(): response("Success",
+			fmt.Sprintf("Updated %d synonym sets", len(sets)))},
+		nil,
+	), true
+}
+
+func resolveGetSynonyms(ctx context.Context, q schema.Query) *resolve.Resolved {
+	sets, err := edgraph.GetSynonyms(ctx)
+	if err != nil {
+		return resolve.EmptyResult(q, err)
+	}
+
+	res := make([]interface{}, 0, len(sets))
+	for _, set := range sets {
+		words := make([]interface{}, 0, len(set.Words))
+		for _, word := range set.Words {
+			words = append(words, word)
+		}
+		res = append(res, map[string]interface{}{
+			"name":    set.Name,
+			"words":   words,
+			"version": json.Number(strconv.FormatUint(set.Version, 10)),
+		})
+	}
+	return resolve.DataResult(q, map[string]interface{}{q.Name(): res}, nil)
+}
+
+func getUpdateSynonymsInput(m schema.Mutation) (*updateSynonymsInput, error) {
+	inputArg := m.ArgValue(schema.InputArgName)
+	inputBytes, err := json.Marshal(inputArg)
+	if err != nil {
+		return nil, inputArgError(err)
+	}
+
+	var input updateSynonymsInput
+	if err := json.Unmarshal(inputBytes, &input); err != nil {
+		return nil, inputArgError(err)
+	}
+	return &input, nil
+}
@@ -36,18 +36,10 @@ type taskInput struct {
 
 func resolveTask(ctx context.Context, q schema.Query) *resolve.Resolved {
 	// Get Task ID.
-	input, err := getTaskInput(q)
+	taskId, err := getTaskId(q.ArgValue(schema.InputArgName))
 	if err != nil {
 		return resolve.EmptyResult(q, err)
 	}
-	if input.Id == "" {
-		return resolve.EmptyResult(q, fmt.Errorf("task ID is missing"))
-	}
-	taskId, err := strconv.ParseUint(input.Id, 0, 64)
-	if err != nil {
-		err = errors.Wrapf(err, "invalid task ID: %s", input.Id)
-		return resolve.EmptyResult(q, err)
-	}
 
 	// Get TaskMeta from network.
 	req := &pb.TaskStatusRequest{TaskId: taskId}
@@ -62,21 +54,46 @@ func resolveTask(ctx context.Context, q schema.Query) *resolve.Resolved {
 			"kind":        meta.Kind().String(),
 			"status":      meta.Status().String(),
 			"lastUpdated": meta.Timestamp().Format(time.RFC3339),
+			"progress":    json.Number(strconv.FormatUint(resp.GetProgress(), 10)),
 		}},
 		nil,
 	)
 }
 
-func getTaskInput(q schema.Query) (*taskInput, error) {
-	inputArg := q.ArgValue(schema.InputArgName)
+func resolveCancelTask(ctx context.Context, m schema.Mutation) (*resolve.Resolved, bool) {
+	taskId, err := getTaskId(m.ArgValue(schema.InputArgName))
+	if err != nil {
+		return resolve.EmptyResult(m, err), false
+	}
+
+	req := &pb.TaskStatusRequest{TaskId: taskId, Cancel: true}
+	if _, err := worker.TaskStatusOverNetwork(context.Background(), req); err != nil {
+		return resolve.EmptyResult(m, err), false
+	}
+	return resolve.DataResult(
+		m,
+		map[string]interface{}{m.Name(): response("Success",
+			fmt.Sprintf("Cancelled task %#x", taskId))},
+		nil,
+	), true
+}
+
+func getTaskId(inputArg interface{}) (uint64, error) {
 	inputBytes, err := json.Marshal(inputArg)
 	if err != nil {
-		return nil, schema.GQLWrapf(err, "couldn't get input argument")
+		return 0, schema.GQLWrapf(err, "couldn't get input argument")
 	}
 
 	var input taskInput
 	if err := json.Unmarshal(inputBytes, &input); err != nil {
-		return nil, schema.GQLWrapf(err, "couldn't get input argument")
+		return 0, schema.GQLWrapf(err, "couldn't get input argument")
+	}
+	if input.Id == "" {
+		return 0, fmt.Errorf("task ID is missing")
+	}
+	taskId, err := strconv.ParseUint(input.Id, 0, 64)
+	if err != nil {
+		return 0, errors.Wrapf(err, "invalid task ID: %s", input.Id)
 	}
-	return &input, nil
+	return taskId, nil
 }
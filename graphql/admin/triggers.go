@@ -0,0 +1,114 @@
+/*
+ * Copyright 2022 Dgraph Labs, Inc. and Contributors
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package admin
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"strconv"
+
+	"github.com/dgraph-io/dgraph/edgraph"
+	"github.com/dgraph-io/dgraph/graphql/resolve"
+	"github.com/dgraph-io/dgraph/graphql/schema"
+	"github.com/dgraph-io/dgraph/protos/pb"
+)
+
+type triggerInput struct {
+	Name      string
+	Predicate string
+	Type      string
+	Url       string
+	Phase     string
+}
+
+type updateTriggersInput struct {
+	Triggers []triggerInput
+}
+
+func resolveUpdateTriggers(ctx context.Context, m schema.Mutation) (*resolve.Resolved, bool) {
+	input, err := getUpdateTriggersInput(m)
+	if err != nil {
+		return resolve.EmptyResult(m, err), false
+	}
+
+	triggers := make([]*pb.Trigger, 0, len(input.Triggers))
+	for _, tr := range input.Triggers {
+		triggers = append(triggers, &pb.Trigger{
+			Name:         tr.Name,
+			Predicate:    tr.Predicate,
+			TypeName:     tr.Type,
+			Url:          tr.Url,
+			BeforeCommit: tr.Phase == "BEFORE_COMMIT",
+		})
+	}
+	if err := edgraph.UpdateTriggers(ctx, triggers); err != nil {
+		return resolve.EmptyResult(m, err), false
+	}
+
+	return resolve.DataResult(
+		m,
+		map[string]interface{}{m.Name(): response("Success",
+			fmt.Sprintf("Updated %d triggers", len(triggers)))},
+		nil,
+	), true
+}
+
+func resolveGetTriggers(ctx context.Context, q schema.Query) *resolve.Resolved {
+	triggers, err := edgraph.GetTriggers(ctx)
+	if err != nil {
+		return resolve.EmptyResult(q, err)
+	}
+
+	res := make([]interface{}, 0, len(triggers))
+	for _, tr := range triggers {
+		var predicate, typeName interface{}
+		if tr.Predicate != "" {
+			predicate = tr.Predicate
+		}
+		if tr.TypeName != "" {
+			typeName = tr.TypeName
+		}
+		phase := "AFTER_COMMIT"
+		if tr.BeforeCommit {
+			phase = "BEFORE_COMMIT"
+		}
+		res = append(res, map[string]interface{}{
+			"name":      tr.Name,
+			"predicate": predicate,
+			"type":      typeName,
+			"url":       tr.Url,
+			"phase":     phase,
+			"version":   json.Number(strconv.FormatUint(tr.Version, 10)),
+		})
+	}
+	return resolve.DataResult(q, map[string]interface{}{q.Name(): res}, nil)
+}
+
+func getUpdateTriggersInput(m schema.Mutation) (*updateTriggersInput, error) {
+	inputArg := m.ArgValue(schema.InputArgName)
+	inputBytes, err := json.Marshal(inputArg)
+	if err != nil {
+		return nil, inputArgError(err)
+	}
+
+	var input updateTriggersInput
+	if err := json.Unmarshal(inputBytes, &input); err != nil {
+		return nil, inputArgError(err)
+	}
+	return &input, nil
+}
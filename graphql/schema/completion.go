@@ -190,7 +190,7 @@ func CompleteValue(
 	switch val := val.(type) {
 	case map[string]interface{}:
 		switch field.Type().Name() {
-		case "String", "ID", "Boolean", "Float", "Int", "Int64", "DateTime":
+		case "String", "ID", "Boolean", "Float", "Int", "Int64", "Decimal", "DateTime":
 			return nil, x.GqlErrorList{field.GqlErrorf(path, ErrExpectedScalar)}
 		}
 		enumValues := field.EnumValues()
@@ -429,6 +429,23 @@ func coerceScalar(val interface{}, field Field, path []interface{}) (interface{}
 		default:
 			return nil, valueCoercionError(v)
 		}
+	case "Decimal":
+		switch v := val.(type) {
+		case string:
+			d, err := dgTypes.ParseDecimal(v)
+			if err != nil {
+				return nil, valueCoercionError(v)
+			}
+			val = d.String()
+		case json.Number:
+			d, err := dgTypes.ParseDecimal(v.String())
+			if err != nil {
+				return nil, valueCoercionError(v)
+			}
+			val = d.String()
+		default:
+			return nil, valueCoercionError(v)
+		}
 	// UInt64 is present only in admin schema.
 	case "UInt64":
 		switch v := val.(type) {
@@ -113,6 +113,13 @@ Int64 can represent values in range [-(2^63),(2^63 - 1)].
 """
 scalar Int64
 
+"""
+The Decimal scalar type represents an arbitrary-precision decimal number, like "1234.5678".
+Decimal values are returned as strings so that no digits are lost, and can be given either as
+strings or as numbers.
+"""
+scalar Decimal
+
 """
 The DateTime scalar type represents date and time as a string in RFC3339 format.
 For example: "1985-04-12T23:20:50.52Z" represents 20 mins 50.52 secs after the 23rd hour of Apr 12th 1985 in UTC.
@@ -134,6 +141,11 @@ input Int64Range{
 	max: Int64!
 }
 
+input DecimalRange{
+	min: Decimal!
+	max: Decimal!
+}
+
 input DateTimeRange{
 	min: DateTime!
 	max: DateTime!
@@ -336,6 +348,16 @@ input Int64Filter {
 	between: Int64Range
 }
 
+input DecimalFilter {
+	eq: Decimal
+	in: [Decimal]
+	le: Decimal
+	lt: Decimal
+	ge: Decimal
+	gt: Decimal
+	between: DecimalRange
+}
+
 input FloatFilter {
 	eq: Float
 	in: [Float]
@@ -443,6 +465,7 @@ var supportedSearches = map[string]searchTypeIndex{
 	"int":          {"Int", "int"},
 	"int64":        {"Int64", "int"},
 	"float":        {"Float", "float"},
+	"decimal":      {"Decimal", "decimal"},
 	"bool":         {"Boolean", "bool"},
 	"hash":         {"String", "hash"},
 	"exact":        {"String", "exact"},
@@ -466,6 +489,7 @@ var defaultSearches = map[string]string{
 	"Int":          "int",
 	"Int64":        "int64",
 	"Float":        "float",
+	"Decimal":      "decimal",
 	"String":       "term",
 	"DateTime":     "year",
 	"Point":        "point",
@@ -494,15 +518,17 @@ var orderable = map[string]bool{
 	"Int":      true,
 	"Int64":    true,
 	"Float":    true,
+	"Decimal":  true,
 	"String":   true,
 	"DateTime": true,
 }
 
 // GraphQL types that can be summed. Types that have a well defined addition function.
 var summable = map[string]bool{
-	"Int":   true,
-	"Int64": true,
-	"Float": true,
+	"Int":     true,
+	"Int64":   true,
+	"Float":   true,
+	"Decimal": true,
 }
 
 var enumDirectives = map[string]bool{
@@ -518,6 +544,7 @@ var builtInFilters = map[string]string{
 	"int":          "IntFilter",
 	"int64":        "Int64Filter",
 	"float":        "FloatFilter",
+	"decimal":      "DecimalFilter",
 	"year":         "DateTimeFilter",
 	"month":        "DateTimeFilter",
 	"day":          "DateTimeFilter",
@@ -540,6 +567,7 @@ var inbuiltTypeToDgraph = map[string]string{
 	"Int":          "int",
 	"Int64":        "int",
 	"Float":        "float",
+	"Decimal":      "decimal",
 	"String":       "string",
 	"DateTime":     "dateTime",
 	"Password":     "password",
@@ -270,6 +270,7 @@ func typeNameValidation(schema *ast.SchemaDocument) gqlerror.List {
 	forbiddenTypeNames := map[string]bool{
 		// The static types that we define in schemaExtras
 		"Int64":                true,
+		"Decimal":              true,
 		"DateTime":             true,
 		"DgraphIndex":          true,
 		"AuthRule":             true,
@@ -278,6 +279,8 @@ func typeNameValidation(schema *ast.SchemaDocument) gqlerror.List {
 		"CustomHTTP":           true,
 		"IntFilter":            true,
 		"Int64Filter":          true,
+		"DecimalFilter":        true,
+		"DecimalRange":         true,
 		"FloatFilter":          true,
 		"DateTimeFilter":       true,
 		"StringTermFilter":     true,
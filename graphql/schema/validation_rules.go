@@ -21,6 +21,7 @@ import (
 	"fmt"
 	"strconv"
 
+	dgTypes "github.com/dgraph-io/dgraph/types"
 	"github.com/dgraph-io/dgraph/x"
 	"github.com/dgraph-io/gqlparser/v2/ast"
 	"github.com/dgraph-io/gqlparser/v2/gqlerror"
@@ -29,7 +30,7 @@ import (
 
 var allowedFilters = []string{"StringHashFilter", "StringExactFilter", "StringFullTextFilter",
 	"StringRegExpFilter", "StringTermFilter", "DateTimeFilter", "FloatFilter", "Int64Filter",
-	"IntFilter", "PointGeoFilter", "ContainsFilter", "IntersectsFilter", "PolygonGeoFilter"}
+	"DecimalFilter", "IntFilter", "PointGeoFilter", "ContainsFilter", "IntersectsFilter", "PolygonGeoFilter"}
 
 func listInputCoercion(observers *validator.Events, addError validator.AddErrFunc) {
 	observers.OnValue(func(walker *validator.Walker, value *ast.Value) {
@@ -188,6 +189,19 @@ func intRangeCheck(observers *validator.Events, addError validator.AddErrFunc) {
 				addError(validator.Message("Type mismatched for Value `%s`, expected: Int64, got: '%s'", value.Raw,
 					valueKindToString(value.Kind)), validator.At(value.Position))
 			}
+		case "Decimal":
+			switch value.Kind {
+			case ast.IntValue, ast.FloatValue, ast.StringValue:
+				if _, err := dgTypes.ParseDecimal(value.Raw); err != nil {
+					addError(validator.Message("%s", err), validator.At(value.Position))
+				}
+				// Decimal values are propagated as strings, so that no digits are lost.
+				value.Kind = ast.StringValue
+			default:
+				addError(validator.Message("Type mismatched for Value `%s`, expected: Decimal, "+
+					"got: '%s'", value.Raw,
+					valueKindToString(value.Kind)), validator.At(value.Position))
+			}
 		case "UInt64":
 			// UInt64 exists only in admin schema
 			if value.Kind == ast.IntValue || value.Kind == ast.StringValue {
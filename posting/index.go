@@ -24,6 +24,7 @@ import (
 	"io/ioutil"
 	"math"
 	"os"
+	"sync"
 	"sync/atomic"
 	"time"
 
@@ -455,13 +456,29 @@ func (txn *Txn) addMutationHelper(ctx context.Context, l *List, doUpdateIndex bo
 }
 
 // AddMutationWithIndex is addMutation with support for indexing. It also
-// supports reverse edges.
+// supports reverse edges and the positions of @ordered lists.
 func (l *List) AddMutationWithIndex(ctx context.Context, edge *pb.DirectedEdge, txn *Txn) error {
 	if edge.Attr == "" {
 		return errors.Errorf("Predicate cannot be empty for edge with subject: [%v], object: [%v]"+
 			" and value: [%v]", edge.Entity, edge.ValueId, edge.Value)
 	}
 
+	if schema.State().IsOrdered(ctx, edge.Attr) {
+		edges, err := txn.orderedListEdges(l, edge)
+		if err != nil {
+			return err
+		}
+		for _, e := range edges {
+			if err := l.addMutationWithIndex(ctx, e, txn); err != nil {
+				return err
+			}
+		}
+		return nil
+	}
+	return l.addMutationWithIndex(ctx, edge, txn)
+}
+
+func (l *List) addMutationWithIndex(ctx context.Context, edge *pb.DirectedEdge, txn *Txn) error {
 	if edge.Op == pb.DirectedEdge_DEL && string(edge.Value) == x.Star {
 		return l.handleDeleteAll(ctx, edge, txn)
 	}
@@ -531,13 +548,19 @@ func prefixesToDeleteTokensFor(attr, tokenizerName string, hasLang bool) ([][]by
 		// It will be same for all the language.
 		tokenizer = tok.GetTokenizerForLang(tokenizer, "en")
 	}
-	prefix = append(prefix, tokenizer.Identifier())
+	tokPrefix := []byte{tokenizer.Identifier()}
+	if t, ok := tokenizer.(tok.JSONPathTokenizer); ok {
+		// The jsonpath indexes of a predicate share the same identifier, only the tokens of
+		// the path are deleted.
+		tokPrefix = append(tokPrefix, t.TokenPrefix()...)
+	}
+	prefix = append(prefix, tokPrefix...)
 	prefixes = append(prefixes, prefix)
 	// All the parts of any list that has been split into multiple parts.
 	// Such keys have a different prefix (the last byte is set to 1).
 	prefix = pk.IndexPrefix()
 	prefix[0] = x.ByteSplit
-	prefix = append(prefix, tokenizer.Identifier())
+	prefix = append(prefix, tokPrefix...)
 	prefixes = append(prefixes, prefix)
 
 	return prefixes, nil
@@ -552,6 +575,62 @@ type rebuilder struct {
 	// The posting list passed here is the on disk version. It is not coming
 	// from the LRU cache.
 	fn func(uid uint64, pl *List, txn *Txn) error
+	// progress, when set, is called with the number of posting lists read so far and the
+	// total number of posting lists to read.
+	progress func(done, total uint64)
+}
+
+// reindexThrottle limits the rate at which the rebuilders read and write posting lists, as set
+// by the reindex-rate limit option, so that rebuilding an index leaves I/O for the live traffic.
+var reindexThrottle rateLimiter
+
+type rateLimiter struct {
+	sync.Mutex
+	next time.Time
+}
+
+// wait blocks until the next posting list can be processed at the given rate per second. A rate
+// of zero means that there is no limit.
+func (l *rateLimiter) wait(ctx context.Context, rate int64) error {
+	if rate <= 0 {
+		return nil
+	}
+	l.Lock()
+	now := time.Now()
+	if l.next.Before(now) {
+		l.next = now
+	}
+	at := l.next
+	l.next = l.next.Add(time.Second / time.Duration(rate))
+	l.Unlock()
+
+	d := time.Until(at)
+	if d <= 0 {
+		return nil
+	}
+	select {
+	case <-ctx.Done():
+		return ctx.Err()
+	case <-time.After(d):
+		return nil
+	}
+}
+
+// countKeys returns the number of keys with the given prefix, as read at readTs.
+func countKeys(prefix []byte, readTs uint64) uint64 {
+	txn := pstore.NewTransactionAt(readTs, false)
+	defer txn.Discard()
+	iopt := badger.DefaultIteratorOptions
+	iopt.PrefetchValues = false
+	iopt.Prefix = prefix
+	itr := txn.NewIterator(iopt)
+	defer itr.Close()
+
+	var count uint64
+	for itr.Rewind(); itr.Valid(); itr.Next() {
+		count++
+	}
+	return count
 }
 
 func (r *rebuilder) Run(ctx context.Context) error {
@@ -597,6 +676,11 @@ func (r *rebuilder) Run(ctx context.Context) error {
 	// We set it to 1 in case there are no keys found and NewStreamAt is called with ts=0.
 	var counter uint64 = 1
 
+	var done, total uint64
+	if r.progress != nil {
+		total = countKeys(r.prefix, r.startTs)
+	}
+
 	tmpWriter := tmpDB.NewManagedWriteBatch()
 	stream := pstore.NewStreamAt(r.startTs)
 	stream.LogPrefix = fmt.Sprintf("Rebuilding index for predicate %s (1/2):",
@@ -609,6 +693,9 @@ func (r *rebuilder) Run(ctx context.Context) error {
 			return nil, ctx.Err()
 		default:
 		}
+		if err := reindexThrottle.wait(ctx, x.Config.LimitReindexRate); err != nil {
+			return nil, err
+		}
 
 		pk, err := x.Parse(key)
 		if err != nil {
@@ -627,6 +714,10 @@ func (r *rebuilder) Run(ctx context.Context) error {
 		if err := r.fn(pk.Uid, l, txn); err != nil {
 			return nil, err
 		}
+		// Report the progress every thousand posting lists, to keep the overhead low.
+		if n := atomic.AddUint64(&done, 1); r.progress != nil && (n%1000 == 0 || n == total) {
+			r.progress(n, total)
+		}
 
 		// Convert data into deltas.
 		txn.Update()
@@ -678,6 +769,9 @@ func (r *rebuilder) Run(ctx context.Context) error {
 	tmpStream.LogPrefix = fmt.Sprintf("Rebuilding index for predicate %s (2/2):",
 		x.FormatNsAttr(r.attr))
 	tmpStream.KeyToList = func(key []byte, itr *badger.Iterator) (*bpb.KVList, error) {
+		if err := reindexThrottle.wait(ctx, x.Config.LimitReindexRate); err != nil {
+			return nil, err
+		}
 		l, err := ReadPostingList(key, itr)
 		if err != nil {
 			return nil, errors.Wrap(err, "error in reading posting list from pstore")
@@ -730,6 +824,11 @@ type IndexRebuild struct {
 	StartTs       uint64
 	OldSchema     *pb.SchemaUpdate
 	CurrentSchema *pb.SchemaUpdate
+	// Progress, when set, is called by BuildIndexes with the percentage of the rebuild done.
+	Progress func(percent uint64)
+
+	// passes is the number of rebuilder runs made by BuildIndexes, and pass the current one.
+	passes, pass uint64
 }
 
 type indexOp int
@@ -799,6 +898,19 @@ func (rb *IndexRebuild) NeedIndexRebuild() bool {
 
 // BuildIndexes builds indexes.
 func (rb *IndexRebuild) BuildIndexes(ctx context.Context) error {
+	rb.pass, rb.passes = 0, 0
+	if info := rb.needsTokIndexRebuild(); info.op == indexRebuild &&
+		len(info.tokenizersToRebuild) > 0 {
+		rb.passes++
+	}
+	if rb.needsReverseEdgesRebuild() == indexRebuild {
+		rb.passes++
+	}
+	if rb.needsCountIndexRebuild() == indexRebuild {
+		// The count index is built from both the data and the reverse edges.
+		rb.passes += 2
+	}
+
 	if err := rebuildTokIndex(ctx, rb); err != nil {
 		return err
 	}
@@ -808,6 +920,22 @@ func (rb *IndexRebuild) BuildIndexes(ctx context.Context) error {
 	return rebuildCountIndex(ctx, rb)
 }
 
+// nextPass returns the progress function of the next rebuilder run, which reports the progress
+// of that run as its share of the whole rebuild.
+func (rb *IndexRebuild) nextPass() func(done, total uint64) {
+	if rb.Progress == nil || rb.passes == 0 {
+		return nil
+	}
+	pass, passes := rb.pass, rb.passes
+	rb.pass++
+	return func(done, total uint64) {
+		if total == 0 || done > total {
+			done, total = 1, 1
+		}
+		rb.Progress((pass*100 + done*100/total) / passes)
+	}
+}
+
 type indexRebuildInfo struct {
 	op                  indexOp
 	tokenizersToDelete  []string
@@ -951,7 +1079,8 @@ func rebuildTokIndex(ctx context.Context, rb *IndexRebuild) error {
 	}
 
 	pk := x.ParsedKey{Attr: rb.Attr}
-	builder := rebuilder{attr: rb.Attr, prefix: pk.DataPrefix(), startTs: rb.StartTs}
+	builder := rebuilder{attr: rb.Attr, prefix: pk.DataPrefix(), startTs: rb.StartTs,
+		progress: rb.nextPass()}
 	builder.fn = func(uid uint64, pl *List, txn *Txn) error {
 		edge := pb.DirectedEdge{Attr: rb.Attr, Entity: uid}
 		return pl.Iterate(txn.StartTs, 0, func(p *pb.Posting) error {
@@ -1064,7 +1193,8 @@ func rebuildCountIndex(ctx context.Context, rb *IndexRebuild) error {
 
 	// Create the forward index.
 	pk := x.ParsedKey{Attr: rb.Attr}
-	builder := rebuilder{attr: rb.Attr, prefix: pk.DataPrefix(), startTs: rb.StartTs}
+	builder := rebuilder{attr: rb.Attr, prefix: pk.DataPrefix(), startTs: rb.StartTs,
+		progress: rb.nextPass()}
 	builder.fn = fn
 	if err := builder.Run(ctx); err != nil {
 		return err
@@ -1075,7 +1205,8 @@ func rebuildCountIndex(ctx context.Context, rb *IndexRebuild) error {
 	// to call builder.Run even if that's not the case as the reverse prefix
 	// will be empty.
 	reverse = true
-	builder = rebuilder{attr: rb.Attr, prefix: pk.ReversePrefix(), startTs: rb.StartTs}
+	builder = rebuilder{attr: rb.Attr, prefix: pk.ReversePrefix(), startTs: rb.StartTs,
+		progress: rb.nextPass()}
 	builder.fn = fn
 	return builder.Run(ctx)
 }
@@ -1134,7 +1265,8 @@ func rebuildReverseEdges(ctx context.Context, rb *IndexRebuild) error {
 
 	glog.Infof("Rebuilding reverse index for %s", rb.Attr)
 	pk := x.ParsedKey{Attr: rb.Attr}
-	builder := rebuilder{attr: rb.Attr, prefix: pk.DataPrefix(), startTs: rb.StartTs}
+	builder := rebuilder{attr: rb.Attr, prefix: pk.DataPrefix(), startTs: rb.StartTs,
+		progress: rb.nextPass()}
 	builder.fn = func(uid uint64, pl *List, txn *Txn) error {
 		edge := pb.DirectedEdge{Attr: rb.Attr, Entity: uid}
 		return pl.Iterate(txn.StartTs, 0, func(pp *pb.Posting) error {
@@ -24,11 +24,13 @@ import (
 	"time"
 
 	"github.com/dgraph-io/badger/v3"
+	"github.com/dgraph-io/dgo/v210/protos/api"
 	"github.com/stretchr/testify/require"
 
 	"github.com/dgraph-io/dgraph/protos/pb"
 	"github.com/dgraph-io/dgraph/schema"
 	"github.com/dgraph-io/dgraph/types"
+	"github.com/dgraph-io/dgraph/types/facets"
 	"github.com/dgraph-io/dgraph/x"
 )
 
@@ -309,6 +311,37 @@ func TestRebuildTokIndex(t *testing.T) {
 	require.EqualValues(t, 91, uids2[0])
 }
 
+func TestIndexRebuildProgress(t *testing.T) {
+	var percents []uint64
+	rb := IndexRebuild{passes: 2}
+	rb.Progress = func(percent uint64) { percents = append(percents, percent) }
+
+	first, second := rb.nextPass(), rb.nextPass()
+	first(50, 100)
+	first(100, 100)
+	second(0, 0)
+	require.Equal(t, []uint64{25, 50, 100}, percents)
+	require.Nil(t, (&IndexRebuild{passes: 2}).nextPass())
+}
+
+func TestRateLimiter(t *testing.T) {
+	var l rateLimiter
+	require.NoError(t, l.wait(context.Background(), 0))
+
+	start := time.Now()
+	for i := 0; i < 3; i++ {
+		require.NoError(t, l.wait(context.Background(), 20))
+	}
+	require.GreaterOrEqual(t, time.Since(start), 100*time.Millisecond)
+
+	// Only the waits which have to sleep are interrupted by the context.
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+	var l2 rateLimiter
+	require.NoError(t, l2.wait(ctx, 1))
+	require.Equal(t, context.Canceled, l2.wait(ctx, 1))
+}
+
 func TestRebuildTokIndexWithDeletion(t *testing.T) {
 	addEdgeToValue(t, x.GalaxyAttr("name2"), 91, "Michonne", uint64(1), uint64(2))
 	addEdgeToValue(t, x.GalaxyAttr("name2"), 92, "David", uint64(3), uint64(4))
@@ -539,3 +572,71 @@ func TestNeedsListTypeRebuild(t *testing.T) {
 	require.False(t, rebuild)
 	require.Error(t, err)
 }
+
+func orderedValues(t *testing.T, l *List, readTs uint64) []string {
+	posts, err := l.OrderedPostings(readTs)
+	require.NoError(t, err)
+	var vals []string
+	for _, p := range posts {
+		vals = append(vals, string(p.Value))
+	}
+	return vals
+}
+
+func TestOrderedList(t *testing.T) {
+	require.NoError(t, schema.ParseBytes([]byte("steps: [string] @ordered ."), 1))
+	attr := x.GalaxyAttr("steps")
+	l, err := getNew(x.DataKey(attr, 1), ps, math.MaxUint64)
+	require.NoError(t, err)
+
+	edge := func(val string, idx string) *pb.DirectedEdge {
+		e := &pb.DirectedEdge{
+			Value:  []byte(val),
+			Attr:   attr,
+			Entity: 1,
+		}
+		if idx != "" {
+			f, err := facets.FacetFor(x.ListIndexFacet, idx)
+			require.NoError(t, err)
+			e.Facets = []*api.Facet{f}
+		}
+		return e
+	}
+
+	ts := uint64(1)
+	mutate := func(e *pb.DirectedEdge, op uint32) {
+		addMutation(t, l, e, op, ts, ts+1, true)
+		ts += 2
+	}
+
+	// The values are appended in the order they are added.
+	mutate(edge("c", ""), Set)
+	mutate(edge("a", ""), Set)
+	mutate(edge("b", ""), Set)
+	require.Equal(t, []string{"c", "a", "b"}, orderedValues(t, l, ts))
+
+	// Inserting and moving values.
+	mutate(edge("d", "0"), Set)
+	require.Equal(t, []string{"d", "c", "a", "b"}, orderedValues(t, l, ts))
+	mutate(edge("b", "1"), Set)
+	require.Equal(t, []string{"d", "b", "c", "a"}, orderedValues(t, l, ts))
+
+	// Setting a value already in the list keeps it in place.
+	mutate(edge("d", ""), Set)
+	require.Equal(t, []string{"d", "b", "c", "a"}, orderedValues(t, l, ts))
+
+	// A position past the end of the list appends the value.
+	mutate(edge("e", "10"), Set)
+	require.Equal(t, []string{"d", "b", "c", "a", "e"}, orderedValues(t, l, ts))
+
+	// Deleting the value at a position, and a value.
+	mutate(edge(x.Star, "2"), Del)
+	require.Equal(t, []string{"d", "b", "a", "e"}, orderedValues(t, l, ts))
+	mutate(edge("d", ""), Del)
+	require.Equal(t, []string{"b", "a", "e"}, orderedValues(t, l, ts))
+
+	e := edge(x.Star, "3")
+	e.Op = pb.DirectedEdge_DEL
+	txn := Oracle().RegisterStartTs(ts)
+	require.Error(t, l.AddMutationWithIndex(context.Background(), e, txn))
+}
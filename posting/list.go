@@ -463,6 +463,11 @@ func GetConflictKey(pk x.ParsedKey, key []byte, t *pb.DirectedEdge) uint64 {
 		// that two users don't set the same email id.
 		conflictKey = getKey(key, 0)
 
+	case pk.IsData() && schema.State().IsOrdered(context.Background(), t.Attr):
+		// The positions of an ordered list depend on all of its values, so two transactions
+		// changing the same list conflict, like they would for a single value.
+		conflictKey = getKey(key, 0)
+
 	case pk.IsData() && schema.State().IsList(t.Attr):
 		// Data keys, irrespective of whether they are UID or values, should be judged based on
 		// whether they are lists or not. For UID, t.ValueId = UID. For value, t.ValueId =
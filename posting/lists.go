@@ -19,6 +19,7 @@ package posting
 import (
 	"context"
 	"fmt"
+	"sort"
 	"sync"
 	"time"
 
@@ -28,8 +29,10 @@ import (
 	"github.com/dgraph-io/dgo/v210/protos/api"
 	"github.com/dgraph-io/ristretto"
 	"github.com/dgraph-io/ristretto/z"
+	"github.com/dgryski/go-farm"
 
 	"github.com/dgraph-io/dgraph/protos/pb"
+	"github.com/dgraph-io/dgraph/schema"
 	"github.com/dgraph-io/dgraph/x"
 )
 
@@ -229,6 +232,34 @@ func (lc *LocalCache) UpdateDeltasAndDiscardLists() {
 	lc.plists = make(map[string]*List)
 }
 
+// deltaKeys returns the keys of the posting lists changed by the transaction, sorted.
+func (lc *LocalCache) deltaKeys() []string {
+	lc.RLock()
+	defer lc.RUnlock()
+	keys := make([]string, 0, len(lc.deltas))
+	for key := range lc.deltas {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+	return keys
+}
+
+// fillWrittenKeys adds the written conflict keys of the lists changed by the transaction and of
+// their predicates, which the serializable transactions reading them conflict with.
+func (lc *LocalCache) fillWrittenKeys(ctx *api.TxnContext) {
+	lc.RLock()
+	defer lc.RUnlock()
+	for key := range lc.deltas {
+		pk, err := x.Parse([]byte(key))
+		x.Check(err)
+		if len(pk.Attr) == 0 || schema.State().HasNoConflict(pk.Attr) {
+			continue
+		}
+		ctx.Keys = append(ctx.Keys, x.WrittenConflictKey(farm.Fingerprint64([]byte(key))),
+			x.WrittenConflictKey(PredicateFingerprint(pk.Attr)))
+	}
+}
+
 func (lc *LocalCache) fillPreds(ctx *api.TxnContext, gid uint32) {
 	lc.RLock()
 	defer lc.RUnlock()
@@ -20,7 +20,6 @@ import (
 	"bytes"
 	"encoding/hex"
 	"math"
-	"strconv"
 	"sync"
 	"sync/atomic"
 	"time"
@@ -31,6 +30,7 @@ import (
 	"github.com/dgraph-io/dgraph/protos/pb"
 	"github.com/dgraph-io/dgraph/x"
 	"github.com/dgraph-io/ristretto/z"
+	"github.com/dgryski/go-farm"
 	"github.com/golang/glog"
 	"github.com/golang/protobuf/proto"
 	"github.com/pkg/errors"
@@ -202,6 +202,12 @@ func (txn *Txn) addConflictKey(conflictKey uint64) {
 	}
 }
 
+// AddConflictKey makes the transaction conflict with the other transactions which add the same
+// conflict key, on top of the keys derived from its edges.
+func (txn *Txn) AddConflictKey(conflictKey uint64) {
+	txn.addConflictKey(conflictKey)
+}
+
 // FillContext updates the given transaction context with data from this transaction.
 func (txn *Txn) FillContext(ctx *api.TxnContext, gid uint32) {
 	txn.Lock()
@@ -211,16 +217,37 @@ func (txn *Txn) FillContext(ctx *api.TxnContext, gid uint32) {
 		// We don'txn need to send the whole conflict key to Zero. Solving #2338
 		// should be done by sending a list of mutating predicates to Zero,
 		// along with the keys to be used for conflict detection.
-		fps := strconv.FormatUint(key, 36)
-		ctx.Keys = append(ctx.Keys, fps)
+		ctx.Keys = append(ctx.Keys, x.ConflictKey(key))
 	}
-	ctx.Keys = x.Unique(ctx.Keys)
 
 	txn.Unlock()
 	txn.Update()
+	if x.WorkerConfig.SerializableEnabled {
+		txn.cache.fillWrittenKeys(ctx)
+	}
+	ctx.Keys = x.Unique(ctx.Keys)
 	txn.cache.fillPreds(ctx, gid)
 }
 
+// SetAtCommit records a key which isn't a posting list, like the tombstone of a deleted node, to
+// be written along with the deltas at the commit timestamp of the transaction. The key is dropped
+// if the transaction is aborted.
+func (txn *Txn) SetAtCommit(key, value []byte) {
+	txn.Lock()
+	defer txn.Unlock()
+	if txn.records == nil {
+		txn.records = make(map[string][]byte)
+	}
+	txn.records[string(key)] = value
+	txn.lastUpdate = time.Now()
+}
+
+// PredicateFingerprint returns the fingerprint standing for the whole predicate in the conflict
+// keys, which the serializable transactions scanning the predicate read.
+func PredicateFingerprint(attr string) uint64 {
+	return farm.Fingerprint64(x.PredicatePrefix(attr))
+}
+
 // CommitToDisk commits a transaction to disk.
 // This function only stores deltas to the commit timestamps. It does not try to generate a state.
 // State generation is done via rollups, which happen when a snapshot is created.
@@ -230,6 +257,10 @@ func (txn *Txn) CommitToDisk(writer *TxnWriter, commitTs uint64) error {
 		return nil
 	}
 
+	txn.Lock()
+	records := txn.records
+	txn.Unlock()
+
 	cache := txn.cache
 	cache.Lock()
 	defer cache.Unlock()
@@ -280,6 +311,12 @@ func (txn *Txn) CommitToDisk(writer *TxnWriter, commitTs uint64) error {
 			return err
 		}
 	}
+
+	for key, value := range records {
+		if err := writer.SetAt([]byte(key), value, BitSchemaPosting, commitTs); err != nil {
+			return err
+		}
+	}
 	return nil
 }
 
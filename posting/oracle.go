@@ -19,6 +19,7 @@ package posting
 import (
 	"context"
 	"math"
+	"sort"
 	"sync"
 	"sync/atomic"
 	"time"
@@ -60,6 +61,10 @@ type Txn struct {
 	// determine unhealthy, stale txns.
 	lastUpdate time.Time
 
+	// records keep the keys which aren't posting lists, but are written along with the deltas
+	// when the transaction commits.
+	records map[string][]byte
+
 	cache *LocalCache // This pointer does not get modified.
 }
 
@@ -170,6 +175,32 @@ func (o *oracle) TxnOlderThan(dur time.Duration) (res []uint64) {
 	return res
 }
 
+// PendingTxn describes a transaction with mutations which is neither committed nor aborted yet.
+type PendingTxn struct {
+	StartTs uint64
+	// LastUpdate is the time of the last mutation of the transaction.
+	LastUpdate time.Time
+	// Keys are the keys of the posting lists changed by the transaction.
+	Keys []string
+}
+
+// PendingTxns returns the transactions pending a commit or abort decision, sorted by start ts.
+func (o *oracle) PendingTxns() []PendingTxn {
+	o.RLock()
+	defer o.RUnlock()
+
+	res := make([]PendingTxn, 0, len(o.pendingTxns))
+	for startTs, txn := range o.pendingTxns {
+		res = append(res, PendingTxn{
+			StartTs:    startTs,
+			LastUpdate: txn.lastUpdate,
+			Keys:       txn.cache.deltaKeys(),
+		})
+	}
+	sort.Slice(res, func(i, j int) bool { return res[i].StartTs < res[j].StartTs })
+	return res
+}
+
 func (o *oracle) addToWaiters(startTs uint64) (chan struct{}, bool) {
 	if startTs <= o.MaxAssigned() {
 		return nil, false
@@ -0,0 +1,209 @@
+/*
+ * Copyright 2016-2022 Dgraph Labs, Inc. and Contributors
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package posting
+
+import (
+	"sort"
+
+	"github.com/dgraph-io/dgo/v210/protos/api"
+	"github.com/pkg/errors"
+
+	"github.com/dgraph-io/dgraph/protos/pb"
+	"github.com/dgraph-io/dgraph/types/facets"
+	"github.com/dgraph-io/dgraph/x"
+)
+
+// listIndex returns the position given by the x.ListIndexFacet facet of the edge, and removes the
+// facet from the edge.
+func listIndex(edge *pb.DirectedEdge) (int, bool, error) {
+	for i, f := range edge.Facets {
+		if f.Key != x.ListIndexFacet {
+			continue
+		}
+		edge.Facets = append(edge.Facets[:i:i], edge.Facets[i+1:]...)
+		val, err := facets.ValFor(f)
+		if err != nil {
+			return 0, false, err
+		}
+		idx, ok := val.Value.(int64)
+		if !ok {
+			return 0, false, errors.Errorf("Facet %s should be an int. Got: %v",
+				x.ListIndexFacet, val.Value)
+		}
+		return int(idx), true, nil
+	}
+	return 0, false, nil
+}
+
+// postingPosition returns the position stored in the posting of an @ordered list. The postings
+// added before the predicate was ordered don't have any, and come first.
+func postingPosition(p *pb.Posting) int64 {
+	for _, f := range p.Facets {
+		if f.Key != x.ListIndexFacet {
+			continue
+		}
+		if val, err := facets.ValFor(f); err == nil {
+			if pos, ok := val.Value.(int64); ok {
+				return pos
+			}
+		}
+	}
+	return 0
+}
+
+// withPosition returns the facets with the position of the posting set, keeping them sorted.
+func withPosition(fcs []*api.Facet, pos int64) ([]*api.Facet, error) {
+	f, err := facets.ToBinary(x.ListIndexFacet, pos, api.Facet_INT)
+	if err != nil {
+		return nil, err
+	}
+	res := make([]*api.Facet, 0, len(fcs)+1)
+	for _, fc := range fcs {
+		if fc.Key != x.ListIndexFacet {
+			res = append(res, fc)
+		}
+	}
+	res = append(res, f)
+	sort.Slice(res, func(i, j int) bool { return res[i].Key < res[j].Key })
+	return res, nil
+}
+
+// OrderedPostings returns the postings of an @ordered list sorted by their position.
+func (l *List) OrderedPostings(readTs uint64) ([]*pb.Posting, error) {
+	l.RLock()
+	defer l.RUnlock()
+
+	var posts []*pb.Posting
+	err := l.iterate(readTs, 0, func(p *pb.Posting) error {
+		posts = append(posts, p)
+		return nil
+	})
+	sort.SliceStable(posts, func(i, j int) bool {
+		return postingPosition(posts[i]) < postingPosition(posts[j])
+	})
+	return posts, err
+}
+
+// edgeForPosting returns an edge of the entity to set or delete the posting.
+func edgeForPosting(edge *pb.DirectedEdge, p *pb.Posting, op pb.DirectedEdge_Op) *pb.DirectedEdge {
+	e := &pb.DirectedEdge{
+		Entity:    edge.Entity,
+		Attr:      edge.Attr,
+		Lang:      string(p.LangTag),
+		Op:        op,
+		Facets:    p.Facets,
+		Namespace: edge.Namespace,
+	}
+	if p.PostingType == pb.Posting_REF {
+		e.ValueId = p.Uid
+		e.ValueType = pb.Posting_UID
+	} else {
+		e.Value = p.Value
+		e.ValueType = p.ValType
+	}
+	return e
+}
+
+// orderedListEdges returns the edges to apply to the @ordered list for the edge. A set edge
+// appends its value to the list, or moves it to the position given by the x.ListIndexFacet facet,
+// in which case the values after it are moved one position further. A position past the end of
+// the list appends the value. A star delete edge with the facet only deletes the value at that
+// position.
+func (txn *Txn) orderedListEdges(l *List, edge *pb.DirectedEdge) ([]*pb.DirectedEdge, error) {
+	idx, hasIdx, err := listIndex(edge)
+	if err != nil {
+		return nil, err
+	}
+	if edge.Op == pb.DirectedEdge_DEL && (!hasIdx || string(edge.Value) != x.Star) {
+		// The positions of the other values don't change when a value is deleted.
+		return []*pb.DirectedEdge{edge}, nil
+	}
+
+	posts, err := l.OrderedPostings(txn.StartTs)
+	if err != nil {
+		return nil, err
+	}
+	if edge.Op == pb.DirectedEdge_DEL {
+		if idx < 0 || idx >= len(posts) {
+			return nil, errors.Errorf("Index %d out of range for list %s of length %d",
+				idx, x.ParseAttr(edge.Attr), len(posts))
+		}
+		return []*pb.DirectedEdge{edgeForPosting(edge, posts[idx], pb.DirectedEdge_DEL)}, nil
+	}
+
+	uid := edge.ValueId
+	if edge.ValueType != pb.Posting_UID {
+		uid = fingerprintEdge(edge)
+	}
+	cur := -1
+	for i, p := range posts {
+		if p.Uid == uid {
+			cur = i
+			break
+		}
+	}
+
+	if !hasIdx {
+		// The value stays where it is if it's already in the list, and is appended otherwise.
+		var pos int64
+		switch {
+		case cur >= 0:
+			pos = postingPosition(posts[cur])
+		case len(posts) > 0:
+			pos = postingPosition(posts[len(posts)-1]) + 1
+		}
+		if edge.Facets, err = withPosition(edge.Facets, pos); err != nil {
+			return nil, err
+		}
+		return []*pb.DirectedEdge{edge}, nil
+	}
+
+	if cur >= 0 {
+		posts = append(posts[:cur:cur], posts[cur+1:]...)
+	}
+	if idx < 0 {
+		return nil, errors.Errorf("Index %d out of range for list %s of length %d",
+			idx, x.ParseAttr(edge.Attr), len(posts))
+	}
+	if idx > len(posts) {
+		idx = len(posts)
+	}
+
+	// Renumber the values from the inserted one onwards, keeping the positions before it.
+	var edges []*pb.DirectedEdge
+	pos := int64(idx)
+	if idx > 0 && postingPosition(posts[idx-1]) >= pos {
+		pos = postingPosition(posts[idx-1]) + 1
+	}
+	if edge.Facets, err = withPosition(edge.Facets, pos); err != nil {
+		return nil, err
+	}
+	edges = append(edges, edge)
+	for _, p := range posts[idx:] {
+		pos++
+		if postingPosition(p) >= pos {
+			// The values after this one are already further in the list.
+			break
+		}
+		e := edgeForPosting(edge, p, pb.DirectedEdge_SET)
+		if e.Facets, err = withPosition(p.Facets, pos); err != nil {
+			return nil, err
+		}
+		edges = append(edges, e)
+	}
+	return edges, nil
+}
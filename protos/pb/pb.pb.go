@@ -136,6 +136,10 @@ const (
 	Posting_PASSWORD Posting_ValType = 8
 	Posting_STRING   Posting_ValType = 9
 	Posting_OBJECT   Posting_ValType = 10
+	Posting_DECIMAL  Posting_ValType = 11
+	Posting_DURATION Posting_ValType = 12
+	Posting_INTERVAL Posting_ValType = 13
+	Posting_JSON     Posting_ValType = 14
 )
 
 var Posting_ValType_name = map[int32]string{
@@ -150,6 +154,10 @@ var Posting_ValType_name = map[int32]string{
 	8:  "PASSWORD",
 	9:  "STRING",
 	10: "OBJECT",
+	11: "DECIMAL",
+	12: "DURATION",
+	13: "INTERVAL",
+	14: "JSON",
 }
 
 var Posting_ValType_value = map[string]int32{
@@ -164,6 +172,10 @@ var Posting_ValType_value = map[string]int32{
 	"PASSWORD": 8,
 	"STRING":   9,
 	"OBJECT":   10,
+	"DECIMAL":  11,
+	"DURATION": 12,
+	"INTERVAL": 13,
+	"JSON":     14,
 }
 
 func (x Posting_ValType) String() string {
@@ -295,36 +307,48 @@ func (DropOperation_DropOp) EnumDescriptor() ([]byte, []int) {
 type BackupKey_KeyType int32
 
 const (
-	BackupKey_UNKNOWN   BackupKey_KeyType = 0
-	BackupKey_DATA      BackupKey_KeyType = 1
-	BackupKey_INDEX     BackupKey_KeyType = 2
-	BackupKey_REVERSE   BackupKey_KeyType = 3
-	BackupKey_COUNT     BackupKey_KeyType = 4
-	BackupKey_COUNT_REV BackupKey_KeyType = 5
-	BackupKey_SCHEMA    BackupKey_KeyType = 6
-	BackupKey_TYPE      BackupKey_KeyType = 7
+	BackupKey_UNKNOWN        BackupKey_KeyType = 0
+	BackupKey_DATA           BackupKey_KeyType = 1
+	BackupKey_INDEX          BackupKey_KeyType = 2
+	BackupKey_REVERSE        BackupKey_KeyType = 3
+	BackupKey_COUNT          BackupKey_KeyType = 4
+	BackupKey_COUNT_REV      BackupKey_KeyType = 5
+	BackupKey_SCHEMA         BackupKey_KeyType = 6
+	BackupKey_TYPE           BackupKey_KeyType = 7
+	BackupKey_SYNONYM        BackupKey_KeyType = 8
+	BackupKey_SCHEMA_VERSION BackupKey_KeyType = 9
+	BackupKey_TRIGGER        BackupKey_KeyType = 10
+	BackupKey_STORED_QUERY   BackupKey_KeyType = 11
 )
 
 var BackupKey_KeyType_name = map[int32]string{
-	0: "UNKNOWN",
-	1: "DATA",
-	2: "INDEX",
-	3: "REVERSE",
-	4: "COUNT",
-	5: "COUNT_REV",
-	6: "SCHEMA",
-	7: "TYPE",
+	0:  "UNKNOWN",
+	1:  "DATA",
+	2:  "INDEX",
+	3:  "REVERSE",
+	4:  "COUNT",
+	5:  "COUNT_REV",
+	6:  "SCHEMA",
+	7:  "TYPE",
+	8:  "SYNONYM",
+	9:  "SCHEMA_VERSION",
+	10: "TRIGGER",
+	11: "STORED_QUERY",
 }
 
 var BackupKey_KeyType_value = map[string]int32{
-	"UNKNOWN":   0,
-	"DATA":      1,
-	"INDEX":     2,
-	"REVERSE":   3,
-	"COUNT":     4,
-	"COUNT_REV": 5,
-	"SCHEMA":    6,
-	"TYPE":      7,
+	"UNKNOWN":        0,
+	"DATA":           1,
+	"INDEX":          2,
+	"REVERSE":        3,
+	"COUNT":          4,
+	"COUNT_REV":      5,
+	"SCHEMA":         6,
+	"TYPE":           7,
+	"SYNONYM":        8,
+	"SCHEMA_VERSION": 9,
+	"TRIGGER":        10,
+	"STORED_QUERY":   11,
 }
 
 func (x BackupKey_KeyType) String() string {
@@ -432,9 +456,10 @@ func (m *TaskValue) GetValType() Posting_ValType {
 }
 
 type SrcFunction struct {
-	Name    string   `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
-	Args    []string `protobuf:"bytes,3,rep,name=args,proto3" json:"args,omitempty"`
-	IsCount bool     `protobuf:"varint,4,opt,name=isCount,proto3" json:"isCount,omitempty"`
+	Name     string   `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	Args     []string `protobuf:"bytes,3,rep,name=args,proto3" json:"args,omitempty"`
+	IsCount  bool     `protobuf:"varint,4,opt,name=isCount,proto3" json:"isCount,omitempty"`
+	JsonPath string   `protobuf:"bytes,5,opt,name=jsonPath,proto3" json:"jsonPath,omitempty"`
 }
 
 func (m *SrcFunction) Reset()         { *m = SrcFunction{} }
@@ -491,6 +516,13 @@ func (m *SrcFunction) GetIsCount() bool {
 	return false
 }
 
+func (m *SrcFunction) GetJsonPath() string {
+	if m != nil {
+		return m.JsonPath
+	}
+	return ""
+}
+
 type Query struct {
 	Attr     string   `protobuf:"bytes,1,opt,name=attr,proto3" json:"attr,omitempty"`
 	Langs    []string `protobuf:"bytes,2,rep,name=langs,proto3" json:"langs,omitempty"`
@@ -508,7 +540,8 @@ type Query struct {
 	Cache        int32        `protobuf:"varint,14,opt,name=cache,proto3" json:"cache,omitempty"`
 	First        int32        `protobuf:"varint,15,opt,name=first,proto3" json:"first,omitempty"`
 	// field. Now, It's been used only for has query.
-	Offset int32 `protobuf:"varint,16,opt,name=offset,proto3" json:"offset,omitempty"`
+	Offset     int32 `protobuf:"varint,16,opt,name=offset,proto3" json:"offset,omitempty"`
+	TrackReads bool  `protobuf:"varint,17,opt,name=track_reads,json=trackReads,proto3" json:"track_reads,omitempty"`
 }
 
 func (m *Query) Reset()         { *m = Query{} }
@@ -642,6 +675,13 @@ func (m *Query) GetOffset() int32 {
 	return 0
 }
 
+func (m *Query) GetTrackReads() bool {
+	if m != nil {
+		return m.TrackReads
+	}
+	return false
+}
+
 type ValueList struct {
 	Values []*TaskValue `protobuf:"bytes,1,rep,name=values,proto3" json:"values,omitempty"`
 }
@@ -738,6 +778,7 @@ type Result struct {
 	FacetMatrix   []*FacetsList `protobuf:"bytes,5,rep,name=facet_matrix,json=facetMatrix,proto3" json:"facet_matrix,omitempty"`
 	LangMatrix    []*LangList   `protobuf:"bytes,6,rep,name=lang_matrix,json=langMatrix,proto3" json:"lang_matrix,omitempty"`
 	List          bool          `protobuf:"varint,7,opt,name=list,proto3" json:"list,omitempty"`
+	ReadKeys      []string      `protobuf:"bytes,8,rep,name=read_keys,json=readKeys,proto3" json:"read_keys,omitempty"`
 }
 
 func (m *Result) Reset()         { *m = Result{} }
@@ -822,6 +863,13 @@ func (m *Result) GetList() bool {
 	return false
 }
 
+func (m *Result) GetReadKeys() []string {
+	if m != nil {
+		return m.ReadKeys
+	}
+	return nil
+}
+
 type Order struct {
 	Attr  string   `protobuf:"bytes,1,opt,name=attr,proto3" json:"attr,omitempty"`
 	Desc  bool     `protobuf:"varint,2,opt,name=desc,proto3" json:"desc,omitempty"`
@@ -1994,14 +2042,23 @@ func (m *DirectedEdge) GetNamespace() uint64 {
 }
 
 type Mutations struct {
-	GroupId   uint32           `protobuf:"varint,1,opt,name=group_id,json=groupId,proto3" json:"group_id,omitempty"`
-	StartTs   uint64           `protobuf:"varint,2,opt,name=start_ts,json=startTs,proto3" json:"start_ts,omitempty"`
-	Edges     []*DirectedEdge  `protobuf:"bytes,3,rep,name=edges,proto3" json:"edges,omitempty"`
-	Schema    []*SchemaUpdate  `protobuf:"bytes,4,rep,name=schema,proto3" json:"schema,omitempty"`
-	Types     []*TypeUpdate    `protobuf:"bytes,6,rep,name=types,proto3" json:"types,omitempty"`
-	DropOp    Mutations_DropOp `protobuf:"varint,7,opt,name=drop_op,json=dropOp,proto3,enum=pb.Mutations_DropOp" json:"drop_op,omitempty"`
-	DropValue string           `protobuf:"bytes,8,opt,name=drop_value,json=dropValue,proto3" json:"drop_value,omitempty"`
-	Metadata  *Metadata        `protobuf:"bytes,9,opt,name=metadata,proto3" json:"metadata,omitempty"`
+	GroupId        uint32           `protobuf:"varint,1,opt,name=group_id,json=groupId,proto3" json:"group_id,omitempty"`
+	StartTs        uint64           `protobuf:"varint,2,opt,name=start_ts,json=startTs,proto3" json:"start_ts,omitempty"`
+	Edges          []*DirectedEdge  `protobuf:"bytes,3,rep,name=edges,proto3" json:"edges,omitempty"`
+	Schema         []*SchemaUpdate  `protobuf:"bytes,4,rep,name=schema,proto3" json:"schema,omitempty"`
+	Types          []*TypeUpdate    `protobuf:"bytes,6,rep,name=types,proto3" json:"types,omitempty"`
+	DropOp         Mutations_DropOp `protobuf:"varint,7,opt,name=drop_op,json=dropOp,proto3,enum=pb.Mutations_DropOp" json:"drop_op,omitempty"`
+	DropValue      string           `protobuf:"bytes,8,opt,name=drop_value,json=dropValue,proto3" json:"drop_value,omitempty"`
+	Metadata       *Metadata        `protobuf:"bytes,9,opt,name=metadata,proto3" json:"metadata,omitempty"`
+	Synonyms       []*SynonymUpdate `protobuf:"bytes,10,rep,name=synonyms,proto3" json:"synonyms,omitempty"`
+	SchemaVersions []*SchemaVersion `protobuf:"bytes,11,rep,name=schema_versions,json=schemaVersions,proto3" json:"schema_versions,omitempty"`
+	Tombstones     []*Tombstone     `protobuf:"bytes,12,rep,name=tombstones,proto3" json:"tombstones,omitempty"`
+	Triggers       []*Trigger       `protobuf:"bytes,13,rep,name=triggers,proto3" json:"triggers,omitempty"`
+	StoredQueries  []*StoredQuery   `protobuf:"bytes,14,rep,name=stored_queries,json=storedQueries,proto3" json:"stored_queries,omitempty"`
+	CancelIndexing []string         `protobuf:"bytes,15,rep,name=cancel_indexing,json=cancelIndexing,proto3" json:"cancel_indexing,omitempty"`
+	IfVersion      uint64           `protobuf:"varint,16,opt,name=if_version,json=ifVersion,proto3" json:"if_version,omitempty"`
+	TriggerEvents  []*TriggerEvent  `protobuf:"bytes,17,rep,name=trigger_events,json=triggerEvents,proto3" json:"trigger_events,omitempty"`
+	TtlMarks       []*TTLMark       `protobuf:"bytes,18,rep,name=ttl_marks,json=ttlMarks,proto3" json:"ttl_marks,omitempty"`
 }
 
 func (m *Mutations) Reset()         { *m = Mutations{} }
@@ -2093,6 +2150,69 @@ func (m *Mutations) GetMetadata() *Metadata {
 	return nil
 }
 
+func (m *Mutations) GetSynonyms() []*SynonymUpdate {
+	if m != nil {
+		return m.Synonyms
+	}
+	return nil
+}
+
+func (m *Mutations) GetSchemaVersions() []*SchemaVersion {
+	if m != nil {
+		return m.SchemaVersions
+	}
+	return nil
+}
+
+func (m *Mutations) GetTombstones() []*Tombstone {
+	if m != nil {
+		return m.Tombstones
+	}
+	return nil
+}
+
+func (m *Mutations) GetTriggers() []*Trigger {
+	if m != nil {
+		return m.Triggers
+	}
+	return nil
+}
+
+func (m *Mutations) GetStoredQueries() []*StoredQuery {
+	if m != nil {
+		return m.StoredQueries
+	}
+	return nil
+}
+
+func (m *Mutations) GetCancelIndexing() []string {
+	if m != nil {
+		return m.CancelIndexing
+	}
+	return nil
+}
+
+func (m *Mutations) GetIfVersion() uint64 {
+	if m != nil {
+		return m.IfVersion
+	}
+	return 0
+}
+
+func (m *Mutations) GetTriggerEvents() []*TriggerEvent {
+	if m != nil {
+		return m.TriggerEvents
+	}
+	return nil
+}
+
+func (m *Mutations) GetTtlMarks() []*TTLMark {
+	if m != nil {
+		return m.TtlMarks
+	}
+	return nil
+}
+
 type Metadata struct {
 	// Map of predicates to their hints.
 	PredHints map[string]Metadata_HintType `protobuf:"bytes,1,rep,name=pred_hints,json=predHints,proto3" json:"pred_hints,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"varint,2,opt,name=value,proto3,enum=pb.Metadata_HintType"`
@@ -3395,6 +3515,8 @@ type SchemaNode struct {
 	Upsert     bool     `protobuf:"varint,8,opt,name=upsert,proto3" json:"upsert,omitempty"`
 	Lang       bool     `protobuf:"varint,9,opt,name=lang,proto3" json:"lang,omitempty"`
 	NoConflict bool     `protobuf:"varint,10,opt,name=no_conflict,json=noConflict,proto3" json:"no_conflict,omitempty"`
+	Ordered    bool     `protobuf:"varint,11,opt,name=ordered,proto3" json:"ordered,omitempty"`
+	Ttl        string   `protobuf:"bytes,12,opt,name=ttl,proto3" json:"ttl,omitempty"`
 }
 
 func (m *SchemaNode) Reset()         { *m = SchemaNode{} }
@@ -3500,6 +3622,20 @@ func (m *SchemaNode) GetNoConflict() bool {
 	return false
 }
 
+func (m *SchemaNode) GetOrdered() bool {
+	if m != nil {
+		return m.Ordered
+	}
+	return false
+}
+
+func (m *SchemaNode) GetTtl() string {
+	if m != nil {
+		return m.Ttl
+	}
+	return ""
+}
+
 type SchemaResult struct {
 	Schema []*SchemaNode `protobuf:"bytes,1,rep,name=schema,proto3" json:"schema,omitempty"` // Deprecated: Do not use.
 }
@@ -3561,6 +3697,8 @@ type SchemaUpdate struct {
 	// custom name. This field stores said name.
 	ObjectTypeName string `protobuf:"bytes,12,opt,name=object_type_name,json=objectTypeName,proto3" json:"object_type_name,omitempty"`
 	NoConflict     bool   `protobuf:"varint,13,opt,name=no_conflict,json=noConflict,proto3" json:"no_conflict,omitempty"`
+	Ordered        bool   `protobuf:"varint,14,opt,name=ordered,proto3" json:"ordered,omitempty"`
+	Ttl            int64  `protobuf:"varint,15,opt,name=ttl,proto3" json:"ttl,omitempty"`
 }
 
 func (m *SchemaUpdate) Reset()         { *m = SchemaUpdate{} }
@@ -3680,9 +3818,26 @@ func (m *SchemaUpdate) GetNoConflict() bool {
 	return false
 }
 
+func (m *SchemaUpdate) GetOrdered() bool {
+	if m != nil {
+		return m.Ordered
+	}
+	return false
+}
+
+func (m *SchemaUpdate) GetTtl() int64 {
+	if m != nil {
+		return m.Ttl
+	}
+	return 0
+}
+
 type TypeUpdate struct {
 	TypeName string          `protobuf:"bytes,1,opt,name=type_name,json=typeName,proto3" json:"type_name,omitempty"`
 	Fields   []*SchemaUpdate `protobuf:"bytes,2,rep,name=fields,proto3" json:"fields,omitempty"`
+	Strict   bool            `protobuf:"varint,3,opt,name=strict,proto3" json:"strict,omitempty"`
+	Extends  string          `protobuf:"bytes,4,opt,name=extends,proto3" json:"extends,omitempty"`
+	Ttl      int64           `protobuf:"varint,5,opt,name=ttl,proto3" json:"ttl,omitempty"`
 }
 
 func (m *TypeUpdate) Reset()         { *m = TypeUpdate{} }
@@ -3732,6 +3887,27 @@ func (m *TypeUpdate) GetFields() []*SchemaUpdate {
 	return nil
 }
 
+func (m *TypeUpdate) GetStrict() bool {
+	if m != nil {
+		return m.Strict
+	}
+	return false
+}
+
+func (m *TypeUpdate) GetExtends() string {
+	if m != nil {
+		return m.Extends
+	}
+	return ""
+}
+
+func (m *TypeUpdate) GetTtl() int64 {
+	if m != nil {
+		return m.Ttl
+	}
+	return 0
+}
+
 type MapHeader struct {
 	PartitionKeys [][]byte `protobuf:"bytes,1,rep,name=partition_keys,json=partitionKeys,proto3" json:"partition_keys,omitempty"`
 }
@@ -5492,6 +5668,7 @@ func (m *DeleteNsRequest) GetNamespace() uint64 {
 
 type TaskStatusRequest struct {
 	TaskId uint64 `protobuf:"varint,1,opt,name=task_id,json=taskId,proto3" json:"task_id,omitempty"`
+	Cancel bool   `protobuf:"varint,2,opt,name=cancel,proto3" json:"cancel,omitempty"`
 }
 
 func (m *TaskStatusRequest) Reset()         { *m = TaskStatusRequest{} }
@@ -5534,8 +5711,16 @@ func (m *TaskStatusRequest) GetTaskId() uint64 {
 	return 0
 }
 
+func (m *TaskStatusRequest) GetCancel() bool {
+	if m != nil {
+		return m.Cancel
+	}
+	return false
+}
+
 type TaskStatusResponse struct {
 	TaskMeta uint64 `protobuf:"varint,1,opt,name=task_meta,json=taskMeta,proto3" json:"task_meta,omitempty"`
+	Progress uint64 `protobuf:"varint,2,opt,name=progress,proto3" json:"progress,omitempty"`
 }
 
 func (m *TaskStatusResponse) Reset()         { *m = TaskStatusResponse{} }
@@ -5578,2818 +5763,2580 @@ func (m *TaskStatusResponse) GetTaskMeta() uint64 {
 	return 0
 }
 
-func init() {
-	proto.RegisterEnum("pb.DirectedEdge_Op", DirectedEdge_Op_name, DirectedEdge_Op_value)
-	proto.RegisterEnum("pb.Mutations_DropOp", Mutations_DropOp_name, Mutations_DropOp_value)
-	proto.RegisterEnum("pb.Metadata_HintType", Metadata_HintType_name, Metadata_HintType_value)
-	proto.RegisterEnum("pb.Posting_ValType", Posting_ValType_name, Posting_ValType_value)
-	proto.RegisterEnum("pb.Posting_PostingType", Posting_PostingType_name, Posting_PostingType_value)
-	proto.RegisterEnum("pb.SchemaUpdate_Directive", SchemaUpdate_Directive_name, SchemaUpdate_Directive_value)
-	proto.RegisterEnum("pb.NumLeaseType", NumLeaseType_name, NumLeaseType_value)
-	proto.RegisterEnum("pb.DropOperation_DropOp", DropOperation_DropOp_name, DropOperation_DropOp_value)
-	proto.RegisterEnum("pb.BackupKey_KeyType", BackupKey_KeyType_name, BackupKey_KeyType_value)
-	proto.RegisterType((*List)(nil), "pb.List")
-	proto.RegisterType((*TaskValue)(nil), "pb.TaskValue")
-	proto.RegisterType((*SrcFunction)(nil), "pb.SrcFunction")
-	proto.RegisterType((*Query)(nil), "pb.Query")
-	proto.RegisterType((*ValueList)(nil), "pb.ValueList")
-	proto.RegisterType((*LangList)(nil), "pb.LangList")
-	proto.RegisterType((*Result)(nil), "pb.Result")
-	proto.RegisterType((*Order)(nil), "pb.Order")
-	proto.RegisterType((*SortMessage)(nil), "pb.SortMessage")
-	proto.RegisterType((*SortResult)(nil), "pb.SortResult")
-	proto.RegisterType((*RaftContext)(nil), "pb.RaftContext")
-	proto.RegisterType((*Member)(nil), "pb.Member")
-	proto.RegisterType((*Group)(nil), "pb.Group")
-	proto.RegisterMapType((map[uint64]*Member)(nil), "pb.Group.MembersEntry")
-	proto.RegisterMapType((map[string]*Tablet)(nil), "pb.Group.TabletsEntry")
-	proto.RegisterType((*License)(nil), "pb.License")
-	proto.RegisterType((*ZeroProposal)(nil), "pb.ZeroProposal")
-	proto.RegisterMapType((map[uint32]uint64)(nil), "pb.ZeroProposal.SnapshotTsEntry")
-	proto.RegisterType((*MembershipState)(nil), "pb.MembershipState")
-	proto.RegisterMapType((map[uint32]*Group)(nil), "pb.MembershipState.GroupsEntry")
-	proto.RegisterMapType((map[uint64]*Member)(nil), "pb.MembershipState.ZerosEntry")
-	proto.RegisterType((*ConnectionState)(nil), "pb.ConnectionState")
-	proto.RegisterType((*HealthInfo)(nil), "pb.HealthInfo")
-	proto.RegisterType((*Tablet)(nil), "pb.Tablet")
-	proto.RegisterType((*DirectedEdge)(nil), "pb.DirectedEdge")
-	proto.RegisterType((*Mutations)(nil), "pb.Mutations")
-	proto.RegisterType((*Metadata)(nil), "pb.Metadata")
-	proto.RegisterMapType((map[string]Metadata_HintType)(nil), "pb.Metadata.PredHintsEntry")
-	proto.RegisterType((*Snapshot)(nil), "pb.Snapshot")
-	proto.RegisterType((*ZeroSnapshot)(nil), "pb.ZeroSnapshot")
-	proto.RegisterType((*RestoreRequest)(nil), "pb.RestoreRequest")
-	proto.RegisterType((*Proposal)(nil), "pb.Proposal")
-	proto.RegisterType((*CDCState)(nil), "pb.CDCState")
-	proto.RegisterType((*KVS)(nil), "pb.KVS")
-	proto.RegisterType((*Posting)(nil), "pb.Posting")
-	proto.RegisterType((*UidBlock)(nil), "pb.UidBlock")
-	proto.RegisterType((*UidPack)(nil), "pb.UidPack")
-	proto.RegisterType((*PostingList)(nil), "pb.PostingList")
-	proto.RegisterType((*FacetParam)(nil), "pb.FacetParam")
-	proto.RegisterType((*FacetParams)(nil), "pb.FacetParams")
-	proto.RegisterType((*Facets)(nil), "pb.Facets")
-	proto.RegisterType((*FacetsList)(nil), "pb.FacetsList")
-	proto.RegisterType((*Function)(nil), "pb.Function")
-	proto.RegisterType((*FilterTree)(nil), "pb.FilterTree")
-	proto.RegisterType((*SchemaRequest)(nil), "pb.SchemaRequest")
-	proto.RegisterType((*SchemaNode)(nil), "pb.SchemaNode")
-	proto.RegisterType((*SchemaResult)(nil), "pb.SchemaResult")
-	proto.RegisterType((*SchemaUpdate)(nil), "pb.SchemaUpdate")
-	proto.RegisterType((*TypeUpdate)(nil), "pb.TypeUpdate")
-	proto.RegisterType((*MapHeader)(nil), "pb.MapHeader")
-	proto.RegisterType((*MovePredicatePayload)(nil), "pb.MovePredicatePayload")
-	proto.RegisterType((*TxnStatus)(nil), "pb.TxnStatus")
-	proto.RegisterType((*OracleDelta)(nil), "pb.OracleDelta")
-	proto.RegisterMapType((map[uint32]uint64)(nil), "pb.OracleDelta.GroupChecksumsEntry")
-	proto.RegisterType((*TxnTimestamps)(nil), "pb.TxnTimestamps")
-	proto.RegisterType((*PeerResponse)(nil), "pb.PeerResponse")
-	proto.RegisterType((*RaftBatch)(nil), "pb.RaftBatch")
-	proto.RegisterType((*TabletResponse)(nil), "pb.TabletResponse")
-	proto.RegisterType((*TabletRequest)(nil), "pb.TabletRequest")
-	proto.RegisterType((*SubscriptionRequest)(nil), "pb.SubscriptionRequest")
-	proto.RegisterType((*SubscriptionResponse)(nil), "pb.SubscriptionResponse")
-	proto.RegisterType((*Num)(nil), "pb.Num")
-	proto.RegisterType((*AssignedIds)(nil), "pb.AssignedIds")
-	proto.RegisterType((*RemoveNodeRequest)(nil), "pb.RemoveNodeRequest")
-	proto.RegisterType((*MoveTabletRequest)(nil), "pb.MoveTabletRequest")
-	proto.RegisterType((*ApplyLicenseRequest)(nil), "pb.ApplyLicenseRequest")
-	proto.RegisterType((*SnapshotMeta)(nil), "pb.SnapshotMeta")
-	proto.RegisterType((*Status)(nil), "pb.Status")
-	proto.RegisterType((*BackupRequest)(nil), "pb.BackupRequest")
-	proto.RegisterType((*BackupResponse)(nil), "pb.BackupResponse")
-	proto.RegisterType((*DropOperation)(nil), "pb.DropOperation")
-	proto.RegisterType((*ExportRequest)(nil), "pb.ExportRequest")
-	proto.RegisterType((*ExportResponse)(nil), "pb.ExportResponse")
-	proto.RegisterType((*BackupKey)(nil), "pb.BackupKey")
-	proto.RegisterType((*BackupPostingList)(nil), "pb.BackupPostingList")
-	proto.RegisterType((*UpdateGraphQLSchemaRequest)(nil), "pb.UpdateGraphQLSchemaRequest")
-	proto.RegisterType((*UpdateGraphQLSchemaResponse)(nil), "pb.UpdateGraphQLSchemaResponse")
-	proto.RegisterType((*BulkMeta)(nil), "pb.BulkMeta")
-	proto.RegisterMapType((map[string]*SchemaUpdate)(nil), "pb.BulkMeta.SchemaMapEntry")
-	proto.RegisterType((*DeleteNsRequest)(nil), "pb.DeleteNsRequest")
-	proto.RegisterType((*TaskStatusRequest)(nil), "pb.TaskStatusRequest")
-	proto.RegisterType((*TaskStatusResponse)(nil), "pb.TaskStatusResponse")
+func (m *TaskStatusResponse) GetProgress() uint64 {
+	if m != nil {
+		return m.Progress
+	}
+	return 0
 }
 
-func init() { proto.RegisterFile("pb.proto", fileDescriptor_f80abaa17e25ccc8) }
+type SynonymUpdate struct {
+	Name    string   `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	Words   []string `protobuf:"bytes,2,rep,name=words,proto3" json:"words,omitempty"`
+	Version uint64   `protobuf:"varint,3,opt,name=version,proto3" json:"version,omitempty"`
+}
 
-var fileDescriptor_f80abaa17e25ccc8 = []byte{
-	// 5418 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xd4, 0x3b, 0x4b, 0x8f, 0x1c, 0x69,
-	0x52, 0x5d, 0xef, 0xca, 0xa8, 0x87, 0xab, 0x3f, 0x7b, 0x3c, 0xb5, 0xe5, 0x19, 0xdb, 0x93, 0x1e,
-	0xcf, 0xf4, 0xd8, 0xe3, 0xb6, 0xdd, 0xde, 0x85, 0x9d, 0x59, 0xad, 0x44, 0x3f, 0xaa, 0x3d, 0x3d,
-	0x6e, 0x77, 0x7b, 0xb3, 0xcb, 0xde, 0x87, 0x04, 0xa5, 0xec, 0xcc, 0xaf, 0xbb, 0x73, 0x3b, 0x2b,
-	0x33, 0x37, 0x33, 0xab, 0xb7, 0x7b, 0x6e, 0x08, 0x89, 0xbd, 0x70, 0x58, 0x89, 0x0b, 0x27, 0x0e,
-	0x1c, 0xb8, 0xc0, 0x05, 0x04, 0x82, 0x0b, 0x37, 0x84, 0x10, 0xa7, 0x3d, 0x82, 0x80, 0x11, 0x9a,
-	0x45, 0x1c, 0x7c, 0x40, 0x42, 0xfc, 0x01, 0x14, 0x11, 0x5f, 0xbe, 0xaa, 0xab, 0x6d, 0xcf, 0x22,
-	0x0e, 0x9c, 0xea, 0x8b, 0xf8, 0xde, 0xf1, 0xc5, 0x3b, 0xb2, 0xa0, 0x19, 0xec, 0x2f, 0x07, 0xa1,
-	0x1f, 0xfb, 0xa2, 0x1c, 0xec, 0x0f, 0x34, 0x33, 0x70, 0x18, 0x1c, 0xdc, 0x39, 0x74, 0xe2, 0xa3,
-	0xe9, 0xfe, 0xb2, 0xe5, 0x4f, 0xee, 0xdb, 0x87, 0xa1, 0x19, 0x1c, 0xdd, 0x73, 0xfc, 0xfb, 0xfb,
-	0xa6, 0x7d, 0x28, 0xc3, 0xfb, 0x27, 0x8f, 0xee, 0x07, 0xfb, 0xf7, 0x93, 0xa9, 0x83, 0x7b, 0xb9,
-	0xb1, 0x87, 0xfe, 0xa1, 0x7f, 0x9f, 0xd0, 0xfb, 0xd3, 0x03, 0x82, 0x08, 0xa0, 0x16, 0x0f, 0xd7,
-	0x07, 0x50, 0xdd, 0x76, 0xa2, 0x58, 0x08, 0xa8, 0x4e, 0x1d, 0x3b, 0xea, 0x97, 0x6e, 0x56, 0x96,
-	0xea, 0x06, 0xb5, 0xf5, 0xa7, 0xa0, 0x8d, 0xcc, 0xe8, 0xf8, 0x85, 0xe9, 0x4e, 0xa5, 0xe8, 0x41,
-	0xe5, 0xc4, 0x74, 0xfb, 0xa5, 0x9b, 0xa5, 0xa5, 0xb6, 0x81, 0x4d, 0xb1, 0x0c, 0xcd, 0x13, 0xd3,
-	0x1d, 0xc7, 0x67, 0x81, 0xec, 0x97, 0x6f, 0x96, 0x96, 0xba, 0x2b, 0x97, 0x97, 0x83, 0xfd, 0xe5,
-	0x67, 0x7e, 0x14, 0x3b, 0xde, 0xe1, 0xf2, 0x0b, 0xd3, 0x1d, 0x9d, 0x05, 0xd2, 0x68, 0x9c, 0x70,
-	0x43, 0xdf, 0x85, 0xd6, 0x5e, 0x68, 0x6d, 0x4e, 0x3d, 0x2b, 0x76, 0x7c, 0x0f, 0x77, 0xf4, 0xcc,
-	0x89, 0xa4, 0x15, 0x35, 0x83, 0xda, 0x88, 0x33, 0xc3, 0xc3, 0xa8, 0x5f, 0xb9, 0x59, 0x41, 0x1c,
-	0xb6, 0x45, 0x1f, 0x1a, 0x4e, 0xb4, 0xee, 0x4f, 0xbd, 0xb8, 0x5f, 0xbd, 0x59, 0x5a, 0x6a, 0x1a,
-	0x09, 0xa8, 0xff, 0x55, 0x05, 0x6a, 0xdf, 0x9b, 0xca, 0xf0, 0x8c, 0xe6, 0xc5, 0x71, 0x98, 0xac,
-	0x85, 0x6d, 0x71, 0x05, 0x6a, 0xae, 0xe9, 0x1d, 0x46, 0xfd, 0x32, 0x2d, 0xc6, 0x80, 0xb8, 0x06,
-	0x9a, 0x79, 0x10, 0xcb, 0x70, 0x3c, 0x75, 0xec, 0x7e, 0xe5, 0x66, 0x69, 0xa9, 0x6e, 0x34, 0x09,
-	0xf1, 0xdc, 0xb1, 0xc5, 0x37, 0xa0, 0x69, 0xfb, 0x63, 0x2b, 0xbf, 0x97, 0xed, 0xd3, 0x5e, 0xe2,
-	0x16, 0x34, 0xa7, 0x8e, 0x3d, 0x76, 0x9d, 0x28, 0xee, 0xd7, 0x6e, 0x96, 0x96, 0x5a, 0x2b, 0x4d,
-	0xbc, 0x2c, 0xd2, 0xce, 0x68, 0x4c, 0x1d, 0x9b, 0x88, 0x78, 0x07, 0x9a, 0x51, 0x68, 0x8d, 0x0f,
-	0xa6, 0x9e, 0xd5, 0xaf, 0xd3, 0xa0, 0x4b, 0x38, 0x28, 0x77, 0x6b, 0xa3, 0x11, 0x31, 0x80, 0xd7,
-	0x0a, 0xe5, 0x89, 0x0c, 0x23, 0xd9, 0x6f, 0xf0, 0x56, 0x0a, 0x14, 0x0f, 0xa0, 0x75, 0x60, 0x5a,
-	0x32, 0x1e, 0x07, 0x66, 0x68, 0x4e, 0xfa, 0xcd, 0x6c, 0xa1, 0x4d, 0x44, 0x3f, 0x43, 0x6c, 0x64,
-	0xc0, 0x41, 0x0a, 0x88, 0x47, 0xd0, 0x21, 0x28, 0x1a, 0x1f, 0x38, 0x6e, 0x2c, 0xc3, 0xbe, 0x46,
-	0x73, 0xba, 0x34, 0x87, 0x30, 0xa3, 0x50, 0x4a, 0xa3, 0xcd, 0x83, 0x18, 0x23, 0xde, 0x05, 0x90,
-	0xa7, 0x81, 0xe9, 0xd9, 0x63, 0xd3, 0x75, 0xfb, 0x40, 0x67, 0xd0, 0x18, 0xb3, 0xea, 0xba, 0xe2,
-	0x6d, 0x3c, 0x9f, 0x69, 0x8f, 0xe3, 0xa8, 0xdf, 0xb9, 0x59, 0x5a, 0xaa, 0x1a, 0x75, 0x04, 0x47,
-	0x11, 0xd2, 0xd5, 0x32, 0xad, 0x23, 0xd9, 0xef, 0xde, 0x2c, 0x2d, 0xd5, 0x0c, 0x06, 0x10, 0x7b,
-	0xe0, 0x84, 0x51, 0xdc, 0xbf, 0xc4, 0x58, 0x02, 0xc4, 0x55, 0xa8, 0xfb, 0x07, 0x07, 0x91, 0x8c,
-	0xfb, 0x3d, 0x42, 0x2b, 0x48, 0x5f, 0x01, 0x8d, 0xb8, 0x8a, 0xa8, 0x76, 0x1b, 0xea, 0x27, 0x08,
-	0x30, 0xf3, 0xb5, 0x56, 0x3a, 0x78, 0xec, 0x94, 0xf1, 0x0c, 0xd5, 0xa9, 0x5f, 0x87, 0xe6, 0xb6,
-	0xe9, 0x1d, 0x26, 0xdc, 0x8a, 0xcf, 0x49, 0x13, 0x34, 0x83, 0xda, 0xfa, 0x1f, 0x94, 0xa1, 0x6e,
-	0xc8, 0x68, 0xea, 0xc6, 0xe2, 0x43, 0x00, 0x7c, 0xac, 0x89, 0x19, 0x87, 0xce, 0xa9, 0x5a, 0x35,
-	0x7b, 0x2e, 0x6d, 0xea, 0xd8, 0x4f, 0xa9, 0x4b, 0x3c, 0x80, 0x36, 0xad, 0x9e, 0x0c, 0x2d, 0x67,
-	0x07, 0x48, 0xcf, 0x67, 0xb4, 0x68, 0x88, 0x9a, 0x71, 0x15, 0xea, 0xc4, 0x1f, 0xcc, 0xa3, 0x1d,
-	0x43, 0x41, 0xe2, 0x36, 0x74, 0x1d, 0x2f, 0xc6, 0xf7, 0xb3, 0xe2, 0xb1, 0x2d, 0xa3, 0x84, 0x81,
-	0x3a, 0x29, 0x76, 0x43, 0x46, 0xb1, 0x78, 0x08, 0xfc, 0x08, 0xc9, 0x86, 0x35, 0xda, 0xb0, 0x9b,
-	0x3e, 0x6e, 0xc4, 0x3b, 0xd2, 0x18, 0xb5, 0xe3, 0x3d, 0x68, 0xe1, 0xfd, 0x92, 0x19, 0x75, 0x9a,
-	0xd1, 0xa6, 0xdb, 0x28, 0x72, 0x18, 0x80, 0x03, 0xd4, 0x70, 0x24, 0x0d, 0x32, 0x29, 0x33, 0x15,
-	0xb5, 0xf5, 0x21, 0xd4, 0x76, 0x43, 0x5b, 0x86, 0x73, 0xe5, 0x44, 0x40, 0xd5, 0x96, 0x91, 0x45,
-	0x22, 0xdc, 0x34, 0xa8, 0x9d, 0xc9, 0x4e, 0x25, 0x27, 0x3b, 0xfa, 0x1f, 0x96, 0xa0, 0xb5, 0xe7,
-	0x87, 0xf1, 0x53, 0x19, 0x45, 0xe6, 0xa1, 0x14, 0x37, 0xa0, 0xe6, 0xe3, 0xb2, 0x8a, 0xc2, 0x1a,
-	0x9e, 0x89, 0xf6, 0x31, 0x18, 0x3f, 0xf3, 0x0e, 0xe5, 0x8b, 0xdf, 0x01, 0x79, 0x8a, 0xa4, 0xae,
-	0xa2, 0x78, 0x8a, 0x64, 0x2e, 0xe3, 0x9e, 0x6a, 0x9e, 0x7b, 0x2e, 0x64, 0x4d, 0xfd, 0x5b, 0x00,
-	0x78, 0xbe, 0xaf, 0xc9, 0x05, 0xfa, 0xcf, 0x4a, 0xd0, 0x32, 0xcc, 0x83, 0x78, 0xdd, 0xf7, 0x62,
-	0x79, 0x1a, 0x8b, 0x2e, 0x94, 0x1d, 0x9b, 0x68, 0x54, 0x37, 0xca, 0x8e, 0x8d, 0xa7, 0x3b, 0x0c,
-	0xfd, 0x69, 0x40, 0x24, 0xea, 0x18, 0x0c, 0x10, 0x2d, 0x6d, 0x3b, 0xa4, 0x23, 0x23, 0x2d, 0x6d,
-	0x3b, 0x14, 0x37, 0xa0, 0x15, 0x79, 0x66, 0x10, 0x1d, 0xf9, 0x31, 0x9e, 0xae, 0x4a, 0xa7, 0x83,
-	0x04, 0x35, 0x8a, 0x50, 0xe8, 0x9c, 0x68, 0xec, 0x4a, 0x33, 0xf4, 0x64, 0x48, 0x8a, 0xa4, 0x69,
-	0x68, 0x4e, 0xb4, 0xcd, 0x08, 0xfd, 0x67, 0x15, 0xa8, 0x3f, 0x95, 0x93, 0x7d, 0x19, 0x9e, 0x3b,
-	0xc4, 0x03, 0x68, 0xd2, 0xbe, 0x63, 0xc7, 0xe6, 0x73, 0xac, 0xbd, 0xf5, 0xf2, 0xcb, 0x1b, 0x8b,
-	0x84, 0xdb, 0xb2, 0x3f, 0xf6, 0x27, 0x4e, 0x2c, 0x27, 0x41, 0x7c, 0x66, 0x34, 0x14, 0x6a, 0xee,
-	0x01, 0xaf, 0x42, 0xdd, 0x95, 0x26, 0xbe, 0x19, 0xb3, 0xa7, 0x82, 0xc4, 0x3d, 0x68, 0x98, 0x93,
-	0xb1, 0x2d, 0x4d, 0x9b, 0x0f, 0xb5, 0x76, 0xe5, 0xe5, 0x97, 0x37, 0x7a, 0xe6, 0x64, 0x43, 0x9a,
-	0xf9, 0xb5, 0xeb, 0x8c, 0x11, 0x9f, 0x20, 0x4f, 0x46, 0xf1, 0x78, 0x1a, 0xd8, 0x66, 0x2c, 0x49,
-	0xd7, 0x55, 0xd7, 0xfa, 0x2f, 0xbf, 0xbc, 0x71, 0x05, 0xd1, 0xcf, 0x09, 0x9b, 0x9b, 0x06, 0x19,
-	0x16, 0xf5, 0x5e, 0x72, 0x7d, 0xa5, 0xf7, 0x14, 0x28, 0xb6, 0x60, 0xd1, 0x72, 0xa7, 0x11, 0x2a,
-	0x67, 0xc7, 0x3b, 0xf0, 0xc7, 0xbe, 0xe7, 0x9e, 0xd1, 0x03, 0x37, 0xd7, 0xde, 0x7d, 0xf9, 0xe5,
-	0x8d, 0x6f, 0xa8, 0xce, 0x2d, 0xef, 0xc0, 0xdf, 0xf5, 0xdc, 0xb3, 0xdc, 0xfa, 0x97, 0x66, 0xba,
-	0xc4, 0x6f, 0x40, 0xf7, 0xc0, 0x0f, 0x2d, 0x39, 0x4e, 0x49, 0xd6, 0xa5, 0x75, 0x06, 0x2f, 0xbf,
-	0xbc, 0x71, 0x95, 0x7a, 0x1e, 0x9f, 0xa3, 0x5b, 0x3b, 0x8f, 0xd7, 0xff, 0xb5, 0x0c, 0x35, 0x6a,
-	0x8b, 0x07, 0xd0, 0x98, 0xd0, 0x93, 0x24, 0xfa, 0xe9, 0x2a, 0xf2, 0x10, 0xf5, 0x2d, 0xf3, 0x5b,
-	0x45, 0x43, 0x2f, 0x0e, 0xcf, 0x8c, 0x64, 0x18, 0xce, 0x88, 0xcd, 0x7d, 0x57, 0xc6, 0x91, 0xe2,
-	0xf9, 0xdc, 0x8c, 0x11, 0x77, 0xa8, 0x19, 0x6a, 0xd8, 0x2c, 0xdf, 0x54, 0xce, 0xf1, 0xcd, 0x00,
-	0x9a, 0xd6, 0x91, 0xb4, 0x8e, 0xa3, 0xe9, 0x44, 0x71, 0x55, 0x0a, 0x8b, 0x5b, 0xd0, 0xa1, 0x76,
-	0xe0, 0x3b, 0x1e, 0x4d, 0xaf, 0xd1, 0x80, 0x76, 0x86, 0x1c, 0x45, 0x83, 0x4d, 0x68, 0xe7, 0x0f,
-	0x8b, 0xe6, 0xfc, 0x58, 0x9e, 0x11, 0x7f, 0x55, 0x0d, 0x6c, 0x8a, 0x9b, 0x50, 0x23, 0x45, 0x47,
-	0xdc, 0xd5, 0x5a, 0x01, 0x3c, 0x33, 0x4f, 0x31, 0xb8, 0xe3, 0xd3, 0xf2, 0xb7, 0x4b, 0xb8, 0x4e,
-	0xfe, 0x0a, 0xf9, 0x75, 0xb4, 0x8b, 0xd7, 0xe1, 0x29, 0xb9, 0x75, 0x74, 0x1f, 0x1a, 0xdb, 0x8e,
-	0x25, 0xbd, 0x88, 0x8c, 0xfe, 0x34, 0x92, 0xa9, 0x52, 0xc2, 0x36, 0xde, 0x77, 0x62, 0x9e, 0xee,
-	0xf8, 0xb6, 0x8c, 0x68, 0x9d, 0xaa, 0x91, 0xc2, 0xd8, 0x27, 0x4f, 0x03, 0x27, 0x3c, 0x1b, 0x31,
-	0xa5, 0x2a, 0x46, 0x0a, 0x23, 0x77, 0x49, 0x0f, 0x37, 0xb3, 0x13, 0x03, 0xae, 0x40, 0xfd, 0x4f,
-	0xab, 0xd0, 0xfe, 0x91, 0x0c, 0xfd, 0x67, 0xa1, 0x1f, 0xf8, 0x91, 0xe9, 0x8a, 0xd5, 0x22, 0xcd,
-	0xf9, 0x6d, 0x6f, 0xe2, 0x69, 0xf3, 0xc3, 0x96, 0xf7, 0xd2, 0x47, 0xe0, 0x37, 0xcb, 0xbf, 0x8a,
-	0x0e, 0x75, 0x7e, 0xf3, 0x39, 0x34, 0x53, 0x3d, 0x38, 0x86, 0x5f, 0x99, 0xce, 0x5a, 0xa4, 0x87,
-	0xea, 0x41, 0xa9, 0x9c, 0x98, 0xa7, 0xcf, 0xb7, 0x36, 0xd4, 0xdb, 0x2a, 0x48, 0x51, 0x61, 0x74,
-	0xea, 0x8d, 0x92, 0x47, 0x4d, 0x61, 0xbc, 0x29, 0x52, 0x24, 0xda, 0xda, 0xe8, 0xb7, 0xa9, 0x2b,
-	0x01, 0xc5, 0x3b, 0xa0, 0x4d, 0xcc, 0x53, 0x54, 0x68, 0x5b, 0x36, 0x8b, 0xa6, 0x91, 0x21, 0xc4,
-	0x7b, 0x50, 0x89, 0x4f, 0x3d, 0x92, 0x3d, 0xf4, 0x2a, 0xd0, 0xc9, 0x1c, 0x9d, 0x7a, 0x4a, 0xf5,
-	0x19, 0xd8, 0x87, 0x6f, 0x6a, 0x39, 0x36, 0x39, 0x11, 0x9a, 0x81, 0x4d, 0x71, 0x1b, 0x1a, 0x2e,
-	0xbf, 0x16, 0x39, 0x0a, 0xad, 0x95, 0x16, 0xeb, 0x51, 0x42, 0x19, 0x49, 0x9f, 0xf8, 0x18, 0x9a,
-	0x09, 0x75, 0xfa, 0x2d, 0x1a, 0xd7, 0x4b, 0xe8, 0x99, 0x90, 0xd1, 0x48, 0x47, 0x88, 0x07, 0xa0,
-	0xd9, 0xd2, 0x95, 0xb1, 0x1c, 0x7b, 0xac, 0xc8, 0x5b, 0xec, 0x40, 0x6e, 0x10, 0x72, 0x27, 0x32,
-	0xe4, 0x4f, 0xa6, 0x32, 0x8a, 0x8d, 0xa6, 0xad, 0x10, 0xe2, 0xfd, 0x4c, 0xb0, 0xba, 0xf4, 0x5c,
-	0x79, 0x62, 0x26, 0x5d, 0x83, 0xef, 0xc2, 0xa5, 0x99, 0x47, 0xcb, 0x73, 0x69, 0x87, 0xb9, 0xf4,
-	0x4a, 0x9e, 0x4b, 0xab, 0x39, 0xce, 0xfc, 0xbc, 0xda, 0x6c, 0xf6, 0x34, 0xfd, 0xbf, 0x2a, 0x70,
-	0x49, 0x09, 0xcc, 0x91, 0x13, 0xec, 0xc5, 0x4a, 0x75, 0x91, 0x61, 0x52, 0xbc, 0x5a, 0x35, 0x12,
-	0x50, 0xfc, 0x3a, 0xd4, 0x49, 0xd3, 0x24, 0x02, 0x7f, 0x23, 0x63, 0x84, 0x74, 0x3a, 0x2b, 0x00,
-	0xc5, 0x45, 0x6a, 0xb8, 0xf8, 0x26, 0xd4, 0xbe, 0x90, 0xa1, 0xcf, 0x86, 0xb6, 0xb5, 0x72, 0x7d,
-	0xde, 0x3c, 0x24, 0x9f, 0x9a, 0xc6, 0x83, 0xff, 0xb7, 0xfc, 0x02, 0x5f, 0x87, 0x5f, 0xde, 0x47,
-	0x63, 0x3b, 0xf1, 0x4f, 0xa4, 0xdd, 0x6f, 0x64, 0x34, 0x57, 0x4c, 0x9e, 0x74, 0x25, 0x2c, 0xd3,
-	0x9c, 0xcb, 0x32, 0xda, 0xc5, 0x2c, 0x33, 0xd8, 0x80, 0x56, 0x8e, 0x2e, 0x73, 0x1e, 0xea, 0x46,
-	0x51, 0x9d, 0x68, 0xa9, 0x2a, 0xcd, 0x6b, 0xa5, 0x0d, 0x80, 0x8c, 0x4a, 0xbf, 0xaa, 0x6e, 0xd3,
-	0x7f, 0xbb, 0x04, 0x97, 0xd6, 0x7d, 0xcf, 0x93, 0xe4, 0xaa, 0xf3, 0x9b, 0x67, 0x22, 0x5e, 0xba,
-	0x50, 0xc4, 0x3f, 0x82, 0x5a, 0x84, 0x83, 0xd5, 0xea, 0x97, 0xe7, 0x3c, 0xa2, 0xc1, 0x23, 0x50,
-	0xd1, 0x4f, 0xcc, 0xd3, 0x71, 0x20, 0x3d, 0xdb, 0xf1, 0x0e, 0x13, 0x45, 0x3f, 0x31, 0x4f, 0x9f,
-	0x31, 0x46, 0xff, 0xeb, 0x32, 0xc0, 0x67, 0xd2, 0x74, 0xe3, 0x23, 0x34, 0x66, 0xf8, 0xa2, 0x8e,
-	0x17, 0xc5, 0xa6, 0x67, 0x25, 0x81, 0x52, 0x0a, 0xe3, 0x8b, 0xa2, 0x4d, 0x97, 0x11, 0xab, 0x48,
-	0xcd, 0x48, 0x40, 0xe4, 0x0f, 0xdc, 0x6e, 0x1a, 0x29, 0xdb, 0xaf, 0xa0, 0xcc, 0x91, 0xa9, 0x12,
-	0x5a, 0x39, 0x32, 0x7d, 0x68, 0x60, 0xe0, 0xe1, 0xf8, 0x1e, 0x31, 0x8d, 0x66, 0x24, 0x20, 0xae,
-	0x33, 0x0d, 0x62, 0x67, 0xc2, 0x16, 0xbe, 0x62, 0x28, 0x08, 0x4f, 0x85, 0x16, 0x7d, 0x68, 0x1d,
-	0xf9, 0xa4, 0x48, 0x2a, 0x46, 0x0a, 0xe3, 0x6a, 0xbe, 0x77, 0xe8, 0xe3, 0xed, 0x9a, 0xe4, 0x3c,
-	0x26, 0x20, 0xdf, 0xc5, 0x96, 0xa7, 0xd8, 0xa5, 0x51, 0x57, 0x0a, 0x23, 0x5d, 0xa4, 0x1c, 0x1f,
-	0x48, 0x33, 0x9e, 0x86, 0x32, 0xea, 0x03, 0x75, 0x83, 0x94, 0x9b, 0x0a, 0x23, 0xde, 0x83, 0x36,
-	0x12, 0xce, 0x8c, 0x22, 0xe7, 0xd0, 0x93, 0x36, 0xa9, 0x97, 0xaa, 0x81, 0xc4, 0x5c, 0x55, 0x28,
-	0xfd, 0x6f, 0xca, 0x50, 0x67, 0x5d, 0x50, 0x70, 0x96, 0x4a, 0x6f, 0xe4, 0x2c, 0xbd, 0x03, 0x5a,
-	0x10, 0x4a, 0xdb, 0xb1, 0x92, 0x77, 0xd4, 0x8c, 0x0c, 0x41, 0xd1, 0x0d, 0x7a, 0x07, 0x44, 0xcf,
-	0xa6, 0xc1, 0x80, 0xd0, 0xa1, 0xe3, 0x7b, 0x63, 0xdb, 0x89, 0x8e, 0xc7, 0xfb, 0x67, 0xb1, 0x8c,
-	0x14, 0x2d, 0x5a, 0xbe, 0xb7, 0xe1, 0x44, 0xc7, 0x6b, 0x88, 0x42, 0x12, 0xb2, 0x8c, 0x90, 0x6c,
-	0x34, 0x0d, 0x05, 0x89, 0x47, 0xa0, 0x91, 0x0f, 0x4b, 0x4e, 0x8e, 0x46, 0xce, 0xc9, 0xd5, 0x97,
-	0x5f, 0xde, 0x10, 0x88, 0x9c, 0xf1, 0x6e, 0x9a, 0x09, 0x0e, 0xbd, 0x34, 0x9c, 0x8c, 0xe6, 0x8a,
-	0x64, 0x98, 0xbd, 0x34, 0x44, 0x8d, 0xa2, 0xbc, 0x97, 0xc6, 0x18, 0x71, 0x0f, 0xc4, 0xd4, 0xb3,
-	0xfc, 0x49, 0x80, 0x4c, 0x21, 0x6d, 0x75, 0xc8, 0x16, 0x1d, 0x72, 0x31, 0xdf, 0x43, 0x47, 0xd5,
-	0xff, 0xa5, 0x0c, 0xed, 0x0d, 0x27, 0x94, 0x56, 0x2c, 0xed, 0xa1, 0x7d, 0x28, 0xf1, 0xec, 0xd2,
-	0x8b, 0x9d, 0xf8, 0x4c, 0xb9, 0xa1, 0x0a, 0x4a, 0xa3, 0x88, 0x72, 0x31, 0xda, 0x66, 0x09, 0xab,
-	0x50, 0x82, 0x80, 0x01, 0xb1, 0x02, 0xc0, 0xf1, 0x15, 0x25, 0x09, 0xaa, 0x17, 0x27, 0x09, 0x34,
-	0x1a, 0x86, 0x4d, 0x0c, 0xc2, 0x79, 0x8e, 0xc3, 0xbe, 0x68, 0x9d, 0x32, 0x08, 0x53, 0xc9, 0x1e,
-	0x2d, 0x85, 0x7d, 0x0d, 0xde, 0x18, 0xdb, 0xe2, 0x16, 0x94, 0xfd, 0x80, 0x88, 0xab, 0x96, 0xce,
-	0x5f, 0x61, 0x79, 0x37, 0x30, 0xca, 0x7e, 0x80, 0x52, 0xcc, 0xb1, 0x2f, 0x31, 0x1e, 0x4a, 0x31,
-	0xda, 0x3d, 0x8a, 0xb8, 0x0c, 0xd5, 0x23, 0x74, 0x68, 0x9b, 0xae, 0xeb, 0xff, 0x54, 0xda, 0xcf,
-	0x42, 0x69, 0x27, 0x3c, 0x58, 0xc0, 0x21, 0x97, 0x78, 0xe6, 0x44, 0x46, 0x81, 0x69, 0x49, 0xc5,
-	0x82, 0x19, 0x42, 0xbf, 0x0a, 0xe5, 0xdd, 0x40, 0x34, 0xa0, 0xb2, 0x37, 0x1c, 0xf5, 0x16, 0xb0,
-	0xb1, 0x31, 0xdc, 0xee, 0xa1, 0x45, 0xa9, 0xf7, 0x1a, 0xfa, 0x57, 0x65, 0xd0, 0x9e, 0x4e, 0x63,
-	0x13, 0x75, 0x4b, 0x84, 0xb7, 0x2c, 0x72, 0x68, 0xc6, 0x8a, 0xdf, 0x80, 0x66, 0x14, 0x9b, 0x21,
-	0x79, 0x25, 0x6c, 0x9d, 0x1a, 0x04, 0x8f, 0x22, 0xf1, 0x01, 0xd4, 0xa4, 0x7d, 0x28, 0x13, 0x73,
-	0xd1, 0x9b, 0xbd, 0xaf, 0xc1, 0xdd, 0x62, 0x09, 0xea, 0x91, 0x75, 0x24, 0x27, 0x66, 0xbf, 0x9a,
-	0x0d, 0xdc, 0x23, 0x0c, 0xbb, 0xe1, 0x86, 0xea, 0x17, 0xef, 0x43, 0x0d, 0xdf, 0x26, 0x52, 0x71,
-	0x25, 0x45, 0xa2, 0xf8, 0x0c, 0x6a, 0x18, 0x77, 0x22, 0xe3, 0xd9, 0xa1, 0x1f, 0x8c, 0xfd, 0x80,
-	0x68, 0xdf, 0x5d, 0xb9, 0x42, 0x3a, 0x2e, 0xb9, 0xcd, 0xf2, 0x46, 0xe8, 0x07, 0xbb, 0x81, 0x51,
-	0xb7, 0xe9, 0x17, 0xa3, 0x1c, 0x1a, 0xce, 0x1c, 0xc1, 0x46, 0x41, 0x43, 0x0c, 0xa7, 0x92, 0x96,
-	0xa0, 0x39, 0x91, 0xb1, 0x69, 0x9b, 0xb1, 0xa9, 0x6c, 0x43, 0x9b, 0x55, 0x26, 0xe3, 0x8c, 0xb4,
-	0x57, 0xbf, 0x0f, 0x75, 0x5e, 0x5a, 0x34, 0xa1, 0xba, 0xb3, 0xbb, 0x33, 0x64, 0xb2, 0xae, 0x6e,
-	0x6f, 0xf7, 0x4a, 0x88, 0xda, 0x58, 0x1d, 0xad, 0xf6, 0xca, 0xd8, 0x1a, 0xfd, 0xf0, 0xd9, 0xb0,
-	0x57, 0xd1, 0xff, 0xa1, 0x04, 0xcd, 0x64, 0x1d, 0xf1, 0x29, 0x00, 0x8a, 0xf0, 0xf8, 0xc8, 0xf1,
-	0x52, 0x07, 0xef, 0x5a, 0x7e, 0xa7, 0x65, 0x7c, 0xd5, 0xcf, 0xb0, 0x97, 0xcd, 0x2b, 0x49, 0x3c,
-	0xc1, 0x83, 0x3d, 0xe8, 0x16, 0x3b, 0xe7, 0x78, 0xba, 0x77, 0xf3, 0x56, 0xa5, 0xbb, 0xf2, 0x56,
-	0x61, 0x69, 0x9c, 0x49, 0xac, 0x9d, 0x33, 0x30, 0xf7, 0xa0, 0x99, 0xa0, 0x45, 0x0b, 0x1a, 0x1b,
-	0xc3, 0xcd, 0xd5, 0xe7, 0xdb, 0xc8, 0x2a, 0x00, 0xf5, 0xbd, 0xad, 0x9d, 0xc7, 0xdb, 0x43, 0xbe,
-	0xd6, 0xf6, 0xd6, 0xde, 0xa8, 0x57, 0xd6, 0x7f, 0xbf, 0x04, 0xcd, 0xc4, 0x93, 0x11, 0x1f, 0xa1,
-	0xf3, 0x41, 0x4e, 0x9a, 0xb2, 0x44, 0x94, 0x11, 0xca, 0x85, 0xad, 0x46, 0xd2, 0x8f, 0xb2, 0x48,
-	0x8a, 0x35, 0xf1, 0x6d, 0x08, 0xc8, 0x47, 0xcd, 0x95, 0x42, 0x42, 0x47, 0x40, 0xd5, 0xf6, 0x3d,
-	0xa9, 0x1c, 0x66, 0x6a, 0x13, 0x0f, 0x3a, 0x9e, 0x25, 0xb3, 0x70, 0xa2, 0x41, 0xf0, 0x28, 0xd2,
-	0x63, 0xf6, 0xa3, 0xd3, 0x83, 0xa5, 0xbb, 0x95, 0xf2, 0xbb, 0x9d, 0x0b, 0x4a, 0xca, 0xe7, 0x83,
-	0x92, 0xcc, 0x70, 0xd6, 0x5e, 0x67, 0x38, 0xf5, 0x3f, 0xab, 0x42, 0xd7, 0x90, 0x51, 0xec, 0x87,
-	0x52, 0xf9, 0x85, 0xaf, 0x12, 0xa1, 0x77, 0x01, 0x42, 0x1e, 0x9c, 0x6d, 0xad, 0x29, 0x0c, 0x47,
-	0x53, 0xae, 0x6f, 0x11, 0xef, 0x2a, 0x0b, 0x99, 0xc2, 0xe2, 0x1a, 0x68, 0xfb, 0xa6, 0x75, 0xcc,
-	0xcb, 0xb2, 0x9d, 0x6c, 0x32, 0x82, 0xd7, 0x35, 0x2d, 0x4b, 0x46, 0xd1, 0x18, 0x59, 0x81, 0xad,
-	0xa5, 0xc6, 0x98, 0x27, 0xf2, 0x0c, 0xbb, 0x23, 0x69, 0x85, 0x32, 0xa6, 0xee, 0x3a, 0x77, 0x33,
-	0x06, 0xbb, 0x6f, 0x41, 0x27, 0x92, 0x11, 0x5a, 0xd6, 0x71, 0xec, 0x1f, 0x4b, 0x4f, 0xe9, 0xb1,
-	0xb6, 0x42, 0x8e, 0x10, 0x87, 0x2a, 0xc6, 0xf4, 0x7c, 0xef, 0x6c, 0xe2, 0x4f, 0x23, 0x65, 0x33,
-	0x32, 0x84, 0x58, 0x86, 0xcb, 0xd2, 0xb3, 0xc2, 0xb3, 0x00, 0xcf, 0x8a, 0xbb, 0x8c, 0x0f, 0x1c,
-	0x57, 0x2a, 0x57, 0x7d, 0x31, 0xeb, 0x7a, 0x22, 0xcf, 0x36, 0x1d, 0x57, 0xe2, 0x89, 0x4e, 0xcc,
-	0xa9, 0x1b, 0x8f, 0x29, 0x13, 0x00, 0x7c, 0x22, 0xc2, 0xac, 0xda, 0x76, 0x28, 0xee, 0xc0, 0x22,
-	0x77, 0x87, 0xbe, 0x2b, 0x1d, 0x9b, 0x17, 0x6b, 0xd1, 0xa8, 0x4b, 0xd4, 0x61, 0x10, 0x9e, 0x96,
-	0x5a, 0x86, 0xcb, 0x3c, 0x96, 0x2f, 0x94, 0x8c, 0x6e, 0xf3, 0xd6, 0xd4, 0xb5, 0xa7, 0x7a, 0x8a,
-	0x5b, 0x07, 0x66, 0x7c, 0x44, 0xfe, 0x7d, 0xb2, 0xf5, 0x33, 0x33, 0x3e, 0x42, 0x8b, 0xcf, 0xdd,
-	0x07, 0x8e, 0x74, 0x39, 0x3e, 0xd7, 0x0c, 0x9e, 0xb1, 0x89, 0x18, 0xb4, 0xf8, 0x6a, 0x80, 0x1f,
-	0x4e, 0x4c, 0x4e, 0x2c, 0x6a, 0x06, 0x4f, 0xda, 0x24, 0x14, 0x6e, 0xa1, 0xde, 0xca, 0x9b, 0x4e,
-	0x28, 0xc5, 0x58, 0x35, 0xd4, 0xeb, 0xed, 0x4c, 0x27, 0xfa, 0xcb, 0x0a, 0x34, 0xd3, 0x70, 0xef,
-	0x2e, 0x68, 0x93, 0x44, 0x5f, 0x29, 0x47, 0xad, 0x53, 0x50, 0x62, 0x46, 0xd6, 0x2f, 0xde, 0x85,
-	0xf2, 0xf1, 0x89, 0xd2, 0x9d, 0x9d, 0x65, 0x4e, 0xb4, 0x07, 0xfb, 0x8f, 0x96, 0x9f, 0xbc, 0x30,
-	0xca, 0xc7, 0x27, 0x5f, 0x83, 0x6f, 0xc5, 0x87, 0x70, 0xc9, 0x72, 0xa5, 0xe9, 0x8d, 0x33, 0xef,
-	0x82, 0xf9, 0xa2, 0x4b, 0xe8, 0x67, 0xa9, 0x8b, 0x71, 0x1b, 0x6a, 0xb6, 0x74, 0x63, 0x33, 0x9f,
-	0xef, 0xdd, 0x0d, 0x4d, 0xcb, 0x95, 0x1b, 0x88, 0x36, 0xb8, 0x17, 0x75, 0x67, 0x1a, 0x62, 0xe5,
-	0x74, 0xe7, 0x9c, 0xf0, 0x2a, 0x95, 0x4b, 0xc8, 0xcb, 0xe5, 0x5d, 0x58, 0x94, 0xa7, 0x01, 0x19,
-	0x8c, 0x71, 0x9a, 0x51, 0x60, 0x4b, 0xd6, 0x4b, 0x3a, 0xd6, 0x93, 0xcc, 0xc2, 0xc7, 0xa8, 0x32,
-	0x48, 0x68, 0xe8, 0x99, 0x5b, 0x2b, 0x82, 0x74, 0x4e, 0x41, 0x0c, 0x8d, 0x64, 0x88, 0xf8, 0x08,
-	0x34, 0xcb, 0xb6, 0xc6, 0x4c, 0x99, 0x4e, 0x76, 0xb6, 0xf5, 0x8d, 0x75, 0x26, 0x49, 0xd3, 0xb2,
-	0x2d, 0xf6, 0xaa, 0x0b, 0xa1, 0x5f, 0xf7, 0x4d, 0x42, 0xbf, 0xbc, 0x51, 0xec, 0x15, 0x8c, 0xe2,
-	0xe7, 0xd5, 0x66, 0xa3, 0xd7, 0xd4, 0x6f, 0x41, 0x33, 0xd9, 0x08, 0x55, 0x5d, 0x24, 0x3d, 0x15,
-	0xd6, 0x93, 0xaa, 0x43, 0x70, 0x14, 0xe9, 0x16, 0x54, 0x9e, 0xbc, 0xd8, 0x23, 0x8d, 0x87, 0xc6,
-	0xa7, 0x46, 0xbe, 0x0a, 0xb5, 0x53, 0x2d, 0x58, 0xce, 0x69, 0xc1, 0xeb, 0x6c, 0x40, 0xe8, 0x81,
-	0x92, 0x5c, 0x68, 0x0e, 0x83, 0x24, 0x66, 0xe3, 0x59, 0xe5, 0x34, 0x29, 0x01, 0xfa, 0x7f, 0x54,
-	0xa0, 0xa1, 0xfc, 0x1b, 0x34, 0x1a, 0xd3, 0x34, 0x8d, 0x87, 0xcd, 0x62, 0xe0, 0x99, 0x3a, 0x4a,
-	0xf9, 0x5a, 0x4a, 0xe5, 0xf5, 0xb5, 0x14, 0xf1, 0x29, 0xb4, 0x03, 0xee, 0xcb, 0xbb, 0x56, 0x6f,
-	0xe7, 0xe7, 0xa8, 0x5f, 0x9a, 0xd7, 0x0a, 0x32, 0x00, 0x49, 0x49, 0x09, 0xe5, 0xd8, 0x3c, 0x54,
-	0x14, 0x68, 0x20, 0x3c, 0x32, 0x0f, 0xdf, 0xc8, 0x4f, 0xea, 0x92, 0xc3, 0xd5, 0x26, 0x85, 0x8b,
-	0xbe, 0x55, 0xfe, 0x65, 0x3a, 0x45, 0x77, 0xe5, 0x1a, 0x68, 0x96, 0x3f, 0x99, 0x38, 0xd4, 0xd7,
-	0x55, 0x69, 0x2b, 0x42, 0x8c, 0x22, 0xfd, 0x77, 0x4b, 0xd0, 0x50, 0xf7, 0x3a, 0x67, 0x0c, 0xd7,
-	0xb6, 0x76, 0x56, 0x8d, 0x1f, 0xf6, 0x4a, 0x68, 0xec, 0xb7, 0x76, 0x46, 0xbd, 0xb2, 0xd0, 0xa0,
-	0xb6, 0xb9, 0xbd, 0xbb, 0x3a, 0xea, 0x55, 0xd0, 0x40, 0xae, 0xed, 0xee, 0x6e, 0xf7, 0xaa, 0xa2,
-	0x0d, 0xcd, 0x8d, 0xd5, 0xd1, 0x70, 0xb4, 0xf5, 0x74, 0xd8, 0xab, 0xe1, 0xd8, 0xc7, 0xc3, 0xdd,
-	0x5e, 0x1d, 0x1b, 0xcf, 0xb7, 0x36, 0x7a, 0x0d, 0xec, 0x7f, 0xb6, 0xba, 0xb7, 0xf7, 0xfd, 0x5d,
-	0x63, 0xa3, 0xd7, 0x24, 0x23, 0x3b, 0x32, 0xb6, 0x76, 0x1e, 0xf7, 0x34, 0x6c, 0xef, 0xae, 0x7d,
-	0x3e, 0x5c, 0x1f, 0xf5, 0x40, 0x7f, 0x08, 0xad, 0x1c, 0xad, 0x70, 0xb6, 0x31, 0xdc, 0xec, 0x2d,
-	0xe0, 0x96, 0x2f, 0x56, 0xb7, 0x9f, 0xa3, 0x4d, 0xee, 0x02, 0x50, 0x73, 0xbc, 0xbd, 0xba, 0xf3,
-	0xb8, 0x57, 0x56, 0x1e, 0xdd, 0xf7, 0xa0, 0xf9, 0xdc, 0xb1, 0xd7, 0x5c, 0xdf, 0x3a, 0x46, 0xf6,
-	0xd9, 0x37, 0x23, 0xa9, 0xf8, 0x8d, 0xda, 0xe8, 0x3f, 0x93, 0xd0, 0x46, 0xea, 0xad, 0x15, 0x84,
-	0x14, 0xf3, 0xa6, 0x93, 0x31, 0xd5, 0xdb, 0x2a, 0x6c, 0xb8, 0xbc, 0xe9, 0xe4, 0xb9, 0x63, 0x47,
-	0xfa, 0x31, 0x34, 0x9e, 0x3b, 0xf6, 0x33, 0xd3, 0x3a, 0x26, 0xe5, 0x86, 0x4b, 0x8f, 0x23, 0xe7,
-	0x0b, 0xa9, 0x0c, 0x9c, 0x46, 0x98, 0x3d, 0xe7, 0x0b, 0x29, 0xde, 0x87, 0x3a, 0x01, 0x49, 0xca,
-	0x81, 0x44, 0x2d, 0x39, 0x8e, 0xa1, 0xfa, 0xa8, 0xdc, 0xe5, 0xba, 0xbe, 0x35, 0x0e, 0xe5, 0x41,
-	0xff, 0x6d, 0x7e, 0x01, 0x42, 0x18, 0xf2, 0x40, 0xff, 0xbd, 0x52, 0x7a, 0x73, 0xaa, 0xaa, 0xdc,
-	0x80, 0x6a, 0x60, 0x5a, 0xc7, 0xca, 0xbf, 0x68, 0xa9, 0x05, 0xf1, 0x30, 0x06, 0x75, 0x88, 0x0f,
-	0xa1, 0xa9, 0x18, 0x29, 0xd9, 0xb5, 0x95, 0xe3, 0x38, 0x23, 0xed, 0x2c, 0x3e, 0x7c, 0xa5, 0xf8,
-	0xf0, 0x14, 0x9d, 0x06, 0xae, 0x13, 0xb3, 0xd8, 0xa0, 0x70, 0x12, 0xa4, 0x7f, 0x13, 0x20, 0x2b,
-	0x70, 0xcd, 0x71, 0xb7, 0xae, 0x40, 0xcd, 0x74, 0x1d, 0x33, 0x89, 0x76, 0x19, 0xd0, 0x77, 0xa0,
-	0x95, 0x2b, 0x8b, 0x21, 0x6d, 0x4d, 0xd7, 0x45, 0xcb, 0xc8, 0xb2, 0xdf, 0x34, 0x1a, 0xa6, 0xeb,
-	0x3e, 0x91, 0x67, 0x11, 0xba, 0xba, 0x5c, 0x51, 0x2b, 0xcf, 0x14, 0x5d, 0x68, 0xaa, 0xc1, 0x9d,
-	0xfa, 0xc7, 0x50, 0xdf, 0x4c, 0x02, 0x82, 0x44, 0x18, 0x4a, 0x17, 0x09, 0x83, 0xfe, 0x89, 0x3a,
-	0x33, 0xd5, 0x6d, 0xc4, 0x5d, 0x55, 0xb9, 0x8b, 0xb8, 0x4e, 0x58, 0xca, 0xf2, 0x25, 0x3c, 0x48,
-	0x15, 0xed, 0x68, 0xb0, 0xbe, 0x01, 0xcd, 0x57, 0xd6, 0x42, 0x15, 0x01, 0xca, 0x19, 0x01, 0xe6,
-	0x54, 0x47, 0xf5, 0x1f, 0x03, 0x64, 0x15, 0x3e, 0x25, 0x9b, 0xbc, 0x0a, 0xca, 0xe6, 0x1d, 0x68,
-	0x5a, 0x47, 0x8e, 0x6b, 0x87, 0xd2, 0x2b, 0xdc, 0x3a, 0xab, 0x09, 0xa6, 0xfd, 0xe2, 0x26, 0x54,
-	0xa9, 0x70, 0x59, 0xc9, 0x34, 0x77, 0x5a, 0xb5, 0xa4, 0x1e, 0xfd, 0x14, 0x3a, 0x1c, 0x43, 0xbc,
-	0x81, 0x07, 0x56, 0x54, 0x9d, 0xe5, 0x73, 0xaa, 0xf3, 0x2a, 0xd4, 0xc9, 0xf0, 0x27, 0xb7, 0x51,
-	0xd0, 0x05, 0x2a, 0xf5, 0x77, 0xca, 0x00, 0xbc, 0xf5, 0x8e, 0x6f, 0xcb, 0x62, 0xb0, 0x5e, 0x9a,
-	0x0d, 0xd6, 0x05, 0x54, 0xd3, 0x9a, 0xb4, 0x66, 0x50, 0x3b, 0x33, 0x86, 0x2a, 0x80, 0x67, 0x63,
-	0xf8, 0x0e, 0x68, 0xe4, 0x88, 0x39, 0x5f, 0x50, 0x41, 0x04, 0x37, 0xcc, 0x10, 0xf9, 0x0a, 0x6d,
-	0xad, 0x58, 0xa1, 0x4d, 0xcb, 0x55, 0x75, 0x5e, 0x8d, 0xcb, 0x55, 0x73, 0x2a, 0x6f, 0x9c, 0x41,
-	0x89, 0x64, 0x18, 0x27, 0xe1, 0x3f, 0x43, 0x69, 0x24, 0xab, 0xa9, 0xb1, 0x26, 0xe7, 0x40, 0x3c,
-	0x7f, 0x6c, 0xf9, 0xde, 0x81, 0xeb, 0x58, 0xb1, 0xaa, 0xc8, 0x82, 0xe7, 0xaf, 0x2b, 0x8c, 0xfe,
-	0x29, 0xb4, 0x13, 0xfa, 0x53, 0x81, 0xeb, 0x4e, 0x1a, 0xe5, 0x95, 0xb2, 0xb7, 0xcd, 0xc8, 0xb4,
-	0x56, 0xee, 0x97, 0x92, 0x38, 0x4f, 0xff, 0xef, 0x4a, 0x32, 0x59, 0xd5, 0x61, 0x5e, 0x4d, 0xc3,
-	0x62, 0xe0, 0x5e, 0x7e, 0xa3, 0xc0, 0xfd, 0xdb, 0xa0, 0xd9, 0x14, 0x8b, 0x3a, 0x27, 0x89, 0x11,
-	0x1b, 0xcc, 0xc6, 0x9d, 0x2a, 0x5a, 0x75, 0x4e, 0xa4, 0x91, 0x0d, 0x7e, 0xcd, 0x3b, 0xa4, 0xd4,
-	0xae, 0xcd, 0xa3, 0x76, 0xfd, 0x57, 0xa4, 0xf6, 0x7b, 0xd0, 0xf6, 0x7c, 0x6f, 0xec, 0x4d, 0x5d,
-	0xd7, 0xdc, 0x77, 0xa5, 0x22, 0x77, 0xcb, 0xf3, 0xbd, 0x1d, 0x85, 0x42, 0xef, 0x38, 0x3f, 0x84,
-	0x85, 0xba, 0x45, 0xe3, 0x2e, 0xe5, 0xc6, 0x91, 0xe8, 0x2f, 0x41, 0xcf, 0xdf, 0xff, 0xb1, 0xb4,
-	0x62, 0xa2, 0xd8, 0x98, 0xa4, 0x99, 0x5d, 0xe3, 0x2e, 0xe3, 0x91, 0x44, 0x3b, 0x28, 0xd7, 0x33,
-	0xcf, 0xdc, 0x39, 0xf7, 0xcc, 0x9f, 0x80, 0x96, 0x52, 0x29, 0x17, 0xf7, 0x6a, 0x50, 0xdb, 0xda,
-	0xd9, 0x18, 0xfe, 0xa0, 0x57, 0x42, 0x73, 0x69, 0x0c, 0x5f, 0x0c, 0x8d, 0xbd, 0x61, 0xaf, 0x8c,
-	0xa6, 0x6c, 0x63, 0xb8, 0x3d, 0x1c, 0x0d, 0x7b, 0x15, 0x76, 0x85, 0xa8, 0x1c, 0xe2, 0x3a, 0x96,
-	0x13, 0xeb, 0x7b, 0x00, 0x59, 0x30, 0x8f, 0x5a, 0x39, 0x3b, 0x9c, 0xca, 0x26, 0xc6, 0xc9, 0xb1,
-	0x96, 0x52, 0x81, 0x2c, 0x5f, 0x94, 0x32, 0xe0, 0x7e, 0x7d, 0x05, 0xb4, 0xa7, 0x66, 0xf0, 0x19,
-	0x17, 0x0e, 0x6f, 0x43, 0x37, 0x30, 0xc3, 0xd8, 0x49, 0xe2, 0x11, 0x56, 0x96, 0x6d, 0xa3, 0x93,
-	0x62, 0x51, 0xf7, 0xea, 0x7f, 0x5e, 0x82, 0x2b, 0x4f, 0xfd, 0x13, 0x99, 0xfa, 0xbb, 0xcf, 0xcc,
-	0x33, 0xd7, 0x37, 0xed, 0xd7, 0xb0, 0x21, 0x06, 0x54, 0xfe, 0x94, 0x0a, 0x79, 0x49, 0xd9, 0xd3,
-	0xd0, 0x18, 0xf3, 0x58, 0x7d, 0xaf, 0x21, 0xa3, 0x98, 0x3a, 0x95, 0x21, 0x45, 0x18, 0xbb, 0xde,
-	0x82, 0x7a, 0x7c, 0xea, 0x65, 0x45, 0xd8, 0x5a, 0x4c, 0x59, 0xf0, 0xb9, 0xee, 0x6f, 0x6d, 0xbe,
-	0xfb, 0xab, 0xaf, 0x83, 0x36, 0x3a, 0xa5, 0x3c, 0xf0, 0xb4, 0xe8, 0x80, 0x96, 0x5e, 0xe1, 0xe6,
-	0x94, 0x67, 0xdc, 0x9c, 0x7f, 0x2f, 0x41, 0x2b, 0xe7, 0xc7, 0x8b, 0xf7, 0xa0, 0x1a, 0x9f, 0x7a,
-	0xc5, 0x6f, 0x1d, 0x92, 0x4d, 0x0c, 0xea, 0x3a, 0x97, 0xeb, 0x2c, 0x9f, 0xcb, 0x75, 0x8a, 0x6d,
-	0xb8, 0xc4, 0x9a, 0x37, 0xb9, 0x44, 0x92, 0x12, 0xba, 0x35, 0x13, 0x37, 0x70, 0xae, 0x3c, 0xb9,
-	0x92, 0xca, 0x73, 0x74, 0x0f, 0x0b, 0xc8, 0xc1, 0x2a, 0x5c, 0x9e, 0x33, 0xec, 0xeb, 0x54, 0x4d,
-	0xf4, 0x1b, 0xd0, 0x19, 0x9d, 0x7a, 0x23, 0x67, 0x22, 0xa3, 0xd8, 0x9c, 0x04, 0xe4, 0x26, 0x2a,
-	0xcb, 0x59, 0x35, 0xca, 0x71, 0xa4, 0x7f, 0x00, 0xed, 0x67, 0x52, 0x86, 0x86, 0x8c, 0x02, 0xdf,
-	0x63, 0xe7, 0x48, 0xe5, 0xa8, 0xd9, 0x4c, 0x2b, 0x48, 0xff, 0x2d, 0xd0, 0x0c, 0xf3, 0x20, 0x5e,
-	0x33, 0x63, 0xeb, 0xe8, 0xeb, 0x24, 0x3d, 0x3e, 0x80, 0x46, 0xc0, 0x3c, 0xa5, 0xa2, 0xbb, 0x36,
-	0x99, 0x6b, 0xc5, 0x67, 0x46, 0xd2, 0xa9, 0xff, 0x1a, 0x74, 0x55, 0xc1, 0x28, 0x39, 0x49, 0xae,
-	0xaa, 0x54, 0xba, 0xb0, 0xaa, 0xa4, 0x1f, 0x42, 0x27, 0x99, 0xc7, 0xc6, 0xef, 0x8d, 0xa6, 0x7d,
-	0xfd, 0xb2, 0xbd, 0xfe, 0x9b, 0x70, 0x79, 0x6f, 0xba, 0x1f, 0x59, 0xa1, 0x43, 0x91, 0x7c, 0xb2,
-	0xdd, 0x00, 0x9a, 0x41, 0x28, 0x0f, 0x9c, 0x53, 0x99, 0x88, 0x58, 0x0a, 0x8b, 0x3b, 0xd0, 0x98,
-	0x20, 0xbd, 0x64, 0x26, 0xbc, 0x59, 0xcc, 0xfa, 0x14, 0x7b, 0x8c, 0x64, 0x80, 0xfe, 0x1d, 0xb8,
-	0x52, 0x5c, 0x5e, 0x51, 0xe1, 0x16, 0x54, 0x8e, 0x4f, 0x22, 0x45, 0xe6, 0xc5, 0x42, 0xcc, 0x4b,
-	0xdf, 0x4b, 0x60, 0xaf, 0xfe, 0xc7, 0x25, 0xa8, 0xec, 0x4c, 0x27, 0xf9, 0x8f, 0xc1, 0xaa, 0xfc,
-	0x31, 0xd8, 0xb5, 0x7c, 0x3e, 0x9b, 0x63, 0xa8, 0x2c, 0x6f, 0xfd, 0x0e, 0x68, 0x07, 0x7e, 0xf8,
-	0x53, 0x33, 0xb4, 0xa5, 0xad, 0x2c, 0x70, 0x86, 0x10, 0xb7, 0x95, 0xbd, 0xe6, 0x18, 0x66, 0x11,
-	0xa9, 0xb8, 0x33, 0x9d, 0x2c, 0xbb, 0xd2, 0x8c, 0xc8, 0xb0, 0xb0, 0x09, 0xd7, 0xef, 0x82, 0x96,
-	0xa2, 0x50, 0x19, 0xee, 0xec, 0x8d, 0xb7, 0x36, 0x38, 0x1f, 0x88, 0xde, 0x7e, 0x09, 0x15, 0xe1,
-	0xe8, 0x07, 0x3b, 0xe3, 0xd1, 0x5e, 0xaf, 0xac, 0xff, 0x08, 0x5a, 0x89, 0xac, 0x6c, 0xd9, 0x54,
-	0xfc, 0x22, 0x61, 0xdd, 0xb2, 0x0b, 0xb2, 0xbb, 0x45, 0xe1, 0x98, 0xf4, 0xec, 0xad, 0x44, 0xc8,
-	0x18, 0x28, 0xde, 0x46, 0x55, 0xd2, 0x92, 0xdb, 0xe8, 0x43, 0x58, 0x34, 0x28, 0x89, 0x8f, 0x46,
-	0x36, 0x79, 0x9e, 0xab, 0x50, 0xf7, 0x7c, 0x5b, 0xa6, 0x1b, 0x28, 0x08, 0x77, 0x56, 0x0f, 0xab,
-	0xd4, 0x57, 0xfa, 0xce, 0x12, 0x16, 0x51, 0x23, 0x16, 0x99, 0xaa, 0x90, 0x60, 0x2e, 0xcd, 0x24,
-	0x98, 0x71, 0x13, 0x55, 0x4b, 0x66, 0xdf, 0x26, 0xa9, 0x1f, 0x0f, 0xa0, 0x69, 0x47, 0x31, 0x89,
-	0xb0, 0xd2, 0x83, 0x29, 0xac, 0xdf, 0x87, 0xcb, 0xab, 0x41, 0xe0, 0x9e, 0x25, 0x95, 0x37, 0xb5,
-	0x51, 0x3f, 0x2b, 0xcf, 0x95, 0x54, 0x0c, 0xc8, 0xa0, 0xbe, 0x09, 0xed, 0x24, 0x9b, 0xf0, 0x54,
-	0xc6, 0x26, 0x69, 0x37, 0xd7, 0x29, 0x84, 0xd3, 0x4d, 0x46, 0x8c, 0x8a, 0x69, 0xec, 0x99, 0xfb,
-	0x2d, 0x43, 0x5d, 0xa9, 0x4e, 0x01, 0x55, 0xcb, 0xb7, 0x79, 0xa3, 0x9a, 0x41, 0x6d, 0xe4, 0xa0,
-	0x49, 0x74, 0x98, 0x78, 0xb7, 0x93, 0xe8, 0x50, 0xff, 0xa7, 0x32, 0x74, 0xd6, 0x28, 0x77, 0x93,
-	0x9c, 0x31, 0x97, 0xb1, 0x2c, 0x15, 0x32, 0x96, 0xf9, 0xec, 0x64, 0xb9, 0x90, 0x9d, 0x2c, 0x1c,
-	0xa8, 0x52, 0x74, 0x49, 0xdf, 0x86, 0xc6, 0xd4, 0x73, 0x4e, 0x13, 0x9b, 0xa0, 0x19, 0x75, 0x04,
-	0x47, 0x91, 0xb8, 0x09, 0x2d, 0x34, 0x1b, 0x8e, 0xc7, 0x19, 0x41, 0x4e, 0xeb, 0xe5, 0x51, 0x33,
-	0x79, 0xbf, 0xfa, 0xab, 0xf3, 0x7e, 0x8d, 0xd7, 0xe6, 0xfd, 0x9a, 0xaf, 0xcb, 0xfb, 0x69, 0xb3,
-	0x79, 0xbf, 0xa2, 0x3b, 0x0d, 0xe7, 0xdc, 0xe9, 0x77, 0x01, 0xf8, 0x83, 0x97, 0x83, 0xa9, 0xeb,
-	0x2a, 0x1f, 0x45, 0x23, 0xcc, 0xe6, 0xd4, 0x75, 0xf5, 0x6d, 0xe8, 0x26, 0xa4, 0x55, 0xe2, 0xfe,
-	0x29, 0x5c, 0x52, 0x19, 0x7d, 0x19, 0xaa, 0xa4, 0x18, 0x6b, 0x31, 0x92, 0x3f, 0x4e, 0xba, 0xab,
-	0x1e, 0xa3, 0x6b, 0xe7, 0xc1, 0x48, 0xff, 0x79, 0x09, 0x3a, 0x85, 0x11, 0xe2, 0x61, 0x56, 0x1f,
-	0x28, 0x91, 0x14, 0xf7, 0xcf, 0xad, 0xf2, 0xea, 0x1a, 0x41, 0x79, 0xa6, 0x46, 0xa0, 0xdf, 0x4b,
-	0x33, 0xff, 0x2a, 0xdf, 0xbf, 0x90, 0xe6, 0xfb, 0x29, 0x45, 0xbe, 0x3a, 0x1a, 0x19, 0xbd, 0xb2,
-	0xa8, 0x43, 0x79, 0x67, 0xaf, 0x57, 0xd1, 0xff, 0xb2, 0x0c, 0x9d, 0xe1, 0x69, 0x40, 0x1f, 0x7f,
-	0xbd, 0x36, 0x36, 0xc9, 0xf1, 0x55, 0xb9, 0xc0, 0x57, 0x39, 0x0e, 0xa9, 0xa8, 0x82, 0x27, 0x73,
-	0x08, 0x46, 0x2b, 0x9c, 0x85, 0x54, 0x9c, 0xc3, 0xd0, 0xff, 0x07, 0xce, 0x29, 0x68, 0x14, 0x98,
-	0x2d, 0x59, 0x6d, 0x43, 0x37, 0x21, 0x9b, 0x62, 0x8c, 0x37, 0x12, 0x56, 0xfe, 0xdc, 0xd3, 0x4d,
-	0x93, 0x62, 0x0c, 0xe8, 0x7f, 0x52, 0x06, 0x8d, 0xf9, 0x0c, 0x0f, 0xff, 0x91, 0xd2, 0xeb, 0xa5,
-	0xac, 0x3a, 0x92, 0x76, 0x2e, 0x3f, 0x91, 0x67, 0x99, 0x6e, 0x9f, 0x5b, 0x51, 0x54, 0xa9, 0x33,
-	0xce, 0x1e, 0x50, 0xea, 0xec, 0x1a, 0x68, 0xec, 0x82, 0x4d, 0x55, 0x6a, 0xbe, 0x6a, 0xb0, 0x4f,
-	0xf6, 0xdc, 0xa1, 0xda, 0x60, 0x2c, 0xc3, 0x89, 0x7a, 0x03, 0x6a, 0x17, 0xe3, 0xb4, 0x4e, 0x12,
-	0x39, 0x14, 0x28, 0xd2, 0x98, 0xa5, 0xc8, 0x11, 0x34, 0xd4, 0xd9, 0xd0, 0xcd, 0x7e, 0xbe, 0xf3,
-	0x64, 0x67, 0xf7, 0xfb, 0x3b, 0x05, 0xee, 0x4b, 0x1d, 0xf1, 0x72, 0xde, 0x11, 0xaf, 0x20, 0x7e,
-	0x7d, 0xf7, 0xf9, 0xce, 0xa8, 0x57, 0x15, 0x1d, 0xd0, 0xa8, 0x39, 0x36, 0x86, 0x2f, 0x7a, 0x35,
-	0xca, 0x3c, 0xad, 0x7f, 0x36, 0x7c, 0xba, 0xda, 0xab, 0xa7, 0xb5, 0xaa, 0x86, 0xfe, 0x47, 0x25,
-	0x58, 0x64, 0x82, 0xe4, 0x93, 0x30, 0xf9, 0x0f, 0xb1, 0xab, 0xfc, 0x21, 0xf6, 0xff, 0x6d, 0xde,
-	0x05, 0x27, 0x4d, 0x9d, 0xa4, 0x3a, 0xcc, 0x09, 0xc1, 0xe6, 0xd4, 0x51, 0x45, 0xe1, 0xbf, 0x2b,
-	0xc1, 0x80, 0xfd, 0xff, 0xc7, 0xa1, 0x19, 0x1c, 0x7d, 0x6f, 0xfb, 0x5c, 0x06, 0xe0, 0x22, 0xaf,
-	0xf8, 0x36, 0x74, 0xe9, 0x53, 0xf5, 0x9f, 0xb8, 0x63, 0x15, 0xa5, 0xf2, 0xeb, 0x76, 0x14, 0x96,
-	0x17, 0x12, 0x8f, 0xa0, 0xcd, 0x9f, 0xb4, 0x53, 0x86, 0xbc, 0x50, 0xd9, 0x2c, 0x44, 0x1f, 0x2d,
-	0x1e, 0xc5, 0x75, 0xd8, 0x87, 0xe9, 0xa4, 0x2c, 0x59, 0x70, 0xbe, 0x78, 0xa9, 0xa6, 0x8c, 0x28,
-	0x85, 0x70, 0x1f, 0xae, 0xcd, 0xbd, 0x87, 0x62, 0xfb, 0x5c, 0xa2, 0x96, 0xb9, 0x4d, 0xff, 0xe7,
-	0x12, 0x34, 0xd7, 0xa6, 0xee, 0x31, 0x19, 0xc1, 0x77, 0x01, 0xa4, 0x7d, 0x28, 0xd5, 0xb7, 0xe1,
-	0x25, 0x52, 0x0e, 0x1a, 0x62, 0xf8, 0xeb, 0xf0, 0x4f, 0x01, 0xf8, 0x8e, 0xe3, 0x89, 0x19, 0xa8,
-	0x27, 0xa2, 0x4a, 0x63, 0xb2, 0x80, 0xba, 0xcb, 0x53, 0x33, 0x50, 0x95, 0xc6, 0x28, 0x81, 0xb3,
-	0x0a, 0x6c, 0xe5, 0x15, 0x15, 0xd8, 0xc1, 0x0e, 0x74, 0x8b, 0x4b, 0xcc, 0x49, 0x90, 0x7d, 0x50,
-	0xfc, 0xca, 0xe5, 0x3c, 0x0d, 0x73, 0xfe, 0xfa, 0xe7, 0x70, 0x69, 0x26, 0xd9, 0xfe, 0x2a, 0x8d,
-	0x59, 0x10, 0x99, 0xf2, 0xac, 0xc8, 0x7c, 0x0c, 0x8b, 0x23, 0x33, 0x3a, 0x56, 0x31, 0x4c, 0x66,
-	0xbc, 0x63, 0x33, 0x3a, 0x1e, 0xa7, 0x44, 0xad, 0x23, 0xb8, 0x65, 0xeb, 0x0f, 0x41, 0xe4, 0x47,
-	0x2b, 0xfa, 0x63, 0x6c, 0x8a, 0xc3, 0x27, 0x32, 0x36, 0x13, 0x2f, 0x03, 0x11, 0x48, 0xbc, 0x95,
-	0xbf, 0x2d, 0x41, 0x15, 0x9d, 0x7e, 0x71, 0x0f, 0xb4, 0xcf, 0xa4, 0x19, 0xc6, 0xfb, 0xd2, 0x8c,
-	0x45, 0xc1, 0xc1, 0x1f, 0x10, 0xdd, 0xb2, 0x2f, 0x67, 0xf4, 0x85, 0x07, 0x25, 0xb1, 0xcc, 0xdf,
-	0xf5, 0x26, 0xdf, 0x2b, 0x77, 0x92, 0xe0, 0x81, 0x82, 0x8b, 0x41, 0x61, 0xbe, 0xbe, 0xb0, 0x44,
-	0xe3, 0x3f, 0xf7, 0x1d, 0x6f, 0x9d, 0xbf, 0x26, 0x15, 0xb3, 0xc1, 0xc6, 0xec, 0x0c, 0x71, 0x0f,
-	0xea, 0x5b, 0x11, 0x46, 0x35, 0xe7, 0x87, 0x12, 0xf1, 0xf3, 0x01, 0x8f, 0xbe, 0xb0, 0xf2, 0x17,
-	0x35, 0xa8, 0xfe, 0x48, 0x86, 0xbe, 0xf8, 0x18, 0x1a, 0xea, 0x3b, 0x23, 0x91, 0xfb, 0x9e, 0x68,
-	0x40, 0x09, 0x96, 0x99, 0x0f, 0x90, 0x68, 0x97, 0x1e, 0xbf, 0x5f, 0x56, 0x62, 0x12, 0xd9, 0x67,
-	0x50, 0xe7, 0x0e, 0xf5, 0x09, 0xf4, 0xf6, 0xe2, 0x50, 0x9a, 0x93, 0xdc, 0xf0, 0x22, 0xa9, 0xe6,
-	0xd5, 0xab, 0x88, 0x5e, 0x77, 0xa1, 0xce, 0xa1, 0xe3, 0xcc, 0x84, 0xd9, 0x62, 0x14, 0x0d, 0xfe,
-	0x10, 0x5a, 0x7b, 0x47, 0xfe, 0xd4, 0xb5, 0xf7, 0x64, 0x78, 0x22, 0x45, 0x2e, 0xfa, 0x19, 0xe4,
-	0xda, 0xfa, 0x82, 0x78, 0x08, 0x75, 0x7c, 0x91, 0x70, 0x22, 0x16, 0x73, 0x11, 0x12, 0xb3, 0xc9,
-	0x40, 0xe4, 0x51, 0x09, 0xa5, 0xc4, 0x87, 0xa0, 0xb1, 0xfb, 0x8e, 0xce, 0x7b, 0x43, 0x45, 0x04,
-	0x7c, 0x8c, 0x9c, 0x5b, 0xaf, 0x2f, 0x88, 0x25, 0x80, 0x5c, 0xcc, 0xf9, 0xaa, 0x91, 0x8f, 0xa0,
-	0xb3, 0x4e, 0x9a, 0x70, 0x37, 0x5c, 0xdd, 0xf7, 0xc3, 0x58, 0xcc, 0x7e, 0xfb, 0x38, 0x98, 0x45,
-	0xe8, 0x0b, 0x18, 0xbd, 0x8d, 0xc2, 0x33, 0x1e, 0xbf, 0xa8, 0x42, 0xf5, 0x6c, 0xbf, 0x39, 0x74,
-	0x11, 0xdf, 0x4c, 0xe5, 0x2a, 0xf5, 0xda, 0xe7, 0x55, 0xb6, 0x98, 0x44, 0x2c, 0x03, 0x44, 0x22,
-	0xc8, 0x42, 0x0a, 0xf1, 0x16, 0x57, 0xd9, 0x66, 0x42, 0x8c, 0xf3, 0x53, 0xb2, 0xf0, 0x81, 0xa7,
-	0x9c, 0x0b, 0x27, 0x66, 0xa6, 0x7c, 0x0b, 0xda, 0xf9, 0x50, 0x40, 0x50, 0xb9, 0x68, 0x4e, 0x70,
-	0x50, 0x9c, 0xb6, 0xf2, 0x9f, 0x35, 0xa8, 0x7f, 0xdf, 0x0f, 0x8f, 0x65, 0x28, 0xee, 0x40, 0x9d,
-	0xea, 0xa5, 0x4a, 0x96, 0xd2, 0xda, 0xe9, 0x3c, 0xda, 0xbd, 0x0f, 0x1a, 0x71, 0x06, 0x0a, 0x3b,
-	0xf3, 0x2b, 0xfd, 0x57, 0x87, 0x17, 0xe7, 0x0c, 0x26, 0x31, 0x77, 0x97, 0xb9, 0x35, 0xfd, 0x9e,
-	0xa0, 0x50, 0xcf, 0x1c, 0xd0, 0x93, 0x3e, 0x79, 0xb1, 0x87, 0xf2, 0xf9, 0xa0, 0x84, 0x3e, 0xc5,
-	0x1e, 0x3f, 0x1e, 0x0e, 0xca, 0xfe, 0x8b, 0xc0, 0xe2, 0x9f, 0x7d, 0xfc, 0xaf, 0x2f, 0x88, 0xfb,
-	0x50, 0x57, 0x26, 0x66, 0x31, 0x53, 0x84, 0xc9, 0x0d, 0x7b, 0x79, 0x94, 0x9a, 0xf0, 0x10, 0xea,
-	0x6c, 0x8e, 0x79, 0x42, 0x21, 0x16, 0x61, 0x3e, 0x2d, 0xfa, 0xd0, 0xfa, 0x82, 0xb8, 0x0b, 0x0d,
-	0x55, 0x0d, 0x15, 0x73, 0x4a, 0xa3, 0xe7, 0x5e, 0xac, 0xce, 0xbe, 0x16, 0xaf, 0x5f, 0x70, 0x57,
-	0x79, 0xfd, 0xa2, 0x2b, 0xc6, 0xa2, 0x6f, 0x48, 0x4b, 0x3a, 0xb9, 0xc4, 0x99, 0x48, 0x28, 0x32,
-	0x47, 0x7f, 0x7d, 0x02, 0x9d, 0x42, 0x92, 0x4d, 0xf4, 0x13, 0xb6, 0x98, 0xcd, 0xbb, 0x9d, 0xd3,
-	0x1a, 0xdf, 0x01, 0x4d, 0xa5, 0x05, 0xf6, 0x15, 0x63, 0xcc, 0x49, 0x42, 0x0c, 0xce, 0xe7, 0x05,
-	0x48, 0x15, 0xfc, 0x00, 0x2e, 0xcf, 0xb1, 0xad, 0x82, 0xbe, 0x66, 0xbd, 0xd8, 0x79, 0x18, 0xdc,
-	0xb8, 0xb0, 0x3f, 0x25, 0xc0, 0xaf, 0x26, 0x4e, 0xdf, 0x05, 0xc8, 0x4c, 0x0c, 0xcb, 0xc6, 0x39,
-	0x03, 0x35, 0xb8, 0x3a, 0x8b, 0x4e, 0x36, 0x5d, 0xeb, 0xff, 0xfd, 0x57, 0xd7, 0x4b, 0xbf, 0xf8,
-	0xea, 0x7a, 0xe9, 0xdf, 0xbe, 0xba, 0x5e, 0xfa, 0xf9, 0x2f, 0xaf, 0x2f, 0xfc, 0xe2, 0x97, 0xd7,
-	0x17, 0xfe, 0xf1, 0x97, 0xd7, 0x17, 0xf6, 0xeb, 0xf4, 0xa7, 0xb9, 0x47, 0xff, 0x13, 0x00, 0x00,
-	0xff, 0xff, 0x8f, 0xfa, 0xf4, 0xef, 0xaa, 0x37, 0x00, 0x00,
+func (m *SynonymUpdate) Reset()         { *m = SynonymUpdate{} }
+func (m *SynonymUpdate) String() string { return proto.CompactTextString(m) }
+func (*SynonymUpdate) ProtoMessage()    {}
+func (*SynonymUpdate) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f80abaa17e25ccc8, []int{74}
+}
+func (m *SynonymUpdate) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *SynonymUpdate) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_SynonymUpdate.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *SynonymUpdate) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SynonymUpdate.Merge(m, src)
+}
+func (m *SynonymUpdate) XXX_Size() int {
+	return m.Size()
+}
+func (m *SynonymUpdate) XXX_DiscardUnknown() {
+	xxx_messageInfo_SynonymUpdate.DiscardUnknown(m)
 }
 
-// Reference imports to suppress errors if they are not otherwise used.
-var _ context.Context
-var _ grpc.ClientConn
+var xxx_messageInfo_SynonymUpdate proto.InternalMessageInfo
 
-// This is a compile-time assertion to ensure that this generated file
-// is compatible with the grpc package it is being compiled against.
-const _ = grpc.SupportPackageIsVersion4
+func (m *SynonymUpdate) GetName() string {
+	if m != nil {
+		return m.Name
+	}
+	return ""
+}
 
-// RaftClient is the client API for Raft service.
-//
-// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
-type RaftClient interface {
-	Heartbeat(ctx context.Context, in *api.Payload, opts ...grpc.CallOption) (Raft_HeartbeatClient, error)
-	RaftMessage(ctx context.Context, opts ...grpc.CallOption) (Raft_RaftMessageClient, error)
-	JoinCluster(ctx context.Context, in *RaftContext, opts ...grpc.CallOption) (*api.Payload, error)
-	IsPeer(ctx context.Context, in *RaftContext, opts ...grpc.CallOption) (*PeerResponse, error)
+func (m *SynonymUpdate) GetWords() []string {
+	if m != nil {
+		return m.Words
+	}
+	return nil
 }
 
-type raftClient struct {
-	cc *grpc.ClientConn
+func (m *SynonymUpdate) GetVersion() uint64 {
+	if m != nil {
+		return m.Version
+	}
+	return 0
 }
 
-func NewRaftClient(cc *grpc.ClientConn) RaftClient {
-	return &raftClient{cc}
+type SchemaVersion struct {
+	Namespace uint64 `protobuf:"varint,1,opt,name=namespace,proto3" json:"namespace,omitempty"`
+	Version   uint64 `protobuf:"varint,2,opt,name=version,proto3" json:"version,omitempty"`
+	Graphql   bool   `protobuf:"varint,3,opt,name=graphql,proto3" json:"graphql,omitempty"`
+	Schema    string `protobuf:"bytes,4,opt,name=schema,proto3" json:"schema,omitempty"`
+	Author    string `protobuf:"bytes,5,opt,name=author,proto3" json:"author,omitempty"`
+	CreatedAt int64  `protobuf:"varint,6,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
 }
 
-func (c *raftClient) Heartbeat(ctx context.Context, in *api.Payload, opts ...grpc.CallOption) (Raft_HeartbeatClient, error) {
-	stream, err := c.cc.NewStream(ctx, &_Raft_serviceDesc.Streams[0], "/pb.Raft/Heartbeat", opts...)
-	if err != nil {
-		return nil, err
-	}
-	x := &raftHeartbeatClient{stream}
-	if err := x.ClientStream.SendMsg(in); err != nil {
-		return nil, err
+func (m *SchemaVersion) Reset()         { *m = SchemaVersion{} }
+func (m *SchemaVersion) String() string { return proto.CompactTextString(m) }
+func (*SchemaVersion) ProtoMessage()    {}
+func (*SchemaVersion) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f80abaa17e25ccc8, []int{75}
+}
+func (m *SchemaVersion) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *SchemaVersion) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_SchemaVersion.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	if err := x.ClientStream.CloseSend(); err != nil {
-		return nil, err
+}
+func (m *SchemaVersion) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SchemaVersion.Merge(m, src)
+}
+func (m *SchemaVersion) XXX_Size() int {
+	return m.Size()
+}
+func (m *SchemaVersion) XXX_DiscardUnknown() {
+	xxx_messageInfo_SchemaVersion.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_SchemaVersion proto.InternalMessageInfo
+
+func (m *SchemaVersion) GetNamespace() uint64 {
+	if m != nil {
+		return m.Namespace
 	}
-	return x, nil
+	return 0
 }
 
-type Raft_HeartbeatClient interface {
-	Recv() (*HealthInfo, error)
-	grpc.ClientStream
+func (m *SchemaVersion) GetVersion() uint64 {
+	if m != nil {
+		return m.Version
+	}
+	return 0
 }
 
-type raftHeartbeatClient struct {
-	grpc.ClientStream
+func (m *SchemaVersion) GetGraphql() bool {
+	if m != nil {
+		return m.Graphql
+	}
+	return false
 }
 
-func (x *raftHeartbeatClient) Recv() (*HealthInfo, error) {
-	m := new(HealthInfo)
-	if err := x.ClientStream.RecvMsg(m); err != nil {
-		return nil, err
+func (m *SchemaVersion) GetSchema() string {
+	if m != nil {
+		return m.Schema
 	}
-	return m, nil
+	return ""
 }
 
-func (c *raftClient) RaftMessage(ctx context.Context, opts ...grpc.CallOption) (Raft_RaftMessageClient, error) {
-	stream, err := c.cc.NewStream(ctx, &_Raft_serviceDesc.Streams[1], "/pb.Raft/RaftMessage", opts...)
-	if err != nil {
-		return nil, err
+func (m *SchemaVersion) GetAuthor() string {
+	if m != nil {
+		return m.Author
 	}
-	x := &raftRaftMessageClient{stream}
-	return x, nil
+	return ""
 }
 
-type Raft_RaftMessageClient interface {
-	Send(*RaftBatch) error
-	CloseAndRecv() (*api.Payload, error)
-	grpc.ClientStream
+func (m *SchemaVersion) GetCreatedAt() int64 {
+	if m != nil {
+		return m.CreatedAt
+	}
+	return 0
 }
 
-type raftRaftMessageClient struct {
-	grpc.ClientStream
+type Tombstone struct {
+	Namespace  uint64   `protobuf:"varint,1,opt,name=namespace,proto3" json:"namespace,omitempty"`
+	Uid        uint64   `protobuf:"varint,2,opt,name=uid,proto3" json:"uid,omitempty"`
+	DeletedAt  uint64   `protobuf:"varint,3,opt,name=deleted_at,json=deletedAt,proto3" json:"deleted_at,omitempty"`
+	CreatedAt  int64    `protobuf:"varint,4,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	Predicates []string `protobuf:"bytes,5,rep,name=predicates,proto3" json:"predicates,omitempty"`
+	Remove     bool     `protobuf:"varint,6,opt,name=remove,proto3" json:"remove,omitempty"`
+	StartTs    uint64   `protobuf:"varint,7,opt,name=start_ts,json=startTs,proto3" json:"start_ts,omitempty"`
 }
 
-func (x *raftRaftMessageClient) Send(m *RaftBatch) error {
-	return x.ClientStream.SendMsg(m)
+func (m *Tombstone) Reset()         { *m = Tombstone{} }
+func (m *Tombstone) String() string { return proto.CompactTextString(m) }
+func (*Tombstone) ProtoMessage()    {}
+func (*Tombstone) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f80abaa17e25ccc8, []int{76}
+}
+func (m *Tombstone) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *Tombstone) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_Tombstone.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *Tombstone) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_Tombstone.Merge(m, src)
+}
+func (m *Tombstone) XXX_Size() int {
+	return m.Size()
+}
+func (m *Tombstone) XXX_DiscardUnknown() {
+	xxx_messageInfo_Tombstone.DiscardUnknown(m)
 }
 
-func (x *raftRaftMessageClient) CloseAndRecv() (*api.Payload, error) {
-	if err := x.ClientStream.CloseSend(); err != nil {
-		return nil, err
+var xxx_messageInfo_Tombstone proto.InternalMessageInfo
+
+func (m *Tombstone) GetNamespace() uint64 {
+	if m != nil {
+		return m.Namespace
 	}
-	m := new(api.Payload)
-	if err := x.ClientStream.RecvMsg(m); err != nil {
-		return nil, err
+	return 0
+}
+
+func (m *Tombstone) GetUid() uint64 {
+	if m != nil {
+		return m.Uid
 	}
-	return m, nil
+	return 0
 }
 
-func (c *raftClient) JoinCluster(ctx context.Context, in *RaftContext, opts ...grpc.CallOption) (*api.Payload, error) {
-	out := new(api.Payload)
-	err := c.cc.Invoke(ctx, "/pb.Raft/JoinCluster", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *Tombstone) GetDeletedAt() uint64 {
+	if m != nil {
+		return m.DeletedAt
 	}
-	return out, nil
+	return 0
 }
 
-func (c *raftClient) IsPeer(ctx context.Context, in *RaftContext, opts ...grpc.CallOption) (*PeerResponse, error) {
-	out := new(PeerResponse)
-	err := c.cc.Invoke(ctx, "/pb.Raft/IsPeer", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *Tombstone) GetCreatedAt() int64 {
+	if m != nil {
+		return m.CreatedAt
 	}
-	return out, nil
+	return 0
 }
 
-// RaftServer is the server API for Raft service.
-type RaftServer interface {
-	Heartbeat(*api.Payload, Raft_HeartbeatServer) error
-	RaftMessage(Raft_RaftMessageServer) error
-	JoinCluster(context.Context, *RaftContext) (*api.Payload, error)
-	IsPeer(context.Context, *RaftContext) (*PeerResponse, error)
+func (m *Tombstone) GetPredicates() []string {
+	if m != nil {
+		return m.Predicates
+	}
+	return nil
 }
 
-// UnimplementedRaftServer can be embedded to have forward compatible implementations.
-type UnimplementedRaftServer struct {
+func (m *Tombstone) GetRemove() bool {
+	if m != nil {
+		return m.Remove
+	}
+	return false
 }
 
-func (*UnimplementedRaftServer) Heartbeat(req *api.Payload, srv Raft_HeartbeatServer) error {
-	return status.Errorf(codes.Unimplemented, "method Heartbeat not implemented")
+func (m *Tombstone) GetStartTs() uint64 {
+	if m != nil {
+		return m.StartTs
+	}
+	return 0
 }
-func (*UnimplementedRaftServer) RaftMessage(srv Raft_RaftMessageServer) error {
-	return status.Errorf(codes.Unimplemented, "method RaftMessage not implemented")
+
+type Trigger struct {
+	Name         string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	Predicate    string `protobuf:"bytes,2,opt,name=predicate,proto3" json:"predicate,omitempty"`
+	TypeName     string `protobuf:"bytes,3,opt,name=type_name,json=typeName,proto3" json:"type_name,omitempty"`
+	Url          string `protobuf:"bytes,4,opt,name=url,proto3" json:"url,omitempty"`
+	BeforeCommit bool   `protobuf:"varint,5,opt,name=before_commit,json=beforeCommit,proto3" json:"before_commit,omitempty"`
+	Version      uint64 `protobuf:"varint,6,opt,name=version,proto3" json:"version,omitempty"`
 }
-func (*UnimplementedRaftServer) JoinCluster(ctx context.Context, req *RaftContext) (*api.Payload, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method JoinCluster not implemented")
+
+func (m *Trigger) Reset()         { *m = Trigger{} }
+func (m *Trigger) String() string { return proto.CompactTextString(m) }
+func (*Trigger) ProtoMessage()    {}
+func (*Trigger) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f80abaa17e25ccc8, []int{77}
 }
-func (*UnimplementedRaftServer) IsPeer(ctx context.Context, req *RaftContext) (*PeerResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method IsPeer not implemented")
+func (m *Trigger) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
 }
-
-func RegisterRaftServer(s *grpc.Server, srv RaftServer) {
-	s.RegisterService(&_Raft_serviceDesc, srv)
+func (m *Trigger) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_Trigger.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *Trigger) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_Trigger.Merge(m, src)
+}
+func (m *Trigger) XXX_Size() int {
+	return m.Size()
+}
+func (m *Trigger) XXX_DiscardUnknown() {
+	xxx_messageInfo_Trigger.DiscardUnknown(m)
 }
 
-func _Raft_Heartbeat_Handler(srv interface{}, stream grpc.ServerStream) error {
-	m := new(api.Payload)
-	if err := stream.RecvMsg(m); err != nil {
-		return err
+var xxx_messageInfo_Trigger proto.InternalMessageInfo
+
+func (m *Trigger) GetName() string {
+	if m != nil {
+		return m.Name
 	}
-	return srv.(RaftServer).Heartbeat(m, &raftHeartbeatServer{stream})
+	return ""
 }
 
-type Raft_HeartbeatServer interface {
-	Send(*HealthInfo) error
-	grpc.ServerStream
+func (m *Trigger) GetPredicate() string {
+	if m != nil {
+		return m.Predicate
+	}
+	return ""
 }
 
-type raftHeartbeatServer struct {
-	grpc.ServerStream
+func (m *Trigger) GetTypeName() string {
+	if m != nil {
+		return m.TypeName
+	}
+	return ""
 }
 
-func (x *raftHeartbeatServer) Send(m *HealthInfo) error {
-	return x.ServerStream.SendMsg(m)
+func (m *Trigger) GetUrl() string {
+	if m != nil {
+		return m.Url
+	}
+	return ""
 }
 
-func _Raft_RaftMessage_Handler(srv interface{}, stream grpc.ServerStream) error {
-	return srv.(RaftServer).RaftMessage(&raftRaftMessageServer{stream})
+func (m *Trigger) GetBeforeCommit() bool {
+	if m != nil {
+		return m.BeforeCommit
+	}
+	return false
 }
 
-type Raft_RaftMessageServer interface {
-	SendAndClose(*api.Payload) error
-	Recv() (*RaftBatch, error)
-	grpc.ServerStream
+func (m *Trigger) GetVersion() uint64 {
+	if m != nil {
+		return m.Version
+	}
+	return 0
 }
 
-type raftRaftMessageServer struct {
-	grpc.ServerStream
+type StoredQuery struct {
+	Name    string   `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	Query   string   `protobuf:"bytes,2,opt,name=query,proto3" json:"query,omitempty"`
+	Groups  []string `protobuf:"bytes,3,rep,name=groups,proto3" json:"groups,omitempty"`
+	Version uint64   `protobuf:"varint,4,opt,name=version,proto3" json:"version,omitempty"`
 }
 
-func (x *raftRaftMessageServer) SendAndClose(m *api.Payload) error {
-	return x.ServerStream.SendMsg(m)
+func (m *StoredQuery) Reset()         { *m = StoredQuery{} }
+func (m *StoredQuery) String() string { return proto.CompactTextString(m) }
+func (*StoredQuery) ProtoMessage()    {}
+func (*StoredQuery) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f80abaa17e25ccc8, []int{78}
 }
-
-func (x *raftRaftMessageServer) Recv() (*RaftBatch, error) {
-	m := new(RaftBatch)
-	if err := x.ServerStream.RecvMsg(m); err != nil {
-		return nil, err
+func (m *StoredQuery) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *StoredQuery) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_StoredQuery.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return m, nil
+}
+func (m *StoredQuery) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_StoredQuery.Merge(m, src)
+}
+func (m *StoredQuery) XXX_Size() int {
+	return m.Size()
+}
+func (m *StoredQuery) XXX_DiscardUnknown() {
+	xxx_messageInfo_StoredQuery.DiscardUnknown(m)
 }
 
-func _Raft_JoinCluster_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(RaftContext)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(RaftServer).JoinCluster(ctx, in)
+var xxx_messageInfo_StoredQuery proto.InternalMessageInfo
+
+func (m *StoredQuery) GetName() string {
+	if m != nil {
+		return m.Name
 	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/pb.Raft/JoinCluster",
+	return ""
+}
+
+func (m *StoredQuery) GetQuery() string {
+	if m != nil {
+		return m.Query
 	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(RaftServer).JoinCluster(ctx, req.(*RaftContext))
+	return ""
+}
+
+func (m *StoredQuery) GetGroups() []string {
+	if m != nil {
+		return m.Groups
 	}
-	return interceptor(ctx, in, info, handler)
+	return nil
 }
 
-func _Raft_IsPeer_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(RaftContext)
-	if err := dec(in); err != nil {
-		return nil, err
+func (m *StoredQuery) GetVersion() uint64 {
+	if m != nil {
+		return m.Version
 	}
-	if interceptor == nil {
-		return srv.(RaftServer).IsPeer(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/pb.Raft/IsPeer",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(RaftServer).IsPeer(ctx, req.(*RaftContext))
-	}
-	return interceptor(ctx, in, info, handler)
+	return 0
 }
 
-var _Raft_serviceDesc = grpc.ServiceDesc{
-	ServiceName: "pb.Raft",
-	HandlerType: (*RaftServer)(nil),
-	Methods: []grpc.MethodDesc{
-		{
-			MethodName: "JoinCluster",
-			Handler:    _Raft_JoinCluster_Handler,
-		},
-		{
-			MethodName: "IsPeer",
-			Handler:    _Raft_IsPeer_Handler,
-		},
-	},
-	Streams: []grpc.StreamDesc{
-		{
-			StreamName:    "Heartbeat",
-			Handler:       _Raft_Heartbeat_Handler,
-			ServerStreams: true,
-		},
-		{
-			StreamName:    "RaftMessage",
-			Handler:       _Raft_RaftMessage_Handler,
-			ClientStreams: true,
-		},
-	},
-	Metadata: "pb.proto",
+type DeleteByQueryRequest struct {
+	Namespace uint64 `protobuf:"varint,1,opt,name=namespace,proto3" json:"namespace,omitempty"`
+	Query     string `protobuf:"bytes,2,opt,name=query,proto3" json:"query,omitempty"`
+	BatchSize uint64 `protobuf:"varint,3,opt,name=batch_size,json=batchSize,proto3" json:"batch_size,omitempty"`
 }
 
-// ZeroClient is the client API for Zero service.
-//
-// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
-type ZeroClient interface {
-	// These 3 endpoints are for handling membership.
-	Connect(ctx context.Context, in *Member, opts ...grpc.CallOption) (*ConnectionState, error)
-	UpdateMembership(ctx context.Context, in *Group, opts ...grpc.CallOption) (*api.Payload, error)
-	StreamMembership(ctx context.Context, in *api.Payload, opts ...grpc.CallOption) (Zero_StreamMembershipClient, error)
-	Oracle(ctx context.Context, in *api.Payload, opts ...grpc.CallOption) (Zero_OracleClient, error)
-	ShouldServe(ctx context.Context, in *Tablet, opts ...grpc.CallOption) (*Tablet, error)
-	Inform(ctx context.Context, in *TabletRequest, opts ...grpc.CallOption) (*TabletResponse, error)
-	AssignIds(ctx context.Context, in *Num, opts ...grpc.CallOption) (*AssignedIds, error)
-	Timestamps(ctx context.Context, in *Num, opts ...grpc.CallOption) (*AssignedIds, error)
-	CommitOrAbort(ctx context.Context, in *api.TxnContext, opts ...grpc.CallOption) (*api.TxnContext, error)
-	TryAbort(ctx context.Context, in *TxnTimestamps, opts ...grpc.CallOption) (*OracleDelta, error)
-	DeleteNamespace(ctx context.Context, in *DeleteNsRequest, opts ...grpc.CallOption) (*Status, error)
-	RemoveNode(ctx context.Context, in *RemoveNodeRequest, opts ...grpc.CallOption) (*Status, error)
-	MoveTablet(ctx context.Context, in *MoveTabletRequest, opts ...grpc.CallOption) (*Status, error)
-	ApplyLicense(ctx context.Context, in *ApplyLicenseRequest, opts ...grpc.CallOption) (*Status, error)
+func (m *DeleteByQueryRequest) Reset()         { *m = DeleteByQueryRequest{} }
+func (m *DeleteByQueryRequest) String() string { return proto.CompactTextString(m) }
+func (*DeleteByQueryRequest) ProtoMessage()    {}
+func (*DeleteByQueryRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f80abaa17e25ccc8, []int{79}
 }
-
-type zeroClient struct {
-	cc *grpc.ClientConn
+func (m *DeleteByQueryRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
 }
-
-func NewZeroClient(cc *grpc.ClientConn) ZeroClient {
-	return &zeroClient{cc}
+func (m *DeleteByQueryRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_DeleteByQueryRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *DeleteByQueryRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_DeleteByQueryRequest.Merge(m, src)
+}
+func (m *DeleteByQueryRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *DeleteByQueryRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_DeleteByQueryRequest.DiscardUnknown(m)
 }
 
-func (c *zeroClient) Connect(ctx context.Context, in *Member, opts ...grpc.CallOption) (*ConnectionState, error) {
-	out := new(ConnectionState)
-	err := c.cc.Invoke(ctx, "/pb.Zero/Connect", in, out, opts...)
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_DeleteByQueryRequest proto.InternalMessageInfo
+
+func (m *DeleteByQueryRequest) GetNamespace() uint64 {
+	if m != nil {
+		return m.Namespace
 	}
-	return out, nil
+	return 0
 }
 
-func (c *zeroClient) UpdateMembership(ctx context.Context, in *Group, opts ...grpc.CallOption) (*api.Payload, error) {
-	out := new(api.Payload)
-	err := c.cc.Invoke(ctx, "/pb.Zero/UpdateMembership", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *DeleteByQueryRequest) GetQuery() string {
+	if m != nil {
+		return m.Query
 	}
-	return out, nil
+	return ""
 }
 
-func (c *zeroClient) StreamMembership(ctx context.Context, in *api.Payload, opts ...grpc.CallOption) (Zero_StreamMembershipClient, error) {
-	stream, err := c.cc.NewStream(ctx, &_Zero_serviceDesc.Streams[0], "/pb.Zero/StreamMembership", opts...)
-	if err != nil {
-		return nil, err
-	}
-	x := &zeroStreamMembershipClient{stream}
-	if err := x.ClientStream.SendMsg(in); err != nil {
-		return nil, err
-	}
-	if err := x.ClientStream.CloseSend(); err != nil {
-		return nil, err
+func (m *DeleteByQueryRequest) GetBatchSize() uint64 {
+	if m != nil {
+		return m.BatchSize
 	}
-	return x, nil
+	return 0
 }
 
-type Zero_StreamMembershipClient interface {
-	Recv() (*MembershipState, error)
-	grpc.ClientStream
+type TriggerEvent struct {
+	Trigger  string `protobuf:"bytes,1,opt,name=trigger,proto3" json:"trigger,omitempty"`
+	Url      string `protobuf:"bytes,2,opt,name=url,proto3" json:"url,omitempty"`
+	Payload  []byte `protobuf:"bytes,3,opt,name=payload,proto3" json:"payload,omitempty"`
+	StartTs  uint64 `protobuf:"varint,4,opt,name=start_ts,json=startTs,proto3" json:"start_ts,omitempty"`
+	CommitTs uint64 `protobuf:"varint,5,opt,name=commit_ts,json=commitTs,proto3" json:"commit_ts,omitempty"`
+	Id       uint64 `protobuf:"varint,6,opt,name=id,proto3" json:"id,omitempty"`
+	Remove   bool   `protobuf:"varint,7,opt,name=remove,proto3" json:"remove,omitempty"`
 }
 
-type zeroStreamMembershipClient struct {
-	grpc.ClientStream
+func (m *TriggerEvent) Reset()         { *m = TriggerEvent{} }
+func (m *TriggerEvent) String() string { return proto.CompactTextString(m) }
+func (*TriggerEvent) ProtoMessage()    {}
+func (*TriggerEvent) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f80abaa17e25ccc8, []int{80}
 }
-
-func (x *zeroStreamMembershipClient) Recv() (*MembershipState, error) {
-	m := new(MembershipState)
-	if err := x.ClientStream.RecvMsg(m); err != nil {
-		return nil, err
-	}
-	return m, nil
+func (m *TriggerEvent) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
 }
-
-func (c *zeroClient) Oracle(ctx context.Context, in *api.Payload, opts ...grpc.CallOption) (Zero_OracleClient, error) {
-	stream, err := c.cc.NewStream(ctx, &_Zero_serviceDesc.Streams[1], "/pb.Zero/Oracle", opts...)
-	if err != nil {
-		return nil, err
-	}
-	x := &zeroOracleClient{stream}
-	if err := x.ClientStream.SendMsg(in); err != nil {
-		return nil, err
-	}
-	if err := x.ClientStream.CloseSend(); err != nil {
-		return nil, err
+func (m *TriggerEvent) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_TriggerEvent.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return x, nil
 }
-
-type Zero_OracleClient interface {
-	Recv() (*OracleDelta, error)
-	grpc.ClientStream
+func (m *TriggerEvent) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_TriggerEvent.Merge(m, src)
 }
-
-type zeroOracleClient struct {
-	grpc.ClientStream
+func (m *TriggerEvent) XXX_Size() int {
+	return m.Size()
+}
+func (m *TriggerEvent) XXX_DiscardUnknown() {
+	xxx_messageInfo_TriggerEvent.DiscardUnknown(m)
 }
 
-func (x *zeroOracleClient) Recv() (*OracleDelta, error) {
-	m := new(OracleDelta)
-	if err := x.ClientStream.RecvMsg(m); err != nil {
-		return nil, err
+var xxx_messageInfo_TriggerEvent proto.InternalMessageInfo
+
+func (m *TriggerEvent) GetTrigger() string {
+	if m != nil {
+		return m.Trigger
 	}
-	return m, nil
+	return ""
 }
 
-func (c *zeroClient) ShouldServe(ctx context.Context, in *Tablet, opts ...grpc.CallOption) (*Tablet, error) {
-	out := new(Tablet)
-	err := c.cc.Invoke(ctx, "/pb.Zero/ShouldServe", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *TriggerEvent) GetUrl() string {
+	if m != nil {
+		return m.Url
 	}
-	return out, nil
+	return ""
 }
 
-func (c *zeroClient) Inform(ctx context.Context, in *TabletRequest, opts ...grpc.CallOption) (*TabletResponse, error) {
-	out := new(TabletResponse)
-	err := c.cc.Invoke(ctx, "/pb.Zero/Inform", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *TriggerEvent) GetPayload() []byte {
+	if m != nil {
+		return m.Payload
 	}
-	return out, nil
+	return nil
 }
 
-func (c *zeroClient) AssignIds(ctx context.Context, in *Num, opts ...grpc.CallOption) (*AssignedIds, error) {
-	out := new(AssignedIds)
-	err := c.cc.Invoke(ctx, "/pb.Zero/AssignIds", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *TriggerEvent) GetStartTs() uint64 {
+	if m != nil {
+		return m.StartTs
 	}
-	return out, nil
+	return 0
 }
 
-func (c *zeroClient) Timestamps(ctx context.Context, in *Num, opts ...grpc.CallOption) (*AssignedIds, error) {
-	out := new(AssignedIds)
-	err := c.cc.Invoke(ctx, "/pb.Zero/Timestamps", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *TriggerEvent) GetCommitTs() uint64 {
+	if m != nil {
+		return m.CommitTs
 	}
-	return out, nil
+	return 0
 }
 
-func (c *zeroClient) CommitOrAbort(ctx context.Context, in *api.TxnContext, opts ...grpc.CallOption) (*api.TxnContext, error) {
-	out := new(api.TxnContext)
-	err := c.cc.Invoke(ctx, "/pb.Zero/CommitOrAbort", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *TriggerEvent) GetId() uint64 {
+	if m != nil {
+		return m.Id
 	}
-	return out, nil
+	return 0
 }
 
-func (c *zeroClient) TryAbort(ctx context.Context, in *TxnTimestamps, opts ...grpc.CallOption) (*OracleDelta, error) {
-	out := new(OracleDelta)
-	err := c.cc.Invoke(ctx, "/pb.Zero/TryAbort", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *TriggerEvent) GetRemove() bool {
+	if m != nil {
+		return m.Remove
 	}
-	return out, nil
+	return false
 }
 
-func (c *zeroClient) DeleteNamespace(ctx context.Context, in *DeleteNsRequest, opts ...grpc.CallOption) (*Status, error) {
-	out := new(Status)
-	err := c.cc.Invoke(ctx, "/pb.Zero/DeleteNamespace", in, out, opts...)
-	if err != nil {
-		return nil, err
+type TTLMark struct {
+	Ts     uint64 `protobuf:"varint,1,opt,name=ts,proto3" json:"ts,omitempty"`
+	Time   int64  `protobuf:"varint,2,opt,name=time,proto3" json:"time,omitempty"`
+	Remove bool   `protobuf:"varint,3,opt,name=remove,proto3" json:"remove,omitempty"`
+}
+
+func (m *TTLMark) Reset()         { *m = TTLMark{} }
+func (m *TTLMark) String() string { return proto.CompactTextString(m) }
+func (*TTLMark) ProtoMessage()    {}
+func (*TTLMark) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f80abaa17e25ccc8, []int{81}
+}
+func (m *TTLMark) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *TTLMark) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_TTLMark.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return out, nil
+}
+func (m *TTLMark) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_TTLMark.Merge(m, src)
+}
+func (m *TTLMark) XXX_Size() int {
+	return m.Size()
+}
+func (m *TTLMark) XXX_DiscardUnknown() {
+	xxx_messageInfo_TTLMark.DiscardUnknown(m)
 }
 
-func (c *zeroClient) RemoveNode(ctx context.Context, in *RemoveNodeRequest, opts ...grpc.CallOption) (*Status, error) {
-	out := new(Status)
-	err := c.cc.Invoke(ctx, "/pb.Zero/RemoveNode", in, out, opts...)
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_TTLMark proto.InternalMessageInfo
+
+func (m *TTLMark) GetTs() uint64 {
+	if m != nil {
+		return m.Ts
 	}
-	return out, nil
+	return 0
 }
 
-func (c *zeroClient) MoveTablet(ctx context.Context, in *MoveTabletRequest, opts ...grpc.CallOption) (*Status, error) {
-	out := new(Status)
-	err := c.cc.Invoke(ctx, "/pb.Zero/MoveTablet", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *TTLMark) GetTime() int64 {
+	if m != nil {
+		return m.Time
 	}
-	return out, nil
+	return 0
 }
 
-func (c *zeroClient) ApplyLicense(ctx context.Context, in *ApplyLicenseRequest, opts ...grpc.CallOption) (*Status, error) {
-	out := new(Status)
-	err := c.cc.Invoke(ctx, "/pb.Zero/ApplyLicense", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *TTLMark) GetRemove() bool {
+	if m != nil {
+		return m.Remove
 	}
-	return out, nil
+	return false
 }
 
-// ZeroServer is the server API for Zero service.
-type ZeroServer interface {
-	// These 3 endpoints are for handling membership.
-	Connect(context.Context, *Member) (*ConnectionState, error)
-	UpdateMembership(context.Context, *Group) (*api.Payload, error)
-	StreamMembership(*api.Payload, Zero_StreamMembershipServer) error
-	Oracle(*api.Payload, Zero_OracleServer) error
-	ShouldServe(context.Context, *Tablet) (*Tablet, error)
-	Inform(context.Context, *TabletRequest) (*TabletResponse, error)
-	AssignIds(context.Context, *Num) (*AssignedIds, error)
-	Timestamps(context.Context, *Num) (*AssignedIds, error)
-	CommitOrAbort(context.Context, *api.TxnContext) (*api.TxnContext, error)
-	TryAbort(context.Context, *TxnTimestamps) (*OracleDelta, error)
-	DeleteNamespace(context.Context, *DeleteNsRequest) (*Status, error)
-	RemoveNode(context.Context, *RemoveNodeRequest) (*Status, error)
-	MoveTablet(context.Context, *MoveTabletRequest) (*Status, error)
-	ApplyLicense(context.Context, *ApplyLicenseRequest) (*Status, error)
+func init() {
+	proto.RegisterEnum("pb.DirectedEdge_Op", DirectedEdge_Op_name, DirectedEdge_Op_value)
+	proto.RegisterEnum("pb.Mutations_DropOp", Mutations_DropOp_name, Mutations_DropOp_value)
+	proto.RegisterEnum("pb.Metadata_HintType", Metadata_HintType_name, Metadata_HintType_value)
+	proto.RegisterEnum("pb.Posting_ValType", Posting_ValType_name, Posting_ValType_value)
+	proto.RegisterEnum("pb.Posting_PostingType", Posting_PostingType_name, Posting_PostingType_value)
+	proto.RegisterEnum("pb.SchemaUpdate_Directive", SchemaUpdate_Directive_name, SchemaUpdate_Directive_value)
+	proto.RegisterEnum("pb.NumLeaseType", NumLeaseType_name, NumLeaseType_value)
+	proto.RegisterEnum("pb.DropOperation_DropOp", DropOperation_DropOp_name, DropOperation_DropOp_value)
+	proto.RegisterEnum("pb.BackupKey_KeyType", BackupKey_KeyType_name, BackupKey_KeyType_value)
+	proto.RegisterType((*List)(nil), "pb.List")
+	proto.RegisterType((*TaskValue)(nil), "pb.TaskValue")
+	proto.RegisterType((*SrcFunction)(nil), "pb.SrcFunction")
+	proto.RegisterType((*Query)(nil), "pb.Query")
+	proto.RegisterType((*ValueList)(nil), "pb.ValueList")
+	proto.RegisterType((*LangList)(nil), "pb.LangList")
+	proto.RegisterType((*Result)(nil), "pb.Result")
+	proto.RegisterType((*Order)(nil), "pb.Order")
+	proto.RegisterType((*SortMessage)(nil), "pb.SortMessage")
+	proto.RegisterType((*SortResult)(nil), "pb.SortResult")
+	proto.RegisterType((*RaftContext)(nil), "pb.RaftContext")
+	proto.RegisterType((*Member)(nil), "pb.Member")
+	proto.RegisterType((*Group)(nil), "pb.Group")
+	proto.RegisterMapType((map[uint64]*Member)(nil), "pb.Group.MembersEntry")
+	proto.RegisterMapType((map[string]*Tablet)(nil), "pb.Group.TabletsEntry")
+	proto.RegisterType((*License)(nil), "pb.License")
+	proto.RegisterType((*ZeroProposal)(nil), "pb.ZeroProposal")
+	proto.RegisterMapType((map[uint32]uint64)(nil), "pb.ZeroProposal.SnapshotTsEntry")
+	proto.RegisterType((*MembershipState)(nil), "pb.MembershipState")
+	proto.RegisterMapType((map[uint32]*Group)(nil), "pb.MembershipState.GroupsEntry")
+	proto.RegisterMapType((map[uint64]*Member)(nil), "pb.MembershipState.ZerosEntry")
+	proto.RegisterType((*ConnectionState)(nil), "pb.ConnectionState")
+	proto.RegisterType((*HealthInfo)(nil), "pb.HealthInfo")
+	proto.RegisterType((*Tablet)(nil), "pb.Tablet")
+	proto.RegisterType((*DirectedEdge)(nil), "pb.DirectedEdge")
+	proto.RegisterType((*Mutations)(nil), "pb.Mutations")
+	proto.RegisterType((*Metadata)(nil), "pb.Metadata")
+	proto.RegisterMapType((map[string]Metadata_HintType)(nil), "pb.Metadata.PredHintsEntry")
+	proto.RegisterType((*Snapshot)(nil), "pb.Snapshot")
+	proto.RegisterType((*ZeroSnapshot)(nil), "pb.ZeroSnapshot")
+	proto.RegisterType((*RestoreRequest)(nil), "pb.RestoreRequest")
+	proto.RegisterType((*Proposal)(nil), "pb.Proposal")
+	proto.RegisterType((*CDCState)(nil), "pb.CDCState")
+	proto.RegisterType((*KVS)(nil), "pb.KVS")
+	proto.RegisterType((*Posting)(nil), "pb.Posting")
+	proto.RegisterType((*UidBlock)(nil), "pb.UidBlock")
+	proto.RegisterType((*UidPack)(nil), "pb.UidPack")
+	proto.RegisterType((*PostingList)(nil), "pb.PostingList")
+	proto.RegisterType((*FacetParam)(nil), "pb.FacetParam")
+	proto.RegisterType((*FacetParams)(nil), "pb.FacetParams")
+	proto.RegisterType((*Facets)(nil), "pb.Facets")
+	proto.RegisterType((*FacetsList)(nil), "pb.FacetsList")
+	proto.RegisterType((*Function)(nil), "pb.Function")
+	proto.RegisterType((*FilterTree)(nil), "pb.FilterTree")
+	proto.RegisterType((*SchemaRequest)(nil), "pb.SchemaRequest")
+	proto.RegisterType((*SchemaNode)(nil), "pb.SchemaNode")
+	proto.RegisterType((*SchemaResult)(nil), "pb.SchemaResult")
+	proto.RegisterType((*SchemaUpdate)(nil), "pb.SchemaUpdate")
+	proto.RegisterType((*TypeUpdate)(nil), "pb.TypeUpdate")
+	proto.RegisterType((*MapHeader)(nil), "pb.MapHeader")
+	proto.RegisterType((*MovePredicatePayload)(nil), "pb.MovePredicatePayload")
+	proto.RegisterType((*TxnStatus)(nil), "pb.TxnStatus")
+	proto.RegisterType((*OracleDelta)(nil), "pb.OracleDelta")
+	proto.RegisterMapType((map[uint32]uint64)(nil), "pb.OracleDelta.GroupChecksumsEntry")
+	proto.RegisterType((*TxnTimestamps)(nil), "pb.TxnTimestamps")
+	proto.RegisterType((*PeerResponse)(nil), "pb.PeerResponse")
+	proto.RegisterType((*RaftBatch)(nil), "pb.RaftBatch")
+	proto.RegisterType((*TabletResponse)(nil), "pb.TabletResponse")
+	proto.RegisterType((*TabletRequest)(nil), "pb.TabletRequest")
+	proto.RegisterType((*SubscriptionRequest)(nil), "pb.SubscriptionRequest")
+	proto.RegisterType((*SubscriptionResponse)(nil), "pb.SubscriptionResponse")
+	proto.RegisterType((*Num)(nil), "pb.Num")
+	proto.RegisterType((*AssignedIds)(nil), "pb.AssignedIds")
+	proto.RegisterType((*RemoveNodeRequest)(nil), "pb.RemoveNodeRequest")
+	proto.RegisterType((*MoveTabletRequest)(nil), "pb.MoveTabletRequest")
+	proto.RegisterType((*ApplyLicenseRequest)(nil), "pb.ApplyLicenseRequest")
+	proto.RegisterType((*SnapshotMeta)(nil), "pb.SnapshotMeta")
+	proto.RegisterType((*Status)(nil), "pb.Status")
+	proto.RegisterType((*BackupRequest)(nil), "pb.BackupRequest")
+	proto.RegisterType((*BackupResponse)(nil), "pb.BackupResponse")
+	proto.RegisterType((*DropOperation)(nil), "pb.DropOperation")
+	proto.RegisterType((*ExportRequest)(nil), "pb.ExportRequest")
+	proto.RegisterType((*ExportResponse)(nil), "pb.ExportResponse")
+	proto.RegisterType((*BackupKey)(nil), "pb.BackupKey")
+	proto.RegisterType((*BackupPostingList)(nil), "pb.BackupPostingList")
+	proto.RegisterType((*UpdateGraphQLSchemaRequest)(nil), "pb.UpdateGraphQLSchemaRequest")
+	proto.RegisterType((*UpdateGraphQLSchemaResponse)(nil), "pb.UpdateGraphQLSchemaResponse")
+	proto.RegisterType((*BulkMeta)(nil), "pb.BulkMeta")
+	proto.RegisterMapType((map[string]*SchemaUpdate)(nil), "pb.BulkMeta.SchemaMapEntry")
+	proto.RegisterType((*DeleteNsRequest)(nil), "pb.DeleteNsRequest")
+	proto.RegisterType((*TaskStatusRequest)(nil), "pb.TaskStatusRequest")
+	proto.RegisterType((*TaskStatusResponse)(nil), "pb.TaskStatusResponse")
+	proto.RegisterType((*SynonymUpdate)(nil), "pb.SynonymUpdate")
+	proto.RegisterType((*SchemaVersion)(nil), "pb.SchemaVersion")
+	proto.RegisterType((*Tombstone)(nil), "pb.Tombstone")
+	proto.RegisterType((*Trigger)(nil), "pb.Trigger")
+	proto.RegisterType((*StoredQuery)(nil), "pb.StoredQuery")
+	proto.RegisterType((*DeleteByQueryRequest)(nil), "pb.DeleteByQueryRequest")
+	proto.RegisterType((*TriggerEvent)(nil), "pb.TriggerEvent")
+	proto.RegisterType((*TTLMark)(nil), "pb.TTLMark")
 }
 
-// UnimplementedZeroServer can be embedded to have forward compatible implementations.
-type UnimplementedZeroServer struct {
-}
+func init() { proto.RegisterFile("pb.proto", fileDescriptor_f80abaa17e25ccc8) }
 
-func (*UnimplementedZeroServer) Connect(ctx context.Context, req *Member) (*ConnectionState, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method Connect not implemented")
-}
-func (*UnimplementedZeroServer) UpdateMembership(ctx context.Context, req *Group) (*api.Payload, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method UpdateMembership not implemented")
-}
-func (*UnimplementedZeroServer) StreamMembership(req *api.Payload, srv Zero_StreamMembershipServer) error {
-	return status.Errorf(codes.Unimplemented, "method StreamMembership not implemented")
-}
-func (*UnimplementedZeroServer) Oracle(req *api.Payload, srv Zero_OracleServer) error {
-	return status.Errorf(codes.Unimplemented, "method Oracle not implemented")
-}
-func (*UnimplementedZeroServer) ShouldServe(ctx context.Context, req *Tablet) (*Tablet, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method ShouldServe not implemented")
-}
-func (*UnimplementedZeroServer) Inform(ctx context.Context, req *TabletRequest) (*TabletResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method Inform not implemented")
-}
-func (*UnimplementedZeroServer) AssignIds(ctx context.Context, req *Num) (*AssignedIds, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method AssignIds not implemented")
-}
-func (*UnimplementedZeroServer) Timestamps(ctx context.Context, req *Num) (*AssignedIds, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method Timestamps not implemented")
-}
-func (*UnimplementedZeroServer) CommitOrAbort(ctx context.Context, req *api.TxnContext) (*api.TxnContext, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method CommitOrAbort not implemented")
-}
-func (*UnimplementedZeroServer) TryAbort(ctx context.Context, req *TxnTimestamps) (*OracleDelta, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method TryAbort not implemented")
-}
-func (*UnimplementedZeroServer) DeleteNamespace(ctx context.Context, req *DeleteNsRequest) (*Status, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method DeleteNamespace not implemented")
-}
-func (*UnimplementedZeroServer) RemoveNode(ctx context.Context, req *RemoveNodeRequest) (*Status, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method RemoveNode not implemented")
+var fileDescriptor_f80abaa17e25ccc8 = []byte{
+	// 5993 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xd5, 0x3b, 0x49, 0x6f, 0x24, 0x59,
+	0x5a, 0x95, 0x7b, 0xc6, 0xcb, 0xc5, 0xe9, 0xa8, 0xea, 0xea, 0x9c, 0x6c, 0xa6, 0xab, 0x89, 0x5e,
+	0xa6, 0xa6, 0xab, 0xdb, 0xd5, 0xed, 0xea, 0x19, 0xa6, 0x7b, 0x34, 0x12, 0x5e, 0xd2, 0xd5, 0xee,
+	0xf2, 0xd6, 0x91, 0xe9, 0xea, 0xee, 0x91, 0x20, 0x15, 0xce, 0x0c, 0xdb, 0x31, 0xce, 0x8c, 0xc8,
+	0x89, 0x88, 0xf4, 0xd8, 0x73, 0xe3, 0xc2, 0x70, 0xe0, 0x30, 0x12, 0x07, 0x6e, 0x20, 0x71, 0x40,
+	0x20, 0x21, 0xb1, 0x68, 0x90, 0xb8, 0x70, 0x43, 0x08, 0x21, 0x0e, 0x73, 0x1c, 0xc4, 0x22, 0x04,
+	0x88, 0xc3, 0x08, 0x21, 0xf1, 0x0f, 0xf8, 0x96, 0xf7, 0x22, 0x5e, 0xa4, 0xd3, 0xae, 0xaa, 0x46,
+	0x1c, 0x38, 0x58, 0x8e, 0xef, 0x7b, 0xfb, 0xb7, 0xbd, 0x6f, 0x79, 0x29, 0xaa, 0xd3, 0xa3, 0x95,
+	0x69, 0x18, 0xc4, 0x81, 0x99, 0x9f, 0x1e, 0x75, 0x0c, 0x67, 0xea, 0x31, 0xd8, 0x79, 0xfb, 0xc4,
+	0x8b, 0x4f, 0x67, 0x47, 0x2b, 0xc3, 0x60, 0xf2, 0x70, 0x74, 0x12, 0x3a, 0xd3, 0xd3, 0x77, 0xbd,
+	0xe0, 0xe1, 0x91, 0x33, 0x3a, 0x71, 0xc3, 0x87, 0xe7, 0x8f, 0x1e, 0x4e, 0x8f, 0x1e, 0xaa, 0xa1,
+	0x9d, 0x77, 0xb5, 0xbe, 0x27, 0xc1, 0x49, 0xf0, 0x90, 0xd0, 0x47, 0xb3, 0x63, 0x82, 0x08, 0xa0,
+	0x2f, 0xee, 0x6e, 0x75, 0x44, 0x71, 0xc7, 0x8b, 0x62, 0xd3, 0x14, 0xc5, 0x99, 0x37, 0x8a, 0xda,
+	0xb9, 0xd7, 0x0a, 0xf7, 0xcb, 0x36, 0x7d, 0x5b, 0xbb, 0xc2, 0xe8, 0x3b, 0xd1, 0xd9, 0x53, 0x67,
+	0x3c, 0x73, 0xcd, 0x96, 0x28, 0x9c, 0x3b, 0x63, 0x68, 0xcf, 0xdd, 0xaf, 0xdb, 0xf8, 0x69, 0xae,
+	0x88, 0x2a, 0xfc, 0x1b, 0xc4, 0x97, 0x53, 0xb7, 0x9d, 0x07, 0x74, 0x73, 0xf5, 0xf6, 0x0a, 0x6c,
+	0xe3, 0x20, 0x88, 0x62, 0xcf, 0x3f, 0x59, 0x81, 0x61, 0x7d, 0x68, 0xb2, 0x2b, 0xe7, 0xfc, 0x61,
+	0xed, 0x8b, 0x5a, 0x2f, 0x1c, 0x6e, 0xcd, 0xfc, 0x61, 0xec, 0x05, 0x3e, 0xae, 0xe8, 0x3b, 0x13,
+	0x97, 0x66, 0x34, 0x6c, 0xfa, 0x46, 0x9c, 0x13, 0x9e, 0x44, 0xed, 0x02, 0xec, 0x02, 0x70, 0xf8,
+	0x6d, 0xb6, 0x45, 0xc5, 0x8b, 0x36, 0x82, 0x99, 0x1f, 0xb7, 0x8b, 0xd0, 0xb5, 0x6a, 0x2b, 0xd0,
+	0xfa, 0x59, 0x41, 0x94, 0x3e, 0x9d, 0xb9, 0xe1, 0x25, 0x8d, 0x8b, 0xe3, 0x50, 0xcd, 0x85, 0xdf,
+	0xe6, 0x1d, 0x51, 0x1a, 0x3b, 0x3e, 0x4c, 0x96, 0xa7, 0xc9, 0x18, 0x30, 0x5f, 0x11, 0x86, 0x73,
+	0x1c, 0xbb, 0xe1, 0x00, 0x4e, 0x08, 0xcb, 0xe4, 0xe0, 0xb0, 0x55, 0x42, 0x1c, 0x7a, 0x23, 0xf3,
+	0x2b, 0xa2, 0x3a, 0x0a, 0x06, 0x43, 0x7d, 0xad, 0x51, 0x40, 0x6b, 0x99, 0xaf, 0x8b, 0x2a, 0x8c,
+	0x18, 0x8c, 0x81, 0x56, 0xed, 0x12, 0x34, 0xd5, 0x56, 0xab, 0x78, 0x58, 0xa4, 0x9d, 0x5d, 0x81,
+	0x16, 0x22, 0xe2, 0xdb, 0xa2, 0x1a, 0x85, 0xc3, 0xc1, 0x31, 0x1c, 0xb1, 0x5d, 0xa6, 0x4e, 0x4b,
+	0xd8, 0x49, 0x3b, 0xb5, 0x5d, 0x89, 0x18, 0xc0, 0x63, 0x85, 0xee, 0xb9, 0x1b, 0x46, 0x6e, 0xbb,
+	0xc2, 0x4b, 0x49, 0xd0, 0x7c, 0x4f, 0xd4, 0x8e, 0x9d, 0xa1, 0x1b, 0x0f, 0xa6, 0x4e, 0xe8, 0x4c,
+	0xda, 0xd5, 0x74, 0xa2, 0x2d, 0x44, 0x1f, 0x20, 0x36, 0xb2, 0xc5, 0x71, 0x02, 0x98, 0x8f, 0x44,
+	0x83, 0xa0, 0x68, 0x70, 0xec, 0x8d, 0xe1, 0x2c, 0x6d, 0x83, 0xc6, 0x34, 0x69, 0x0c, 0x61, 0xfa,
+	0xa1, 0xeb, 0xda, 0x75, 0xee, 0xc4, 0x18, 0xf3, 0xab, 0x42, 0xb8, 0x17, 0x53, 0xc7, 0x1f, 0x0d,
+	0x9c, 0xf1, 0xb8, 0x2d, 0x68, 0x0f, 0x06, 0x63, 0xd6, 0xc6, 0x63, 0xf3, 0x65, 0xdc, 0x9f, 0x33,
+	0x1a, 0xc4, 0x51, 0xbb, 0x01, 0x6d, 0x45, 0xbb, 0x8c, 0x60, 0x3f, 0x42, 0xba, 0x0e, 0x9d, 0xe1,
+	0xa9, 0xdb, 0x6e, 0x02, 0xba, 0x64, 0x33, 0x80, 0xd8, 0x63, 0x2f, 0x04, 0xe2, 0x2c, 0x31, 0x96,
+	0x00, 0xf3, 0xae, 0x28, 0x07, 0xc7, 0xc7, 0x91, 0x1b, 0xb7, 0x5b, 0x84, 0x96, 0x90, 0x79, 0x4f,
+	0xd4, 0xe2, 0xd0, 0x19, 0x9e, 0x0d, 0x70, 0xce, 0xa8, 0xbd, 0x4c, 0x8b, 0x0b, 0x42, 0xd9, 0x88,
+	0xb1, 0x56, 0x85, 0x41, 0x62, 0x47, 0x64, 0x7d, 0x53, 0x94, 0xcf, 0x11, 0x60, 0xe9, 0xac, 0xad,
+	0x36, 0xf0, 0x5c, 0x89, 0x64, 0xda, 0xb2, 0xd1, 0x7a, 0x55, 0x54, 0x77, 0x80, 0xc7, 0x4a, 0x9c,
+	0x91, 0xdf, 0x34, 0x00, 0x04, 0x02, 0xbf, 0xad, 0x3f, 0xcf, 0x8b, 0xb2, 0xed, 0x46, 0xb3, 0x71,
+	0x6c, 0x7e, 0x4d, 0x08, 0xe4, 0xe6, 0xc4, 0x89, 0x43, 0xef, 0x42, 0xce, 0x9a, 0xf2, 0xd3, 0x80,
+	0xb6, 0x5d, 0x6a, 0x02, 0x5e, 0xd4, 0x69, 0x76, 0xd5, 0x35, 0x9f, 0x6e, 0x20, 0xd9, 0x9f, 0x5d,
+	0xa3, 0x2e, 0x72, 0x04, 0x1c, 0x99, 0x04, 0x88, 0x85, 0xb8, 0x61, 0x4b, 0x08, 0x0e, 0xd1, 0xf4,
+	0xfc, 0x18, 0x19, 0x3c, 0x8c, 0x07, 0x23, 0x37, 0x52, 0x12, 0xd6, 0x48, 0xb0, 0x9b, 0x80, 0x34,
+	0xdf, 0x17, 0xcc, 0x25, 0xb5, 0x60, 0x89, 0x16, 0x6c, 0x26, 0xdc, 0x8f, 0x78, 0x45, 0xea, 0x23,
+	0x57, 0x7c, 0x57, 0xd4, 0xf0, 0x7c, 0x6a, 0x44, 0x99, 0x46, 0xd4, 0xe9, 0x34, 0x92, 0x1c, 0xb6,
+	0xc0, 0x0e, 0xb2, 0x3b, 0x92, 0x06, 0xa5, 0x98, 0xa5, 0x8e, 0xbe, 0x51, 0x2b, 0x88, 0xd9, 0x67,
+	0xee, 0x65, 0x04, 0x02, 0x87, 0x34, 0xab, 0x22, 0xe2, 0x09, 0xc0, 0x56, 0x57, 0x94, 0xf6, 0xc3,
+	0x11, 0x48, 0xcc, 0x22, 0x2d, 0x03, 0x1c, 0x1c, 0x66, 0x48, 0x06, 0x00, 0x66, 0xc3, 0xef, 0x54,
+	0xf3, 0x0a, 0x9a, 0xe6, 0x59, 0xbf, 0x93, 0x03, 0xfd, 0x0f, 0xc2, 0x78, 0xd7, 0x8d, 0x22, 0xe7,
+	0xc4, 0x05, 0x19, 0x28, 0x05, 0x38, 0xad, 0x24, 0xbf, 0x81, 0x1b, 0xa6, 0x75, 0x6c, 0xc6, 0xcf,
+	0x31, 0x29, 0x7f, 0x3d, 0x93, 0x50, 0x22, 0x49, 0x67, 0x0b, 0x52, 0x22, 0x49, 0x63, 0x53, 0xd9,
+	0x2b, 0x66, 0x64, 0xef, 0x3a, 0xc1, 0xb6, 0xbe, 0x21, 0x04, 0xee, 0xef, 0x05, 0x45, 0xc4, 0xfa,
+	0x11, 0x9c, 0xcb, 0x06, 0x13, 0xb2, 0x11, 0x00, 0x23, 0x2f, 0x62, 0xb3, 0x29, 0xf2, 0x60, 0x5a,
+	0x72, 0x64, 0x5a, 0xe0, 0x0b, 0x77, 0x77, 0x12, 0x06, 0xb3, 0x29, 0x91, 0xa8, 0x61, 0x33, 0x40,
+	0xb4, 0x1c, 0x8d, 0x42, 0xda, 0x32, 0xd2, 0x12, 0xbe, 0x51, 0x2b, 0x22, 0xdf, 0x99, 0x46, 0xa7,
+	0x41, 0x8c, 0xbb, 0x2b, 0xd2, 0xee, 0x84, 0x42, 0x81, 0xea, 0x81, 0xca, 0x7a, 0xd1, 0x60, 0xec,
+	0x3a, 0xa1, 0x0f, 0x74, 0x2b, 0xb1, 0xca, 0x7a, 0xd1, 0x0e, 0x23, 0xac, 0x1f, 0x15, 0x44, 0x79,
+	0xd7, 0x9d, 0x1c, 0x01, 0xed, 0xe6, 0x37, 0xf1, 0x9e, 0xa8, 0xd2, 0xba, 0x03, 0xc0, 0xd2, 0x3e,
+	0xd6, 0x5f, 0xfa, 0xf9, 0x3f, 0xdf, 0x5b, 0x26, 0xdc, 0xf6, 0xe8, 0x9d, 0x60, 0xe2, 0xc5, 0xee,
+	0x64, 0x1a, 0x5f, 0xda, 0x15, 0x89, 0x5a, 0xb8, 0x41, 0x20, 0x29, 0x2c, 0x8e, 0x3c, 0x63, 0xd9,
+	0x95, 0x10, 0x48, 0x60, 0xc5, 0x99, 0x80, 0x50, 0x3b, 0x23, 0xde, 0xd4, 0xfa, 0x1d, 0x98, 0xbc,
+	0xe5, 0x4c, 0x36, 0x01, 0xa3, 0xcd, 0x5d, 0x66, 0x8c, 0xf9, 0x21, 0x0a, 0x6c, 0x14, 0x0f, 0x66,
+	0xd3, 0x91, 0x13, 0xbb, 0x64, 0x29, 0x8b, 0xeb, 0x6d, 0x18, 0x72, 0x07, 0xd1, 0x87, 0x84, 0xd5,
+	0x86, 0x89, 0x14, 0x8b, 0x56, 0x53, 0x1d, 0x5f, 0x5a, 0x4d, 0x09, 0x9a, 0xdb, 0x62, 0x79, 0x38,
+	0x9e, 0x45, 0x68, 0xda, 0x3d, 0xff, 0x38, 0x18, 0x04, 0xfe, 0xf8, 0x92, 0x18, 0x5c, 0x5d, 0xff,
+	0x2a, 0x4c, 0xfd, 0x15, 0xd9, 0xb8, 0x0d, 0x6d, 0xfb, 0xd0, 0xa4, 0xcd, 0xbf, 0x34, 0xd7, 0x64,
+	0xfe, 0xb2, 0x68, 0x1e, 0x07, 0xe1, 0xd0, 0x1d, 0x24, 0x24, 0x6b, 0xd2, 0x3c, 0x1d, 0x98, 0xe7,
+	0x2e, 0xb5, 0x3c, 0xbe, 0x42, 0xb7, 0xba, 0x8e, 0xb7, 0xfe, 0x29, 0x2f, 0x4a, 0xf4, 0x0d, 0x84,
+	0xaf, 0x4c, 0x88, 0x25, 0xca, 0x78, 0xdd, 0x45, 0x19, 0xa2, 0xb6, 0x15, 0xe6, 0x55, 0xd4, 0xf5,
+	0xe3, 0x10, 0x08, 0x2f, 0xbb, 0xe1, 0x88, 0xd8, 0x39, 0x1a, 0x83, 0xaa, 0x4b, 0x99, 0xd7, 0x46,
+	0xf4, 0xb9, 0x41, 0x8e, 0x90, 0xdd, 0xe6, 0xe5, 0xa6, 0x70, 0x45, 0x6e, 0x3a, 0xa2, 0x0a, 0x36,
+	0x7a, 0x78, 0x16, 0xcd, 0x26, 0x52, 0xaa, 0x12, 0x18, 0x2e, 0xb6, 0x06, 0x7d, 0x4f, 0x03, 0x30,
+	0x44, 0x38, 0xbc, 0x44, 0x1d, 0xea, 0x29, 0xb2, 0x1f, 0x75, 0xb6, 0x44, 0x5d, 0xdf, 0x2c, 0x3a,
+	0x03, 0x60, 0x2a, 0x48, 0xbe, 0x8a, 0x36, 0x7e, 0x9a, 0xaf, 0x89, 0x12, 0x59, 0x41, 0x92, 0xae,
+	0xda, 0xaa, 0xc0, 0x3d, 0xf3, 0x10, 0x9b, 0x1b, 0x3e, 0xca, 0x7f, 0x2b, 0x87, 0xf3, 0xe8, 0x47,
+	0xd0, 0xe7, 0x31, 0xae, 0x9f, 0x87, 0x87, 0x68, 0xf3, 0x58, 0x81, 0xa8, 0xec, 0x78, 0x43, 0xd7,
+	0x8f, 0xc8, 0x65, 0x98, 0x45, 0x6e, 0x62, 0x94, 0xf0, 0x1b, 0xcf, 0x3b, 0x71, 0x2e, 0xf6, 0x02,
+	0xb0, 0x46, 0x34, 0x0f, 0x9c, 0x57, 0xc1, 0xd8, 0x06, 0x97, 0x9c, 0x17, 0x5e, 0xf6, 0x99, 0x52,
+	0x05, 0x3b, 0x81, 0x51, 0xba, 0x5c, 0x1f, 0x17, 0x1b, 0xa9, 0xeb, 0x5f, 0x82, 0xd6, 0x1f, 0x15,
+	0x45, 0xfd, 0xbb, 0x6e, 0x18, 0x1c, 0x84, 0xc1, 0x34, 0x88, 0xc0, 0xf9, 0x59, 0xcb, 0xd2, 0x9c,
+	0x79, 0xfb, 0x1a, 0xee, 0x56, 0xef, 0xb6, 0xd2, 0x4b, 0x98, 0xc0, 0x3c, 0xd3, 0xb9, 0x62, 0x89,
+	0x32, 0xf3, 0x7c, 0x01, 0xcd, 0x64, 0x0b, 0xf6, 0x61, 0x2e, 0xd3, 0x5e, 0xb3, 0xf4, 0x90, 0x2d,
+	0xa8, 0x95, 0x70, 0xba, 0xc3, 0xed, 0x4d, 0xc9, 0x5b, 0x09, 0x49, 0x2a, 0xf4, 0x2f, 0xfc, 0xbe,
+	0x62, 0x6a, 0x02, 0xe3, 0x49, 0x91, 0x22, 0x11, 0x0c, 0xaa, 0x53, 0x93, 0x02, 0xcd, 0x5f, 0x10,
+	0x06, 0x7c, 0xa2, 0x41, 0xdb, 0x1e, 0xb1, 0x6a, 0xda, 0x29, 0xc2, 0xfc, 0x45, 0x51, 0x88, 0x2f,
+	0x7c, 0xd2, 0x3d, 0xf4, 0x49, 0xd0, 0x45, 0x85, 0x09, 0xa5, 0xe9, 0xb3, 0xb1, 0x0d, 0x79, 0x3a,
+	0x04, 0x95, 0x31, 0x98, 0xa7, 0xf0, 0x09, 0x57, 0x5f, 0x65, 0xcc, 0xdc, 0x22, 0x37, 0xa3, 0xb6,
+	0x5a, 0x63, 0x3b, 0x4a, 0x28, 0x5b, 0xb5, 0x99, 0xef, 0x80, 0xf7, 0x24, 0xa9, 0xd3, 0xae, 0x51,
+	0xbf, 0x96, 0xa2, 0xa7, 0x22, 0xa3, 0x9d, 0xf4, 0x00, 0x35, 0x31, 0x46, 0x2e, 0x1c, 0xdf, 0x1d,
+	0xf8, 0x6c, 0xc8, 0x6b, 0xec, 0x7e, 0x6e, 0x12, 0x72, 0x2f, 0xb2, 0xdd, 0xef, 0x83, 0x53, 0x00,
+	0x23, 0x46, 0x12, 0x61, 0xbe, 0x91, 0x2a, 0x56, 0x93, 0xd8, 0xa5, 0x13, 0x53, 0x35, 0x75, 0xbe,
+	0x23, 0x96, 0xe6, 0x98, 0xa6, 0x4b, 0x69, 0x83, 0xa5, 0xf4, 0x8e, 0x2e, 0xa5, 0x45, 0x4d, 0x32,
+	0x3f, 0x29, 0x56, 0xab, 0x2d, 0xc3, 0xfa, 0xef, 0x82, 0x58, 0x92, 0x0a, 0x73, 0xea, 0x4d, 0x7b,
+	0xb1, 0x34, 0x5d, 0x74, 0x31, 0x49, 0x59, 0x05, 0x92, 0x4b, 0xd0, 0xfc, 0x25, 0x51, 0x26, 0x4b,
+	0xa3, 0x14, 0xfe, 0x5e, 0x2a, 0x08, 0xc9, 0x70, 0x36, 0x00, 0x52, 0x8a, 0x64, 0x77, 0xf3, 0x03,
+	0x51, 0xfa, 0x21, 0x50, 0x87, 0x2f, 0xda, 0xda, 0xea, 0xab, 0x8b, 0xc6, 0x21, 0xf9, 0xe4, 0x30,
+	0xee, 0xfc, 0xbf, 0x95, 0x17, 0xf1, 0x22, 0xf2, 0xf2, 0x06, 0x5e, 0xb6, 0x93, 0xe0, 0x1c, 0x34,
+	0xaa, 0x92, 0xd2, 0x5c, 0x0a, 0xb9, 0x6a, 0x52, 0x22, 0x53, 0x5d, 0x28, 0x32, 0xc6, 0xf5, 0x22,
+	0xd3, 0xd9, 0x14, 0x35, 0x8d, 0x2e, 0x0b, 0x18, 0x75, 0x2f, 0x6b, 0x4e, 0x8c, 0xc4, 0x94, 0xea,
+	0x56, 0x69, 0x53, 0x88, 0x94, 0x4a, 0x5f, 0xd6, 0xb6, 0x59, 0xbf, 0x96, 0x13, 0x4b, 0xa0, 0x08,
+	0xbe, 0x4b, 0x8e, 0x3e, 0xf3, 0x3c, 0x55, 0xf1, 0xdc, 0xb5, 0x2a, 0xfe, 0x75, 0x51, 0x8a, 0xb0,
+	0xb3, 0x9c, 0xfd, 0xf6, 0x02, 0x26, 0xda, 0xdc, 0x03, 0x0d, 0x3d, 0x90, 0x76, 0x30, 0x75, 0xfd,
+	0x11, 0x44, 0x58, 0xca, 0xd0, 0x03, 0xea, 0x80, 0x31, 0xd6, 0x5f, 0xe4, 0x85, 0xf8, 0xd8, 0x75,
+	0xc6, 0xf1, 0x29, 0x5e, 0x66, 0xc8, 0x51, 0xcf, 0x87, 0xa1, 0xfe, 0x50, 0x85, 0x59, 0x09, 0x8c,
+	0x1c, 0xc5, 0x3b, 0x1d, 0x9c, 0x31, 0x5a, 0xd8, 0xb0, 0x15, 0x88, 0xf2, 0x81, 0xcb, 0xcd, 0x22,
+	0x79, 0xf7, 0x4b, 0x28, 0x75, 0x64, 0x8a, 0x84, 0x96, 0x8e, 0x0c, 0xcc, 0x83, 0x61, 0x0b, 0x1c,
+	0x99, 0x84, 0x06, 0xe6, 0x91, 0x20, 0xce, 0x33, 0x9b, 0xc6, 0xde, 0x84, 0x6f, 0xf8, 0x82, 0x2d,
+	0x21, 0xdc, 0x15, 0xde, 0xe8, 0xdd, 0xe1, 0x69, 0x40, 0x86, 0x04, 0x2c, 0xb0, 0x82, 0x71, 0xb6,
+	0xc0, 0x3f, 0x09, 0xf0, 0x74, 0xec, 0x86, 0x2a, 0x90, 0xcf, 0x32, 0x72, 0x2f, 0xb0, 0xc9, 0x60,
+	0x0f, 0x55, 0xc1, 0x48, 0x17, 0xd7, 0x1d, 0x1c, 0xbb, 0xb0, 0x4d, 0x38, 0x01, 0x48, 0x28, 0x36,
+	0x0b, 0xd7, 0xdd, 0x92, 0x18, 0x30, 0x5b, 0x75, 0x24, 0x9c, 0x13, 0x45, 0xde, 0x89, 0x0f, 0xb2,
+	0x58, 0x23, 0xca, 0x21, 0x31, 0xd7, 0x24, 0xca, 0xfa, 0x4b, 0x88, 0x0e, 0xd8, 0x16, 0x64, 0x9c,
+	0xa5, 0xdc, 0x73, 0x39, 0x4b, 0xa0, 0x04, 0xd3, 0xd0, 0x1d, 0x79, 0x43, 0xc5, 0x47, 0xc3, 0x4e,
+	0x11, 0x14, 0x1b, 0xa1, 0x77, 0x40, 0xf4, 0xac, 0xda, 0x0c, 0x80, 0x6c, 0x34, 0x02, 0x7f, 0x30,
+	0xf2, 0xa2, 0xb3, 0xc1, 0xd1, 0x65, 0x0c, 0xdb, 0x66, 0x5a, 0xd4, 0x02, 0x7f, 0x13, 0x70, 0xeb,
+	0x88, 0x42, 0x12, 0xb2, 0x8e, 0x90, 0x6e, 0x54, 0x6d, 0x09, 0x41, 0xc0, 0xc7, 0xfe, 0x3a, 0x39,
+	0x39, 0x06, 0x39, 0x27, 0x77, 0x61, 0x8b, 0x26, 0x22, 0xe7, 0xbc, 0x9b, 0xaa, 0xc2, 0xa1, 0x97,
+	0x86, 0x83, 0xf1, 0xba, 0x22, 0x1d, 0x66, 0x2f, 0x0d, 0x51, 0xfd, 0x48, 0xf7, 0xd2, 0x18, 0x03,
+	0xdd, 0x4d, 0x88, 0x53, 0x83, 0xc9, 0x14, 0x85, 0xc2, 0x1d, 0xc9, 0x4d, 0xd6, 0x68, 0x93, 0xcb,
+	0x7a, 0x0b, 0x6d, 0xd5, 0xfa, 0xc7, 0xbc, 0xa8, 0x6f, 0x7a, 0x21, 0x48, 0xbf, 0x3b, 0xea, 0x8e,
+	0xc0, 0xbf, 0x87, 0xbd, 0xbb, 0x7e, 0xec, 0xc5, 0x97, 0xd2, 0x0d, 0x95, 0x50, 0x12, 0x45, 0xe4,
+	0xb3, 0xb1, 0x3a, 0x6b, 0x58, 0x81, 0xd2, 0x0b, 0x0c, 0x98, 0xab, 0x42, 0x70, 0xf0, 0x45, 0x29,
+	0x86, 0xe2, 0xf5, 0x29, 0x06, 0x83, 0xba, 0xe1, 0x27, 0x86, 0xf0, 0x3c, 0xc6, 0x63, 0x5f, 0xb4,
+	0x4c, 0xf9, 0x87, 0x99, 0xcb, 0x1e, 0x2d, 0xc5, 0x84, 0x15, 0x5e, 0x18, 0xbf, 0xc1, 0xfb, 0xc9,
+	0x07, 0x53, 0x22, 0xae, 0x9c, 0x5a, 0x3f, 0xc2, 0xca, 0xfe, 0xd4, 0x86, 0x66, 0xd4, 0x62, 0x8e,
+	0x9c, 0x49, 0xf0, 0x50, 0x8b, 0xf1, 0xde, 0xa3, 0x70, 0xcc, 0x96, 0x2d, 0xd0, 0xa7, 0x0e, 0x61,
+	0x74, 0xf0, 0x03, 0x77, 0x74, 0x00, 0x7c, 0x57, 0x32, 0x98, 0xc1, 0xa1, 0x94, 0x60, 0x96, 0x23,
+	0x9a, 0xc2, 0x10, 0x29, 0x82, 0x29, 0xc2, 0xba, 0x2b, 0xf2, 0xfb, 0x53, 0xb3, 0x22, 0x0a, 0xbd,
+	0x6e, 0xbf, 0x75, 0x0b, 0x3f, 0x36, 0xbb, 0x3b, 0x2d, 0xbc, 0x51, 0xca, 0xad, 0x8a, 0xf5, 0x1b,
+	0x65, 0x61, 0xec, 0xce, 0x40, 0x11, 0x41, 0xb3, 0x22, 0x3c, 0x65, 0x56, 0x42, 0x53, 0x51, 0x84,
+	0x26, 0xd0, 0xd7, 0x90, 0xbc, 0x12, 0xbe, 0x9d, 0x2a, 0x04, 0x03, 0x47, 0xdf, 0x12, 0x25, 0x17,
+	0x8e, 0xa5, 0xae, 0x8b, 0xd6, 0xfc, 0x79, 0x6d, 0x6e, 0x36, 0xef, 0x83, 0x01, 0x00, 0xf7, 0x6f,
+	0xe2, 0x00, 0xcd, 0x93, 0x8e, 0x3d, 0xc2, 0xb0, 0x1b, 0x6e, 0xcb, 0x76, 0x30, 0xef, 0x25, 0xe4,
+	0x4d, 0x24, 0x83, 0x4e, 0x0a, 0x53, 0x91, 0x0d, 0xb2, 0x1b, 0x37, 0xa2, 0xe0, 0x8d, 0xc0, 0x21,
+	0x1a, 0x00, 0xa5, 0x2b, 0x44, 0xe9, 0x3b, 0x64, 0xe3, 0xd4, 0x69, 0x56, 0x36, 0xa1, 0x11, 0x48,
+	0x5d, 0x1e, 0xd1, 0x7f, 0x8c, 0x72, 0xa8, 0x3b, 0x4b, 0x04, 0x5f, 0x0a, 0x06, 0x62, 0x38, 0x11,
+	0x75, 0x1f, 0xae, 0x29, 0x37, 0x76, 0x60, 0x01, 0x47, 0xde, 0x0d, 0x75, 0x36, 0x99, 0x8c, 0xb3,
+	0x93, 0x56, 0x58, 0xb7, 0x1a, 0x5d, 0xfa, 0x81, 0x7f, 0x39, 0x61, 0x7e, 0xd4, 0x56, 0x97, 0xe9,
+	0x24, 0x8c, 0x93, 0x7b, 0x4c, 0xba, 0x98, 0x1f, 0x89, 0x25, 0x3e, 0xd6, 0x40, 0x5a, 0x30, 0x94,
+	0xf6, 0x74, 0x14, 0x35, 0x3d, 0xe5, 0x16, 0xbb, 0x19, 0xe9, 0x20, 0x1e, 0x51, 0xc4, 0xc1, 0xe4,
+	0x28, 0x8a, 0x03, 0x1f, 0xa8, 0x51, 0xd7, 0xd2, 0x14, 0x0a, 0x6b, 0x6b, 0x1d, 0x20, 0xb8, 0xac,
+	0x42, 0xec, 0x78, 0x72, 0x82, 0x61, 0x41, 0x83, 0x3a, 0xd3, 0xfd, 0xd6, 0x67, 0x9c, 0x9d, 0x34,
+	0x9a, 0xdf, 0x14, 0x4d, 0x18, 0x02, 0xd2, 0x33, 0x00, 0x6f, 0x26, 0xf4, 0x5c, 0xe5, 0xba, 0x70,
+	0x5e, 0x89, 0x5a, 0x28, 0x03, 0x66, 0x37, 0xa2, 0x04, 0xf0, 0x68, 0x81, 0xa5, 0x21, 0x9a, 0xf9,
+	0xf1, 0x20, 0x31, 0x9a, 0x4b, 0x24, 0x91, 0x4d, 0x46, 0x6f, 0x2b, 0xd3, 0x89, 0x21, 0xe5, 0xb1,
+	0x3a, 0x30, 0x65, 0x69, 0x40, 0x28, 0xbd, 0x63, 0x79, 0x30, 0x70, 0x4d, 0x9a, 0x72, 0x2f, 0x03,
+	0xf7, 0xdc, 0xc5, 0xac, 0xc6, 0x72, 0x2a, 0x12, 0x72, 0xbb, 0x5d, 0x6c, 0xb0, 0x1b, 0xb1, 0x06,
+	0xa1, 0x0c, 0x19, 0x71, 0x3c, 0x86, 0xf0, 0x39, 0x3c, 0x8b, 0xda, 0xa6, 0x76, 0xc4, 0xfe, 0xce,
+	0x2e, 0xe0, 0xe0, 0x88, 0xf1, 0x18, 0x3f, 0x22, 0xeb, 0xa1, 0x28, 0xb3, 0x00, 0x98, 0x55, 0x51,
+	0xdc, 0xdb, 0xdf, 0xeb, 0xb2, 0xf0, 0xaf, 0xed, 0x80, 0xf0, 0x23, 0x6a, 0x73, 0xad, 0xbf, 0xd6,
+	0xca, 0xe3, 0x57, 0xff, 0x8b, 0x83, 0x6e, 0xab, 0x60, 0xfd, 0x6d, 0x4e, 0x54, 0x15, 0xb7, 0x81,
+	0x69, 0x02, 0x0d, 0xed, 0xe0, 0xd4, 0xf3, 0x13, 0x37, 0xfc, 0x15, 0x5d, 0x1e, 0x56, 0x50, 0xf7,
+	0x3e, 0xc6, 0x56, 0x76, 0x82, 0xc8, 0x2e, 0x13, 0xdc, 0xe9, 0x89, 0x66, 0xb6, 0x71, 0x41, 0x3c,
+	0xf2, 0x40, 0xbf, 0xfb, 0x9b, 0xab, 0x2f, 0x65, 0xa6, 0xc6, 0x91, 0x64, 0x80, 0x34, 0x37, 0x00,
+	0x84, 0x4e, 0xa1, 0xcd, 0x9a, 0xa8, 0x6c, 0x76, 0xb7, 0xd6, 0x0e, 0x77, 0x50, 0xa1, 0x85, 0x28,
+	0xf7, 0xb6, 0xf7, 0x1e, 0xef, 0x74, 0xf9, 0x58, 0x3b, 0xdb, 0xbd, 0x7e, 0x2b, 0x6f, 0xfd, 0x16,
+	0x1c, 0x46, 0xf9, 0x9b, 0xe0, 0x0a, 0x80, 0x4f, 0x48, 0xae, 0xb4, 0xf4, 0x17, 0x88, 0xcd, 0x5a,
+	0x72, 0xc1, 0x56, 0xed, 0x68, 0x31, 0x89, 0xb3, 0xca, 0x03, 0x25, 0x40, 0xcf, 0x6d, 0x14, 0x32,
+	0x49, 0x3b, 0x4c, 0xd3, 0x80, 0xe4, 0xc9, 0xb0, 0x86, 0xbe, 0xc9, 0x52, 0x78, 0x20, 0x0c, 0x69,
+	0xd0, 0x57, 0x21, 0xb8, 0x1f, 0x59, 0x31, 0x47, 0x3b, 0xc9, 0xc6, 0x92, 0xd5, 0x72, 0xfa, 0x6a,
+	0x57, 0x42, 0xc7, 0xfc, 0xd5, 0xd0, 0x31, 0x75, 0x6f, 0x4a, 0xcf, 0x72, 0x6f, 0xac, 0x3f, 0x2d,
+	0x8a, 0xa6, 0xed, 0x92, 0x20, 0x4b, 0xef, 0xfd, 0x26, 0x43, 0x07, 0x92, 0x1b, 0x72, 0xe7, 0x74,
+	0x69, 0x43, 0x62, 0x38, 0xe6, 0x1d, 0x07, 0x43, 0xb2, 0x30, 0xd2, 0x8f, 0x49, 0x60, 0x4c, 0x77,
+	0x1d, 0x39, 0xc3, 0x33, 0x9e, 0x96, 0xbd, 0x99, 0x2a, 0x23, 0x78, 0x5e, 0x67, 0x38, 0x84, 0x9b,
+	0x0d, 0xb3, 0x61, 0xd2, 0xa7, 0x31, 0x18, 0xf3, 0x04, 0x04, 0x02, 0x9a, 0x23, 0x77, 0x18, 0xba,
+	0x31, 0x35, 0x97, 0xb9, 0x99, 0x31, 0xd8, 0x0c, 0x34, 0x89, 0xa0, 0x27, 0xac, 0x32, 0x88, 0x83,
+	0x33, 0xd7, 0x97, 0xb7, 0x4d, 0x5d, 0x22, 0xfb, 0x88, 0xc3, 0x8b, 0xc0, 0x21, 0xa3, 0x13, 0xcc,
+	0x22, 0x79, 0xb3, 0xa7, 0x08, 0x73, 0x45, 0xdc, 0x76, 0xfd, 0x61, 0x78, 0x39, 0xc5, 0xbd, 0xe2,
+	0x2a, 0x98, 0xd5, 0x75, 0x65, 0x40, 0xb5, 0x9c, 0x36, 0xc1, 0x72, 0x5b, 0xd0, 0x80, 0x3b, 0x3a,
+	0x77, 0x66, 0xe3, 0x78, 0x40, 0xf9, 0x1a, 0xc1, 0x3b, 0x22, 0xcc, 0x1a, 0x26, 0x6d, 0xde, 0x16,
+	0xcb, 0xdc, 0x1c, 0x06, 0x63, 0xd7, 0x1b, 0xf1, 0x64, 0x35, 0xea, 0xb5, 0x44, 0x0d, 0x36, 0xe1,
+	0x69, 0x2a, 0x58, 0x9a, 0xfb, 0xf2, 0x81, 0x54, 0xef, 0x3a, 0x2f, 0x4d, 0x4d, 0x3d, 0xd9, 0x92,
+	0x5d, 0x7a, 0xea, 0xc4, 0xa7, 0x14, 0x85, 0xa9, 0xa5, 0x0f, 0x00, 0x81, 0x7e, 0x19, 0x37, 0x1f,
+	0x7b, 0xee, 0x98, 0xb3, 0x28, 0xe0, 0x97, 0x11, 0x6a, 0x0b, 0x31, 0xe8, 0x97, 0xc9, 0x0e, 0x41,
+	0x38, 0x71, 0x38, 0x79, 0x6c, 0xd8, 0x3c, 0x68, 0x8b, 0x50, 0xb8, 0x84, 0xe4, 0x95, 0x3f, 0x9b,
+	0x28, 0x03, 0xc5, 0x98, 0xbd, 0xd9, 0xc4, 0xfa, 0x79, 0x41, 0x54, 0x93, 0xa0, 0xfc, 0x01, 0xc4,
+	0x22, 0xea, 0x56, 0x91, 0xee, 0x74, 0x23, 0x73, 0xd5, 0xd8, 0x69, 0x3b, 0x4c, 0x9c, 0x3f, 0x3b,
+	0x97, 0x37, 0x5c, 0x63, 0x85, 0x8b, 0x29, 0xd3, 0xa3, 0x47, 0x2b, 0x4f, 0x9e, 0xda, 0xd0, 0xf0,
+	0x02, 0x72, 0x4b, 0xc6, 0x76, 0xec, 0x3a, 0xfe, 0x20, 0xf5, 0x01, 0x59, 0x2e, 0x9a, 0x84, 0x3e,
+	0x48, 0x1c, 0xc1, 0x37, 0x45, 0x09, 0xa2, 0x51, 0xb8, 0xb7, 0xb4, 0x9c, 0xfe, 0x7e, 0xe8, 0x40,
+	0xaf, 0x4d, 0x44, 0xdb, 0xdc, 0x8a, 0x37, 0x5c, 0x12, 0x08, 0x6b, 0x37, 0xdc, 0x82, 0x20, 0x38,
+	0xd1, 0x4b, 0xa1, 0xeb, 0xe5, 0x03, 0xb1, 0xec, 0x5e, 0x4c, 0xe9, 0x5a, 0x1f, 0x24, 0x79, 0x1f,
+	0xf6, 0x37, 0x5a, 0xaa, 0x61, 0x43, 0xe5, 0x7f, 0xde, 0x41, 0x93, 0x41, 0x4a, 0x43, 0x6c, 0xae,
+	0xad, 0x9a, 0x64, 0x73, 0x32, 0x6a, 0x68, 0xab, 0x2e, 0x40, 0x15, 0x63, 0x38, 0x1a, 0x0e, 0x98,
+	0x32, 0x8d, 0x74, 0x6f, 0x1b, 0x9b, 0x1b, 0x4c, 0x92, 0x2a, 0x34, 0x73, 0xec, 0x93, 0x09, 0xd0,
+	0x9b, 0xcf, 0x13, 0xa0, 0xeb, 0xae, 0x4b, 0x2b, 0xe3, 0xba, 0x80, 0x13, 0x54, 0x69, 0x55, 0xad,
+	0xd7, 0x45, 0x55, 0x2d, 0x84, 0xa6, 0x2e, 0x72, 0x7d, 0x99, 0x7c, 0x21, 0x53, 0x87, 0x20, 0xd8,
+	0xae, 0xa1, 0x28, 0x3c, 0x79, 0xda, 0x23, 0x8b, 0x87, 0x2e, 0x42, 0x89, 0x3c, 0x4a, 0xfa, 0x4e,
+	0xac, 0x60, 0x5e, 0xb3, 0x82, 0xaf, 0xf2, 0x05, 0x42, 0x0c, 0x52, 0x19, 0x6b, 0x0d, 0x83, 0x24,
+	0x66, 0x17, 0xa7, 0xc8, 0xc9, 0x6c, 0x02, 0xac, 0xff, 0x28, 0x88, 0x8a, 0xf4, 0x42, 0xf1, 0xd2,
+	0x98, 0x25, 0xc9, 0x56, 0xfc, 0xcc, 0xa6, 0x07, 0x12, 0x77, 0x56, 0xaf, 0x97, 0x15, 0x9e, 0x5d,
+	0x2f, 0x83, 0xab, 0xad, 0x3e, 0xe5, 0x36, 0xdd, 0x01, 0x7e, 0x59, 0x1f, 0x23, 0xff, 0xd3, 0xb8,
+	0xda, 0x34, 0x05, 0x90, 0x94, 0x54, 0x13, 0x88, 0x9d, 0x13, 0x49, 0x81, 0x0a, 0xc2, 0x7d, 0xe7,
+	0xe4, 0xb9, 0xbc, 0xd9, 0x26, 0xb9, 0xc5, 0x75, 0x32, 0xb8, 0xe8, 0x01, 0xeb, 0x9c, 0x69, 0x64,
+	0x9d, 0x4a, 0xb0, 0xa5, 0x10, 0x0a, 0x40, 0xf4, 0x30, 0x88, 0x99, 0xcd, 0x98, 0x5c, 0x24, 0x04,
+	0xf0, 0xe2, 0xd7, 0x73, 0xa2, 0x22, 0xcf, 0x75, 0xe5, 0x32, 0x5c, 0xdf, 0xde, 0x5b, 0xb3, 0xbf,
+	0x80, 0xcb, 0x10, 0x2e, 0xfb, 0xed, 0x3d, 0xb8, 0x0b, 0x4d, 0x43, 0x94, 0xb6, 0x76, 0xf6, 0xd7,
+	0xfa, 0xad, 0x02, 0x5e, 0x90, 0xeb, 0xfb, 0xfb, 0x3b, 0xad, 0xa2, 0x59, 0x17, 0x55, 0xf0, 0x00,
+	0xba, 0xfd, 0xed, 0xdd, 0x6e, 0xab, 0x84, 0x7d, 0x1f, 0x77, 0xf7, 0x5b, 0x65, 0xfc, 0x38, 0xdc,
+	0xde, 0x6c, 0x55, 0xb0, 0xfd, 0x60, 0xad, 0xd7, 0xfb, 0x6c, 0xdf, 0xde, 0x6c, 0x55, 0xe9, 0x92,
+	0xed, 0xdb, 0x70, 0xcd, 0xb6, 0x0c, 0xfc, 0xde, 0x5f, 0xff, 0xa4, 0xbb, 0xd1, 0x6f, 0x09, 0xeb,
+	0x7d, 0x51, 0xd3, 0x68, 0x85, 0xa3, 0xed, 0xee, 0x16, 0xec, 0x03, 0x96, 0x7c, 0xba, 0xb6, 0x73,
+	0x88, 0x77, 0x72, 0x53, 0x08, 0xfa, 0x1c, 0xec, 0xac, 0xc1, 0xf0, 0xbc, 0xf4, 0xbb, 0x3f, 0x15,
+	0xd5, 0x43, 0x6f, 0xb4, 0x0e, 0x57, 0xc7, 0x19, 0x8a, 0xcf, 0x91, 0x13, 0xb9, 0x52, 0xde, 0xe8,
+	0x1b, 0xa3, 0x1c, 0x52, 0xda, 0x48, 0xf2, 0x5a, 0x42, 0x48, 0x31, 0xb0, 0x57, 0x03, 0xaa, 0xa9,
+	0x16, 0xf8, 0xe2, 0x02, 0xf8, 0x10, 0xcb, 0xaa, 0x67, 0xa2, 0x02, 0xff, 0x0f, 0xc0, 0x84, 0x91,
+	0x71, 0xc3, 0xa9, 0x07, 0x91, 0xf7, 0x43, 0x57, 0x5e, 0x70, 0x06, 0x61, 0x7a, 0x80, 0x00, 0xf7,
+	0xba, 0x4c, 0x80, 0x4a, 0x0c, 0x91, 0xaa, 0xa9, 0xed, 0xd8, 0xb2, 0x8d, 0x4a, 0x9a, 0x10, 0x66,
+	0x0c, 0x07, 0xa1, 0x7b, 0xdc, 0x7e, 0x99, 0x39, 0x40, 0x08, 0xdb, 0x3d, 0xb6, 0x7e, 0x33, 0x97,
+	0x9c, 0x9c, 0x0a, 0x63, 0xf7, 0x44, 0x11, 0xa2, 0x8d, 0x33, 0xe9, 0x5f, 0xd4, 0xe4, 0x84, 0xb8,
+	0x19, 0x9b, 0x1a, 0xd0, 0x35, 0x95, 0x82, 0xa4, 0x56, 0xad, 0x69, 0x12, 0x67, 0x27, 0x8d, 0x59,
+	0xc6, 0x17, 0xb2, 0x8c, 0xa7, 0x1c, 0xc2, 0x74, 0xec, 0xc5, 0xac, 0x36, 0xa8, 0x9c, 0x04, 0x59,
+	0x1f, 0x08, 0x91, 0x16, 0x31, 0x17, 0xb8, 0x5b, 0xa0, 0x39, 0xce, 0xd8, 0x73, 0x54, 0x4e, 0x82,
+	0x01, 0x6b, 0x4f, 0xd4, 0xb4, 0xd2, 0x27, 0xd2, 0x16, 0xce, 0xc7, 0xc5, 0xaa, 0x1c, 0xe7, 0x69,
+	0x01, 0xc6, 0x5a, 0x15, 0x06, 0x24, 0x5c, 0x35, 0xcd, 0xcf, 0xd5, 0xcd, 0x68, 0xa8, 0xcd, 0x8d,
+	0xd6, 0x3b, 0xa2, 0xbc, 0xa5, 0xc2, 0x36, 0xa5, 0x0c, 0xb9, 0xeb, 0x94, 0xc1, 0xfa, 0x50, 0xee,
+	0x99, 0x4a, 0x6f, 0x60, 0x5c, 0x6b, 0xb2, 0xd6, 0x4a, 0x55, 0xb4, 0x5c, 0x9a, 0xd5, 0xe2, 0x4e,
+	0xb2, 0x30, 0x4b, 0x9d, 0xad, 0x4d, 0x51, 0xbd, 0xb1, 0xde, 0x2d, 0x09, 0x90, 0x4f, 0x09, 0xb0,
+	0xa0, 0x02, 0x6e, 0x7d, 0x0f, 0x36, 0x90, 0x54, 0x71, 0xa5, 0x6e, 0xf2, 0x2c, 0xa8, 0x9b, 0x6f,
+	0x63, 0x72, 0xdf, 0x1b, 0x8f, 0x42, 0x70, 0x36, 0xf4, 0x53, 0xa7, 0x75, 0xdf, 0xa4, 0xdd, 0x7c,
+	0x4d, 0x14, 0xa9, 0x38, 0x5d, 0x48, 0x2d, 0x77, 0x52, 0x99, 0xa6, 0x16, 0xeb, 0x42, 0x34, 0x38,
+	0xd2, 0x79, 0x0e, 0x0f, 0x2c, 0x6b, 0x3a, 0xf3, 0x57, 0x4c, 0x27, 0x08, 0x01, 0x5d, 0xfc, 0xea,
+	0x34, 0x12, 0xba, 0xc6, 0xa4, 0xfe, 0x71, 0x5e, 0x08, 0x5e, 0x1a, 0x13, 0xf5, 0xd9, 0x94, 0x4a,
+	0x6e, 0x3e, 0xa5, 0x02, 0x64, 0x4a, 0xde, 0x1d, 0x00, 0x99, 0xf0, 0x3b, 0xbd, 0x0c, 0x65, 0x9a,
+	0x85, 0x2f, 0x43, 0x98, 0x87, 0x1c, 0x31, 0xd0, 0xa7, 0x50, 0x2e, 0x98, 0x22, 0xf4, 0x2a, 0x7c,
+	0x29, 0x5b, 0x85, 0x4f, 0x8a, 0x8a, 0x65, 0x9e, 0x8d, 0x8b, 0x8a, 0x8b, 0x8a, 0xa7, 0x94, 0xe7,
+	0x8a, 0xdc, 0x30, 0x56, 0x49, 0x1a, 0x86, 0x92, 0x7c, 0x83, 0x21, 0xfb, 0x3a, 0x9c, 0xa9, 0xf2,
+	0xf1, 0x85, 0x81, 0x7f, 0x3c, 0xf6, 0x86, 0xb1, 0xac, 0xba, 0x0b, 0x3f, 0xd8, 0x90, 0x18, 0x4a,
+	0x80, 0x61, 0xf5, 0x53, 0x26, 0xa9, 0x60, 0x43, 0x12, 0x44, 0x59, 0x81, 0x98, 0x49, 0xfa, 0x62,
+	0xf8, 0x69, 0xc1, 0x05, 0xa1, 0x78, 0x45, 0x25, 0xcb, 0xb7, 0x93, 0xb8, 0x3d, 0x97, 0xca, 0x41,
+	0x4a, 0xd2, 0xf5, 0x7c, 0x3b, 0xa7, 0x22, 0x77, 0xeb, 0x77, 0x8b, 0x6a, 0xb0, 0xac, 0xac, 0xdd,
+	0x4c, 0xef, 0x6c, 0x2a, 0x26, 0xff, 0x5c, 0xa9, 0x98, 0x6f, 0x81, 0x03, 0x40, 0xd9, 0x05, 0xef,
+	0x5c, 0x5d, 0x78, 0x9d, 0xf9, 0x4c, 0x82, 0xcc, 0x3f, 0x40, 0x0f, 0x3b, 0xed, 0xfc, 0x0c, 0x9e,
+	0x25, 0x9c, 0x29, 0x2d, 0xe2, 0x4c, 0xf9, 0x4b, 0x72, 0x06, 0x5c, 0x51, 0xf0, 0xc0, 0xc1, 0xc9,
+	0x1c, 0x8f, 0x31, 0x0b, 0x28, 0x59, 0x03, 0xdc, 0xf2, 0xf7, 0x24, 0x0a, 0x3d, 0x69, 0xbd, 0x0b,
+	0x1b, 0x00, 0xe6, 0xd2, 0x92, 0xd6, 0x8f, 0xcc, 0xc4, 0x7d, 0xd1, 0x0a, 0x8e, 0xbe, 0x87, 0xb5,
+	0x7e, 0xa4, 0xd8, 0x80, 0x34, 0x9f, 0x59, 0xd7, 0x64, 0x3c, 0x92, 0x68, 0x0f, 0x6d, 0xc0, 0x9c,
+	0x48, 0x34, 0x6e, 0x12, 0x89, 0xe6, 0x42, 0x91, 0x58, 0xa2, 0x9c, 0x1c, 0x89, 0xc4, 0x87, 0xc2,
+	0x48, 0x28, 0xaa, 0xc5, 0xd3, 0x70, 0xcd, 0x6d, 0xef, 0x6d, 0x76, 0x3f, 0x87, 0x6b, 0x0e, 0xae,
+	0x61, 0xbb, 0xfb, 0xb4, 0x6b, 0xf7, 0xba, 0x70, 0xe3, 0xc2, 0x15, 0xb9, 0xd9, 0xdd, 0xe9, 0xf6,
+	0x21, 0xac, 0x66, 0x17, 0x8b, 0x8a, 0x61, 0xb0, 0xaa, 0x17, 0x5b, 0xbf, 0x9d, 0x13, 0x22, 0xcd,
+	0xe5, 0xa0, 0xb9, 0x4f, 0x4f, 0x22, 0x93, 0xc9, 0xb1, 0x3a, 0xc3, 0xfd, 0x44, 0xd3, 0xf3, 0xd7,
+	0x65, 0x8c, 0xa4, 0xee, 0x53, 0x72, 0x39, 0xc4, 0x83, 0xb2, 0x96, 0x4a, 0x88, 0x4a, 0x6f, 0x17,
+	0xb1, 0xeb, 0x8f, 0x22, 0x19, 0x90, 0x29, 0x50, 0x1d, 0xb2, 0x94, 0x1e, 0x72, 0x55, 0x18, 0xbb,
+	0xce, 0xf4, 0x63, 0xae, 0x3d, 0xbf, 0x29, 0x9a, 0x60, 0xd4, 0x63, 0x4f, 0x05, 0x4b, 0x6c, 0xc9,
+	0xeb, 0x76, 0x23, 0xc1, 0xd2, 0x23, 0x86, 0x3f, 0xcb, 0x89, 0x3b, 0xbb, 0xc1, 0xb9, 0x9b, 0x38,
+	0xe3, 0x07, 0xce, 0xe5, 0x38, 0x70, 0x46, 0xcf, 0x90, 0x7b, 0x8c, 0xf6, 0x82, 0x19, 0xd5, 0x82,
+	0x55, 0xe5, 0x1c, 0xa2, 0x3d, 0xc2, 0x3c, 0x96, 0x0f, 0x86, 0xc0, 0x48, 0x52, 0xa3, 0xbc, 0xe5,
+	0x11, 0xc6, 0xa6, 0x97, 0x44, 0x39, 0xbe, 0xf0, 0xd3, 0x3a, 0x7e, 0x29, 0xa6, 0x42, 0xca, 0x42,
+	0xdf, 0xbc, 0xb4, 0xd8, 0x37, 0xb7, 0x36, 0x84, 0xd1, 0xbf, 0xa0, 0x52, 0xc2, 0x2c, 0xeb, 0x1d,
+	0xe7, 0x6e, 0xf0, 0xc1, 0xf2, 0x73, 0x3e, 0xd8, 0xbf, 0x83, 0x07, 0xa0, 0x05, 0x19, 0x20, 0xe8,
+	0x45, 0xd8, 0x4a, 0xf6, 0x2d, 0x8d, 0x5a, 0xc4, 0xa6, 0xa6, 0x2b, 0xe9, 0xf2, 0xfc, 0x95, 0x74,
+	0xb9, 0xb9, 0x23, 0x96, 0xf8, 0x5a, 0x50, 0x87, 0x50, 0x59, 0xc5, 0xd7, 0xe7, 0x82, 0x1a, 0x2e,
+	0xb7, 0xa8, 0x23, 0xc9, 0x24, 0x4c, 0xf3, 0x24, 0x83, 0xec, 0xac, 0x89, 0xdb, 0x0b, 0xba, 0xbd,
+	0x48, 0xe1, 0xcd, 0xba, 0x27, 0x1a, 0x58, 0xaa, 0xf2, 0x26, 0x40, 0x7f, 0x67, 0x32, 0x25, 0x1f,
+	0x56, 0x5e, 0xeb, 0x45, 0x1b, 0xbe, 0xac, 0xb7, 0x44, 0xfd, 0xc0, 0x75, 0x43, 0xb0, 0x95, 0xd3,
+	0xc0, 0x67, 0xcf, 0x4d, 0x96, 0x39, 0x72, 0x4a, 0x12, 0x11, 0xb2, 0x7e, 0x55, 0x18, 0x98, 0x71,
+	0x59, 0x77, 0xe2, 0xe1, 0xe9, 0x8b, 0x64, 0x64, 0xde, 0x12, 0x95, 0x29, 0xcb, 0x94, 0x0c, 0x3d,
+	0xeb, 0xe4, 0x4b, 0x48, 0x39, 0xb3, 0x55, 0xa3, 0xf5, 0x4d, 0xd1, 0x94, 0x35, 0x47, 0xb5, 0x13,
+	0xad, 0x30, 0x99, 0xbb, 0xb6, 0x30, 0x69, 0x9d, 0xc0, 0x01, 0xe5, 0x38, 0xbe, 0x99, 0x9f, 0x6b,
+	0xd8, 0x8b, 0xbf, 0xfc, 0xb0, 0x7e, 0x45, 0xdc, 0xee, 0xcd, 0x8e, 0xa2, 0x61, 0xe8, 0x51, 0x9a,
+	0x41, 0x2d, 0xd7, 0x01, 0xc7, 0x10, 0x3c, 0x4c, 0xef, 0xc2, 0x55, 0x2a, 0x96, 0xc0, 0x60, 0x19,
+	0x2b, 0x13, 0xa4, 0x97, 0x9b, 0x1a, 0x80, 0x34, 0xa0, 0xde, 0xc5, 0x16, 0x5b, 0x75, 0xb0, 0xbe,
+	0x2d, 0xee, 0x64, 0xa7, 0x97, 0x54, 0x78, 0x1d, 0x98, 0x7d, 0x1e, 0x49, 0x32, 0x2f, 0x67, 0x02,
+	0x72, 0x7a, 0x72, 0x83, 0xad, 0xd6, 0xef, 0xe7, 0x44, 0x01, 0xc2, 0x7e, 0xfd, 0x35, 0x62, 0x91,
+	0x5f, 0x23, 0xbe, 0xa2, 0x97, 0x44, 0x38, 0xc0, 0x4b, 0x4b, 0x1f, 0xa0, 0xe4, 0xc7, 0x41, 0xf8,
+	0x03, 0x07, 0xec, 0xe6, 0x48, 0x1a, 0x9e, 0x14, 0x01, 0x26, 0xa4, 0xa8, 0x05, 0x58, 0x94, 0xed,
+	0x85, 0x35, 0x56, 0x20, 0x76, 0x8f, 0xe8, 0x26, 0x63, 0xff, 0xc2, 0x7a, 0x20, 0x8c, 0x04, 0x85,
+	0x16, 0x75, 0xaf, 0x37, 0x80, 0x08, 0xe4, 0x96, 0x0a, 0x45, 0x72, 0x68, 0x4d, 0xfb, 0x9f, 0xef,
+	0x0d, 0xfa, 0xbd, 0x56, 0xde, 0xfa, 0xae, 0xa8, 0x29, 0x5d, 0xd9, 0x1e, 0x51, 0xfd, 0x94, 0x94,
+	0x75, 0x7b, 0x94, 0xd1, 0xdd, 0x6d, 0x8a, 0x15, 0xc1, 0xcc, 0x6d, 0x2b, 0x25, 0x63, 0x20, 0x7b,
+	0x1a, 0x59, 0x8c, 0x55, 0xa7, 0xb1, 0xba, 0x62, 0xd9, 0xa6, 0x3a, 0x10, 0xde, 0xea, 0x8a, 0x3d,
+	0x20, 0xce, 0x3e, 0x80, 0xc9, 0x02, 0x12, 0xc2, 0x95, 0x25, 0x63, 0xa5, 0xf9, 0x4a, 0xf8, 0xec,
+	0x8a, 0x65, 0xb4, 0x88, 0x59, 0xa1, 0xca, 0xd4, 0x28, 0x72, 0x73, 0x35, 0x0a, 0x5c, 0x44, 0x3e,
+	0x47, 0x60, 0xc7, 0x4b, 0x3d, 0x41, 0x00, 0xd9, 0x18, 0x81, 0xd9, 0xa3, 0xea, 0x20, 0xdb, 0xc1,
+	0x04, 0xb6, 0x1e, 0x8a, 0xdb, 0x6b, 0xd3, 0xe9, 0xf8, 0x52, 0x15, 0x6f, 0xe5, 0x42, 0xed, 0xb4,
+	0xc2, 0x9b, 0x93, 0x01, 0x2a, 0x83, 0xd6, 0x16, 0x78, 0x26, 0x32, 0xc1, 0x81, 0x99, 0x56, 0xb2,
+	0x6e, 0x63, 0x2f, 0x13, 0xeb, 0x57, 0x19, 0xd1, 0xcf, 0x56, 0x42, 0xe6, 0xce, 0xb7, 0x02, 0xb1,
+	0x20, 0x9b, 0x4e, 0xb8, 0xef, 0x87, 0x40, 0x0d, 0x1a, 0x5c, 0xb2, 0xe9, 0x1b, 0x25, 0x68, 0x12,
+	0x9d, 0x28, 0xd7, 0x1b, 0x3e, 0xad, 0xbf, 0xcf, 0x8b, 0xc6, 0x3a, 0x25, 0x96, 0xd4, 0x1e, 0xb5,
+	0x74, 0x6a, 0x2e, 0x93, 0x4e, 0xd5, 0x53, 0xa7, 0xf9, 0x4c, 0xea, 0x34, 0xb3, 0xa1, 0x42, 0xd6,
+	0x5f, 0x86, 0xe9, 0x66, 0xbe, 0x77, 0xa1, 0xee, 0x04, 0x20, 0x1f, 0x82, 0x30, 0xe6, 0x35, 0x51,
+	0xc3, 0x6b, 0xc3, 0xf3, 0x39, 0x5d, 0xc9, 0x39, 0x47, 0x1d, 0x35, 0x97, 0x94, 0x2c, 0xdf, 0x9c,
+	0x94, 0xac, 0x3c, 0x33, 0x29, 0x59, 0x7d, 0x56, 0x52, 0xd2, 0x98, 0x4f, 0x4a, 0x66, 0x7d, 0x7d,
+	0x71, 0xc5, 0xd7, 0x87, 0x1d, 0xf0, 0x9b, 0xa9, 0x63, 0xf0, 0x82, 0xa4, 0x53, 0x64, 0x10, 0x66,
+	0x0b, 0x10, 0xd6, 0x8e, 0x68, 0x2a, 0xd2, 0x4a, 0x75, 0xff, 0x48, 0x2c, 0xc9, 0xa2, 0x90, 0x1b,
+	0xca, 0x8c, 0x5d, 0x2e, 0xad, 0xb6, 0x70, 0x45, 0x40, 0xb6, 0xd8, 0xcd, 0x91, 0x0e, 0x46, 0xd6,
+	0x8f, 0x73, 0xa2, 0x91, 0xe9, 0x61, 0xbe, 0x9f, 0x96, 0x98, 0x72, 0xa4, 0xc5, 0xed, 0x2b, 0xb3,
+	0xdc, 0x5c, 0x66, 0xca, 0xcf, 0x95, 0x99, 0xac, 0x77, 0x93, 0xb2, 0x84, 0x2c, 0x46, 0xdc, 0x4a,
+	0x8a, 0x11, 0x94, 0xbf, 0x5f, 0xeb, 0xf7, 0x6d, 0xf0, 0xa0, 0xca, 0x22, 0xbf, 0xd7, 0x6b, 0x15,
+	0xf0, 0x71, 0x69, 0xa3, 0x7b, 0x31, 0xa5, 0xf7, 0x83, 0xcf, 0x0c, 0x9c, 0x34, 0xb9, 0xca, 0x67,
+	0xe4, 0x4a, 0x93, 0x90, 0x82, 0xac, 0x99, 0xb3, 0x84, 0x60, 0x28, 0xc5, 0x29, 0x52, 0x29, 0x39,
+	0x0c, 0xfd, 0x7f, 0x90, 0x9c, 0x8c, 0x45, 0x11, 0xf3, 0x55, 0x4f, 0x10, 0x0c, 0x45, 0x36, 0x29,
+	0x18, 0xcf, 0xa5, 0xac, 0xfc, 0xde, 0x78, 0x9c, 0x64, 0xec, 0x18, 0xb0, 0xfe, 0x33, 0x2f, 0x0c,
+	0x96, 0x33, 0xdc, 0xfc, 0xd7, 0xa5, 0x5d, 0xcf, 0xa5, 0xa5, 0x9b, 0xa4, 0x71, 0x05, 0xfe, 0x52,
+	0xdb, 0xbe, 0xb0, 0x28, 0x2d, 0xf3, 0x7a, 0x9c, 0xda, 0xa0, 0xbc, 0x1e, 0x58, 0x22, 0x76, 0xc1,
+	0x66, 0xb2, 0x6e, 0x00, 0x96, 0x88, 0x10, 0xf8, 0x78, 0x1c, 0x43, 0x52, 0x37, 0x9c, 0x48, 0x1e,
+	0xd0, 0x77, 0x36, 0x88, 0x6c, 0xa8, 0x50, 0x25, 0x43, 0x91, 0xca, 0x3c, 0x45, 0xfe, 0x20, 0x27,
+	0x2a, 0x72, 0x73, 0xe8, 0xac, 0x1f, 0xee, 0x3d, 0xd9, 0xdb, 0xff, 0x6c, 0x2f, 0x23, 0x7e, 0x89,
+	0x3b, 0x9f, 0xd7, 0xdd, 0xf9, 0x02, 0xe2, 0x37, 0xf6, 0x0f, 0xf7, 0xfa, 0xad, 0xa2, 0xd9, 0x10,
+	0x06, 0x7d, 0x0e, 0xa0, 0xb5, 0x55, 0xa2, 0xbc, 0xd8, 0xc6, 0xc7, 0xdd, 0xdd, 0xb5, 0x56, 0x39,
+	0xa9, 0xa4, 0x55, 0x70, 0x70, 0xef, 0x0b, 0x08, 0x11, 0xbe, 0xd8, 0x6d, 0x55, 0x61, 0xff, 0x4d,
+	0xee, 0x32, 0xc0, 0xe9, 0xb6, 0xf7, 0xf7, 0x5a, 0x06, 0x76, 0xe8, 0xdb, 0xdb, 0x8f, 0x1f, 0x77,
+	0xed, 0x96, 0x00, 0x7a, 0xd4, 0x7b, 0xfd, 0x7d, 0xbb, 0xbb, 0x39, 0xf8, 0xf4, 0xb0, 0x6b, 0x7f,
+	0xd1, 0xaa, 0x59, 0xbf, 0x97, 0x13, 0xcb, 0x4c, 0x51, 0x3d, 0xc5, 0xa4, 0xff, 0x94, 0xa0, 0xc8,
+	0x3f, 0x25, 0xf8, 0xbf, 0xcd, 0x2a, 0xe1, 0x20, 0x7c, 0xab, 0xcb, 0x2f, 0x14, 0x38, 0xdd, 0x89,
+	0xaf, 0xf5, 0xf9, 0x61, 0xc2, 0x5f, 0xe7, 0x44, 0x87, 0x83, 0x90, 0xc7, 0xf8, 0xcb, 0x89, 0x4f,
+	0x77, 0xae, 0xe4, 0x37, 0xae, 0x73, 0xab, 0x21, 0xb4, 0xa0, 0x1f, 0x5b, 0x7c, 0x7f, 0x3c, 0x90,
+	0x71, 0x35, 0x8b, 0x47, 0x43, 0x62, 0x79, 0x22, 0xf3, 0x91, 0xa8, 0xf3, 0x8f, 0x32, 0x28, 0xff,
+	0x9f, 0xa9, 0xae, 0x67, 0x42, 0xa0, 0x1a, 0xf7, 0xe2, 0xb7, 0x00, 0xef, 0x27, 0x83, 0xd2, 0x54,
+	0xc8, 0xd5, 0x02, 0xba, 0x1c, 0xd2, 0xa7, 0x04, 0xc9, 0x43, 0xf1, 0xca, 0xc2, 0x73, 0x48, 0xbd,
+	0xd1, 0xd2, 0xd0, 0x2c, 0xae, 0xd6, 0x3f, 0xe4, 0x44, 0x75, 0x7d, 0x36, 0x3e, 0xa3, 0x5b, 0x14,
+	0x9f, 0xfb, 0x83, 0x47, 0x25, 0x7f, 0xdd, 0x90, 0x23, 0xeb, 0x62, 0x20, 0x86, 0x7f, 0xdf, 0xf0,
+	0x11, 0xd8, 0x01, 0x2e, 0x7e, 0x4f, 0x9c, 0xa9, 0x64, 0x11, 0xd5, 0x51, 0xd5, 0x04, 0xf2, 0x2c,
+	0x10, 0x78, 0xc9, 0x3a, 0x6a, 0xa4, 0xe0, 0xf4, 0x15, 0x40, 0xe1, 0x86, 0x57, 0x00, 0x9d, 0x3d,
+	0x90, 0xaf, 0xcc, 0x14, 0x0b, 0xd2, 0x7f, 0x6f, 0x65, 0x5f, 0x5a, 0x5d, 0xa5, 0xa1, 0xe6, 0xf0,
+	0x7f, 0x22, 0x96, 0xe6, 0x4a, 0x09, 0x37, 0x99, 0xdc, 0x8c, 0xce, 0xe5, 0xe7, 0x75, 0x6e, 0x53,
+	0x2c, 0xe3, 0xef, 0x09, 0x64, 0x10, 0x94, 0xde, 0xfe, 0x31, 0x20, 0x07, 0x09, 0x51, 0xcb, 0x08,
+	0xc2, 0x5c, 0xf8, 0xc4, 0x9f, 0xaa, 0xe8, 0xd2, 0xcf, 0x94, 0x90, 0xb5, 0x2b, 0x4c, 0x7d, 0x16,
+	0xc9, 0x17, 0x0c, 0x9c, 0x71, 0x1a, 0x7c, 0x96, 0xa0, 0xdc, 0x17, 0x44, 0x10, 0x57, 0xc8, 0xa9,
+	0x0e, 0x4e, 0x92, 0x67, 0x58, 0x45, 0x3b, 0x81, 0xad, 0x9e, 0x68, 0x64, 0x9e, 0x2a, 0x2c, 0xcc,
+	0x20, 0x82, 0x85, 0xf9, 0x41, 0x10, 0x8e, 0x92, 0x5f, 0xb9, 0x10, 0xa0, 0x3f, 0xca, 0x62, 0x1d,
+	0x52, 0xa0, 0xf5, 0x27, 0x39, 0x95, 0xe0, 0x53, 0x25, 0xfe, 0x9b, 0x3d, 0x3e, 0x6d, 0xa6, 0x7c,
+	0x66, 0x26, 0x76, 0x2c, 0x49, 0x0f, 0xa4, 0x47, 0xad, 0x40, 0x52, 0x53, 0xf5, 0x7e, 0x84, 0x1f,
+	0x90, 0xb1, 0xa2, 0x00, 0xde, 0x99, 0xc5, 0xa7, 0x41, 0x28, 0x6d, 0xa4, 0x84, 0x50, 0x34, 0xe1,
+	0xbe, 0x71, 0x30, 0x24, 0x76, 0x62, 0xf9, 0x58, 0xcc, 0x90, 0x98, 0xb5, 0xd8, 0xfa, 0xbb, 0x1c,
+	0x84, 0xc1, 0xea, 0xed, 0xc4, 0x33, 0xb6, 0x2b, 0x95, 0x20, 0x9f, 0xda, 0x6c, 0xbc, 0xe6, 0x49,
+	0x4c, 0x68, 0x72, 0xa6, 0x86, 0x2c, 0x4c, 0xc1, 0xe4, 0x73, 0x6b, 0x17, 0xe7, 0xd6, 0x9e, 0x73,
+	0x7b, 0x4a, 0x8b, 0x52, 0x9c, 0xf2, 0x81, 0x56, 0x39, 0xf3, 0x40, 0x4b, 0xb7, 0x2a, 0x95, 0x8c,
+	0x55, 0xb1, 0xfe, 0x10, 0xec, 0xbb, 0x7c, 0x39, 0xb1, 0x90, 0xa3, 0x37, 0xbf, 0x25, 0xcb, 0xa4,
+	0x61, 0x0a, 0x73, 0x69, 0x18, 0x3c, 0x7d, 0x38, 0x96, 0x54, 0xc7, 0x4f, 0xbc, 0xbf, 0x8f, 0xdc,
+	0x63, 0xac, 0x91, 0xb3, 0x11, 0x95, 0x39, 0xb3, 0x3a, 0x23, 0x37, 0x08, 0xa7, 0xf3, 0xb8, 0x9c,
+	0x95, 0x16, 0x4f, 0xd4, 0xb4, 0x47, 0x26, 0xd7, 0x09, 0x20, 0x3e, 0x4d, 0x51, 0x49, 0x6c, 0x06,
+	0x90, 0x2e, 0xf2, 0x49, 0xab, 0x4c, 0xfd, 0xca, 0x17, 0xab, 0xda, 0x52, 0xc5, 0xf9, 0xa5, 0xee,
+	0xb0, 0x3a, 0xaf, 0x5f, 0xf2, 0x8b, 0x96, 0xe7, 0x0a, 0x48, 0x16, 0xaf, 0x4e, 0x35, 0x63, 0x88,
+	0x36, 0xb9, 0xac, 0x52, 0x50, 0x35, 0x63, 0xc0, 0x60, 0x59, 0xc5, 0xfa, 0x49, 0x4e, 0xd4, 0xf5,
+	0xb7, 0x2b, 0xb8, 0x2b, 0xf9, 0x7a, 0x45, 0x1e, 0x4d, 0x81, 0x8a, 0xa2, 0xf9, 0x94, 0xa2, 0xed,
+	0x34, 0xcc, 0xe7, 0xc7, 0x6a, 0x0a, 0xcc, 0xf0, 0xbc, 0x78, 0x43, 0x82, 0xa6, 0x34, 0x77, 0xab,
+	0xf1, 0x6f, 0x35, 0x98, 0xf2, 0xf8, 0x5b, 0x8d, 0x54, 0xa6, 0x2a, 0xba, 0x4c, 0x41, 0xd8, 0x57,
+	0x91, 0xaf, 0x67, 0x92, 0xdc, 0x46, 0x8e, 0x73, 0x1b, 0xe4, 0x7b, 0xe0, 0x43, 0xcb, 0x3c, 0xc9,
+	0x2f, 0x7d, 0x6b, 0xd3, 0x14, 0xf4, 0x69, 0x56, 0xff, 0x2a, 0x27, 0x8a, 0x98, 0xc0, 0x30, 0xdf,
+	0x15, 0xc6, 0xc7, 0x2e, 0xec, 0xef, 0x08, 0x84, 0xdd, 0xcc, 0x24, 0x2b, 0x3a, 0x64, 0xc2, 0xd3,
+	0x87, 0xa4, 0xd6, 0xad, 0xf7, 0x72, 0xe6, 0x0a, 0xff, 0xcc, 0x45, 0xfd, 0x7c, 0xa7, 0xa1, 0x12,
+	0x21, 0x94, 0x28, 0xe9, 0x64, 0xc6, 0x5b, 0xb7, 0xee, 0x53, 0xff, 0x4f, 0x02, 0xcf, 0xdf, 0xe0,
+	0x1f, 0x57, 0x98, 0xf3, 0x89, 0x93, 0xf9, 0x11, 0xb0, 0x9d, 0xf2, 0x76, 0x84, 0x19, 0x9a, 0xab,
+	0x5d, 0xe9, 0x1e, 0xd0, 0x93, 0x37, 0xd6, 0xad, 0xd5, 0x9f, 0x94, 0x44, 0x11, 0xdf, 0xa8, 0x60,
+	0x01, 0x5b, 0x3e, 0xbb, 0x35, 0xb5, 0xe7, 0xb5, 0x1d, 0xca, 0x4e, 0xcf, 0xbd, 0xc7, 0xa5, 0x55,
+	0x5a, 0x6c, 0x4d, 0xd3, 0x5a, 0xbe, 0x99, 0xbe, 0x0a, 0xbe, 0xb2, 0xa9, 0x0f, 0x45, 0xab, 0x17,
+	0x83, 0x35, 0x98, 0x68, 0xdd, 0xb3, 0xa4, 0x5a, 0xf4, 0x30, 0x80, 0xe8, 0xf5, 0x40, 0x94, 0x39,
+	0x0d, 0x36, 0x37, 0x60, 0xbe, 0xea, 0x4f, 0x9d, 0xbf, 0x06, 0x8a, 0x76, 0x1a, 0xcc, 0xc6, 0xa3,
+	0x9e, 0x1b, 0x82, 0xf9, 0xd0, 0x32, 0x39, 0x1d, 0xed, 0x1b, 0x36, 0xf4, 0x3e, 0x50, 0xc9, 0x47,
+	0xe7, 0xdf, 0x5c, 0xd6, 0xb2, 0x3d, 0xac, 0x2b, 0x1d, 0x53, 0x47, 0x29, 0x4a, 0xc1, 0xdc, 0x06,
+	0xa7, 0x22, 0x30, 0x11, 0x51, 0x91, 0xd9, 0x0d, 0xde, 0x86, 0x96, 0xa2, 0x80, 0x8e, 0xf7, 0x85,
+	0xd0, 0xf2, 0x67, 0x37, 0xf5, 0x7c, 0x24, 0x1a, 0x6c, 0x3b, 0xf6, 0xc3, 0xb5, 0x23, 0x70, 0xde,
+	0xcd, 0xf9, 0x9f, 0x02, 0x74, 0xe6, 0x11, 0x30, 0xe8, 0x3d, 0x51, 0xed, 0x87, 0x97, 0xdc, 0x7f,
+	0x59, 0xa6, 0x1d, 0xd3, 0xf5, 0x16, 0xd0, 0xc5, 0xfc, 0x20, 0xb9, 0xe2, 0x13, 0x85, 0x5f, 0xf4,
+	0x84, 0x80, 0x49, 0xc4, 0xd7, 0x2e, 0x91, 0x48, 0xa4, 0xe9, 0x11, 0xf3, 0x25, 0x7e, 0xce, 0x30,
+	0x97, 0x2e, 0xb9, 0x3a, 0x24, 0x4d, 0x85, 0xf0, 0x90, 0x2b, 0xa9, 0x91, 0xb9, 0x21, 0xdf, 0x10,
+	0x75, 0x3d, 0xad, 0x61, 0x52, 0x5d, 0x7e, 0x41, 0xa2, 0x23, 0x3b, 0x6c, 0xf5, 0xbf, 0x4a, 0xa2,
+	0xfc, 0x59, 0x10, 0x9e, 0xb9, 0xf8, 0x30, 0xa7, 0x4c, 0x0f, 0x53, 0xa4, 0x2e, 0x25, 0x8f, 0x54,
+	0x16, 0xd1, 0xee, 0x0d, 0x61, 0x90, 0x64, 0xa0, 0x7f, 0xc1, 0xf2, 0x4a, 0x46, 0x92, 0x27, 0xe7,
+	0xf2, 0x0f, 0x09, 0x77, 0x93, 0xa5, 0x35, 0x79, 0xb8, 0x95, 0x79, 0x38, 0xd2, 0x21, 0x96, 0x3e,
+	0x79, 0xda, 0x43, 0xfd, 0x04, 0xa1, 0x83, 0xf8, 0xa8, 0xc7, 0xcc, 0xc3, 0x4e, 0xe9, 0x4f, 0xf3,
+	0x58, 0xfd, 0xd3, 0xdf, 0xc2, 0xc1, 0xcc, 0x0f, 0x21, 0x7e, 0xe0, 0x4b, 0x5c, 0x7b, 0x0c, 0xa9,
+	0x4e, 0xd8, 0xd2, 0x51, 0x72, 0x00, 0xc8, 0x29, 0x47, 0x06, 0x3c, 0x20, 0x93, 0x57, 0x61, 0x39,
+	0xcd, 0xe6, 0x03, 0x60, 0xc8, 0x03, 0x08, 0x65, 0xe4, 0x33, 0x93, 0x05, 0x6f, 0x50, 0xae, 0x70,
+	0xac, 0xcc, 0x71, 0x23, 0xcf, 0x9f, 0x09, 0xbd, 0x79, 0xfe, 0x6c, 0x58, 0xc9, 0xaa, 0x6f, 0xbb,
+	0x43, 0xd7, 0xd3, 0x8a, 0x00, 0xa6, 0xa2, 0xc8, 0x02, 0xfb, 0xf5, 0xa1, 0x68, 0x64, 0x0a, 0x06,
+	0x66, 0x5b, 0x89, 0xc5, 0x7c, 0x0d, 0xe1, 0x8a, 0xd5, 0xf8, 0x36, 0x70, 0x8b, 0x53, 0x9c, 0x47,
+	0x52, 0x30, 0x16, 0x24, 0x54, 0x3b, 0x57, 0x73, 0x9c, 0x64, 0x0a, 0x3e, 0x17, 0xb7, 0x17, 0xb8,
+	0xf9, 0x26, 0xfd, 0xb8, 0xe3, 0xfa, 0x38, 0xa6, 0x73, 0xef, 0xda, 0xf6, 0x84, 0x00, 0x5f, 0x4e,
+	0x9d, 0xbe, 0x03, 0x56, 0x21, 0xf1, 0x6a, 0x59, 0x37, 0xae, 0xf8, 0xca, 0x9d, 0xbb, 0xf3, 0x68,
+	0xb5, 0xe8, 0x7a, 0xfb, 0x6f, 0xfe, 0xf5, 0xd5, 0xdc, 0x4f, 0xe1, 0xef, 0x5f, 0xe0, 0xef, 0xc7,
+	0xff, 0xf6, 0xea, 0xad, 0x9f, 0xc2, 0xdf, 0xcf, 0xe0, 0xef, 0xa8, 0x4c, 0xbf, 0x40, 0x7f, 0xf4,
+	0x3f, 0x73, 0xa1, 0x12, 0x7e, 0xf7, 0x3e, 0x00, 0x00,
 }
-func (*UnimplementedZeroServer) MoveTablet(ctx context.Context, req *MoveTabletRequest) (*Status, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method MoveTablet not implemented")
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ context.Context
+var _ grpc.ClientConn
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+const _ = grpc.SupportPackageIsVersion4
+
+// RaftClient is the client API for Raft service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
+type RaftClient interface {
+	Heartbeat(ctx context.Context, in *api.Payload, opts ...grpc.CallOption) (Raft_HeartbeatClient, error)
+	RaftMessage(ctx context.Context, opts ...grpc.CallOption) (Raft_RaftMessageClient, error)
+	JoinCluster(ctx context.Context, in *RaftContext, opts ...grpc.CallOption) (*api.Payload, error)
+	IsPeer(ctx context.Context, in *RaftContext, opts ...grpc.CallOption) (*PeerResponse, error)
 }
-func (*UnimplementedZeroServer) ApplyLicense(ctx context.Context, req *ApplyLicenseRequest) (*Status, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method ApplyLicense not implemented")
+
+type raftClient struct {
+	cc *grpc.ClientConn
 }
 
-func RegisterZeroServer(s *grpc.Server, srv ZeroServer) {
-	s.RegisterService(&_Zero_serviceDesc, srv)
+func NewRaftClient(cc *grpc.ClientConn) RaftClient {
+	return &raftClient{cc}
 }
 
-func _Zero_Connect_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(Member)
-	if err := dec(in); err != nil {
+func (c *raftClient) Heartbeat(ctx context.Context, in *api.Payload, opts ...grpc.CallOption) (Raft_HeartbeatClient, error) {
+	stream, err := c.cc.NewStream(ctx, &_Raft_serviceDesc.Streams[0], "/pb.Raft/Heartbeat", opts...)
+	if err != nil {
 		return nil, err
 	}
-	if interceptor == nil {
-		return srv.(ZeroServer).Connect(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/pb.Zero/Connect",
+	x := &raftHeartbeatClient{stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
 	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(ZeroServer).Connect(ctx, req.(*Member))
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
 	}
-	return interceptor(ctx, in, info, handler)
+	return x, nil
 }
 
-func _Zero_UpdateMembership_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(Group)
-	if err := dec(in); err != nil {
+type Raft_HeartbeatClient interface {
+	Recv() (*HealthInfo, error)
+	grpc.ClientStream
+}
+
+type raftHeartbeatClient struct {
+	grpc.ClientStream
+}
+
+func (x *raftHeartbeatClient) Recv() (*HealthInfo, error) {
+	m := new(HealthInfo)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
 		return nil, err
 	}
-	if interceptor == nil {
-		return srv.(ZeroServer).UpdateMembership(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/pb.Zero/UpdateMembership",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(ZeroServer).UpdateMembership(ctx, req.(*Group))
-	}
-	return interceptor(ctx, in, info, handler)
+	return m, nil
 }
 
-func _Zero_StreamMembership_Handler(srv interface{}, stream grpc.ServerStream) error {
-	m := new(api.Payload)
-	if err := stream.RecvMsg(m); err != nil {
-		return err
+func (c *raftClient) RaftMessage(ctx context.Context, opts ...grpc.CallOption) (Raft_RaftMessageClient, error) {
+	stream, err := c.cc.NewStream(ctx, &_Raft_serviceDesc.Streams[1], "/pb.Raft/RaftMessage", opts...)
+	if err != nil {
+		return nil, err
 	}
-	return srv.(ZeroServer).StreamMembership(m, &zeroStreamMembershipServer{stream})
+	x := &raftRaftMessageClient{stream}
+	return x, nil
 }
 
-type Zero_StreamMembershipServer interface {
-	Send(*MembershipState) error
-	grpc.ServerStream
+type Raft_RaftMessageClient interface {
+	Send(*RaftBatch) error
+	CloseAndRecv() (*api.Payload, error)
+	grpc.ClientStream
 }
 
-type zeroStreamMembershipServer struct {
-	grpc.ServerStream
+type raftRaftMessageClient struct {
+	grpc.ClientStream
 }
 
-func (x *zeroStreamMembershipServer) Send(m *MembershipState) error {
-	return x.ServerStream.SendMsg(m)
+func (x *raftRaftMessageClient) Send(m *RaftBatch) error {
+	return x.ClientStream.SendMsg(m)
 }
 
-func _Zero_Oracle_Handler(srv interface{}, stream grpc.ServerStream) error {
+func (x *raftRaftMessageClient) CloseAndRecv() (*api.Payload, error) {
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
 	m := new(api.Payload)
-	if err := stream.RecvMsg(m); err != nil {
-		return err
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
 	}
-	return srv.(ZeroServer).Oracle(m, &zeroOracleServer{stream})
+	return m, nil
 }
 
-type Zero_OracleServer interface {
-	Send(*OracleDelta) error
-	grpc.ServerStream
+func (c *raftClient) JoinCluster(ctx context.Context, in *RaftContext, opts ...grpc.CallOption) (*api.Payload, error) {
+	out := new(api.Payload)
+	err := c.cc.Invoke(ctx, "/pb.Raft/JoinCluster", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
 
-type zeroOracleServer struct {
-	grpc.ServerStream
+func (c *raftClient) IsPeer(ctx context.Context, in *RaftContext, opts ...grpc.CallOption) (*PeerResponse, error) {
+	out := new(PeerResponse)
+	err := c.cc.Invoke(ctx, "/pb.Raft/IsPeer", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
 
-func (x *zeroOracleServer) Send(m *OracleDelta) error {
-	return x.ServerStream.SendMsg(m)
+// RaftServer is the server API for Raft service.
+type RaftServer interface {
+	Heartbeat(*api.Payload, Raft_HeartbeatServer) error
+	RaftMessage(Raft_RaftMessageServer) error
+	JoinCluster(context.Context, *RaftContext) (*api.Payload, error)
+	IsPeer(context.Context, *RaftContext) (*PeerResponse, error)
 }
 
-func _Zero_ShouldServe_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(Tablet)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(ZeroServer).ShouldServe(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/pb.Zero/ShouldServe",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(ZeroServer).ShouldServe(ctx, req.(*Tablet))
-	}
-	return interceptor(ctx, in, info, handler)
+// UnimplementedRaftServer can be embedded to have forward compatible implementations.
+type UnimplementedRaftServer struct {
 }
 
-func _Zero_Inform_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(TabletRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(ZeroServer).Inform(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/pb.Zero/Inform",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(ZeroServer).Inform(ctx, req.(*TabletRequest))
-	}
-	return interceptor(ctx, in, info, handler)
+func (*UnimplementedRaftServer) Heartbeat(req *api.Payload, srv Raft_HeartbeatServer) error {
+	return status.Errorf(codes.Unimplemented, "method Heartbeat not implemented")
+}
+func (*UnimplementedRaftServer) RaftMessage(srv Raft_RaftMessageServer) error {
+	return status.Errorf(codes.Unimplemented, "method RaftMessage not implemented")
+}
+func (*UnimplementedRaftServer) JoinCluster(ctx context.Context, req *RaftContext) (*api.Payload, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method JoinCluster not implemented")
+}
+func (*UnimplementedRaftServer) IsPeer(ctx context.Context, req *RaftContext) (*PeerResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method IsPeer not implemented")
 }
 
-func _Zero_AssignIds_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(Num)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(ZeroServer).AssignIds(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/pb.Zero/AssignIds",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(ZeroServer).AssignIds(ctx, req.(*Num))
-	}
-	return interceptor(ctx, in, info, handler)
+func RegisterRaftServer(s *grpc.Server, srv RaftServer) {
+	s.RegisterService(&_Raft_serviceDesc, srv)
 }
 
-func _Zero_Timestamps_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(Num)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(ZeroServer).Timestamps(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/pb.Zero/Timestamps",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(ZeroServer).Timestamps(ctx, req.(*Num))
+func _Raft_Heartbeat_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(api.Payload)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
 	}
-	return interceptor(ctx, in, info, handler)
+	return srv.(RaftServer).Heartbeat(m, &raftHeartbeatServer{stream})
 }
 
-func _Zero_CommitOrAbort_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(api.TxnContext)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(ZeroServer).CommitOrAbort(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/pb.Zero/CommitOrAbort",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(ZeroServer).CommitOrAbort(ctx, req.(*api.TxnContext))
-	}
-	return interceptor(ctx, in, info, handler)
+type Raft_HeartbeatServer interface {
+	Send(*HealthInfo) error
+	grpc.ServerStream
 }
 
-func _Zero_TryAbort_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(TxnTimestamps)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(ZeroServer).TryAbort(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/pb.Zero/TryAbort",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(ZeroServer).TryAbort(ctx, req.(*TxnTimestamps))
-	}
-	return interceptor(ctx, in, info, handler)
+type raftHeartbeatServer struct {
+	grpc.ServerStream
 }
 
-func _Zero_DeleteNamespace_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(DeleteNsRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(ZeroServer).DeleteNamespace(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/pb.Zero/DeleteNamespace",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(ZeroServer).DeleteNamespace(ctx, req.(*DeleteNsRequest))
-	}
-	return interceptor(ctx, in, info, handler)
+func (x *raftHeartbeatServer) Send(m *HealthInfo) error {
+	return x.ServerStream.SendMsg(m)
 }
 
-func _Zero_RemoveNode_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(RemoveNodeRequest)
-	if err := dec(in); err != nil {
+func _Raft_RaftMessage_Handler(srv interface{}, stream grpc.ServerStream) error {
+	return srv.(RaftServer).RaftMessage(&raftRaftMessageServer{stream})
+}
+
+type Raft_RaftMessageServer interface {
+	SendAndClose(*api.Payload) error
+	Recv() (*RaftBatch, error)
+	grpc.ServerStream
+}
+
+type raftRaftMessageServer struct {
+	grpc.ServerStream
+}
+
+func (x *raftRaftMessageServer) SendAndClose(m *api.Payload) error {
+	return x.ServerStream.SendMsg(m)
+}
+
+func (x *raftRaftMessageServer) Recv() (*RaftBatch, error) {
+	m := new(RaftBatch)
+	if err := x.ServerStream.RecvMsg(m); err != nil {
 		return nil, err
 	}
-	if interceptor == nil {
-		return srv.(ZeroServer).RemoveNode(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/pb.Zero/RemoveNode",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(ZeroServer).RemoveNode(ctx, req.(*RemoveNodeRequest))
-	}
-	return interceptor(ctx, in, info, handler)
+	return m, nil
 }
 
-func _Zero_MoveTablet_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(MoveTabletRequest)
+func _Raft_JoinCluster_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RaftContext)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(ZeroServer).MoveTablet(ctx, in)
+		return srv.(RaftServer).JoinCluster(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/pb.Zero/MoveTablet",
+		FullMethod: "/pb.Raft/JoinCluster",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(ZeroServer).MoveTablet(ctx, req.(*MoveTabletRequest))
+		return srv.(RaftServer).JoinCluster(ctx, req.(*RaftContext))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _Zero_ApplyLicense_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(ApplyLicenseRequest)
+func _Raft_IsPeer_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RaftContext)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(ZeroServer).ApplyLicense(ctx, in)
+		return srv.(RaftServer).IsPeer(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/pb.Zero/ApplyLicense",
+		FullMethod: "/pb.Raft/IsPeer",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(ZeroServer).ApplyLicense(ctx, req.(*ApplyLicenseRequest))
+		return srv.(RaftServer).IsPeer(ctx, req.(*RaftContext))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-var _Zero_serviceDesc = grpc.ServiceDesc{
-	ServiceName: "pb.Zero",
-	HandlerType: (*ZeroServer)(nil),
+var _Raft_serviceDesc = grpc.ServiceDesc{
+	ServiceName: "pb.Raft",
+	HandlerType: (*RaftServer)(nil),
 	Methods: []grpc.MethodDesc{
 		{
-			MethodName: "Connect",
-			Handler:    _Zero_Connect_Handler,
+			MethodName: "JoinCluster",
+			Handler:    _Raft_JoinCluster_Handler,
 		},
 		{
-			MethodName: "UpdateMembership",
-			Handler:    _Zero_UpdateMembership_Handler,
+			MethodName: "IsPeer",
+			Handler:    _Raft_IsPeer_Handler,
 		},
+	},
+	Streams: []grpc.StreamDesc{
 		{
-			MethodName: "ShouldServe",
-			Handler:    _Zero_ShouldServe_Handler,
+			StreamName:    "Heartbeat",
+			Handler:       _Raft_Heartbeat_Handler,
+			ServerStreams: true,
 		},
 		{
-			MethodName: "Inform",
-			Handler:    _Zero_Inform_Handler,
-		},
-		{
-			MethodName: "AssignIds",
-			Handler:    _Zero_AssignIds_Handler,
-		},
-		{
-			MethodName: "Timestamps",
-			Handler:    _Zero_Timestamps_Handler,
-		},
-		{
-			MethodName: "CommitOrAbort",
-			Handler:    _Zero_CommitOrAbort_Handler,
-		},
-		{
-			MethodName: "TryAbort",
-			Handler:    _Zero_TryAbort_Handler,
-		},
-		{
-			MethodName: "DeleteNamespace",
-			Handler:    _Zero_DeleteNamespace_Handler,
-		},
-		{
-			MethodName: "RemoveNode",
-			Handler:    _Zero_RemoveNode_Handler,
-		},
-		{
-			MethodName: "MoveTablet",
-			Handler:    _Zero_MoveTablet_Handler,
-		},
-		{
-			MethodName: "ApplyLicense",
-			Handler:    _Zero_ApplyLicense_Handler,
-		},
-	},
-	Streams: []grpc.StreamDesc{
-		{
-			StreamName:    "StreamMembership",
-			Handler:       _Zero_StreamMembership_Handler,
-			ServerStreams: true,
-		},
-		{
-			StreamName:    "Oracle",
-			Handler:       _Zero_Oracle_Handler,
-			ServerStreams: true,
+			StreamName:    "RaftMessage",
+			Handler:       _Raft_RaftMessage_Handler,
+			ClientStreams: true,
 		},
 	},
 	Metadata: "pb.proto",
 }
 
-// WorkerClient is the client API for Worker service.
+// ZeroClient is the client API for Zero service.
 //
 // For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
-type WorkerClient interface {
-	// Data serving RPCs.
-	Mutate(ctx context.Context, in *Mutations, opts ...grpc.CallOption) (*api.TxnContext, error)
-	ServeTask(ctx context.Context, in *Query, opts ...grpc.CallOption) (*Result, error)
-	StreamSnapshot(ctx context.Context, opts ...grpc.CallOption) (Worker_StreamSnapshotClient, error)
-	Sort(ctx context.Context, in *SortMessage, opts ...grpc.CallOption) (*SortResult, error)
-	Schema(ctx context.Context, in *SchemaRequest, opts ...grpc.CallOption) (*SchemaResult, error)
-	Backup(ctx context.Context, in *BackupRequest, opts ...grpc.CallOption) (*BackupResponse, error)
-	Restore(ctx context.Context, in *RestoreRequest, opts ...grpc.CallOption) (*Status, error)
-	Export(ctx context.Context, in *ExportRequest, opts ...grpc.CallOption) (*ExportResponse, error)
-	ReceivePredicate(ctx context.Context, opts ...grpc.CallOption) (Worker_ReceivePredicateClient, error)
-	MovePredicate(ctx context.Context, in *MovePredicatePayload, opts ...grpc.CallOption) (*api.Payload, error)
-	Subscribe(ctx context.Context, in *SubscriptionRequest, opts ...grpc.CallOption) (Worker_SubscribeClient, error)
-	UpdateGraphQLSchema(ctx context.Context, in *UpdateGraphQLSchemaRequest, opts ...grpc.CallOption) (*UpdateGraphQLSchemaResponse, error)
+type ZeroClient interface {
+	// These 3 endpoints are for handling membership.
+	Connect(ctx context.Context, in *Member, opts ...grpc.CallOption) (*ConnectionState, error)
+	UpdateMembership(ctx context.Context, in *Group, opts ...grpc.CallOption) (*api.Payload, error)
+	StreamMembership(ctx context.Context, in *api.Payload, opts ...grpc.CallOption) (Zero_StreamMembershipClient, error)
+	Oracle(ctx context.Context, in *api.Payload, opts ...grpc.CallOption) (Zero_OracleClient, error)
+	ShouldServe(ctx context.Context, in *Tablet, opts ...grpc.CallOption) (*Tablet, error)
+	Inform(ctx context.Context, in *TabletRequest, opts ...grpc.CallOption) (*TabletResponse, error)
+	AssignIds(ctx context.Context, in *Num, opts ...grpc.CallOption) (*AssignedIds, error)
+	Timestamps(ctx context.Context, in *Num, opts ...grpc.CallOption) (*AssignedIds, error)
+	CommitOrAbort(ctx context.Context, in *api.TxnContext, opts ...grpc.CallOption) (*api.TxnContext, error)
+	TryAbort(ctx context.Context, in *TxnTimestamps, opts ...grpc.CallOption) (*OracleDelta, error)
 	DeleteNamespace(ctx context.Context, in *DeleteNsRequest, opts ...grpc.CallOption) (*Status, error)
-	TaskStatus(ctx context.Context, in *TaskStatusRequest, opts ...grpc.CallOption) (*TaskStatusResponse, error)
+	RemoveNode(ctx context.Context, in *RemoveNodeRequest, opts ...grpc.CallOption) (*Status, error)
+	MoveTablet(ctx context.Context, in *MoveTabletRequest, opts ...grpc.CallOption) (*Status, error)
+	ApplyLicense(ctx context.Context, in *ApplyLicenseRequest, opts ...grpc.CallOption) (*Status, error)
 }
 
-type workerClient struct {
+type zeroClient struct {
 	cc *grpc.ClientConn
 }
 
-func NewWorkerClient(cc *grpc.ClientConn) WorkerClient {
-	return &workerClient{cc}
+func NewZeroClient(cc *grpc.ClientConn) ZeroClient {
+	return &zeroClient{cc}
 }
 
-func (c *workerClient) Mutate(ctx context.Context, in *Mutations, opts ...grpc.CallOption) (*api.TxnContext, error) {
-	out := new(api.TxnContext)
-	err := c.cc.Invoke(ctx, "/pb.Worker/Mutate", in, out, opts...)
+func (c *zeroClient) Connect(ctx context.Context, in *Member, opts ...grpc.CallOption) (*ConnectionState, error) {
+	out := new(ConnectionState)
+	err := c.cc.Invoke(ctx, "/pb.Zero/Connect", in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
 	return out, nil
 }
 
-func (c *workerClient) ServeTask(ctx context.Context, in *Query, opts ...grpc.CallOption) (*Result, error) {
-	out := new(Result)
-	err := c.cc.Invoke(ctx, "/pb.Worker/ServeTask", in, out, opts...)
+func (c *zeroClient) UpdateMembership(ctx context.Context, in *Group, opts ...grpc.CallOption) (*api.Payload, error) {
+	out := new(api.Payload)
+	err := c.cc.Invoke(ctx, "/pb.Zero/UpdateMembership", in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
 	return out, nil
 }
 
-func (c *workerClient) StreamSnapshot(ctx context.Context, opts ...grpc.CallOption) (Worker_StreamSnapshotClient, error) {
-	stream, err := c.cc.NewStream(ctx, &_Worker_serviceDesc.Streams[0], "/pb.Worker/StreamSnapshot", opts...)
+func (c *zeroClient) StreamMembership(ctx context.Context, in *api.Payload, opts ...grpc.CallOption) (Zero_StreamMembershipClient, error) {
+	stream, err := c.cc.NewStream(ctx, &_Zero_serviceDesc.Streams[0], "/pb.Zero/StreamMembership", opts...)
 	if err != nil {
 		return nil, err
 	}
-	x := &workerStreamSnapshotClient{stream}
+	x := &zeroStreamMembershipClient{stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
 	return x, nil
 }
 
-type Worker_StreamSnapshotClient interface {
-	Send(*Snapshot) error
-	Recv() (*KVS, error)
+type Zero_StreamMembershipClient interface {
+	Recv() (*MembershipState, error)
 	grpc.ClientStream
 }
 
-type workerStreamSnapshotClient struct {
+type zeroStreamMembershipClient struct {
 	grpc.ClientStream
 }
 
-func (x *workerStreamSnapshotClient) Send(m *Snapshot) error {
-	return x.ClientStream.SendMsg(m)
-}
-
-func (x *workerStreamSnapshotClient) Recv() (*KVS, error) {
-	m := new(KVS)
+func (x *zeroStreamMembershipClient) Recv() (*MembershipState, error) {
+	m := new(MembershipState)
 	if err := x.ClientStream.RecvMsg(m); err != nil {
 		return nil, err
 	}
 	return m, nil
 }
 
-func (c *workerClient) Sort(ctx context.Context, in *SortMessage, opts ...grpc.CallOption) (*SortResult, error) {
-	out := new(SortResult)
-	err := c.cc.Invoke(ctx, "/pb.Worker/Sort", in, out, opts...)
-	if err != nil {
-		return nil, err
-	}
-	return out, nil
-}
-
-func (c *workerClient) Schema(ctx context.Context, in *SchemaRequest, opts ...grpc.CallOption) (*SchemaResult, error) {
-	out := new(SchemaResult)
-	err := c.cc.Invoke(ctx, "/pb.Worker/Schema", in, out, opts...)
-	if err != nil {
-		return nil, err
-	}
-	return out, nil
-}
-
-func (c *workerClient) Backup(ctx context.Context, in *BackupRequest, opts ...grpc.CallOption) (*BackupResponse, error) {
-	out := new(BackupResponse)
-	err := c.cc.Invoke(ctx, "/pb.Worker/Backup", in, out, opts...)
-	if err != nil {
-		return nil, err
-	}
-	return out, nil
-}
-
-func (c *workerClient) Restore(ctx context.Context, in *RestoreRequest, opts ...grpc.CallOption) (*Status, error) {
-	out := new(Status)
-	err := c.cc.Invoke(ctx, "/pb.Worker/Restore", in, out, opts...)
+func (c *zeroClient) Oracle(ctx context.Context, in *api.Payload, opts ...grpc.CallOption) (Zero_OracleClient, error) {
+	stream, err := c.cc.NewStream(ctx, &_Zero_serviceDesc.Streams[1], "/pb.Zero/Oracle", opts...)
 	if err != nil {
 		return nil, err
 	}
-	return out, nil
-}
-
-func (c *workerClient) Export(ctx context.Context, in *ExportRequest, opts ...grpc.CallOption) (*ExportResponse, error) {
-	out := new(ExportResponse)
-	err := c.cc.Invoke(ctx, "/pb.Worker/Export", in, out, opts...)
-	if err != nil {
+	x := &zeroOracleClient{stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
 		return nil, err
 	}
-	return out, nil
-}
-
-func (c *workerClient) ReceivePredicate(ctx context.Context, opts ...grpc.CallOption) (Worker_ReceivePredicateClient, error) {
-	stream, err := c.cc.NewStream(ctx, &_Worker_serviceDesc.Streams[1], "/pb.Worker/ReceivePredicate", opts...)
-	if err != nil {
+	if err := x.ClientStream.CloseSend(); err != nil {
 		return nil, err
 	}
-	x := &workerReceivePredicateClient{stream}
 	return x, nil
 }
 
-type Worker_ReceivePredicateClient interface {
-	Send(*KVS) error
-	CloseAndRecv() (*api.Payload, error)
+type Zero_OracleClient interface {
+	Recv() (*OracleDelta, error)
 	grpc.ClientStream
 }
 
-type workerReceivePredicateClient struct {
+type zeroOracleClient struct {
 	grpc.ClientStream
 }
 
-func (x *workerReceivePredicateClient) Send(m *KVS) error {
-	return x.ClientStream.SendMsg(m)
-}
-
-func (x *workerReceivePredicateClient) CloseAndRecv() (*api.Payload, error) {
-	if err := x.ClientStream.CloseSend(); err != nil {
-		return nil, err
-	}
-	m := new(api.Payload)
+func (x *zeroOracleClient) Recv() (*OracleDelta, error) {
+	m := new(OracleDelta)
 	if err := x.ClientStream.RecvMsg(m); err != nil {
 		return nil, err
 	}
 	return m, nil
 }
 
-func (c *workerClient) MovePredicate(ctx context.Context, in *MovePredicatePayload, opts ...grpc.CallOption) (*api.Payload, error) {
-	out := new(api.Payload)
-	err := c.cc.Invoke(ctx, "/pb.Worker/MovePredicate", in, out, opts...)
+func (c *zeroClient) ShouldServe(ctx context.Context, in *Tablet, opts ...grpc.CallOption) (*Tablet, error) {
+	out := new(Tablet)
+	err := c.cc.Invoke(ctx, "/pb.Zero/ShouldServe", in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
 	return out, nil
 }
 
-func (c *workerClient) Subscribe(ctx context.Context, in *SubscriptionRequest, opts ...grpc.CallOption) (Worker_SubscribeClient, error) {
-	stream, err := c.cc.NewStream(ctx, &_Worker_serviceDesc.Streams[2], "/pb.Worker/Subscribe", opts...)
+func (c *zeroClient) Inform(ctx context.Context, in *TabletRequest, opts ...grpc.CallOption) (*TabletResponse, error) {
+	out := new(TabletResponse)
+	err := c.cc.Invoke(ctx, "/pb.Zero/Inform", in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
-	x := &workerSubscribeClient{stream}
-	if err := x.ClientStream.SendMsg(in); err != nil {
-		return nil, err
-	}
-	if err := x.ClientStream.CloseSend(); err != nil {
-		return nil, err
-	}
-	return x, nil
+	return out, nil
 }
 
-type Worker_SubscribeClient interface {
-	Recv() (*pb.KVList, error)
-	grpc.ClientStream
+func (c *zeroClient) AssignIds(ctx context.Context, in *Num, opts ...grpc.CallOption) (*AssignedIds, error) {
+	out := new(AssignedIds)
+	err := c.cc.Invoke(ctx, "/pb.Zero/AssignIds", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
 
-type workerSubscribeClient struct {
-	grpc.ClientStream
+func (c *zeroClient) Timestamps(ctx context.Context, in *Num, opts ...grpc.CallOption) (*AssignedIds, error) {
+	out := new(AssignedIds)
+	err := c.cc.Invoke(ctx, "/pb.Zero/Timestamps", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
 
-func (x *workerSubscribeClient) Recv() (*pb.KVList, error) {
-	m := new(pb.KVList)
-	if err := x.ClientStream.RecvMsg(m); err != nil {
+func (c *zeroClient) CommitOrAbort(ctx context.Context, in *api.TxnContext, opts ...grpc.CallOption) (*api.TxnContext, error) {
+	out := new(api.TxnContext)
+	err := c.cc.Invoke(ctx, "/pb.Zero/CommitOrAbort", in, out, opts...)
+	if err != nil {
 		return nil, err
 	}
-	return m, nil
+	return out, nil
 }
 
-func (c *workerClient) UpdateGraphQLSchema(ctx context.Context, in *UpdateGraphQLSchemaRequest, opts ...grpc.CallOption) (*UpdateGraphQLSchemaResponse, error) {
-	out := new(UpdateGraphQLSchemaResponse)
-	err := c.cc.Invoke(ctx, "/pb.Worker/UpdateGraphQLSchema", in, out, opts...)
+func (c *zeroClient) TryAbort(ctx context.Context, in *TxnTimestamps, opts ...grpc.CallOption) (*OracleDelta, error) {
+	out := new(OracleDelta)
+	err := c.cc.Invoke(ctx, "/pb.Zero/TryAbort", in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
 	return out, nil
 }
 
-func (c *workerClient) DeleteNamespace(ctx context.Context, in *DeleteNsRequest, opts ...grpc.CallOption) (*Status, error) {
+func (c *zeroClient) DeleteNamespace(ctx context.Context, in *DeleteNsRequest, opts ...grpc.CallOption) (*Status, error) {
 	out := new(Status)
-	err := c.cc.Invoke(ctx, "/pb.Worker/DeleteNamespace", in, out, opts...)
+	err := c.cc.Invoke(ctx, "/pb.Zero/DeleteNamespace", in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
 	return out, nil
 }
 
-func (c *workerClient) TaskStatus(ctx context.Context, in *TaskStatusRequest, opts ...grpc.CallOption) (*TaskStatusResponse, error) {
-	out := new(TaskStatusResponse)
-	err := c.cc.Invoke(ctx, "/pb.Worker/TaskStatus", in, out, opts...)
+func (c *zeroClient) RemoveNode(ctx context.Context, in *RemoveNodeRequest, opts ...grpc.CallOption) (*Status, error) {
+	out := new(Status)
+	err := c.cc.Invoke(ctx, "/pb.Zero/RemoveNode", in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
 	return out, nil
 }
 
-// WorkerServer is the server API for Worker service.
-type WorkerServer interface {
-	// Data serving RPCs.
-	Mutate(context.Context, *Mutations) (*api.TxnContext, error)
-	ServeTask(context.Context, *Query) (*Result, error)
-	StreamSnapshot(Worker_StreamSnapshotServer) error
-	Sort(context.Context, *SortMessage) (*SortResult, error)
-	Schema(context.Context, *SchemaRequest) (*SchemaResult, error)
-	Backup(context.Context, *BackupRequest) (*BackupResponse, error)
-	Restore(context.Context, *RestoreRequest) (*Status, error)
-	Export(context.Context, *ExportRequest) (*ExportResponse, error)
-	ReceivePredicate(Worker_ReceivePredicateServer) error
-	MovePredicate(context.Context, *MovePredicatePayload) (*api.Payload, error)
-	Subscribe(*SubscriptionRequest, Worker_SubscribeServer) error
-	UpdateGraphQLSchema(context.Context, *UpdateGraphQLSchemaRequest) (*UpdateGraphQLSchemaResponse, error)
-	DeleteNamespace(context.Context, *DeleteNsRequest) (*Status, error)
-	TaskStatus(context.Context, *TaskStatusRequest) (*TaskStatusResponse, error)
+func (c *zeroClient) MoveTablet(ctx context.Context, in *MoveTabletRequest, opts ...grpc.CallOption) (*Status, error) {
+	out := new(Status)
+	err := c.cc.Invoke(ctx, "/pb.Zero/MoveTablet", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
 
-// UnimplementedWorkerServer can be embedded to have forward compatible implementations.
-type UnimplementedWorkerServer struct {
+func (c *zeroClient) ApplyLicense(ctx context.Context, in *ApplyLicenseRequest, opts ...grpc.CallOption) (*Status, error) {
+	out := new(Status)
+	err := c.cc.Invoke(ctx, "/pb.Zero/ApplyLicense", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
 
-func (*UnimplementedWorkerServer) Mutate(ctx context.Context, req *Mutations) (*api.TxnContext, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method Mutate not implemented")
+// ZeroServer is the server API for Zero service.
+type ZeroServer interface {
+	// These 3 endpoints are for handling membership.
+	Connect(context.Context, *Member) (*ConnectionState, error)
+	UpdateMembership(context.Context, *Group) (*api.Payload, error)
+	StreamMembership(*api.Payload, Zero_StreamMembershipServer) error
+	Oracle(*api.Payload, Zero_OracleServer) error
+	ShouldServe(context.Context, *Tablet) (*Tablet, error)
+	Inform(context.Context, *TabletRequest) (*TabletResponse, error)
+	AssignIds(context.Context, *Num) (*AssignedIds, error)
+	Timestamps(context.Context, *Num) (*AssignedIds, error)
+	CommitOrAbort(context.Context, *api.TxnContext) (*api.TxnContext, error)
+	TryAbort(context.Context, *TxnTimestamps) (*OracleDelta, error)
+	DeleteNamespace(context.Context, *DeleteNsRequest) (*Status, error)
+	RemoveNode(context.Context, *RemoveNodeRequest) (*Status, error)
+	MoveTablet(context.Context, *MoveTabletRequest) (*Status, error)
+	ApplyLicense(context.Context, *ApplyLicenseRequest) (*Status, error)
 }
-func (*UnimplementedWorkerServer) ServeTask(ctx context.Context, req *Query) (*Result, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method ServeTask not implemented")
+
+// UnimplementedZeroServer can be embedded to have forward compatible implementations.
+type UnimplementedZeroServer struct {
 }
-func (*UnimplementedWorkerServer) StreamSnapshot(srv Worker_StreamSnapshotServer) error {
-	return status.Errorf(codes.Unimplemented, "method StreamSnapshot not implemented")
+
+func (*UnimplementedZeroServer) Connect(ctx context.Context, req *Member) (*ConnectionState, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Connect not implemented")
 }
-func (*UnimplementedWorkerServer) Sort(ctx context.Context, req *SortMessage) (*SortResult, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method Sort not implemented")
+func (*UnimplementedZeroServer) UpdateMembership(ctx context.Context, req *Group) (*api.Payload, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateMembership not implemented")
 }
-func (*UnimplementedWorkerServer) Schema(ctx context.Context, req *SchemaRequest) (*SchemaResult, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method Schema not implemented")
+func (*UnimplementedZeroServer) StreamMembership(req *api.Payload, srv Zero_StreamMembershipServer) error {
+	return status.Errorf(codes.Unimplemented, "method StreamMembership not implemented")
 }
-func (*UnimplementedWorkerServer) Backup(ctx context.Context, req *BackupRequest) (*BackupResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method Backup not implemented")
+func (*UnimplementedZeroServer) Oracle(req *api.Payload, srv Zero_OracleServer) error {
+	return status.Errorf(codes.Unimplemented, "method Oracle not implemented")
 }
-func (*UnimplementedWorkerServer) Restore(ctx context.Context, req *RestoreRequest) (*Status, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method Restore not implemented")
+func (*UnimplementedZeroServer) ShouldServe(ctx context.Context, req *Tablet) (*Tablet, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ShouldServe not implemented")
 }
-func (*UnimplementedWorkerServer) Export(ctx context.Context, req *ExportRequest) (*ExportResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method Export not implemented")
+func (*UnimplementedZeroServer) Inform(ctx context.Context, req *TabletRequest) (*TabletResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Inform not implemented")
 }
-func (*UnimplementedWorkerServer) ReceivePredicate(srv Worker_ReceivePredicateServer) error {
-	return status.Errorf(codes.Unimplemented, "method ReceivePredicate not implemented")
+func (*UnimplementedZeroServer) AssignIds(ctx context.Context, req *Num) (*AssignedIds, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AssignIds not implemented")
 }
-func (*UnimplementedWorkerServer) MovePredicate(ctx context.Context, req *MovePredicatePayload) (*api.Payload, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method MovePredicate not implemented")
+func (*UnimplementedZeroServer) Timestamps(ctx context.Context, req *Num) (*AssignedIds, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Timestamps not implemented")
 }
-func (*UnimplementedWorkerServer) Subscribe(req *SubscriptionRequest, srv Worker_SubscribeServer) error {
-	return status.Errorf(codes.Unimplemented, "method Subscribe not implemented")
+func (*UnimplementedZeroServer) CommitOrAbort(ctx context.Context, req *api.TxnContext) (*api.TxnContext, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CommitOrAbort not implemented")
 }
-func (*UnimplementedWorkerServer) UpdateGraphQLSchema(ctx context.Context, req *UpdateGraphQLSchemaRequest) (*UpdateGraphQLSchemaResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method UpdateGraphQLSchema not implemented")
+func (*UnimplementedZeroServer) TryAbort(ctx context.Context, req *TxnTimestamps) (*OracleDelta, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method TryAbort not implemented")
 }
-func (*UnimplementedWorkerServer) DeleteNamespace(ctx context.Context, req *DeleteNsRequest) (*Status, error) {
+func (*UnimplementedZeroServer) DeleteNamespace(ctx context.Context, req *DeleteNsRequest) (*Status, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method DeleteNamespace not implemented")
 }
-func (*UnimplementedWorkerServer) TaskStatus(ctx context.Context, req *TaskStatusRequest) (*TaskStatusResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method TaskStatus not implemented")
+func (*UnimplementedZeroServer) RemoveNode(ctx context.Context, req *RemoveNodeRequest) (*Status, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RemoveNode not implemented")
+}
+func (*UnimplementedZeroServer) MoveTablet(ctx context.Context, req *MoveTabletRequest) (*Status, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method MoveTablet not implemented")
+}
+func (*UnimplementedZeroServer) ApplyLicense(ctx context.Context, req *ApplyLicenseRequest) (*Status, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ApplyLicense not implemented")
 }
 
-func RegisterWorkerServer(s *grpc.Server, srv WorkerServer) {
-	s.RegisterService(&_Worker_serviceDesc, srv)
+func RegisterZeroServer(s *grpc.Server, srv ZeroServer) {
+	s.RegisterService(&_Zero_serviceDesc, srv)
 }
 
-func _Worker_Mutate_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(Mutations)
+func _Zero_Connect_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(Member)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(WorkerServer).Mutate(ctx, in)
+		return srv.(ZeroServer).Connect(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/pb.Worker/Mutate",
+		FullMethod: "/pb.Zero/Connect",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(WorkerServer).Mutate(ctx, req.(*Mutations))
+		return srv.(ZeroServer).Connect(ctx, req.(*Member))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _Worker_ServeTask_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(Query)
+func _Zero_UpdateMembership_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(Group)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(WorkerServer).ServeTask(ctx, in)
+		return srv.(ZeroServer).UpdateMembership(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/pb.Worker/ServeTask",
+		FullMethod: "/pb.Zero/UpdateMembership",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(WorkerServer).ServeTask(ctx, req.(*Query))
+		return srv.(ZeroServer).UpdateMembership(ctx, req.(*Group))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _Worker_StreamSnapshot_Handler(srv interface{}, stream grpc.ServerStream) error {
-	return srv.(WorkerServer).StreamSnapshot(&workerStreamSnapshotServer{stream})
+func _Zero_StreamMembership_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(api.Payload)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(ZeroServer).StreamMembership(m, &zeroStreamMembershipServer{stream})
 }
 
-type Worker_StreamSnapshotServer interface {
-	Send(*KVS) error
-	Recv() (*Snapshot, error)
+type Zero_StreamMembershipServer interface {
+	Send(*MembershipState) error
 	grpc.ServerStream
 }
 
-type workerStreamSnapshotServer struct {
+type zeroStreamMembershipServer struct {
 	grpc.ServerStream
 }
 
-func (x *workerStreamSnapshotServer) Send(m *KVS) error {
+func (x *zeroStreamMembershipServer) Send(m *MembershipState) error {
 	return x.ServerStream.SendMsg(m)
 }
 
-func (x *workerStreamSnapshotServer) Recv() (*Snapshot, error) {
-	m := new(Snapshot)
-	if err := x.ServerStream.RecvMsg(m); err != nil {
-		return nil, err
+func _Zero_Oracle_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(api.Payload)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
 	}
-	return m, nil
+	return srv.(ZeroServer).Oracle(m, &zeroOracleServer{stream})
 }
 
-func _Worker_Sort_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(SortMessage)
+type Zero_OracleServer interface {
+	Send(*OracleDelta) error
+	grpc.ServerStream
+}
+
+type zeroOracleServer struct {
+	grpc.ServerStream
+}
+
+func (x *zeroOracleServer) Send(m *OracleDelta) error {
+	return x.ServerStream.SendMsg(m)
+}
+
+func _Zero_ShouldServe_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(Tablet)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(WorkerServer).Sort(ctx, in)
+		return srv.(ZeroServer).ShouldServe(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/pb.Worker/Sort",
+		FullMethod: "/pb.Zero/ShouldServe",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(WorkerServer).Sort(ctx, req.(*SortMessage))
+		return srv.(ZeroServer).ShouldServe(ctx, req.(*Tablet))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _Worker_Schema_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(SchemaRequest)
+func _Zero_Inform_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(TabletRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(WorkerServer).Schema(ctx, in)
+		return srv.(ZeroServer).Inform(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/pb.Worker/Schema",
+		FullMethod: "/pb.Zero/Inform",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(WorkerServer).Schema(ctx, req.(*SchemaRequest))
+		return srv.(ZeroServer).Inform(ctx, req.(*TabletRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _Worker_Backup_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(BackupRequest)
+func _Zero_AssignIds_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(Num)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(WorkerServer).Backup(ctx, in)
+		return srv.(ZeroServer).AssignIds(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/pb.Worker/Backup",
+		FullMethod: "/pb.Zero/AssignIds",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(WorkerServer).Backup(ctx, req.(*BackupRequest))
+		return srv.(ZeroServer).AssignIds(ctx, req.(*Num))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _Worker_Restore_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(RestoreRequest)
+func _Zero_Timestamps_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(Num)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(WorkerServer).Restore(ctx, in)
+		return srv.(ZeroServer).Timestamps(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/pb.Worker/Restore",
+		FullMethod: "/pb.Zero/Timestamps",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(WorkerServer).Restore(ctx, req.(*RestoreRequest))
+		return srv.(ZeroServer).Timestamps(ctx, req.(*Num))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _Worker_Export_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(ExportRequest)
+func _Zero_CommitOrAbort_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(api.TxnContext)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(WorkerServer).Export(ctx, in)
+		return srv.(ZeroServer).CommitOrAbort(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/pb.Worker/Export",
+		FullMethod: "/pb.Zero/CommitOrAbort",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(WorkerServer).Export(ctx, req.(*ExportRequest))
+		return srv.(ZeroServer).CommitOrAbort(ctx, req.(*api.TxnContext))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _Worker_ReceivePredicate_Handler(srv interface{}, stream grpc.ServerStream) error {
-	return srv.(WorkerServer).ReceivePredicate(&workerReceivePredicateServer{stream})
-}
-
-type Worker_ReceivePredicateServer interface {
-	SendAndClose(*api.Payload) error
-	Recv() (*KVS, error)
-	grpc.ServerStream
-}
-
-type workerReceivePredicateServer struct {
-	grpc.ServerStream
-}
-
-func (x *workerReceivePredicateServer) SendAndClose(m *api.Payload) error {
-	return x.ServerStream.SendMsg(m)
-}
-
-func (x *workerReceivePredicateServer) Recv() (*KVS, error) {
-	m := new(KVS)
-	if err := x.ServerStream.RecvMsg(m); err != nil {
-		return nil, err
-	}
-	return m, nil
-}
-
-func _Worker_MovePredicate_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(MovePredicatePayload)
+func _Zero_TryAbort_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(TxnTimestamps)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(WorkerServer).MovePredicate(ctx, in)
+		return srv.(ZeroServer).TryAbort(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/pb.Worker/MovePredicate",
+		FullMethod: "/pb.Zero/TryAbort",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(WorkerServer).MovePredicate(ctx, req.(*MovePredicatePayload))
+		return srv.(ZeroServer).TryAbort(ctx, req.(*TxnTimestamps))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _Worker_Subscribe_Handler(srv interface{}, stream grpc.ServerStream) error {
-	m := new(SubscriptionRequest)
-	if err := stream.RecvMsg(m); err != nil {
-		return err
+func _Zero_DeleteNamespace_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeleteNsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	return srv.(WorkerServer).Subscribe(m, &workerSubscribeServer{stream})
-}
-
-type Worker_SubscribeServer interface {
-	Send(*pb.KVList) error
-	grpc.ServerStream
-}
-
-type workerSubscribeServer struct {
-	grpc.ServerStream
-}
-
-func (x *workerSubscribeServer) Send(m *pb.KVList) error {
-	return x.ServerStream.SendMsg(m)
+	if interceptor == nil {
+		return srv.(ZeroServer).DeleteNamespace(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/pb.Zero/DeleteNamespace",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ZeroServer).DeleteNamespace(ctx, req.(*DeleteNsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func _Worker_UpdateGraphQLSchema_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(UpdateGraphQLSchemaRequest)
+func _Zero_RemoveNode_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RemoveNodeRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(WorkerServer).UpdateGraphQLSchema(ctx, in)
+		return srv.(ZeroServer).RemoveNode(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/pb.Worker/UpdateGraphQLSchema",
+		FullMethod: "/pb.Zero/RemoveNode",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(WorkerServer).UpdateGraphQLSchema(ctx, req.(*UpdateGraphQLSchemaRequest))
+		return srv.(ZeroServer).RemoveNode(ctx, req.(*RemoveNodeRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _Worker_DeleteNamespace_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(DeleteNsRequest)
+func _Zero_MoveTablet_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MoveTabletRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(WorkerServer).DeleteNamespace(ctx, in)
+		return srv.(ZeroServer).MoveTablet(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/pb.Worker/DeleteNamespace",
+		FullMethod: "/pb.Zero/MoveTablet",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(WorkerServer).DeleteNamespace(ctx, req.(*DeleteNsRequest))
+		return srv.(ZeroServer).MoveTablet(ctx, req.(*MoveTabletRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _Worker_TaskStatus_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(TaskStatusRequest)
+func _Zero_ApplyLicense_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ApplyLicenseRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(WorkerServer).TaskStatus(ctx, in)
+		return srv.(ZeroServer).ApplyLicense(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/pb.Worker/TaskStatus",
+		FullMethod: "/pb.Zero/ApplyLicense",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(WorkerServer).TaskStatus(ctx, req.(*TaskStatusRequest))
+		return srv.(ZeroServer).ApplyLicense(ctx, req.(*ApplyLicenseRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-var _Worker_serviceDesc = grpc.ServiceDesc{
-	ServiceName: "pb.Worker",
-	HandlerType: (*WorkerServer)(nil),
+var _Zero_serviceDesc = grpc.ServiceDesc{
+	ServiceName: "pb.Zero",
+	HandlerType: (*ZeroServer)(nil),
 	Methods: []grpc.MethodDesc{
 		{
-			MethodName: "Mutate",
-			Handler:    _Worker_Mutate_Handler,
+			MethodName: "Connect",
+			Handler:    _Zero_Connect_Handler,
 		},
 		{
-			MethodName: "ServeTask",
-			Handler:    _Worker_ServeTask_Handler,
+			MethodName: "UpdateMembership",
+			Handler:    _Zero_UpdateMembership_Handler,
 		},
 		{
-			MethodName: "Sort",
-			Handler:    _Worker_Sort_Handler,
+			MethodName: "ShouldServe",
+			Handler:    _Zero_ShouldServe_Handler,
 		},
 		{
-			MethodName: "Schema",
-			Handler:    _Worker_Schema_Handler,
+			MethodName: "Inform",
+			Handler:    _Zero_Inform_Handler,
 		},
 		{
-			MethodName: "Backup",
-			Handler:    _Worker_Backup_Handler,
+			MethodName: "AssignIds",
+			Handler:    _Zero_AssignIds_Handler,
 		},
 		{
-			MethodName: "Restore",
-			Handler:    _Worker_Restore_Handler,
+			MethodName: "Timestamps",
+			Handler:    _Zero_Timestamps_Handler,
 		},
 		{
-			MethodName: "Export",
-			Handler:    _Worker_Export_Handler,
+			MethodName: "CommitOrAbort",
+			Handler:    _Zero_CommitOrAbort_Handler,
 		},
 		{
-			MethodName: "MovePredicate",
-			Handler:    _Worker_MovePredicate_Handler,
+			MethodName: "TryAbort",
+			Handler:    _Zero_TryAbort_Handler,
 		},
 		{
-			MethodName: "UpdateGraphQLSchema",
-			Handler:    _Worker_UpdateGraphQLSchema_Handler,
+			MethodName: "DeleteNamespace",
+			Handler:    _Zero_DeleteNamespace_Handler,
 		},
 		{
-			MethodName: "DeleteNamespace",
-			Handler:    _Worker_DeleteNamespace_Handler,
+			MethodName: "RemoveNode",
+			Handler:    _Zero_RemoveNode_Handler,
 		},
 		{
-			MethodName: "TaskStatus",
-			Handler:    _Worker_TaskStatus_Handler,
+			MethodName: "MoveTablet",
+			Handler:    _Zero_MoveTablet_Handler,
+		},
+		{
+			MethodName: "ApplyLicense",
+			Handler:    _Zero_ApplyLicense_Handler,
 		},
 	},
 	Streams: []grpc.StreamDesc{
 		{
-			StreamName:    "StreamSnapshot",
-			Handler:       _Worker_StreamSnapshot_Handler,
+			StreamName:    "StreamMembership",
+			Handler:       _Zero_StreamMembership_Handler,
 			ServerStreams: true,
-			ClientStreams: true,
-		},
-		{
-			StreamName:    "ReceivePredicate",
-			Handler:       _Worker_ReceivePredicate_Handler,
-			ClientStreams: true,
 		},
 		{
-			StreamName:    "Subscribe",
-			Handler:       _Worker_Subscribe_Handler,
+			StreamName:    "Oracle",
+			Handler:       _Zero_Oracle_Handler,
 			ServerStreams: true,
 		},
 	},
 	Metadata: "pb.proto",
 }
 
-func (m *List) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+// WorkerClient is the client API for Worker service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
+type WorkerClient interface {
+	// Data serving RPCs.
+	Mutate(ctx context.Context, in *Mutations, opts ...grpc.CallOption) (*api.TxnContext, error)
+	ServeTask(ctx context.Context, in *Query, opts ...grpc.CallOption) (*Result, error)
+	StreamSnapshot(ctx context.Context, opts ...grpc.CallOption) (Worker_StreamSnapshotClient, error)
+	Sort(ctx context.Context, in *SortMessage, opts ...grpc.CallOption) (*SortResult, error)
+	Schema(ctx context.Context, in *SchemaRequest, opts ...grpc.CallOption) (*SchemaResult, error)
+	Backup(ctx context.Context, in *BackupRequest, opts ...grpc.CallOption) (*BackupResponse, error)
+	Restore(ctx context.Context, in *RestoreRequest, opts ...grpc.CallOption) (*Status, error)
+	Export(ctx context.Context, in *ExportRequest, opts ...grpc.CallOption) (*ExportResponse, error)
+	ReceivePredicate(ctx context.Context, opts ...grpc.CallOption) (Worker_ReceivePredicateClient, error)
+	MovePredicate(ctx context.Context, in *MovePredicatePayload, opts ...grpc.CallOption) (*api.Payload, error)
+	Subscribe(ctx context.Context, in *SubscriptionRequest, opts ...grpc.CallOption) (Worker_SubscribeClient, error)
+	UpdateGraphQLSchema(ctx context.Context, in *UpdateGraphQLSchemaRequest, opts ...grpc.CallOption) (*UpdateGraphQLSchemaResponse, error)
+	DeleteNamespace(ctx context.Context, in *DeleteNsRequest, opts ...grpc.CallOption) (*Status, error)
+	TaskStatus(ctx context.Context, in *TaskStatusRequest, opts ...grpc.CallOption) (*TaskStatusResponse, error)
+}
+
+type workerClient struct {
+	cc *grpc.ClientConn
+}
+
+func NewWorkerClient(cc *grpc.ClientConn) WorkerClient {
+	return &workerClient{cc}
+}
+
+func (c *workerClient) Mutate(ctx context.Context, in *Mutations, opts ...grpc.CallOption) (*api.TxnContext, error) {
+	out := new(api.TxnContext)
+	err := c.cc.Invoke(ctx, "/pb.Worker/Mutate", in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
-}
-
-func (m *List) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+	return out, nil
 }
 
-func (m *List) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.Uids) > 0 {
-		for iNdEx := len(m.Uids) - 1; iNdEx >= 0; iNdEx-- {
-			i -= 8
-			encoding_binary.LittleEndian.PutUint64(dAtA[i:], uint64(m.Uids[iNdEx]))
-		}
-		i = encodeVarintPb(dAtA, i, uint64(len(m.Uids)*8))
-		i--
-		dAtA[i] = 0xa
+func (c *workerClient) ServeTask(ctx context.Context, in *Query, opts ...grpc.CallOption) (*Result, error) {
+	out := new(Result)
+	err := c.cc.Invoke(ctx, "/pb.Worker/ServeTask", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	return len(dAtA) - i, nil
+	return out, nil
 }
 
-func (m *TaskValue) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+func (c *workerClient) StreamSnapshot(ctx context.Context, opts ...grpc.CallOption) (Worker_StreamSnapshotClient, error) {
+	stream, err := c.cc.NewStream(ctx, &_Worker_serviceDesc.Streams[0], "/pb.Worker/StreamSnapshot", opts...)
 	if err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
+	x := &workerStreamSnapshotClient{stream}
+	return x, nil
 }
 
-func (m *TaskValue) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+type Worker_StreamSnapshotClient interface {
+	Send(*Snapshot) error
+	Recv() (*KVS, error)
+	grpc.ClientStream
 }
 
-func (m *TaskValue) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.ValType != 0 {
-		i = encodeVarintPb(dAtA, i, uint64(m.ValType))
-		i--
-		dAtA[i] = 0x10
-	}
-	if len(m.Val) > 0 {
-		i -= len(m.Val)
-		copy(dAtA[i:], m.Val)
-		i = encodeVarintPb(dAtA, i, uint64(len(m.Val)))
-		i--
-		dAtA[i] = 0xa
+type workerStreamSnapshotClient struct {
+	grpc.ClientStream
+}
+
+func (x *workerStreamSnapshotClient) Send(m *Snapshot) error {
+	return x.ClientStream.SendMsg(m)
+}
+
+func (x *workerStreamSnapshotClient) Recv() (*KVS, error) {
+	m := new(KVS)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
 	}
-	return len(dAtA) - i, nil
+	return m, nil
 }
 
-func (m *SrcFunction) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+func (c *workerClient) Sort(ctx context.Context, in *SortMessage, opts ...grpc.CallOption) (*SortResult, error) {
+	out := new(SortResult)
+	err := c.cc.Invoke(ctx, "/pb.Worker/Sort", in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
+	return out, nil
 }
 
-func (m *SrcFunction) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
-}
-
-func (m *SrcFunction) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.IsCount {
-		i--
-		if m.IsCount {
-			dAtA[i] = 1
-		} else {
-			dAtA[i] = 0
-		}
-		i--
-		dAtA[i] = 0x20
-	}
-	if len(m.Args) > 0 {
-		for iNdEx := len(m.Args) - 1; iNdEx >= 0; iNdEx-- {
-			i -= len(m.Args[iNdEx])
-			copy(dAtA[i:], m.Args[iNdEx])
-			i = encodeVarintPb(dAtA, i, uint64(len(m.Args[iNdEx])))
-			i--
-			dAtA[i] = 0x1a
-		}
-	}
-	if len(m.Name) > 0 {
-		i -= len(m.Name)
-		copy(dAtA[i:], m.Name)
-		i = encodeVarintPb(dAtA, i, uint64(len(m.Name)))
-		i--
-		dAtA[i] = 0xa
+func (c *workerClient) Schema(ctx context.Context, in *SchemaRequest, opts ...grpc.CallOption) (*SchemaResult, error) {
+	out := new(SchemaResult)
+	err := c.cc.Invoke(ctx, "/pb.Worker/Schema", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	return len(dAtA) - i, nil
+	return out, nil
 }
 
-func (m *Query) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+func (c *workerClient) Backup(ctx context.Context, in *BackupRequest, opts ...grpc.CallOption) (*BackupResponse, error) {
+	out := new(BackupResponse)
+	err := c.cc.Invoke(ctx, "/pb.Worker/Backup", in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
+	return out, nil
 }
 
-func (m *Query) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (c *workerClient) Restore(ctx context.Context, in *RestoreRequest, opts ...grpc.CallOption) (*Status, error) {
+	out := new(Status)
+	err := c.cc.Invoke(ctx, "/pb.Worker/Restore", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
 
-func (m *Query) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.Offset != 0 {
-		i = encodeVarintPb(dAtA, i, uint64(m.Offset))
-		i--
-		dAtA[i] = 0x1
-		i--
-		dAtA[i] = 0x80
-	}
-	if m.First != 0 {
-		i = encodeVarintPb(dAtA, i, uint64(m.First))
-		i--
-		dAtA[i] = 0x78
-	}
-	if m.Cache != 0 {
-		i = encodeVarintPb(dAtA, i, uint64(m.Cache))
-		i--
-		dAtA[i] = 0x70
-	}
-	if m.ReadTs != 0 {
-		i = encodeVarintPb(dAtA, i, uint64(m.ReadTs))
-		i--
-		dAtA[i] = 0x68
-	}
-	if m.ExpandAll {
-		i--
-		if m.ExpandAll {
-			dAtA[i] = 1
-		} else {
-			dAtA[i] = 0
-		}
-		i--
-		dAtA[i] = 0x50
-	}
-	if m.FacetsFilter != nil {
-		{
-			size, err := m.FacetsFilter.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintPb(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0x4a
-	}
-	if m.FacetParam != nil {
-		{
-			size, err := m.FacetParam.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintPb(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0x42
-	}
-	if m.Reverse {
-		i--
-		if m.Reverse {
-			dAtA[i] = 1
-		} else {
-			dAtA[i] = 0
-		}
-		i--
-		dAtA[i] = 0x38
-	}
-	if m.SrcFunc != nil {
-		{
-			size, err := m.SrcFunc.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintPb(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0x32
-	}
-	if m.UidList != nil {
-		{
-			size, err := m.UidList.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintPb(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0x2a
-	}
-	if m.DoCount {
-		i--
-		if m.DoCount {
-			dAtA[i] = 1
-		} else {
-			dAtA[i] = 0
-		}
-		i--
-		dAtA[i] = 0x20
-	}
-	if m.AfterUid != 0 {
-		i -= 8
-		encoding_binary.LittleEndian.PutUint64(dAtA[i:], uint64(m.AfterUid))
-		i--
-		dAtA[i] = 0x19
-	}
-	if len(m.Langs) > 0 {
-		for iNdEx := len(m.Langs) - 1; iNdEx >= 0; iNdEx-- {
-			i -= len(m.Langs[iNdEx])
-			copy(dAtA[i:], m.Langs[iNdEx])
-			i = encodeVarintPb(dAtA, i, uint64(len(m.Langs[iNdEx])))
-			i--
-			dAtA[i] = 0x12
-		}
-	}
-	if len(m.Attr) > 0 {
-		i -= len(m.Attr)
-		copy(dAtA[i:], m.Attr)
-		i = encodeVarintPb(dAtA, i, uint64(len(m.Attr)))
-		i--
-		dAtA[i] = 0xa
+func (c *workerClient) Export(ctx context.Context, in *ExportRequest, opts ...grpc.CallOption) (*ExportResponse, error) {
+	out := new(ExportResponse)
+	err := c.cc.Invoke(ctx, "/pb.Worker/Export", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	return len(dAtA) - i, nil
+	return out, nil
 }
 
-func (m *ValueList) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+func (c *workerClient) ReceivePredicate(ctx context.Context, opts ...grpc.CallOption) (Worker_ReceivePredicateClient, error) {
+	stream, err := c.cc.NewStream(ctx, &_Worker_serviceDesc.Streams[1], "/pb.Worker/ReceivePredicate", opts...)
 	if err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
+	x := &workerReceivePredicateClient{stream}
+	return x, nil
 }
 
-func (m *ValueList) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+type Worker_ReceivePredicateClient interface {
+	Send(*KVS) error
+	CloseAndRecv() (*api.Payload, error)
+	grpc.ClientStream
 }
 
-func (m *ValueList) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.Values) > 0 {
-		for iNdEx := len(m.Values) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.Values[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintPb(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0xa
-		}
-	}
-	return len(dAtA) - i, nil
+type workerReceivePredicateClient struct {
+	grpc.ClientStream
 }
 
-func (m *LangList) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
+func (x *workerReceivePredicateClient) Send(m *KVS) error {
+	return x.ClientStream.SendMsg(m)
+}
+
+func (x *workerReceivePredicateClient) CloseAndRecv() (*api.Payload, error) {
+	if err := x.ClientStream.CloseSend(); err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
+	m := new(api.Payload)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
 }
 
-func (m *LangList) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (c *workerClient) MovePredicate(ctx context.Context, in *MovePredicatePayload, opts ...grpc.CallOption) (*api.Payload, error) {
+	out := new(api.Payload)
+	err := c.cc.Invoke(ctx, "/pb.Worker/MovePredicate", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
 
-func (m *LangList) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.Lang) > 0 {
-		for iNdEx := len(m.Lang) - 1; iNdEx >= 0; iNdEx-- {
-			i -= len(m.Lang[iNdEx])
-			copy(dAtA[i:], m.Lang[iNdEx])
-			i = encodeVarintPb(dAtA, i, uint64(len(m.Lang[iNdEx])))
-			i--
-			dAtA[i] = 0xa
-		}
-	}
-	return len(dAtA) - i, nil
-}
-
-func (m *Result) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+func (c *workerClient) Subscribe(ctx context.Context, in *SubscriptionRequest, opts ...grpc.CallOption) (Worker_SubscribeClient, error) {
+	stream, err := c.cc.NewStream(ctx, &_Worker_serviceDesc.Streams[2], "/pb.Worker/Subscribe", opts...)
 	if err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
+	x := &workerSubscribeClient{stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
 }
 
-func (m *Result) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+type Worker_SubscribeClient interface {
+	Recv() (*pb.KVList, error)
+	grpc.ClientStream
 }
 
-func (m *Result) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.List {
-		i--
-		if m.List {
-			dAtA[i] = 1
-		} else {
-			dAtA[i] = 0
-		}
-		i--
-		dAtA[i] = 0x38
-	}
-	if len(m.LangMatrix) > 0 {
-		for iNdEx := len(m.LangMatrix) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.LangMatrix[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintPb(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0x32
-		}
-	}
-	if len(m.FacetMatrix) > 0 {
-		for iNdEx := len(m.FacetMatrix) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.FacetMatrix[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintPb(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0x2a
-		}
-	}
-	if m.IntersectDest {
-		i--
-		if m.IntersectDest {
-			dAtA[i] = 1
-		} else {
-			dAtA[i] = 0
-		}
-		i--
-		dAtA[i] = 0x20
-	}
-	if len(m.Counts) > 0 {
-		dAtA6 := make([]byte, len(m.Counts)*10)
-		var j5 int
-		for _, num := range m.Counts {
-			for num >= 1<<7 {
-				dAtA6[j5] = uint8(uint64(num)&0x7f | 0x80)
-				num >>= 7
-				j5++
-			}
-			dAtA6[j5] = uint8(num)
-			j5++
-		}
-		i -= j5
-		copy(dAtA[i:], dAtA6[:j5])
-		i = encodeVarintPb(dAtA, i, uint64(j5))
-		i--
-		dAtA[i] = 0x1a
-	}
-	if len(m.ValueMatrix) > 0 {
-		for iNdEx := len(m.ValueMatrix) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.ValueMatrix[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintPb(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0x12
-		}
-	}
-	if len(m.UidMatrix) > 0 {
-		for iNdEx := len(m.UidMatrix) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.UidMatrix[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintPb(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0xa
-		}
-	}
-	return len(dAtA) - i, nil
+type workerSubscribeClient struct {
+	grpc.ClientStream
 }
 
-func (m *Order) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
+func (x *workerSubscribeClient) Recv() (*pb.KVList, error) {
+	m := new(pb.KVList)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
+	return m, nil
 }
 
-func (m *Order) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (c *workerClient) UpdateGraphQLSchema(ctx context.Context, in *UpdateGraphQLSchemaRequest, opts ...grpc.CallOption) (*UpdateGraphQLSchemaResponse, error) {
+	out := new(UpdateGraphQLSchemaResponse)
+	err := c.cc.Invoke(ctx, "/pb.Worker/UpdateGraphQLSchema", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
 
-func (m *Order) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.Langs) > 0 {
-		for iNdEx := len(m.Langs) - 1; iNdEx >= 0; iNdEx-- {
-			i -= len(m.Langs[iNdEx])
-			copy(dAtA[i:], m.Langs[iNdEx])
-			i = encodeVarintPb(dAtA, i, uint64(len(m.Langs[iNdEx])))
-			i--
-			dAtA[i] = 0x1a
-		}
-	}
-	if m.Desc {
-		i--
-		if m.Desc {
-			dAtA[i] = 1
-		} else {
-			dAtA[i] = 0
-		}
-		i--
-		dAtA[i] = 0x10
-	}
-	if len(m.Attr) > 0 {
-		i -= len(m.Attr)
-		copy(dAtA[i:], m.Attr)
-		i = encodeVarintPb(dAtA, i, uint64(len(m.Attr)))
-		i--
-		dAtA[i] = 0xa
+func (c *workerClient) DeleteNamespace(ctx context.Context, in *DeleteNsRequest, opts ...grpc.CallOption) (*Status, error) {
+	out := new(Status)
+	err := c.cc.Invoke(ctx, "/pb.Worker/DeleteNamespace", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	return len(dAtA) - i, nil
+	return out, nil
 }
 
-func (m *SortMessage) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+func (c *workerClient) TaskStatus(ctx context.Context, in *TaskStatusRequest, opts ...grpc.CallOption) (*TaskStatusResponse, error) {
+	out := new(TaskStatusResponse)
+	err := c.cc.Invoke(ctx, "/pb.Worker/TaskStatus", in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
+	return out, nil
 }
 
-func (m *SortMessage) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+// WorkerServer is the server API for Worker service.
+type WorkerServer interface {
+	// Data serving RPCs.
+	Mutate(context.Context, *Mutations) (*api.TxnContext, error)
+	ServeTask(context.Context, *Query) (*Result, error)
+	StreamSnapshot(Worker_StreamSnapshotServer) error
+	Sort(context.Context, *SortMessage) (*SortResult, error)
+	Schema(context.Context, *SchemaRequest) (*SchemaResult, error)
+	Backup(context.Context, *BackupRequest) (*BackupResponse, error)
+	Restore(context.Context, *RestoreRequest) (*Status, error)
+	Export(context.Context, *ExportRequest) (*ExportResponse, error)
+	ReceivePredicate(Worker_ReceivePredicateServer) error
+	MovePredicate(context.Context, *MovePredicatePayload) (*api.Payload, error)
+	Subscribe(*SubscriptionRequest, Worker_SubscribeServer) error
+	UpdateGraphQLSchema(context.Context, *UpdateGraphQLSchemaRequest) (*UpdateGraphQLSchemaResponse, error)
+	DeleteNamespace(context.Context, *DeleteNsRequest) (*Status, error)
+	TaskStatus(context.Context, *TaskStatusRequest) (*TaskStatusResponse, error)
 }
 
-func (m *SortMessage) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.ReadTs != 0 {
-		i = encodeVarintPb(dAtA, i, uint64(m.ReadTs))
-		i--
-		dAtA[i] = 0x68
-	}
-	if m.Offset != 0 {
-		i = encodeVarintPb(dAtA, i, uint64(m.Offset))
-		i--
-		dAtA[i] = 0x20
-	}
-	if m.Count != 0 {
-		i = encodeVarintPb(dAtA, i, uint64(m.Count))
-		i--
-		dAtA[i] = 0x18
-	}
-	if len(m.UidMatrix) > 0 {
-		for iNdEx := len(m.UidMatrix) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.UidMatrix[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintPb(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0x12
-		}
+// UnimplementedWorkerServer can be embedded to have forward compatible implementations.
+type UnimplementedWorkerServer struct {
+}
+
+func (*UnimplementedWorkerServer) Mutate(ctx context.Context, req *Mutations) (*api.TxnContext, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Mutate not implemented")
+}
+func (*UnimplementedWorkerServer) ServeTask(ctx context.Context, req *Query) (*Result, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ServeTask not implemented")
+}
+func (*UnimplementedWorkerServer) StreamSnapshot(srv Worker_StreamSnapshotServer) error {
+	return status.Errorf(codes.Unimplemented, "method StreamSnapshot not implemented")
+}
+func (*UnimplementedWorkerServer) Sort(ctx context.Context, req *SortMessage) (*SortResult, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Sort not implemented")
+}
+func (*UnimplementedWorkerServer) Schema(ctx context.Context, req *SchemaRequest) (*SchemaResult, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Schema not implemented")
+}
+func (*UnimplementedWorkerServer) Backup(ctx context.Context, req *BackupRequest) (*BackupResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Backup not implemented")
+}
+func (*UnimplementedWorkerServer) Restore(ctx context.Context, req *RestoreRequest) (*Status, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Restore not implemented")
+}
+func (*UnimplementedWorkerServer) Export(ctx context.Context, req *ExportRequest) (*ExportResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Export not implemented")
+}
+func (*UnimplementedWorkerServer) ReceivePredicate(srv Worker_ReceivePredicateServer) error {
+	return status.Errorf(codes.Unimplemented, "method ReceivePredicate not implemented")
+}
+func (*UnimplementedWorkerServer) MovePredicate(ctx context.Context, req *MovePredicatePayload) (*api.Payload, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method MovePredicate not implemented")
+}
+func (*UnimplementedWorkerServer) Subscribe(req *SubscriptionRequest, srv Worker_SubscribeServer) error {
+	return status.Errorf(codes.Unimplemented, "method Subscribe not implemented")
+}
+func (*UnimplementedWorkerServer) UpdateGraphQLSchema(ctx context.Context, req *UpdateGraphQLSchemaRequest) (*UpdateGraphQLSchemaResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateGraphQLSchema not implemented")
+}
+func (*UnimplementedWorkerServer) DeleteNamespace(ctx context.Context, req *DeleteNsRequest) (*Status, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeleteNamespace not implemented")
+}
+func (*UnimplementedWorkerServer) TaskStatus(ctx context.Context, req *TaskStatusRequest) (*TaskStatusResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method TaskStatus not implemented")
+}
+
+func RegisterWorkerServer(s *grpc.Server, srv WorkerServer) {
+	s.RegisterService(&_Worker_serviceDesc, srv)
+}
+
+func _Worker_Mutate_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(Mutations)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	if len(m.Order) > 0 {
-		for iNdEx := len(m.Order) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.Order[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintPb(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0xa
-		}
+	if interceptor == nil {
+		return srv.(WorkerServer).Mutate(ctx, in)
 	}
-	return len(dAtA) - i, nil
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/pb.Worker/Mutate",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(WorkerServer).Mutate(ctx, req.(*Mutations))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *SortResult) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
+func _Worker_ServeTask_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(Query)
+	if err := dec(in); err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
+	if interceptor == nil {
+		return srv.(WorkerServer).ServeTask(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/pb.Worker/ServeTask",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(WorkerServer).ServeTask(ctx, req.(*Query))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *SortResult) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func _Worker_StreamSnapshot_Handler(srv interface{}, stream grpc.ServerStream) error {
+	return srv.(WorkerServer).StreamSnapshot(&workerStreamSnapshotServer{stream})
 }
 
-func (m *SortResult) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.UidMatrix) > 0 {
-		for iNdEx := len(m.UidMatrix) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.UidMatrix[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintPb(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0xa
-		}
-	}
-	return len(dAtA) - i, nil
+type Worker_StreamSnapshotServer interface {
+	Send(*KVS) error
+	Recv() (*Snapshot, error)
+	grpc.ServerStream
 }
 
-func (m *RaftContext) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
-	}
-	return dAtA[:n], nil
+type workerStreamSnapshotServer struct {
+	grpc.ServerStream
 }
 
-func (m *RaftContext) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (x *workerStreamSnapshotServer) Send(m *KVS) error {
+	return x.ServerStream.SendMsg(m)
 }
 
-func (m *RaftContext) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.IsLearner {
-		i--
-		if m.IsLearner {
-			dAtA[i] = 1
-		} else {
-			dAtA[i] = 0
-		}
-		i--
-		dAtA[i] = 0x28
+func (x *workerStreamSnapshotServer) Recv() (*Snapshot, error) {
+	m := new(Snapshot)
+	if err := x.ServerStream.RecvMsg(m); err != nil {
+		return nil, err
 	}
-	if m.SnapshotTs != 0 {
-		i = encodeVarintPb(dAtA, i, uint64(m.SnapshotTs))
-		i--
-		dAtA[i] = 0x20
+	return m, nil
+}
+
+func _Worker_Sort_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SortMessage)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	if len(m.Addr) > 0 {
-		i -= len(m.Addr)
-		copy(dAtA[i:], m.Addr)
-		i = encodeVarintPb(dAtA, i, uint64(len(m.Addr)))
-		i--
-		dAtA[i] = 0x1a
+	if interceptor == nil {
+		return srv.(WorkerServer).Sort(ctx, in)
 	}
-	if m.Group != 0 {
-		i = encodeVarintPb(dAtA, i, uint64(m.Group))
-		i--
-		dAtA[i] = 0x10
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/pb.Worker/Sort",
 	}
-	if m.Id != 0 {
-		i -= 8
-		encoding_binary.LittleEndian.PutUint64(dAtA[i:], uint64(m.Id))
-		i--
-		dAtA[i] = 0x9
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(WorkerServer).Sort(ctx, req.(*SortMessage))
 	}
-	return len(dAtA) - i, nil
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *Member) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
+func _Worker_Schema_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SchemaRequest)
+	if err := dec(in); err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
+	if interceptor == nil {
+		return srv.(WorkerServer).Schema(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/pb.Worker/Schema",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(WorkerServer).Schema(ctx, req.(*SchemaRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *Member) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func _Worker_Backup_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(BackupRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(WorkerServer).Backup(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/pb.Worker/Backup",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(WorkerServer).Backup(ctx, req.(*BackupRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *Member) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.ForceGroupId {
-		i--
-		if m.ForceGroupId {
-			dAtA[i] = 1
-		} else {
-			dAtA[i] = 0
-		}
-		i--
-		dAtA[i] = 0x70
+func _Worker_Restore_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RestoreRequest)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	if m.ClusterInfoOnly {
-		i--
-		if m.ClusterInfoOnly {
-			dAtA[i] = 1
-		} else {
-			dAtA[i] = 0
-		}
-		i--
-		dAtA[i] = 0x68
-	}
-	if m.Learner {
-		i--
-		if m.Learner {
-			dAtA[i] = 1
-		} else {
-			dAtA[i] = 0
-		}
-		i--
-		dAtA[i] = 0x38
+	if interceptor == nil {
+		return srv.(WorkerServer).Restore(ctx, in)
 	}
-	if m.LastUpdate != 0 {
-		i = encodeVarintPb(dAtA, i, uint64(m.LastUpdate))
-		i--
-		dAtA[i] = 0x30
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/pb.Worker/Restore",
 	}
-	if m.AmDead {
-		i--
-		if m.AmDead {
-			dAtA[i] = 1
-		} else {
-			dAtA[i] = 0
-		}
-		i--
-		dAtA[i] = 0x28
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(WorkerServer).Restore(ctx, req.(*RestoreRequest))
 	}
-	if m.Leader {
-		i--
-		if m.Leader {
-			dAtA[i] = 1
-		} else {
-			dAtA[i] = 0
-		}
-		i--
-		dAtA[i] = 0x20
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Worker_Export_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ExportRequest)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	if len(m.Addr) > 0 {
-		i -= len(m.Addr)
-		copy(dAtA[i:], m.Addr)
-		i = encodeVarintPb(dAtA, i, uint64(len(m.Addr)))
-		i--
-		dAtA[i] = 0x1a
+	if interceptor == nil {
+		return srv.(WorkerServer).Export(ctx, in)
 	}
-	if m.GroupId != 0 {
-		i = encodeVarintPb(dAtA, i, uint64(m.GroupId))
-		i--
-		dAtA[i] = 0x10
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/pb.Worker/Export",
 	}
-	if m.Id != 0 {
-		i -= 8
-		encoding_binary.LittleEndian.PutUint64(dAtA[i:], uint64(m.Id))
-		i--
-		dAtA[i] = 0x9
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(WorkerServer).Export(ctx, req.(*ExportRequest))
 	}
-	return len(dAtA) - i, nil
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *Group) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
-	}
-	return dAtA[:n], nil
+func _Worker_ReceivePredicate_Handler(srv interface{}, stream grpc.ServerStream) error {
+	return srv.(WorkerServer).ReceivePredicate(&workerReceivePredicateServer{stream})
 }
 
-func (m *Group) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+type Worker_ReceivePredicateServer interface {
+	SendAndClose(*api.Payload) error
+	Recv() (*KVS, error)
+	grpc.ServerStream
 }
 
-func (m *Group) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.CheckpointTs != 0 {
-		i = encodeVarintPb(dAtA, i, uint64(m.CheckpointTs))
-		i--
-		dAtA[i] = 0x28
+type workerReceivePredicateServer struct {
+	grpc.ServerStream
+}
+
+func (x *workerReceivePredicateServer) SendAndClose(m *api.Payload) error {
+	return x.ServerStream.SendMsg(m)
+}
+
+func (x *workerReceivePredicateServer) Recv() (*KVS, error) {
+	m := new(KVS)
+	if err := x.ServerStream.RecvMsg(m); err != nil {
+		return nil, err
 	}
-	if m.Checksum != 0 {
-		i = encodeVarintPb(dAtA, i, uint64(m.Checksum))
-		i--
-		dAtA[i] = 0x20
+	return m, nil
+}
+
+func _Worker_MovePredicate_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MovePredicatePayload)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	if m.SnapshotTs != 0 {
-		i = encodeVarintPb(dAtA, i, uint64(m.SnapshotTs))
-		i--
-		dAtA[i] = 0x18
+	if interceptor == nil {
+		return srv.(WorkerServer).MovePredicate(ctx, in)
 	}
-	if len(m.Tablets) > 0 {
-		for k := range m.Tablets {
-			v := m.Tablets[k]
-			baseI := i
-			if v != nil {
-				{
-					size, err := v.MarshalToSizedBuffer(dAtA[:i])
-					if err != nil {
-						return 0, err
-					}
-					i -= size
-					i = encodeVarintPb(dAtA, i, uint64(size))
-				}
-				i--
-				dAtA[i] = 0x12
-			}
-			i -= len(k)
-			copy(dAtA[i:], k)
-			i = encodeVarintPb(dAtA, i, uint64(len(k)))
-			i--
-			dAtA[i] = 0xa
-			i = encodeVarintPb(dAtA, i, uint64(baseI-i))
-			i--
-			dAtA[i] = 0x12
-		}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/pb.Worker/MovePredicate",
 	}
-	if len(m.Members) > 0 {
-		for k := range m.Members {
-			v := m.Members[k]
-			baseI := i
-			if v != nil {
-				{
-					size, err := v.MarshalToSizedBuffer(dAtA[:i])
-					if err != nil {
-						return 0, err
-					}
-					i -= size
-					i = encodeVarintPb(dAtA, i, uint64(size))
-				}
-				i--
-				dAtA[i] = 0x12
-			}
-			i = encodeVarintPb(dAtA, i, uint64(k))
-			i--
-			dAtA[i] = 0x8
-			i = encodeVarintPb(dAtA, i, uint64(baseI-i))
-			i--
-			dAtA[i] = 0xa
-		}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(WorkerServer).MovePredicate(ctx, req.(*MovePredicatePayload))
 	}
-	return len(dAtA) - i, nil
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *License) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func _Worker_Subscribe_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(SubscriptionRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
 	}
-	return dAtA[:n], nil
+	return srv.(WorkerServer).Subscribe(m, &workerSubscribeServer{stream})
 }
 
-func (m *License) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+type Worker_SubscribeServer interface {
+	Send(*pb.KVList) error
+	grpc.ServerStream
 }
 
-func (m *License) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.Enabled {
-		i--
-		if m.Enabled {
-			dAtA[i] = 1
-		} else {
-			dAtA[i] = 0
-		}
-		i--
-		dAtA[i] = 0x20
+type workerSubscribeServer struct {
+	grpc.ServerStream
+}
+
+func (x *workerSubscribeServer) Send(m *pb.KVList) error {
+	return x.ServerStream.SendMsg(m)
+}
+
+func _Worker_UpdateGraphQLSchema_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpdateGraphQLSchemaRequest)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	if m.ExpiryTs != 0 {
-		i = encodeVarintPb(dAtA, i, uint64(m.ExpiryTs))
-		i--
-		dAtA[i] = 0x18
+	if interceptor == nil {
+		return srv.(WorkerServer).UpdateGraphQLSchema(ctx, in)
 	}
-	if m.MaxNodes != 0 {
-		i = encodeVarintPb(dAtA, i, uint64(m.MaxNodes))
-		i--
-		dAtA[i] = 0x10
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/pb.Worker/UpdateGraphQLSchema",
 	}
-	if len(m.User) > 0 {
-		i -= len(m.User)
-		copy(dAtA[i:], m.User)
-		i = encodeVarintPb(dAtA, i, uint64(len(m.User)))
-		i--
-		dAtA[i] = 0xa
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(WorkerServer).UpdateGraphQLSchema(ctx, req.(*UpdateGraphQLSchemaRequest))
 	}
-	return len(dAtA) - i, nil
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *ZeroProposal) Marshal() (dAtA []byte, err error) {
+func _Worker_DeleteNamespace_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeleteNsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(WorkerServer).DeleteNamespace(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/pb.Worker/DeleteNamespace",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(WorkerServer).DeleteNamespace(ctx, req.(*DeleteNsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Worker_TaskStatus_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(TaskStatusRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(WorkerServer).TaskStatus(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/pb.Worker/TaskStatus",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(WorkerServer).TaskStatus(ctx, req.(*TaskStatusRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+var _Worker_serviceDesc = grpc.ServiceDesc{
+	ServiceName: "pb.Worker",
+	HandlerType: (*WorkerServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "Mutate",
+			Handler:    _Worker_Mutate_Handler,
+		},
+		{
+			MethodName: "ServeTask",
+			Handler:    _Worker_ServeTask_Handler,
+		},
+		{
+			MethodName: "Sort",
+			Handler:    _Worker_Sort_Handler,
+		},
+		{
+			MethodName: "Schema",
+			Handler:    _Worker_Schema_Handler,
+		},
+		{
+			MethodName: "Backup",
+			Handler:    _Worker_Backup_Handler,
+		},
+		{
+			MethodName: "Restore",
+			Handler:    _Worker_Restore_Handler,
+		},
+		{
+			MethodName: "Export",
+			Handler:    _Worker_Export_Handler,
+		},
+		{
+			MethodName: "MovePredicate",
+			Handler:    _Worker_MovePredicate_Handler,
+		},
+		{
+			MethodName: "UpdateGraphQLSchema",
+			Handler:    _Worker_UpdateGraphQLSchema_Handler,
+		},
+		{
+			MethodName: "DeleteNamespace",
+			Handler:    _Worker_DeleteNamespace_Handler,
+		},
+		{
+			MethodName: "TaskStatus",
+			Handler:    _Worker_TaskStatus_Handler,
+		},
+	},
+	Streams: []grpc.StreamDesc{
+		{
+			StreamName:    "StreamSnapshot",
+			Handler:       _Worker_StreamSnapshot_Handler,
+			ServerStreams: true,
+			ClientStreams: true,
+		},
+		{
+			StreamName:    "ReceivePredicate",
+			Handler:       _Worker_ReceivePredicate_Handler,
+			ClientStreams: true,
+		},
+		{
+			StreamName:    "Subscribe",
+			Handler:       _Worker_Subscribe_Handler,
+			ServerStreams: true,
+		},
+	},
+	Metadata: "pb.proto",
+}
+
+func (m *List) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -8399,148 +8346,29 @@ func (m *ZeroProposal) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *ZeroProposal) MarshalTo(dAtA []byte) (int, error) {
+func (m *List) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *ZeroProposal) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *List) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if len(m.Tablets) > 0 {
-		for iNdEx := len(m.Tablets) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.Tablets[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintPb(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0x72
-		}
-	}
-	if m.DeleteNs != nil {
-		{
-			size, err := m.DeleteNs.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintPb(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0x6a
-	}
-	if m.MaxNsID != 0 {
-		i = encodeVarintPb(dAtA, i, uint64(m.MaxNsID))
-		i--
-		dAtA[i] = 0x60
-	}
-	if m.Snapshot != nil {
-		{
-			size, err := m.Snapshot.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintPb(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0x5a
-	}
-	if m.License != nil {
-		{
-			size, err := m.License.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintPb(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0x52
-	}
-	if len(m.Cid) > 0 {
-		i -= len(m.Cid)
-		copy(dAtA[i:], m.Cid)
-		i = encodeVarintPb(dAtA, i, uint64(len(m.Cid)))
-		i--
-		dAtA[i] = 0x4a
-	}
-	if m.Txn != nil {
-		{
-			size, err := m.Txn.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintPb(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0x3a
-	}
-	if m.MaxRaftId != 0 {
-		i = encodeVarintPb(dAtA, i, uint64(m.MaxRaftId))
-		i--
-		dAtA[i] = 0x30
-	}
-	if m.MaxTxnTs != 0 {
-		i = encodeVarintPb(dAtA, i, uint64(m.MaxTxnTs))
-		i--
-		dAtA[i] = 0x28
-	}
-	if m.MaxUID != 0 {
-		i = encodeVarintPb(dAtA, i, uint64(m.MaxUID))
-		i--
-		dAtA[i] = 0x20
-	}
-	if m.Tablet != nil {
-		{
-			size, err := m.Tablet.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintPb(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0x1a
-	}
-	if m.Member != nil {
-		{
-			size, err := m.Member.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintPb(dAtA, i, uint64(size))
+	if len(m.Uids) > 0 {
+		for iNdEx := len(m.Uids) - 1; iNdEx >= 0; iNdEx-- {
+			i -= 8
+			encoding_binary.LittleEndian.PutUint64(dAtA[i:], uint64(m.Uids[iNdEx]))
 		}
+		i = encodeVarintPb(dAtA, i, uint64(len(m.Uids)*8))
 		i--
-		dAtA[i] = 0x12
-	}
-	if len(m.SnapshotTs) > 0 {
-		for k := range m.SnapshotTs {
-			v := m.SnapshotTs[k]
-			baseI := i
-			i = encodeVarintPb(dAtA, i, uint64(v))
-			i--
-			dAtA[i] = 0x10
-			i = encodeVarintPb(dAtA, i, uint64(k))
-			i--
-			dAtA[i] = 0x8
-			i = encodeVarintPb(dAtA, i, uint64(baseI-i))
-			i--
-			dAtA[i] = 0xa
-		}
+		dAtA[i] = 0xa
 	}
 	return len(dAtA) - i, nil
 }
 
-func (m *MembershipState) Marshal() (dAtA []byte, err error) {
+func (m *TaskValue) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -8550,126 +8378,88 @@ func (m *MembershipState) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *MembershipState) MarshalTo(dAtA []byte) (int, error) {
+func (m *TaskValue) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *MembershipState) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *TaskValue) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if m.MaxNsID != 0 {
-		i = encodeVarintPb(dAtA, i, uint64(m.MaxNsID))
+	if m.ValType != 0 {
+		i = encodeVarintPb(dAtA, i, uint64(m.ValType))
 		i--
-		dAtA[i] = 0x50
+		dAtA[i] = 0x10
 	}
-	if m.License != nil {
-		{
-			size, err := m.License.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintPb(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0x4a
-	}
-	if len(m.Cid) > 0 {
-		i -= len(m.Cid)
-		copy(dAtA[i:], m.Cid)
-		i = encodeVarintPb(dAtA, i, uint64(len(m.Cid)))
+	if len(m.Val) > 0 {
+		i -= len(m.Val)
+		copy(dAtA[i:], m.Val)
+		i = encodeVarintPb(dAtA, i, uint64(len(m.Val)))
 		i--
-		dAtA[i] = 0x42
+		dAtA[i] = 0xa
 	}
-	if len(m.Removed) > 0 {
-		for iNdEx := len(m.Removed) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.Removed[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintPb(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0x3a
-		}
+	return len(dAtA) - i, nil
+}
+
+func (m *SrcFunction) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	if m.MaxRaftId != 0 {
-		i = encodeVarintPb(dAtA, i, uint64(m.MaxRaftId))
+	return dAtA[:n], nil
+}
+
+func (m *SrcFunction) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *SrcFunction) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.JsonPath) > 0 {
+		i -= len(m.JsonPath)
+		copy(dAtA[i:], m.JsonPath)
+		i = encodeVarintPb(dAtA, i, uint64(len(m.JsonPath)))
 		i--
-		dAtA[i] = 0x30
+		dAtA[i] = 0x2a
 	}
-	if m.MaxTxnTs != 0 {
-		i = encodeVarintPb(dAtA, i, uint64(m.MaxTxnTs))
+	if m.IsCount {
 		i--
-		dAtA[i] = 0x28
-	}
-	if m.MaxUID != 0 {
-		i = encodeVarintPb(dAtA, i, uint64(m.MaxUID))
+		if m.IsCount {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
 		i--
 		dAtA[i] = 0x20
 	}
-	if len(m.Zeros) > 0 {
-		for k := range m.Zeros {
-			v := m.Zeros[k]
-			baseI := i
-			if v != nil {
-				{
-					size, err := v.MarshalToSizedBuffer(dAtA[:i])
-					if err != nil {
-						return 0, err
-					}
-					i -= size
-					i = encodeVarintPb(dAtA, i, uint64(size))
-				}
-				i--
-				dAtA[i] = 0x12
-			}
-			i = encodeVarintPb(dAtA, i, uint64(k))
-			i--
-			dAtA[i] = 0x8
-			i = encodeVarintPb(dAtA, i, uint64(baseI-i))
+	if len(m.Args) > 0 {
+		for iNdEx := len(m.Args) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.Args[iNdEx])
+			copy(dAtA[i:], m.Args[iNdEx])
+			i = encodeVarintPb(dAtA, i, uint64(len(m.Args[iNdEx])))
 			i--
 			dAtA[i] = 0x1a
 		}
 	}
-	if len(m.Groups) > 0 {
-		for k := range m.Groups {
-			v := m.Groups[k]
-			baseI := i
-			if v != nil {
-				{
-					size, err := v.MarshalToSizedBuffer(dAtA[:i])
-					if err != nil {
-						return 0, err
-					}
-					i -= size
-					i = encodeVarintPb(dAtA, i, uint64(size))
-				}
-				i--
-				dAtA[i] = 0x12
-			}
-			i = encodeVarintPb(dAtA, i, uint64(k))
-			i--
-			dAtA[i] = 0x8
-			i = encodeVarintPb(dAtA, i, uint64(baseI-i))
-			i--
-			dAtA[i] = 0x12
-		}
-	}
-	if m.Counter != 0 {
-		i = encodeVarintPb(dAtA, i, uint64(m.Counter))
+	if len(m.Name) > 0 {
+		i -= len(m.Name)
+		copy(dAtA[i:], m.Name)
+		i = encodeVarintPb(dAtA, i, uint64(len(m.Name)))
 		i--
-		dAtA[i] = 0x8
+		dAtA[i] = 0xa
 	}
 	return len(dAtA) - i, nil
 }
 
-func (m *ConnectionState) Marshal() (dAtA []byte, err error) {
+func (m *Query) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -8679,24 +8469,63 @@ func (m *ConnectionState) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *ConnectionState) MarshalTo(dAtA []byte) (int, error) {
+func (m *Query) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *ConnectionState) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *Query) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if m.MaxPending != 0 {
-		i = encodeVarintPb(dAtA, i, uint64(m.MaxPending))
+	if m.TrackReads {
 		i--
-		dAtA[i] = 0x18
+		if m.TrackReads {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0x88
 	}
-	if m.State != nil {
+	if m.Offset != 0 {
+		i = encodeVarintPb(dAtA, i, uint64(m.Offset))
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0x80
+	}
+	if m.First != 0 {
+		i = encodeVarintPb(dAtA, i, uint64(m.First))
+		i--
+		dAtA[i] = 0x78
+	}
+	if m.Cache != 0 {
+		i = encodeVarintPb(dAtA, i, uint64(m.Cache))
+		i--
+		dAtA[i] = 0x70
+	}
+	if m.ReadTs != 0 {
+		i = encodeVarintPb(dAtA, i, uint64(m.ReadTs))
+		i--
+		dAtA[i] = 0x68
+	}
+	if m.ExpandAll {
+		i--
+		if m.ExpandAll {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x50
+	}
+	if m.FacetsFilter != nil {
 		{
-			size, err := m.State.MarshalToSizedBuffer(dAtA[:i])
+			size, err := m.FacetsFilter.MarshalToSizedBuffer(dAtA[:i])
 			if err != nil {
 				return 0, err
 			}
@@ -8704,11 +8533,45 @@ func (m *ConnectionState) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 			i = encodeVarintPb(dAtA, i, uint64(size))
 		}
 		i--
-		dAtA[i] = 0x12
+		dAtA[i] = 0x4a
 	}
-	if m.Member != nil {
+	if m.FacetParam != nil {
 		{
-			size, err := m.Member.MarshalToSizedBuffer(dAtA[:i])
+			size, err := m.FacetParam.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintPb(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x42
+	}
+	if m.Reverse {
+		i--
+		if m.Reverse {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x38
+	}
+	if m.SrcFunc != nil {
+		{
+			size, err := m.SrcFunc.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintPb(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x32
+	}
+	if m.UidList != nil {
+		{
+			size, err := m.UidList.MarshalToSizedBuffer(dAtA[:i])
 			if err != nil {
 				return 0, err
 			}
@@ -8716,12 +8579,44 @@ func (m *ConnectionState) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 			i = encodeVarintPb(dAtA, i, uint64(size))
 		}
 		i--
+		dAtA[i] = 0x2a
+	}
+	if m.DoCount {
+		i--
+		if m.DoCount {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x20
+	}
+	if m.AfterUid != 0 {
+		i -= 8
+		encoding_binary.LittleEndian.PutUint64(dAtA[i:], uint64(m.AfterUid))
+		i--
+		dAtA[i] = 0x19
+	}
+	if len(m.Langs) > 0 {
+		for iNdEx := len(m.Langs) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.Langs[iNdEx])
+			copy(dAtA[i:], m.Langs[iNdEx])
+			i = encodeVarintPb(dAtA, i, uint64(len(m.Langs[iNdEx])))
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if len(m.Attr) > 0 {
+		i -= len(m.Attr)
+		copy(dAtA[i:], m.Attr)
+		i = encodeVarintPb(dAtA, i, uint64(len(m.Attr)))
+		i--
 		dAtA[i] = 0xa
 	}
 	return len(dAtA) - i, nil
 }
 
-func (m *HealthInfo) Marshal() (dAtA []byte, err error) {
+func (m *ValueList) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -8731,97 +8626,66 @@ func (m *HealthInfo) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *HealthInfo) MarshalTo(dAtA []byte) (int, error) {
+func (m *ValueList) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *HealthInfo) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *ValueList) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if m.MaxAssigned != 0 {
-		i = encodeVarintPb(dAtA, i, uint64(m.MaxAssigned))
-		i--
-		dAtA[i] = 0x58
-	}
-	if len(m.EeFeatures) > 0 {
-		for iNdEx := len(m.EeFeatures) - 1; iNdEx >= 0; iNdEx-- {
-			i -= len(m.EeFeatures[iNdEx])
-			copy(dAtA[i:], m.EeFeatures[iNdEx])
-			i = encodeVarintPb(dAtA, i, uint64(len(m.EeFeatures[iNdEx])))
+	if len(m.Values) > 0 {
+		for iNdEx := len(m.Values) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Values[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintPb(dAtA, i, uint64(size))
+			}
 			i--
-			dAtA[i] = 0x52
+			dAtA[i] = 0xa
 		}
 	}
-	if len(m.Indexing) > 0 {
-		for iNdEx := len(m.Indexing) - 1; iNdEx >= 0; iNdEx-- {
-			i -= len(m.Indexing[iNdEx])
-			copy(dAtA[i:], m.Indexing[iNdEx])
-			i = encodeVarintPb(dAtA, i, uint64(len(m.Indexing[iNdEx])))
-			i--
-			dAtA[i] = 0x4a
-		}
+	return len(dAtA) - i, nil
+}
+
+func (m *LangList) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	if len(m.Ongoing) > 0 {
-		for iNdEx := len(m.Ongoing) - 1; iNdEx >= 0; iNdEx-- {
-			i -= len(m.Ongoing[iNdEx])
-			copy(dAtA[i:], m.Ongoing[iNdEx])
-			i = encodeVarintPb(dAtA, i, uint64(len(m.Ongoing[iNdEx])))
+	return dAtA[:n], nil
+}
+
+func (m *LangList) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *LangList) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Lang) > 0 {
+		for iNdEx := len(m.Lang) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.Lang[iNdEx])
+			copy(dAtA[i:], m.Lang[iNdEx])
+			i = encodeVarintPb(dAtA, i, uint64(len(m.Lang[iNdEx])))
 			i--
-			dAtA[i] = 0x42
+			dAtA[i] = 0xa
 		}
 	}
-	if m.LastEcho != 0 {
-		i = encodeVarintPb(dAtA, i, uint64(m.LastEcho))
-		i--
-		dAtA[i] = 0x38
-	}
-	if m.Uptime != 0 {
-		i = encodeVarintPb(dAtA, i, uint64(m.Uptime))
-		i--
-		dAtA[i] = 0x30
-	}
-	if len(m.Version) > 0 {
-		i -= len(m.Version)
-		copy(dAtA[i:], m.Version)
-		i = encodeVarintPb(dAtA, i, uint64(len(m.Version)))
-		i--
-		dAtA[i] = 0x2a
-	}
-	if len(m.Group) > 0 {
-		i -= len(m.Group)
-		copy(dAtA[i:], m.Group)
-		i = encodeVarintPb(dAtA, i, uint64(len(m.Group)))
-		i--
-		dAtA[i] = 0x22
-	}
-	if len(m.Status) > 0 {
-		i -= len(m.Status)
-		copy(dAtA[i:], m.Status)
-		i = encodeVarintPb(dAtA, i, uint64(len(m.Status)))
-		i--
-		dAtA[i] = 0x1a
-	}
-	if len(m.Address) > 0 {
-		i -= len(m.Address)
-		copy(dAtA[i:], m.Address)
-		i = encodeVarintPb(dAtA, i, uint64(len(m.Address)))
-		i--
-		dAtA[i] = 0x12
-	}
-	if len(m.Instance) > 0 {
-		i -= len(m.Instance)
-		copy(dAtA[i:], m.Instance)
-		i = encodeVarintPb(dAtA, i, uint64(len(m.Instance)))
-		i--
-		dAtA[i] = 0xa
-	}
 	return len(dAtA) - i, nil
 }
 
-func (m *Tablet) Marshal() (dAtA []byte, err error) {
+func (m *Result) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -8831,77 +8695,123 @@ func (m *Tablet) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *Tablet) MarshalTo(dAtA []byte) (int, error) {
+func (m *Result) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *Tablet) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *Result) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if m.UncompressedBytes != 0 {
-		i = encodeVarintPb(dAtA, i, uint64(m.UncompressedBytes))
-		i--
-		dAtA[i] = 0x58
-	}
-	if m.MoveTs != 0 {
-		i = encodeVarintPb(dAtA, i, uint64(m.MoveTs))
-		i--
-		dAtA[i] = 0x50
+	if len(m.ReadKeys) > 0 {
+		for iNdEx := len(m.ReadKeys) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.ReadKeys[iNdEx])
+			copy(dAtA[i:], m.ReadKeys[iNdEx])
+			i = encodeVarintPb(dAtA, i, uint64(len(m.ReadKeys[iNdEx])))
+			i--
+			dAtA[i] = 0x42
+		}
 	}
-	if m.ReadOnly {
+	if m.List {
 		i--
-		if m.ReadOnly {
+		if m.List {
 			dAtA[i] = 1
 		} else {
 			dAtA[i] = 0
 		}
 		i--
-		dAtA[i] = 0x48
+		dAtA[i] = 0x38
 	}
-	if m.Remove {
-		i--
-		if m.Remove {
-			dAtA[i] = 1
-		} else {
-			dAtA[i] = 0
+	if len(m.LangMatrix) > 0 {
+		for iNdEx := len(m.LangMatrix) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.LangMatrix[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintPb(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x32
 		}
-		i--
-		dAtA[i] = 0x40
 	}
-	if m.OnDiskBytes != 0 {
-		i = encodeVarintPb(dAtA, i, uint64(m.OnDiskBytes))
-		i--
-		dAtA[i] = 0x38
+	if len(m.FacetMatrix) > 0 {
+		for iNdEx := len(m.FacetMatrix) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.FacetMatrix[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintPb(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x2a
+		}
 	}
-	if m.Force {
+	if m.IntersectDest {
 		i--
-		if m.Force {
+		if m.IntersectDest {
 			dAtA[i] = 1
 		} else {
 			dAtA[i] = 0
 		}
 		i--
-		dAtA[i] = 0x18
+		dAtA[i] = 0x20
 	}
-	if len(m.Predicate) > 0 {
-		i -= len(m.Predicate)
-		copy(dAtA[i:], m.Predicate)
-		i = encodeVarintPb(dAtA, i, uint64(len(m.Predicate)))
+	if len(m.Counts) > 0 {
+		dAtA6 := make([]byte, len(m.Counts)*10)
+		var j5 int
+		for _, num := range m.Counts {
+			for num >= 1<<7 {
+				dAtA6[j5] = uint8(uint64(num)&0x7f | 0x80)
+				num >>= 7
+				j5++
+			}
+			dAtA6[j5] = uint8(num)
+			j5++
+		}
+		i -= j5
+		copy(dAtA[i:], dAtA6[:j5])
+		i = encodeVarintPb(dAtA, i, uint64(j5))
 		i--
-		dAtA[i] = 0x12
+		dAtA[i] = 0x1a
 	}
-	if m.GroupId != 0 {
-		i = encodeVarintPb(dAtA, i, uint64(m.GroupId))
-		i--
-		dAtA[i] = 0x8
+	if len(m.ValueMatrix) > 0 {
+		for iNdEx := len(m.ValueMatrix) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.ValueMatrix[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintPb(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if len(m.UidMatrix) > 0 {
+		for iNdEx := len(m.UidMatrix) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.UidMatrix[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintPb(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
 	}
 	return len(dAtA) - i, nil
 }
 
-func (m *DirectedEdge) Marshal() (dAtA []byte, err error) {
+func (m *Order) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -8911,91 +8821,46 @@ func (m *DirectedEdge) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *DirectedEdge) MarshalTo(dAtA []byte) (int, error) {
+func (m *Order) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *DirectedEdge) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *Order) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if m.Namespace != 0 {
-		i = encodeVarintPb(dAtA, i, uint64(m.Namespace))
-		i--
-		dAtA[i] = 0x58
-	}
-	if len(m.AllowedPreds) > 0 {
-		for iNdEx := len(m.AllowedPreds) - 1; iNdEx >= 0; iNdEx-- {
-			i -= len(m.AllowedPreds[iNdEx])
-			copy(dAtA[i:], m.AllowedPreds[iNdEx])
-			i = encodeVarintPb(dAtA, i, uint64(len(m.AllowedPreds[iNdEx])))
+	if len(m.Langs) > 0 {
+		for iNdEx := len(m.Langs) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.Langs[iNdEx])
+			copy(dAtA[i:], m.Langs[iNdEx])
+			i = encodeVarintPb(dAtA, i, uint64(len(m.Langs[iNdEx])))
 			i--
-			dAtA[i] = 0x52
+			dAtA[i] = 0x1a
 		}
 	}
-	if len(m.Facets) > 0 {
-		for iNdEx := len(m.Facets) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.Facets[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintPb(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0x4a
-		}
-	}
-	if m.Op != 0 {
-		i = encodeVarintPb(dAtA, i, uint64(m.Op))
-		i--
-		dAtA[i] = 0x40
-	}
-	if len(m.Lang) > 0 {
-		i -= len(m.Lang)
-		copy(dAtA[i:], m.Lang)
-		i = encodeVarintPb(dAtA, i, uint64(len(m.Lang)))
-		i--
-		dAtA[i] = 0x3a
-	}
-	if m.ValueId != 0 {
-		i -= 8
-		encoding_binary.LittleEndian.PutUint64(dAtA[i:], uint64(m.ValueId))
-		i--
-		dAtA[i] = 0x29
-	}
-	if m.ValueType != 0 {
-		i = encodeVarintPb(dAtA, i, uint64(m.ValueType))
+	if m.Desc {
 		i--
-		dAtA[i] = 0x20
-	}
-	if len(m.Value) > 0 {
-		i -= len(m.Value)
-		copy(dAtA[i:], m.Value)
-		i = encodeVarintPb(dAtA, i, uint64(len(m.Value)))
+		if m.Desc {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
 		i--
-		dAtA[i] = 0x1a
+		dAtA[i] = 0x10
 	}
 	if len(m.Attr) > 0 {
 		i -= len(m.Attr)
 		copy(dAtA[i:], m.Attr)
 		i = encodeVarintPb(dAtA, i, uint64(len(m.Attr)))
 		i--
-		dAtA[i] = 0x12
-	}
-	if m.Entity != 0 {
-		i -= 8
-		encoding_binary.LittleEndian.PutUint64(dAtA[i:], uint64(m.Entity))
-		i--
-		dAtA[i] = 0x9
+		dAtA[i] = 0xa
 	}
 	return len(dAtA) - i, nil
 }
 
-func (m *Mutations) Marshal() (dAtA []byte, err error) {
+func (m *SortMessage) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -9005,58 +8870,35 @@ func (m *Mutations) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *Mutations) MarshalTo(dAtA []byte) (int, error) {
+func (m *SortMessage) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *Mutations) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *SortMessage) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if m.Metadata != nil {
-		{
-			size, err := m.Metadata.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintPb(dAtA, i, uint64(size))
-		}
+	if m.ReadTs != 0 {
+		i = encodeVarintPb(dAtA, i, uint64(m.ReadTs))
 		i--
-		dAtA[i] = 0x4a
+		dAtA[i] = 0x68
 	}
-	if len(m.DropValue) > 0 {
-		i -= len(m.DropValue)
-		copy(dAtA[i:], m.DropValue)
-		i = encodeVarintPb(dAtA, i, uint64(len(m.DropValue)))
+	if m.Offset != 0 {
+		i = encodeVarintPb(dAtA, i, uint64(m.Offset))
 		i--
-		dAtA[i] = 0x42
+		dAtA[i] = 0x20
 	}
-	if m.DropOp != 0 {
-		i = encodeVarintPb(dAtA, i, uint64(m.DropOp))
+	if m.Count != 0 {
+		i = encodeVarintPb(dAtA, i, uint64(m.Count))
 		i--
-		dAtA[i] = 0x38
-	}
-	if len(m.Types) > 0 {
-		for iNdEx := len(m.Types) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.Types[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintPb(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0x32
-		}
+		dAtA[i] = 0x18
 	}
-	if len(m.Schema) > 0 {
-		for iNdEx := len(m.Schema) - 1; iNdEx >= 0; iNdEx-- {
+	if len(m.UidMatrix) > 0 {
+		for iNdEx := len(m.UidMatrix) - 1; iNdEx >= 0; iNdEx-- {
 			{
-				size, err := m.Schema[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				size, err := m.UidMatrix[iNdEx].MarshalToSizedBuffer(dAtA[:i])
 				if err != nil {
 					return 0, err
 				}
@@ -9064,13 +8906,13 @@ func (m *Mutations) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 				i = encodeVarintPb(dAtA, i, uint64(size))
 			}
 			i--
-			dAtA[i] = 0x22
+			dAtA[i] = 0x12
 		}
 	}
-	if len(m.Edges) > 0 {
-		for iNdEx := len(m.Edges) - 1; iNdEx >= 0; iNdEx-- {
+	if len(m.Order) > 0 {
+		for iNdEx := len(m.Order) - 1; iNdEx >= 0; iNdEx-- {
 			{
-				size, err := m.Edges[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				size, err := m.Order[iNdEx].MarshalToSizedBuffer(dAtA[:i])
 				if err != nil {
 					return 0, err
 				}
@@ -9078,23 +8920,13 @@ func (m *Mutations) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 				i = encodeVarintPb(dAtA, i, uint64(size))
 			}
 			i--
-			dAtA[i] = 0x1a
+			dAtA[i] = 0xa
 		}
 	}
-	if m.StartTs != 0 {
-		i = encodeVarintPb(dAtA, i, uint64(m.StartTs))
-		i--
-		dAtA[i] = 0x10
-	}
-	if m.GroupId != 0 {
-		i = encodeVarintPb(dAtA, i, uint64(m.GroupId))
-		i--
-		dAtA[i] = 0x8
-	}
 	return len(dAtA) - i, nil
 }
 
-func (m *Metadata) Marshal() (dAtA []byte, err error) {
+func (m *SortResult) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -9104,29 +8936,26 @@ func (m *Metadata) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *Metadata) MarshalTo(dAtA []byte) (int, error) {
+func (m *SortResult) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *Metadata) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *SortResult) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if len(m.PredHints) > 0 {
-		for k := range m.PredHints {
-			v := m.PredHints[k]
-			baseI := i
-			i = encodeVarintPb(dAtA, i, uint64(v))
-			i--
-			dAtA[i] = 0x10
-			i -= len(k)
-			copy(dAtA[i:], k)
-			i = encodeVarintPb(dAtA, i, uint64(len(k)))
-			i--
-			dAtA[i] = 0xa
-			i = encodeVarintPb(dAtA, i, uint64(baseI-i))
+	if len(m.UidMatrix) > 0 {
+		for iNdEx := len(m.UidMatrix) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.UidMatrix[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintPb(dAtA, i, uint64(size))
+			}
 			i--
 			dAtA[i] = 0xa
 		}
@@ -9134,7 +8963,7 @@ func (m *Metadata) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
-func (m *Snapshot) Marshal() (dAtA []byte, err error) {
+func (m *RaftContext) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -9144,57 +8973,53 @@ func (m *Snapshot) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *Snapshot) MarshalTo(dAtA []byte) (int, error) {
+func (m *RaftContext) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *Snapshot) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *RaftContext) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if m.SinceTs != 0 {
-		i = encodeVarintPb(dAtA, i, uint64(m.SinceTs))
-		i--
-		dAtA[i] = 0x28
-	}
-	if m.Done {
+	if m.IsLearner {
 		i--
-		if m.Done {
+		if m.IsLearner {
 			dAtA[i] = 1
 		} else {
 			dAtA[i] = 0
 		}
 		i--
+		dAtA[i] = 0x28
+	}
+	if m.SnapshotTs != 0 {
+		i = encodeVarintPb(dAtA, i, uint64(m.SnapshotTs))
+		i--
 		dAtA[i] = 0x20
 	}
-	if m.ReadTs != 0 {
-		i = encodeVarintPb(dAtA, i, uint64(m.ReadTs))
+	if len(m.Addr) > 0 {
+		i -= len(m.Addr)
+		copy(dAtA[i:], m.Addr)
+		i = encodeVarintPb(dAtA, i, uint64(len(m.Addr)))
 		i--
-		dAtA[i] = 0x18
+		dAtA[i] = 0x1a
 	}
-	if m.Index != 0 {
-		i = encodeVarintPb(dAtA, i, uint64(m.Index))
+	if m.Group != 0 {
+		i = encodeVarintPb(dAtA, i, uint64(m.Group))
 		i--
 		dAtA[i] = 0x10
 	}
-	if m.Context != nil {
-		{
-			size, err := m.Context.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintPb(dAtA, i, uint64(size))
-		}
+	if m.Id != 0 {
+		i -= 8
+		encoding_binary.LittleEndian.PutUint64(dAtA[i:], uint64(m.Id))
 		i--
-		dAtA[i] = 0xa
+		dAtA[i] = 0x9
 	}
 	return len(dAtA) - i, nil
 }
 
-func (m *ZeroSnapshot) Marshal() (dAtA []byte, err error) {
+func (m *Member) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -9204,176 +9029,93 @@ func (m *ZeroSnapshot) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *ZeroSnapshot) MarshalTo(dAtA []byte) (int, error) {
+func (m *Member) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *ZeroSnapshot) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *Member) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if m.State != nil {
-		{
-			size, err := m.State.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintPb(dAtA, i, uint64(size))
+	if m.ForceGroupId {
+		i--
+		if m.ForceGroupId {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
 		}
 		i--
-		dAtA[i] = 0x2a
+		dAtA[i] = 0x70
 	}
-	if m.CheckpointTs != 0 {
-		i = encodeVarintPb(dAtA, i, uint64(m.CheckpointTs))
+	if m.ClusterInfoOnly {
 		i--
-		dAtA[i] = 0x10
-	}
-	if m.Index != 0 {
-		i = encodeVarintPb(dAtA, i, uint64(m.Index))
+		if m.ClusterInfoOnly {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
 		i--
-		dAtA[i] = 0x8
+		dAtA[i] = 0x68
 	}
-	return len(dAtA) - i, nil
-}
-
-func (m *RestoreRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+	if m.Learner {
+		i--
+		if m.Learner {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x38
 	}
-	return dAtA[:n], nil
-}
-
-func (m *RestoreRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
-}
-
-func (m *RestoreRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.BackupNum != 0 {
-		i = encodeVarintPb(dAtA, i, uint64(m.BackupNum))
-		i--
-		dAtA[i] = 0x1
-		i--
-		dAtA[i] = 0x80
-	}
-	if len(m.VaultFormat) > 0 {
-		i -= len(m.VaultFormat)
-		copy(dAtA[i:], m.VaultFormat)
-		i = encodeVarintPb(dAtA, i, uint64(len(m.VaultFormat)))
-		i--
-		dAtA[i] = 0x7a
-	}
-	if len(m.VaultField) > 0 {
-		i -= len(m.VaultField)
-		copy(dAtA[i:], m.VaultField)
-		i = encodeVarintPb(dAtA, i, uint64(len(m.VaultField)))
-		i--
-		dAtA[i] = 0x72
-	}
-	if len(m.VaultPath) > 0 {
-		i -= len(m.VaultPath)
-		copy(dAtA[i:], m.VaultPath)
-		i = encodeVarintPb(dAtA, i, uint64(len(m.VaultPath)))
-		i--
-		dAtA[i] = 0x6a
-	}
-	if len(m.VaultSecretidFile) > 0 {
-		i -= len(m.VaultSecretidFile)
-		copy(dAtA[i:], m.VaultSecretidFile)
-		i = encodeVarintPb(dAtA, i, uint64(len(m.VaultSecretidFile)))
-		i--
-		dAtA[i] = 0x62
-	}
-	if len(m.VaultRoleidFile) > 0 {
-		i -= len(m.VaultRoleidFile)
-		copy(dAtA[i:], m.VaultRoleidFile)
-		i = encodeVarintPb(dAtA, i, uint64(len(m.VaultRoleidFile)))
-		i--
-		dAtA[i] = 0x5a
-	}
-	if len(m.VaultAddr) > 0 {
-		i -= len(m.VaultAddr)
-		copy(dAtA[i:], m.VaultAddr)
-		i = encodeVarintPb(dAtA, i, uint64(len(m.VaultAddr)))
-		i--
-		dAtA[i] = 0x52
-	}
-	if len(m.EncryptionKeyFile) > 0 {
-		i -= len(m.EncryptionKeyFile)
-		copy(dAtA[i:], m.EncryptionKeyFile)
-		i = encodeVarintPb(dAtA, i, uint64(len(m.EncryptionKeyFile)))
+	if m.LastUpdate != 0 {
+		i = encodeVarintPb(dAtA, i, uint64(m.LastUpdate))
 		i--
-		dAtA[i] = 0x4a
+		dAtA[i] = 0x30
 	}
-	if m.Anonymous {
+	if m.AmDead {
 		i--
-		if m.Anonymous {
+		if m.AmDead {
 			dAtA[i] = 1
 		} else {
 			dAtA[i] = 0
 		}
 		i--
-		dAtA[i] = 0x40
-	}
-	if len(m.SessionToken) > 0 {
-		i -= len(m.SessionToken)
-		copy(dAtA[i:], m.SessionToken)
-		i = encodeVarintPb(dAtA, i, uint64(len(m.SessionToken)))
-		i--
-		dAtA[i] = 0x3a
-	}
-	if len(m.SecretKey) > 0 {
-		i -= len(m.SecretKey)
-		copy(dAtA[i:], m.SecretKey)
-		i = encodeVarintPb(dAtA, i, uint64(len(m.SecretKey)))
-		i--
-		dAtA[i] = 0x32
+		dAtA[i] = 0x28
 	}
-	if len(m.AccessKey) > 0 {
-		i -= len(m.AccessKey)
-		copy(dAtA[i:], m.AccessKey)
-		i = encodeVarintPb(dAtA, i, uint64(len(m.AccessKey)))
+	if m.Leader {
 		i--
-		dAtA[i] = 0x2a
-	}
-	if len(m.BackupId) > 0 {
-		i -= len(m.BackupId)
-		copy(dAtA[i:], m.BackupId)
-		i = encodeVarintPb(dAtA, i, uint64(len(m.BackupId)))
+		if m.Leader {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
 		i--
-		dAtA[i] = 0x22
+		dAtA[i] = 0x20
 	}
-	if len(m.Location) > 0 {
-		i -= len(m.Location)
-		copy(dAtA[i:], m.Location)
-		i = encodeVarintPb(dAtA, i, uint64(len(m.Location)))
+	if len(m.Addr) > 0 {
+		i -= len(m.Addr)
+		copy(dAtA[i:], m.Addr)
+		i = encodeVarintPb(dAtA, i, uint64(len(m.Addr)))
 		i--
 		dAtA[i] = 0x1a
 	}
-	if m.RestoreTs != 0 {
-		i = encodeVarintPb(dAtA, i, uint64(m.RestoreTs))
+	if m.GroupId != 0 {
+		i = encodeVarintPb(dAtA, i, uint64(m.GroupId))
 		i--
 		dAtA[i] = 0x10
 	}
-	if m.GroupId != 0 {
-		i = encodeVarintPb(dAtA, i, uint64(m.GroupId))
+	if m.Id != 0 {
+		i -= 8
+		encoding_binary.LittleEndian.PutUint64(dAtA[i:], uint64(m.Id))
 		i--
-		dAtA[i] = 0x8
+		dAtA[i] = 0x9
 	}
 	return len(dAtA) - i, nil
 }
 
-func (m *Proposal) Marshal() (dAtA []byte, err error) {
+func (m *Group) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -9383,68 +9125,184 @@ func (m *Proposal) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *Proposal) MarshalTo(dAtA []byte) (int, error) {
+func (m *Group) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *Proposal) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *Group) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if m.StartTs != 0 {
-		i = encodeVarintPb(dAtA, i, uint64(m.StartTs))
+	if m.CheckpointTs != 0 {
+		i = encodeVarintPb(dAtA, i, uint64(m.CheckpointTs))
 		i--
-		dAtA[i] = 0x1
+		dAtA[i] = 0x28
+	}
+	if m.Checksum != 0 {
+		i = encodeVarintPb(dAtA, i, uint64(m.Checksum))
 		i--
-		dAtA[i] = 0x80
+		dAtA[i] = 0x20
 	}
-	if m.DeleteNs != nil {
-		{
-			size, err := m.DeleteNs.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintPb(dAtA, i, uint64(size))
-		}
+	if m.SnapshotTs != 0 {
+		i = encodeVarintPb(dAtA, i, uint64(m.SnapshotTs))
 		i--
-		dAtA[i] = 0x72
+		dAtA[i] = 0x18
 	}
-	if m.CdcState != nil {
-		{
-			size, err := m.CdcState.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
+	if len(m.Tablets) > 0 {
+		for k := range m.Tablets {
+			v := m.Tablets[k]
+			baseI := i
+			if v != nil {
+				{
+					size, err := v.MarshalToSizedBuffer(dAtA[:i])
+					if err != nil {
+						return 0, err
+					}
+					i -= size
+					i = encodeVarintPb(dAtA, i, uint64(size))
+				}
+				i--
+				dAtA[i] = 0x12
 			}
-			i -= size
-			i = encodeVarintPb(dAtA, i, uint64(size))
+			i -= len(k)
+			copy(dAtA[i:], k)
+			i = encodeVarintPb(dAtA, i, uint64(len(k)))
+			i--
+			dAtA[i] = 0xa
+			i = encodeVarintPb(dAtA, i, uint64(baseI-i))
+			i--
+			dAtA[i] = 0x12
 		}
-		i--
-		dAtA[i] = 0x6a
 	}
-	if m.Restore != nil {
-		{
-			size, err := m.Restore.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
+	if len(m.Members) > 0 {
+		for k := range m.Members {
+			v := m.Members[k]
+			baseI := i
+			if v != nil {
+				{
+					size, err := v.MarshalToSizedBuffer(dAtA[:i])
+					if err != nil {
+						return 0, err
+					}
+					i -= size
+					i = encodeVarintPb(dAtA, i, uint64(size))
+				}
+				i--
+				dAtA[i] = 0x12
 			}
-			i -= size
-			i = encodeVarintPb(dAtA, i, uint64(size))
+			i = encodeVarintPb(dAtA, i, uint64(k))
+			i--
+			dAtA[i] = 0x8
+			i = encodeVarintPb(dAtA, i, uint64(baseI-i))
+			i--
+			dAtA[i] = 0xa
 		}
-		i--
-		dAtA[i] = 0x62
 	}
-	if m.ExpectedChecksum != 0 {
-		i = encodeVarintPb(dAtA, i, uint64(m.ExpectedChecksum))
-		i--
-		dAtA[i] = 0x58
+	return len(dAtA) - i, nil
+}
+
+func (m *License) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	if m.Index != 0 {
-		i = encodeVarintPb(dAtA, i, uint64(m.Index))
+	return dAtA[:n], nil
+}
+
+func (m *License) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *License) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Enabled {
 		i--
-		dAtA[i] = 0x50
+		if m.Enabled {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x20
+	}
+	if m.ExpiryTs != 0 {
+		i = encodeVarintPb(dAtA, i, uint64(m.ExpiryTs))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.MaxNodes != 0 {
+		i = encodeVarintPb(dAtA, i, uint64(m.MaxNodes))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.User) > 0 {
+		i -= len(m.User)
+		copy(dAtA[i:], m.User)
+		i = encodeVarintPb(dAtA, i, uint64(len(m.User)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *ZeroProposal) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ZeroProposal) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ZeroProposal) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Tablets) > 0 {
+		for iNdEx := len(m.Tablets) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Tablets[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintPb(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x72
+		}
+	}
+	if m.DeleteNs != nil {
+		{
+			size, err := m.DeleteNs.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintPb(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x6a
+	}
+	if m.MaxNsID != 0 {
+		i = encodeVarintPb(dAtA, i, uint64(m.MaxNsID))
+		i--
+		dAtA[i] = 0x60
 	}
 	if m.Snapshot != nil {
 		{
@@ -9456,11 +9314,11 @@ func (m *Proposal) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 			i = encodeVarintPb(dAtA, i, uint64(size))
 		}
 		i--
-		dAtA[i] = 0x4a
+		dAtA[i] = 0x5a
 	}
-	if m.Delta != nil {
+	if m.License != nil {
 		{
-			size, err := m.Delta.MarshalToSizedBuffer(dAtA[:i])
+			size, err := m.License.MarshalToSizedBuffer(dAtA[:i])
 			if err != nil {
 				return 0, err
 			}
@@ -9468,18 +9326,18 @@ func (m *Proposal) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 			i = encodeVarintPb(dAtA, i, uint64(size))
 		}
 		i--
-		dAtA[i] = 0x42
+		dAtA[i] = 0x52
 	}
-	if len(m.CleanPredicate) > 0 {
-		i -= len(m.CleanPredicate)
-		copy(dAtA[i:], m.CleanPredicate)
-		i = encodeVarintPb(dAtA, i, uint64(len(m.CleanPredicate)))
+	if len(m.Cid) > 0 {
+		i -= len(m.Cid)
+		copy(dAtA[i:], m.Cid)
+		i = encodeVarintPb(dAtA, i, uint64(len(m.Cid)))
 		i--
-		dAtA[i] = 0x32
+		dAtA[i] = 0x4a
 	}
-	if m.State != nil {
+	if m.Txn != nil {
 		{
-			size, err := m.State.MarshalToSizedBuffer(dAtA[:i])
+			size, err := m.Txn.MarshalToSizedBuffer(dAtA[:i])
 			if err != nil {
 				return 0, err
 			}
@@ -9487,25 +9345,38 @@ func (m *Proposal) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 			i = encodeVarintPb(dAtA, i, uint64(size))
 		}
 		i--
-		dAtA[i] = 0x2a
+		dAtA[i] = 0x3a
 	}
-	if len(m.Kv) > 0 {
-		for iNdEx := len(m.Kv) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.Kv[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintPb(dAtA, i, uint64(size))
+	if m.MaxRaftId != 0 {
+		i = encodeVarintPb(dAtA, i, uint64(m.MaxRaftId))
+		i--
+		dAtA[i] = 0x30
+	}
+	if m.MaxTxnTs != 0 {
+		i = encodeVarintPb(dAtA, i, uint64(m.MaxTxnTs))
+		i--
+		dAtA[i] = 0x28
+	}
+	if m.MaxUID != 0 {
+		i = encodeVarintPb(dAtA, i, uint64(m.MaxUID))
+		i--
+		dAtA[i] = 0x20
+	}
+	if m.Tablet != nil {
+		{
+			size, err := m.Tablet.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
 			}
-			i--
-			dAtA[i] = 0x22
+			i -= size
+			i = encodeVarintPb(dAtA, i, uint64(size))
 		}
+		i--
+		dAtA[i] = 0x1a
 	}
-	if m.Mutations != nil {
+	if m.Member != nil {
 		{
-			size, err := m.Mutations.MarshalToSizedBuffer(dAtA[:i])
+			size, err := m.Member.MarshalToSizedBuffer(dAtA[:i])
 			if err != nil {
 				return 0, err
 			}
@@ -9515,10 +9386,25 @@ func (m *Proposal) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 		i--
 		dAtA[i] = 0x12
 	}
+	if len(m.SnapshotTs) > 0 {
+		for k := range m.SnapshotTs {
+			v := m.SnapshotTs[k]
+			baseI := i
+			i = encodeVarintPb(dAtA, i, uint64(v))
+			i--
+			dAtA[i] = 0x10
+			i = encodeVarintPb(dAtA, i, uint64(k))
+			i--
+			dAtA[i] = 0x8
+			i = encodeVarintPb(dAtA, i, uint64(baseI-i))
+			i--
+			dAtA[i] = 0xa
+		}
+	}
 	return len(dAtA) - i, nil
 }
 
-func (m *CDCState) Marshal() (dAtA []byte, err error) {
+func (m *MembershipState) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -9528,165 +9414,126 @@ func (m *CDCState) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *CDCState) MarshalTo(dAtA []byte) (int, error) {
+func (m *MembershipState) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *CDCState) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *MembershipState) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if m.SentTs != 0 {
-		i = encodeVarintPb(dAtA, i, uint64(m.SentTs))
+	if m.MaxNsID != 0 {
+		i = encodeVarintPb(dAtA, i, uint64(m.MaxNsID))
 		i--
-		dAtA[i] = 0x8
+		dAtA[i] = 0x50
 	}
-	return len(dAtA) - i, nil
-}
-
-func (m *KVS) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+	if m.License != nil {
+		{
+			size, err := m.License.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintPb(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x4a
 	}
-	return dAtA[:n], nil
-}
-
-func (m *KVS) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
-}
-
-func (m *KVS) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.Data) > 0 {
-		i -= len(m.Data)
-		copy(dAtA[i:], m.Data)
-		i = encodeVarintPb(dAtA, i, uint64(len(m.Data)))
+	if len(m.Cid) > 0 {
+		i -= len(m.Cid)
+		copy(dAtA[i:], m.Cid)
+		i = encodeVarintPb(dAtA, i, uint64(len(m.Cid)))
 		i--
-		dAtA[i] = 0x2a
+		dAtA[i] = 0x42
 	}
-	if len(m.Types) > 0 {
-		for iNdEx := len(m.Types) - 1; iNdEx >= 0; iNdEx-- {
-			i -= len(m.Types[iNdEx])
-			copy(dAtA[i:], m.Types[iNdEx])
-			i = encodeVarintPb(dAtA, i, uint64(len(m.Types[iNdEx])))
+	if len(m.Removed) > 0 {
+		for iNdEx := len(m.Removed) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Removed[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintPb(dAtA, i, uint64(size))
+			}
 			i--
-			dAtA[i] = 0x22
+			dAtA[i] = 0x3a
 		}
 	}
-	if len(m.Predicates) > 0 {
-		for iNdEx := len(m.Predicates) - 1; iNdEx >= 0; iNdEx-- {
-			i -= len(m.Predicates[iNdEx])
-			copy(dAtA[i:], m.Predicates[iNdEx])
-			i = encodeVarintPb(dAtA, i, uint64(len(m.Predicates[iNdEx])))
-			i--
-			dAtA[i] = 0x1a
-		}
+	if m.MaxRaftId != 0 {
+		i = encodeVarintPb(dAtA, i, uint64(m.MaxRaftId))
+		i--
+		dAtA[i] = 0x30
 	}
-	if m.Done {
+	if m.MaxTxnTs != 0 {
+		i = encodeVarintPb(dAtA, i, uint64(m.MaxTxnTs))
 		i--
-		if m.Done {
-			dAtA[i] = 1
-		} else {
-			dAtA[i] = 0
-		}
+		dAtA[i] = 0x28
+	}
+	if m.MaxUID != 0 {
+		i = encodeVarintPb(dAtA, i, uint64(m.MaxUID))
 		i--
-		dAtA[i] = 0x10
+		dAtA[i] = 0x20
 	}
-	return len(dAtA) - i, nil
-}
-
-func (m *Posting) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
-	}
-	return dAtA[:n], nil
-}
-
-func (m *Posting) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
-}
-
-func (m *Posting) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.CommitTs != 0 {
-		i = encodeVarintPb(dAtA, i, uint64(m.CommitTs))
-		i--
-		dAtA[i] = 0x70
-	}
-	if m.StartTs != 0 {
-		i = encodeVarintPb(dAtA, i, uint64(m.StartTs))
-		i--
-		dAtA[i] = 0x68
-	}
-	if m.Op != 0 {
-		i = encodeVarintPb(dAtA, i, uint64(m.Op))
-		i--
-		dAtA[i] = 0x60
-	}
-	if len(m.Facets) > 0 {
-		for iNdEx := len(m.Facets) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.Facets[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
+	if len(m.Zeros) > 0 {
+		for k := range m.Zeros {
+			v := m.Zeros[k]
+			baseI := i
+			if v != nil {
+				{
+					size, err := v.MarshalToSizedBuffer(dAtA[:i])
+					if err != nil {
+						return 0, err
+					}
+					i -= size
+					i = encodeVarintPb(dAtA, i, uint64(size))
 				}
-				i -= size
-				i = encodeVarintPb(dAtA, i, uint64(size))
+				i--
+				dAtA[i] = 0x12
 			}
+			i = encodeVarintPb(dAtA, i, uint64(k))
 			i--
-			dAtA[i] = 0x4a
+			dAtA[i] = 0x8
+			i = encodeVarintPb(dAtA, i, uint64(baseI-i))
+			i--
+			dAtA[i] = 0x1a
 		}
 	}
-	if len(m.LangTag) > 0 {
-		i -= len(m.LangTag)
-		copy(dAtA[i:], m.LangTag)
-		i = encodeVarintPb(dAtA, i, uint64(len(m.LangTag)))
-		i--
-		dAtA[i] = 0x2a
-	}
-	if m.PostingType != 0 {
-		i = encodeVarintPb(dAtA, i, uint64(m.PostingType))
-		i--
-		dAtA[i] = 0x20
-	}
-	if m.ValType != 0 {
-		i = encodeVarintPb(dAtA, i, uint64(m.ValType))
-		i--
-		dAtA[i] = 0x18
-	}
-	if len(m.Value) > 0 {
-		i -= len(m.Value)
-		copy(dAtA[i:], m.Value)
-		i = encodeVarintPb(dAtA, i, uint64(len(m.Value)))
-		i--
-		dAtA[i] = 0x12
+	if len(m.Groups) > 0 {
+		for k := range m.Groups {
+			v := m.Groups[k]
+			baseI := i
+			if v != nil {
+				{
+					size, err := v.MarshalToSizedBuffer(dAtA[:i])
+					if err != nil {
+						return 0, err
+					}
+					i -= size
+					i = encodeVarintPb(dAtA, i, uint64(size))
+				}
+				i--
+				dAtA[i] = 0x12
+			}
+			i = encodeVarintPb(dAtA, i, uint64(k))
+			i--
+			dAtA[i] = 0x8
+			i = encodeVarintPb(dAtA, i, uint64(baseI-i))
+			i--
+			dAtA[i] = 0x12
+		}
 	}
-	if m.Uid != 0 {
-		i -= 8
-		encoding_binary.LittleEndian.PutUint64(dAtA[i:], uint64(m.Uid))
+	if m.Counter != 0 {
+		i = encodeVarintPb(dAtA, i, uint64(m.Counter))
 		i--
-		dAtA[i] = 0x9
+		dAtA[i] = 0x8
 	}
 	return len(dAtA) - i, nil
 }
 
-func (m *UidBlock) Marshal() (dAtA []byte, err error) {
+func (m *ConnectionState) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -9696,37 +9543,49 @@ func (m *UidBlock) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *UidBlock) MarshalTo(dAtA []byte) (int, error) {
+func (m *ConnectionState) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *UidBlock) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *ConnectionState) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if m.NumUids != 0 {
-		i = encodeVarintPb(dAtA, i, uint64(m.NumUids))
+	if m.MaxPending != 0 {
+		i = encodeVarintPb(dAtA, i, uint64(m.MaxPending))
 		i--
 		dAtA[i] = 0x18
 	}
-	if len(m.Deltas) > 0 {
-		i -= len(m.Deltas)
-		copy(dAtA[i:], m.Deltas)
-		i = encodeVarintPb(dAtA, i, uint64(len(m.Deltas)))
+	if m.State != nil {
+		{
+			size, err := m.State.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintPb(dAtA, i, uint64(size))
+		}
 		i--
 		dAtA[i] = 0x12
 	}
-	if m.Base != 0 {
-		i = encodeVarintPb(dAtA, i, uint64(m.Base))
+	if m.Member != nil {
+		{
+			size, err := m.Member.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintPb(dAtA, i, uint64(size))
+		}
 		i--
-		dAtA[i] = 0x8
+		dAtA[i] = 0xa
 	}
 	return len(dAtA) - i, nil
 }
 
-func (m *UidPack) Marshal() (dAtA []byte, err error) {
+func (m *HealthInfo) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -9736,46 +9595,97 @@ func (m *UidPack) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *UidPack) MarshalTo(dAtA []byte) (int, error) {
+func (m *HealthInfo) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *UidPack) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *HealthInfo) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if m.AllocRef != 0 {
-		i = encodeVarintPb(dAtA, i, uint64(m.AllocRef))
-		i--
-		dAtA[i] = 0x1
+	if m.MaxAssigned != 0 {
+		i = encodeVarintPb(dAtA, i, uint64(m.MaxAssigned))
 		i--
-		dAtA[i] = 0xb8
+		dAtA[i] = 0x58
 	}
-	if len(m.Blocks) > 0 {
-		for iNdEx := len(m.Blocks) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.Blocks[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintPb(dAtA, i, uint64(size))
-			}
+	if len(m.EeFeatures) > 0 {
+		for iNdEx := len(m.EeFeatures) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.EeFeatures[iNdEx])
+			copy(dAtA[i:], m.EeFeatures[iNdEx])
+			i = encodeVarintPb(dAtA, i, uint64(len(m.EeFeatures[iNdEx])))
 			i--
-			dAtA[i] = 0x12
+			dAtA[i] = 0x52
 		}
 	}
-	if m.BlockSize != 0 {
-		i = encodeVarintPb(dAtA, i, uint64(m.BlockSize))
+	if len(m.Indexing) > 0 {
+		for iNdEx := len(m.Indexing) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.Indexing[iNdEx])
+			copy(dAtA[i:], m.Indexing[iNdEx])
+			i = encodeVarintPb(dAtA, i, uint64(len(m.Indexing[iNdEx])))
+			i--
+			dAtA[i] = 0x4a
+		}
+	}
+	if len(m.Ongoing) > 0 {
+		for iNdEx := len(m.Ongoing) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.Ongoing[iNdEx])
+			copy(dAtA[i:], m.Ongoing[iNdEx])
+			i = encodeVarintPb(dAtA, i, uint64(len(m.Ongoing[iNdEx])))
+			i--
+			dAtA[i] = 0x42
+		}
+	}
+	if m.LastEcho != 0 {
+		i = encodeVarintPb(dAtA, i, uint64(m.LastEcho))
 		i--
-		dAtA[i] = 0x8
+		dAtA[i] = 0x38
+	}
+	if m.Uptime != 0 {
+		i = encodeVarintPb(dAtA, i, uint64(m.Uptime))
+		i--
+		dAtA[i] = 0x30
+	}
+	if len(m.Version) > 0 {
+		i -= len(m.Version)
+		copy(dAtA[i:], m.Version)
+		i = encodeVarintPb(dAtA, i, uint64(len(m.Version)))
+		i--
+		dAtA[i] = 0x2a
+	}
+	if len(m.Group) > 0 {
+		i -= len(m.Group)
+		copy(dAtA[i:], m.Group)
+		i = encodeVarintPb(dAtA, i, uint64(len(m.Group)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.Status) > 0 {
+		i -= len(m.Status)
+		copy(dAtA[i:], m.Status)
+		i = encodeVarintPb(dAtA, i, uint64(len(m.Status)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Address) > 0 {
+		i -= len(m.Address)
+		copy(dAtA[i:], m.Address)
+		i = encodeVarintPb(dAtA, i, uint64(len(m.Address)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Instance) > 0 {
+		i -= len(m.Instance)
+		copy(dAtA[i:], m.Instance)
+		i = encodeVarintPb(dAtA, i, uint64(len(m.Instance)))
+		i--
+		dAtA[i] = 0xa
 	}
 	return len(dAtA) - i, nil
 }
 
-func (m *PostingList) Marshal() (dAtA []byte, err error) {
+func (m *Tablet) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -9785,106 +9695,77 @@ func (m *PostingList) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *PostingList) MarshalTo(dAtA []byte) (int, error) {
+func (m *Tablet) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *PostingList) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *Tablet) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if len(m.Splits) > 0 {
-		dAtA31 := make([]byte, len(m.Splits)*10)
-		var j30 int
-		for _, num := range m.Splits {
-			for num >= 1<<7 {
-				dAtA31[j30] = uint8(uint64(num)&0x7f | 0x80)
-				num >>= 7
-				j30++
-			}
-			dAtA31[j30] = uint8(num)
-			j30++
-		}
-		i -= j30
-		copy(dAtA[i:], dAtA31[:j30])
-		i = encodeVarintPb(dAtA, i, uint64(j30))
+	if m.UncompressedBytes != 0 {
+		i = encodeVarintPb(dAtA, i, uint64(m.UncompressedBytes))
 		i--
-		dAtA[i] = 0x22
+		dAtA[i] = 0x58
 	}
-	if m.CommitTs != 0 {
-		i = encodeVarintPb(dAtA, i, uint64(m.CommitTs))
+	if m.MoveTs != 0 {
+		i = encodeVarintPb(dAtA, i, uint64(m.MoveTs))
 		i--
-		dAtA[i] = 0x18
+		dAtA[i] = 0x50
 	}
-	if len(m.Postings) > 0 {
-		for iNdEx := len(m.Postings) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.Postings[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintPb(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0x12
+	if m.ReadOnly {
+		i--
+		if m.ReadOnly {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
 		}
+		i--
+		dAtA[i] = 0x48
 	}
-	if m.Pack != nil {
-		{
-			size, err := m.Pack.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintPb(dAtA, i, uint64(size))
+	if m.Remove {
+		i--
+		if m.Remove {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
 		}
 		i--
-		dAtA[i] = 0xa
+		dAtA[i] = 0x40
 	}
-	return len(dAtA) - i, nil
-}
-
-func (m *FacetParam) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+	if m.OnDiskBytes != 0 {
+		i = encodeVarintPb(dAtA, i, uint64(m.OnDiskBytes))
+		i--
+		dAtA[i] = 0x38
 	}
-	return dAtA[:n], nil
-}
-
-func (m *FacetParam) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
-}
-
-func (m *FacetParam) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.Alias) > 0 {
-		i -= len(m.Alias)
-		copy(dAtA[i:], m.Alias)
-		i = encodeVarintPb(dAtA, i, uint64(len(m.Alias)))
+	if m.Force {
+		i--
+		if m.Force {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x18
+	}
+	if len(m.Predicate) > 0 {
+		i -= len(m.Predicate)
+		copy(dAtA[i:], m.Predicate)
+		i = encodeVarintPb(dAtA, i, uint64(len(m.Predicate)))
 		i--
 		dAtA[i] = 0x12
 	}
-	if len(m.Key) > 0 {
-		i -= len(m.Key)
-		copy(dAtA[i:], m.Key)
-		i = encodeVarintPb(dAtA, i, uint64(len(m.Key)))
+	if m.GroupId != 0 {
+		i = encodeVarintPb(dAtA, i, uint64(m.GroupId))
 		i--
-		dAtA[i] = 0xa
+		dAtA[i] = 0x8
 	}
 	return len(dAtA) - i, nil
 }
 
-func (m *FacetParams) Marshal() (dAtA []byte, err error) {
+func (m *DirectedEdge) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -9894,20 +9775,34 @@ func (m *FacetParams) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *FacetParams) MarshalTo(dAtA []byte) (int, error) {
+func (m *DirectedEdge) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *FacetParams) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *DirectedEdge) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if len(m.Param) > 0 {
-		for iNdEx := len(m.Param) - 1; iNdEx >= 0; iNdEx-- {
+	if m.Namespace != 0 {
+		i = encodeVarintPb(dAtA, i, uint64(m.Namespace))
+		i--
+		dAtA[i] = 0x58
+	}
+	if len(m.AllowedPreds) > 0 {
+		for iNdEx := len(m.AllowedPreds) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.AllowedPreds[iNdEx])
+			copy(dAtA[i:], m.AllowedPreds[iNdEx])
+			i = encodeVarintPb(dAtA, i, uint64(len(m.AllowedPreds[iNdEx])))
+			i--
+			dAtA[i] = 0x52
+		}
+	}
+	if len(m.Facets) > 0 {
+		for iNdEx := len(m.Facets) - 1; iNdEx >= 0; iNdEx-- {
 			{
-				size, err := m.Param[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				size, err := m.Facets[iNdEx].MarshalToSizedBuffer(dAtA[:i])
 				if err != nil {
 					return 0, err
 				}
@@ -9915,23 +9810,56 @@ func (m *FacetParams) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 				i = encodeVarintPb(dAtA, i, uint64(size))
 			}
 			i--
-			dAtA[i] = 0x12
+			dAtA[i] = 0x4a
 		}
 	}
-	if m.AllKeys {
+	if m.Op != 0 {
+		i = encodeVarintPb(dAtA, i, uint64(m.Op))
 		i--
-		if m.AllKeys {
-			dAtA[i] = 1
-		} else {
-			dAtA[i] = 0
-		}
+		dAtA[i] = 0x40
+	}
+	if len(m.Lang) > 0 {
+		i -= len(m.Lang)
+		copy(dAtA[i:], m.Lang)
+		i = encodeVarintPb(dAtA, i, uint64(len(m.Lang)))
 		i--
-		dAtA[i] = 0x8
+		dAtA[i] = 0x3a
+	}
+	if m.ValueId != 0 {
+		i -= 8
+		encoding_binary.LittleEndian.PutUint64(dAtA[i:], uint64(m.ValueId))
+		i--
+		dAtA[i] = 0x29
+	}
+	if m.ValueType != 0 {
+		i = encodeVarintPb(dAtA, i, uint64(m.ValueType))
+		i--
+		dAtA[i] = 0x20
+	}
+	if len(m.Value) > 0 {
+		i -= len(m.Value)
+		copy(dAtA[i:], m.Value)
+		i = encodeVarintPb(dAtA, i, uint64(len(m.Value)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Attr) > 0 {
+		i -= len(m.Attr)
+		copy(dAtA[i:], m.Attr)
+		i = encodeVarintPb(dAtA, i, uint64(len(m.Attr)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.Entity != 0 {
+		i -= 8
+		encoding_binary.LittleEndian.PutUint64(dAtA[i:], uint64(m.Entity))
+		i--
+		dAtA[i] = 0x9
 	}
 	return len(dAtA) - i, nil
 }
 
-func (m *Facets) Marshal() (dAtA []byte, err error) {
+func (m *Mutations) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -9941,20 +9869,20 @@ func (m *Facets) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *Facets) MarshalTo(dAtA []byte) (int, error) {
+func (m *Mutations) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *Facets) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *Mutations) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if len(m.Facets) > 0 {
-		for iNdEx := len(m.Facets) - 1; iNdEx >= 0; iNdEx-- {
+	if len(m.TtlMarks) > 0 {
+		for iNdEx := len(m.TtlMarks) - 1; iNdEx >= 0; iNdEx-- {
 			{
-				size, err := m.Facets[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				size, err := m.TtlMarks[iNdEx].MarshalToSizedBuffer(dAtA[:i])
 				if err != nil {
 					return 0, err
 				}
@@ -9962,36 +9890,15 @@ func (m *Facets) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 				i = encodeVarintPb(dAtA, i, uint64(size))
 			}
 			i--
-			dAtA[i] = 0xa
+			dAtA[i] = 0x1
+			i--
+			dAtA[i] = 0x92
 		}
 	}
-	return len(dAtA) - i, nil
-}
-
-func (m *FacetsList) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
-	}
-	return dAtA[:n], nil
-}
-
-func (m *FacetsList) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
-}
-
-func (m *FacetsList) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.FacetsList) > 0 {
-		for iNdEx := len(m.FacetsList) - 1; iNdEx >= 0; iNdEx-- {
+	if len(m.TriggerEvents) > 0 {
+		for iNdEx := len(m.TriggerEvents) - 1; iNdEx >= 0; iNdEx-- {
 			{
-				size, err := m.FacetsList[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				size, err := m.TriggerEvents[iNdEx].MarshalToSizedBuffer(dAtA[:i])
 				if err != nil {
 					return 0, err
 				}
@@ -9999,81 +9906,100 @@ func (m *FacetsList) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 				i = encodeVarintPb(dAtA, i, uint64(size))
 			}
 			i--
-			dAtA[i] = 0xa
+			dAtA[i] = 0x1
+			i--
+			dAtA[i] = 0x8a
 		}
 	}
-	return len(dAtA) - i, nil
-}
-
-func (m *Function) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+	if m.IfVersion != 0 {
+		i = encodeVarintPb(dAtA, i, uint64(m.IfVersion))
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0x80
 	}
-	return dAtA[:n], nil
-}
-
-func (m *Function) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
-}
-
-func (m *Function) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.Args) > 0 {
-		for iNdEx := len(m.Args) - 1; iNdEx >= 0; iNdEx-- {
-			i -= len(m.Args[iNdEx])
-			copy(dAtA[i:], m.Args[iNdEx])
-			i = encodeVarintPb(dAtA, i, uint64(len(m.Args[iNdEx])))
+	if len(m.CancelIndexing) > 0 {
+		for iNdEx := len(m.CancelIndexing) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.CancelIndexing[iNdEx])
+			copy(dAtA[i:], m.CancelIndexing[iNdEx])
+			i = encodeVarintPb(dAtA, i, uint64(len(m.CancelIndexing[iNdEx])))
 			i--
-			dAtA[i] = 0x1a
+			dAtA[i] = 0x7a
 		}
 	}
-	if len(m.Key) > 0 {
-		i -= len(m.Key)
-		copy(dAtA[i:], m.Key)
-		i = encodeVarintPb(dAtA, i, uint64(len(m.Key)))
-		i--
-		dAtA[i] = 0x12
+	if len(m.StoredQueries) > 0 {
+		for iNdEx := len(m.StoredQueries) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.StoredQueries[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintPb(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x72
+		}
 	}
-	if len(m.Name) > 0 {
-		i -= len(m.Name)
-		copy(dAtA[i:], m.Name)
-		i = encodeVarintPb(dAtA, i, uint64(len(m.Name)))
-		i--
-		dAtA[i] = 0xa
+	if len(m.Triggers) > 0 {
+		for iNdEx := len(m.Triggers) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Triggers[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintPb(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x6a
+		}
 	}
-	return len(dAtA) - i, nil
-}
-
-func (m *FilterTree) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+	if len(m.Tombstones) > 0 {
+		for iNdEx := len(m.Tombstones) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Tombstones[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintPb(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x62
+		}
 	}
-	return dAtA[:n], nil
-}
-
-func (m *FilterTree) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
-}
-
-func (m *FilterTree) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.Func != nil {
+	if len(m.SchemaVersions) > 0 {
+		for iNdEx := len(m.SchemaVersions) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.SchemaVersions[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintPb(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x5a
+		}
+	}
+	if len(m.Synonyms) > 0 {
+		for iNdEx := len(m.Synonyms) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Synonyms[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintPb(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x52
+		}
+	}
+	if m.Metadata != nil {
 		{
-			size, err := m.Func.MarshalToSizedBuffer(dAtA[:i])
+			size, err := m.Metadata.MarshalToSizedBuffer(dAtA[:i])
 			if err != nil {
 				return 0, err
 			}
@@ -10081,12 +10007,24 @@ func (m *FilterTree) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 			i = encodeVarintPb(dAtA, i, uint64(size))
 		}
 		i--
-		dAtA[i] = 0x1a
+		dAtA[i] = 0x4a
 	}
-	if len(m.Children) > 0 {
-		for iNdEx := len(m.Children) - 1; iNdEx >= 0; iNdEx-- {
+	if len(m.DropValue) > 0 {
+		i -= len(m.DropValue)
+		copy(dAtA[i:], m.DropValue)
+		i = encodeVarintPb(dAtA, i, uint64(len(m.DropValue)))
+		i--
+		dAtA[i] = 0x42
+	}
+	if m.DropOp != 0 {
+		i = encodeVarintPb(dAtA, i, uint64(m.DropOp))
+		i--
+		dAtA[i] = 0x38
+	}
+	if len(m.Types) > 0 {
+		for iNdEx := len(m.Types) - 1; iNdEx >= 0; iNdEx-- {
 			{
-				size, err := m.Children[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				size, err := m.Types[iNdEx].MarshalToSizedBuffer(dAtA[:i])
 				if err != nil {
 					return 0, err
 				}
@@ -10094,20 +10032,51 @@ func (m *FilterTree) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 				i = encodeVarintPb(dAtA, i, uint64(size))
 			}
 			i--
-			dAtA[i] = 0x12
+			dAtA[i] = 0x32
 		}
 	}
-	if len(m.Op) > 0 {
-		i -= len(m.Op)
-		copy(dAtA[i:], m.Op)
-		i = encodeVarintPb(dAtA, i, uint64(len(m.Op)))
+	if len(m.Schema) > 0 {
+		for iNdEx := len(m.Schema) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Schema[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintPb(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x22
+		}
+	}
+	if len(m.Edges) > 0 {
+		for iNdEx := len(m.Edges) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Edges[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintPb(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
+	if m.StartTs != 0 {
+		i = encodeVarintPb(dAtA, i, uint64(m.StartTs))
 		i--
-		dAtA[i] = 0xa
+		dAtA[i] = 0x10
+	}
+	if m.GroupId != 0 {
+		i = encodeVarintPb(dAtA, i, uint64(m.GroupId))
+		i--
+		dAtA[i] = 0x8
 	}
 	return len(dAtA) - i, nil
 }
 
-func (m *SchemaRequest) Marshal() (dAtA []byte, err error) {
+func (m *Metadata) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -10117,52 +10086,37 @@ func (m *SchemaRequest) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *SchemaRequest) MarshalTo(dAtA []byte) (int, error) {
+func (m *Metadata) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *SchemaRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *Metadata) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if len(m.Types) > 0 {
-		for iNdEx := len(m.Types) - 1; iNdEx >= 0; iNdEx-- {
-			i -= len(m.Types[iNdEx])
-			copy(dAtA[i:], m.Types[iNdEx])
-			i = encodeVarintPb(dAtA, i, uint64(len(m.Types[iNdEx])))
+	if len(m.PredHints) > 0 {
+		for k := range m.PredHints {
+			v := m.PredHints[k]
+			baseI := i
+			i = encodeVarintPb(dAtA, i, uint64(v))
 			i--
-			dAtA[i] = 0x22
-		}
-	}
-	if len(m.Fields) > 0 {
-		for iNdEx := len(m.Fields) - 1; iNdEx >= 0; iNdEx-- {
-			i -= len(m.Fields[iNdEx])
-			copy(dAtA[i:], m.Fields[iNdEx])
-			i = encodeVarintPb(dAtA, i, uint64(len(m.Fields[iNdEx])))
+			dAtA[i] = 0x10
+			i -= len(k)
+			copy(dAtA[i:], k)
+			i = encodeVarintPb(dAtA, i, uint64(len(k)))
 			i--
-			dAtA[i] = 0x1a
-		}
-	}
-	if len(m.Predicates) > 0 {
-		for iNdEx := len(m.Predicates) - 1; iNdEx >= 0; iNdEx-- {
-			i -= len(m.Predicates[iNdEx])
-			copy(dAtA[i:], m.Predicates[iNdEx])
-			i = encodeVarintPb(dAtA, i, uint64(len(m.Predicates[iNdEx])))
+			dAtA[i] = 0xa
+			i = encodeVarintPb(dAtA, i, uint64(baseI-i))
 			i--
-			dAtA[i] = 0x12
+			dAtA[i] = 0xa
 		}
 	}
-	if m.GroupId != 0 {
-		i = encodeVarintPb(dAtA, i, uint64(m.GroupId))
-		i--
-		dAtA[i] = 0x8
-	}
 	return len(dAtA) - i, nil
 }
 
-func (m *SchemaNode) Marshal() (dAtA []byte, err error) {
+func (m *Snapshot) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -10172,113 +10126,57 @@ func (m *SchemaNode) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *SchemaNode) MarshalTo(dAtA []byte) (int, error) {
+func (m *Snapshot) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *SchemaNode) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *Snapshot) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if m.NoConflict {
-		i--
-		if m.NoConflict {
-			dAtA[i] = 1
-		} else {
-			dAtA[i] = 0
-		}
+	if m.SinceTs != 0 {
+		i = encodeVarintPb(dAtA, i, uint64(m.SinceTs))
 		i--
-		dAtA[i] = 0x50
+		dAtA[i] = 0x28
 	}
-	if m.Lang {
+	if m.Done {
 		i--
-		if m.Lang {
+		if m.Done {
 			dAtA[i] = 1
 		} else {
 			dAtA[i] = 0
 		}
 		i--
-		dAtA[i] = 0x48
+		dAtA[i] = 0x20
 	}
-	if m.Upsert {
-		i--
-		if m.Upsert {
-			dAtA[i] = 1
-		} else {
-			dAtA[i] = 0
-		}
+	if m.ReadTs != 0 {
+		i = encodeVarintPb(dAtA, i, uint64(m.ReadTs))
 		i--
-		dAtA[i] = 0x40
+		dAtA[i] = 0x18
 	}
-	if m.List {
-		i--
-		if m.List {
-			dAtA[i] = 1
-		} else {
-			dAtA[i] = 0
-		}
+	if m.Index != 0 {
+		i = encodeVarintPb(dAtA, i, uint64(m.Index))
 		i--
-		dAtA[i] = 0x38
+		dAtA[i] = 0x10
 	}
-	if m.Count {
-		i--
-		if m.Count {
-			dAtA[i] = 1
-		} else {
-			dAtA[i] = 0
+	if m.Context != nil {
+		{
+			size, err := m.Context.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintPb(dAtA, i, uint64(size))
 		}
 		i--
-		dAtA[i] = 0x30
-	}
-	if m.Reverse {
-		i--
-		if m.Reverse {
-			dAtA[i] = 1
-		} else {
-			dAtA[i] = 0
-		}
-		i--
-		dAtA[i] = 0x28
-	}
-	if len(m.Tokenizer) > 0 {
-		for iNdEx := len(m.Tokenizer) - 1; iNdEx >= 0; iNdEx-- {
-			i -= len(m.Tokenizer[iNdEx])
-			copy(dAtA[i:], m.Tokenizer[iNdEx])
-			i = encodeVarintPb(dAtA, i, uint64(len(m.Tokenizer[iNdEx])))
-			i--
-			dAtA[i] = 0x22
-		}
-	}
-	if m.Index {
-		i--
-		if m.Index {
-			dAtA[i] = 1
-		} else {
-			dAtA[i] = 0
-		}
-		i--
-		dAtA[i] = 0x18
-	}
-	if len(m.Type) > 0 {
-		i -= len(m.Type)
-		copy(dAtA[i:], m.Type)
-		i = encodeVarintPb(dAtA, i, uint64(len(m.Type)))
-		i--
-		dAtA[i] = 0x12
-	}
-	if len(m.Predicate) > 0 {
-		i -= len(m.Predicate)
-		copy(dAtA[i:], m.Predicate)
-		i = encodeVarintPb(dAtA, i, uint64(len(m.Predicate)))
-		i--
-		dAtA[i] = 0xa
+		dAtA[i] = 0xa
 	}
 	return len(dAtA) - i, nil
 }
 
-func (m *SchemaResult) Marshal() (dAtA []byte, err error) {
+func (m *ZeroSnapshot) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -10288,34 +10186,42 @@ func (m *SchemaResult) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *SchemaResult) MarshalTo(dAtA []byte) (int, error) {
+func (m *ZeroSnapshot) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *SchemaResult) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *ZeroSnapshot) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if len(m.Schema) > 0 {
-		for iNdEx := len(m.Schema) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.Schema[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintPb(dAtA, i, uint64(size))
+	if m.State != nil {
+		{
+			size, err := m.State.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
 			}
-			i--
-			dAtA[i] = 0xa
+			i -= size
+			i = encodeVarintPb(dAtA, i, uint64(size))
 		}
+		i--
+		dAtA[i] = 0x2a
+	}
+	if m.CheckpointTs != 0 {
+		i = encodeVarintPb(dAtA, i, uint64(m.CheckpointTs))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.Index != 0 {
+		i = encodeVarintPb(dAtA, i, uint64(m.Index))
+		i--
+		dAtA[i] = 0x8
 	}
 	return len(dAtA) - i, nil
 }
 
-func (m *SchemaUpdate) Marshal() (dAtA []byte, err error) {
+func (m *RestoreRequest) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -10325,66 +10231,75 @@ func (m *SchemaUpdate) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *SchemaUpdate) MarshalTo(dAtA []byte) (int, error) {
+func (m *RestoreRequest) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *SchemaUpdate) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *RestoreRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if m.NoConflict {
+	if m.BackupNum != 0 {
+		i = encodeVarintPb(dAtA, i, uint64(m.BackupNum))
 		i--
-		if m.NoConflict {
-			dAtA[i] = 1
-		} else {
-			dAtA[i] = 0
-		}
+		dAtA[i] = 0x1
 		i--
-		dAtA[i] = 0x68
+		dAtA[i] = 0x80
 	}
-	if len(m.ObjectTypeName) > 0 {
-		i -= len(m.ObjectTypeName)
-		copy(dAtA[i:], m.ObjectTypeName)
-		i = encodeVarintPb(dAtA, i, uint64(len(m.ObjectTypeName)))
+	if len(m.VaultFormat) > 0 {
+		i -= len(m.VaultFormat)
+		copy(dAtA[i:], m.VaultFormat)
+		i = encodeVarintPb(dAtA, i, uint64(len(m.VaultFormat)))
 		i--
-		dAtA[i] = 0x62
+		dAtA[i] = 0x7a
 	}
-	if m.NonNullableList {
+	if len(m.VaultField) > 0 {
+		i -= len(m.VaultField)
+		copy(dAtA[i:], m.VaultField)
+		i = encodeVarintPb(dAtA, i, uint64(len(m.VaultField)))
 		i--
-		if m.NonNullableList {
-			dAtA[i] = 1
-		} else {
-			dAtA[i] = 0
-		}
+		dAtA[i] = 0x72
+	}
+	if len(m.VaultPath) > 0 {
+		i -= len(m.VaultPath)
+		copy(dAtA[i:], m.VaultPath)
+		i = encodeVarintPb(dAtA, i, uint64(len(m.VaultPath)))
 		i--
-		dAtA[i] = 0x58
+		dAtA[i] = 0x6a
 	}
-	if m.NonNullable {
+	if len(m.VaultSecretidFile) > 0 {
+		i -= len(m.VaultSecretidFile)
+		copy(dAtA[i:], m.VaultSecretidFile)
+		i = encodeVarintPb(dAtA, i, uint64(len(m.VaultSecretidFile)))
 		i--
-		if m.NonNullable {
-			dAtA[i] = 1
-		} else {
-			dAtA[i] = 0
-		}
+		dAtA[i] = 0x62
+	}
+	if len(m.VaultRoleidFile) > 0 {
+		i -= len(m.VaultRoleidFile)
+		copy(dAtA[i:], m.VaultRoleidFile)
+		i = encodeVarintPb(dAtA, i, uint64(len(m.VaultRoleidFile)))
 		i--
-		dAtA[i] = 0x50
+		dAtA[i] = 0x5a
 	}
-	if m.Lang {
+	if len(m.VaultAddr) > 0 {
+		i -= len(m.VaultAddr)
+		copy(dAtA[i:], m.VaultAddr)
+		i = encodeVarintPb(dAtA, i, uint64(len(m.VaultAddr)))
 		i--
-		if m.Lang {
-			dAtA[i] = 1
-		} else {
-			dAtA[i] = 0
-		}
+		dAtA[i] = 0x52
+	}
+	if len(m.EncryptionKeyFile) > 0 {
+		i -= len(m.EncryptionKeyFile)
+		copy(dAtA[i:], m.EncryptionKeyFile)
+		i = encodeVarintPb(dAtA, i, uint64(len(m.EncryptionKeyFile)))
 		i--
-		dAtA[i] = 0x48
+		dAtA[i] = 0x4a
 	}
-	if m.Upsert {
+	if m.Anonymous {
 		i--
-		if m.Upsert {
+		if m.Anonymous {
 			dAtA[i] = 1
 		} else {
 			dAtA[i] = 0
@@ -10392,56 +10307,55 @@ func (m *SchemaUpdate) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 		i--
 		dAtA[i] = 0x40
 	}
-	if m.List {
-		i--
-		if m.List {
-			dAtA[i] = 1
-		} else {
-			dAtA[i] = 0
-		}
+	if len(m.SessionToken) > 0 {
+		i -= len(m.SessionToken)
+		copy(dAtA[i:], m.SessionToken)
+		i = encodeVarintPb(dAtA, i, uint64(len(m.SessionToken)))
 		i--
-		dAtA[i] = 0x30
+		dAtA[i] = 0x3a
 	}
-	if m.Count {
+	if len(m.SecretKey) > 0 {
+		i -= len(m.SecretKey)
+		copy(dAtA[i:], m.SecretKey)
+		i = encodeVarintPb(dAtA, i, uint64(len(m.SecretKey)))
 		i--
-		if m.Count {
-			dAtA[i] = 1
-		} else {
-			dAtA[i] = 0
-		}
+		dAtA[i] = 0x32
+	}
+	if len(m.AccessKey) > 0 {
+		i -= len(m.AccessKey)
+		copy(dAtA[i:], m.AccessKey)
+		i = encodeVarintPb(dAtA, i, uint64(len(m.AccessKey)))
 		i--
-		dAtA[i] = 0x28
+		dAtA[i] = 0x2a
 	}
-	if len(m.Tokenizer) > 0 {
-		for iNdEx := len(m.Tokenizer) - 1; iNdEx >= 0; iNdEx-- {
-			i -= len(m.Tokenizer[iNdEx])
-			copy(dAtA[i:], m.Tokenizer[iNdEx])
-			i = encodeVarintPb(dAtA, i, uint64(len(m.Tokenizer[iNdEx])))
-			i--
-			dAtA[i] = 0x22
-		}
+	if len(m.BackupId) > 0 {
+		i -= len(m.BackupId)
+		copy(dAtA[i:], m.BackupId)
+		i = encodeVarintPb(dAtA, i, uint64(len(m.BackupId)))
+		i--
+		dAtA[i] = 0x22
 	}
-	if m.Directive != 0 {
-		i = encodeVarintPb(dAtA, i, uint64(m.Directive))
+	if len(m.Location) > 0 {
+		i -= len(m.Location)
+		copy(dAtA[i:], m.Location)
+		i = encodeVarintPb(dAtA, i, uint64(len(m.Location)))
 		i--
-		dAtA[i] = 0x18
+		dAtA[i] = 0x1a
 	}
-	if m.ValueType != 0 {
-		i = encodeVarintPb(dAtA, i, uint64(m.ValueType))
+	if m.RestoreTs != 0 {
+		i = encodeVarintPb(dAtA, i, uint64(m.RestoreTs))
 		i--
 		dAtA[i] = 0x10
 	}
-	if len(m.Predicate) > 0 {
-		i -= len(m.Predicate)
-		copy(dAtA[i:], m.Predicate)
-		i = encodeVarintPb(dAtA, i, uint64(len(m.Predicate)))
+	if m.GroupId != 0 {
+		i = encodeVarintPb(dAtA, i, uint64(m.GroupId))
 		i--
-		dAtA[i] = 0xa
+		dAtA[i] = 0x8
 	}
 	return len(dAtA) - i, nil
 }
 
-func (m *TypeUpdate) Marshal() (dAtA []byte, err error) {
+func (m *Proposal) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -10451,41 +10365,142 @@ func (m *TypeUpdate) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *TypeUpdate) MarshalTo(dAtA []byte) (int, error) {
+func (m *Proposal) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *TypeUpdate) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *Proposal) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if len(m.Fields) > 0 {
-		for iNdEx := len(m.Fields) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.Fields[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintPb(dAtA, i, uint64(size))
+	if m.StartTs != 0 {
+		i = encodeVarintPb(dAtA, i, uint64(m.StartTs))
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0x80
+	}
+	if m.DeleteNs != nil {
+		{
+			size, err := m.DeleteNs.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
 			}
-			i--
-			dAtA[i] = 0x12
+			i -= size
+			i = encodeVarintPb(dAtA, i, uint64(size))
 		}
+		i--
+		dAtA[i] = 0x72
 	}
-	if len(m.TypeName) > 0 {
-		i -= len(m.TypeName)
-		copy(dAtA[i:], m.TypeName)
-		i = encodeVarintPb(dAtA, i, uint64(len(m.TypeName)))
+	if m.CdcState != nil {
+		{
+			size, err := m.CdcState.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintPb(dAtA, i, uint64(size))
+		}
 		i--
-		dAtA[i] = 0xa
+		dAtA[i] = 0x6a
+	}
+	if m.Restore != nil {
+		{
+			size, err := m.Restore.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintPb(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x62
+	}
+	if m.ExpectedChecksum != 0 {
+		i = encodeVarintPb(dAtA, i, uint64(m.ExpectedChecksum))
+		i--
+		dAtA[i] = 0x58
+	}
+	if m.Index != 0 {
+		i = encodeVarintPb(dAtA, i, uint64(m.Index))
+		i--
+		dAtA[i] = 0x50
+	}
+	if m.Snapshot != nil {
+		{
+			size, err := m.Snapshot.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintPb(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x4a
+	}
+	if m.Delta != nil {
+		{
+			size, err := m.Delta.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintPb(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x42
+	}
+	if len(m.CleanPredicate) > 0 {
+		i -= len(m.CleanPredicate)
+		copy(dAtA[i:], m.CleanPredicate)
+		i = encodeVarintPb(dAtA, i, uint64(len(m.CleanPredicate)))
+		i--
+		dAtA[i] = 0x32
+	}
+	if m.State != nil {
+		{
+			size, err := m.State.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintPb(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x2a
+	}
+	if len(m.Kv) > 0 {
+		for iNdEx := len(m.Kv) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Kv[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintPb(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x22
+		}
+	}
+	if m.Mutations != nil {
+		{
+			size, err := m.Mutations.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintPb(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
 	}
 	return len(dAtA) - i, nil
 }
 
-func (m *MapHeader) Marshal() (dAtA []byte, err error) {
+func (m *CDCState) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -10495,29 +10510,25 @@ func (m *MapHeader) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *MapHeader) MarshalTo(dAtA []byte) (int, error) {
+func (m *CDCState) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *MapHeader) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *CDCState) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if len(m.PartitionKeys) > 0 {
-		for iNdEx := len(m.PartitionKeys) - 1; iNdEx >= 0; iNdEx-- {
-			i -= len(m.PartitionKeys[iNdEx])
-			copy(dAtA[i:], m.PartitionKeys[iNdEx])
-			i = encodeVarintPb(dAtA, i, uint64(len(m.PartitionKeys[iNdEx])))
-			i--
-			dAtA[i] = 0xa
-		}
+	if m.SentTs != 0 {
+		i = encodeVarintPb(dAtA, i, uint64(m.SentTs))
+		i--
+		dAtA[i] = 0x8
 	}
 	return len(dAtA) - i, nil
 }
 
-func (m *MovePredicatePayload) Marshal() (dAtA []byte, err error) {
+func (m *KVS) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -10527,47 +10538,55 @@ func (m *MovePredicatePayload) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *MovePredicatePayload) MarshalTo(dAtA []byte) (int, error) {
+func (m *KVS) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *MovePredicatePayload) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *KVS) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if m.ExpectedChecksum != 0 {
-		i = encodeVarintPb(dAtA, i, uint64(m.ExpectedChecksum))
+	if len(m.Data) > 0 {
+		i -= len(m.Data)
+		copy(dAtA[i:], m.Data)
+		i = encodeVarintPb(dAtA, i, uint64(len(m.Data)))
 		i--
-		dAtA[i] = 0x28
+		dAtA[i] = 0x2a
 	}
-	if m.TxnTs != 0 {
-		i = encodeVarintPb(dAtA, i, uint64(m.TxnTs))
-		i--
-		dAtA[i] = 0x20
+	if len(m.Types) > 0 {
+		for iNdEx := len(m.Types) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.Types[iNdEx])
+			copy(dAtA[i:], m.Types[iNdEx])
+			i = encodeVarintPb(dAtA, i, uint64(len(m.Types[iNdEx])))
+			i--
+			dAtA[i] = 0x22
+		}
 	}
-	if m.DestGid != 0 {
-		i = encodeVarintPb(dAtA, i, uint64(m.DestGid))
-		i--
-		dAtA[i] = 0x18
+	if len(m.Predicates) > 0 {
+		for iNdEx := len(m.Predicates) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.Predicates[iNdEx])
+			copy(dAtA[i:], m.Predicates[iNdEx])
+			i = encodeVarintPb(dAtA, i, uint64(len(m.Predicates[iNdEx])))
+			i--
+			dAtA[i] = 0x1a
+		}
 	}
-	if m.SourceGid != 0 {
-		i = encodeVarintPb(dAtA, i, uint64(m.SourceGid))
+	if m.Done {
 		i--
-		dAtA[i] = 0x10
-	}
-	if len(m.Predicate) > 0 {
-		i -= len(m.Predicate)
-		copy(dAtA[i:], m.Predicate)
-		i = encodeVarintPb(dAtA, i, uint64(len(m.Predicate)))
+		if m.Done {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
 		i--
-		dAtA[i] = 0xa
+		dAtA[i] = 0x10
 	}
 	return len(dAtA) - i, nil
 }
 
-func (m *TxnStatus) Marshal() (dAtA []byte, err error) {
+func (m *Posting) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -10577,12 +10596,12 @@ func (m *TxnStatus) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *TxnStatus) MarshalTo(dAtA []byte) (int, error) {
+func (m *Posting) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *TxnStatus) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *Posting) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
@@ -10590,17 +10609,66 @@ func (m *TxnStatus) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	if m.CommitTs != 0 {
 		i = encodeVarintPb(dAtA, i, uint64(m.CommitTs))
 		i--
-		dAtA[i] = 0x10
+		dAtA[i] = 0x70
 	}
 	if m.StartTs != 0 {
 		i = encodeVarintPb(dAtA, i, uint64(m.StartTs))
 		i--
-		dAtA[i] = 0x8
+		dAtA[i] = 0x68
+	}
+	if m.Op != 0 {
+		i = encodeVarintPb(dAtA, i, uint64(m.Op))
+		i--
+		dAtA[i] = 0x60
+	}
+	if len(m.Facets) > 0 {
+		for iNdEx := len(m.Facets) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Facets[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintPb(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x4a
+		}
+	}
+	if len(m.LangTag) > 0 {
+		i -= len(m.LangTag)
+		copy(dAtA[i:], m.LangTag)
+		i = encodeVarintPb(dAtA, i, uint64(len(m.LangTag)))
+		i--
+		dAtA[i] = 0x2a
+	}
+	if m.PostingType != 0 {
+		i = encodeVarintPb(dAtA, i, uint64(m.PostingType))
+		i--
+		dAtA[i] = 0x20
+	}
+	if m.ValType != 0 {
+		i = encodeVarintPb(dAtA, i, uint64(m.ValType))
+		i--
+		dAtA[i] = 0x18
+	}
+	if len(m.Value) > 0 {
+		i -= len(m.Value)
+		copy(dAtA[i:], m.Value)
+		i = encodeVarintPb(dAtA, i, uint64(len(m.Value)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.Uid != 0 {
+		i -= 8
+		encoding_binary.LittleEndian.PutUint64(dAtA[i:], uint64(m.Uid))
+		i--
+		dAtA[i] = 0x9
 	}
 	return len(dAtA) - i, nil
 }
 
-func (m *OracleDelta) Marshal() (dAtA []byte, err error) {
+func (m *UidBlock) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -10610,54 +10678,37 @@ func (m *OracleDelta) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *OracleDelta) MarshalTo(dAtA []byte) (int, error) {
+func (m *UidBlock) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *OracleDelta) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *UidBlock) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if len(m.GroupChecksums) > 0 {
-		for k := range m.GroupChecksums {
-			v := m.GroupChecksums[k]
-			baseI := i
-			i = encodeVarintPb(dAtA, i, uint64(v))
-			i--
-			dAtA[i] = 0x10
-			i = encodeVarintPb(dAtA, i, uint64(k))
-			i--
-			dAtA[i] = 0x8
-			i = encodeVarintPb(dAtA, i, uint64(baseI-i))
-			i--
-			dAtA[i] = 0x1a
-		}
+	if m.NumUids != 0 {
+		i = encodeVarintPb(dAtA, i, uint64(m.NumUids))
+		i--
+		dAtA[i] = 0x18
 	}
-	if m.MaxAssigned != 0 {
-		i = encodeVarintPb(dAtA, i, uint64(m.MaxAssigned))
+	if len(m.Deltas) > 0 {
+		i -= len(m.Deltas)
+		copy(dAtA[i:], m.Deltas)
+		i = encodeVarintPb(dAtA, i, uint64(len(m.Deltas)))
 		i--
-		dAtA[i] = 0x10
+		dAtA[i] = 0x12
 	}
-	if len(m.Txns) > 0 {
-		for iNdEx := len(m.Txns) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.Txns[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintPb(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0xa
-		}
+	if m.Base != 0 {
+		i = encodeVarintPb(dAtA, i, uint64(m.Base))
+		i--
+		dAtA[i] = 0x8
 	}
 	return len(dAtA) - i, nil
 }
 
-func (m *TxnTimestamps) Marshal() (dAtA []byte, err error) {
+func (m *UidPack) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -10667,38 +10718,46 @@ func (m *TxnTimestamps) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *TxnTimestamps) MarshalTo(dAtA []byte) (int, error) {
+func (m *UidPack) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *TxnTimestamps) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *UidPack) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if len(m.Ts) > 0 {
-		dAtA35 := make([]byte, len(m.Ts)*10)
-		var j34 int
-		for _, num := range m.Ts {
-			for num >= 1<<7 {
-				dAtA35[j34] = uint8(uint64(num)&0x7f | 0x80)
-				num >>= 7
-				j34++
+	if m.AllocRef != 0 {
+		i = encodeVarintPb(dAtA, i, uint64(m.AllocRef))
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0xb8
+	}
+	if len(m.Blocks) > 0 {
+		for iNdEx := len(m.Blocks) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Blocks[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintPb(dAtA, i, uint64(size))
 			}
-			dAtA35[j34] = uint8(num)
-			j34++
+			i--
+			dAtA[i] = 0x12
 		}
-		i -= j34
-		copy(dAtA[i:], dAtA35[:j34])
-		i = encodeVarintPb(dAtA, i, uint64(j34))
+	}
+	if m.BlockSize != 0 {
+		i = encodeVarintPb(dAtA, i, uint64(m.BlockSize))
 		i--
-		dAtA[i] = 0xa
+		dAtA[i] = 0x8
 	}
 	return len(dAtA) - i, nil
 }
 
-func (m *PeerResponse) Marshal() (dAtA []byte, err error) {
+func (m *PostingList) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -10708,30 +10767,69 @@ func (m *PeerResponse) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *PeerResponse) MarshalTo(dAtA []byte) (int, error) {
+func (m *PostingList) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *PeerResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *PostingList) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if m.Status {
+	if len(m.Splits) > 0 {
+		dAtA31 := make([]byte, len(m.Splits)*10)
+		var j30 int
+		for _, num := range m.Splits {
+			for num >= 1<<7 {
+				dAtA31[j30] = uint8(uint64(num)&0x7f | 0x80)
+				num >>= 7
+				j30++
+			}
+			dAtA31[j30] = uint8(num)
+			j30++
+		}
+		i -= j30
+		copy(dAtA[i:], dAtA31[:j30])
+		i = encodeVarintPb(dAtA, i, uint64(j30))
 		i--
-		if m.Status {
-			dAtA[i] = 1
-		} else {
-			dAtA[i] = 0
+		dAtA[i] = 0x22
+	}
+	if m.CommitTs != 0 {
+		i = encodeVarintPb(dAtA, i, uint64(m.CommitTs))
+		i--
+		dAtA[i] = 0x18
+	}
+	if len(m.Postings) > 0 {
+		for iNdEx := len(m.Postings) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Postings[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintPb(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if m.Pack != nil {
+		{
+			size, err := m.Pack.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintPb(dAtA, i, uint64(size))
 		}
 		i--
-		dAtA[i] = 0x8
+		dAtA[i] = 0xa
 	}
 	return len(dAtA) - i, nil
 }
 
-func (m *RaftBatch) Marshal() (dAtA []byte, err error) {
+func (m *FacetParam) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -10741,44 +10839,34 @@ func (m *RaftBatch) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *RaftBatch) MarshalTo(dAtA []byte) (int, error) {
+func (m *FacetParam) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *RaftBatch) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *FacetParam) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if m.Payload != nil {
-		{
-			size, err := m.Payload.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintPb(dAtA, i, uint64(size))
-		}
+	if len(m.Alias) > 0 {
+		i -= len(m.Alias)
+		copy(dAtA[i:], m.Alias)
+		i = encodeVarintPb(dAtA, i, uint64(len(m.Alias)))
 		i--
 		dAtA[i] = 0x12
 	}
-	if m.Context != nil {
-		{
-			size, err := m.Context.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintPb(dAtA, i, uint64(size))
-		}
+	if len(m.Key) > 0 {
+		i -= len(m.Key)
+		copy(dAtA[i:], m.Key)
+		i = encodeVarintPb(dAtA, i, uint64(len(m.Key)))
 		i--
 		dAtA[i] = 0xa
 	}
 	return len(dAtA) - i, nil
 }
 
-func (m *TabletResponse) Marshal() (dAtA []byte, err error) {
+func (m *FacetParams) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -10788,20 +10876,20 @@ func (m *TabletResponse) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *TabletResponse) MarshalTo(dAtA []byte) (int, error) {
+func (m *FacetParams) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *TabletResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *FacetParams) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if len(m.Tablets) > 0 {
-		for iNdEx := len(m.Tablets) - 1; iNdEx >= 0; iNdEx-- {
+	if len(m.Param) > 0 {
+		for iNdEx := len(m.Param) - 1; iNdEx >= 0; iNdEx-- {
 			{
-				size, err := m.Tablets[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				size, err := m.Param[iNdEx].MarshalToSizedBuffer(dAtA[:i])
 				if err != nil {
 					return 0, err
 				}
@@ -10809,13 +10897,23 @@ func (m *TabletResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 				i = encodeVarintPb(dAtA, i, uint64(size))
 			}
 			i--
-			dAtA[i] = 0xa
+			dAtA[i] = 0x12
+		}
+	}
+	if m.AllKeys {
+		i--
+		if m.AllKeys {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
 		}
+		i--
+		dAtA[i] = 0x8
 	}
 	return len(dAtA) - i, nil
 }
 
-func (m *TabletRequest) Marshal() (dAtA []byte, err error) {
+func (m *Facets) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -10825,25 +10923,20 @@ func (m *TabletRequest) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *TabletRequest) MarshalTo(dAtA []byte) (int, error) {
+func (m *Facets) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *TabletRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *Facets) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if m.GroupId != 0 {
-		i = encodeVarintPb(dAtA, i, uint64(m.GroupId))
-		i--
-		dAtA[i] = 0x10
-	}
-	if len(m.Tablets) > 0 {
-		for iNdEx := len(m.Tablets) - 1; iNdEx >= 0; iNdEx-- {
+	if len(m.Facets) > 0 {
+		for iNdEx := len(m.Facets) - 1; iNdEx >= 0; iNdEx-- {
 			{
-				size, err := m.Tablets[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				size, err := m.Facets[iNdEx].MarshalToSizedBuffer(dAtA[:i])
 				if err != nil {
 					return 0, err
 				}
@@ -10857,7 +10950,7 @@ func (m *TabletRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
-func (m *SubscriptionRequest) Marshal() (dAtA []byte, err error) {
+func (m *FacetsList) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -10867,20 +10960,20 @@ func (m *SubscriptionRequest) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *SubscriptionRequest) MarshalTo(dAtA []byte) (int, error) {
+func (m *FacetsList) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *SubscriptionRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *FacetsList) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if len(m.Matches) > 0 {
-		for iNdEx := len(m.Matches) - 1; iNdEx >= 0; iNdEx-- {
+	if len(m.FacetsList) > 0 {
+		for iNdEx := len(m.FacetsList) - 1; iNdEx >= 0; iNdEx-- {
 			{
-				size, err := m.Matches[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				size, err := m.FacetsList[iNdEx].MarshalToSizedBuffer(dAtA[:i])
 				if err != nil {
 					return 0, err
 				}
@@ -10888,22 +10981,13 @@ func (m *SubscriptionRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 				i = encodeVarintPb(dAtA, i, uint64(size))
 			}
 			i--
-			dAtA[i] = 0x12
-		}
-	}
-	if len(m.Prefixes) > 0 {
-		for iNdEx := len(m.Prefixes) - 1; iNdEx >= 0; iNdEx-- {
-			i -= len(m.Prefixes[iNdEx])
-			copy(dAtA[i:], m.Prefixes[iNdEx])
-			i = encodeVarintPb(dAtA, i, uint64(len(m.Prefixes[iNdEx])))
-			i--
 			dAtA[i] = 0xa
 		}
 	}
 	return len(dAtA) - i, nil
 }
 
-func (m *SubscriptionResponse) Marshal() (dAtA []byte, err error) {
+func (m *Function) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -10913,32 +10997,43 @@ func (m *SubscriptionResponse) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *SubscriptionResponse) MarshalTo(dAtA []byte) (int, error) {
+func (m *Function) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *SubscriptionResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *Function) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if m.Kvs != nil {
-		{
-			size, err := m.Kvs.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintPb(dAtA, i, uint64(size))
+	if len(m.Args) > 0 {
+		for iNdEx := len(m.Args) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.Args[iNdEx])
+			copy(dAtA[i:], m.Args[iNdEx])
+			i = encodeVarintPb(dAtA, i, uint64(len(m.Args[iNdEx])))
+			i--
+			dAtA[i] = 0x1a
 		}
+	}
+	if len(m.Key) > 0 {
+		i -= len(m.Key)
+		copy(dAtA[i:], m.Key)
+		i = encodeVarintPb(dAtA, i, uint64(len(m.Key)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Name) > 0 {
+		i -= len(m.Name)
+		copy(dAtA[i:], m.Name)
+		i = encodeVarintPb(dAtA, i, uint64(len(m.Name)))
 		i--
 		dAtA[i] = 0xa
 	}
 	return len(dAtA) - i, nil
 }
 
-func (m *Num) Marshal() (dAtA []byte, err error) {
+func (m *FilterTree) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -10948,50 +11043,53 @@ func (m *Num) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *Num) MarshalTo(dAtA []byte) (int, error) {
+func (m *FilterTree) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *Num) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *FilterTree) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if m.Type != 0 {
-		i = encodeVarintPb(dAtA, i, uint64(m.Type))
-		i--
-		dAtA[i] = 0x20
-	}
-	if m.Forwarded {
-		i--
-		if m.Forwarded {
-			dAtA[i] = 1
-		} else {
-			dAtA[i] = 0
+	if m.Func != nil {
+		{
+			size, err := m.Func.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintPb(dAtA, i, uint64(size))
 		}
 		i--
-		dAtA[i] = 0x18
+		dAtA[i] = 0x1a
 	}
-	if m.ReadOnly {
-		i--
-		if m.ReadOnly {
-			dAtA[i] = 1
-		} else {
-			dAtA[i] = 0
+	if len(m.Children) > 0 {
+		for iNdEx := len(m.Children) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Children[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintPb(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
 		}
-		i--
-		dAtA[i] = 0x10
 	}
-	if m.Val != 0 {
-		i = encodeVarintPb(dAtA, i, uint64(m.Val))
+	if len(m.Op) > 0 {
+		i -= len(m.Op)
+		copy(dAtA[i:], m.Op)
+		i = encodeVarintPb(dAtA, i, uint64(len(m.Op)))
 		i--
-		dAtA[i] = 0x8
+		dAtA[i] = 0xa
 	}
 	return len(dAtA) - i, nil
 }
 
-func (m *AssignedIds) Marshal() (dAtA []byte, err error) {
+func (m *SchemaRequest) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -11001,35 +11099,52 @@ func (m *AssignedIds) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *AssignedIds) MarshalTo(dAtA []byte) (int, error) {
+func (m *SchemaRequest) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *AssignedIds) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *SchemaRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if m.ReadOnly != 0 {
-		i = encodeVarintPb(dAtA, i, uint64(m.ReadOnly))
-		i--
-		dAtA[i] = 0x28
+	if len(m.Types) > 0 {
+		for iNdEx := len(m.Types) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.Types[iNdEx])
+			copy(dAtA[i:], m.Types[iNdEx])
+			i = encodeVarintPb(dAtA, i, uint64(len(m.Types[iNdEx])))
+			i--
+			dAtA[i] = 0x22
+		}
 	}
-	if m.EndId != 0 {
-		i = encodeVarintPb(dAtA, i, uint64(m.EndId))
-		i--
-		dAtA[i] = 0x10
+	if len(m.Fields) > 0 {
+		for iNdEx := len(m.Fields) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.Fields[iNdEx])
+			copy(dAtA[i:], m.Fields[iNdEx])
+			i = encodeVarintPb(dAtA, i, uint64(len(m.Fields[iNdEx])))
+			i--
+			dAtA[i] = 0x1a
+		}
 	}
-	if m.StartId != 0 {
-		i = encodeVarintPb(dAtA, i, uint64(m.StartId))
+	if len(m.Predicates) > 0 {
+		for iNdEx := len(m.Predicates) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.Predicates[iNdEx])
+			copy(dAtA[i:], m.Predicates[iNdEx])
+			i = encodeVarintPb(dAtA, i, uint64(len(m.Predicates[iNdEx])))
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if m.GroupId != 0 {
+		i = encodeVarintPb(dAtA, i, uint64(m.GroupId))
 		i--
 		dAtA[i] = 0x8
 	}
 	return len(dAtA) - i, nil
 }
 
-func (m *RemoveNodeRequest) Marshal() (dAtA []byte, err error) {
+func (m *SchemaNode) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -11039,30 +11154,130 @@ func (m *RemoveNodeRequest) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *RemoveNodeRequest) MarshalTo(dAtA []byte) (int, error) {
+func (m *SchemaNode) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *RemoveNodeRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *SchemaNode) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if m.GroupId != 0 {
-		i = encodeVarintPb(dAtA, i, uint64(m.GroupId))
+	if len(m.Ttl) > 0 {
+		i -= len(m.Ttl)
+		copy(dAtA[i:], m.Ttl)
+		i = encodeVarintPb(dAtA, i, uint64(len(m.Ttl)))
 		i--
-		dAtA[i] = 0x10
+		dAtA[i] = 0x62
 	}
-	if m.NodeId != 0 {
-		i = encodeVarintPb(dAtA, i, uint64(m.NodeId))
+	if m.Ordered {
 		i--
-		dAtA[i] = 0x8
+		if m.Ordered {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x58
+	}
+	if m.NoConflict {
+		i--
+		if m.NoConflict {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x50
+	}
+	if m.Lang {
+		i--
+		if m.Lang {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x48
+	}
+	if m.Upsert {
+		i--
+		if m.Upsert {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x40
+	}
+	if m.List {
+		i--
+		if m.List {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x38
+	}
+	if m.Count {
+		i--
+		if m.Count {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x30
+	}
+	if m.Reverse {
+		i--
+		if m.Reverse {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x28
+	}
+	if len(m.Tokenizer) > 0 {
+		for iNdEx := len(m.Tokenizer) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.Tokenizer[iNdEx])
+			copy(dAtA[i:], m.Tokenizer[iNdEx])
+			i = encodeVarintPb(dAtA, i, uint64(len(m.Tokenizer[iNdEx])))
+			i--
+			dAtA[i] = 0x22
+		}
+	}
+	if m.Index {
+		i--
+		if m.Index {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x18
+	}
+	if len(m.Type) > 0 {
+		i -= len(m.Type)
+		copy(dAtA[i:], m.Type)
+		i = encodeVarintPb(dAtA, i, uint64(len(m.Type)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Predicate) > 0 {
+		i -= len(m.Predicate)
+		copy(dAtA[i:], m.Predicate)
+		i = encodeVarintPb(dAtA, i, uint64(len(m.Predicate)))
+		i--
+		dAtA[i] = 0xa
 	}
 	return len(dAtA) - i, nil
 }
 
-func (m *MoveTabletRequest) Marshal() (dAtA []byte, err error) {
+func (m *SchemaResult) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -11072,37 +11287,34 @@ func (m *MoveTabletRequest) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *MoveTabletRequest) MarshalTo(dAtA []byte) (int, error) {
+func (m *SchemaResult) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *MoveTabletRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *SchemaResult) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if m.DstGroup != 0 {
-		i = encodeVarintPb(dAtA, i, uint64(m.DstGroup))
-		i--
-		dAtA[i] = 0x18
-	}
-	if len(m.Tablet) > 0 {
-		i -= len(m.Tablet)
-		copy(dAtA[i:], m.Tablet)
-		i = encodeVarintPb(dAtA, i, uint64(len(m.Tablet)))
-		i--
-		dAtA[i] = 0x12
-	}
-	if m.Namespace != 0 {
-		i = encodeVarintPb(dAtA, i, uint64(m.Namespace))
-		i--
-		dAtA[i] = 0x8
+	if len(m.Schema) > 0 {
+		for iNdEx := len(m.Schema) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Schema[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintPb(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
 	}
 	return len(dAtA) - i, nil
 }
 
-func (m *ApplyLicenseRequest) Marshal() (dAtA []byte, err error) {
+func (m *SchemaUpdate) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -11112,117 +11324,51 @@ func (m *ApplyLicenseRequest) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *ApplyLicenseRequest) MarshalTo(dAtA []byte) (int, error) {
+func (m *SchemaUpdate) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *ApplyLicenseRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *SchemaUpdate) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if len(m.License) > 0 {
-		i -= len(m.License)
-		copy(dAtA[i:], m.License)
-		i = encodeVarintPb(dAtA, i, uint64(len(m.License)))
+	if m.Ttl != 0 {
+		i = encodeVarintPb(dAtA, i, uint64(m.Ttl))
 		i--
-		dAtA[i] = 0xa
-	}
-	return len(dAtA) - i, nil
-}
-
-func (m *SnapshotMeta) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+		dAtA[i] = 0x78
 	}
-	return dAtA[:n], nil
-}
-
-func (m *SnapshotMeta) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
-}
-
-func (m *SnapshotMeta) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.GroupId != 0 {
-		i = encodeVarintPb(dAtA, i, uint64(m.GroupId))
+	if m.Ordered {
 		i--
-		dAtA[i] = 0x10
-	}
-	if m.ClientTs != 0 {
-		i = encodeVarintPb(dAtA, i, uint64(m.ClientTs))
+		if m.Ordered {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
 		i--
-		dAtA[i] = 0x8
-	}
-	return len(dAtA) - i, nil
-}
-
-func (m *Status) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+		dAtA[i] = 0x70
 	}
-	return dAtA[:n], nil
-}
-
-func (m *Status) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
-}
-
-func (m *Status) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.Msg) > 0 {
-		i -= len(m.Msg)
-		copy(dAtA[i:], m.Msg)
-		i = encodeVarintPb(dAtA, i, uint64(len(m.Msg)))
+	if m.NoConflict {
 		i--
-		dAtA[i] = 0x12
-	}
-	if m.Code != 0 {
-		i = encodeVarintPb(dAtA, i, uint64(m.Code))
+		if m.NoConflict {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
 		i--
-		dAtA[i] = 0x8
+		dAtA[i] = 0x68
 	}
-	return len(dAtA) - i, nil
-}
-
-func (m *BackupRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+	if len(m.ObjectTypeName) > 0 {
+		i -= len(m.ObjectTypeName)
+		copy(dAtA[i:], m.ObjectTypeName)
+		i = encodeVarintPb(dAtA, i, uint64(len(m.ObjectTypeName)))
+		i--
+		dAtA[i] = 0x62
 	}
-	return dAtA[:n], nil
-}
-
-func (m *BackupRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
-}
-
-func (m *BackupRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.ForceFull {
+	if m.NonNullableList {
 		i--
-		if m.ForceFull {
+		if m.NonNullableList {
 			dAtA[i] = 1
 		} else {
 			dAtA[i] = 0
@@ -11230,18 +11376,19 @@ func (m *BackupRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 		i--
 		dAtA[i] = 0x58
 	}
-	if len(m.Predicates) > 0 {
-		for iNdEx := len(m.Predicates) - 1; iNdEx >= 0; iNdEx-- {
-			i -= len(m.Predicates[iNdEx])
-			copy(dAtA[i:], m.Predicates[iNdEx])
-			i = encodeVarintPb(dAtA, i, uint64(len(m.Predicates[iNdEx])))
-			i--
-			dAtA[i] = 0x52
+	if m.NonNullable {
+		i--
+		if m.NonNullable {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
 		}
+		i--
+		dAtA[i] = 0x50
 	}
-	if m.Anonymous {
+	if m.Lang {
 		i--
-		if m.Anonymous {
+		if m.Lang {
 			dAtA[i] = 1
 		} else {
 			dAtA[i] = 0
@@ -11249,60 +11396,66 @@ func (m *BackupRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 		i--
 		dAtA[i] = 0x48
 	}
-	if len(m.SessionToken) > 0 {
-		i -= len(m.SessionToken)
-		copy(dAtA[i:], m.SessionToken)
-		i = encodeVarintPb(dAtA, i, uint64(len(m.SessionToken)))
+	if m.Upsert {
 		i--
-		dAtA[i] = 0x42
-	}
-	if len(m.SecretKey) > 0 {
-		i -= len(m.SecretKey)
-		copy(dAtA[i:], m.SecretKey)
-		i = encodeVarintPb(dAtA, i, uint64(len(m.SecretKey)))
+		if m.Upsert {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
 		i--
-		dAtA[i] = 0x3a
+		dAtA[i] = 0x40
 	}
-	if len(m.AccessKey) > 0 {
-		i -= len(m.AccessKey)
-		copy(dAtA[i:], m.AccessKey)
-		i = encodeVarintPb(dAtA, i, uint64(len(m.AccessKey)))
+	if m.List {
 		i--
-		dAtA[i] = 0x32
-	}
-	if len(m.Destination) > 0 {
-		i -= len(m.Destination)
-		copy(dAtA[i:], m.Destination)
-		i = encodeVarintPb(dAtA, i, uint64(len(m.Destination)))
+		if m.List {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
 		i--
-		dAtA[i] = 0x2a
+		dAtA[i] = 0x30
 	}
-	if len(m.UnixTs) > 0 {
-		i -= len(m.UnixTs)
-		copy(dAtA[i:], m.UnixTs)
-		i = encodeVarintPb(dAtA, i, uint64(len(m.UnixTs)))
+	if m.Count {
 		i--
-		dAtA[i] = 0x22
+		if m.Count {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x28
 	}
-	if m.GroupId != 0 {
-		i = encodeVarintPb(dAtA, i, uint64(m.GroupId))
+	if len(m.Tokenizer) > 0 {
+		for iNdEx := len(m.Tokenizer) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.Tokenizer[iNdEx])
+			copy(dAtA[i:], m.Tokenizer[iNdEx])
+			i = encodeVarintPb(dAtA, i, uint64(len(m.Tokenizer[iNdEx])))
+			i--
+			dAtA[i] = 0x22
+		}
+	}
+	if m.Directive != 0 {
+		i = encodeVarintPb(dAtA, i, uint64(m.Directive))
 		i--
 		dAtA[i] = 0x18
 	}
-	if m.SinceTs != 0 {
-		i = encodeVarintPb(dAtA, i, uint64(m.SinceTs))
+	if m.ValueType != 0 {
+		i = encodeVarintPb(dAtA, i, uint64(m.ValueType))
 		i--
 		dAtA[i] = 0x10
 	}
-	if m.ReadTs != 0 {
-		i = encodeVarintPb(dAtA, i, uint64(m.ReadTs))
+	if len(m.Predicate) > 0 {
+		i -= len(m.Predicate)
+		copy(dAtA[i:], m.Predicate)
+		i = encodeVarintPb(dAtA, i, uint64(len(m.Predicate)))
 		i--
-		dAtA[i] = 0x8
+		dAtA[i] = 0xa
 	}
 	return len(dAtA) - i, nil
 }
 
-func (m *BackupResponse) Marshal() (dAtA []byte, err error) {
+func (m *TypeUpdate) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -11312,20 +11465,42 @@ func (m *BackupResponse) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *BackupResponse) MarshalTo(dAtA []byte) (int, error) {
+func (m *TypeUpdate) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *BackupResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *TypeUpdate) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if len(m.DropOperations) > 0 {
-		for iNdEx := len(m.DropOperations) - 1; iNdEx >= 0; iNdEx-- {
+	if m.Ttl != 0 {
+		i = encodeVarintPb(dAtA, i, uint64(m.Ttl))
+		i--
+		dAtA[i] = 0x28
+	}
+	if len(m.Extends) > 0 {
+		i -= len(m.Extends)
+		copy(dAtA[i:], m.Extends)
+		i = encodeVarintPb(dAtA, i, uint64(len(m.Extends)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if m.Strict {
+		i--
+		if m.Strict {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x18
+	}
+	if len(m.Fields) > 0 {
+		for iNdEx := len(m.Fields) - 1; iNdEx >= 0; iNdEx-- {
 			{
-				size, err := m.DropOperations[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				size, err := m.Fields[iNdEx].MarshalToSizedBuffer(dAtA[:i])
 				if err != nil {
 					return 0, err
 				}
@@ -11333,13 +11508,20 @@ func (m *BackupResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 				i = encodeVarintPb(dAtA, i, uint64(size))
 			}
 			i--
-			dAtA[i] = 0xa
+			dAtA[i] = 0x12
 		}
 	}
+	if len(m.TypeName) > 0 {
+		i -= len(m.TypeName)
+		copy(dAtA[i:], m.TypeName)
+		i = encodeVarintPb(dAtA, i, uint64(len(m.TypeName)))
+		i--
+		dAtA[i] = 0xa
+	}
 	return len(dAtA) - i, nil
 }
 
-func (m *DropOperation) Marshal() (dAtA []byte, err error) {
+func (m *MapHeader) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -11349,32 +11531,29 @@ func (m *DropOperation) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *DropOperation) MarshalTo(dAtA []byte) (int, error) {
+func (m *MapHeader) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *DropOperation) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *MapHeader) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if len(m.DropValue) > 0 {
-		i -= len(m.DropValue)
-		copy(dAtA[i:], m.DropValue)
-		i = encodeVarintPb(dAtA, i, uint64(len(m.DropValue)))
-		i--
-		dAtA[i] = 0x12
-	}
-	if m.DropOp != 0 {
-		i = encodeVarintPb(dAtA, i, uint64(m.DropOp))
-		i--
-		dAtA[i] = 0x8
+	if len(m.PartitionKeys) > 0 {
+		for iNdEx := len(m.PartitionKeys) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.PartitionKeys[iNdEx])
+			copy(dAtA[i:], m.PartitionKeys[iNdEx])
+			i = encodeVarintPb(dAtA, i, uint64(len(m.PartitionKeys[iNdEx])))
+			i--
+			dAtA[i] = 0xa
+		}
 	}
 	return len(dAtA) - i, nil
 }
 
-func (m *ExportRequest) Marshal() (dAtA []byte, err error) {
+func (m *MovePredicatePayload) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -11384,85 +11563,47 @@ func (m *ExportRequest) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *ExportRequest) MarshalTo(dAtA []byte) (int, error) {
+func (m *MovePredicatePayload) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *ExportRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *MovePredicatePayload) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if m.Namespace != 0 {
-		i = encodeVarintPb(dAtA, i, uint64(m.Namespace))
-		i--
-		dAtA[i] = 0x50
-	}
-	if m.Anonymous {
-		i--
-		if m.Anonymous {
-			dAtA[i] = 1
-		} else {
-			dAtA[i] = 0
-		}
-		i--
-		dAtA[i] = 0x48
-	}
-	if len(m.SessionToken) > 0 {
-		i -= len(m.SessionToken)
-		copy(dAtA[i:], m.SessionToken)
-		i = encodeVarintPb(dAtA, i, uint64(len(m.SessionToken)))
-		i--
-		dAtA[i] = 0x42
-	}
-	if len(m.SecretKey) > 0 {
-		i -= len(m.SecretKey)
-		copy(dAtA[i:], m.SecretKey)
-		i = encodeVarintPb(dAtA, i, uint64(len(m.SecretKey)))
-		i--
-		dAtA[i] = 0x3a
-	}
-	if len(m.AccessKey) > 0 {
-		i -= len(m.AccessKey)
-		copy(dAtA[i:], m.AccessKey)
-		i = encodeVarintPb(dAtA, i, uint64(len(m.AccessKey)))
-		i--
-		dAtA[i] = 0x32
-	}
-	if len(m.Destination) > 0 {
-		i -= len(m.Destination)
-		copy(dAtA[i:], m.Destination)
-		i = encodeVarintPb(dAtA, i, uint64(len(m.Destination)))
+	if m.ExpectedChecksum != 0 {
+		i = encodeVarintPb(dAtA, i, uint64(m.ExpectedChecksum))
 		i--
-		dAtA[i] = 0x2a
+		dAtA[i] = 0x28
 	}
-	if len(m.Format) > 0 {
-		i -= len(m.Format)
-		copy(dAtA[i:], m.Format)
-		i = encodeVarintPb(dAtA, i, uint64(len(m.Format)))
+	if m.TxnTs != 0 {
+		i = encodeVarintPb(dAtA, i, uint64(m.TxnTs))
 		i--
-		dAtA[i] = 0x22
+		dAtA[i] = 0x20
 	}
-	if m.UnixTs != 0 {
-		i = encodeVarintPb(dAtA, i, uint64(m.UnixTs))
+	if m.DestGid != 0 {
+		i = encodeVarintPb(dAtA, i, uint64(m.DestGid))
 		i--
 		dAtA[i] = 0x18
 	}
-	if m.ReadTs != 0 {
-		i = encodeVarintPb(dAtA, i, uint64(m.ReadTs))
+	if m.SourceGid != 0 {
+		i = encodeVarintPb(dAtA, i, uint64(m.SourceGid))
 		i--
 		dAtA[i] = 0x10
 	}
-	if m.GroupId != 0 {
-		i = encodeVarintPb(dAtA, i, uint64(m.GroupId))
+	if len(m.Predicate) > 0 {
+		i -= len(m.Predicate)
+		copy(dAtA[i:], m.Predicate)
+		i = encodeVarintPb(dAtA, i, uint64(len(m.Predicate)))
 		i--
-		dAtA[i] = 0x8
+		dAtA[i] = 0xa
 	}
 	return len(dAtA) - i, nil
 }
 
-func (m *ExportResponse) Marshal() (dAtA []byte, err error) {
+func (m *TxnStatus) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -11472,41 +11613,30 @@ func (m *ExportResponse) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *ExportResponse) MarshalTo(dAtA []byte) (int, error) {
+func (m *TxnStatus) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *ExportResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *TxnStatus) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if len(m.Files) > 0 {
-		for iNdEx := len(m.Files) - 1; iNdEx >= 0; iNdEx-- {
-			i -= len(m.Files[iNdEx])
-			copy(dAtA[i:], m.Files[iNdEx])
-			i = encodeVarintPb(dAtA, i, uint64(len(m.Files[iNdEx])))
-			i--
-			dAtA[i] = 0x1a
-		}
-	}
-	if len(m.Msg) > 0 {
-		i -= len(m.Msg)
-		copy(dAtA[i:], m.Msg)
-		i = encodeVarintPb(dAtA, i, uint64(len(m.Msg)))
+	if m.CommitTs != 0 {
+		i = encodeVarintPb(dAtA, i, uint64(m.CommitTs))
 		i--
-		dAtA[i] = 0x12
+		dAtA[i] = 0x10
 	}
-	if m.Code != 0 {
-		i = encodeVarintPb(dAtA, i, uint64(m.Code))
+	if m.StartTs != 0 {
+		i = encodeVarintPb(dAtA, i, uint64(m.StartTs))
 		i--
 		dAtA[i] = 0x8
 	}
 	return len(dAtA) - i, nil
 }
 
-func (m *BackupKey) Marshal() (dAtA []byte, err error) {
+func (m *OracleDelta) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -11516,59 +11646,54 @@ func (m *BackupKey) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *BackupKey) MarshalTo(dAtA []byte) (int, error) {
+func (m *OracleDelta) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *BackupKey) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *OracleDelta) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if m.Namespace != 0 {
-		i = encodeVarintPb(dAtA, i, uint64(m.Namespace))
-		i--
-		dAtA[i] = 0x38
-	}
-	if m.Count != 0 {
-		i = encodeVarintPb(dAtA, i, uint64(m.Count))
-		i--
-		dAtA[i] = 0x30
-	}
-	if len(m.Term) > 0 {
-		i -= len(m.Term)
-		copy(dAtA[i:], m.Term)
-		i = encodeVarintPb(dAtA, i, uint64(len(m.Term)))
-		i--
-		dAtA[i] = 0x2a
-	}
-	if m.StartUid != 0 {
-		i = encodeVarintPb(dAtA, i, uint64(m.StartUid))
-		i--
-		dAtA[i] = 0x20
-	}
-	if m.Uid != 0 {
-		i = encodeVarintPb(dAtA, i, uint64(m.Uid))
-		i--
-		dAtA[i] = 0x18
+	if len(m.GroupChecksums) > 0 {
+		for k := range m.GroupChecksums {
+			v := m.GroupChecksums[k]
+			baseI := i
+			i = encodeVarintPb(dAtA, i, uint64(v))
+			i--
+			dAtA[i] = 0x10
+			i = encodeVarintPb(dAtA, i, uint64(k))
+			i--
+			dAtA[i] = 0x8
+			i = encodeVarintPb(dAtA, i, uint64(baseI-i))
+			i--
+			dAtA[i] = 0x1a
+		}
 	}
-	if len(m.Attr) > 0 {
-		i -= len(m.Attr)
-		copy(dAtA[i:], m.Attr)
-		i = encodeVarintPb(dAtA, i, uint64(len(m.Attr)))
+	if m.MaxAssigned != 0 {
+		i = encodeVarintPb(dAtA, i, uint64(m.MaxAssigned))
 		i--
-		dAtA[i] = 0x12
+		dAtA[i] = 0x10
 	}
-	if m.Type != 0 {
-		i = encodeVarintPb(dAtA, i, uint64(m.Type))
-		i--
-		dAtA[i] = 0x8
+	if len(m.Txns) > 0 {
+		for iNdEx := len(m.Txns) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Txns[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintPb(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
 	}
 	return len(dAtA) - i, nil
 }
 
-func (m *BackupPostingList) Marshal() (dAtA []byte, err error) {
+func (m *TxnTimestamps) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -11578,82 +11703,38 @@ func (m *BackupPostingList) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *BackupPostingList) MarshalTo(dAtA []byte) (int, error) {
+func (m *TxnTimestamps) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *BackupPostingList) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *TxnTimestamps) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if len(m.UidBytes) > 0 {
-		i -= len(m.UidBytes)
-		copy(dAtA[i:], m.UidBytes)
-		i = encodeVarintPb(dAtA, i, uint64(len(m.UidBytes)))
-		i--
-		dAtA[i] = 0x2a
-	}
-	if len(m.Splits) > 0 {
-		dAtA40 := make([]byte, len(m.Splits)*10)
-		var j39 int
-		for _, num := range m.Splits {
-			for num >= 1<<7 {
-				dAtA40[j39] = uint8(uint64(num)&0x7f | 0x80)
-				num >>= 7
-				j39++
-			}
-			dAtA40[j39] = uint8(num)
-			j39++
-		}
-		i -= j39
-		copy(dAtA[i:], dAtA40[:j39])
-		i = encodeVarintPb(dAtA, i, uint64(j39))
-		i--
-		dAtA[i] = 0x22
-	}
-	if m.CommitTs != 0 {
-		i = encodeVarintPb(dAtA, i, uint64(m.CommitTs))
-		i--
-		dAtA[i] = 0x18
-	}
-	if len(m.Postings) > 0 {
-		for iNdEx := len(m.Postings) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.Postings[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintPb(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0x12
-		}
-	}
-	if len(m.Uids) > 0 {
-		dAtA42 := make([]byte, len(m.Uids)*10)
-		var j41 int
-		for _, num := range m.Uids {
+	if len(m.Ts) > 0 {
+		dAtA35 := make([]byte, len(m.Ts)*10)
+		var j34 int
+		for _, num := range m.Ts {
 			for num >= 1<<7 {
-				dAtA42[j41] = uint8(uint64(num)&0x7f | 0x80)
+				dAtA35[j34] = uint8(uint64(num)&0x7f | 0x80)
 				num >>= 7
-				j41++
+				j34++
 			}
-			dAtA42[j41] = uint8(num)
-			j41++
+			dAtA35[j34] = uint8(num)
+			j34++
 		}
-		i -= j41
-		copy(dAtA[i:], dAtA42[:j41])
-		i = encodeVarintPb(dAtA, i, uint64(j41))
+		i -= j34
+		copy(dAtA[i:], dAtA35[:j34])
+		i = encodeVarintPb(dAtA, i, uint64(j34))
 		i--
 		dAtA[i] = 0xa
 	}
 	return len(dAtA) - i, nil
 }
 
-func (m *UpdateGraphQLSchemaRequest) Marshal() (dAtA []byte, err error) {
+func (m *PeerResponse) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -11663,34 +11744,100 @@ func (m *UpdateGraphQLSchemaRequest) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *UpdateGraphQLSchemaRequest) MarshalTo(dAtA []byte) (int, error) {
+func (m *PeerResponse) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *UpdateGraphQLSchemaRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *PeerResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if len(m.DgraphTypes) > 0 {
-		for iNdEx := len(m.DgraphTypes) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.DgraphTypes[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintPb(dAtA, i, uint64(size))
+	if m.Status {
+		i--
+		if m.Status {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *RaftBatch) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *RaftBatch) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *RaftBatch) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Payload != nil {
+		{
+			size, err := m.Payload.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
 			}
-			i--
-			dAtA[i] = 0x22
+			i -= size
+			i = encodeVarintPb(dAtA, i, uint64(size))
 		}
+		i--
+		dAtA[i] = 0x12
 	}
-	if len(m.DgraphPreds) > 0 {
-		for iNdEx := len(m.DgraphPreds) - 1; iNdEx >= 0; iNdEx-- {
+	if m.Context != nil {
+		{
+			size, err := m.Context.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintPb(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *TabletResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *TabletResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *TabletResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Tablets) > 0 {
+		for iNdEx := len(m.Tablets) - 1; iNdEx >= 0; iNdEx-- {
 			{
-				size, err := m.DgraphPreds[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				size, err := m.Tablets[iNdEx].MarshalToSizedBuffer(dAtA[:i])
 				if err != nil {
 					return 0, err
 				}
@@ -11698,25 +11845,13 @@ func (m *UpdateGraphQLSchemaRequest) MarshalToSizedBuffer(dAtA []byte) (int, err
 				i = encodeVarintPb(dAtA, i, uint64(size))
 			}
 			i--
-			dAtA[i] = 0x1a
+			dAtA[i] = 0xa
 		}
 	}
-	if len(m.GraphqlSchema) > 0 {
-		i -= len(m.GraphqlSchema)
-		copy(dAtA[i:], m.GraphqlSchema)
-		i = encodeVarintPb(dAtA, i, uint64(len(m.GraphqlSchema)))
-		i--
-		dAtA[i] = 0x12
-	}
-	if m.StartTs != 0 {
-		i = encodeVarintPb(dAtA, i, uint64(m.StartTs))
-		i--
-		dAtA[i] = 0x8
-	}
 	return len(dAtA) - i, nil
 }
 
-func (m *UpdateGraphQLSchemaResponse) Marshal() (dAtA []byte, err error) {
+func (m *TabletRequest) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -11726,25 +11861,39 @@ func (m *UpdateGraphQLSchemaResponse) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *UpdateGraphQLSchemaResponse) MarshalTo(dAtA []byte) (int, error) {
+func (m *TabletRequest) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *UpdateGraphQLSchemaResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *TabletRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if m.Uid != 0 {
-		i = encodeVarintPb(dAtA, i, uint64(m.Uid))
+	if m.GroupId != 0 {
+		i = encodeVarintPb(dAtA, i, uint64(m.GroupId))
 		i--
-		dAtA[i] = 0x8
+		dAtA[i] = 0x10
+	}
+	if len(m.Tablets) > 0 {
+		for iNdEx := len(m.Tablets) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Tablets[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintPb(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
 	}
 	return len(dAtA) - i, nil
 }
 
-func (m *BulkMeta) Marshal() (dAtA []byte, err error) {
+func (m *SubscriptionRequest) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -11754,20 +11903,20 @@ func (m *BulkMeta) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *BulkMeta) MarshalTo(dAtA []byte) (int, error) {
+func (m *SubscriptionRequest) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *BulkMeta) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *SubscriptionRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if len(m.Types) > 0 {
-		for iNdEx := len(m.Types) - 1; iNdEx >= 0; iNdEx-- {
+	if len(m.Matches) > 0 {
+		for iNdEx := len(m.Matches) - 1; iNdEx >= 0; iNdEx-- {
 			{
-				size, err := m.Types[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				size, err := m.Matches[iNdEx].MarshalToSizedBuffer(dAtA[:i])
 				if err != nil {
 					return 0, err
 				}
@@ -11775,44 +11924,22 @@ func (m *BulkMeta) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 				i = encodeVarintPb(dAtA, i, uint64(size))
 			}
 			i--
-			dAtA[i] = 0x1a
+			dAtA[i] = 0x12
 		}
 	}
-	if len(m.SchemaMap) > 0 {
-		for k := range m.SchemaMap {
-			v := m.SchemaMap[k]
-			baseI := i
-			if v != nil {
-				{
-					size, err := v.MarshalToSizedBuffer(dAtA[:i])
-					if err != nil {
-						return 0, err
-					}
-					i -= size
-					i = encodeVarintPb(dAtA, i, uint64(size))
-				}
-				i--
-				dAtA[i] = 0x12
-			}
-			i -= len(k)
-			copy(dAtA[i:], k)
-			i = encodeVarintPb(dAtA, i, uint64(len(k)))
+	if len(m.Prefixes) > 0 {
+		for iNdEx := len(m.Prefixes) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.Prefixes[iNdEx])
+			copy(dAtA[i:], m.Prefixes[iNdEx])
+			i = encodeVarintPb(dAtA, i, uint64(len(m.Prefixes[iNdEx])))
 			i--
 			dAtA[i] = 0xa
-			i = encodeVarintPb(dAtA, i, uint64(baseI-i))
-			i--
-			dAtA[i] = 0x12
 		}
 	}
-	if m.EdgeCount != 0 {
-		i = encodeVarintPb(dAtA, i, uint64(m.EdgeCount))
-		i--
-		dAtA[i] = 0x8
-	}
 	return len(dAtA) - i, nil
 }
 
-func (m *DeleteNsRequest) Marshal() (dAtA []byte, err error) {
+func (m *SubscriptionResponse) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -11822,30 +11949,32 @@ func (m *DeleteNsRequest) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *DeleteNsRequest) MarshalTo(dAtA []byte) (int, error) {
+func (m *SubscriptionResponse) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *DeleteNsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *SubscriptionResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if m.Namespace != 0 {
-		i = encodeVarintPb(dAtA, i, uint64(m.Namespace))
-		i--
-		dAtA[i] = 0x10
-	}
-	if m.GroupId != 0 {
-		i = encodeVarintPb(dAtA, i, uint64(m.GroupId))
+	if m.Kvs != nil {
+		{
+			size, err := m.Kvs.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintPb(dAtA, i, uint64(size))
+		}
 		i--
-		dAtA[i] = 0x8
+		dAtA[i] = 0xa
 	}
 	return len(dAtA) - i, nil
 }
 
-func (m *TaskStatusRequest) Marshal() (dAtA []byte, err error) {
+func (m *Num) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -11855,25 +11984,50 @@ func (m *TaskStatusRequest) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *TaskStatusRequest) MarshalTo(dAtA []byte) (int, error) {
+func (m *Num) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *TaskStatusRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *Num) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if m.TaskId != 0 {
-		i = encodeVarintPb(dAtA, i, uint64(m.TaskId))
+	if m.Type != 0 {
+		i = encodeVarintPb(dAtA, i, uint64(m.Type))
+		i--
+		dAtA[i] = 0x20
+	}
+	if m.Forwarded {
+		i--
+		if m.Forwarded {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.ReadOnly {
+		i--
+		if m.ReadOnly {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.Val != 0 {
+		i = encodeVarintPb(dAtA, i, uint64(m.Val))
 		i--
 		dAtA[i] = 0x8
 	}
 	return len(dAtA) - i, nil
 }
 
-func (m *TaskStatusResponse) Marshal() (dAtA []byte, err error) {
+func (m *AssignedIds) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -11883,1138 +12037,1498 @@ func (m *TaskStatusResponse) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *TaskStatusResponse) MarshalTo(dAtA []byte) (int, error) {
+func (m *AssignedIds) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *TaskStatusResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *AssignedIds) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if m.TaskMeta != 0 {
-		i = encodeVarintPb(dAtA, i, uint64(m.TaskMeta))
+	if m.ReadOnly != 0 {
+		i = encodeVarintPb(dAtA, i, uint64(m.ReadOnly))
 		i--
-		dAtA[i] = 0x8
+		dAtA[i] = 0x28
 	}
-	return len(dAtA) - i, nil
-}
-
-func encodeVarintPb(dAtA []byte, offset int, v uint64) int {
-	offset -= sovPb(v)
-	base := offset
-	for v >= 1<<7 {
-		dAtA[offset] = uint8(v&0x7f | 0x80)
-		v >>= 7
-		offset++
-	}
-	dAtA[offset] = uint8(v)
-	return base
-}
-func (m *List) Size() (n int) {
-	if m == nil {
-		return 0
+	if m.EndId != 0 {
+		i = encodeVarintPb(dAtA, i, uint64(m.EndId))
+		i--
+		dAtA[i] = 0x10
 	}
-	var l int
-	_ = l
-	if len(m.Uids) > 0 {
-		n += 1 + sovPb(uint64(len(m.Uids)*8)) + len(m.Uids)*8
+	if m.StartId != 0 {
+		i = encodeVarintPb(dAtA, i, uint64(m.StartId))
+		i--
+		dAtA[i] = 0x8
 	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *TaskValue) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *RemoveNodeRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *RemoveNodeRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *RemoveNodeRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = len(m.Val)
-	if l > 0 {
-		n += 1 + l + sovPb(uint64(l))
+	if m.GroupId != 0 {
+		i = encodeVarintPb(dAtA, i, uint64(m.GroupId))
+		i--
+		dAtA[i] = 0x10
 	}
-	if m.ValType != 0 {
-		n += 1 + sovPb(uint64(m.ValType))
+	if m.NodeId != 0 {
+		i = encodeVarintPb(dAtA, i, uint64(m.NodeId))
+		i--
+		dAtA[i] = 0x8
 	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *SrcFunction) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *MoveTabletRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *MoveTabletRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MoveTabletRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = len(m.Name)
-	if l > 0 {
-		n += 1 + l + sovPb(uint64(l))
+	if m.DstGroup != 0 {
+		i = encodeVarintPb(dAtA, i, uint64(m.DstGroup))
+		i--
+		dAtA[i] = 0x18
 	}
-	if len(m.Args) > 0 {
-		for _, s := range m.Args {
-			l = len(s)
-			n += 1 + l + sovPb(uint64(l))
-		}
+	if len(m.Tablet) > 0 {
+		i -= len(m.Tablet)
+		copy(dAtA[i:], m.Tablet)
+		i = encodeVarintPb(dAtA, i, uint64(len(m.Tablet)))
+		i--
+		dAtA[i] = 0x12
 	}
-	if m.IsCount {
-		n += 2
+	if m.Namespace != 0 {
+		i = encodeVarintPb(dAtA, i, uint64(m.Namespace))
+		i--
+		dAtA[i] = 0x8
 	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *Query) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *ApplyLicenseRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *ApplyLicenseRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ApplyLicenseRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = len(m.Attr)
-	if l > 0 {
-		n += 1 + l + sovPb(uint64(l))
-	}
-	if len(m.Langs) > 0 {
-		for _, s := range m.Langs {
-			l = len(s)
-			n += 1 + l + sovPb(uint64(l))
-		}
-	}
-	if m.AfterUid != 0 {
-		n += 9
-	}
-	if m.DoCount {
-		n += 2
-	}
-	if m.UidList != nil {
-		l = m.UidList.Size()
-		n += 1 + l + sovPb(uint64(l))
-	}
-	if m.SrcFunc != nil {
-		l = m.SrcFunc.Size()
-		n += 1 + l + sovPb(uint64(l))
-	}
-	if m.Reverse {
-		n += 2
-	}
-	if m.FacetParam != nil {
-		l = m.FacetParam.Size()
-		n += 1 + l + sovPb(uint64(l))
-	}
-	if m.FacetsFilter != nil {
-		l = m.FacetsFilter.Size()
-		n += 1 + l + sovPb(uint64(l))
-	}
-	if m.ExpandAll {
-		n += 2
-	}
-	if m.ReadTs != 0 {
-		n += 1 + sovPb(uint64(m.ReadTs))
-	}
-	if m.Cache != 0 {
-		n += 1 + sovPb(uint64(m.Cache))
-	}
-	if m.First != 0 {
-		n += 1 + sovPb(uint64(m.First))
-	}
-	if m.Offset != 0 {
-		n += 2 + sovPb(uint64(m.Offset))
+	if len(m.License) > 0 {
+		i -= len(m.License)
+		copy(dAtA[i:], m.License)
+		i = encodeVarintPb(dAtA, i, uint64(len(m.License)))
+		i--
+		dAtA[i] = 0xa
 	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *ValueList) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *SnapshotMeta) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *SnapshotMeta) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *SnapshotMeta) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	if len(m.Values) > 0 {
-		for _, e := range m.Values {
-			l = e.Size()
-			n += 1 + l + sovPb(uint64(l))
-		}
+	if m.GroupId != 0 {
+		i = encodeVarintPb(dAtA, i, uint64(m.GroupId))
+		i--
+		dAtA[i] = 0x10
 	}
-	return n
+	if m.ClientTs != 0 {
+		i = encodeVarintPb(dAtA, i, uint64(m.ClientTs))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
 }
 
-func (m *LangList) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *Status) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *Status) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *Status) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	if len(m.Lang) > 0 {
-		for _, s := range m.Lang {
-			l = len(s)
-			n += 1 + l + sovPb(uint64(l))
-		}
+	if len(m.Msg) > 0 {
+		i -= len(m.Msg)
+		copy(dAtA[i:], m.Msg)
+		i = encodeVarintPb(dAtA, i, uint64(len(m.Msg)))
+		i--
+		dAtA[i] = 0x12
 	}
-	return n
+	if m.Code != 0 {
+		i = encodeVarintPb(dAtA, i, uint64(m.Code))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
 }
 
-func (m *Result) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *BackupRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *BackupRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *BackupRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	if len(m.UidMatrix) > 0 {
-		for _, e := range m.UidMatrix {
-			l = e.Size()
-			n += 1 + l + sovPb(uint64(l))
+	if m.ForceFull {
+		i--
+		if m.ForceFull {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
 		}
+		i--
+		dAtA[i] = 0x58
 	}
-	if len(m.ValueMatrix) > 0 {
-		for _, e := range m.ValueMatrix {
-			l = e.Size()
-			n += 1 + l + sovPb(uint64(l))
+	if len(m.Predicates) > 0 {
+		for iNdEx := len(m.Predicates) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.Predicates[iNdEx])
+			copy(dAtA[i:], m.Predicates[iNdEx])
+			i = encodeVarintPb(dAtA, i, uint64(len(m.Predicates[iNdEx])))
+			i--
+			dAtA[i] = 0x52
 		}
 	}
-	if len(m.Counts) > 0 {
-		l = 0
-		for _, e := range m.Counts {
-			l += sovPb(uint64(e))
+	if m.Anonymous {
+		i--
+		if m.Anonymous {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
 		}
-		n += 1 + sovPb(uint64(l)) + l
+		i--
+		dAtA[i] = 0x48
 	}
-	if m.IntersectDest {
-		n += 2
+	if len(m.SessionToken) > 0 {
+		i -= len(m.SessionToken)
+		copy(dAtA[i:], m.SessionToken)
+		i = encodeVarintPb(dAtA, i, uint64(len(m.SessionToken)))
+		i--
+		dAtA[i] = 0x42
 	}
-	if len(m.FacetMatrix) > 0 {
-		for _, e := range m.FacetMatrix {
-			l = e.Size()
-			n += 1 + l + sovPb(uint64(l))
-		}
+	if len(m.SecretKey) > 0 {
+		i -= len(m.SecretKey)
+		copy(dAtA[i:], m.SecretKey)
+		i = encodeVarintPb(dAtA, i, uint64(len(m.SecretKey)))
+		i--
+		dAtA[i] = 0x3a
 	}
-	if len(m.LangMatrix) > 0 {
-		for _, e := range m.LangMatrix {
-			l = e.Size()
-			n += 1 + l + sovPb(uint64(l))
-		}
+	if len(m.AccessKey) > 0 {
+		i -= len(m.AccessKey)
+		copy(dAtA[i:], m.AccessKey)
+		i = encodeVarintPb(dAtA, i, uint64(len(m.AccessKey)))
+		i--
+		dAtA[i] = 0x32
 	}
-	if m.List {
-		n += 2
+	if len(m.Destination) > 0 {
+		i -= len(m.Destination)
+		copy(dAtA[i:], m.Destination)
+		i = encodeVarintPb(dAtA, i, uint64(len(m.Destination)))
+		i--
+		dAtA[i] = 0x2a
 	}
-	return n
-}
-
-func (m *Order) Size() (n int) {
-	if m == nil {
-		return 0
+	if len(m.UnixTs) > 0 {
+		i -= len(m.UnixTs)
+		copy(dAtA[i:], m.UnixTs)
+		i = encodeVarintPb(dAtA, i, uint64(len(m.UnixTs)))
+		i--
+		dAtA[i] = 0x22
 	}
-	var l int
-	_ = l
-	l = len(m.Attr)
-	if l > 0 {
-		n += 1 + l + sovPb(uint64(l))
+	if m.GroupId != 0 {
+		i = encodeVarintPb(dAtA, i, uint64(m.GroupId))
+		i--
+		dAtA[i] = 0x18
 	}
-	if m.Desc {
-		n += 2
+	if m.SinceTs != 0 {
+		i = encodeVarintPb(dAtA, i, uint64(m.SinceTs))
+		i--
+		dAtA[i] = 0x10
 	}
-	if len(m.Langs) > 0 {
-		for _, s := range m.Langs {
-			l = len(s)
-			n += 1 + l + sovPb(uint64(l))
-		}
+	if m.ReadTs != 0 {
+		i = encodeVarintPb(dAtA, i, uint64(m.ReadTs))
+		i--
+		dAtA[i] = 0x8
 	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *SortMessage) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if len(m.Order) > 0 {
-		for _, e := range m.Order {
-			l = e.Size()
-			n += 1 + l + sovPb(uint64(l))
-		}
-	}
-	if len(m.UidMatrix) > 0 {
-		for _, e := range m.UidMatrix {
-			l = e.Size()
-			n += 1 + l + sovPb(uint64(l))
-		}
-	}
-	if m.Count != 0 {
-		n += 1 + sovPb(uint64(m.Count))
-	}
-	if m.Offset != 0 {
-		n += 1 + sovPb(uint64(m.Offset))
-	}
-	if m.ReadTs != 0 {
-		n += 1 + sovPb(uint64(m.ReadTs))
+func (m *BackupResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	return n
+	return dAtA[:n], nil
 }
 
-func (m *SortResult) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+func (m *BackupResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *BackupResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	if len(m.UidMatrix) > 0 {
-		for _, e := range m.UidMatrix {
-			l = e.Size()
-			n += 1 + l + sovPb(uint64(l))
+	if len(m.DropOperations) > 0 {
+		for iNdEx := len(m.DropOperations) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.DropOperations[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintPb(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
 		}
 	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *RaftContext) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *DropOperation) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *DropOperation) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *DropOperation) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	if m.Id != 0 {
-		n += 9
-	}
-	if m.Group != 0 {
-		n += 1 + sovPb(uint64(m.Group))
-	}
-	l = len(m.Addr)
-	if l > 0 {
-		n += 1 + l + sovPb(uint64(l))
-	}
-	if m.SnapshotTs != 0 {
-		n += 1 + sovPb(uint64(m.SnapshotTs))
+	if len(m.DropValue) > 0 {
+		i -= len(m.DropValue)
+		copy(dAtA[i:], m.DropValue)
+		i = encodeVarintPb(dAtA, i, uint64(len(m.DropValue)))
+		i--
+		dAtA[i] = 0x12
 	}
-	if m.IsLearner {
-		n += 2
+	if m.DropOp != 0 {
+		i = encodeVarintPb(dAtA, i, uint64(m.DropOp))
+		i--
+		dAtA[i] = 0x8
 	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *Member) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if m.Id != 0 {
-		n += 9
+func (m *ExportRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	if m.GroupId != 0 {
-		n += 1 + sovPb(uint64(m.GroupId))
+	return dAtA[:n], nil
+}
+
+func (m *ExportRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ExportRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Namespace != 0 {
+		i = encodeVarintPb(dAtA, i, uint64(m.Namespace))
+		i--
+		dAtA[i] = 0x50
 	}
-	l = len(m.Addr)
-	if l > 0 {
-		n += 1 + l + sovPb(uint64(l))
+	if m.Anonymous {
+		i--
+		if m.Anonymous {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x48
 	}
-	if m.Leader {
-		n += 2
+	if len(m.SessionToken) > 0 {
+		i -= len(m.SessionToken)
+		copy(dAtA[i:], m.SessionToken)
+		i = encodeVarintPb(dAtA, i, uint64(len(m.SessionToken)))
+		i--
+		dAtA[i] = 0x42
 	}
-	if m.AmDead {
-		n += 2
+	if len(m.SecretKey) > 0 {
+		i -= len(m.SecretKey)
+		copy(dAtA[i:], m.SecretKey)
+		i = encodeVarintPb(dAtA, i, uint64(len(m.SecretKey)))
+		i--
+		dAtA[i] = 0x3a
 	}
-	if m.LastUpdate != 0 {
-		n += 1 + sovPb(uint64(m.LastUpdate))
+	if len(m.AccessKey) > 0 {
+		i -= len(m.AccessKey)
+		copy(dAtA[i:], m.AccessKey)
+		i = encodeVarintPb(dAtA, i, uint64(len(m.AccessKey)))
+		i--
+		dAtA[i] = 0x32
 	}
-	if m.Learner {
-		n += 2
+	if len(m.Destination) > 0 {
+		i -= len(m.Destination)
+		copy(dAtA[i:], m.Destination)
+		i = encodeVarintPb(dAtA, i, uint64(len(m.Destination)))
+		i--
+		dAtA[i] = 0x2a
 	}
-	if m.ClusterInfoOnly {
-		n += 2
+	if len(m.Format) > 0 {
+		i -= len(m.Format)
+		copy(dAtA[i:], m.Format)
+		i = encodeVarintPb(dAtA, i, uint64(len(m.Format)))
+		i--
+		dAtA[i] = 0x22
 	}
-	if m.ForceGroupId {
-		n += 2
+	if m.UnixTs != 0 {
+		i = encodeVarintPb(dAtA, i, uint64(m.UnixTs))
+		i--
+		dAtA[i] = 0x18
 	}
-	return n
+	if m.ReadTs != 0 {
+		i = encodeVarintPb(dAtA, i, uint64(m.ReadTs))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.GroupId != 0 {
+		i = encodeVarintPb(dAtA, i, uint64(m.GroupId))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
 }
 
-func (m *Group) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *ExportResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *ExportResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ExportResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	if len(m.Members) > 0 {
-		for k, v := range m.Members {
-			_ = k
-			_ = v
-			l = 0
-			if v != nil {
-				l = v.Size()
-				l += 1 + sovPb(uint64(l))
-			}
-			mapEntrySize := 1 + sovPb(uint64(k)) + l
-			n += mapEntrySize + 1 + sovPb(uint64(mapEntrySize))
-		}
-	}
-	if len(m.Tablets) > 0 {
-		for k, v := range m.Tablets {
-			_ = k
-			_ = v
-			l = 0
-			if v != nil {
-				l = v.Size()
-				l += 1 + sovPb(uint64(l))
-			}
-			mapEntrySize := 1 + len(k) + sovPb(uint64(len(k))) + l
-			n += mapEntrySize + 1 + sovPb(uint64(mapEntrySize))
+	if len(m.Files) > 0 {
+		for iNdEx := len(m.Files) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.Files[iNdEx])
+			copy(dAtA[i:], m.Files[iNdEx])
+			i = encodeVarintPb(dAtA, i, uint64(len(m.Files[iNdEx])))
+			i--
+			dAtA[i] = 0x1a
 		}
 	}
-	if m.SnapshotTs != 0 {
-		n += 1 + sovPb(uint64(m.SnapshotTs))
-	}
-	if m.Checksum != 0 {
-		n += 1 + sovPb(uint64(m.Checksum))
+	if len(m.Msg) > 0 {
+		i -= len(m.Msg)
+		copy(dAtA[i:], m.Msg)
+		i = encodeVarintPb(dAtA, i, uint64(len(m.Msg)))
+		i--
+		dAtA[i] = 0x12
 	}
-	if m.CheckpointTs != 0 {
-		n += 1 + sovPb(uint64(m.CheckpointTs))
+	if m.Code != 0 {
+		i = encodeVarintPb(dAtA, i, uint64(m.Code))
+		i--
+		dAtA[i] = 0x8
 	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *License) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.User)
-	if l > 0 {
-		n += 1 + l + sovPb(uint64(l))
-	}
-	if m.MaxNodes != 0 {
-		n += 1 + sovPb(uint64(m.MaxNodes))
-	}
-	if m.ExpiryTs != 0 {
-		n += 1 + sovPb(uint64(m.ExpiryTs))
-	}
-	if m.Enabled {
-		n += 2
+func (m *BackupKey) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	return n
+	return dAtA[:n], nil
 }
 
-func (m *ZeroProposal) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+func (m *BackupKey) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *BackupKey) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	if len(m.SnapshotTs) > 0 {
-		for k, v := range m.SnapshotTs {
-			_ = k
-			_ = v
-			mapEntrySize := 1 + sovPb(uint64(k)) + 1 + sovPb(uint64(v))
-			n += mapEntrySize + 1 + sovPb(uint64(mapEntrySize))
-		}
-	}
-	if m.Member != nil {
-		l = m.Member.Size()
-		n += 1 + l + sovPb(uint64(l))
+	if m.Namespace != 0 {
+		i = encodeVarintPb(dAtA, i, uint64(m.Namespace))
+		i--
+		dAtA[i] = 0x38
 	}
-	if m.Tablet != nil {
-		l = m.Tablet.Size()
-		n += 1 + l + sovPb(uint64(l))
+	if m.Count != 0 {
+		i = encodeVarintPb(dAtA, i, uint64(m.Count))
+		i--
+		dAtA[i] = 0x30
 	}
-	if m.MaxUID != 0 {
-		n += 1 + sovPb(uint64(m.MaxUID))
+	if len(m.Term) > 0 {
+		i -= len(m.Term)
+		copy(dAtA[i:], m.Term)
+		i = encodeVarintPb(dAtA, i, uint64(len(m.Term)))
+		i--
+		dAtA[i] = 0x2a
 	}
-	if m.MaxTxnTs != 0 {
-		n += 1 + sovPb(uint64(m.MaxTxnTs))
+	if m.StartUid != 0 {
+		i = encodeVarintPb(dAtA, i, uint64(m.StartUid))
+		i--
+		dAtA[i] = 0x20
 	}
-	if m.MaxRaftId != 0 {
-		n += 1 + sovPb(uint64(m.MaxRaftId))
+	if m.Uid != 0 {
+		i = encodeVarintPb(dAtA, i, uint64(m.Uid))
+		i--
+		dAtA[i] = 0x18
 	}
-	if m.Txn != nil {
-		l = m.Txn.Size()
-		n += 1 + l + sovPb(uint64(l))
+	if len(m.Attr) > 0 {
+		i -= len(m.Attr)
+		copy(dAtA[i:], m.Attr)
+		i = encodeVarintPb(dAtA, i, uint64(len(m.Attr)))
+		i--
+		dAtA[i] = 0x12
 	}
-	l = len(m.Cid)
-	if l > 0 {
-		n += 1 + l + sovPb(uint64(l))
+	if m.Type != 0 {
+		i = encodeVarintPb(dAtA, i, uint64(m.Type))
+		i--
+		dAtA[i] = 0x8
 	}
-	if m.License != nil {
-		l = m.License.Size()
-		n += 1 + l + sovPb(uint64(l))
-	}
-	if m.Snapshot != nil {
-		l = m.Snapshot.Size()
-		n += 1 + l + sovPb(uint64(l))
-	}
-	if m.MaxNsID != 0 {
-		n += 1 + sovPb(uint64(m.MaxNsID))
-	}
-	if m.DeleteNs != nil {
-		l = m.DeleteNs.Size()
-		n += 1 + l + sovPb(uint64(l))
-	}
-	if len(m.Tablets) > 0 {
-		for _, e := range m.Tablets {
-			l = e.Size()
-			n += 1 + l + sovPb(uint64(l))
-		}
-	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *MembershipState) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *BackupPostingList) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *BackupPostingList) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *BackupPostingList) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	if m.Counter != 0 {
-		n += 1 + sovPb(uint64(m.Counter))
+	if len(m.UidBytes) > 0 {
+		i -= len(m.UidBytes)
+		copy(dAtA[i:], m.UidBytes)
+		i = encodeVarintPb(dAtA, i, uint64(len(m.UidBytes)))
+		i--
+		dAtA[i] = 0x2a
 	}
-	if len(m.Groups) > 0 {
-		for k, v := range m.Groups {
-			_ = k
-			_ = v
-			l = 0
-			if v != nil {
-				l = v.Size()
-				l += 1 + sovPb(uint64(l))
+	if len(m.Splits) > 0 {
+		dAtA40 := make([]byte, len(m.Splits)*10)
+		var j39 int
+		for _, num := range m.Splits {
+			for num >= 1<<7 {
+				dAtA40[j39] = uint8(uint64(num)&0x7f | 0x80)
+				num >>= 7
+				j39++
 			}
-			mapEntrySize := 1 + sovPb(uint64(k)) + l
-			n += mapEntrySize + 1 + sovPb(uint64(mapEntrySize))
+			dAtA40[j39] = uint8(num)
+			j39++
 		}
+		i -= j39
+		copy(dAtA[i:], dAtA40[:j39])
+		i = encodeVarintPb(dAtA, i, uint64(j39))
+		i--
+		dAtA[i] = 0x22
 	}
-	if len(m.Zeros) > 0 {
-		for k, v := range m.Zeros {
-			_ = k
-			_ = v
-			l = 0
-			if v != nil {
-				l = v.Size()
-				l += 1 + sovPb(uint64(l))
+	if m.CommitTs != 0 {
+		i = encodeVarintPb(dAtA, i, uint64(m.CommitTs))
+		i--
+		dAtA[i] = 0x18
+	}
+	if len(m.Postings) > 0 {
+		for iNdEx := len(m.Postings) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Postings[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintPb(dAtA, i, uint64(size))
 			}
-			mapEntrySize := 1 + sovPb(uint64(k)) + l
-			n += mapEntrySize + 1 + sovPb(uint64(mapEntrySize))
+			i--
+			dAtA[i] = 0x12
 		}
 	}
-	if m.MaxUID != 0 {
-		n += 1 + sovPb(uint64(m.MaxUID))
-	}
-	if m.MaxTxnTs != 0 {
-		n += 1 + sovPb(uint64(m.MaxTxnTs))
+	if len(m.Uids) > 0 {
+		dAtA42 := make([]byte, len(m.Uids)*10)
+		var j41 int
+		for _, num := range m.Uids {
+			for num >= 1<<7 {
+				dAtA42[j41] = uint8(uint64(num)&0x7f | 0x80)
+				num >>= 7
+				j41++
+			}
+			dAtA42[j41] = uint8(num)
+			j41++
+		}
+		i -= j41
+		copy(dAtA[i:], dAtA42[:j41])
+		i = encodeVarintPb(dAtA, i, uint64(j41))
+		i--
+		dAtA[i] = 0xa
 	}
-	if m.MaxRaftId != 0 {
-		n += 1 + sovPb(uint64(m.MaxRaftId))
+	return len(dAtA) - i, nil
+}
+
+func (m *UpdateGraphQLSchemaRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	if len(m.Removed) > 0 {
-		for _, e := range m.Removed {
-			l = e.Size()
-			n += 1 + l + sovPb(uint64(l))
+	return dAtA[:n], nil
+}
+
+func (m *UpdateGraphQLSchemaRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *UpdateGraphQLSchemaRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.DgraphTypes) > 0 {
+		for iNdEx := len(m.DgraphTypes) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.DgraphTypes[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintPb(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x22
 		}
 	}
-	l = len(m.Cid)
-	if l > 0 {
-		n += 1 + l + sovPb(uint64(l))
+	if len(m.DgraphPreds) > 0 {
+		for iNdEx := len(m.DgraphPreds) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.DgraphPreds[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintPb(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1a
+		}
 	}
-	if m.License != nil {
-		l = m.License.Size()
-		n += 1 + l + sovPb(uint64(l))
+	if len(m.GraphqlSchema) > 0 {
+		i -= len(m.GraphqlSchema)
+		copy(dAtA[i:], m.GraphqlSchema)
+		i = encodeVarintPb(dAtA, i, uint64(len(m.GraphqlSchema)))
+		i--
+		dAtA[i] = 0x12
 	}
-	if m.MaxNsID != 0 {
-		n += 1 + sovPb(uint64(m.MaxNsID))
+	if m.StartTs != 0 {
+		i = encodeVarintPb(dAtA, i, uint64(m.StartTs))
+		i--
+		dAtA[i] = 0x8
 	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *ConnectionState) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *UpdateGraphQLSchemaResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *UpdateGraphQLSchemaResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *UpdateGraphQLSchemaResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	if m.Member != nil {
-		l = m.Member.Size()
-		n += 1 + l + sovPb(uint64(l))
-	}
-	if m.State != nil {
-		l = m.State.Size()
-		n += 1 + l + sovPb(uint64(l))
-	}
-	if m.MaxPending != 0 {
-		n += 1 + sovPb(uint64(m.MaxPending))
+	if m.Uid != 0 {
+		i = encodeVarintPb(dAtA, i, uint64(m.Uid))
+		i--
+		dAtA[i] = 0x8
 	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *HealthInfo) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *BulkMeta) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *BulkMeta) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *BulkMeta) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = len(m.Instance)
-	if l > 0 {
-		n += 1 + l + sovPb(uint64(l))
-	}
-	l = len(m.Address)
-	if l > 0 {
-		n += 1 + l + sovPb(uint64(l))
-	}
-	l = len(m.Status)
-	if l > 0 {
-		n += 1 + l + sovPb(uint64(l))
-	}
-	l = len(m.Group)
-	if l > 0 {
-		n += 1 + l + sovPb(uint64(l))
-	}
-	l = len(m.Version)
-	if l > 0 {
-		n += 1 + l + sovPb(uint64(l))
-	}
-	if m.Uptime != 0 {
-		n += 1 + sovPb(uint64(m.Uptime))
-	}
-	if m.LastEcho != 0 {
-		n += 1 + sovPb(uint64(m.LastEcho))
-	}
-	if len(m.Ongoing) > 0 {
-		for _, s := range m.Ongoing {
-			l = len(s)
-			n += 1 + l + sovPb(uint64(l))
-		}
-	}
-	if len(m.Indexing) > 0 {
-		for _, s := range m.Indexing {
-			l = len(s)
-			n += 1 + l + sovPb(uint64(l))
+	if len(m.Types) > 0 {
+		for iNdEx := len(m.Types) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Types[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintPb(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1a
 		}
 	}
-	if len(m.EeFeatures) > 0 {
-		for _, s := range m.EeFeatures {
-			l = len(s)
-			n += 1 + l + sovPb(uint64(l))
+	if len(m.SchemaMap) > 0 {
+		for k := range m.SchemaMap {
+			v := m.SchemaMap[k]
+			baseI := i
+			if v != nil {
+				{
+					size, err := v.MarshalToSizedBuffer(dAtA[:i])
+					if err != nil {
+						return 0, err
+					}
+					i -= size
+					i = encodeVarintPb(dAtA, i, uint64(size))
+				}
+				i--
+				dAtA[i] = 0x12
+			}
+			i -= len(k)
+			copy(dAtA[i:], k)
+			i = encodeVarintPb(dAtA, i, uint64(len(k)))
+			i--
+			dAtA[i] = 0xa
+			i = encodeVarintPb(dAtA, i, uint64(baseI-i))
+			i--
+			dAtA[i] = 0x12
 		}
 	}
-	if m.MaxAssigned != 0 {
-		n += 1 + sovPb(uint64(m.MaxAssigned))
+	if m.EdgeCount != 0 {
+		i = encodeVarintPb(dAtA, i, uint64(m.EdgeCount))
+		i--
+		dAtA[i] = 0x8
 	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *Tablet) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *DeleteNsRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *DeleteNsRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *DeleteNsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	if m.GroupId != 0 {
-		n += 1 + sovPb(uint64(m.GroupId))
-	}
-	l = len(m.Predicate)
-	if l > 0 {
-		n += 1 + l + sovPb(uint64(l))
-	}
-	if m.Force {
-		n += 2
-	}
-	if m.OnDiskBytes != 0 {
-		n += 1 + sovPb(uint64(m.OnDiskBytes))
-	}
-	if m.Remove {
-		n += 2
-	}
-	if m.ReadOnly {
-		n += 2
-	}
-	if m.MoveTs != 0 {
-		n += 1 + sovPb(uint64(m.MoveTs))
+	if m.Namespace != 0 {
+		i = encodeVarintPb(dAtA, i, uint64(m.Namespace))
+		i--
+		dAtA[i] = 0x10
 	}
-	if m.UncompressedBytes != 0 {
-		n += 1 + sovPb(uint64(m.UncompressedBytes))
+	if m.GroupId != 0 {
+		i = encodeVarintPb(dAtA, i, uint64(m.GroupId))
+		i--
+		dAtA[i] = 0x8
 	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *DirectedEdge) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *TaskStatusRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *TaskStatusRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *TaskStatusRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	if m.Entity != 0 {
-		n += 9
-	}
-	l = len(m.Attr)
-	if l > 0 {
-		n += 1 + l + sovPb(uint64(l))
-	}
-	l = len(m.Value)
-	if l > 0 {
-		n += 1 + l + sovPb(uint64(l))
-	}
-	if m.ValueType != 0 {
-		n += 1 + sovPb(uint64(m.ValueType))
-	}
-	if m.ValueId != 0 {
-		n += 9
-	}
-	l = len(m.Lang)
-	if l > 0 {
-		n += 1 + l + sovPb(uint64(l))
+	if m.Cancel {
+		i--
+		if m.Cancel {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x10
 	}
-	if m.Op != 0 {
-		n += 1 + sovPb(uint64(m.Op))
+	if m.TaskId != 0 {
+		i = encodeVarintPb(dAtA, i, uint64(m.TaskId))
+		i--
+		dAtA[i] = 0x8
 	}
-	if len(m.Facets) > 0 {
-		for _, e := range m.Facets {
-			l = e.Size()
-			n += 1 + l + sovPb(uint64(l))
-		}
+	return len(dAtA) - i, nil
+}
+
+func (m *TaskStatusResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	if len(m.AllowedPreds) > 0 {
-		for _, s := range m.AllowedPreds {
-			l = len(s)
-			n += 1 + l + sovPb(uint64(l))
-		}
+	return dAtA[:n], nil
+}
+
+func (m *TaskStatusResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *TaskStatusResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Progress != 0 {
+		i = encodeVarintPb(dAtA, i, uint64(m.Progress))
+		i--
+		dAtA[i] = 0x10
 	}
-	if m.Namespace != 0 {
-		n += 1 + sovPb(uint64(m.Namespace))
+	if m.TaskMeta != 0 {
+		i = encodeVarintPb(dAtA, i, uint64(m.TaskMeta))
+		i--
+		dAtA[i] = 0x8
 	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *Mutations) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *SynonymUpdate) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *SynonymUpdate) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *SynonymUpdate) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	if m.GroupId != 0 {
-		n += 1 + sovPb(uint64(m.GroupId))
+	if m.Version != 0 {
+		i = encodeVarintPb(dAtA, i, uint64(m.Version))
+		i--
+		dAtA[i] = 0x18
 	}
-	if m.StartTs != 0 {
-		n += 1 + sovPb(uint64(m.StartTs))
+	if len(m.Words) > 0 {
+		for iNdEx := len(m.Words) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.Words[iNdEx])
+			copy(dAtA[i:], m.Words[iNdEx])
+			i = encodeVarintPb(dAtA, i, uint64(len(m.Words[iNdEx])))
+			i--
+			dAtA[i] = 0x12
+		}
 	}
-	if len(m.Edges) > 0 {
-		for _, e := range m.Edges {
-			l = e.Size()
-			n += 1 + l + sovPb(uint64(l))
-		}
+	if len(m.Name) > 0 {
+		i -= len(m.Name)
+		copy(dAtA[i:], m.Name)
+		i = encodeVarintPb(dAtA, i, uint64(len(m.Name)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *SchemaVersion) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *SchemaVersion) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *SchemaVersion) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.CreatedAt != 0 {
+		i = encodeVarintPb(dAtA, i, uint64(m.CreatedAt))
+		i--
+		dAtA[i] = 0x30
+	}
+	if len(m.Author) > 0 {
+		i -= len(m.Author)
+		copy(dAtA[i:], m.Author)
+		i = encodeVarintPb(dAtA, i, uint64(len(m.Author)))
+		i--
+		dAtA[i] = 0x2a
 	}
 	if len(m.Schema) > 0 {
-		for _, e := range m.Schema {
-			l = e.Size()
-			n += 1 + l + sovPb(uint64(l))
-		}
+		i -= len(m.Schema)
+		copy(dAtA[i:], m.Schema)
+		i = encodeVarintPb(dAtA, i, uint64(len(m.Schema)))
+		i--
+		dAtA[i] = 0x22
 	}
-	if len(m.Types) > 0 {
-		for _, e := range m.Types {
-			l = e.Size()
-			n += 1 + l + sovPb(uint64(l))
+	if m.Graphql {
+		i--
+		if m.Graphql {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
 		}
+		i--
+		dAtA[i] = 0x18
 	}
-	if m.DropOp != 0 {
-		n += 1 + sovPb(uint64(m.DropOp))
-	}
-	l = len(m.DropValue)
-	if l > 0 {
-		n += 1 + l + sovPb(uint64(l))
+	if m.Version != 0 {
+		i = encodeVarintPb(dAtA, i, uint64(m.Version))
+		i--
+		dAtA[i] = 0x10
 	}
-	if m.Metadata != nil {
-		l = m.Metadata.Size()
-		n += 1 + l + sovPb(uint64(l))
+	if m.Namespace != 0 {
+		i = encodeVarintPb(dAtA, i, uint64(m.Namespace))
+		i--
+		dAtA[i] = 0x8
 	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *Metadata) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *Tombstone) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *Tombstone) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *Tombstone) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	if len(m.PredHints) > 0 {
-		for k, v := range m.PredHints {
-			_ = k
-			_ = v
-			mapEntrySize := 1 + len(k) + sovPb(uint64(len(k))) + 1 + sovPb(uint64(v))
-			n += mapEntrySize + 1 + sovPb(uint64(mapEntrySize))
+	if m.StartTs != 0 {
+		i = encodeVarintPb(dAtA, i, uint64(m.StartTs))
+		i--
+		dAtA[i] = 0x38
+	}
+	if m.Remove {
+		i--
+		if m.Remove {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
 		}
+		i--
+		dAtA[i] = 0x30
 	}
-	return n
+	if len(m.Predicates) > 0 {
+		for iNdEx := len(m.Predicates) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.Predicates[iNdEx])
+			copy(dAtA[i:], m.Predicates[iNdEx])
+			i = encodeVarintPb(dAtA, i, uint64(len(m.Predicates[iNdEx])))
+			i--
+			dAtA[i] = 0x2a
+		}
+	}
+	if m.CreatedAt != 0 {
+		i = encodeVarintPb(dAtA, i, uint64(m.CreatedAt))
+		i--
+		dAtA[i] = 0x20
+	}
+	if m.DeletedAt != 0 {
+		i = encodeVarintPb(dAtA, i, uint64(m.DeletedAt))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.Uid != 0 {
+		i = encodeVarintPb(dAtA, i, uint64(m.Uid))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.Namespace != 0 {
+		i = encodeVarintPb(dAtA, i, uint64(m.Namespace))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
 }
 
-func (m *Snapshot) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *Trigger) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *Trigger) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *Trigger) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	if m.Context != nil {
-		l = m.Context.Size()
-		n += 1 + l + sovPb(uint64(l))
+	if m.Version != 0 {
+		i = encodeVarintPb(dAtA, i, uint64(m.Version))
+		i--
+		dAtA[i] = 0x30
 	}
-	if m.Index != 0 {
-		n += 1 + sovPb(uint64(m.Index))
+	if m.BeforeCommit {
+		i--
+		if m.BeforeCommit {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x28
 	}
-	if m.ReadTs != 0 {
-		n += 1 + sovPb(uint64(m.ReadTs))
+	if len(m.Url) > 0 {
+		i -= len(m.Url)
+		copy(dAtA[i:], m.Url)
+		i = encodeVarintPb(dAtA, i, uint64(len(m.Url)))
+		i--
+		dAtA[i] = 0x22
 	}
-	if m.Done {
-		n += 2
+	if len(m.TypeName) > 0 {
+		i -= len(m.TypeName)
+		copy(dAtA[i:], m.TypeName)
+		i = encodeVarintPb(dAtA, i, uint64(len(m.TypeName)))
+		i--
+		dAtA[i] = 0x1a
 	}
-	if m.SinceTs != 0 {
-		n += 1 + sovPb(uint64(m.SinceTs))
+	if len(m.Predicate) > 0 {
+		i -= len(m.Predicate)
+		copy(dAtA[i:], m.Predicate)
+		i = encodeVarintPb(dAtA, i, uint64(len(m.Predicate)))
+		i--
+		dAtA[i] = 0x12
 	}
-	return n
+	if len(m.Name) > 0 {
+		i -= len(m.Name)
+		copy(dAtA[i:], m.Name)
+		i = encodeVarintPb(dAtA, i, uint64(len(m.Name)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
 }
 
-func (m *ZeroSnapshot) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *StoredQuery) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *StoredQuery) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *StoredQuery) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	if m.Index != 0 {
-		n += 1 + sovPb(uint64(m.Index))
+	if m.Version != 0 {
+		i = encodeVarintPb(dAtA, i, uint64(m.Version))
+		i--
+		dAtA[i] = 0x20
 	}
-	if m.CheckpointTs != 0 {
-		n += 1 + sovPb(uint64(m.CheckpointTs))
+	if len(m.Groups) > 0 {
+		for iNdEx := len(m.Groups) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.Groups[iNdEx])
+			copy(dAtA[i:], m.Groups[iNdEx])
+			i = encodeVarintPb(dAtA, i, uint64(len(m.Groups[iNdEx])))
+			i--
+			dAtA[i] = 0x1a
+		}
 	}
-	if m.State != nil {
-		l = m.State.Size()
-		n += 1 + l + sovPb(uint64(l))
+	if len(m.Query) > 0 {
+		i -= len(m.Query)
+		copy(dAtA[i:], m.Query)
+		i = encodeVarintPb(dAtA, i, uint64(len(m.Query)))
+		i--
+		dAtA[i] = 0x12
 	}
-	return n
+	if len(m.Name) > 0 {
+		i -= len(m.Name)
+		copy(dAtA[i:], m.Name)
+		i = encodeVarintPb(dAtA, i, uint64(len(m.Name)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
 }
 
-func (m *RestoreRequest) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *DeleteByQueryRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *DeleteByQueryRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *DeleteByQueryRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	if m.GroupId != 0 {
-		n += 1 + sovPb(uint64(m.GroupId))
-	}
-	if m.RestoreTs != 0 {
-		n += 1 + sovPb(uint64(m.RestoreTs))
+	if m.BatchSize != 0 {
+		i = encodeVarintPb(dAtA, i, uint64(m.BatchSize))
+		i--
+		dAtA[i] = 0x18
 	}
-	l = len(m.Location)
-	if l > 0 {
-		n += 1 + l + sovPb(uint64(l))
-	}
-	l = len(m.BackupId)
-	if l > 0 {
-		n += 1 + l + sovPb(uint64(l))
-	}
-	l = len(m.AccessKey)
-	if l > 0 {
-		n += 1 + l + sovPb(uint64(l))
-	}
-	l = len(m.SecretKey)
-	if l > 0 {
-		n += 1 + l + sovPb(uint64(l))
-	}
-	l = len(m.SessionToken)
-	if l > 0 {
-		n += 1 + l + sovPb(uint64(l))
-	}
-	if m.Anonymous {
-		n += 2
-	}
-	l = len(m.EncryptionKeyFile)
-	if l > 0 {
-		n += 1 + l + sovPb(uint64(l))
-	}
-	l = len(m.VaultAddr)
-	if l > 0 {
-		n += 1 + l + sovPb(uint64(l))
-	}
-	l = len(m.VaultRoleidFile)
-	if l > 0 {
-		n += 1 + l + sovPb(uint64(l))
-	}
-	l = len(m.VaultSecretidFile)
-	if l > 0 {
-		n += 1 + l + sovPb(uint64(l))
-	}
-	l = len(m.VaultPath)
-	if l > 0 {
-		n += 1 + l + sovPb(uint64(l))
-	}
-	l = len(m.VaultField)
-	if l > 0 {
-		n += 1 + l + sovPb(uint64(l))
-	}
-	l = len(m.VaultFormat)
-	if l > 0 {
-		n += 1 + l + sovPb(uint64(l))
+	if len(m.Query) > 0 {
+		i -= len(m.Query)
+		copy(dAtA[i:], m.Query)
+		i = encodeVarintPb(dAtA, i, uint64(len(m.Query)))
+		i--
+		dAtA[i] = 0x12
 	}
-	if m.BackupNum != 0 {
-		n += 2 + sovPb(uint64(m.BackupNum))
+	if m.Namespace != 0 {
+		i = encodeVarintPb(dAtA, i, uint64(m.Namespace))
+		i--
+		dAtA[i] = 0x8
 	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *Proposal) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *TriggerEvent) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *TriggerEvent) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *TriggerEvent) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	if m.Mutations != nil {
-		l = m.Mutations.Size()
-		n += 1 + l + sovPb(uint64(l))
-	}
-	if len(m.Kv) > 0 {
-		for _, e := range m.Kv {
-			l = e.Size()
-			n += 1 + l + sovPb(uint64(l))
+	if m.Remove {
+		i--
+		if m.Remove {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
 		}
+		i--
+		dAtA[i] = 0x38
 	}
-	if m.State != nil {
-		l = m.State.Size()
-		n += 1 + l + sovPb(uint64(l))
+	if m.Id != 0 {
+		i = encodeVarintPb(dAtA, i, uint64(m.Id))
+		i--
+		dAtA[i] = 0x30
 	}
-	l = len(m.CleanPredicate)
-	if l > 0 {
-		n += 1 + l + sovPb(uint64(l))
+	if m.CommitTs != 0 {
+		i = encodeVarintPb(dAtA, i, uint64(m.CommitTs))
+		i--
+		dAtA[i] = 0x28
 	}
-	if m.Delta != nil {
-		l = m.Delta.Size()
-		n += 1 + l + sovPb(uint64(l))
+	if m.StartTs != 0 {
+		i = encodeVarintPb(dAtA, i, uint64(m.StartTs))
+		i--
+		dAtA[i] = 0x20
 	}
-	if m.Snapshot != nil {
-		l = m.Snapshot.Size()
-		n += 1 + l + sovPb(uint64(l))
+	if len(m.Payload) > 0 {
+		i -= len(m.Payload)
+		copy(dAtA[i:], m.Payload)
+		i = encodeVarintPb(dAtA, i, uint64(len(m.Payload)))
+		i--
+		dAtA[i] = 0x1a
 	}
-	if m.Index != 0 {
-		n += 1 + sovPb(uint64(m.Index))
+	if len(m.Url) > 0 {
+		i -= len(m.Url)
+		copy(dAtA[i:], m.Url)
+		i = encodeVarintPb(dAtA, i, uint64(len(m.Url)))
+		i--
+		dAtA[i] = 0x12
 	}
-	if m.ExpectedChecksum != 0 {
-		n += 1 + sovPb(uint64(m.ExpectedChecksum))
+	if len(m.Trigger) > 0 {
+		i -= len(m.Trigger)
+		copy(dAtA[i:], m.Trigger)
+		i = encodeVarintPb(dAtA, i, uint64(len(m.Trigger)))
+		i--
+		dAtA[i] = 0xa
 	}
-	if m.Restore != nil {
-		l = m.Restore.Size()
-		n += 1 + l + sovPb(uint64(l))
+	return len(dAtA) - i, nil
+}
+
+func (m *TTLMark) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	if m.CdcState != nil {
-		l = m.CdcState.Size()
-		n += 1 + l + sovPb(uint64(l))
+	return dAtA[:n], nil
+}
+
+func (m *TTLMark) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *TTLMark) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Remove {
+		i--
+		if m.Remove {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x18
 	}
-	if m.DeleteNs != nil {
-		l = m.DeleteNs.Size()
-		n += 1 + l + sovPb(uint64(l))
+	if m.Time != 0 {
+		i = encodeVarintPb(dAtA, i, uint64(m.Time))
+		i--
+		dAtA[i] = 0x10
 	}
-	if m.StartTs != 0 {
-		n += 2 + sovPb(uint64(m.StartTs))
+	if m.Ts != 0 {
+		i = encodeVarintPb(dAtA, i, uint64(m.Ts))
+		i--
+		dAtA[i] = 0x8
 	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *CDCState) Size() (n int) {
+func encodeVarintPb(dAtA []byte, offset int, v uint64) int {
+	offset -= sovPb(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *List) Size() (n int) {
 	if m == nil {
 		return 0
 	}
 	var l int
 	_ = l
-	if m.SentTs != 0 {
-		n += 1 + sovPb(uint64(m.SentTs))
+	if len(m.Uids) > 0 {
+		n += 1 + sovPb(uint64(len(m.Uids)*8)) + len(m.Uids)*8
 	}
 	return n
 }
 
-func (m *KVS) Size() (n int) {
+func (m *TaskValue) Size() (n int) {
 	if m == nil {
 		return 0
 	}
 	var l int
 	_ = l
-	if m.Done {
-		n += 2
-	}
-	if len(m.Predicates) > 0 {
-		for _, s := range m.Predicates {
-			l = len(s)
-			n += 1 + l + sovPb(uint64(l))
-		}
-	}
-	if len(m.Types) > 0 {
-		for _, s := range m.Types {
-			l = len(s)
-			n += 1 + l + sovPb(uint64(l))
-		}
-	}
-	l = len(m.Data)
+	l = len(m.Val)
 	if l > 0 {
 		n += 1 + l + sovPb(uint64(l))
 	}
+	if m.ValType != 0 {
+		n += 1 + sovPb(uint64(m.ValType))
+	}
 	return n
 }
 
-func (m *Posting) Size() (n int) {
+func (m *SrcFunction) Size() (n int) {
 	if m == nil {
 		return 0
 	}
 	var l int
 	_ = l
-	if m.Uid != 0 {
-		n += 9
-	}
-	l = len(m.Value)
-	if l > 0 {
-		n += 1 + l + sovPb(uint64(l))
-	}
-	if m.ValType != 0 {
-		n += 1 + sovPb(uint64(m.ValType))
-	}
-	if m.PostingType != 0 {
-		n += 1 + sovPb(uint64(m.PostingType))
-	}
-	l = len(m.LangTag)
+	l = len(m.Name)
 	if l > 0 {
 		n += 1 + l + sovPb(uint64(l))
 	}
-	if len(m.Facets) > 0 {
-		for _, e := range m.Facets {
-			l = e.Size()
+	if len(m.Args) > 0 {
+		for _, s := range m.Args {
+			l = len(s)
 			n += 1 + l + sovPb(uint64(l))
 		}
 	}
-	if m.Op != 0 {
-		n += 1 + sovPb(uint64(m.Op))
-	}
-	if m.StartTs != 0 {
-		n += 1 + sovPb(uint64(m.StartTs))
+	if m.IsCount {
+		n += 2
 	}
-	if m.CommitTs != 0 {
-		n += 1 + sovPb(uint64(m.CommitTs))
+	l = len(m.JsonPath)
+	if l > 0 {
+		n += 1 + l + sovPb(uint64(l))
 	}
 	return n
 }
 
-func (m *UidBlock) Size() (n int) {
+func (m *Query) Size() (n int) {
 	if m == nil {
 		return 0
 	}
 	var l int
 	_ = l
-	if m.Base != 0 {
-		n += 1 + sovPb(uint64(m.Base))
-	}
-	l = len(m.Deltas)
+	l = len(m.Attr)
 	if l > 0 {
 		n += 1 + l + sovPb(uint64(l))
 	}
-	if m.NumUids != 0 {
-		n += 1 + sovPb(uint64(m.NumUids))
-	}
-	return n
-}
-
-func (m *UidPack) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if m.BlockSize != 0 {
-		n += 1 + sovPb(uint64(m.BlockSize))
-	}
-	if len(m.Blocks) > 0 {
-		for _, e := range m.Blocks {
-			l = e.Size()
+	if len(m.Langs) > 0 {
+		for _, s := range m.Langs {
+			l = len(s)
 			n += 1 + l + sovPb(uint64(l))
 		}
 	}
-	if m.AllocRef != 0 {
-		n += 2 + sovPb(uint64(m.AllocRef))
+	if m.AfterUid != 0 {
+		n += 9
 	}
-	return n
-}
-
-func (m *PostingList) Size() (n int) {
-	if m == nil {
-		return 0
+	if m.DoCount {
+		n += 2
 	}
-	var l int
-	_ = l
-	if m.Pack != nil {
-		l = m.Pack.Size()
+	if m.UidList != nil {
+		l = m.UidList.Size()
 		n += 1 + l + sovPb(uint64(l))
 	}
-	if len(m.Postings) > 0 {
-		for _, e := range m.Postings {
-			l = e.Size()
-			n += 1 + l + sovPb(uint64(l))
-		}
-	}
-	if m.CommitTs != 0 {
-		n += 1 + sovPb(uint64(m.CommitTs))
-	}
-	if len(m.Splits) > 0 {
-		l = 0
-		for _, e := range m.Splits {
-			l += sovPb(uint64(e))
-		}
-		n += 1 + sovPb(uint64(l)) + l
+	if m.SrcFunc != nil {
+		l = m.SrcFunc.Size()
+		n += 1 + l + sovPb(uint64(l))
 	}
-	return n
-}
-
-func (m *FacetParam) Size() (n int) {
-	if m == nil {
-		return 0
+	if m.Reverse {
+		n += 2
 	}
-	var l int
-	_ = l
-	l = len(m.Key)
-	if l > 0 {
+	if m.FacetParam != nil {
+		l = m.FacetParam.Size()
 		n += 1 + l + sovPb(uint64(l))
 	}
-	l = len(m.Alias)
-	if l > 0 {
+	if m.FacetsFilter != nil {
+		l = m.FacetsFilter.Size()
 		n += 1 + l + sovPb(uint64(l))
 	}
-	return n
-}
-
-func (m *FacetParams) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if m.AllKeys {
+	if m.ExpandAll {
 		n += 2
 	}
-	if len(m.Param) > 0 {
-		for _, e := range m.Param {
-			l = e.Size()
-			n += 1 + l + sovPb(uint64(l))
-		}
+	if m.ReadTs != 0 {
+		n += 1 + sovPb(uint64(m.ReadTs))
 	}
-	return n
-}
-
-func (m *Facets) Size() (n int) {
-	if m == nil {
-		return 0
+	if m.Cache != 0 {
+		n += 1 + sovPb(uint64(m.Cache))
 	}
-	var l int
-	_ = l
-	if len(m.Facets) > 0 {
-		for _, e := range m.Facets {
-			l = e.Size()
-			n += 1 + l + sovPb(uint64(l))
-		}
+	if m.First != 0 {
+		n += 1 + sovPb(uint64(m.First))
+	}
+	if m.Offset != 0 {
+		n += 2 + sovPb(uint64(m.Offset))
+	}
+	if m.TrackReads {
+		n += 3
 	}
 	return n
 }
 
-func (m *FacetsList) Size() (n int) {
+func (m *ValueList) Size() (n int) {
 	if m == nil {
 		return 0
 	}
 	var l int
 	_ = l
-	if len(m.FacetsList) > 0 {
-		for _, e := range m.FacetsList {
+	if len(m.Values) > 0 {
+		for _, e := range m.Values {
 			l = e.Size()
 			n += 1 + l + sovPb(uint64(l))
 		}
@@ -13022,22 +13536,14 @@ func (m *FacetsList) Size() (n int) {
 	return n
 }
 
-func (m *Function) Size() (n int) {
+func (m *LangList) Size() (n int) {
 	if m == nil {
 		return 0
 	}
 	var l int
 	_ = l
-	l = len(m.Name)
-	if l > 0 {
-		n += 1 + l + sovPb(uint64(l))
-	}
-	l = len(m.Key)
-	if l > 0 {
-		n += 1 + l + sovPb(uint64(l))
-	}
-	if len(m.Args) > 0 {
-		for _, s := range m.Args {
+	if len(m.Lang) > 0 {
+		for _, s := range m.Lang {
 			l = len(s)
 			n += 1 + l + sovPb(uint64(l))
 		}
@@ -13045,52 +13551,51 @@ func (m *Function) Size() (n int) {
 	return n
 }
 
-func (m *FilterTree) Size() (n int) {
+func (m *Result) Size() (n int) {
 	if m == nil {
 		return 0
 	}
 	var l int
 	_ = l
-	l = len(m.Op)
-	if l > 0 {
-		n += 1 + l + sovPb(uint64(l))
-	}
-	if len(m.Children) > 0 {
-		for _, e := range m.Children {
+	if len(m.UidMatrix) > 0 {
+		for _, e := range m.UidMatrix {
 			l = e.Size()
 			n += 1 + l + sovPb(uint64(l))
 		}
 	}
-	if m.Func != nil {
-		l = m.Func.Size()
-		n += 1 + l + sovPb(uint64(l))
+	if len(m.ValueMatrix) > 0 {
+		for _, e := range m.ValueMatrix {
+			l = e.Size()
+			n += 1 + l + sovPb(uint64(l))
+		}
 	}
-	return n
-}
-
-func (m *SchemaRequest) Size() (n int) {
-	if m == nil {
-		return 0
+	if len(m.Counts) > 0 {
+		l = 0
+		for _, e := range m.Counts {
+			l += sovPb(uint64(e))
+		}
+		n += 1 + sovPb(uint64(l)) + l
 	}
-	var l int
-	_ = l
-	if m.GroupId != 0 {
-		n += 1 + sovPb(uint64(m.GroupId))
+	if m.IntersectDest {
+		n += 2
 	}
-	if len(m.Predicates) > 0 {
-		for _, s := range m.Predicates {
-			l = len(s)
+	if len(m.FacetMatrix) > 0 {
+		for _, e := range m.FacetMatrix {
+			l = e.Size()
 			n += 1 + l + sovPb(uint64(l))
 		}
 	}
-	if len(m.Fields) > 0 {
-		for _, s := range m.Fields {
-			l = len(s)
+	if len(m.LangMatrix) > 0 {
+		for _, e := range m.LangMatrix {
+			l = e.Size()
 			n += 1 + l + sovPb(uint64(l))
 		}
 	}
-	if len(m.Types) > 0 {
-		for _, s := range m.Types {
+	if m.List {
+		n += 2
+	}
+	if len(m.ReadKeys) > 0 {
+		for _, s := range m.ReadKeys {
 			l = len(s)
 			n += 1 + l + sovPb(uint64(l))
 		}
@@ -13098,58 +13603,66 @@ func (m *SchemaRequest) Size() (n int) {
 	return n
 }
 
-func (m *SchemaNode) Size() (n int) {
+func (m *Order) Size() (n int) {
 	if m == nil {
 		return 0
 	}
 	var l int
 	_ = l
-	l = len(m.Predicate)
-	if l > 0 {
-		n += 1 + l + sovPb(uint64(l))
-	}
-	l = len(m.Type)
+	l = len(m.Attr)
 	if l > 0 {
 		n += 1 + l + sovPb(uint64(l))
 	}
-	if m.Index {
+	if m.Desc {
 		n += 2
 	}
-	if len(m.Tokenizer) > 0 {
-		for _, s := range m.Tokenizer {
+	if len(m.Langs) > 0 {
+		for _, s := range m.Langs {
 			l = len(s)
 			n += 1 + l + sovPb(uint64(l))
 		}
 	}
-	if m.Reverse {
-		n += 2
+	return n
+}
+
+func (m *SortMessage) Size() (n int) {
+	if m == nil {
+		return 0
 	}
-	if m.Count {
-		n += 2
+	var l int
+	_ = l
+	if len(m.Order) > 0 {
+		for _, e := range m.Order {
+			l = e.Size()
+			n += 1 + l + sovPb(uint64(l))
+		}
 	}
-	if m.List {
-		n += 2
+	if len(m.UidMatrix) > 0 {
+		for _, e := range m.UidMatrix {
+			l = e.Size()
+			n += 1 + l + sovPb(uint64(l))
+		}
 	}
-	if m.Upsert {
-		n += 2
+	if m.Count != 0 {
+		n += 1 + sovPb(uint64(m.Count))
 	}
-	if m.Lang {
-		n += 2
+	if m.Offset != 0 {
+		n += 1 + sovPb(uint64(m.Offset))
 	}
-	if m.NoConflict {
-		n += 2
+	if m.ReadTs != 0 {
+		n += 1 + sovPb(uint64(m.ReadTs))
 	}
 	return n
 }
 
-func (m *SchemaResult) Size() (n int) {
+func (m *SortResult) Size() (n int) {
 	if m == nil {
 		return 0
 	}
 	var l int
 	_ = l
-	if len(m.Schema) > 0 {
-		for _, e := range m.Schema {
+	if len(m.UidMatrix) > 0 {
+		for _, e := range m.UidMatrix {
 			l = e.Size()
 			n += 1 + l + sovPb(uint64(l))
 		}
@@ -13157,456 +13670,449 @@ func (m *SchemaResult) Size() (n int) {
 	return n
 }
 
-func (m *SchemaUpdate) Size() (n int) {
+func (m *RaftContext) Size() (n int) {
 	if m == nil {
 		return 0
 	}
 	var l int
 	_ = l
-	l = len(m.Predicate)
+	if m.Id != 0 {
+		n += 9
+	}
+	if m.Group != 0 {
+		n += 1 + sovPb(uint64(m.Group))
+	}
+	l = len(m.Addr)
 	if l > 0 {
 		n += 1 + l + sovPb(uint64(l))
 	}
-	if m.ValueType != 0 {
-		n += 1 + sovPb(uint64(m.ValueType))
+	if m.SnapshotTs != 0 {
+		n += 1 + sovPb(uint64(m.SnapshotTs))
 	}
-	if m.Directive != 0 {
-		n += 1 + sovPb(uint64(m.Directive))
+	if m.IsLearner {
+		n += 2
 	}
-	if len(m.Tokenizer) > 0 {
-		for _, s := range m.Tokenizer {
-			l = len(s)
-			n += 1 + l + sovPb(uint64(l))
-		}
+	return n
+}
+
+func (m *Member) Size() (n int) {
+	if m == nil {
+		return 0
 	}
-	if m.Count {
-		n += 2
+	var l int
+	_ = l
+	if m.Id != 0 {
+		n += 9
 	}
-	if m.List {
-		n += 2
+	if m.GroupId != 0 {
+		n += 1 + sovPb(uint64(m.GroupId))
 	}
-	if m.Upsert {
-		n += 2
+	l = len(m.Addr)
+	if l > 0 {
+		n += 1 + l + sovPb(uint64(l))
 	}
-	if m.Lang {
+	if m.Leader {
 		n += 2
 	}
-	if m.NonNullable {
+	if m.AmDead {
 		n += 2
 	}
-	if m.NonNullableList {
+	if m.LastUpdate != 0 {
+		n += 1 + sovPb(uint64(m.LastUpdate))
+	}
+	if m.Learner {
 		n += 2
 	}
-	l = len(m.ObjectTypeName)
-	if l > 0 {
-		n += 1 + l + sovPb(uint64(l))
+	if m.ClusterInfoOnly {
+		n += 2
 	}
-	if m.NoConflict {
+	if m.ForceGroupId {
 		n += 2
 	}
 	return n
 }
 
-func (m *TypeUpdate) Size() (n int) {
+func (m *Group) Size() (n int) {
 	if m == nil {
 		return 0
 	}
 	var l int
 	_ = l
-	l = len(m.TypeName)
-	if l > 0 {
-		n += 1 + l + sovPb(uint64(l))
+	if len(m.Members) > 0 {
+		for k, v := range m.Members {
+			_ = k
+			_ = v
+			l = 0
+			if v != nil {
+				l = v.Size()
+				l += 1 + sovPb(uint64(l))
+			}
+			mapEntrySize := 1 + sovPb(uint64(k)) + l
+			n += mapEntrySize + 1 + sovPb(uint64(mapEntrySize))
+		}
 	}
-	if len(m.Fields) > 0 {
-		for _, e := range m.Fields {
-			l = e.Size()
-			n += 1 + l + sovPb(uint64(l))
+	if len(m.Tablets) > 0 {
+		for k, v := range m.Tablets {
+			_ = k
+			_ = v
+			l = 0
+			if v != nil {
+				l = v.Size()
+				l += 1 + sovPb(uint64(l))
+			}
+			mapEntrySize := 1 + len(k) + sovPb(uint64(len(k))) + l
+			n += mapEntrySize + 1 + sovPb(uint64(mapEntrySize))
 		}
 	}
-	return n
-}
-
-func (m *MapHeader) Size() (n int) {
-	if m == nil {
-		return 0
+	if m.SnapshotTs != 0 {
+		n += 1 + sovPb(uint64(m.SnapshotTs))
 	}
-	var l int
-	_ = l
-	if len(m.PartitionKeys) > 0 {
-		for _, b := range m.PartitionKeys {
-			l = len(b)
-			n += 1 + l + sovPb(uint64(l))
-		}
+	if m.Checksum != 0 {
+		n += 1 + sovPb(uint64(m.Checksum))
+	}
+	if m.CheckpointTs != 0 {
+		n += 1 + sovPb(uint64(m.CheckpointTs))
 	}
 	return n
 }
 
-func (m *MovePredicatePayload) Size() (n int) {
+func (m *License) Size() (n int) {
 	if m == nil {
 		return 0
 	}
 	var l int
 	_ = l
-	l = len(m.Predicate)
+	l = len(m.User)
 	if l > 0 {
 		n += 1 + l + sovPb(uint64(l))
 	}
-	if m.SourceGid != 0 {
-		n += 1 + sovPb(uint64(m.SourceGid))
-	}
-	if m.DestGid != 0 {
-		n += 1 + sovPb(uint64(m.DestGid))
-	}
-	if m.TxnTs != 0 {
-		n += 1 + sovPb(uint64(m.TxnTs))
-	}
-	if m.ExpectedChecksum != 0 {
-		n += 1 + sovPb(uint64(m.ExpectedChecksum))
-	}
-	return n
-}
-
-func (m *TxnStatus) Size() (n int) {
-	if m == nil {
-		return 0
+	if m.MaxNodes != 0 {
+		n += 1 + sovPb(uint64(m.MaxNodes))
 	}
-	var l int
-	_ = l
-	if m.StartTs != 0 {
-		n += 1 + sovPb(uint64(m.StartTs))
+	if m.ExpiryTs != 0 {
+		n += 1 + sovPb(uint64(m.ExpiryTs))
 	}
-	if m.CommitTs != 0 {
-		n += 1 + sovPb(uint64(m.CommitTs))
+	if m.Enabled {
+		n += 2
 	}
 	return n
 }
 
-func (m *OracleDelta) Size() (n int) {
+func (m *ZeroProposal) Size() (n int) {
 	if m == nil {
 		return 0
 	}
 	var l int
 	_ = l
-	if len(m.Txns) > 0 {
-		for _, e := range m.Txns {
-			l = e.Size()
-			n += 1 + l + sovPb(uint64(l))
-		}
-	}
-	if m.MaxAssigned != 0 {
-		n += 1 + sovPb(uint64(m.MaxAssigned))
-	}
-	if len(m.GroupChecksums) > 0 {
-		for k, v := range m.GroupChecksums {
+	if len(m.SnapshotTs) > 0 {
+		for k, v := range m.SnapshotTs {
 			_ = k
 			_ = v
 			mapEntrySize := 1 + sovPb(uint64(k)) + 1 + sovPb(uint64(v))
 			n += mapEntrySize + 1 + sovPb(uint64(mapEntrySize))
 		}
 	}
-	return n
-}
-
-func (m *TxnTimestamps) Size() (n int) {
-	if m == nil {
-		return 0
+	if m.Member != nil {
+		l = m.Member.Size()
+		n += 1 + l + sovPb(uint64(l))
 	}
-	var l int
-	_ = l
-	if len(m.Ts) > 0 {
-		l = 0
-		for _, e := range m.Ts {
-			l += sovPb(uint64(e))
-		}
-		n += 1 + sovPb(uint64(l)) + l
+	if m.Tablet != nil {
+		l = m.Tablet.Size()
+		n += 1 + l + sovPb(uint64(l))
 	}
-	return n
-}
-
-func (m *PeerResponse) Size() (n int) {
-	if m == nil {
-		return 0
+	if m.MaxUID != 0 {
+		n += 1 + sovPb(uint64(m.MaxUID))
 	}
-	var l int
-	_ = l
-	if m.Status {
-		n += 2
+	if m.MaxTxnTs != 0 {
+		n += 1 + sovPb(uint64(m.MaxTxnTs))
 	}
-	return n
-}
-
-func (m *RaftBatch) Size() (n int) {
-	if m == nil {
-		return 0
+	if m.MaxRaftId != 0 {
+		n += 1 + sovPb(uint64(m.MaxRaftId))
 	}
-	var l int
-	_ = l
-	if m.Context != nil {
-		l = m.Context.Size()
+	if m.Txn != nil {
+		l = m.Txn.Size()
 		n += 1 + l + sovPb(uint64(l))
 	}
-	if m.Payload != nil {
-		l = m.Payload.Size()
+	l = len(m.Cid)
+	if l > 0 {
 		n += 1 + l + sovPb(uint64(l))
 	}
-	return n
-}
-
-func (m *TabletResponse) Size() (n int) {
-	if m == nil {
-		return 0
+	if m.License != nil {
+		l = m.License.Size()
+		n += 1 + l + sovPb(uint64(l))
 	}
-	var l int
-	_ = l
-	if len(m.Tablets) > 0 {
-		for _, e := range m.Tablets {
-			l = e.Size()
-			n += 1 + l + sovPb(uint64(l))
-		}
+	if m.Snapshot != nil {
+		l = m.Snapshot.Size()
+		n += 1 + l + sovPb(uint64(l))
 	}
-	return n
-}
-
-func (m *TabletRequest) Size() (n int) {
-	if m == nil {
-		return 0
+	if m.MaxNsID != 0 {
+		n += 1 + sovPb(uint64(m.MaxNsID))
+	}
+	if m.DeleteNs != nil {
+		l = m.DeleteNs.Size()
+		n += 1 + l + sovPb(uint64(l))
 	}
-	var l int
-	_ = l
 	if len(m.Tablets) > 0 {
 		for _, e := range m.Tablets {
 			l = e.Size()
 			n += 1 + l + sovPb(uint64(l))
 		}
 	}
-	if m.GroupId != 0 {
-		n += 1 + sovPb(uint64(m.GroupId))
-	}
 	return n
 }
 
-func (m *SubscriptionRequest) Size() (n int) {
+func (m *MembershipState) Size() (n int) {
 	if m == nil {
 		return 0
 	}
 	var l int
 	_ = l
-	if len(m.Prefixes) > 0 {
-		for _, b := range m.Prefixes {
-			l = len(b)
-			n += 1 + l + sovPb(uint64(l))
-		}
+	if m.Counter != 0 {
+		n += 1 + sovPb(uint64(m.Counter))
 	}
-	if len(m.Matches) > 0 {
-		for _, e := range m.Matches {
-			l = e.Size()
-			n += 1 + l + sovPb(uint64(l))
+	if len(m.Groups) > 0 {
+		for k, v := range m.Groups {
+			_ = k
+			_ = v
+			l = 0
+			if v != nil {
+				l = v.Size()
+				l += 1 + sovPb(uint64(l))
+			}
+			mapEntrySize := 1 + sovPb(uint64(k)) + l
+			n += mapEntrySize + 1 + sovPb(uint64(mapEntrySize))
 		}
 	}
-	return n
-}
-
-func (m *SubscriptionResponse) Size() (n int) {
-	if m == nil {
-		return 0
+	if len(m.Zeros) > 0 {
+		for k, v := range m.Zeros {
+			_ = k
+			_ = v
+			l = 0
+			if v != nil {
+				l = v.Size()
+				l += 1 + sovPb(uint64(l))
+			}
+			mapEntrySize := 1 + sovPb(uint64(k)) + l
+			n += mapEntrySize + 1 + sovPb(uint64(mapEntrySize))
+		}
 	}
-	var l int
-	_ = l
-	if m.Kvs != nil {
-		l = m.Kvs.Size()
-		n += 1 + l + sovPb(uint64(l))
+	if m.MaxUID != 0 {
+		n += 1 + sovPb(uint64(m.MaxUID))
 	}
-	return n
-}
-
-func (m *Num) Size() (n int) {
-	if m == nil {
-		return 0
+	if m.MaxTxnTs != 0 {
+		n += 1 + sovPb(uint64(m.MaxTxnTs))
 	}
-	var l int
-	_ = l
-	if m.Val != 0 {
-		n += 1 + sovPb(uint64(m.Val))
+	if m.MaxRaftId != 0 {
+		n += 1 + sovPb(uint64(m.MaxRaftId))
 	}
-	if m.ReadOnly {
-		n += 2
+	if len(m.Removed) > 0 {
+		for _, e := range m.Removed {
+			l = e.Size()
+			n += 1 + l + sovPb(uint64(l))
+		}
 	}
-	if m.Forwarded {
-		n += 2
+	l = len(m.Cid)
+	if l > 0 {
+		n += 1 + l + sovPb(uint64(l))
 	}
-	if m.Type != 0 {
-		n += 1 + sovPb(uint64(m.Type))
+	if m.License != nil {
+		l = m.License.Size()
+		n += 1 + l + sovPb(uint64(l))
+	}
+	if m.MaxNsID != 0 {
+		n += 1 + sovPb(uint64(m.MaxNsID))
 	}
 	return n
 }
 
-func (m *AssignedIds) Size() (n int) {
+func (m *ConnectionState) Size() (n int) {
 	if m == nil {
 		return 0
 	}
 	var l int
 	_ = l
-	if m.StartId != 0 {
-		n += 1 + sovPb(uint64(m.StartId))
+	if m.Member != nil {
+		l = m.Member.Size()
+		n += 1 + l + sovPb(uint64(l))
 	}
-	if m.EndId != 0 {
-		n += 1 + sovPb(uint64(m.EndId))
+	if m.State != nil {
+		l = m.State.Size()
+		n += 1 + l + sovPb(uint64(l))
 	}
-	if m.ReadOnly != 0 {
-		n += 1 + sovPb(uint64(m.ReadOnly))
+	if m.MaxPending != 0 {
+		n += 1 + sovPb(uint64(m.MaxPending))
 	}
 	return n
 }
 
-func (m *RemoveNodeRequest) Size() (n int) {
+func (m *HealthInfo) Size() (n int) {
 	if m == nil {
 		return 0
 	}
 	var l int
 	_ = l
-	if m.NodeId != 0 {
-		n += 1 + sovPb(uint64(m.NodeId))
+	l = len(m.Instance)
+	if l > 0 {
+		n += 1 + l + sovPb(uint64(l))
 	}
-	if m.GroupId != 0 {
-		n += 1 + sovPb(uint64(m.GroupId))
+	l = len(m.Address)
+	if l > 0 {
+		n += 1 + l + sovPb(uint64(l))
 	}
-	return n
-}
-
-func (m *MoveTabletRequest) Size() (n int) {
-	if m == nil {
-		return 0
+	l = len(m.Status)
+	if l > 0 {
+		n += 1 + l + sovPb(uint64(l))
 	}
-	var l int
-	_ = l
-	if m.Namespace != 0 {
-		n += 1 + sovPb(uint64(m.Namespace))
+	l = len(m.Group)
+	if l > 0 {
+		n += 1 + l + sovPb(uint64(l))
 	}
-	l = len(m.Tablet)
+	l = len(m.Version)
 	if l > 0 {
 		n += 1 + l + sovPb(uint64(l))
 	}
-	if m.DstGroup != 0 {
-		n += 1 + sovPb(uint64(m.DstGroup))
+	if m.Uptime != 0 {
+		n += 1 + sovPb(uint64(m.Uptime))
 	}
-	return n
-}
-
-func (m *ApplyLicenseRequest) Size() (n int) {
-	if m == nil {
-		return 0
+	if m.LastEcho != 0 {
+		n += 1 + sovPb(uint64(m.LastEcho))
 	}
-	var l int
-	_ = l
-	l = len(m.License)
-	if l > 0 {
-		n += 1 + l + sovPb(uint64(l))
+	if len(m.Ongoing) > 0 {
+		for _, s := range m.Ongoing {
+			l = len(s)
+			n += 1 + l + sovPb(uint64(l))
+		}
 	}
-	return n
-}
-
-func (m *SnapshotMeta) Size() (n int) {
-	if m == nil {
-		return 0
+	if len(m.Indexing) > 0 {
+		for _, s := range m.Indexing {
+			l = len(s)
+			n += 1 + l + sovPb(uint64(l))
+		}
 	}
-	var l int
-	_ = l
-	if m.ClientTs != 0 {
-		n += 1 + sovPb(uint64(m.ClientTs))
+	if len(m.EeFeatures) > 0 {
+		for _, s := range m.EeFeatures {
+			l = len(s)
+			n += 1 + l + sovPb(uint64(l))
+		}
 	}
-	if m.GroupId != 0 {
-		n += 1 + sovPb(uint64(m.GroupId))
+	if m.MaxAssigned != 0 {
+		n += 1 + sovPb(uint64(m.MaxAssigned))
 	}
 	return n
 }
 
-func (m *Status) Size() (n int) {
+func (m *Tablet) Size() (n int) {
 	if m == nil {
 		return 0
 	}
 	var l int
 	_ = l
-	if m.Code != 0 {
-		n += 1 + sovPb(uint64(m.Code))
+	if m.GroupId != 0 {
+		n += 1 + sovPb(uint64(m.GroupId))
 	}
-	l = len(m.Msg)
+	l = len(m.Predicate)
 	if l > 0 {
 		n += 1 + l + sovPb(uint64(l))
 	}
+	if m.Force {
+		n += 2
+	}
+	if m.OnDiskBytes != 0 {
+		n += 1 + sovPb(uint64(m.OnDiskBytes))
+	}
+	if m.Remove {
+		n += 2
+	}
+	if m.ReadOnly {
+		n += 2
+	}
+	if m.MoveTs != 0 {
+		n += 1 + sovPb(uint64(m.MoveTs))
+	}
+	if m.UncompressedBytes != 0 {
+		n += 1 + sovPb(uint64(m.UncompressedBytes))
+	}
 	return n
 }
 
-func (m *BackupRequest) Size() (n int) {
+func (m *DirectedEdge) Size() (n int) {
 	if m == nil {
 		return 0
 	}
 	var l int
 	_ = l
-	if m.ReadTs != 0 {
-		n += 1 + sovPb(uint64(m.ReadTs))
-	}
-	if m.SinceTs != 0 {
-		n += 1 + sovPb(uint64(m.SinceTs))
-	}
-	if m.GroupId != 0 {
-		n += 1 + sovPb(uint64(m.GroupId))
+	if m.Entity != 0 {
+		n += 9
 	}
-	l = len(m.UnixTs)
+	l = len(m.Attr)
 	if l > 0 {
 		n += 1 + l + sovPb(uint64(l))
 	}
-	l = len(m.Destination)
+	l = len(m.Value)
 	if l > 0 {
 		n += 1 + l + sovPb(uint64(l))
 	}
-	l = len(m.AccessKey)
-	if l > 0 {
-		n += 1 + l + sovPb(uint64(l))
+	if m.ValueType != 0 {
+		n += 1 + sovPb(uint64(m.ValueType))
 	}
-	l = len(m.SecretKey)
-	if l > 0 {
-		n += 1 + l + sovPb(uint64(l))
+	if m.ValueId != 0 {
+		n += 9
 	}
-	l = len(m.SessionToken)
+	l = len(m.Lang)
 	if l > 0 {
 		n += 1 + l + sovPb(uint64(l))
 	}
-	if m.Anonymous {
-		n += 2
+	if m.Op != 0 {
+		n += 1 + sovPb(uint64(m.Op))
 	}
-	if len(m.Predicates) > 0 {
-		for _, s := range m.Predicates {
+	if len(m.Facets) > 0 {
+		for _, e := range m.Facets {
+			l = e.Size()
+			n += 1 + l + sovPb(uint64(l))
+		}
+	}
+	if len(m.AllowedPreds) > 0 {
+		for _, s := range m.AllowedPreds {
 			l = len(s)
 			n += 1 + l + sovPb(uint64(l))
 		}
 	}
-	if m.ForceFull {
-		n += 2
+	if m.Namespace != 0 {
+		n += 1 + sovPb(uint64(m.Namespace))
 	}
 	return n
 }
 
-func (m *BackupResponse) Size() (n int) {
+func (m *Mutations) Size() (n int) {
 	if m == nil {
 		return 0
 	}
 	var l int
 	_ = l
-	if len(m.DropOperations) > 0 {
-		for _, e := range m.DropOperations {
+	if m.GroupId != 0 {
+		n += 1 + sovPb(uint64(m.GroupId))
+	}
+	if m.StartTs != 0 {
+		n += 1 + sovPb(uint64(m.StartTs))
+	}
+	if len(m.Edges) > 0 {
+		for _, e := range m.Edges {
 			l = e.Size()
 			n += 1 + l + sovPb(uint64(l))
 		}
 	}
-	return n
-}
-
-func (m *DropOperation) Size() (n int) {
-	if m == nil {
-		return 0
+	if len(m.Schema) > 0 {
+		for _, e := range m.Schema {
+			l = e.Size()
+			n += 1 + l + sovPb(uint64(l))
+		}
+	}
+	if len(m.Types) > 0 {
+		for _, e := range m.Types {
+			l = e.Size()
+			n += 1 + l + sovPb(uint64(l))
+		}
 	}
-	var l int
-	_ = l
 	if m.DropOp != 0 {
 		n += 1 + sovPb(uint64(m.DropOp))
 	}
@@ -13614,29 +14120,142 @@ func (m *DropOperation) Size() (n int) {
 	if l > 0 {
 		n += 1 + l + sovPb(uint64(l))
 	}
-	return n
-}
-
-func (m *ExportRequest) Size() (n int) {
+	if m.Metadata != nil {
+		l = m.Metadata.Size()
+		n += 1 + l + sovPb(uint64(l))
+	}
+	if len(m.Synonyms) > 0 {
+		for _, e := range m.Synonyms {
+			l = e.Size()
+			n += 1 + l + sovPb(uint64(l))
+		}
+	}
+	if len(m.SchemaVersions) > 0 {
+		for _, e := range m.SchemaVersions {
+			l = e.Size()
+			n += 1 + l + sovPb(uint64(l))
+		}
+	}
+	if len(m.Tombstones) > 0 {
+		for _, e := range m.Tombstones {
+			l = e.Size()
+			n += 1 + l + sovPb(uint64(l))
+		}
+	}
+	if len(m.Triggers) > 0 {
+		for _, e := range m.Triggers {
+			l = e.Size()
+			n += 1 + l + sovPb(uint64(l))
+		}
+	}
+	if len(m.StoredQueries) > 0 {
+		for _, e := range m.StoredQueries {
+			l = e.Size()
+			n += 1 + l + sovPb(uint64(l))
+		}
+	}
+	if len(m.CancelIndexing) > 0 {
+		for _, s := range m.CancelIndexing {
+			l = len(s)
+			n += 1 + l + sovPb(uint64(l))
+		}
+	}
+	if m.IfVersion != 0 {
+		n += 2 + sovPb(uint64(m.IfVersion))
+	}
+	if len(m.TriggerEvents) > 0 {
+		for _, e := range m.TriggerEvents {
+			l = e.Size()
+			n += 2 + l + sovPb(uint64(l))
+		}
+	}
+	if len(m.TtlMarks) > 0 {
+		for _, e := range m.TtlMarks {
+			l = e.Size()
+			n += 2 + l + sovPb(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *Metadata) Size() (n int) {
 	if m == nil {
 		return 0
 	}
 	var l int
 	_ = l
-	if m.GroupId != 0 {
-		n += 1 + sovPb(uint64(m.GroupId))
+	if len(m.PredHints) > 0 {
+		for k, v := range m.PredHints {
+			_ = k
+			_ = v
+			mapEntrySize := 1 + len(k) + sovPb(uint64(len(k))) + 1 + sovPb(uint64(v))
+			n += mapEntrySize + 1 + sovPb(uint64(mapEntrySize))
+		}
+	}
+	return n
+}
+
+func (m *Snapshot) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Context != nil {
+		l = m.Context.Size()
+		n += 1 + l + sovPb(uint64(l))
+	}
+	if m.Index != 0 {
+		n += 1 + sovPb(uint64(m.Index))
 	}
 	if m.ReadTs != 0 {
 		n += 1 + sovPb(uint64(m.ReadTs))
 	}
-	if m.UnixTs != 0 {
-		n += 1 + sovPb(uint64(m.UnixTs))
+	if m.Done {
+		n += 2
 	}
-	l = len(m.Format)
+	if m.SinceTs != 0 {
+		n += 1 + sovPb(uint64(m.SinceTs))
+	}
+	return n
+}
+
+func (m *ZeroSnapshot) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Index != 0 {
+		n += 1 + sovPb(uint64(m.Index))
+	}
+	if m.CheckpointTs != 0 {
+		n += 1 + sovPb(uint64(m.CheckpointTs))
+	}
+	if m.State != nil {
+		l = m.State.Size()
+		n += 1 + l + sovPb(uint64(l))
+	}
+	return n
+}
+
+func (m *RestoreRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.GroupId != 0 {
+		n += 1 + sovPb(uint64(m.GroupId))
+	}
+	if m.RestoreTs != 0 {
+		n += 1 + sovPb(uint64(m.RestoreTs))
+	}
+	l = len(m.Location)
 	if l > 0 {
 		n += 1 + l + sovPb(uint64(l))
 	}
-	l = len(m.Destination)
+	l = len(m.BackupId)
 	if l > 0 {
 		n += 1 + l + sovPb(uint64(l))
 	}
@@ -13655,219 +14274,3001 @@ func (m *ExportRequest) Size() (n int) {
 	if m.Anonymous {
 		n += 2
 	}
-	if m.Namespace != 0 {
-		n += 1 + sovPb(uint64(m.Namespace))
-	}
-	return n
-}
-
-func (m *ExportResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if m.Code != 0 {
-		n += 1 + sovPb(uint64(m.Code))
-	}
-	l = len(m.Msg)
+	l = len(m.EncryptionKeyFile)
 	if l > 0 {
 		n += 1 + l + sovPb(uint64(l))
 	}
-	if len(m.Files) > 0 {
-		for _, s := range m.Files {
-			l = len(s)
-			n += 1 + l + sovPb(uint64(l))
-		}
-	}
-	return n
-}
-
-func (m *BackupKey) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if m.Type != 0 {
-		n += 1 + sovPb(uint64(m.Type))
+	l = len(m.VaultAddr)
+	if l > 0 {
+		n += 1 + l + sovPb(uint64(l))
 	}
-	l = len(m.Attr)
+	l = len(m.VaultRoleidFile)
 	if l > 0 {
 		n += 1 + l + sovPb(uint64(l))
 	}
-	if m.Uid != 0 {
-		n += 1 + sovPb(uint64(m.Uid))
+	l = len(m.VaultSecretidFile)
+	if l > 0 {
+		n += 1 + l + sovPb(uint64(l))
 	}
-	if m.StartUid != 0 {
-		n += 1 + sovPb(uint64(m.StartUid))
+	l = len(m.VaultPath)
+	if l > 0 {
+		n += 1 + l + sovPb(uint64(l))
 	}
-	l = len(m.Term)
+	l = len(m.VaultField)
 	if l > 0 {
 		n += 1 + l + sovPb(uint64(l))
 	}
-	if m.Count != 0 {
-		n += 1 + sovPb(uint64(m.Count))
+	l = len(m.VaultFormat)
+	if l > 0 {
+		n += 1 + l + sovPb(uint64(l))
 	}
-	if m.Namespace != 0 {
-		n += 1 + sovPb(uint64(m.Namespace))
+	if m.BackupNum != 0 {
+		n += 2 + sovPb(uint64(m.BackupNum))
 	}
 	return n
 }
 
-func (m *BackupPostingList) Size() (n int) {
+func (m *Proposal) Size() (n int) {
 	if m == nil {
 		return 0
 	}
 	var l int
 	_ = l
-	if len(m.Uids) > 0 {
-		l = 0
-		for _, e := range m.Uids {
-			l += sovPb(uint64(e))
-		}
-		n += 1 + sovPb(uint64(l)) + l
+	if m.Mutations != nil {
+		l = m.Mutations.Size()
+		n += 1 + l + sovPb(uint64(l))
 	}
-	if len(m.Postings) > 0 {
-		for _, e := range m.Postings {
+	if len(m.Kv) > 0 {
+		for _, e := range m.Kv {
 			l = e.Size()
 			n += 1 + l + sovPb(uint64(l))
 		}
 	}
-	if m.CommitTs != 0 {
-		n += 1 + sovPb(uint64(m.CommitTs))
-	}
-	if len(m.Splits) > 0 {
-		l = 0
-		for _, e := range m.Splits {
-			l += sovPb(uint64(e))
-		}
-		n += 1 + sovPb(uint64(l)) + l
+	if m.State != nil {
+		l = m.State.Size()
+		n += 1 + l + sovPb(uint64(l))
 	}
-	l = len(m.UidBytes)
+	l = len(m.CleanPredicate)
 	if l > 0 {
 		n += 1 + l + sovPb(uint64(l))
 	}
-	return n
-}
-
-func (m *UpdateGraphQLSchemaRequest) Size() (n int) {
-	if m == nil {
-		return 0
+	if m.Delta != nil {
+		l = m.Delta.Size()
+		n += 1 + l + sovPb(uint64(l))
 	}
-	var l int
-	_ = l
-	if m.StartTs != 0 {
-		n += 1 + sovPb(uint64(m.StartTs))
+	if m.Snapshot != nil {
+		l = m.Snapshot.Size()
+		n += 1 + l + sovPb(uint64(l))
 	}
-	l = len(m.GraphqlSchema)
-	if l > 0 {
+	if m.Index != 0 {
+		n += 1 + sovPb(uint64(m.Index))
+	}
+	if m.ExpectedChecksum != 0 {
+		n += 1 + sovPb(uint64(m.ExpectedChecksum))
+	}
+	if m.Restore != nil {
+		l = m.Restore.Size()
 		n += 1 + l + sovPb(uint64(l))
 	}
-	if len(m.DgraphPreds) > 0 {
-		for _, e := range m.DgraphPreds {
-			l = e.Size()
-			n += 1 + l + sovPb(uint64(l))
-		}
+	if m.CdcState != nil {
+		l = m.CdcState.Size()
+		n += 1 + l + sovPb(uint64(l))
 	}
-	if len(m.DgraphTypes) > 0 {
-		for _, e := range m.DgraphTypes {
-			l = e.Size()
-			n += 1 + l + sovPb(uint64(l))
-		}
+	if m.DeleteNs != nil {
+		l = m.DeleteNs.Size()
+		n += 1 + l + sovPb(uint64(l))
+	}
+	if m.StartTs != 0 {
+		n += 2 + sovPb(uint64(m.StartTs))
 	}
 	return n
 }
 
-func (m *UpdateGraphQLSchemaResponse) Size() (n int) {
+func (m *CDCState) Size() (n int) {
 	if m == nil {
 		return 0
 	}
 	var l int
 	_ = l
-	if m.Uid != 0 {
-		n += 1 + sovPb(uint64(m.Uid))
+	if m.SentTs != 0 {
+		n += 1 + sovPb(uint64(m.SentTs))
 	}
 	return n
 }
 
-func (m *BulkMeta) Size() (n int) {
+func (m *KVS) Size() (n int) {
 	if m == nil {
 		return 0
 	}
 	var l int
 	_ = l
-	if m.EdgeCount != 0 {
-		n += 1 + sovPb(uint64(m.EdgeCount))
+	if m.Done {
+		n += 2
 	}
-	if len(m.SchemaMap) > 0 {
-		for k, v := range m.SchemaMap {
-			_ = k
-			_ = v
-			l = 0
-			if v != nil {
-				l = v.Size()
-				l += 1 + sovPb(uint64(l))
-			}
-			mapEntrySize := 1 + len(k) + sovPb(uint64(len(k))) + l
-			n += mapEntrySize + 1 + sovPb(uint64(mapEntrySize))
+	if len(m.Predicates) > 0 {
+		for _, s := range m.Predicates {
+			l = len(s)
+			n += 1 + l + sovPb(uint64(l))
 		}
 	}
 	if len(m.Types) > 0 {
-		for _, e := range m.Types {
+		for _, s := range m.Types {
+			l = len(s)
+			n += 1 + l + sovPb(uint64(l))
+		}
+	}
+	l = len(m.Data)
+	if l > 0 {
+		n += 1 + l + sovPb(uint64(l))
+	}
+	return n
+}
+
+func (m *Posting) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Uid != 0 {
+		n += 9
+	}
+	l = len(m.Value)
+	if l > 0 {
+		n += 1 + l + sovPb(uint64(l))
+	}
+	if m.ValType != 0 {
+		n += 1 + sovPb(uint64(m.ValType))
+	}
+	if m.PostingType != 0 {
+		n += 1 + sovPb(uint64(m.PostingType))
+	}
+	l = len(m.LangTag)
+	if l > 0 {
+		n += 1 + l + sovPb(uint64(l))
+	}
+	if len(m.Facets) > 0 {
+		for _, e := range m.Facets {
 			l = e.Size()
 			n += 1 + l + sovPb(uint64(l))
 		}
 	}
+	if m.Op != 0 {
+		n += 1 + sovPb(uint64(m.Op))
+	}
+	if m.StartTs != 0 {
+		n += 1 + sovPb(uint64(m.StartTs))
+	}
+	if m.CommitTs != 0 {
+		n += 1 + sovPb(uint64(m.CommitTs))
+	}
 	return n
 }
 
-func (m *DeleteNsRequest) Size() (n int) {
+func (m *UidBlock) Size() (n int) {
 	if m == nil {
 		return 0
 	}
 	var l int
 	_ = l
-	if m.GroupId != 0 {
-		n += 1 + sovPb(uint64(m.GroupId))
+	if m.Base != 0 {
+		n += 1 + sovPb(uint64(m.Base))
 	}
-	if m.Namespace != 0 {
-		n += 1 + sovPb(uint64(m.Namespace))
+	l = len(m.Deltas)
+	if l > 0 {
+		n += 1 + l + sovPb(uint64(l))
+	}
+	if m.NumUids != 0 {
+		n += 1 + sovPb(uint64(m.NumUids))
 	}
 	return n
 }
 
-func (m *TaskStatusRequest) Size() (n int) {
+func (m *UidPack) Size() (n int) {
 	if m == nil {
 		return 0
 	}
 	var l int
 	_ = l
-	if m.TaskId != 0 {
-		n += 1 + sovPb(uint64(m.TaskId))
+	if m.BlockSize != 0 {
+		n += 1 + sovPb(uint64(m.BlockSize))
+	}
+	if len(m.Blocks) > 0 {
+		for _, e := range m.Blocks {
+			l = e.Size()
+			n += 1 + l + sovPb(uint64(l))
+		}
+	}
+	if m.AllocRef != 0 {
+		n += 2 + sovPb(uint64(m.AllocRef))
 	}
 	return n
 }
 
-func (m *TaskStatusResponse) Size() (n int) {
+func (m *PostingList) Size() (n int) {
 	if m == nil {
 		return 0
 	}
 	var l int
 	_ = l
-	if m.TaskMeta != 0 {
-		n += 1 + sovPb(uint64(m.TaskMeta))
+	if m.Pack != nil {
+		l = m.Pack.Size()
+		n += 1 + l + sovPb(uint64(l))
+	}
+	if len(m.Postings) > 0 {
+		for _, e := range m.Postings {
+			l = e.Size()
+			n += 1 + l + sovPb(uint64(l))
+		}
+	}
+	if m.CommitTs != 0 {
+		n += 1 + sovPb(uint64(m.CommitTs))
+	}
+	if len(m.Splits) > 0 {
+		l = 0
+		for _, e := range m.Splits {
+			l += sovPb(uint64(e))
+		}
+		n += 1 + sovPb(uint64(l)) + l
 	}
 	return n
 }
 
-func sovPb(x uint64) (n int) {
-	return (math_bits.Len64(x|1) + 6) / 7
+func (m *FacetParam) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Key)
+	if l > 0 {
+		n += 1 + l + sovPb(uint64(l))
+	}
+	l = len(m.Alias)
+	if l > 0 {
+		n += 1 + l + sovPb(uint64(l))
+	}
+	return n
 }
-func sozPb(x uint64) (n int) {
-	return sovPb(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+
+func (m *FacetParams) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.AllKeys {
+		n += 2
+	}
+	if len(m.Param) > 0 {
+		for _, e := range m.Param {
+			l = e.Size()
+			n += 1 + l + sovPb(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *Facets) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Facets) > 0 {
+		for _, e := range m.Facets {
+			l = e.Size()
+			n += 1 + l + sovPb(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *FacetsList) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.FacetsList) > 0 {
+		for _, e := range m.FacetsList {
+			l = e.Size()
+			n += 1 + l + sovPb(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *Function) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Name)
+	if l > 0 {
+		n += 1 + l + sovPb(uint64(l))
+	}
+	l = len(m.Key)
+	if l > 0 {
+		n += 1 + l + sovPb(uint64(l))
+	}
+	if len(m.Args) > 0 {
+		for _, s := range m.Args {
+			l = len(s)
+			n += 1 + l + sovPb(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *FilterTree) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Op)
+	if l > 0 {
+		n += 1 + l + sovPb(uint64(l))
+	}
+	if len(m.Children) > 0 {
+		for _, e := range m.Children {
+			l = e.Size()
+			n += 1 + l + sovPb(uint64(l))
+		}
+	}
+	if m.Func != nil {
+		l = m.Func.Size()
+		n += 1 + l + sovPb(uint64(l))
+	}
+	return n
+}
+
+func (m *SchemaRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.GroupId != 0 {
+		n += 1 + sovPb(uint64(m.GroupId))
+	}
+	if len(m.Predicates) > 0 {
+		for _, s := range m.Predicates {
+			l = len(s)
+			n += 1 + l + sovPb(uint64(l))
+		}
+	}
+	if len(m.Fields) > 0 {
+		for _, s := range m.Fields {
+			l = len(s)
+			n += 1 + l + sovPb(uint64(l))
+		}
+	}
+	if len(m.Types) > 0 {
+		for _, s := range m.Types {
+			l = len(s)
+			n += 1 + l + sovPb(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *SchemaNode) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Predicate)
+	if l > 0 {
+		n += 1 + l + sovPb(uint64(l))
+	}
+	l = len(m.Type)
+	if l > 0 {
+		n += 1 + l + sovPb(uint64(l))
+	}
+	if m.Index {
+		n += 2
+	}
+	if len(m.Tokenizer) > 0 {
+		for _, s := range m.Tokenizer {
+			l = len(s)
+			n += 1 + l + sovPb(uint64(l))
+		}
+	}
+	if m.Reverse {
+		n += 2
+	}
+	if m.Count {
+		n += 2
+	}
+	if m.List {
+		n += 2
+	}
+	if m.Upsert {
+		n += 2
+	}
+	if m.Lang {
+		n += 2
+	}
+	if m.NoConflict {
+		n += 2
+	}
+	if m.Ordered {
+		n += 2
+	}
+	l = len(m.Ttl)
+	if l > 0 {
+		n += 1 + l + sovPb(uint64(l))
+	}
+	return n
+}
+
+func (m *SchemaResult) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Schema) > 0 {
+		for _, e := range m.Schema {
+			l = e.Size()
+			n += 1 + l + sovPb(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *SchemaUpdate) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Predicate)
+	if l > 0 {
+		n += 1 + l + sovPb(uint64(l))
+	}
+	if m.ValueType != 0 {
+		n += 1 + sovPb(uint64(m.ValueType))
+	}
+	if m.Directive != 0 {
+		n += 1 + sovPb(uint64(m.Directive))
+	}
+	if len(m.Tokenizer) > 0 {
+		for _, s := range m.Tokenizer {
+			l = len(s)
+			n += 1 + l + sovPb(uint64(l))
+		}
+	}
+	if m.Count {
+		n += 2
+	}
+	if m.List {
+		n += 2
+	}
+	if m.Upsert {
+		n += 2
+	}
+	if m.Lang {
+		n += 2
+	}
+	if m.NonNullable {
+		n += 2
+	}
+	if m.NonNullableList {
+		n += 2
+	}
+	l = len(m.ObjectTypeName)
+	if l > 0 {
+		n += 1 + l + sovPb(uint64(l))
+	}
+	if m.NoConflict {
+		n += 2
+	}
+	if m.Ordered {
+		n += 2
+	}
+	if m.Ttl != 0 {
+		n += 1 + sovPb(uint64(m.Ttl))
+	}
+	return n
+}
+
+func (m *TypeUpdate) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.TypeName)
+	if l > 0 {
+		n += 1 + l + sovPb(uint64(l))
+	}
+	if len(m.Fields) > 0 {
+		for _, e := range m.Fields {
+			l = e.Size()
+			n += 1 + l + sovPb(uint64(l))
+		}
+	}
+	if m.Strict {
+		n += 2
+	}
+	l = len(m.Extends)
+	if l > 0 {
+		n += 1 + l + sovPb(uint64(l))
+	}
+	if m.Ttl != 0 {
+		n += 1 + sovPb(uint64(m.Ttl))
+	}
+	return n
+}
+
+func (m *MapHeader) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.PartitionKeys) > 0 {
+		for _, b := range m.PartitionKeys {
+			l = len(b)
+			n += 1 + l + sovPb(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *MovePredicatePayload) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Predicate)
+	if l > 0 {
+		n += 1 + l + sovPb(uint64(l))
+	}
+	if m.SourceGid != 0 {
+		n += 1 + sovPb(uint64(m.SourceGid))
+	}
+	if m.DestGid != 0 {
+		n += 1 + sovPb(uint64(m.DestGid))
+	}
+	if m.TxnTs != 0 {
+		n += 1 + sovPb(uint64(m.TxnTs))
+	}
+	if m.ExpectedChecksum != 0 {
+		n += 1 + sovPb(uint64(m.ExpectedChecksum))
+	}
+	return n
+}
+
+func (m *TxnStatus) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.StartTs != 0 {
+		n += 1 + sovPb(uint64(m.StartTs))
+	}
+	if m.CommitTs != 0 {
+		n += 1 + sovPb(uint64(m.CommitTs))
+	}
+	return n
+}
+
+func (m *OracleDelta) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Txns) > 0 {
+		for _, e := range m.Txns {
+			l = e.Size()
+			n += 1 + l + sovPb(uint64(l))
+		}
+	}
+	if m.MaxAssigned != 0 {
+		n += 1 + sovPb(uint64(m.MaxAssigned))
+	}
+	if len(m.GroupChecksums) > 0 {
+		for k, v := range m.GroupChecksums {
+			_ = k
+			_ = v
+			mapEntrySize := 1 + sovPb(uint64(k)) + 1 + sovPb(uint64(v))
+			n += mapEntrySize + 1 + sovPb(uint64(mapEntrySize))
+		}
+	}
+	return n
+}
+
+func (m *TxnTimestamps) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Ts) > 0 {
+		l = 0
+		for _, e := range m.Ts {
+			l += sovPb(uint64(e))
+		}
+		n += 1 + sovPb(uint64(l)) + l
+	}
+	return n
+}
+
+func (m *PeerResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Status {
+		n += 2
+	}
+	return n
+}
+
+func (m *RaftBatch) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Context != nil {
+		l = m.Context.Size()
+		n += 1 + l + sovPb(uint64(l))
+	}
+	if m.Payload != nil {
+		l = m.Payload.Size()
+		n += 1 + l + sovPb(uint64(l))
+	}
+	return n
+}
+
+func (m *TabletResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Tablets) > 0 {
+		for _, e := range m.Tablets {
+			l = e.Size()
+			n += 1 + l + sovPb(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *TabletRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Tablets) > 0 {
+		for _, e := range m.Tablets {
+			l = e.Size()
+			n += 1 + l + sovPb(uint64(l))
+		}
+	}
+	if m.GroupId != 0 {
+		n += 1 + sovPb(uint64(m.GroupId))
+	}
+	return n
+}
+
+func (m *SubscriptionRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Prefixes) > 0 {
+		for _, b := range m.Prefixes {
+			l = len(b)
+			n += 1 + l + sovPb(uint64(l))
+		}
+	}
+	if len(m.Matches) > 0 {
+		for _, e := range m.Matches {
+			l = e.Size()
+			n += 1 + l + sovPb(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *SubscriptionResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Kvs != nil {
+		l = m.Kvs.Size()
+		n += 1 + l + sovPb(uint64(l))
+	}
+	return n
+}
+
+func (m *Num) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Val != 0 {
+		n += 1 + sovPb(uint64(m.Val))
+	}
+	if m.ReadOnly {
+		n += 2
+	}
+	if m.Forwarded {
+		n += 2
+	}
+	if m.Type != 0 {
+		n += 1 + sovPb(uint64(m.Type))
+	}
+	return n
+}
+
+func (m *AssignedIds) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.StartId != 0 {
+		n += 1 + sovPb(uint64(m.StartId))
+	}
+	if m.EndId != 0 {
+		n += 1 + sovPb(uint64(m.EndId))
+	}
+	if m.ReadOnly != 0 {
+		n += 1 + sovPb(uint64(m.ReadOnly))
+	}
+	return n
+}
+
+func (m *RemoveNodeRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.NodeId != 0 {
+		n += 1 + sovPb(uint64(m.NodeId))
+	}
+	if m.GroupId != 0 {
+		n += 1 + sovPb(uint64(m.GroupId))
+	}
+	return n
+}
+
+func (m *MoveTabletRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Namespace != 0 {
+		n += 1 + sovPb(uint64(m.Namespace))
+	}
+	l = len(m.Tablet)
+	if l > 0 {
+		n += 1 + l + sovPb(uint64(l))
+	}
+	if m.DstGroup != 0 {
+		n += 1 + sovPb(uint64(m.DstGroup))
+	}
+	return n
+}
+
+func (m *ApplyLicenseRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.License)
+	if l > 0 {
+		n += 1 + l + sovPb(uint64(l))
+	}
+	return n
+}
+
+func (m *SnapshotMeta) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.ClientTs != 0 {
+		n += 1 + sovPb(uint64(m.ClientTs))
+	}
+	if m.GroupId != 0 {
+		n += 1 + sovPb(uint64(m.GroupId))
+	}
+	return n
+}
+
+func (m *Status) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Code != 0 {
+		n += 1 + sovPb(uint64(m.Code))
+	}
+	l = len(m.Msg)
+	if l > 0 {
+		n += 1 + l + sovPb(uint64(l))
+	}
+	return n
+}
+
+func (m *BackupRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.ReadTs != 0 {
+		n += 1 + sovPb(uint64(m.ReadTs))
+	}
+	if m.SinceTs != 0 {
+		n += 1 + sovPb(uint64(m.SinceTs))
+	}
+	if m.GroupId != 0 {
+		n += 1 + sovPb(uint64(m.GroupId))
+	}
+	l = len(m.UnixTs)
+	if l > 0 {
+		n += 1 + l + sovPb(uint64(l))
+	}
+	l = len(m.Destination)
+	if l > 0 {
+		n += 1 + l + sovPb(uint64(l))
+	}
+	l = len(m.AccessKey)
+	if l > 0 {
+		n += 1 + l + sovPb(uint64(l))
+	}
+	l = len(m.SecretKey)
+	if l > 0 {
+		n += 1 + l + sovPb(uint64(l))
+	}
+	l = len(m.SessionToken)
+	if l > 0 {
+		n += 1 + l + sovPb(uint64(l))
+	}
+	if m.Anonymous {
+		n += 2
+	}
+	if len(m.Predicates) > 0 {
+		for _, s := range m.Predicates {
+			l = len(s)
+			n += 1 + l + sovPb(uint64(l))
+		}
+	}
+	if m.ForceFull {
+		n += 2
+	}
+	return n
+}
+
+func (m *BackupResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.DropOperations) > 0 {
+		for _, e := range m.DropOperations {
+			l = e.Size()
+			n += 1 + l + sovPb(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *DropOperation) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.DropOp != 0 {
+		n += 1 + sovPb(uint64(m.DropOp))
+	}
+	l = len(m.DropValue)
+	if l > 0 {
+		n += 1 + l + sovPb(uint64(l))
+	}
+	return n
+}
+
+func (m *ExportRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.GroupId != 0 {
+		n += 1 + sovPb(uint64(m.GroupId))
+	}
+	if m.ReadTs != 0 {
+		n += 1 + sovPb(uint64(m.ReadTs))
+	}
+	if m.UnixTs != 0 {
+		n += 1 + sovPb(uint64(m.UnixTs))
+	}
+	l = len(m.Format)
+	if l > 0 {
+		n += 1 + l + sovPb(uint64(l))
+	}
+	l = len(m.Destination)
+	if l > 0 {
+		n += 1 + l + sovPb(uint64(l))
+	}
+	l = len(m.AccessKey)
+	if l > 0 {
+		n += 1 + l + sovPb(uint64(l))
+	}
+	l = len(m.SecretKey)
+	if l > 0 {
+		n += 1 + l + sovPb(uint64(l))
+	}
+	l = len(m.SessionToken)
+	if l > 0 {
+		n += 1 + l + sovPb(uint64(l))
+	}
+	if m.Anonymous {
+		n += 2
+	}
+	if m.Namespace != 0 {
+		n += 1 + sovPb(uint64(m.Namespace))
+	}
+	return n
+}
+
+func (m *ExportResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Code != 0 {
+		n += 1 + sovPb(uint64(m.Code))
+	}
+	l = len(m.Msg)
+	if l > 0 {
+		n += 1 + l + sovPb(uint64(l))
+	}
+	if len(m.Files) > 0 {
+		for _, s := range m.Files {
+			l = len(s)
+			n += 1 + l + sovPb(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *BackupKey) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Type != 0 {
+		n += 1 + sovPb(uint64(m.Type))
+	}
+	l = len(m.Attr)
+	if l > 0 {
+		n += 1 + l + sovPb(uint64(l))
+	}
+	if m.Uid != 0 {
+		n += 1 + sovPb(uint64(m.Uid))
+	}
+	if m.StartUid != 0 {
+		n += 1 + sovPb(uint64(m.StartUid))
+	}
+	l = len(m.Term)
+	if l > 0 {
+		n += 1 + l + sovPb(uint64(l))
+	}
+	if m.Count != 0 {
+		n += 1 + sovPb(uint64(m.Count))
+	}
+	if m.Namespace != 0 {
+		n += 1 + sovPb(uint64(m.Namespace))
+	}
+	return n
+}
+
+func (m *BackupPostingList) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Uids) > 0 {
+		l = 0
+		for _, e := range m.Uids {
+			l += sovPb(uint64(e))
+		}
+		n += 1 + sovPb(uint64(l)) + l
+	}
+	if len(m.Postings) > 0 {
+		for _, e := range m.Postings {
+			l = e.Size()
+			n += 1 + l + sovPb(uint64(l))
+		}
+	}
+	if m.CommitTs != 0 {
+		n += 1 + sovPb(uint64(m.CommitTs))
+	}
+	if len(m.Splits) > 0 {
+		l = 0
+		for _, e := range m.Splits {
+			l += sovPb(uint64(e))
+		}
+		n += 1 + sovPb(uint64(l)) + l
+	}
+	l = len(m.UidBytes)
+	if l > 0 {
+		n += 1 + l + sovPb(uint64(l))
+	}
+	return n
+}
+
+func (m *UpdateGraphQLSchemaRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.StartTs != 0 {
+		n += 1 + sovPb(uint64(m.StartTs))
+	}
+	l = len(m.GraphqlSchema)
+	if l > 0 {
+		n += 1 + l + sovPb(uint64(l))
+	}
+	if len(m.DgraphPreds) > 0 {
+		for _, e := range m.DgraphPreds {
+			l = e.Size()
+			n += 1 + l + sovPb(uint64(l))
+		}
+	}
+	if len(m.DgraphTypes) > 0 {
+		for _, e := range m.DgraphTypes {
+			l = e.Size()
+			n += 1 + l + sovPb(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *UpdateGraphQLSchemaResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Uid != 0 {
+		n += 1 + sovPb(uint64(m.Uid))
+	}
+	return n
+}
+
+func (m *BulkMeta) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.EdgeCount != 0 {
+		n += 1 + sovPb(uint64(m.EdgeCount))
+	}
+	if len(m.SchemaMap) > 0 {
+		for k, v := range m.SchemaMap {
+			_ = k
+			_ = v
+			l = 0
+			if v != nil {
+				l = v.Size()
+				l += 1 + sovPb(uint64(l))
+			}
+			mapEntrySize := 1 + len(k) + sovPb(uint64(len(k))) + l
+			n += mapEntrySize + 1 + sovPb(uint64(mapEntrySize))
+		}
+	}
+	if len(m.Types) > 0 {
+		for _, e := range m.Types {
+			l = e.Size()
+			n += 1 + l + sovPb(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *DeleteNsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.GroupId != 0 {
+		n += 1 + sovPb(uint64(m.GroupId))
+	}
+	if m.Namespace != 0 {
+		n += 1 + sovPb(uint64(m.Namespace))
+	}
+	return n
+}
+
+func (m *TaskStatusRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.TaskId != 0 {
+		n += 1 + sovPb(uint64(m.TaskId))
+	}
+	if m.Cancel {
+		n += 2
+	}
+	return n
+}
+
+func (m *TaskStatusResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.TaskMeta != 0 {
+		n += 1 + sovPb(uint64(m.TaskMeta))
+	}
+	if m.Progress != 0 {
+		n += 1 + sovPb(uint64(m.Progress))
+	}
+	return n
+}
+
+func (m *SynonymUpdate) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Name)
+	if l > 0 {
+		n += 1 + l + sovPb(uint64(l))
+	}
+	if len(m.Words) > 0 {
+		for _, s := range m.Words {
+			l = len(s)
+			n += 1 + l + sovPb(uint64(l))
+		}
+	}
+	if m.Version != 0 {
+		n += 1 + sovPb(uint64(m.Version))
+	}
+	return n
+}
+
+func (m *SchemaVersion) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Namespace != 0 {
+		n += 1 + sovPb(uint64(m.Namespace))
+	}
+	if m.Version != 0 {
+		n += 1 + sovPb(uint64(m.Version))
+	}
+	if m.Graphql {
+		n += 2
+	}
+	l = len(m.Schema)
+	if l > 0 {
+		n += 1 + l + sovPb(uint64(l))
+	}
+	l = len(m.Author)
+	if l > 0 {
+		n += 1 + l + sovPb(uint64(l))
+	}
+	if m.CreatedAt != 0 {
+		n += 1 + sovPb(uint64(m.CreatedAt))
+	}
+	return n
+}
+
+func (m *Tombstone) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Namespace != 0 {
+		n += 1 + sovPb(uint64(m.Namespace))
+	}
+	if m.Uid != 0 {
+		n += 1 + sovPb(uint64(m.Uid))
+	}
+	if m.DeletedAt != 0 {
+		n += 1 + sovPb(uint64(m.DeletedAt))
+	}
+	if m.CreatedAt != 0 {
+		n += 1 + sovPb(uint64(m.CreatedAt))
+	}
+	if len(m.Predicates) > 0 {
+		for _, s := range m.Predicates {
+			l = len(s)
+			n += 1 + l + sovPb(uint64(l))
+		}
+	}
+	if m.Remove {
+		n += 2
+	}
+	if m.StartTs != 0 {
+		n += 1 + sovPb(uint64(m.StartTs))
+	}
+	return n
+}
+
+func (m *Trigger) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Name)
+	if l > 0 {
+		n += 1 + l + sovPb(uint64(l))
+	}
+	l = len(m.Predicate)
+	if l > 0 {
+		n += 1 + l + sovPb(uint64(l))
+	}
+	l = len(m.TypeName)
+	if l > 0 {
+		n += 1 + l + sovPb(uint64(l))
+	}
+	l = len(m.Url)
+	if l > 0 {
+		n += 1 + l + sovPb(uint64(l))
+	}
+	if m.BeforeCommit {
+		n += 2
+	}
+	if m.Version != 0 {
+		n += 1 + sovPb(uint64(m.Version))
+	}
+	return n
+}
+
+func (m *StoredQuery) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Name)
+	if l > 0 {
+		n += 1 + l + sovPb(uint64(l))
+	}
+	l = len(m.Query)
+	if l > 0 {
+		n += 1 + l + sovPb(uint64(l))
+	}
+	if len(m.Groups) > 0 {
+		for _, s := range m.Groups {
+			l = len(s)
+			n += 1 + l + sovPb(uint64(l))
+		}
+	}
+	if m.Version != 0 {
+		n += 1 + sovPb(uint64(m.Version))
+	}
+	return n
+}
+
+func (m *DeleteByQueryRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Namespace != 0 {
+		n += 1 + sovPb(uint64(m.Namespace))
+	}
+	l = len(m.Query)
+	if l > 0 {
+		n += 1 + l + sovPb(uint64(l))
+	}
+	if m.BatchSize != 0 {
+		n += 1 + sovPb(uint64(m.BatchSize))
+	}
+	return n
+}
+
+func (m *TriggerEvent) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Trigger)
+	if l > 0 {
+		n += 1 + l + sovPb(uint64(l))
+	}
+	l = len(m.Url)
+	if l > 0 {
+		n += 1 + l + sovPb(uint64(l))
+	}
+	l = len(m.Payload)
+	if l > 0 {
+		n += 1 + l + sovPb(uint64(l))
+	}
+	if m.StartTs != 0 {
+		n += 1 + sovPb(uint64(m.StartTs))
+	}
+	if m.CommitTs != 0 {
+		n += 1 + sovPb(uint64(m.CommitTs))
+	}
+	if m.Id != 0 {
+		n += 1 + sovPb(uint64(m.Id))
+	}
+	if m.Remove {
+		n += 2
+	}
+	return n
+}
+
+func (m *TTLMark) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Ts != 0 {
+		n += 1 + sovPb(uint64(m.Ts))
+	}
+	if m.Time != 0 {
+		n += 1 + sovPb(uint64(m.Time))
+	}
+	if m.Remove {
+		n += 2
+	}
+	return n
+}
+
+func sovPb(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozPb(x uint64) (n int) {
+	return sovPb(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *List) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowPb
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: List: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: List: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType == 1 {
+				var v uint64
+				if (iNdEx + 8) > l {
+					return io.ErrUnexpectedEOF
+				}
+				v = uint64(encoding_binary.LittleEndian.Uint64(dAtA[iNdEx:]))
+				iNdEx += 8
+				m.Uids = append(m.Uids, v)
+			} else if wireType == 2 {
+				var packedLen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowPb
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					packedLen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if packedLen < 0 {
+					return ErrInvalidLengthPb
+				}
+				postIndex := iNdEx + packedLen
+				if postIndex < 0 {
+					return ErrInvalidLengthPb
+				}
+				if postIndex > l {
+					return io.ErrUnexpectedEOF
+				}
+				var elementCount int
+				elementCount = packedLen / 8
+				if elementCount != 0 && len(m.Uids) == 0 {
+					m.Uids = make([]uint64, 0, elementCount)
+				}
+				for iNdEx < postIndex {
+					var v uint64
+					if (iNdEx + 8) > l {
+						return io.ErrUnexpectedEOF
+					}
+					v = uint64(encoding_binary.LittleEndian.Uint64(dAtA[iNdEx:]))
+					iNdEx += 8
+					m.Uids = append(m.Uids, v)
+				}
+			} else {
+				return fmt.Errorf("proto: wrong wireType = %d for field Uids", wireType)
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipPb(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthPb
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *TaskValue) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowPb
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: TaskValue: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: TaskValue: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Val", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPb
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthPb
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthPb
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Val = append(m.Val[:0], dAtA[iNdEx:postIndex]...)
+			if m.Val == nil {
+				m.Val = []byte{}
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ValType", wireType)
+			}
+			m.ValType = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPb
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ValType |= Posting_ValType(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipPb(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthPb
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *SrcFunction) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowPb
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: SrcFunction: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: SrcFunction: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPb
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthPb
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthPb
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Name = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Args", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPb
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthPb
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthPb
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Args = append(m.Args, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field IsCount", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPb
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.IsCount = bool(v != 0)
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field JsonPath", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPb
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthPb
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthPb
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.JsonPath = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipPb(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthPb
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *Query) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowPb
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Query: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Query: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Attr", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPb
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthPb
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthPb
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Attr = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Langs", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPb
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthPb
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthPb
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Langs = append(m.Langs, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 3:
+			if wireType != 1 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AfterUid", wireType)
+			}
+			m.AfterUid = 0
+			if (iNdEx + 8) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.AfterUid = uint64(encoding_binary.LittleEndian.Uint64(dAtA[iNdEx:]))
+			iNdEx += 8
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DoCount", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPb
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.DoCount = bool(v != 0)
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field UidList", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPb
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthPb
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthPb
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.UidList == nil {
+				m.UidList = &List{}
+			}
+			if err := m.UidList.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SrcFunc", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPb
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthPb
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthPb
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.SrcFunc == nil {
+				m.SrcFunc = &SrcFunction{}
+			}
+			if err := m.SrcFunc.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 7:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Reverse", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPb
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Reverse = bool(v != 0)
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FacetParam", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPb
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthPb
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthPb
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.FacetParam == nil {
+				m.FacetParam = &FacetParams{}
+			}
+			if err := m.FacetParam.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 9:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FacetsFilter", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPb
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthPb
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthPb
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.FacetsFilter == nil {
+				m.FacetsFilter = &FilterTree{}
+			}
+			if err := m.FacetsFilter.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 10:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ExpandAll", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPb
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.ExpandAll = bool(v != 0)
+		case 13:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ReadTs", wireType)
+			}
+			m.ReadTs = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPb
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ReadTs |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 14:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Cache", wireType)
+			}
+			m.Cache = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPb
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Cache |= int32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 15:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field First", wireType)
+			}
+			m.First = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPb
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.First |= int32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 16:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Offset", wireType)
+			}
+			m.Offset = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPb
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Offset |= int32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 17:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TrackReads", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPb
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.TrackReads = bool(v != 0)
+		default:
+			iNdEx = preIndex
+			skippy, err := skipPb(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthPb
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ValueList) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowPb
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ValueList: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ValueList: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Values", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPb
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthPb
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthPb
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Values = append(m.Values, &TaskValue{})
+			if err := m.Values[len(m.Values)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipPb(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthPb
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *LangList) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowPb
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: LangList: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: LangList: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Lang", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPb
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthPb
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthPb
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Lang = append(m.Lang, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipPb(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthPb
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *Result) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowPb
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Result: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Result: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field UidMatrix", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPb
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthPb
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthPb
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.UidMatrix = append(m.UidMatrix, &List{})
+			if err := m.UidMatrix[len(m.UidMatrix)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ValueMatrix", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPb
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthPb
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthPb
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ValueMatrix = append(m.ValueMatrix, &ValueList{})
+			if err := m.ValueMatrix[len(m.ValueMatrix)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType == 0 {
+				var v uint32
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowPb
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					v |= uint32(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				m.Counts = append(m.Counts, v)
+			} else if wireType == 2 {
+				var packedLen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowPb
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					packedLen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if packedLen < 0 {
+					return ErrInvalidLengthPb
+				}
+				postIndex := iNdEx + packedLen
+				if postIndex < 0 {
+					return ErrInvalidLengthPb
+				}
+				if postIndex > l {
+					return io.ErrUnexpectedEOF
+				}
+				var elementCount int
+				var count int
+				for _, integer := range dAtA[iNdEx:postIndex] {
+					if integer < 128 {
+						count++
+					}
+				}
+				elementCount = count
+				if elementCount != 0 && len(m.Counts) == 0 {
+					m.Counts = make([]uint32, 0, elementCount)
+				}
+				for iNdEx < postIndex {
+					var v uint32
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowPb
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						v |= uint32(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					m.Counts = append(m.Counts, v)
+				}
+			} else {
+				return fmt.Errorf("proto: wrong wireType = %d for field Counts", wireType)
+			}
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field IntersectDest", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPb
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.IntersectDest = bool(v != 0)
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FacetMatrix", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPb
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthPb
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthPb
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.FacetMatrix = append(m.FacetMatrix, &FacetsList{})
+			if err := m.FacetMatrix[len(m.FacetMatrix)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LangMatrix", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPb
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthPb
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthPb
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.LangMatrix = append(m.LangMatrix, &LangList{})
+			if err := m.LangMatrix[len(m.LangMatrix)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 7:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field List", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPb
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.List = bool(v != 0)
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ReadKeys", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPb
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthPb
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthPb
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ReadKeys = append(m.ReadKeys, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipPb(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthPb
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *Order) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowPb
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Order: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Order: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Attr", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPb
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthPb
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthPb
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Attr = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Desc", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPb
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Desc = bool(v != 0)
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Langs", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPb
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthPb
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthPb
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Langs = append(m.Langs, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipPb(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthPb
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *SortMessage) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowPb
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: SortMessage: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: SortMessage: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Order", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPb
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthPb
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthPb
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Order = append(m.Order, &Order{})
+			if err := m.Order[len(m.Order)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field UidMatrix", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPb
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthPb
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthPb
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.UidMatrix = append(m.UidMatrix, &List{})
+			if err := m.UidMatrix[len(m.UidMatrix)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Count", wireType)
+			}
+			m.Count = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPb
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Count |= int32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Offset", wireType)
+			}
+			m.Offset = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPb
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Offset |= int32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 13:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ReadTs", wireType)
+			}
+			m.ReadTs = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPb
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ReadTs |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipPb(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthPb
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
 }
-func (m *List) Unmarshal(dAtA []byte) error {
+func (m *SortResult) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -13890,64 +17291,46 @@ func (m *List) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: List: wiretype end group for non-group")
+			return fmt.Errorf("proto: SortResult: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: List: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: SortResult: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType == 1 {
-				var v uint64
-				if (iNdEx + 8) > l {
-					return io.ErrUnexpectedEOF
-				}
-				v = uint64(encoding_binary.LittleEndian.Uint64(dAtA[iNdEx:]))
-				iNdEx += 8
-				m.Uids = append(m.Uids, v)
-			} else if wireType == 2 {
-				var packedLen int
-				for shift := uint(0); ; shift += 7 {
-					if shift >= 64 {
-						return ErrIntOverflowPb
-					}
-					if iNdEx >= l {
-						return io.ErrUnexpectedEOF
-					}
-					b := dAtA[iNdEx]
-					iNdEx++
-					packedLen |= int(b&0x7F) << shift
-					if b < 0x80 {
-						break
-					}
-				}
-				if packedLen < 0 {
-					return ErrInvalidLengthPb
-				}
-				postIndex := iNdEx + packedLen
-				if postIndex < 0 {
-					return ErrInvalidLengthPb
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field UidMatrix", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPb
 				}
-				if postIndex > l {
+				if iNdEx >= l {
 					return io.ErrUnexpectedEOF
 				}
-				var elementCount int
-				elementCount = packedLen / 8
-				if elementCount != 0 && len(m.Uids) == 0 {
-					m.Uids = make([]uint64, 0, elementCount)
-				}
-				for iNdEx < postIndex {
-					var v uint64
-					if (iNdEx + 8) > l {
-						return io.ErrUnexpectedEOF
-					}
-					v = uint64(encoding_binary.LittleEndian.Uint64(dAtA[iNdEx:]))
-					iNdEx += 8
-					m.Uids = append(m.Uids, v)
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
 				}
-			} else {
-				return fmt.Errorf("proto: wrong wireType = %d for field Uids", wireType)
 			}
+			if msglen < 0 {
+				return ErrInvalidLengthPb
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthPb
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.UidMatrix = append(m.UidMatrix, &List{})
+			if err := m.UidMatrix[len(m.UidMatrix)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipPb(dAtA[iNdEx:])
@@ -13969,7 +17352,7 @@ func (m *List) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *TaskValue) Unmarshal(dAtA []byte) error {
+func (m *RaftContext) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -13992,17 +17375,46 @@ func (m *TaskValue) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: TaskValue: wiretype end group for non-group")
+			return fmt.Errorf("proto: RaftContext: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: TaskValue: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: RaftContext: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
+			if wireType != 1 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+			}
+			m.Id = 0
+			if (iNdEx + 8) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Id = uint64(encoding_binary.LittleEndian.Uint64(dAtA[iNdEx:]))
+			iNdEx += 8
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Group", wireType)
+			}
+			m.Group = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPb
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Group |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Val", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Addr", wireType)
 			}
-			var byteLen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowPb
@@ -14012,31 +17424,29 @@ func (m *TaskValue) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				byteLen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if byteLen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthPb
 			}
-			postIndex := iNdEx + byteLen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthPb
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Val = append(m.Val[:0], dAtA[iNdEx:postIndex]...)
-			if m.Val == nil {
-				m.Val = []byte{}
-			}
+			m.Addr = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 2:
+		case 4:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ValType", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field SnapshotTs", wireType)
 			}
-			m.ValType = 0
+			m.SnapshotTs = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowPb
@@ -14046,11 +17456,31 @@ func (m *TaskValue) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.ValType |= Posting_ValType(b&0x7F) << shift
+				m.SnapshotTs |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field IsLearner", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPb
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
+			m.IsLearner = bool(v != 0)
 		default:
 			iNdEx = preIndex
 			skippy, err := skipPb(dAtA[iNdEx:])
@@ -14072,7 +17502,7 @@ func (m *TaskValue) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *SrcFunction) Unmarshal(dAtA []byte) error {
+func (m *Member) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -14091,21 +17521,102 @@ func (m *SrcFunction) Unmarshal(dAtA []byte) error {
 			if b < 0x80 {
 				break
 			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: SrcFunction: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: SrcFunction: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Member: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Member: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 1 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+			}
+			m.Id = 0
+			if (iNdEx + 8) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Id = uint64(encoding_binary.LittleEndian.Uint64(dAtA[iNdEx:]))
+			iNdEx += 8
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field GroupId", wireType)
+			}
+			m.GroupId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPb
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.GroupId |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Addr", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPb
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthPb
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthPb
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Addr = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Leader", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPb
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Leader = bool(v != 0)
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AmDead", wireType)
 			}
-			var stringLen uint64
+			var v int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowPb
@@ -14115,29 +17626,36 @@ func (m *SrcFunction) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				v |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthPb
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthPb
+			m.AmDead = bool(v != 0)
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LastUpdate", wireType)
 			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
+			m.LastUpdate = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPb
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.LastUpdate |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-			m.Name = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 3:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Args", wireType)
+		case 7:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Learner", wireType)
 			}
-			var stringLen uint64
+			var v int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowPb
@@ -14147,27 +17665,35 @@ func (m *SrcFunction) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				v |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthPb
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthPb
+			m.Learner = bool(v != 0)
+		case 13:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ClusterInfoOnly", wireType)
 			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPb
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-			m.Args = append(m.Args, string(dAtA[iNdEx:postIndex]))
-			iNdEx = postIndex
-		case 4:
+			m.ClusterInfoOnly = bool(v != 0)
+		case 14:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field IsCount", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ForceGroupId", wireType)
 			}
 			var v int
 			for shift := uint(0); ; shift += 7 {
@@ -14184,7 +17710,7 @@ func (m *SrcFunction) Unmarshal(dAtA []byte) error {
 					break
 				}
 			}
-			m.IsCount = bool(v != 0)
+			m.ForceGroupId = bool(v != 0)
 		default:
 			iNdEx = preIndex
 			skippy, err := skipPb(dAtA[iNdEx:])
@@ -14206,7 +17732,7 @@ func (m *SrcFunction) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *Query) Unmarshal(dAtA []byte) error {
+func (m *Group) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -14229,49 +17755,17 @@ func (m *Query) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: Query: wiretype end group for non-group")
+			return fmt.Errorf("proto: Group: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: Query: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: Group: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Attr", wireType)
-			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowPb
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthPb
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthPb
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.Attr = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 2:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Langs", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Members", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowPb
@@ -14281,93 +17775,110 @@ func (m *Query) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthPb
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthPb
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Langs = append(m.Langs, string(dAtA[iNdEx:postIndex]))
-			iNdEx = postIndex
-		case 3:
-			if wireType != 1 {
-				return fmt.Errorf("proto: wrong wireType = %d for field AfterUid", wireType)
-			}
-			m.AfterUid = 0
-			if (iNdEx + 8) > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.AfterUid = uint64(encoding_binary.LittleEndian.Uint64(dAtA[iNdEx:]))
-			iNdEx += 8
-		case 4:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field DoCount", wireType)
-			}
-			var v int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowPb
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				v |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			m.DoCount = bool(v != 0)
-		case 5:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field UidList", wireType)
+			if m.Members == nil {
+				m.Members = make(map[uint64]*Member)
 			}
-			var msglen int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowPb
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
+			var mapkey uint64
+			var mapvalue *Member
+			for iNdEx < postIndex {
+				entryPreIndex := iNdEx
+				var wire uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowPb
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					wire |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
 				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				msglen |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
+				fieldNum := int32(wire >> 3)
+				if fieldNum == 1 {
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowPb
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						mapkey |= uint64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+				} else if fieldNum == 2 {
+					var mapmsglen int
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowPb
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						mapmsglen |= int(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					if mapmsglen < 0 {
+						return ErrInvalidLengthPb
+					}
+					postmsgIndex := iNdEx + mapmsglen
+					if postmsgIndex < 0 {
+						return ErrInvalidLengthPb
+					}
+					if postmsgIndex > l {
+						return io.ErrUnexpectedEOF
+					}
+					mapvalue = &Member{}
+					if err := mapvalue.Unmarshal(dAtA[iNdEx:postmsgIndex]); err != nil {
+						return err
+					}
+					iNdEx = postmsgIndex
+				} else {
+					iNdEx = entryPreIndex
+					skippy, err := skipPb(dAtA[iNdEx:])
+					if err != nil {
+						return err
+					}
+					if (skippy < 0) || (iNdEx+skippy) < 0 {
+						return ErrInvalidLengthPb
+					}
+					if (iNdEx + skippy) > postIndex {
+						return io.ErrUnexpectedEOF
+					}
+					iNdEx += skippy
 				}
 			}
-			if msglen < 0 {
-				return ErrInvalidLengthPb
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthPb
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if m.UidList == nil {
-				m.UidList = &List{}
-			}
-			if err := m.UidList.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.Members[mapkey] = mapvalue
 			iNdEx = postIndex
-		case 6:
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field SrcFunc", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Tablets", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -14394,18 +17905,111 @@ func (m *Query) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.SrcFunc == nil {
-				m.SrcFunc = &SrcFunction{}
+			if m.Tablets == nil {
+				m.Tablets = make(map[string]*Tablet)
 			}
-			if err := m.SrcFunc.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
+			var mapkey string
+			var mapvalue *Tablet
+			for iNdEx < postIndex {
+				entryPreIndex := iNdEx
+				var wire uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowPb
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					wire |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				fieldNum := int32(wire >> 3)
+				if fieldNum == 1 {
+					var stringLenmapkey uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowPb
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						stringLenmapkey |= uint64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					intStringLenmapkey := int(stringLenmapkey)
+					if intStringLenmapkey < 0 {
+						return ErrInvalidLengthPb
+					}
+					postStringIndexmapkey := iNdEx + intStringLenmapkey
+					if postStringIndexmapkey < 0 {
+						return ErrInvalidLengthPb
+					}
+					if postStringIndexmapkey > l {
+						return io.ErrUnexpectedEOF
+					}
+					mapkey = string(dAtA[iNdEx:postStringIndexmapkey])
+					iNdEx = postStringIndexmapkey
+				} else if fieldNum == 2 {
+					var mapmsglen int
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowPb
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						mapmsglen |= int(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					if mapmsglen < 0 {
+						return ErrInvalidLengthPb
+					}
+					postmsgIndex := iNdEx + mapmsglen
+					if postmsgIndex < 0 {
+						return ErrInvalidLengthPb
+					}
+					if postmsgIndex > l {
+						return io.ErrUnexpectedEOF
+					}
+					mapvalue = &Tablet{}
+					if err := mapvalue.Unmarshal(dAtA[iNdEx:postmsgIndex]); err != nil {
+						return err
+					}
+					iNdEx = postmsgIndex
+				} else {
+					iNdEx = entryPreIndex
+					skippy, err := skipPb(dAtA[iNdEx:])
+					if err != nil {
+						return err
+					}
+					if (skippy < 0) || (iNdEx+skippy) < 0 {
+						return ErrInvalidLengthPb
+					}
+					if (iNdEx + skippy) > postIndex {
+						return io.ErrUnexpectedEOF
+					}
+					iNdEx += skippy
+				}
 			}
+			m.Tablets[mapkey] = mapvalue
 			iNdEx = postIndex
-		case 7:
+		case 3:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Reverse", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field SnapshotTs", wireType)
 			}
-			var v int
+			m.SnapshotTs = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowPb
@@ -14415,17 +18019,16 @@ func (m *Query) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				v |= int(b&0x7F) << shift
+				m.SnapshotTs |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			m.Reverse = bool(v != 0)
-		case 8:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field FacetParam", wireType)
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Checksum", wireType)
 			}
-			var msglen int
+			m.Checksum = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowPb
@@ -14435,33 +18038,16 @@ func (m *Query) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				m.Checksum |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
-				return ErrInvalidLengthPb
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthPb
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if m.FacetParam == nil {
-				m.FacetParam = &FacetParams{}
-			}
-			if err := m.FacetParam.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
-		case 9:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field FacetsFilter", wireType)
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CheckpointTs", wireType)
 			}
-			var msglen int
+			m.CheckpointTs = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowPb
@@ -14471,53 +18057,66 @@ func (m *Query) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				m.CheckpointTs |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
-				return ErrInvalidLengthPb
+		default:
+			iNdEx = preIndex
+			skippy, err := skipPb(dAtA[iNdEx:])
+			if err != nil {
+				return err
 			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
 				return ErrInvalidLengthPb
 			}
-			if postIndex > l {
+			if (iNdEx + skippy) > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.FacetsFilter == nil {
-				m.FacetsFilter = &FilterTree{}
-			}
-			if err := m.FacetsFilter.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *License) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowPb
 			}
-			iNdEx = postIndex
-		case 10:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ExpandAll", wireType)
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
 			}
-			var v int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowPb
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				v |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
 			}
-			m.ExpandAll = bool(v != 0)
-		case 13:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ReadTs", wireType)
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: License: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: License: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field User", wireType)
 			}
-			m.ReadTs = 0
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowPb
@@ -14527,16 +18126,29 @@ func (m *Query) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.ReadTs |= uint64(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 14:
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthPb
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthPb
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.User = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Cache", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxNodes", wireType)
 			}
-			m.Cache = 0
+			m.MaxNodes = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowPb
@@ -14546,16 +18158,16 @@ func (m *Query) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.Cache |= int32(b&0x7F) << shift
+				m.MaxNodes |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 15:
+		case 3:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field First", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ExpiryTs", wireType)
 			}
-			m.First = 0
+			m.ExpiryTs = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowPb
@@ -14565,16 +18177,16 @@ func (m *Query) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.First |= int32(b&0x7F) << shift
+				m.ExpiryTs |= int64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 16:
+		case 4:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Offset", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Enabled", wireType)
 			}
-			m.Offset = 0
+			var v int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowPb
@@ -14584,11 +18196,12 @@ func (m *Query) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.Offset |= int32(b&0x7F) << shift
+				v |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
+			m.Enabled = bool(v != 0)
 		default:
 			iNdEx = preIndex
 			skippy, err := skipPb(dAtA[iNdEx:])
@@ -14610,7 +18223,7 @@ func (m *Query) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *ValueList) Unmarshal(dAtA []byte) error {
+func (m *ZeroProposal) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -14633,15 +18246,15 @@ func (m *ValueList) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: ValueList: wiretype end group for non-group")
+			return fmt.Errorf("proto: ZeroProposal: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: ValueList: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: ZeroProposal: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Values", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field SnapshotTs", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -14668,66 +18281,172 @@ func (m *ValueList) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Values = append(m.Values, &TaskValue{})
-			if err := m.Values[len(m.Values)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
+			if m.SnapshotTs == nil {
+				m.SnapshotTs = make(map[uint32]uint64)
+			}
+			var mapkey uint32
+			var mapvalue uint64
+			for iNdEx < postIndex {
+				entryPreIndex := iNdEx
+				var wire uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowPb
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					wire |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				fieldNum := int32(wire >> 3)
+				if fieldNum == 1 {
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowPb
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						mapkey |= uint32(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+				} else if fieldNum == 2 {
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowPb
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						mapvalue |= uint64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+				} else {
+					iNdEx = entryPreIndex
+					skippy, err := skipPb(dAtA[iNdEx:])
+					if err != nil {
+						return err
+					}
+					if (skippy < 0) || (iNdEx+skippy) < 0 {
+						return ErrInvalidLengthPb
+					}
+					if (iNdEx + skippy) > postIndex {
+						return io.ErrUnexpectedEOF
+					}
+					iNdEx += skippy
+				}
 			}
+			m.SnapshotTs[mapkey] = mapvalue
 			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipPb(dAtA[iNdEx:])
-			if err != nil {
-				return err
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Member", wireType)
 			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPb
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
 				return ErrInvalidLengthPb
 			}
-			if (iNdEx + skippy) > l {
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthPb
+			}
+			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *LangList) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowPb
+			if m.Member == nil {
+				m.Member = &Member{}
 			}
-			if iNdEx >= l {
+			if err := m.Member.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Tablet", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPb
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthPb
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthPb
+			}
+			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
+			if m.Tablet == nil {
+				m.Tablet = &Tablet{}
 			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: LangList: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: LangList: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Lang", wireType)
+			if err := m.Tablet.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
 			}
-			var stringLen uint64
+			iNdEx = postIndex
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxUID", wireType)
+			}
+			m.MaxUID = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPb
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MaxUID |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxTxnTs", wireType)
+			}
+			m.MaxTxnTs = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowPb
@@ -14737,77 +18456,33 @@ func (m *LangList) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				m.MaxTxnTs |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthPb
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthPb
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.Lang = append(m.Lang, string(dAtA[iNdEx:postIndex]))
-			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipPb(dAtA[iNdEx:])
-			if err != nil {
-				return err
-			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
-				return ErrInvalidLengthPb
-			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
-			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *Result) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowPb
-			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxRaftId", wireType)
 			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
+			m.MaxRaftId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPb
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MaxRaftId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: Result: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: Result: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+		case 7:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field UidMatrix", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Txn", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -14834,16 +18509,18 @@ func (m *Result) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.UidMatrix = append(m.UidMatrix, &List{})
-			if err := m.UidMatrix[len(m.UidMatrix)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if m.Txn == nil {
+				m.Txn = &api.TxnContext{}
+			}
+			if err := m.Txn.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 2:
+		case 9:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ValueMatrix", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Cid", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowPb
@@ -14853,107 +18530,29 @@ func (m *Result) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthPb
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthPb
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.ValueMatrix = append(m.ValueMatrix, &ValueList{})
-			if err := m.ValueMatrix[len(m.ValueMatrix)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.Cid = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 3:
-			if wireType == 0 {
-				var v uint32
-				for shift := uint(0); ; shift += 7 {
-					if shift >= 64 {
-						return ErrIntOverflowPb
-					}
-					if iNdEx >= l {
-						return io.ErrUnexpectedEOF
-					}
-					b := dAtA[iNdEx]
-					iNdEx++
-					v |= uint32(b&0x7F) << shift
-					if b < 0x80 {
-						break
-					}
-				}
-				m.Counts = append(m.Counts, v)
-			} else if wireType == 2 {
-				var packedLen int
-				for shift := uint(0); ; shift += 7 {
-					if shift >= 64 {
-						return ErrIntOverflowPb
-					}
-					if iNdEx >= l {
-						return io.ErrUnexpectedEOF
-					}
-					b := dAtA[iNdEx]
-					iNdEx++
-					packedLen |= int(b&0x7F) << shift
-					if b < 0x80 {
-						break
-					}
-				}
-				if packedLen < 0 {
-					return ErrInvalidLengthPb
-				}
-				postIndex := iNdEx + packedLen
-				if postIndex < 0 {
-					return ErrInvalidLengthPb
-				}
-				if postIndex > l {
-					return io.ErrUnexpectedEOF
-				}
-				var elementCount int
-				var count int
-				for _, integer := range dAtA[iNdEx:postIndex] {
-					if integer < 128 {
-						count++
-					}
-				}
-				elementCount = count
-				if elementCount != 0 && len(m.Counts) == 0 {
-					m.Counts = make([]uint32, 0, elementCount)
-				}
-				for iNdEx < postIndex {
-					var v uint32
-					for shift := uint(0); ; shift += 7 {
-						if shift >= 64 {
-							return ErrIntOverflowPb
-						}
-						if iNdEx >= l {
-							return io.ErrUnexpectedEOF
-						}
-						b := dAtA[iNdEx]
-						iNdEx++
-						v |= uint32(b&0x7F) << shift
-						if b < 0x80 {
-							break
-						}
-					}
-					m.Counts = append(m.Counts, v)
-				}
-			} else {
-				return fmt.Errorf("proto: wrong wireType = %d for field Counts", wireType)
-			}
-		case 4:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field IntersectDest", wireType)
+		case 10:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field License", wireType)
 			}
-			var v int
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowPb
@@ -14963,15 +18562,31 @@ func (m *Result) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				v |= int(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			m.IntersectDest = bool(v != 0)
-		case 5:
+			if msglen < 0 {
+				return ErrInvalidLengthPb
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthPb
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.License == nil {
+				m.License = &License{}
+			}
+			if err := m.License.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 11:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field FacetMatrix", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Snapshot", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -14998,14 +18613,35 @@ func (m *Result) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.FacetMatrix = append(m.FacetMatrix, &FacetsList{})
-			if err := m.FacetMatrix[len(m.FacetMatrix)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if m.Snapshot == nil {
+				m.Snapshot = &ZeroSnapshot{}
+			}
+			if err := m.Snapshot.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 6:
+		case 12:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxNsID", wireType)
+			}
+			m.MaxNsID = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPb
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MaxNsID |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 13:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field LangMatrix", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field DeleteNs", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -15032,16 +18668,18 @@ func (m *Result) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.LangMatrix = append(m.LangMatrix, &LangList{})
-			if err := m.LangMatrix[len(m.LangMatrix)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if m.DeleteNs == nil {
+				m.DeleteNs = &DeleteNsRequest{}
+			}
+			if err := m.DeleteNs.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 7:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field List", wireType)
+		case 14:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Tablets", wireType)
 			}
-			var v int
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowPb
@@ -15051,12 +18689,26 @@ func (m *Result) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				v |= int(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			m.List = bool(v != 0)
+			if msglen < 0 {
+				return ErrInvalidLengthPb
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthPb
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Tablets = append(m.Tablets, &Tablet{})
+			if err := m.Tablets[len(m.Tablets)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipPb(dAtA[iNdEx:])
@@ -15078,7 +18730,7 @@ func (m *Result) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *Order) Unmarshal(dAtA []byte) error {
+func (m *MembershipState) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -15101,17 +18753,17 @@ func (m *Order) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: Order: wiretype end group for non-group")
+			return fmt.Errorf("proto: MembershipState: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: Order: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MembershipState: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Attr", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Counter", wireType)
 			}
-			var stringLen uint64
+			m.Counter = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowPb
@@ -15121,29 +18773,16 @@ func (m *Order) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				m.Counter |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthPb
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthPb
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.Attr = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
 		case 2:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Desc", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Groups", wireType)
 			}
-			var v int
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowPb
@@ -15153,17 +18792,112 @@ func (m *Order) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				v |= int(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			m.Desc = bool(v != 0)
+			if msglen < 0 {
+				return ErrInvalidLengthPb
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthPb
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Groups == nil {
+				m.Groups = make(map[uint32]*Group)
+			}
+			var mapkey uint32
+			var mapvalue *Group
+			for iNdEx < postIndex {
+				entryPreIndex := iNdEx
+				var wire uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowPb
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					wire |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				fieldNum := int32(wire >> 3)
+				if fieldNum == 1 {
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowPb
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						mapkey |= uint32(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+				} else if fieldNum == 2 {
+					var mapmsglen int
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowPb
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						mapmsglen |= int(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					if mapmsglen < 0 {
+						return ErrInvalidLengthPb
+					}
+					postmsgIndex := iNdEx + mapmsglen
+					if postmsgIndex < 0 {
+						return ErrInvalidLengthPb
+					}
+					if postmsgIndex > l {
+						return io.ErrUnexpectedEOF
+					}
+					mapvalue = &Group{}
+					if err := mapvalue.Unmarshal(dAtA[iNdEx:postmsgIndex]); err != nil {
+						return err
+					}
+					iNdEx = postmsgIndex
+				} else {
+					iNdEx = entryPreIndex
+					skippy, err := skipPb(dAtA[iNdEx:])
+					if err != nil {
+						return err
+					}
+					if (skippy < 0) || (iNdEx+skippy) < 0 {
+						return ErrInvalidLengthPb
+					}
+					if (iNdEx + skippy) > postIndex {
+						return io.ErrUnexpectedEOF
+					}
+					iNdEx += skippy
+				}
+			}
+			m.Groups[mapkey] = mapvalue
+			iNdEx = postIndex
 		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Langs", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Zeros", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowPb
@@ -15173,79 +18907,112 @@ func (m *Order) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthPb
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthPb
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Langs = append(m.Langs, string(dAtA[iNdEx:postIndex]))
-			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipPb(dAtA[iNdEx:])
-			if err != nil {
-				return err
-			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
-				return ErrInvalidLengthPb
-			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
-			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *SortMessage) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowPb
-			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
+			if m.Zeros == nil {
+				m.Zeros = make(map[uint64]*Member)
 			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
+			var mapkey uint64
+			var mapvalue *Member
+			for iNdEx < postIndex {
+				entryPreIndex := iNdEx
+				var wire uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowPb
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					wire |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				fieldNum := int32(wire >> 3)
+				if fieldNum == 1 {
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowPb
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						mapkey |= uint64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+				} else if fieldNum == 2 {
+					var mapmsglen int
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowPb
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						mapmsglen |= int(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					if mapmsglen < 0 {
+						return ErrInvalidLengthPb
+					}
+					postmsgIndex := iNdEx + mapmsglen
+					if postmsgIndex < 0 {
+						return ErrInvalidLengthPb
+					}
+					if postmsgIndex > l {
+						return io.ErrUnexpectedEOF
+					}
+					mapvalue = &Member{}
+					if err := mapvalue.Unmarshal(dAtA[iNdEx:postmsgIndex]); err != nil {
+						return err
+					}
+					iNdEx = postmsgIndex
+				} else {
+					iNdEx = entryPreIndex
+					skippy, err := skipPb(dAtA[iNdEx:])
+					if err != nil {
+						return err
+					}
+					if (skippy < 0) || (iNdEx+skippy) < 0 {
+						return ErrInvalidLengthPb
+					}
+					if (iNdEx + skippy) > postIndex {
+						return io.ErrUnexpectedEOF
+					}
+					iNdEx += skippy
+				}
 			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: SortMessage: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: SortMessage: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Order", wireType)
+			m.Zeros[mapkey] = mapvalue
+			iNdEx = postIndex
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxUID", wireType)
 			}
-			var msglen int
+			m.MaxUID = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowPb
@@ -15255,31 +19022,16 @@ func (m *SortMessage) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				m.MaxUID |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
-				return ErrInvalidLengthPb
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthPb
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.Order = append(m.Order, &Order{})
-			if err := m.Order[len(m.Order)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
-		case 2:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field UidMatrix", wireType)
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxTxnTs", wireType)
 			}
-			var msglen int
+			m.MaxTxnTs = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowPb
@@ -15289,31 +19041,16 @@ func (m *SortMessage) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				m.MaxTxnTs |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
-				return ErrInvalidLengthPb
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthPb
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.UidMatrix = append(m.UidMatrix, &List{})
-			if err := m.UidMatrix[len(m.UidMatrix)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
-		case 3:
+		case 6:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Count", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxRaftId", wireType)
 			}
-			m.Count = 0
+			m.MaxRaftId = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowPb
@@ -15323,16 +19060,16 @@ func (m *SortMessage) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.Count |= int32(b&0x7F) << shift
+				m.MaxRaftId |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 4:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Offset", wireType)
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Removed", wireType)
 			}
-			m.Offset = 0
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowPb
@@ -15342,16 +19079,31 @@ func (m *SortMessage) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.Offset |= int32(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 13:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ReadTs", wireType)
+			if msglen < 0 {
+				return ErrInvalidLengthPb
 			}
-			m.ReadTs = 0
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthPb
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Removed = append(m.Removed, &Member{})
+			if err := m.Removed[len(m.Removed)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Cid", wireType)
+			}
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowPb
@@ -15361,64 +19113,27 @@ func (m *SortMessage) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.ReadTs |= uint64(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		default:
-			iNdEx = preIndex
-			skippy, err := skipPb(dAtA[iNdEx:])
-			if err != nil {
-				return err
-			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthPb
 			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
-			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *SortResult) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowPb
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthPb
 			}
-			if iNdEx >= l {
+			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
-			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: SortResult: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: SortResult: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+			m.Cid = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 9:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field UidMatrix", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field License", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -15445,11 +19160,32 @@ func (m *SortResult) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.UidMatrix = append(m.UidMatrix, &List{})
-			if err := m.UidMatrix[len(m.UidMatrix)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if m.License == nil {
+				m.License = &License{}
+			}
+			if err := m.License.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
+		case 10:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxNsID", wireType)
+			}
+			m.MaxNsID = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPb
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MaxNsID |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipPb(dAtA[iNdEx:])
@@ -15471,7 +19207,7 @@ func (m *SortResult) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *RaftContext) Unmarshal(dAtA []byte) error {
+func (m *ConnectionState) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -15494,46 +19230,17 @@ func (m *RaftContext) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: RaftContext: wiretype end group for non-group")
+			return fmt.Errorf("proto: ConnectionState: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: RaftContext: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: ConnectionState: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 1 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
-			}
-			m.Id = 0
-			if (iNdEx + 8) > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.Id = uint64(encoding_binary.LittleEndian.Uint64(dAtA[iNdEx:]))
-			iNdEx += 8
-		case 2:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Group", wireType)
-			}
-			m.Group = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowPb
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.Group |= uint32(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Addr", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Member", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowPb
@@ -15543,29 +19250,33 @@ func (m *RaftContext) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthPb
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthPb
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Addr = string(dAtA[iNdEx:postIndex])
+			if m.Member == nil {
+				m.Member = &Member{}
+			}
+			if err := m.Member.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
-		case 4:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field SnapshotTs", wireType)
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field State", wireType)
 			}
-			m.SnapshotTs = 0
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowPb
@@ -15575,16 +19286,33 @@ func (m *RaftContext) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.SnapshotTs |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 5:
+			if msglen < 0 {
+				return ErrInvalidLengthPb
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthPb
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.State == nil {
+				m.State = &MembershipState{}
+			}
+			if err := m.State.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field IsLearner", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxPending", wireType)
 			}
-			var v int
+			m.MaxPending = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowPb
@@ -15594,12 +19322,11 @@ func (m *RaftContext) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				v |= int(b&0x7F) << shift
+				m.MaxPending |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			m.IsLearner = bool(v != 0)
 		default:
 			iNdEx = preIndex
 			skippy, err := skipPb(dAtA[iNdEx:])
@@ -15621,7 +19348,7 @@ func (m *RaftContext) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *Member) Unmarshal(dAtA []byte) error {
+func (m *HealthInfo) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -15644,27 +19371,17 @@ func (m *Member) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: Member: wiretype end group for non-group")
+			return fmt.Errorf("proto: HealthInfo: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: Member: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: HealthInfo: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 1 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
-			}
-			m.Id = 0
-			if (iNdEx + 8) > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.Id = uint64(encoding_binary.LittleEndian.Uint64(dAtA[iNdEx:]))
-			iNdEx += 8
-		case 2:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field GroupId", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Instance", wireType)
 			}
-			m.GroupId = 0
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowPb
@@ -15674,14 +19391,27 @@ func (m *Member) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.GroupId |= uint32(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 3:
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthPb
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthPb
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Instance = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Addr", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -15709,13 +19439,13 @@ func (m *Member) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Addr = string(dAtA[iNdEx:postIndex])
+			m.Address = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 4:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Leader", wireType)
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Status", wireType)
 			}
-			var v int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowPb
@@ -15725,56 +19455,29 @@ func (m *Member) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				v |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			m.Leader = bool(v != 0)
-		case 5:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field AmDead", wireType)
-			}
-			var v int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowPb
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				v |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthPb
 			}
-			m.AmDead = bool(v != 0)
-		case 6:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field LastUpdate", wireType)
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthPb
 			}
-			m.LastUpdate = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowPb
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.LastUpdate |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
 			}
-		case 7:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Learner", wireType)
+			m.Status = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Group", wireType)
 			}
-			var v int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowPb
@@ -15784,37 +19487,29 @@ func (m *Member) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				v |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			m.Learner = bool(v != 0)
-		case 13:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ClusterInfoOnly", wireType)
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthPb
 			}
-			var v int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowPb
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				v |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthPb
 			}
-			m.ClusterInfoOnly = bool(v != 0)
-		case 14:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ForceGroupId", wireType)
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
 			}
-			var v int
+			m.Group = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Version", wireType)
+			}
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowPb
@@ -15824,67 +19519,29 @@ func (m *Member) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				v |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			m.ForceGroupId = bool(v != 0)
-		default:
-			iNdEx = preIndex
-			skippy, err := skipPb(dAtA[iNdEx:])
-			if err != nil {
-				return err
-			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthPb
 			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
-			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *Group) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowPb
-			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
-			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
-			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: Group: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: Group: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Members", wireType)
-			}
-			var msglen int
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthPb
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Version = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Uptime", wireType)
+			}
+			m.Uptime = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowPb
@@ -15894,112 +19551,35 @@ func (m *Group) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				m.Uptime |= int64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
-				return ErrInvalidLengthPb
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthPb
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if m.Members == nil {
-				m.Members = make(map[uint64]*Member)
+		case 7:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LastEcho", wireType)
 			}
-			var mapkey uint64
-			var mapvalue *Member
-			for iNdEx < postIndex {
-				entryPreIndex := iNdEx
-				var wire uint64
-				for shift := uint(0); ; shift += 7 {
-					if shift >= 64 {
-						return ErrIntOverflowPb
-					}
-					if iNdEx >= l {
-						return io.ErrUnexpectedEOF
-					}
-					b := dAtA[iNdEx]
-					iNdEx++
-					wire |= uint64(b&0x7F) << shift
-					if b < 0x80 {
-						break
-					}
+			m.LastEcho = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPb
 				}
-				fieldNum := int32(wire >> 3)
-				if fieldNum == 1 {
-					for shift := uint(0); ; shift += 7 {
-						if shift >= 64 {
-							return ErrIntOverflowPb
-						}
-						if iNdEx >= l {
-							return io.ErrUnexpectedEOF
-						}
-						b := dAtA[iNdEx]
-						iNdEx++
-						mapkey |= uint64(b&0x7F) << shift
-						if b < 0x80 {
-							break
-						}
-					}
-				} else if fieldNum == 2 {
-					var mapmsglen int
-					for shift := uint(0); ; shift += 7 {
-						if shift >= 64 {
-							return ErrIntOverflowPb
-						}
-						if iNdEx >= l {
-							return io.ErrUnexpectedEOF
-						}
-						b := dAtA[iNdEx]
-						iNdEx++
-						mapmsglen |= int(b&0x7F) << shift
-						if b < 0x80 {
-							break
-						}
-					}
-					if mapmsglen < 0 {
-						return ErrInvalidLengthPb
-					}
-					postmsgIndex := iNdEx + mapmsglen
-					if postmsgIndex < 0 {
-						return ErrInvalidLengthPb
-					}
-					if postmsgIndex > l {
-						return io.ErrUnexpectedEOF
-					}
-					mapvalue = &Member{}
-					if err := mapvalue.Unmarshal(dAtA[iNdEx:postmsgIndex]); err != nil {
-						return err
-					}
-					iNdEx = postmsgIndex
-				} else {
-					iNdEx = entryPreIndex
-					skippy, err := skipPb(dAtA[iNdEx:])
-					if err != nil {
-						return err
-					}
-					if (skippy < 0) || (iNdEx+skippy) < 0 {
-						return ErrInvalidLengthPb
-					}
-					if (iNdEx + skippy) > postIndex {
-						return io.ErrUnexpectedEOF
-					}
-					iNdEx += skippy
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.LastEcho |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
 				}
 			}
-			m.Members[mapkey] = mapvalue
-			iNdEx = postIndex
-		case 2:
+		case 8:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Tablets", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Ongoing", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowPb
@@ -16009,126 +19589,29 @@ func (m *Group) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthPb
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthPb
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Tablets == nil {
-				m.Tablets = make(map[string]*Tablet)
-			}
-			var mapkey string
-			var mapvalue *Tablet
-			for iNdEx < postIndex {
-				entryPreIndex := iNdEx
-				var wire uint64
-				for shift := uint(0); ; shift += 7 {
-					if shift >= 64 {
-						return ErrIntOverflowPb
-					}
-					if iNdEx >= l {
-						return io.ErrUnexpectedEOF
-					}
-					b := dAtA[iNdEx]
-					iNdEx++
-					wire |= uint64(b&0x7F) << shift
-					if b < 0x80 {
-						break
-					}
-				}
-				fieldNum := int32(wire >> 3)
-				if fieldNum == 1 {
-					var stringLenmapkey uint64
-					for shift := uint(0); ; shift += 7 {
-						if shift >= 64 {
-							return ErrIntOverflowPb
-						}
-						if iNdEx >= l {
-							return io.ErrUnexpectedEOF
-						}
-						b := dAtA[iNdEx]
-						iNdEx++
-						stringLenmapkey |= uint64(b&0x7F) << shift
-						if b < 0x80 {
-							break
-						}
-					}
-					intStringLenmapkey := int(stringLenmapkey)
-					if intStringLenmapkey < 0 {
-						return ErrInvalidLengthPb
-					}
-					postStringIndexmapkey := iNdEx + intStringLenmapkey
-					if postStringIndexmapkey < 0 {
-						return ErrInvalidLengthPb
-					}
-					if postStringIndexmapkey > l {
-						return io.ErrUnexpectedEOF
-					}
-					mapkey = string(dAtA[iNdEx:postStringIndexmapkey])
-					iNdEx = postStringIndexmapkey
-				} else if fieldNum == 2 {
-					var mapmsglen int
-					for shift := uint(0); ; shift += 7 {
-						if shift >= 64 {
-							return ErrIntOverflowPb
-						}
-						if iNdEx >= l {
-							return io.ErrUnexpectedEOF
-						}
-						b := dAtA[iNdEx]
-						iNdEx++
-						mapmsglen |= int(b&0x7F) << shift
-						if b < 0x80 {
-							break
-						}
-					}
-					if mapmsglen < 0 {
-						return ErrInvalidLengthPb
-					}
-					postmsgIndex := iNdEx + mapmsglen
-					if postmsgIndex < 0 {
-						return ErrInvalidLengthPb
-					}
-					if postmsgIndex > l {
-						return io.ErrUnexpectedEOF
-					}
-					mapvalue = &Tablet{}
-					if err := mapvalue.Unmarshal(dAtA[iNdEx:postmsgIndex]); err != nil {
-						return err
-					}
-					iNdEx = postmsgIndex
-				} else {
-					iNdEx = entryPreIndex
-					skippy, err := skipPb(dAtA[iNdEx:])
-					if err != nil {
-						return err
-					}
-					if (skippy < 0) || (iNdEx+skippy) < 0 {
-						return ErrInvalidLengthPb
-					}
-					if (iNdEx + skippy) > postIndex {
-						return io.ErrUnexpectedEOF
-					}
-					iNdEx += skippy
-				}
-			}
-			m.Tablets[mapkey] = mapvalue
+			m.Ongoing = append(m.Ongoing, string(dAtA[iNdEx:postIndex]))
 			iNdEx = postIndex
-		case 3:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field SnapshotTs", wireType)
+		case 9:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Indexing", wireType)
 			}
-			m.SnapshotTs = 0
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowPb
@@ -16138,16 +19621,29 @@ func (m *Group) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.SnapshotTs |= uint64(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 4:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Checksum", wireType)
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthPb
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthPb
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Indexing = append(m.Indexing, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 10:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EeFeatures", wireType)
 			}
-			m.Checksum = 0
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowPb
@@ -16157,16 +19653,29 @@ func (m *Group) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.Checksum |= uint64(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 5:
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthPb
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthPb
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.EeFeatures = append(m.EeFeatures, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 11:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field CheckpointTs", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxAssigned", wireType)
 			}
-			m.CheckpointTs = 0
+			m.MaxAssigned = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowPb
@@ -16176,7 +19685,7 @@ func (m *Group) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.CheckpointTs |= uint64(b&0x7F) << shift
+				m.MaxAssigned |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
@@ -16202,7 +19711,7 @@ func (m *Group) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *License) Unmarshal(dAtA []byte) error {
+func (m *Tablet) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -16225,15 +19734,34 @@ func (m *License) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: License: wiretype end group for non-group")
+			return fmt.Errorf("proto: Tablet: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: License: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: Tablet: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field GroupId", wireType)
+			}
+			m.GroupId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPb
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.GroupId |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field User", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Predicate", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -16261,13 +19789,13 @@ func (m *License) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.User = string(dAtA[iNdEx:postIndex])
+			m.Predicate = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 2:
+		case 3:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field MaxNodes", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Force", wireType)
 			}
-			m.MaxNodes = 0
+			var v int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowPb
@@ -16277,16 +19805,17 @@ func (m *License) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.MaxNodes |= uint64(b&0x7F) << shift
+				v |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 3:
+			m.Force = bool(v != 0)
+		case 7:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ExpiryTs", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field OnDiskBytes", wireType)
 			}
-			m.ExpiryTs = 0
+			m.OnDiskBytes = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowPb
@@ -16296,14 +19825,14 @@ func (m *License) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.ExpiryTs |= int64(b&0x7F) << shift
+				m.OnDiskBytes |= int64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 4:
+		case 8:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Enabled", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Remove", wireType)
 			}
 			var v int
 			for shift := uint(0); ; shift += 7 {
@@ -16320,7 +19849,65 @@ func (m *License) Unmarshal(dAtA []byte) error {
 					break
 				}
 			}
-			m.Enabled = bool(v != 0)
+			m.Remove = bool(v != 0)
+		case 9:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ReadOnly", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPb
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.ReadOnly = bool(v != 0)
+		case 10:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MoveTs", wireType)
+			}
+			m.MoveTs = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPb
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MoveTs |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 11:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field UncompressedBytes", wireType)
+			}
+			m.UncompressedBytes = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPb
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.UncompressedBytes |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipPb(dAtA[iNdEx:])
@@ -16342,7 +19929,7 @@ func (m *License) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *ZeroProposal) Unmarshal(dAtA []byte) error {
+func (m *DirectedEdge) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -16365,116 +19952,27 @@ func (m *ZeroProposal) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: ZeroProposal: wiretype end group for non-group")
+			return fmt.Errorf("proto: DirectedEdge: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: ZeroProposal: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: DirectedEdge: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field SnapshotTs", wireType)
-			}
-			var msglen int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowPb
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				msglen |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			if msglen < 0 {
-				return ErrInvalidLengthPb
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthPb
+			if wireType != 1 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Entity", wireType)
 			}
-			if postIndex > l {
+			m.Entity = 0
+			if (iNdEx + 8) > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.SnapshotTs == nil {
-				m.SnapshotTs = make(map[uint32]uint64)
-			}
-			var mapkey uint32
-			var mapvalue uint64
-			for iNdEx < postIndex {
-				entryPreIndex := iNdEx
-				var wire uint64
-				for shift := uint(0); ; shift += 7 {
-					if shift >= 64 {
-						return ErrIntOverflowPb
-					}
-					if iNdEx >= l {
-						return io.ErrUnexpectedEOF
-					}
-					b := dAtA[iNdEx]
-					iNdEx++
-					wire |= uint64(b&0x7F) << shift
-					if b < 0x80 {
-						break
-					}
-				}
-				fieldNum := int32(wire >> 3)
-				if fieldNum == 1 {
-					for shift := uint(0); ; shift += 7 {
-						if shift >= 64 {
-							return ErrIntOverflowPb
-						}
-						if iNdEx >= l {
-							return io.ErrUnexpectedEOF
-						}
-						b := dAtA[iNdEx]
-						iNdEx++
-						mapkey |= uint32(b&0x7F) << shift
-						if b < 0x80 {
-							break
-						}
-					}
-				} else if fieldNum == 2 {
-					for shift := uint(0); ; shift += 7 {
-						if shift >= 64 {
-							return ErrIntOverflowPb
-						}
-						if iNdEx >= l {
-							return io.ErrUnexpectedEOF
-						}
-						b := dAtA[iNdEx]
-						iNdEx++
-						mapvalue |= uint64(b&0x7F) << shift
-						if b < 0x80 {
-							break
-						}
-					}
-				} else {
-					iNdEx = entryPreIndex
-					skippy, err := skipPb(dAtA[iNdEx:])
-					if err != nil {
-						return err
-					}
-					if (skippy < 0) || (iNdEx+skippy) < 0 {
-						return ErrInvalidLengthPb
-					}
-					if (iNdEx + skippy) > postIndex {
-						return io.ErrUnexpectedEOF
-					}
-					iNdEx += skippy
-				}
-			}
-			m.SnapshotTs[mapkey] = mapvalue
-			iNdEx = postIndex
+			m.Entity = uint64(encoding_binary.LittleEndian.Uint64(dAtA[iNdEx:]))
+			iNdEx += 8
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Member", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Attr", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowPb
@@ -16484,33 +19982,29 @@ func (m *ZeroProposal) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthPb
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthPb
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Member == nil {
-				m.Member = &Member{}
-			}
-			if err := m.Member.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.Attr = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Tablet", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Value", wireType)
 			}
-			var msglen int
+			var byteLen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowPb
@@ -16520,33 +20014,31 @@ func (m *ZeroProposal) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				byteLen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			if byteLen < 0 {
 				return ErrInvalidLengthPb
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + byteLen
 			if postIndex < 0 {
 				return ErrInvalidLengthPb
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Tablet == nil {
-				m.Tablet = &Tablet{}
-			}
-			if err := m.Tablet.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
+			m.Value = append(m.Value[:0], dAtA[iNdEx:postIndex]...)
+			if m.Value == nil {
+				m.Value = []byte{}
 			}
 			iNdEx = postIndex
 		case 4:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field MaxUID", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ValueType", wireType)
 			}
-			m.MaxUID = 0
+			m.ValueType = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowPb
@@ -16556,16 +20048,26 @@ func (m *ZeroProposal) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.MaxUID |= uint64(b&0x7F) << shift
+				m.ValueType |= Posting_ValType(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
 		case 5:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field MaxTxnTs", wireType)
+			if wireType != 1 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ValueId", wireType)
 			}
-			m.MaxTxnTs = 0
+			m.ValueId = 0
+			if (iNdEx + 8) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ValueId = uint64(encoding_binary.LittleEndian.Uint64(dAtA[iNdEx:]))
+			iNdEx += 8
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Lang", wireType)
+			}
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowPb
@@ -16575,16 +20077,29 @@ func (m *ZeroProposal) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.MaxTxnTs |= uint64(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 6:
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthPb
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthPb
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Lang = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 8:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field MaxRaftId", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Op", wireType)
 			}
-			m.MaxRaftId = 0
+			m.Op = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowPb
@@ -16594,14 +20109,14 @@ func (m *ZeroProposal) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.MaxRaftId |= uint64(b&0x7F) << shift
+				m.Op |= DirectedEdge_Op(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 7:
+		case 9:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Txn", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Facets", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -16628,16 +20143,14 @@ func (m *ZeroProposal) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Txn == nil {
-				m.Txn = &api.TxnContext{}
-			}
-			if err := m.Txn.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.Facets = append(m.Facets, &api.Facet{})
+			if err := m.Facets[len(m.Facets)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 9:
+		case 10:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Cid", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field AllowedPreds", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -16665,13 +20178,13 @@ func (m *ZeroProposal) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Cid = string(dAtA[iNdEx:postIndex])
+			m.AllowedPreds = append(m.AllowedPreds, string(dAtA[iNdEx:postIndex]))
 			iNdEx = postIndex
-		case 10:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field License", wireType)
+		case 11:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Namespace", wireType)
 			}
-			var msglen int
+			m.Namespace = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowPb
@@ -16681,33 +20194,66 @@ func (m *ZeroProposal) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				m.Namespace |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
-				return ErrInvalidLengthPb
+		default:
+			iNdEx = preIndex
+			skippy, err := skipPb(dAtA[iNdEx:])
+			if err != nil {
+				return err
 			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
 				return ErrInvalidLengthPb
 			}
-			if postIndex > l {
+			if (iNdEx + skippy) > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.License == nil {
-				m.License = &License{}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *Mutations) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowPb
 			}
-			if err := m.License.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
 			}
-			iNdEx = postIndex
-		case 11:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Snapshot", wireType)
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
 			}
-			var msglen int
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Mutations: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Mutations: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field GroupId", wireType)
+			}
+			m.GroupId = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowPb
@@ -16717,33 +20263,16 @@ func (m *ZeroProposal) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				m.GroupId |= uint32(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
-				return ErrInvalidLengthPb
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthPb
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if m.Snapshot == nil {
-				m.Snapshot = &ZeroSnapshot{}
-			}
-			if err := m.Snapshot.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
-		case 12:
+		case 2:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field MaxNsID", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field StartTs", wireType)
 			}
-			m.MaxNsID = 0
+			m.StartTs = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowPb
@@ -16753,14 +20282,14 @@ func (m *ZeroProposal) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.MaxNsID |= uint64(b&0x7F) << shift
+				m.StartTs |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 13:
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field DeleteNs", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Edges", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -16787,16 +20316,14 @@ func (m *ZeroProposal) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.DeleteNs == nil {
-				m.DeleteNs = &DeleteNsRequest{}
-			}
-			if err := m.DeleteNs.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.Edges = append(m.Edges, &DirectedEdge{})
+			if err := m.Edges[len(m.Edges)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 14:
+		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Tablets", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Schema", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -16823,66 +20350,50 @@ func (m *ZeroProposal) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Tablets = append(m.Tablets, &Tablet{})
-			if err := m.Tablets[len(m.Tablets)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.Schema = append(m.Schema, &SchemaUpdate{})
+			if err := m.Schema[len(m.Schema)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipPb(dAtA[iNdEx:])
-			if err != nil {
-				return err
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Types", wireType)
 			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
-				return ErrInvalidLengthPb
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPb
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
+			if msglen < 0 {
+				return ErrInvalidLengthPb
 			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *MembershipState) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowPb
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthPb
 			}
-			if iNdEx >= l {
+			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
+			m.Types = append(m.Types, &TypeUpdate{})
+			if err := m.Types[len(m.Types)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
 			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: MembershipState: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MembershipState: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+			iNdEx = postIndex
+		case 7:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Counter", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field DropOp", wireType)
 			}
-			m.Counter = 0
+			m.DropOp = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowPb
@@ -16892,14 +20403,46 @@ func (m *MembershipState) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.Counter |= uint64(b&0x7F) << shift
+				m.DropOp |= Mutations_DropOp(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 2:
+		case 8:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Groups", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field DropValue", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPb
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthPb
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthPb
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.DropValue = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 9:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Metadata", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -16926,95 +20469,16 @@ func (m *MembershipState) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Groups == nil {
-				m.Groups = make(map[uint32]*Group)
+			if m.Metadata == nil {
+				m.Metadata = &Metadata{}
 			}
-			var mapkey uint32
-			var mapvalue *Group
-			for iNdEx < postIndex {
-				entryPreIndex := iNdEx
-				var wire uint64
-				for shift := uint(0); ; shift += 7 {
-					if shift >= 64 {
-						return ErrIntOverflowPb
-					}
-					if iNdEx >= l {
-						return io.ErrUnexpectedEOF
-					}
-					b := dAtA[iNdEx]
-					iNdEx++
-					wire |= uint64(b&0x7F) << shift
-					if b < 0x80 {
-						break
-					}
-				}
-				fieldNum := int32(wire >> 3)
-				if fieldNum == 1 {
-					for shift := uint(0); ; shift += 7 {
-						if shift >= 64 {
-							return ErrIntOverflowPb
-						}
-						if iNdEx >= l {
-							return io.ErrUnexpectedEOF
-						}
-						b := dAtA[iNdEx]
-						iNdEx++
-						mapkey |= uint32(b&0x7F) << shift
-						if b < 0x80 {
-							break
-						}
-					}
-				} else if fieldNum == 2 {
-					var mapmsglen int
-					for shift := uint(0); ; shift += 7 {
-						if shift >= 64 {
-							return ErrIntOverflowPb
-						}
-						if iNdEx >= l {
-							return io.ErrUnexpectedEOF
-						}
-						b := dAtA[iNdEx]
-						iNdEx++
-						mapmsglen |= int(b&0x7F) << shift
-						if b < 0x80 {
-							break
-						}
-					}
-					if mapmsglen < 0 {
-						return ErrInvalidLengthPb
-					}
-					postmsgIndex := iNdEx + mapmsglen
-					if postmsgIndex < 0 {
-						return ErrInvalidLengthPb
-					}
-					if postmsgIndex > l {
-						return io.ErrUnexpectedEOF
-					}
-					mapvalue = &Group{}
-					if err := mapvalue.Unmarshal(dAtA[iNdEx:postmsgIndex]); err != nil {
-						return err
-					}
-					iNdEx = postmsgIndex
-				} else {
-					iNdEx = entryPreIndex
-					skippy, err := skipPb(dAtA[iNdEx:])
-					if err != nil {
-						return err
-					}
-					if (skippy < 0) || (iNdEx+skippy) < 0 {
-						return ErrInvalidLengthPb
-					}
-					if (iNdEx + skippy) > postIndex {
-						return io.ErrUnexpectedEOF
-					}
-					iNdEx += skippy
-				}
+			if err := m.Metadata.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
 			}
-			m.Groups[mapkey] = mapvalue
 			iNdEx = postIndex
-		case 3:
+		case 10:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Zeros", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Synonyms", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -17041,97 +20505,16 @@ func (m *MembershipState) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Zeros == nil {
-				m.Zeros = make(map[uint64]*Member)
-			}
-			var mapkey uint64
-			var mapvalue *Member
-			for iNdEx < postIndex {
-				entryPreIndex := iNdEx
-				var wire uint64
-				for shift := uint(0); ; shift += 7 {
-					if shift >= 64 {
-						return ErrIntOverflowPb
-					}
-					if iNdEx >= l {
-						return io.ErrUnexpectedEOF
-					}
-					b := dAtA[iNdEx]
-					iNdEx++
-					wire |= uint64(b&0x7F) << shift
-					if b < 0x80 {
-						break
-					}
-				}
-				fieldNum := int32(wire >> 3)
-				if fieldNum == 1 {
-					for shift := uint(0); ; shift += 7 {
-						if shift >= 64 {
-							return ErrIntOverflowPb
-						}
-						if iNdEx >= l {
-							return io.ErrUnexpectedEOF
-						}
-						b := dAtA[iNdEx]
-						iNdEx++
-						mapkey |= uint64(b&0x7F) << shift
-						if b < 0x80 {
-							break
-						}
-					}
-				} else if fieldNum == 2 {
-					var mapmsglen int
-					for shift := uint(0); ; shift += 7 {
-						if shift >= 64 {
-							return ErrIntOverflowPb
-						}
-						if iNdEx >= l {
-							return io.ErrUnexpectedEOF
-						}
-						b := dAtA[iNdEx]
-						iNdEx++
-						mapmsglen |= int(b&0x7F) << shift
-						if b < 0x80 {
-							break
-						}
-					}
-					if mapmsglen < 0 {
-						return ErrInvalidLengthPb
-					}
-					postmsgIndex := iNdEx + mapmsglen
-					if postmsgIndex < 0 {
-						return ErrInvalidLengthPb
-					}
-					if postmsgIndex > l {
-						return io.ErrUnexpectedEOF
-					}
-					mapvalue = &Member{}
-					if err := mapvalue.Unmarshal(dAtA[iNdEx:postmsgIndex]); err != nil {
-						return err
-					}
-					iNdEx = postmsgIndex
-				} else {
-					iNdEx = entryPreIndex
-					skippy, err := skipPb(dAtA[iNdEx:])
-					if err != nil {
-						return err
-					}
-					if (skippy < 0) || (iNdEx+skippy) < 0 {
-						return ErrInvalidLengthPb
-					}
-					if (iNdEx + skippy) > postIndex {
-						return io.ErrUnexpectedEOF
-					}
-					iNdEx += skippy
-				}
+			m.Synonyms = append(m.Synonyms, &SynonymUpdate{})
+			if err := m.Synonyms[len(m.Synonyms)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
 			}
-			m.Zeros[mapkey] = mapvalue
 			iNdEx = postIndex
-		case 4:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field MaxUID", wireType)
+		case 11:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SchemaVersions", wireType)
 			}
-			m.MaxUID = 0
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowPb
@@ -17141,16 +20524,31 @@ func (m *MembershipState) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.MaxUID |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 5:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field MaxTxnTs", wireType)
+			if msglen < 0 {
+				return ErrInvalidLengthPb
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthPb
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
 			}
-			m.MaxTxnTs = 0
+			m.SchemaVersions = append(m.SchemaVersions, &SchemaVersion{})
+			if err := m.SchemaVersions[len(m.SchemaVersions)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 12:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Tombstones", wireType)
+			}
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowPb
@@ -17160,16 +20558,31 @@ func (m *MembershipState) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.MaxTxnTs |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 6:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field MaxRaftId", wireType)
+			if msglen < 0 {
+				return ErrInvalidLengthPb
 			}
-			m.MaxRaftId = 0
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthPb
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Tombstones = append(m.Tombstones, &Tombstone{})
+			if err := m.Tombstones[len(m.Tombstones)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 13:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Triggers", wireType)
+			}
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowPb
@@ -17179,14 +20592,29 @@ func (m *MembershipState) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.MaxRaftId |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 7:
+			if msglen < 0 {
+				return ErrInvalidLengthPb
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthPb
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Triggers = append(m.Triggers, &Trigger{})
+			if err := m.Triggers[len(m.Triggers)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 14:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Removed", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field StoredQueries", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -17213,14 +20641,14 @@ func (m *MembershipState) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Removed = append(m.Removed, &Member{})
-			if err := m.Removed[len(m.Removed)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.StoredQueries = append(m.StoredQueries, &StoredQuery{})
+			if err := m.StoredQueries[len(m.StoredQueries)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 8:
+		case 15:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Cid", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field CancelIndexing", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -17248,11 +20676,30 @@ func (m *MembershipState) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Cid = string(dAtA[iNdEx:postIndex])
+			m.CancelIndexing = append(m.CancelIndexing, string(dAtA[iNdEx:postIndex]))
 			iNdEx = postIndex
-		case 9:
+		case 16:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field IfVersion", wireType)
+			}
+			m.IfVersion = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPb
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.IfVersion |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 17:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field License", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field TriggerEvents", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -17279,18 +20726,16 @@ func (m *MembershipState) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.License == nil {
-				m.License = &License{}
-			}
-			if err := m.License.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.TriggerEvents = append(m.TriggerEvents, &TriggerEvent{})
+			if err := m.TriggerEvents[len(m.TriggerEvents)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 10:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field MaxNsID", wireType)
+		case 18:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TtlMarks", wireType)
 			}
-			m.MaxNsID = 0
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowPb
@@ -17300,11 +20745,26 @@ func (m *MembershipState) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.MaxNsID |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
+			if msglen < 0 {
+				return ErrInvalidLengthPb
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthPb
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.TtlMarks = append(m.TtlMarks, &TTLMark{})
+			if err := m.TtlMarks[len(m.TtlMarks)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipPb(dAtA[iNdEx:])
@@ -17326,7 +20786,7 @@ func (m *MembershipState) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *ConnectionState) Unmarshal(dAtA []byte) error {
+func (m *Metadata) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -17349,15 +20809,15 @@ func (m *ConnectionState) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: ConnectionState: wiretype end group for non-group")
+			return fmt.Errorf("proto: Metadata: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: ConnectionState: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: Metadata: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Member", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field PredHints", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -17384,68 +20844,90 @@ func (m *ConnectionState) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Member == nil {
-				m.Member = &Member{}
-			}
-			if err := m.Member.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
-		case 2:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field State", wireType)
+			if m.PredHints == nil {
+				m.PredHints = make(map[string]Metadata_HintType)
 			}
-			var msglen int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowPb
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
+			var mapkey string
+			var mapvalue Metadata_HintType
+			for iNdEx < postIndex {
+				entryPreIndex := iNdEx
+				var wire uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowPb
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					wire |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
 				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				msglen |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
+				fieldNum := int32(wire >> 3)
+				if fieldNum == 1 {
+					var stringLenmapkey uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowPb
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						stringLenmapkey |= uint64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					intStringLenmapkey := int(stringLenmapkey)
+					if intStringLenmapkey < 0 {
+						return ErrInvalidLengthPb
+					}
+					postStringIndexmapkey := iNdEx + intStringLenmapkey
+					if postStringIndexmapkey < 0 {
+						return ErrInvalidLengthPb
+					}
+					if postStringIndexmapkey > l {
+						return io.ErrUnexpectedEOF
+					}
+					mapkey = string(dAtA[iNdEx:postStringIndexmapkey])
+					iNdEx = postStringIndexmapkey
+				} else if fieldNum == 2 {
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowPb
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						mapvalue |= Metadata_HintType(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+				} else {
+					iNdEx = entryPreIndex
+					skippy, err := skipPb(dAtA[iNdEx:])
+					if err != nil {
+						return err
+					}
+					if (skippy < 0) || (iNdEx+skippy) < 0 {
+						return ErrInvalidLengthPb
+					}
+					if (iNdEx + skippy) > postIndex {
+						return io.ErrUnexpectedEOF
+					}
+					iNdEx += skippy
 				}
 			}
-			if msglen < 0 {
-				return ErrInvalidLengthPb
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthPb
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if m.State == nil {
-				m.State = &MembershipState{}
-			}
-			if err := m.State.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.PredHints[mapkey] = mapvalue
 			iNdEx = postIndex
-		case 3:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field MaxPending", wireType)
-			}
-			m.MaxPending = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowPb
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.MaxPending |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipPb(dAtA[iNdEx:])
@@ -17467,7 +20949,7 @@ func (m *ConnectionState) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *HealthInfo) Unmarshal(dAtA []byte) error {
+func (m *Snapshot) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -17490,17 +20972,17 @@ func (m *HealthInfo) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: HealthInfo: wiretype end group for non-group")
+			return fmt.Errorf("proto: Snapshot: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: HealthInfo: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: Snapshot: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Instance", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Context", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowPb
@@ -17510,29 +20992,33 @@ func (m *HealthInfo) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthPb
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthPb
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Instance = string(dAtA[iNdEx:postIndex])
+			if m.Context == nil {
+				m.Context = &RaftContext{}
+			}
+			if err := m.Context.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		case 2:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Index", wireType)
 			}
-			var stringLen uint64
+			m.Index = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowPb
@@ -17542,29 +21028,16 @@ func (m *HealthInfo) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				m.Index |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthPb
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthPb
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.Address = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
 		case 3:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Status", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ReadTs", wireType)
 			}
-			var stringLen uint64
+			m.ReadTs = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowPb
@@ -17574,29 +21047,16 @@ func (m *HealthInfo) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				m.ReadTs |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthPb
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthPb
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.Status = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
 		case 4:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Group", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Done", wireType)
 			}
-			var stringLen uint64
+			var v int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowPb
@@ -17606,29 +21066,17 @@ func (m *HealthInfo) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				v |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthPb
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthPb
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.Group = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
+			m.Done = bool(v != 0)
 		case 5:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Version", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SinceTs", wireType)
 			}
-			var stringLen uint64
+			m.SinceTs = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowPb
@@ -17638,48 +21086,66 @@ func (m *HealthInfo) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				m.SinceTs |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthPb
+		default:
+			iNdEx = preIndex
+			skippy, err := skipPb(dAtA[iNdEx:])
+			if err != nil {
+				return err
 			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
 				return ErrInvalidLengthPb
 			}
-			if postIndex > l {
+			if (iNdEx + skippy) > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Version = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 6:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Uptime", wireType)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ZeroSnapshot) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowPb
 			}
-			m.Uptime = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowPb
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.Uptime |= int64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
 			}
-		case 7:
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ZeroSnapshot: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ZeroSnapshot: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field LastEcho", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Index", wireType)
 			}
-			m.LastEcho = 0
+			m.Index = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowPb
@@ -17689,16 +21155,16 @@ func (m *HealthInfo) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.LastEcho |= int64(b&0x7F) << shift
+				m.Index |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 8:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Ongoing", wireType)
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CheckpointTs", wireType)
 			}
-			var stringLen uint64
+			m.CheckpointTs = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowPb
@@ -17708,29 +21174,16 @@ func (m *HealthInfo) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				m.CheckpointTs |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthPb
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthPb
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.Ongoing = append(m.Ongoing, string(dAtA[iNdEx:postIndex]))
-			iNdEx = postIndex
-		case 9:
+		case 5:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Indexing", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field State", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowPb
@@ -17740,75 +21193,28 @@ func (m *HealthInfo) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthPb
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthPb
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Indexing = append(m.Indexing, string(dAtA[iNdEx:postIndex]))
-			iNdEx = postIndex
-		case 10:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field EeFeatures", wireType)
-			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowPb
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthPb
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthPb
+			if m.State == nil {
+				m.State = &MembershipState{}
 			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
+			if err := m.State.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
 			}
-			m.EeFeatures = append(m.EeFeatures, string(dAtA[iNdEx:postIndex]))
 			iNdEx = postIndex
-		case 11:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field MaxAssigned", wireType)
-			}
-			m.MaxAssigned = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowPb
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.MaxAssigned |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipPb(dAtA[iNdEx:])
@@ -17830,7 +21236,7 @@ func (m *HealthInfo) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *Tablet) Unmarshal(dAtA []byte) error {
+func (m *RestoreRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -17853,10 +21259,10 @@ func (m *Tablet) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: Tablet: wiretype end group for non-group")
+			return fmt.Errorf("proto: RestoreRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: Tablet: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: RestoreRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -17879,8 +21285,27 @@ func (m *Tablet) Unmarshal(dAtA []byte) error {
 				}
 			}
 		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RestoreTs", wireType)
+			}
+			m.RestoreTs = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPb
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.RestoreTs |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Predicate", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Location", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -17908,13 +21333,13 @@ func (m *Tablet) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Predicate = string(dAtA[iNdEx:postIndex])
+			m.Location = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 3:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Force", wireType)
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BackupId", wireType)
 			}
-			var v int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowPb
@@ -17924,36 +21349,29 @@ func (m *Tablet) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				v |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			m.Force = bool(v != 0)
-		case 7:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field OnDiskBytes", wireType)
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthPb
 			}
-			m.OnDiskBytes = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowPb
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.OnDiskBytes |= int64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthPb
 			}
-		case 8:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Remove", wireType)
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
 			}
-			var v int
+			m.BackupId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AccessKey", wireType)
+			}
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowPb
@@ -17963,17 +21381,29 @@ func (m *Tablet) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				v |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			m.Remove = bool(v != 0)
-		case 9:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ReadOnly", wireType)
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthPb
 			}
-			var v int
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthPb
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.AccessKey = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SecretKey", wireType)
+			}
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowPb
@@ -17983,17 +21413,29 @@ func (m *Tablet) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				v |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			m.ReadOnly = bool(v != 0)
-		case 10:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field MoveTs", wireType)
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthPb
 			}
-			m.MoveTs = 0
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthPb
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.SecretKey = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SessionToken", wireType)
+			}
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowPb
@@ -18003,16 +21445,29 @@ func (m *Tablet) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.MoveTs |= uint64(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 11:
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthPb
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthPb
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.SessionToken = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 8:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field UncompressedBytes", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Anonymous", wireType)
 			}
-			m.UncompressedBytes = 0
+			var v int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowPb
@@ -18022,74 +21477,15 @@ func (m *Tablet) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.UncompressedBytes |= int64(b&0x7F) << shift
+				v |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		default:
-			iNdEx = preIndex
-			skippy, err := skipPb(dAtA[iNdEx:])
-			if err != nil {
-				return err
-			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
-				return ErrInvalidLengthPb
-			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
-			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *DirectedEdge) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowPb
-			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
-			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
-			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: DirectedEdge: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: DirectedEdge: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
-			if wireType != 1 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Entity", wireType)
-			}
-			m.Entity = 0
-			if (iNdEx + 8) > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.Entity = uint64(encoding_binary.LittleEndian.Uint64(dAtA[iNdEx:]))
-			iNdEx += 8
-		case 2:
+			m.Anonymous = bool(v != 0)
+		case 9:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Attr", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field EncryptionKeyFile", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -18117,13 +21513,13 @@ func (m *DirectedEdge) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Attr = string(dAtA[iNdEx:postIndex])
+			m.EncryptionKeyFile = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 3:
+		case 10:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Value", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field VaultAddr", wireType)
 			}
-			var byteLen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowPb
@@ -18133,31 +21529,29 @@ func (m *DirectedEdge) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				byteLen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if byteLen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthPb
 			}
-			postIndex := iNdEx + byteLen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthPb
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Value = append(m.Value[:0], dAtA[iNdEx:postIndex]...)
-			if m.Value == nil {
-				m.Value = []byte{}
-			}
+			m.VaultAddr = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 4:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ValueType", wireType)
+		case 11:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field VaultRoleidFile", wireType)
 			}
-			m.ValueType = 0
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowPb
@@ -18167,24 +21561,27 @@ func (m *DirectedEdge) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.ValueType |= Posting_ValType(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 5:
-			if wireType != 1 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ValueId", wireType)
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthPb
 			}
-			m.ValueId = 0
-			if (iNdEx + 8) > l {
-				return io.ErrUnexpectedEOF
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthPb
 			}
-			m.ValueId = uint64(encoding_binary.LittleEndian.Uint64(dAtA[iNdEx:]))
-			iNdEx += 8
-		case 7:
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.VaultRoleidFile = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 12:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Lang", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field VaultSecretidFile", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -18212,13 +21609,13 @@ func (m *DirectedEdge) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Lang = string(dAtA[iNdEx:postIndex])
+			m.VaultSecretidFile = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 8:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Op", wireType)
+		case 13:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field VaultPath", wireType)
 			}
-			m.Op = 0
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowPb
@@ -18228,16 +21625,29 @@ func (m *DirectedEdge) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.Op |= DirectedEdge_Op(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 9:
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthPb
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthPb
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.VaultPath = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 14:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Facets", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field VaultField", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowPb
@@ -18247,29 +21657,27 @@ func (m *DirectedEdge) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthPb
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthPb
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Facets = append(m.Facets, &api.Facet{})
-			if err := m.Facets[len(m.Facets)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.VaultField = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 10:
+		case 15:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field AllowedPreds", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field VaultFormat", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -18297,13 +21705,13 @@ func (m *DirectedEdge) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.AllowedPreds = append(m.AllowedPreds, string(dAtA[iNdEx:postIndex]))
+			m.VaultFormat = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 11:
+		case 16:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Namespace", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field BackupNum", wireType)
 			}
-			m.Namespace = 0
+			m.BackupNum = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowPb
@@ -18313,7 +21721,7 @@ func (m *DirectedEdge) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.Namespace |= uint64(b&0x7F) << shift
+				m.BackupNum |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
@@ -18339,7 +21747,7 @@ func (m *DirectedEdge) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *Mutations) Unmarshal(dAtA []byte) error {
+func (m *Proposal) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -18362,17 +21770,17 @@ func (m *Mutations) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: Mutations: wiretype end group for non-group")
+			return fmt.Errorf("proto: Proposal: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: Mutations: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: Proposal: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
-		case 1:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field GroupId", wireType)
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Mutations", wireType)
 			}
-			m.GroupId = 0
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowPb
@@ -18382,16 +21790,33 @@ func (m *Mutations) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.GroupId |= uint32(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 2:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field StartTs", wireType)
+			if msglen < 0 {
+				return ErrInvalidLengthPb
 			}
-			m.StartTs = 0
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthPb
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Mutations == nil {
+				m.Mutations = &Mutations{}
+			}
+			if err := m.Mutations.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Kv", wireType)
+			}
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowPb
@@ -18401,14 +21826,29 @@ func (m *Mutations) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.StartTs |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 3:
+			if msglen < 0 {
+				return ErrInvalidLengthPb
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthPb
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Kv = append(m.Kv, &pb.KV{})
+			if err := m.Kv[len(m.Kv)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Edges", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field State", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -18435,16 +21875,141 @@ func (m *Mutations) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Edges = append(m.Edges, &DirectedEdge{})
-			if err := m.Edges[len(m.Edges)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if m.State == nil {
+				m.State = &MembershipState{}
+			}
+			if err := m.State.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 4:
+		case 6:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Schema", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field CleanPredicate", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPb
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthPb
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthPb
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.CleanPredicate = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Delta", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPb
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthPb
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthPb
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Delta == nil {
+				m.Delta = &OracleDelta{}
+			}
+			if err := m.Delta.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 9:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Snapshot", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPb
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthPb
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthPb
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Snapshot == nil {
+				m.Snapshot = &Snapshot{}
+			}
+			if err := m.Snapshot.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 10:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Index", wireType)
+			}
+			m.Index = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPb
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Index |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 11:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ExpectedChecksum", wireType)
 			}
-			var msglen int
+			m.ExpectedChecksum = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowPb
@@ -18454,29 +22019,14 @@ func (m *Mutations) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				m.ExpectedChecksum |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
-				return ErrInvalidLengthPb
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthPb
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.Schema = append(m.Schema, &SchemaUpdate{})
-			if err := m.Schema[len(m.Schema)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
-		case 6:
+		case 12:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Types", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Restore", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -18503,35 +22053,18 @@ func (m *Mutations) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Types = append(m.Types, &TypeUpdate{})
-			if err := m.Types[len(m.Types)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if m.Restore == nil {
+				m.Restore = &RestoreRequest{}
+			}
+			if err := m.Restore.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 7:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field DropOp", wireType)
-			}
-			m.DropOp = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowPb
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.DropOp |= Mutations_DropOp(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-		case 8:
+		case 13:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field DropValue", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field CdcState", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowPb
@@ -18541,27 +22074,31 @@ func (m *Mutations) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthPb
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthPb
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.DropValue = string(dAtA[iNdEx:postIndex])
+			if m.CdcState == nil {
+				m.CdcState = &CDCState{}
+			}
+			if err := m.CdcState.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
-		case 9:
+		case 14:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Metadata", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field DeleteNs", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -18588,13 +22125,32 @@ func (m *Mutations) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Metadata == nil {
-				m.Metadata = &Metadata{}
+			if m.DeleteNs == nil {
+				m.DeleteNs = &DeleteNsRequest{}
 			}
-			if err := m.Metadata.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.DeleteNs.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
+		case 16:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field StartTs", wireType)
+			}
+			m.StartTs = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPb
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.StartTs |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipPb(dAtA[iNdEx:])
@@ -18616,7 +22172,7 @@ func (m *Mutations) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *Metadata) Unmarshal(dAtA []byte) error {
+func (m *CDCState) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -18639,17 +22195,17 @@ func (m *Metadata) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: Metadata: wiretype end group for non-group")
+			return fmt.Errorf("proto: CDCState: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: Metadata: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: CDCState: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field PredHints", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SentTs", wireType)
 			}
-			var msglen int
+			m.SentTs = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowPb
@@ -18659,105 +22215,11 @@ func (m *Metadata) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				m.SentTs |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
-				return ErrInvalidLengthPb
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthPb
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if m.PredHints == nil {
-				m.PredHints = make(map[string]Metadata_HintType)
-			}
-			var mapkey string
-			var mapvalue Metadata_HintType
-			for iNdEx < postIndex {
-				entryPreIndex := iNdEx
-				var wire uint64
-				for shift := uint(0); ; shift += 7 {
-					if shift >= 64 {
-						return ErrIntOverflowPb
-					}
-					if iNdEx >= l {
-						return io.ErrUnexpectedEOF
-					}
-					b := dAtA[iNdEx]
-					iNdEx++
-					wire |= uint64(b&0x7F) << shift
-					if b < 0x80 {
-						break
-					}
-				}
-				fieldNum := int32(wire >> 3)
-				if fieldNum == 1 {
-					var stringLenmapkey uint64
-					for shift := uint(0); ; shift += 7 {
-						if shift >= 64 {
-							return ErrIntOverflowPb
-						}
-						if iNdEx >= l {
-							return io.ErrUnexpectedEOF
-						}
-						b := dAtA[iNdEx]
-						iNdEx++
-						stringLenmapkey |= uint64(b&0x7F) << shift
-						if b < 0x80 {
-							break
-						}
-					}
-					intStringLenmapkey := int(stringLenmapkey)
-					if intStringLenmapkey < 0 {
-						return ErrInvalidLengthPb
-					}
-					postStringIndexmapkey := iNdEx + intStringLenmapkey
-					if postStringIndexmapkey < 0 {
-						return ErrInvalidLengthPb
-					}
-					if postStringIndexmapkey > l {
-						return io.ErrUnexpectedEOF
-					}
-					mapkey = string(dAtA[iNdEx:postStringIndexmapkey])
-					iNdEx = postStringIndexmapkey
-				} else if fieldNum == 2 {
-					for shift := uint(0); ; shift += 7 {
-						if shift >= 64 {
-							return ErrIntOverflowPb
-						}
-						if iNdEx >= l {
-							return io.ErrUnexpectedEOF
-						}
-						b := dAtA[iNdEx]
-						iNdEx++
-						mapvalue |= Metadata_HintType(b&0x7F) << shift
-						if b < 0x80 {
-							break
-						}
-					}
-				} else {
-					iNdEx = entryPreIndex
-					skippy, err := skipPb(dAtA[iNdEx:])
-					if err != nil {
-						return err
-					}
-					if (skippy < 0) || (iNdEx+skippy) < 0 {
-						return ErrInvalidLengthPb
-					}
-					if (iNdEx + skippy) > postIndex {
-						return io.ErrUnexpectedEOF
-					}
-					iNdEx += skippy
-				}
-			}
-			m.PredHints[mapkey] = mapvalue
-			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipPb(dAtA[iNdEx:])
@@ -18779,7 +22241,7 @@ func (m *Metadata) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *Snapshot) Unmarshal(dAtA []byte) error {
+func (m *KVS) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -18787,68 +22249,32 @@ func (m *Snapshot) Unmarshal(dAtA []byte) error {
 		var wire uint64
 		for shift := uint(0); ; shift += 7 {
 			if shift >= 64 {
-				return ErrIntOverflowPb
-			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
-			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
-			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: Snapshot: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: Snapshot: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Context", wireType)
-			}
-			var msglen int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowPb
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				msglen |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			if msglen < 0 {
-				return ErrInvalidLengthPb
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthPb
+				return ErrIntOverflowPb
 			}
-			if postIndex > l {
+			if iNdEx >= l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Context == nil {
-				m.Context = &RaftContext{}
-			}
-			if err := m.Context.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
 			}
-			iNdEx = postIndex
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: KVS: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: KVS: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
 		case 2:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Index", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Done", wireType)
 			}
-			m.Index = 0
+			var v int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowPb
@@ -18858,16 +22284,17 @@ func (m *Snapshot) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.Index |= uint64(b&0x7F) << shift
+				v |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
+			m.Done = bool(v != 0)
 		case 3:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ReadTs", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Predicates", wireType)
 			}
-			m.ReadTs = 0
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowPb
@@ -18877,16 +22304,29 @@ func (m *Snapshot) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.ReadTs |= uint64(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthPb
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthPb
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Predicates = append(m.Predicates, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
 		case 4:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Done", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Types", wireType)
 			}
-			var v int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowPb
@@ -18896,17 +22336,29 @@ func (m *Snapshot) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				v |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			m.Done = bool(v != 0)
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthPb
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthPb
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Types = append(m.Types, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
 		case 5:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field SinceTs", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Data", wireType)
 			}
-			m.SinceTs = 0
+			var byteLen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowPb
@@ -18916,11 +22368,26 @@ func (m *Snapshot) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.SinceTs |= uint64(b&0x7F) << shift
+				byteLen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
+			if byteLen < 0 {
+				return ErrInvalidLengthPb
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthPb
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Data = append(m.Data[:0], dAtA[iNdEx:postIndex]...)
+			if m.Data == nil {
+				m.Data = []byte{}
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipPb(dAtA[iNdEx:])
@@ -18942,7 +22409,7 @@ func (m *Snapshot) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *ZeroSnapshot) Unmarshal(dAtA []byte) error {
+func (m *Posting) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -18965,17 +22432,61 @@ func (m *ZeroSnapshot) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: ZeroSnapshot: wiretype end group for non-group")
+			return fmt.Errorf("proto: Posting: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: ZeroSnapshot: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: Posting: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
+			if wireType != 1 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Uid", wireType)
+			}
+			m.Uid = 0
+			if (iNdEx + 8) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Uid = uint64(encoding_binary.LittleEndian.Uint64(dAtA[iNdEx:]))
+			iNdEx += 8
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Value", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPb
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthPb
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthPb
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Value = append(m.Value[:0], dAtA[iNdEx:postIndex]...)
+			if m.Value == nil {
+				m.Value = []byte{}
+			}
+			iNdEx = postIndex
+		case 3:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Index", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ValType", wireType)
 			}
-			m.Index = 0
+			m.ValType = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowPb
@@ -18985,16 +22496,16 @@ func (m *ZeroSnapshot) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.Index |= uint64(b&0x7F) << shift
+				m.ValType |= Posting_ValType(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 2:
+		case 4:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field CheckpointTs", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field PostingType", wireType)
 			}
-			m.CheckpointTs = 0
+			m.PostingType = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowPb
@@ -19004,16 +22515,16 @@ func (m *ZeroSnapshot) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.CheckpointTs |= uint64(b&0x7F) << shift
+				m.PostingType |= Posting_PostingType(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
 		case 5:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field State", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field LangTag", wireType)
 			}
-			var msglen int
+			var byteLen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowPb
@@ -19023,83 +22534,31 @@ func (m *ZeroSnapshot) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				byteLen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			if byteLen < 0 {
 				return ErrInvalidLengthPb
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + byteLen
 			if postIndex < 0 {
 				return ErrInvalidLengthPb
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.State == nil {
-				m.State = &MembershipState{}
-			}
-			if err := m.State.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
+			m.LangTag = append(m.LangTag[:0], dAtA[iNdEx:postIndex]...)
+			if m.LangTag == nil {
+				m.LangTag = []byte{}
 			}
 			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipPb(dAtA[iNdEx:])
-			if err != nil {
-				return err
-			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
-				return ErrInvalidLengthPb
-			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
-			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *RestoreRequest) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowPb
-			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
-			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
-			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: RestoreRequest: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: RestoreRequest: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field GroupId", wireType)
+		case 9:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Facets", wireType)
 			}
-			m.GroupId = 0
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowPb
@@ -19109,16 +22568,31 @@ func (m *RestoreRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.GroupId |= uint32(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 2:
+			if msglen < 0 {
+				return ErrInvalidLengthPb
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthPb
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Facets = append(m.Facets, &api.Facet{})
+			if err := m.Facets[len(m.Facets)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 12:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field RestoreTs", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Op", wireType)
 			}
-			m.RestoreTs = 0
+			m.Op = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowPb
@@ -19128,16 +22602,16 @@ func (m *RestoreRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.RestoreTs |= uint64(b&0x7F) << shift
+				m.Op |= uint32(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 3:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Location", wireType)
+		case 13:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field StartTs", wireType)
 			}
-			var stringLen uint64
+			m.StartTs = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowPb
@@ -19147,29 +22621,16 @@ func (m *RestoreRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				m.StartTs |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthPb
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthPb
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.Location = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 4:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field BackupId", wireType)
+		case 14:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CommitTs", wireType)
 			}
-			var stringLen uint64
+			m.CommitTs = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowPb
@@ -19179,29 +22640,66 @@ func (m *RestoreRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				m.CommitTs |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthPb
+		default:
+			iNdEx = preIndex
+			skippy, err := skipPb(dAtA[iNdEx:])
+			if err != nil {
+				return err
 			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
 				return ErrInvalidLengthPb
 			}
-			if postIndex > l {
+			if (iNdEx + skippy) > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.BackupId = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 5:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field AccessKey", wireType)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *UidBlock) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowPb
 			}
-			var stringLen uint64
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: UidBlock: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: UidBlock: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Base", wireType)
+			}
+			m.Base = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowPb
@@ -19211,29 +22709,16 @@ func (m *RestoreRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				m.Base |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthPb
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthPb
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.AccessKey = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 6:
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field SecretKey", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Deltas", wireType)
 			}
-			var stringLen uint64
+			var byteLen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowPb
@@ -19243,29 +22728,31 @@ func (m *RestoreRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				byteLen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if byteLen < 0 {
 				return ErrInvalidLengthPb
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + byteLen
 			if postIndex < 0 {
 				return ErrInvalidLengthPb
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.SecretKey = string(dAtA[iNdEx:postIndex])
+			m.Deltas = append(m.Deltas[:0], dAtA[iNdEx:postIndex]...)
+			if m.Deltas == nil {
+				m.Deltas = []byte{}
+			}
 			iNdEx = postIndex
-		case 7:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field SessionToken", wireType)
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NumUids", wireType)
 			}
-			var stringLen uint64
+			m.NumUids = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowPb
@@ -19275,49 +22762,66 @@ func (m *RestoreRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				m.NumUids |= uint32(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthPb
+		default:
+			iNdEx = preIndex
+			skippy, err := skipPb(dAtA[iNdEx:])
+			if err != nil {
+				return err
 			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
 				return ErrInvalidLengthPb
 			}
-			if postIndex > l {
+			if (iNdEx + skippy) > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.SessionToken = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 8:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Anonymous", wireType)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *UidPack) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowPb
 			}
-			var v int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowPb
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				v |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
 			}
-			m.Anonymous = bool(v != 0)
-		case 9:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field EncryptionKeyFile", wireType)
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
 			}
-			var stringLen uint64
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: UidPack: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: UidPack: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BlockSize", wireType)
+			}
+			m.BlockSize = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowPb
@@ -19327,29 +22831,16 @@ func (m *RestoreRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				m.BlockSize |= uint32(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthPb
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthPb
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.EncryptionKeyFile = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 10:
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field VaultAddr", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Blocks", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowPb
@@ -19359,29 +22850,31 @@ func (m *RestoreRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthPb
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthPb
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.VaultAddr = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 11:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field VaultRoleidFile", wireType)
+			m.Blocks = append(m.Blocks, &UidBlock{})
+			if err := m.Blocks[len(m.Blocks)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
 			}
-			var stringLen uint64
+			iNdEx = postIndex
+		case 23:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AllocRef", wireType)
+			}
+			m.AllocRef = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowPb
@@ -19391,29 +22884,66 @@ func (m *RestoreRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				m.AllocRef |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthPb
+		default:
+			iNdEx = preIndex
+			skippy, err := skipPb(dAtA[iNdEx:])
+			if err != nil {
+				return err
 			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
 				return ErrInvalidLengthPb
 			}
-			if postIndex > l {
+			if (iNdEx + skippy) > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.VaultRoleidFile = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 12:
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *PostingList) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowPb
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: PostingList: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: PostingList: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field VaultSecretidFile", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Pack", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowPb
@@ -19423,29 +22953,33 @@ func (m *RestoreRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthPb
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthPb
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.VaultSecretidFile = string(dAtA[iNdEx:postIndex])
+			if m.Pack == nil {
+				m.Pack = &UidPack{}
+			}
+			if err := m.Pack.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
-		case 13:
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field VaultPath", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Postings", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowPb
@@ -19455,29 +22989,31 @@ func (m *RestoreRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthPb
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthPb
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.VaultPath = string(dAtA[iNdEx:postIndex])
+			m.Postings = append(m.Postings, &Posting{})
+			if err := m.Postings[len(m.Postings)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
-		case 14:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field VaultField", wireType)
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CommitTs", wireType)
 			}
-			var stringLen uint64
+			m.CommitTs = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowPb
@@ -19487,27 +23023,140 @@ func (m *RestoreRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				m.CommitTs |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthPb
+		case 4:
+			if wireType == 0 {
+				var v uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowPb
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					v |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				m.Splits = append(m.Splits, v)
+			} else if wireType == 2 {
+				var packedLen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowPb
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					packedLen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if packedLen < 0 {
+					return ErrInvalidLengthPb
+				}
+				postIndex := iNdEx + packedLen
+				if postIndex < 0 {
+					return ErrInvalidLengthPb
+				}
+				if postIndex > l {
+					return io.ErrUnexpectedEOF
+				}
+				var elementCount int
+				var count int
+				for _, integer := range dAtA[iNdEx:postIndex] {
+					if integer < 128 {
+						count++
+					}
+				}
+				elementCount = count
+				if elementCount != 0 && len(m.Splits) == 0 {
+					m.Splits = make([]uint64, 0, elementCount)
+				}
+				for iNdEx < postIndex {
+					var v uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowPb
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						v |= uint64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					m.Splits = append(m.Splits, v)
+				}
+			} else {
+				return fmt.Errorf("proto: wrong wireType = %d for field Splits", wireType)
 			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipPb(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
 				return ErrInvalidLengthPb
 			}
-			if postIndex > l {
+			if (iNdEx + skippy) > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.VaultField = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 15:
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *FacetParam) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowPb
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: FacetParam: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: FacetParam: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field VaultFormat", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Key", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -19535,13 +23184,13 @@ func (m *RestoreRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.VaultFormat = string(dAtA[iNdEx:postIndex])
+			m.Key = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 16:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field BackupNum", wireType)
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Alias", wireType)
 			}
-			m.BackupNum = 0
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowPb
@@ -19551,11 +23200,24 @@ func (m *RestoreRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.BackupNum |= uint64(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthPb
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthPb
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Alias = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipPb(dAtA[iNdEx:])
@@ -19577,7 +23239,7 @@ func (m *RestoreRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *Proposal) Unmarshal(dAtA []byte) error {
+func (m *FacetParams) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -19600,17 +23262,17 @@ func (m *Proposal) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: Proposal: wiretype end group for non-group")
+			return fmt.Errorf("proto: FacetParams: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: Proposal: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: FacetParams: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
-		case 2:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Mutations", wireType)
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AllKeys", wireType)
 			}
-			var msglen int
+			var v int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowPb
@@ -19620,31 +23282,15 @@ func (m *Proposal) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				v |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
-				return ErrInvalidLengthPb
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthPb
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if m.Mutations == nil {
-				m.Mutations = &Mutations{}
-			}
-			if err := m.Mutations.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
-		case 4:
+			m.AllKeys = bool(v != 0)
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Kv", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Param", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -19671,118 +23317,64 @@ func (m *Proposal) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Kv = append(m.Kv, &pb.KV{})
-			if err := m.Kv[len(m.Kv)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.Param = append(m.Param, &FacetParam{})
+			if err := m.Param[len(m.Param)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 5:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field State", wireType)
-			}
-			var msglen int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowPb
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				msglen |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			if msglen < 0 {
-				return ErrInvalidLengthPb
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthPb
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if m.State == nil {
-				m.State = &MembershipState{}
-			}
-			if err := m.State.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipPb(dAtA[iNdEx:])
+			if err != nil {
 				return err
 			}
-			iNdEx = postIndex
-		case 6:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field CleanPredicate", wireType)
-			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowPb
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthPb
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
 				return ErrInvalidLengthPb
 			}
-			if postIndex > l {
+			if (iNdEx + skippy) > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.CleanPredicate = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 8:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Delta", wireType)
-			}
-			var msglen int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowPb
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				msglen |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			if msglen < 0 {
-				return ErrInvalidLengthPb
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthPb
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *Facets) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowPb
 			}
-			if postIndex > l {
+			if iNdEx >= l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Delta == nil {
-				m.Delta = &OracleDelta{}
-			}
-			if err := m.Delta.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
 			}
-			iNdEx = postIndex
-		case 9:
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Facets: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Facets: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Snapshot", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Facets", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -19809,54 +23401,64 @@ func (m *Proposal) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Snapshot == nil {
-				m.Snapshot = &Snapshot{}
-			}
-			if err := m.Snapshot.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.Facets = append(m.Facets, &api.Facet{})
+			if err := m.Facets[len(m.Facets)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 10:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Index", wireType)
+		default:
+			iNdEx = preIndex
+			skippy, err := skipPb(dAtA[iNdEx:])
+			if err != nil {
+				return err
 			}
-			m.Index = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowPb
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.Index |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthPb
 			}
-		case 11:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ExpectedChecksum", wireType)
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
 			}
-			m.ExpectedChecksum = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowPb
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.ExpectedChecksum |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *FacetsList) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowPb
 			}
-		case 12:
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: FacetsList: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: FacetsList: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Restore", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field FacetsList", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -19883,18 +23485,66 @@ func (m *Proposal) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Restore == nil {
-				m.Restore = &RestoreRequest{}
-			}
-			if err := m.Restore.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.FacetsList = append(m.FacetsList, &Facets{})
+			if err := m.FacetsList[len(m.FacetsList)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 13:
+		default:
+			iNdEx = preIndex
+			skippy, err := skipPb(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthPb
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *Function) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowPb
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Function: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Function: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field CdcState", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowPb
@@ -19904,33 +23554,29 @@ func (m *Proposal) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthPb
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthPb
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.CdcState == nil {
-				m.CdcState = &CDCState{}
-			}
-			if err := m.CdcState.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.Name = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 14:
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field DeleteNs", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Key", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowPb
@@ -19940,33 +23586,29 @@ func (m *Proposal) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthPb
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthPb
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.DeleteNs == nil {
-				m.DeleteNs = &DeleteNsRequest{}
-			}
-			if err := m.DeleteNs.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.Key = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 16:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field StartTs", wireType)
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Args", wireType)
 			}
-			m.StartTs = 0
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowPb
@@ -19976,11 +23618,24 @@ func (m *Proposal) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.StartTs |= uint64(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthPb
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthPb
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Args = append(m.Args, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipPb(dAtA[iNdEx:])
@@ -20002,7 +23657,7 @@ func (m *Proposal) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *CDCState) Unmarshal(dAtA []byte) error {
+func (m *FilterTree) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -20025,17 +23680,17 @@ func (m *CDCState) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: CDCState: wiretype end group for non-group")
+			return fmt.Errorf("proto: FilterTree: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: CDCState: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: FilterTree: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field SentTs", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Op", wireType)
 			}
-			m.SentTs = 0
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowPb
@@ -20045,11 +23700,94 @@ func (m *CDCState) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.SentTs |= uint64(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthPb
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthPb
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Op = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Children", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPb
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthPb
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthPb
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Children = append(m.Children, &FilterTree{})
+			if err := m.Children[len(m.Children)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Func", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPb
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
+			if msglen < 0 {
+				return ErrInvalidLengthPb
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthPb
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Func == nil {
+				m.Func = &Function{}
+			}
+			if err := m.Func.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipPb(dAtA[iNdEx:])
@@ -20071,7 +23809,7 @@ func (m *CDCState) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *KVS) Unmarshal(dAtA []byte) error {
+func (m *SchemaRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -20094,17 +23832,17 @@ func (m *KVS) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: KVS: wiretype end group for non-group")
+			return fmt.Errorf("proto: SchemaRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: KVS: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: SchemaRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
-		case 2:
+		case 1:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Done", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field GroupId", wireType)
 			}
-			var v int
+			m.GroupId = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowPb
@@ -20114,13 +23852,12 @@ func (m *KVS) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				v |= int(b&0x7F) << shift
+				m.GroupId |= uint32(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			m.Done = bool(v != 0)
-		case 3:
+		case 2:
 			if wireType != 2 {
 				return fmt.Errorf("proto: wrong wireType = %d for field Predicates", wireType)
 			}
@@ -20152,9 +23889,9 @@ func (m *KVS) Unmarshal(dAtA []byte) error {
 			}
 			m.Predicates = append(m.Predicates, string(dAtA[iNdEx:postIndex]))
 			iNdEx = postIndex
-		case 4:
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Types", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Fields", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -20182,13 +23919,13 @@ func (m *KVS) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Types = append(m.Types, string(dAtA[iNdEx:postIndex]))
+			m.Fields = append(m.Fields, string(dAtA[iNdEx:postIndex]))
 			iNdEx = postIndex
-		case 5:
+		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Data", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Types", wireType)
 			}
-			var byteLen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowPb
@@ -20198,25 +23935,23 @@ func (m *KVS) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				byteLen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if byteLen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthPb
 			}
-			postIndex := iNdEx + byteLen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthPb
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Data = append(m.Data[:0], dAtA[iNdEx:postIndex]...)
-			if m.Data == nil {
-				m.Data = []byte{}
-			}
+			m.Types = append(m.Types, string(dAtA[iNdEx:postIndex]))
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -20239,7 +23974,7 @@ func (m *KVS) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *Posting) Unmarshal(dAtA []byte) error {
+func (m *SchemaNode) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -20262,27 +23997,17 @@ func (m *Posting) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: Posting: wiretype end group for non-group")
+			return fmt.Errorf("proto: SchemaNode: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: Posting: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: SchemaNode: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 1 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Uid", wireType)
-			}
-			m.Uid = 0
-			if (iNdEx + 8) > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.Uid = uint64(encoding_binary.LittleEndian.Uint64(dAtA[iNdEx:]))
-			iNdEx += 8
-		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Value", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Predicate", wireType)
 			}
-			var byteLen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowPb
@@ -20292,31 +24017,29 @@ func (m *Posting) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				byteLen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if byteLen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthPb
 			}
-			postIndex := iNdEx + byteLen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthPb
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Value = append(m.Value[:0], dAtA[iNdEx:postIndex]...)
-			if m.Value == nil {
-				m.Value = []byte{}
-			}
+			m.Predicate = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 3:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ValType", wireType)
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Type", wireType)
 			}
-			m.ValType = 0
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowPb
@@ -20326,16 +24049,29 @@ func (m *Posting) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.ValType |= Posting_ValType(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 4:
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthPb
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthPb
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Type = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field PostingType", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Index", wireType)
 			}
-			m.PostingType = 0
+			var v int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowPb
@@ -20345,16 +24081,17 @@ func (m *Posting) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.PostingType |= Posting_PostingType(b&0x7F) << shift
+				v |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 5:
+			m.Index = bool(v != 0)
+		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field LangTag", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Tokenizer", wireType)
 			}
-			var byteLen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowPb
@@ -20364,31 +24101,29 @@ func (m *Posting) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				byteLen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if byteLen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthPb
 			}
-			postIndex := iNdEx + byteLen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthPb
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.LangTag = append(m.LangTag[:0], dAtA[iNdEx:postIndex]...)
-			if m.LangTag == nil {
-				m.LangTag = []byte{}
-			}
+			m.Tokenizer = append(m.Tokenizer, string(dAtA[iNdEx:postIndex]))
 			iNdEx = postIndex
-		case 9:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Facets", wireType)
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Reverse", wireType)
 			}
-			var msglen int
+			var v int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowPb
@@ -20398,31 +24133,17 @@ func (m *Posting) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				v |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
-				return ErrInvalidLengthPb
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthPb
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.Facets = append(m.Facets, &api.Facet{})
-			if err := m.Facets[len(m.Facets)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
-		case 12:
+			m.Reverse = bool(v != 0)
+		case 6:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Op", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Count", wireType)
 			}
-			m.Op = 0
+			var v int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowPb
@@ -20432,16 +24153,17 @@ func (m *Posting) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.Op |= uint32(b&0x7F) << shift
+				v |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 13:
+			m.Count = bool(v != 0)
+		case 7:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field StartTs", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field List", wireType)
 			}
-			m.StartTs = 0
+			var v int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowPb
@@ -20451,16 +24173,17 @@ func (m *Posting) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.StartTs |= uint64(b&0x7F) << shift
+				v |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 14:
+			m.List = bool(v != 0)
+		case 8:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field CommitTs", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Upsert", wireType)
 			}
-			m.CommitTs = 0
+			var v int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowPb
@@ -20470,66 +24193,17 @@ func (m *Posting) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.CommitTs |= uint64(b&0x7F) << shift
+				v |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		default:
-			iNdEx = preIndex
-			skippy, err := skipPb(dAtA[iNdEx:])
-			if err != nil {
-				return err
-			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
-				return ErrInvalidLengthPb
-			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
-			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *UidBlock) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowPb
-			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
-			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
-			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: UidBlock: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: UidBlock: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+			m.Upsert = bool(v != 0)
+		case 9:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Base", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Lang", wireType)
 			}
-			m.Base = 0
+			var v int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowPb
@@ -20539,16 +24213,17 @@ func (m *UidBlock) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.Base |= uint64(b&0x7F) << shift
+				v |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 2:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Deltas", wireType)
+			m.Lang = bool(v != 0)
+		case 10:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NoConflict", wireType)
 			}
-			var byteLen int
+			var v int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowPb
@@ -20558,31 +24233,37 @@ func (m *UidBlock) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				byteLen |= int(b&0x7F) << shift
+				v |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if byteLen < 0 {
-				return ErrInvalidLengthPb
-			}
-			postIndex := iNdEx + byteLen
-			if postIndex < 0 {
-				return ErrInvalidLengthPb
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
+			m.NoConflict = bool(v != 0)
+		case 11:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Ordered", wireType)
 			}
-			m.Deltas = append(m.Deltas[:0], dAtA[iNdEx:postIndex]...)
-			if m.Deltas == nil {
-				m.Deltas = []byte{}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPb
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-			iNdEx = postIndex
-		case 3:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field NumUids", wireType)
+			m.Ordered = bool(v != 0)
+		case 12:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Ttl", wireType)
 			}
-			m.NumUids = 0
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowPb
@@ -20592,11 +24273,24 @@ func (m *UidBlock) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.NumUids |= uint32(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthPb
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthPb
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Ttl = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipPb(dAtA[iNdEx:])
@@ -20618,7 +24312,7 @@ func (m *UidBlock) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *UidPack) Unmarshal(dAtA []byte) error {
+func (m *SchemaResult) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -20641,34 +24335,15 @@ func (m *UidPack) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: UidPack: wiretype end group for non-group")
+			return fmt.Errorf("proto: SchemaResult: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: UidPack: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: SchemaResult: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field BlockSize", wireType)
-			}
-			m.BlockSize = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowPb
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.BlockSize |= uint32(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Blocks", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Schema", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -20695,30 +24370,11 @@ func (m *UidPack) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Blocks = append(m.Blocks, &UidBlock{})
-			if err := m.Blocks[len(m.Blocks)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.Schema = append(m.Schema, &SchemaNode{})
+			if err := m.Schema[len(m.Schema)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 23:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field AllocRef", wireType)
-			}
-			m.AllocRef = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowPb
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.AllocRef |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipPb(dAtA[iNdEx:])
@@ -20740,7 +24396,7 @@ func (m *UidPack) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *PostingList) Unmarshal(dAtA []byte) error {
+func (m *SchemaUpdate) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -20763,17 +24419,17 @@ func (m *PostingList) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: PostingList: wiretype end group for non-group")
+			return fmt.Errorf("proto: SchemaUpdate: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: PostingList: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: SchemaUpdate: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Pack", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Predicate", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowPb
@@ -20783,33 +24439,29 @@ func (m *PostingList) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthPb
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthPb
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Pack == nil {
-				m.Pack = &UidPack{}
-			}
-			if err := m.Pack.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.Predicate = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Postings", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ValueType", wireType)
 			}
-			var msglen int
+			m.ValueType = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowPb
@@ -20819,31 +24471,16 @@ func (m *PostingList) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				m.ValueType |= Posting_ValType(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
-				return ErrInvalidLengthPb
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthPb
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.Postings = append(m.Postings, &Posting{})
-			if err := m.Postings[len(m.Postings)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
 		case 3:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field CommitTs", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Directive", wireType)
 			}
-			m.CommitTs = 0
+			m.Directive = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowPb
@@ -20853,140 +24490,14 @@ func (m *PostingList) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.CommitTs |= uint64(b&0x7F) << shift
+				m.Directive |= SchemaUpdate_Directive(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
 		case 4:
-			if wireType == 0 {
-				var v uint64
-				for shift := uint(0); ; shift += 7 {
-					if shift >= 64 {
-						return ErrIntOverflowPb
-					}
-					if iNdEx >= l {
-						return io.ErrUnexpectedEOF
-					}
-					b := dAtA[iNdEx]
-					iNdEx++
-					v |= uint64(b&0x7F) << shift
-					if b < 0x80 {
-						break
-					}
-				}
-				m.Splits = append(m.Splits, v)
-			} else if wireType == 2 {
-				var packedLen int
-				for shift := uint(0); ; shift += 7 {
-					if shift >= 64 {
-						return ErrIntOverflowPb
-					}
-					if iNdEx >= l {
-						return io.ErrUnexpectedEOF
-					}
-					b := dAtA[iNdEx]
-					iNdEx++
-					packedLen |= int(b&0x7F) << shift
-					if b < 0x80 {
-						break
-					}
-				}
-				if packedLen < 0 {
-					return ErrInvalidLengthPb
-				}
-				postIndex := iNdEx + packedLen
-				if postIndex < 0 {
-					return ErrInvalidLengthPb
-				}
-				if postIndex > l {
-					return io.ErrUnexpectedEOF
-				}
-				var elementCount int
-				var count int
-				for _, integer := range dAtA[iNdEx:postIndex] {
-					if integer < 128 {
-						count++
-					}
-				}
-				elementCount = count
-				if elementCount != 0 && len(m.Splits) == 0 {
-					m.Splits = make([]uint64, 0, elementCount)
-				}
-				for iNdEx < postIndex {
-					var v uint64
-					for shift := uint(0); ; shift += 7 {
-						if shift >= 64 {
-							return ErrIntOverflowPb
-						}
-						if iNdEx >= l {
-							return io.ErrUnexpectedEOF
-						}
-						b := dAtA[iNdEx]
-						iNdEx++
-						v |= uint64(b&0x7F) << shift
-						if b < 0x80 {
-							break
-						}
-					}
-					m.Splits = append(m.Splits, v)
-				}
-			} else {
-				return fmt.Errorf("proto: wrong wireType = %d for field Splits", wireType)
-			}
-		default:
-			iNdEx = preIndex
-			skippy, err := skipPb(dAtA[iNdEx:])
-			if err != nil {
-				return err
-			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
-				return ErrInvalidLengthPb
-			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
-			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *FacetParam) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowPb
-			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
-			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
-			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: FacetParam: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: FacetParam: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Key", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Tokenizer", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -21014,13 +24525,13 @@ func (m *FacetParam) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Key = string(dAtA[iNdEx:postIndex])
+			m.Tokenizer = append(m.Tokenizer, string(dAtA[iNdEx:postIndex]))
 			iNdEx = postIndex
-		case 2:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Alias", wireType)
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Count", wireType)
 			}
-			var stringLen uint64
+			var v int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowPb
@@ -21030,77 +24541,95 @@ func (m *FacetParam) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				v |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthPb
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthPb
+			m.Count = bool(v != 0)
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field List", wireType)
 			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPb
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-			m.Alias = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipPb(dAtA[iNdEx:])
-			if err != nil {
-				return err
+			m.List = bool(v != 0)
+		case 8:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Upsert", wireType)
 			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
-				return ErrInvalidLengthPb
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPb
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
+			m.Upsert = bool(v != 0)
+		case 9:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Lang", wireType)
 			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *FacetParams) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowPb
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPb
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
+			m.Lang = bool(v != 0)
+		case 10:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NonNullable", wireType)
 			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPb
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: FacetParams: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: FacetParams: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+			m.NonNullable = bool(v != 0)
+		case 11:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field AllKeys", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field NonNullableList", wireType)
 			}
 			var v int
 			for shift := uint(0); ; shift += 7 {
@@ -21117,12 +24646,12 @@ func (m *FacetParams) Unmarshal(dAtA []byte) error {
 					break
 				}
 			}
-			m.AllKeys = bool(v != 0)
-		case 2:
+			m.NonNullableList = bool(v != 0)
+		case 12:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Param", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ObjectTypeName", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowPb
@@ -21132,26 +24661,83 @@ func (m *FacetParams) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthPb
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthPb
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Param = append(m.Param, &FacetParam{})
-			if err := m.Param[len(m.Param)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.ObjectTypeName = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
+		case 13:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NoConflict", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPb
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.NoConflict = bool(v != 0)
+		case 14:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Ordered", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPb
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Ordered = bool(v != 0)
+		case 15:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Ttl", wireType)
+			}
+			m.Ttl = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPb
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Ttl |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipPb(dAtA[iNdEx:])
@@ -21173,7 +24759,7 @@ func (m *FacetParams) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *Facets) Unmarshal(dAtA []byte) error {
+func (m *TypeUpdate) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -21196,15 +24782,47 @@ func (m *Facets) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: Facets: wiretype end group for non-group")
+			return fmt.Errorf("proto: TypeUpdate: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: Facets: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: TypeUpdate: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Facets", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field TypeName", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPb
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthPb
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthPb
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.TypeName = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Fields", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -21231,11 +24849,82 @@ func (m *Facets) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Facets = append(m.Facets, &api.Facet{})
-			if err := m.Facets[len(m.Facets)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.Fields = append(m.Fields, &SchemaUpdate{})
+			if err := m.Fields[len(m.Fields)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Strict", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPb
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Strict = bool(v != 0)
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Extends", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPb
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthPb
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthPb
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Extends = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Ttl", wireType)
+			}
+			m.Ttl = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPb
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Ttl |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipPb(dAtA[iNdEx:])
@@ -21257,7 +24946,7 @@ func (m *Facets) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *FacetsList) Unmarshal(dAtA []byte) error {
+func (m *MapHeader) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -21280,17 +24969,17 @@ func (m *FacetsList) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: FacetsList: wiretype end group for non-group")
+			return fmt.Errorf("proto: MapHeader: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: FacetsList: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MapHeader: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field FacetsList", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field PartitionKeys", wireType)
 			}
-			var msglen int
+			var byteLen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowPb
@@ -21300,25 +24989,23 @@ func (m *FacetsList) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				byteLen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			if byteLen < 0 {
 				return ErrInvalidLengthPb
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + byteLen
 			if postIndex < 0 {
 				return ErrInvalidLengthPb
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.FacetsList = append(m.FacetsList, &Facets{})
-			if err := m.FacetsList[len(m.FacetsList)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.PartitionKeys = append(m.PartitionKeys, make([]byte, postIndex-iNdEx))
+			copy(m.PartitionKeys[len(m.PartitionKeys)-1], dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -21341,7 +25028,7 @@ func (m *FacetsList) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *Function) Unmarshal(dAtA []byte) error {
+func (m *MovePredicatePayload) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -21364,15 +25051,15 @@ func (m *Function) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: Function: wiretype end group for non-group")
+			return fmt.Errorf("proto: MovePredicatePayload: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: Function: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MovePredicatePayload: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Predicate", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -21400,13 +25087,13 @@ func (m *Function) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Name = string(dAtA[iNdEx:postIndex])
+			m.Predicate = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Key", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SourceGid", wireType)
 			}
-			var stringLen uint64
+			m.SourceGid = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowPb
@@ -21416,29 +25103,16 @@ func (m *Function) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				m.SourceGid |= uint32(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthPb
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthPb
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.Key = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
 		case 3:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Args", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DestGid", wireType)
 			}
-			var stringLen uint64
+			m.DestGid = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowPb
@@ -21448,24 +25122,49 @@ func (m *Function) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				m.DestGid |= uint32(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthPb
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TxnTs", wireType)
 			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthPb
+			m.TxnTs = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPb
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.TxnTs |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ExpectedChecksum", wireType)
+			}
+			m.ExpectedChecksum = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPb
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ExpectedChecksum |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-			m.Args = append(m.Args, string(dAtA[iNdEx:postIndex]))
-			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipPb(dAtA[iNdEx:])
@@ -21487,7 +25186,7 @@ func (m *Function) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *FilterTree) Unmarshal(dAtA []byte) error {
+func (m *TxnStatus) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -21510,17 +25209,17 @@ func (m *FilterTree) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: FilterTree: wiretype end group for non-group")
+			return fmt.Errorf("proto: TxnStatus: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: FilterTree: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: TxnStatus: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Op", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field StartTs", wireType)
 			}
-			var stringLen uint64
+			m.StartTs = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowPb
@@ -21530,27 +25229,83 @@ func (m *FilterTree) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				m.StartTs |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthPb
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CommitTs", wireType)
 			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
+			m.CommitTs = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPb
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.CommitTs |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipPb(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
 				return ErrInvalidLengthPb
 			}
-			if postIndex > l {
+			if (iNdEx + skippy) > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Op = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 2:
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *OracleDelta) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowPb
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: OracleDelta: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: OracleDelta: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Children", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Txns", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -21577,14 +25332,33 @@ func (m *FilterTree) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Children = append(m.Children, &FilterTree{})
-			if err := m.Children[len(m.Children)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.Txns = append(m.Txns, &TxnStatus{})
+			if err := m.Txns[len(m.Txns)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxAssigned", wireType)
+			}
+			m.MaxAssigned = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPb
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MaxAssigned |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Func", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field GroupChecksums", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -21611,12 +25385,75 @@ func (m *FilterTree) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Func == nil {
-				m.Func = &Function{}
+			if m.GroupChecksums == nil {
+				m.GroupChecksums = make(map[uint32]uint64)
 			}
-			if err := m.Func.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
+			var mapkey uint32
+			var mapvalue uint64
+			for iNdEx < postIndex {
+				entryPreIndex := iNdEx
+				var wire uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowPb
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					wire |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				fieldNum := int32(wire >> 3)
+				if fieldNum == 1 {
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowPb
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						mapkey |= uint32(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+				} else if fieldNum == 2 {
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowPb
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						mapvalue |= uint64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+				} else {
+					iNdEx = entryPreIndex
+					skippy, err := skipPb(dAtA[iNdEx:])
+					if err != nil {
+						return err
+					}
+					if (skippy < 0) || (iNdEx+skippy) < 0 {
+						return ErrInvalidLengthPb
+					}
+					if (iNdEx + skippy) > postIndex {
+						return io.ErrUnexpectedEOF
+					}
+					iNdEx += skippy
+				}
 			}
+			m.GroupChecksums[mapkey] = mapvalue
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -21639,7 +25476,7 @@ func (m *FilterTree) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *SchemaRequest) Unmarshal(dAtA []byte) error {
+func (m *TxnTimestamps) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -21662,127 +25499,88 @@ func (m *SchemaRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: SchemaRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: TxnTimestamps: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: SchemaRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: TxnTimestamps: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field GroupId", wireType)
-			}
-			m.GroupId = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowPb
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.GroupId |= uint32(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-		case 2:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Predicates", wireType)
-			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowPb
+			if wireType == 0 {
+				var v uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowPb
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					v |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
 				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
+				m.Ts = append(m.Ts, v)
+			} else if wireType == 2 {
+				var packedLen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowPb
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					packedLen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
 				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
+				if packedLen < 0 {
+					return ErrInvalidLengthPb
 				}
-			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthPb
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthPb
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.Predicates = append(m.Predicates, string(dAtA[iNdEx:postIndex]))
-			iNdEx = postIndex
-		case 3:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Fields", wireType)
-			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowPb
+				postIndex := iNdEx + packedLen
+				if postIndex < 0 {
+					return ErrInvalidLengthPb
 				}
-				if iNdEx >= l {
+				if postIndex > l {
 					return io.ErrUnexpectedEOF
 				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthPb
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthPb
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.Fields = append(m.Fields, string(dAtA[iNdEx:postIndex]))
-			iNdEx = postIndex
-		case 4:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Types", wireType)
-			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowPb
+				var elementCount int
+				var count int
+				for _, integer := range dAtA[iNdEx:postIndex] {
+					if integer < 128 {
+						count++
+					}
 				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
+				elementCount = count
+				if elementCount != 0 && len(m.Ts) == 0 {
+					m.Ts = make([]uint64, 0, elementCount)
 				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
+				for iNdEx < postIndex {
+					var v uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowPb
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						v |= uint64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					m.Ts = append(m.Ts, v)
 				}
+			} else {
+				return fmt.Errorf("proto: wrong wireType = %d for field Ts", wireType)
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthPb
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthPb
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.Types = append(m.Types, string(dAtA[iNdEx:postIndex]))
-			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipPb(dAtA[iNdEx:])
@@ -21804,7 +25602,7 @@ func (m *SchemaRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *SchemaNode) Unmarshal(dAtA []byte) error {
+func (m *PeerResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -21819,239 +25617,23 @@ func (m *SchemaNode) Unmarshal(dAtA []byte) error {
 			}
 			b := dAtA[iNdEx]
 			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
-			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: SchemaNode: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: SchemaNode: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Predicate", wireType)
-			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowPb
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthPb
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthPb
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.Predicate = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 2:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Type", wireType)
-			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowPb
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthPb
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthPb
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.Type = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 3:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Index", wireType)
-			}
-			var v int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowPb
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				v |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			m.Index = bool(v != 0)
-		case 4:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Tokenizer", wireType)
-			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowPb
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthPb
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthPb
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.Tokenizer = append(m.Tokenizer, string(dAtA[iNdEx:postIndex]))
-			iNdEx = postIndex
-		case 5:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Reverse", wireType)
-			}
-			var v int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowPb
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				v |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			m.Reverse = bool(v != 0)
-		case 6:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Count", wireType)
-			}
-			var v int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowPb
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				v |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			m.Count = bool(v != 0)
-		case 7:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field List", wireType)
-			}
-			var v int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowPb
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				v |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			m.List = bool(v != 0)
-		case 8:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Upsert", wireType)
-			}
-			var v int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowPb
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				v |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			m.Upsert = bool(v != 0)
-		case 9:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Lang", wireType)
-			}
-			var v int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowPb
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				v |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
 			}
-			m.Lang = bool(v != 0)
-		case 10:
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: PeerResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: PeerResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field NoConflict", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Status", wireType)
 			}
 			var v int
 			for shift := uint(0); ; shift += 7 {
@@ -22068,7 +25650,7 @@ func (m *SchemaNode) Unmarshal(dAtA []byte) error {
 					break
 				}
 			}
-			m.NoConflict = bool(v != 0)
+			m.Status = bool(v != 0)
 		default:
 			iNdEx = preIndex
 			skippy, err := skipPb(dAtA[iNdEx:])
@@ -22090,7 +25672,7 @@ func (m *SchemaNode) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *SchemaResult) Unmarshal(dAtA []byte) error {
+func (m *RaftBatch) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -22113,15 +25695,15 @@ func (m *SchemaResult) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: SchemaResult: wiretype end group for non-group")
+			return fmt.Errorf("proto: RaftBatch: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: SchemaResult: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: RaftBatch: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Schema", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Context", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -22148,8 +25730,46 @@ func (m *SchemaResult) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Schema = append(m.Schema, &SchemaNode{})
-			if err := m.Schema[len(m.Schema)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if m.Context == nil {
+				m.Context = &RaftContext{}
+			}
+			if err := m.Context.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Payload", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPb
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthPb
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthPb
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Payload == nil {
+				m.Payload = &api.Payload{}
+			}
+			if err := m.Payload.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -22174,7 +25794,7 @@ func (m *SchemaResult) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *SchemaUpdate) Unmarshal(dAtA []byte) error {
+func (m *TabletResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -22197,17 +25817,17 @@ func (m *SchemaUpdate) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: SchemaUpdate: wiretype end group for non-group")
+			return fmt.Errorf("proto: TabletResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: SchemaUpdate: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: TabletResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Predicate", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Tablets", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowPb
@@ -22217,159 +25837,81 @@ func (m *SchemaUpdate) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthPb
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthPb
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Predicate = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 2:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ValueType", wireType)
-			}
-			m.ValueType = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowPb
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.ValueType |= Posting_ValType(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-		case 3:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Directive", wireType)
-			}
-			m.Directive = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowPb
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.Directive |= SchemaUpdate_Directive(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-		case 4:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Tokenizer", wireType)
-			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowPb
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
+			m.Tablets = append(m.Tablets, &Tablet{})
+			if err := m.Tablets[len(m.Tablets)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthPb
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipPb(dAtA[iNdEx:])
+			if err != nil {
+				return err
 			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
 				return ErrInvalidLengthPb
 			}
-			if postIndex > l {
+			if (iNdEx + skippy) > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Tokenizer = append(m.Tokenizer, string(dAtA[iNdEx:postIndex]))
-			iNdEx = postIndex
-		case 5:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Count", wireType)
-			}
-			var v int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowPb
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				v |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			m.Count = bool(v != 0)
-		case 6:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field List", wireType)
-			}
-			var v int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowPb
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				v |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *TabletRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowPb
 			}
-			m.List = bool(v != 0)
-		case 8:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Upsert", wireType)
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
 			}
-			var v int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowPb
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				v |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
 			}
-			m.Upsert = bool(v != 0)
-		case 9:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Lang", wireType)
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: TabletRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: TabletRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Tablets", wireType)
 			}
-			var v int
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowPb
@@ -22379,37 +25921,31 @@ func (m *SchemaUpdate) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				v |= int(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			m.Lang = bool(v != 0)
-		case 10:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field NonNullable", wireType)
+			if msglen < 0 {
+				return ErrInvalidLengthPb
 			}
-			var v int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowPb
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				v |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthPb
 			}
-			m.NonNullable = bool(v != 0)
-		case 11:
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Tablets = append(m.Tablets, &Tablet{})
+			if err := m.Tablets[len(m.Tablets)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field NonNullableList", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field GroupId", wireType)
 			}
-			var v int
+			m.GroupId = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowPb
@@ -22419,17 +25955,66 @@ func (m *SchemaUpdate) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				v |= int(b&0x7F) << shift
+				m.GroupId |= uint32(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			m.NonNullableList = bool(v != 0)
-		case 12:
+		default:
+			iNdEx = preIndex
+			skippy, err := skipPb(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthPb
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *SubscriptionRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowPb
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: SubscriptionRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: SubscriptionRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ObjectTypeName", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Prefixes", wireType)
 			}
-			var stringLen uint64
+			var byteLen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowPb
@@ -22439,29 +26024,29 @@ func (m *SchemaUpdate) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				byteLen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if byteLen < 0 {
 				return ErrInvalidLengthPb
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + byteLen
 			if postIndex < 0 {
 				return ErrInvalidLengthPb
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.ObjectTypeName = string(dAtA[iNdEx:postIndex])
+			m.Prefixes = append(m.Prefixes, make([]byte, postIndex-iNdEx))
+			copy(m.Prefixes[len(m.Prefixes)-1], dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 13:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field NoConflict", wireType)
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Matches", wireType)
 			}
-			var v int
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowPb
@@ -22471,12 +26056,26 @@ func (m *SchemaUpdate) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				v |= int(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			m.NoConflict = bool(v != 0)
+			if msglen < 0 {
+				return ErrInvalidLengthPb
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthPb
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Matches = append(m.Matches, &pb.Match{})
+			if err := m.Matches[len(m.Matches)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipPb(dAtA[iNdEx:])
@@ -22498,7 +26097,7 @@ func (m *SchemaUpdate) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *TypeUpdate) Unmarshal(dAtA []byte) error {
+func (m *SubscriptionResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -22521,47 +26120,15 @@ func (m *TypeUpdate) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: TypeUpdate: wiretype end group for non-group")
+			return fmt.Errorf("proto: SubscriptionResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: TypeUpdate: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: SubscriptionResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field TypeName", wireType)
-			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowPb
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthPb
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthPb
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.TypeName = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 2:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Fields", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Kvs", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -22588,8 +26155,10 @@ func (m *TypeUpdate) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Fields = append(m.Fields, &SchemaUpdate{})
-			if err := m.Fields[len(m.Fields)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if m.Kvs == nil {
+				m.Kvs = &pb.KVList{}
+			}
+			if err := m.Kvs.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -22614,7 +26183,7 @@ func (m *TypeUpdate) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MapHeader) Unmarshal(dAtA []byte) error {
+func (m *Num) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -22637,17 +26206,76 @@ func (m *MapHeader) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MapHeader: wiretype end group for non-group")
+			return fmt.Errorf("proto: Num: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MapHeader: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: Num: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field PartitionKeys", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Val", wireType)
+			}
+			m.Val = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPb
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Val |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ReadOnly", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPb
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.ReadOnly = bool(v != 0)
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Forwarded", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPb
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Forwarded = bool(v != 0)
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Type", wireType)
 			}
-			var byteLen int
+			m.Type = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowPb
@@ -22657,24 +26285,11 @@ func (m *MapHeader) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				byteLen |= int(b&0x7F) << shift
+				m.Type |= NumLeaseType(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if byteLen < 0 {
-				return ErrInvalidLengthPb
-			}
-			postIndex := iNdEx + byteLen
-			if postIndex < 0 {
-				return ErrInvalidLengthPb
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.PartitionKeys = append(m.PartitionKeys, make([]byte, postIndex-iNdEx))
-			copy(m.PartitionKeys[len(m.PartitionKeys)-1], dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipPb(dAtA[iNdEx:])
@@ -22696,7 +26311,7 @@ func (m *MapHeader) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MovePredicatePayload) Unmarshal(dAtA []byte) error {
+func (m *AssignedIds) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -22719,17 +26334,17 @@ func (m *MovePredicatePayload) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MovePredicatePayload: wiretype end group for non-group")
+			return fmt.Errorf("proto: AssignedIds: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MovePredicatePayload: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: AssignedIds: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Predicate", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field StartId", wireType)
 			}
-			var stringLen uint64
+			m.StartId = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowPb
@@ -22739,29 +26354,16 @@ func (m *MovePredicatePayload) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				m.StartId |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthPb
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthPb
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.Predicate = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
 		case 2:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field SourceGid", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field EndId", wireType)
 			}
-			m.SourceGid = 0
+			m.EndId = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowPb
@@ -22771,16 +26373,16 @@ func (m *MovePredicatePayload) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.SourceGid |= uint32(b&0x7F) << shift
+				m.EndId |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 3:
+		case 5:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field DestGid", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ReadOnly", wireType)
 			}
-			m.DestGid = 0
+			m.ReadOnly = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowPb
@@ -22790,16 +26392,66 @@ func (m *MovePredicatePayload) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.DestGid |= uint32(b&0x7F) << shift
+				m.ReadOnly |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 4:
+		default:
+			iNdEx = preIndex
+			skippy, err := skipPb(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthPb
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *RemoveNodeRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowPb
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: RemoveNodeRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: RemoveNodeRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field TxnTs", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field NodeId", wireType)
 			}
-			m.TxnTs = 0
+			m.NodeId = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowPb
@@ -22809,16 +26461,16 @@ func (m *MovePredicatePayload) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.TxnTs |= uint64(b&0x7F) << shift
+				m.NodeId |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 5:
+		case 2:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ExpectedChecksum", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field GroupId", wireType)
 			}
-			m.ExpectedChecksum = 0
+			m.GroupId = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowPb
@@ -22828,7 +26480,7 @@ func (m *MovePredicatePayload) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.ExpectedChecksum |= uint64(b&0x7F) << shift
+				m.GroupId |= uint32(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
@@ -22854,7 +26506,7 @@ func (m *MovePredicatePayload) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *TxnStatus) Unmarshal(dAtA []byte) error {
+func (m *MoveTabletRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -22877,17 +26529,17 @@ func (m *TxnStatus) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: TxnStatus: wiretype end group for non-group")
+			return fmt.Errorf("proto: MoveTabletRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: TxnStatus: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MoveTabletRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field StartTs", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Namespace", wireType)
 			}
-			m.StartTs = 0
+			m.Namespace = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowPb
@@ -22897,16 +26549,48 @@ func (m *TxnStatus) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.StartTs |= uint64(b&0x7F) << shift
+				m.Namespace |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
 		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Tablet", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPb
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthPb
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthPb
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Tablet = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field CommitTs", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field DstGroup", wireType)
 			}
-			m.CommitTs = 0
+			m.DstGroup = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowPb
@@ -22916,7 +26600,7 @@ func (m *TxnStatus) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.CommitTs |= uint64(b&0x7F) << shift
+				m.DstGroup |= uint32(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
@@ -22942,7 +26626,7 @@ func (m *TxnStatus) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *OracleDelta) Unmarshal(dAtA []byte) error {
+func (m *ApplyLicenseRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -22965,17 +26649,17 @@ func (m *OracleDelta) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: OracleDelta: wiretype end group for non-group")
+			return fmt.Errorf("proto: ApplyLicenseRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: OracleDelta: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: ApplyLicenseRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Txns", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field License", wireType)
 			}
-			var msglen int
+			var byteLen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowPb
@@ -22985,31 +26669,81 @@ func (m *OracleDelta) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				byteLen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			if byteLen < 0 {
 				return ErrInvalidLengthPb
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + byteLen
 			if postIndex < 0 {
 				return ErrInvalidLengthPb
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Txns = append(m.Txns, &TxnStatus{})
-			if err := m.Txns[len(m.Txns)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
+			m.License = append(m.License[:0], dAtA[iNdEx:postIndex]...)
+			if m.License == nil {
+				m.License = []byte{}
 			}
 			iNdEx = postIndex
-		case 2:
+		default:
+			iNdEx = preIndex
+			skippy, err := skipPb(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthPb
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *SnapshotMeta) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowPb
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: SnapshotMeta: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: SnapshotMeta: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field MaxAssigned", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ClientTs", wireType)
 			}
-			m.MaxAssigned = 0
+			m.ClientTs = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowPb
@@ -23019,16 +26753,16 @@ func (m *OracleDelta) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.MaxAssigned |= uint64(b&0x7F) << shift
+				m.ClientTs |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 3:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field GroupChecksums", wireType)
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field GroupId", wireType)
 			}
-			var msglen int
+			m.GroupId = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowPb
@@ -23038,91 +26772,11 @@ func (m *OracleDelta) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				m.GroupId |= uint32(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
-				return ErrInvalidLengthPb
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthPb
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if m.GroupChecksums == nil {
-				m.GroupChecksums = make(map[uint32]uint64)
-			}
-			var mapkey uint32
-			var mapvalue uint64
-			for iNdEx < postIndex {
-				entryPreIndex := iNdEx
-				var wire uint64
-				for shift := uint(0); ; shift += 7 {
-					if shift >= 64 {
-						return ErrIntOverflowPb
-					}
-					if iNdEx >= l {
-						return io.ErrUnexpectedEOF
-					}
-					b := dAtA[iNdEx]
-					iNdEx++
-					wire |= uint64(b&0x7F) << shift
-					if b < 0x80 {
-						break
-					}
-				}
-				fieldNum := int32(wire >> 3)
-				if fieldNum == 1 {
-					for shift := uint(0); ; shift += 7 {
-						if shift >= 64 {
-							return ErrIntOverflowPb
-						}
-						if iNdEx >= l {
-							return io.ErrUnexpectedEOF
-						}
-						b := dAtA[iNdEx]
-						iNdEx++
-						mapkey |= uint32(b&0x7F) << shift
-						if b < 0x80 {
-							break
-						}
-					}
-				} else if fieldNum == 2 {
-					for shift := uint(0); ; shift += 7 {
-						if shift >= 64 {
-							return ErrIntOverflowPb
-						}
-						if iNdEx >= l {
-							return io.ErrUnexpectedEOF
-						}
-						b := dAtA[iNdEx]
-						iNdEx++
-						mapvalue |= uint64(b&0x7F) << shift
-						if b < 0x80 {
-							break
-						}
-					}
-				} else {
-					iNdEx = entryPreIndex
-					skippy, err := skipPb(dAtA[iNdEx:])
-					if err != nil {
-						return err
-					}
-					if (skippy < 0) || (iNdEx+skippy) < 0 {
-						return ErrInvalidLengthPb
-					}
-					if (iNdEx + skippy) > postIndex {
-						return io.ErrUnexpectedEOF
-					}
-					iNdEx += skippy
-				}
-			}
-			m.GroupChecksums[mapkey] = mapvalue
-			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipPb(dAtA[iNdEx:])
@@ -23144,7 +26798,7 @@ func (m *OracleDelta) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *TxnTimestamps) Unmarshal(dAtA []byte) error {
+func (m *Status) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -23167,88 +26821,63 @@ func (m *TxnTimestamps) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: TxnTimestamps: wiretype end group for non-group")
+			return fmt.Errorf("proto: Status: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: TxnTimestamps: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: Status: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType == 0 {
-				var v uint64
-				for shift := uint(0); ; shift += 7 {
-					if shift >= 64 {
-						return ErrIntOverflowPb
-					}
-					if iNdEx >= l {
-						return io.ErrUnexpectedEOF
-					}
-					b := dAtA[iNdEx]
-					iNdEx++
-					v |= uint64(b&0x7F) << shift
-					if b < 0x80 {
-						break
-					}
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Code", wireType)
+			}
+			m.Code = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPb
 				}
-				m.Ts = append(m.Ts, v)
-			} else if wireType == 2 {
-				var packedLen int
-				for shift := uint(0); ; shift += 7 {
-					if shift >= 64 {
-						return ErrIntOverflowPb
-					}
-					if iNdEx >= l {
-						return io.ErrUnexpectedEOF
-					}
-					b := dAtA[iNdEx]
-					iNdEx++
-					packedLen |= int(b&0x7F) << shift
-					if b < 0x80 {
-						break
-					}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
 				}
-				if packedLen < 0 {
-					return ErrInvalidLengthPb
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Code |= int32(b&0x7F) << shift
+				if b < 0x80 {
+					break
 				}
-				postIndex := iNdEx + packedLen
-				if postIndex < 0 {
-					return ErrInvalidLengthPb
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Msg", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPb
 				}
-				if postIndex > l {
+				if iNdEx >= l {
 					return io.ErrUnexpectedEOF
 				}
-				var elementCount int
-				var count int
-				for _, integer := range dAtA[iNdEx:postIndex] {
-					if integer < 128 {
-						count++
-					}
-				}
-				elementCount = count
-				if elementCount != 0 && len(m.Ts) == 0 {
-					m.Ts = make([]uint64, 0, elementCount)
-				}
-				for iNdEx < postIndex {
-					var v uint64
-					for shift := uint(0); ; shift += 7 {
-						if shift >= 64 {
-							return ErrIntOverflowPb
-						}
-						if iNdEx >= l {
-							return io.ErrUnexpectedEOF
-						}
-						b := dAtA[iNdEx]
-						iNdEx++
-						v |= uint64(b&0x7F) << shift
-						if b < 0x80 {
-							break
-						}
-					}
-					m.Ts = append(m.Ts, v)
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
 				}
-			} else {
-				return fmt.Errorf("proto: wrong wireType = %d for field Ts", wireType)
 			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthPb
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthPb
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Msg = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipPb(dAtA[iNdEx:])
@@ -23270,7 +26899,7 @@ func (m *TxnTimestamps) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *PeerResponse) Unmarshal(dAtA []byte) error {
+func (m *BackupRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -23293,17 +26922,74 @@ func (m *PeerResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: PeerResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: BackupRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: PeerResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: BackupRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Status", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ReadTs", wireType)
+			}
+			m.ReadTs = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPb
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ReadTs |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SinceTs", wireType)
+			}
+			m.SinceTs = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPb
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.SinceTs |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field GroupId", wireType)
+			}
+			m.GroupId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPb
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.GroupId |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-			var v int
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field UnixTs", wireType)
+			}
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowPb
@@ -23313,67 +26999,61 @@ func (m *PeerResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				v |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			m.Status = bool(v != 0)
-		default:
-			iNdEx = preIndex
-			skippy, err := skipPb(dAtA[iNdEx:])
-			if err != nil {
-				return err
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthPb
 			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
 				return ErrInvalidLengthPb
 			}
-			if (iNdEx + skippy) > l {
+			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *RaftBatch) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowPb
+			m.UnixTs = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Destination", wireType)
 			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPb
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthPb
 			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: RaftBatch: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: RaftBatch: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthPb
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Destination = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 6:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Context", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field AccessKey", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowPb
@@ -23383,33 +27063,29 @@ func (m *RaftBatch) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthPb
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthPb
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Context == nil {
-				m.Context = &RaftContext{}
-			}
-			if err := m.Context.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.AccessKey = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 2:
+		case 7:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Payload", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field SecretKey", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowPb
@@ -23419,83 +27095,81 @@ func (m *RaftBatch) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthPb
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthPb
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Payload == nil {
-				m.Payload = &api.Payload{}
+			m.SecretKey = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SessionToken", wireType)
 			}
-			if err := m.Payload.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPb
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipPb(dAtA[iNdEx:])
-			if err != nil {
-				return err
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthPb
 			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
 				return ErrInvalidLengthPb
 			}
-			if (iNdEx + skippy) > l {
+			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *TabletResponse) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowPb
-			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
+			m.SessionToken = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 9:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Anonymous", wireType)
 			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPb
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: TabletResponse: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: TabletResponse: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+			m.Anonymous = bool(v != 0)
+		case 10:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Tablets", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Predicates", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowPb
@@ -23505,26 +27179,44 @@ func (m *TabletResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthPb
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthPb
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Tablets = append(m.Tablets, &Tablet{})
-			if err := m.Tablets[len(m.Tablets)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
+			m.Predicates = append(m.Predicates, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 11:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ForceFull", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPb
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-			iNdEx = postIndex
+			m.ForceFull = bool(v != 0)
 		default:
 			iNdEx = preIndex
 			skippy, err := skipPb(dAtA[iNdEx:])
@@ -23546,7 +27238,7 @@ func (m *TabletResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *TabletRequest) Unmarshal(dAtA []byte) error {
+func (m *BackupResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -23569,15 +27261,15 @@ func (m *TabletRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: TabletRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: BackupResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: TabletRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: BackupResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Tablets", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field DropOperations", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -23604,30 +27296,11 @@ func (m *TabletRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Tablets = append(m.Tablets, &Tablet{})
-			if err := m.Tablets[len(m.Tablets)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.DropOperations = append(m.DropOperations, &DropOperation{})
+			if err := m.DropOperations[len(m.DropOperations)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 2:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field GroupId", wireType)
-			}
-			m.GroupId = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowPb
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.GroupId |= uint32(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipPb(dAtA[iNdEx:])
@@ -23649,7 +27322,7 @@ func (m *TabletRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *SubscriptionRequest) Unmarshal(dAtA []byte) error {
+func (m *DropOperation) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -23672,17 +27345,17 @@ func (m *SubscriptionRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: SubscriptionRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: DropOperation: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: SubscriptionRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: DropOperation: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Prefixes", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DropOp", wireType)
 			}
-			var byteLen int
+			m.DropOp = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowPb
@@ -23692,29 +27365,16 @@ func (m *SubscriptionRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				byteLen |= int(b&0x7F) << shift
+				m.DropOp |= DropOperation_DropOp(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if byteLen < 0 {
-				return ErrInvalidLengthPb
-			}
-			postIndex := iNdEx + byteLen
-			if postIndex < 0 {
-				return ErrInvalidLengthPb
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.Prefixes = append(m.Prefixes, make([]byte, postIndex-iNdEx))
-			copy(m.Prefixes[len(m.Prefixes)-1], dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Matches", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field DropValue", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowPb
@@ -23724,25 +27384,23 @@ func (m *SubscriptionRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthPb
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthPb
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Matches = append(m.Matches, &pb.Match{})
-			if err := m.Matches[len(m.Matches)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.DropValue = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -23765,7 +27423,7 @@ func (m *SubscriptionRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *SubscriptionResponse) Unmarshal(dAtA []byte) error {
+func (m *ExportRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -23788,17 +27446,74 @@ func (m *SubscriptionResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: SubscriptionResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: ExportRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: SubscriptionResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: ExportRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field GroupId", wireType)
+			}
+			m.GroupId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPb
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.GroupId |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ReadTs", wireType)
+			}
+			m.ReadTs = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPb
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ReadTs |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field UnixTs", wireType)
+			}
+			m.UnixTs = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPb
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.UnixTs |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Kvs", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Format", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowPb
@@ -23808,83 +27523,93 @@ func (m *SubscriptionResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthPb
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthPb
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Kvs == nil {
-				m.Kvs = &pb.KVList{}
+			m.Format = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Destination", wireType)
 			}
-			if err := m.Kvs.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPb
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipPb(dAtA[iNdEx:])
-			if err != nil {
-				return err
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthPb
 			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
 				return ErrInvalidLengthPb
 			}
-			if (iNdEx + skippy) > l {
+			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *Num) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowPb
+			m.Destination = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AccessKey", wireType)
 			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPb
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthPb
 			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: Num: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: Num: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Val", wireType)
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthPb
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
 			}
-			m.Val = 0
+			m.AccessKey = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SecretKey", wireType)
+			}
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowPb
@@ -23894,16 +27619,29 @@ func (m *Num) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.Val |= uint64(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 2:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ReadOnly", wireType)
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthPb
 			}
-			var v int
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthPb
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.SecretKey = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SessionToken", wireType)
+			}
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowPb
@@ -23913,15 +27651,27 @@ func (m *Num) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				v |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			m.ReadOnly = bool(v != 0)
-		case 3:
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthPb
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthPb
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.SessionToken = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 9:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Forwarded", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Anonymous", wireType)
 			}
 			var v int
 			for shift := uint(0); ; shift += 7 {
@@ -23938,12 +27688,12 @@ func (m *Num) Unmarshal(dAtA []byte) error {
 					break
 				}
 			}
-			m.Forwarded = bool(v != 0)
-		case 4:
+			m.Anonymous = bool(v != 0)
+		case 10:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Type", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Namespace", wireType)
 			}
-			m.Type = 0
+			m.Namespace = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowPb
@@ -23953,7 +27703,7 @@ func (m *Num) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.Type |= NumLeaseType(b&0x7F) << shift
+				m.Namespace |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
@@ -23979,7 +27729,7 @@ func (m *Num) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *AssignedIds) Unmarshal(dAtA []byte) error {
+func (m *ExportResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -24002,17 +27752,17 @@ func (m *AssignedIds) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: AssignedIds: wiretype end group for non-group")
+			return fmt.Errorf("proto: ExportResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: AssignedIds: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: ExportResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field StartId", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Code", wireType)
 			}
-			m.StartId = 0
+			m.Code = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowPb
@@ -24022,35 +27772,16 @@ func (m *AssignedIds) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.StartId |= uint64(b&0x7F) << shift
+				m.Code |= int32(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
 		case 2:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field EndId", wireType)
-			}
-			m.EndId = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowPb
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.EndId |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-		case 5:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ReadOnly", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Msg", wireType)
 			}
-			m.ReadOnly = 0
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowPb
@@ -24060,66 +27791,29 @@ func (m *AssignedIds) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.ReadOnly |= uint64(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		default:
-			iNdEx = preIndex
-			skippy, err := skipPb(dAtA[iNdEx:])
-			if err != nil {
-				return err
-			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthPb
 			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
-			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *RemoveNodeRequest) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowPb
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthPb
 			}
-			if iNdEx >= l {
+			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
-			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: RemoveNodeRequest: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: RemoveNodeRequest: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field NodeId", wireType)
+			m.Msg = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Files", wireType)
 			}
-			m.NodeId = 0
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowPb
@@ -24129,30 +27823,24 @@ func (m *RemoveNodeRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.NodeId |= uint64(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 2:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field GroupId", wireType)
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthPb
 			}
-			m.GroupId = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowPb
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.GroupId |= uint32(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthPb
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
 			}
+			m.Files = append(m.Files, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipPb(dAtA[iNdEx:])
@@ -24174,7 +27862,7 @@ func (m *RemoveNodeRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MoveTabletRequest) Unmarshal(dAtA []byte) error {
+func (m *BackupKey) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -24197,17 +27885,17 @@ func (m *MoveTabletRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MoveTabletRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: BackupKey: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MoveTabletRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: BackupKey: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Namespace", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Type", wireType)
 			}
-			m.Namespace = 0
+			m.Type = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowPb
@@ -24217,14 +27905,14 @@ func (m *MoveTabletRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.Namespace |= uint64(b&0x7F) << shift
+				m.Type |= BackupKey_KeyType(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Tablet", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Attr", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -24252,13 +27940,13 @@ func (m *MoveTabletRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Tablet = string(dAtA[iNdEx:postIndex])
+			m.Attr = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 3:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field DstGroup", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Uid", wireType)
 			}
-			m.DstGroup = 0
+			m.Uid = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowPb
@@ -24268,66 +27956,35 @@ func (m *MoveTabletRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.DstGroup |= uint32(b&0x7F) << shift
+				m.Uid |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		default:
-			iNdEx = preIndex
-			skippy, err := skipPb(dAtA[iNdEx:])
-			if err != nil {
-				return err
-			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
-				return ErrInvalidLengthPb
-			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
-			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *ApplyLicenseRequest) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowPb
-			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
-			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field StartUid", wireType)
 			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: ApplyLicenseRequest: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: ApplyLicenseRequest: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+			m.StartUid = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPb
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.StartUid |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field License", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Term", wireType)
 			}
-			var byteLen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowPb
@@ -24337,81 +27994,29 @@ func (m *ApplyLicenseRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				byteLen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if byteLen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthPb
 			}
-			postIndex := iNdEx + byteLen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthPb
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.License = append(m.License[:0], dAtA[iNdEx:postIndex]...)
-			if m.License == nil {
-				m.License = []byte{}
-			}
+			m.Term = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipPb(dAtA[iNdEx:])
-			if err != nil {
-				return err
-			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
-				return ErrInvalidLengthPb
-			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
-			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *SnapshotMeta) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowPb
-			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
-			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
-			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: SnapshotMeta: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: SnapshotMeta: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+		case 6:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ClientTs", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Count", wireType)
 			}
-			m.ClientTs = 0
+			m.Count = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowPb
@@ -24421,16 +28026,16 @@ func (m *SnapshotMeta) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.ClientTs |= uint64(b&0x7F) << shift
+				m.Count |= uint32(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 2:
+		case 7:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field GroupId", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Namespace", wireType)
 			}
-			m.GroupId = 0
+			m.Namespace = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowPb
@@ -24440,7 +28045,7 @@ func (m *SnapshotMeta) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.GroupId |= uint32(b&0x7F) << shift
+				m.Namespace |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
@@ -24466,7 +28071,7 @@ func (m *SnapshotMeta) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *Status) Unmarshal(dAtA []byte) error {
+func (m *BackupPostingList) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -24489,36 +28094,222 @@ func (m *Status) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: Status: wiretype end group for non-group")
+			return fmt.Errorf("proto: BackupPostingList: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: Status: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: BackupPostingList: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
+			if wireType == 0 {
+				var v uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowPb
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					v |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				m.Uids = append(m.Uids, v)
+			} else if wireType == 2 {
+				var packedLen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowPb
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					packedLen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if packedLen < 0 {
+					return ErrInvalidLengthPb
+				}
+				postIndex := iNdEx + packedLen
+				if postIndex < 0 {
+					return ErrInvalidLengthPb
+				}
+				if postIndex > l {
+					return io.ErrUnexpectedEOF
+				}
+				var elementCount int
+				var count int
+				for _, integer := range dAtA[iNdEx:postIndex] {
+					if integer < 128 {
+						count++
+					}
+				}
+				elementCount = count
+				if elementCount != 0 && len(m.Uids) == 0 {
+					m.Uids = make([]uint64, 0, elementCount)
+				}
+				for iNdEx < postIndex {
+					var v uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowPb
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						v |= uint64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					m.Uids = append(m.Uids, v)
+				}
+			} else {
+				return fmt.Errorf("proto: wrong wireType = %d for field Uids", wireType)
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Postings", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPb
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthPb
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthPb
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Postings = append(m.Postings, &Posting{})
+			if err := m.Postings[len(m.Postings)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Code", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field CommitTs", wireType)
 			}
-			m.Code = 0
+			m.CommitTs = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowPb
 				}
-				if iNdEx >= l {
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.CommitTs |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType == 0 {
+				var v uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowPb
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					v |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				m.Splits = append(m.Splits, v)
+			} else if wireType == 2 {
+				var packedLen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowPb
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					packedLen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if packedLen < 0 {
+					return ErrInvalidLengthPb
+				}
+				postIndex := iNdEx + packedLen
+				if postIndex < 0 {
+					return ErrInvalidLengthPb
+				}
+				if postIndex > l {
 					return io.ErrUnexpectedEOF
 				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.Code |= int32(b&0x7F) << shift
-				if b < 0x80 {
-					break
+				var elementCount int
+				var count int
+				for _, integer := range dAtA[iNdEx:postIndex] {
+					if integer < 128 {
+						count++
+					}
+				}
+				elementCount = count
+				if elementCount != 0 && len(m.Splits) == 0 {
+					m.Splits = make([]uint64, 0, elementCount)
+				}
+				for iNdEx < postIndex {
+					var v uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowPb
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						v |= uint64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					m.Splits = append(m.Splits, v)
 				}
+			} else {
+				return fmt.Errorf("proto: wrong wireType = %d for field Splits", wireType)
 			}
-		case 2:
+		case 5:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Msg", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field UidBytes", wireType)
 			}
-			var stringLen uint64
+			var byteLen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowPb
@@ -24528,23 +28319,25 @@ func (m *Status) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				byteLen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if byteLen < 0 {
 				return ErrInvalidLengthPb
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + byteLen
 			if postIndex < 0 {
 				return ErrInvalidLengthPb
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Msg = string(dAtA[iNdEx:postIndex])
+			m.UidBytes = append(m.UidBytes[:0], dAtA[iNdEx:postIndex]...)
+			if m.UidBytes == nil {
+				m.UidBytes = []byte{}
+			}
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -24567,7 +28360,7 @@ func (m *Status) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *BackupRequest) Unmarshal(dAtA []byte) error {
+func (m *UpdateGraphQLSchemaRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -24590,170 +28383,17 @@ func (m *BackupRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: BackupRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: UpdateGraphQLSchemaRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: BackupRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: UpdateGraphQLSchemaRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ReadTs", wireType)
-			}
-			m.ReadTs = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowPb
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.ReadTs |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-		case 2:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field SinceTs", wireType)
-			}
-			m.SinceTs = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowPb
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.SinceTs |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-		case 3:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field GroupId", wireType)
-			}
-			m.GroupId = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowPb
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.GroupId |= uint32(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-		case 4:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field UnixTs", wireType)
-			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowPb
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthPb
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthPb
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.UnixTs = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 5:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Destination", wireType)
-			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowPb
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthPb
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthPb
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.Destination = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 6:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field AccessKey", wireType)
-			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowPb
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthPb
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthPb
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.AccessKey = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 7:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field SecretKey", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field StartTs", wireType)
 			}
-			var stringLen uint64
+			m.StartTs = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowPb
@@ -24763,27 +28403,14 @@ func (m *BackupRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				m.StartTs |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
-				}
-			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthPb
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthPb
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
+				}
 			}
-			m.SecretKey = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 8:
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field SessionToken", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field GraphqlSchema", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -24811,33 +28438,13 @@ func (m *BackupRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.SessionToken = string(dAtA[iNdEx:postIndex])
+			m.GraphqlSchema = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 9:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Anonymous", wireType)
-			}
-			var v int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowPb
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				v |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			m.Anonymous = bool(v != 0)
-		case 10:
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Predicates", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field DgraphPreds", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowPb
@@ -24847,29 +28454,31 @@ func (m *BackupRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthPb
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthPb
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Predicates = append(m.Predicates, string(dAtA[iNdEx:postIndex]))
+			m.DgraphPreds = append(m.DgraphPreds, &SchemaUpdate{})
+			if err := m.DgraphPreds[len(m.DgraphPreds)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
-		case 11:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ForceFull", wireType)
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DgraphTypes", wireType)
 			}
-			var v int
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowPb
@@ -24879,12 +28488,26 @@ func (m *BackupRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				v |= int(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			m.ForceFull = bool(v != 0)
+			if msglen < 0 {
+				return ErrInvalidLengthPb
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthPb
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.DgraphTypes = append(m.DgraphTypes, &TypeUpdate{})
+			if err := m.DgraphTypes[len(m.DgraphTypes)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipPb(dAtA[iNdEx:])
@@ -24906,7 +28529,7 @@ func (m *BackupRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *BackupResponse) Unmarshal(dAtA []byte) error {
+func (m *UpdateGraphQLSchemaResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -24929,17 +28552,17 @@ func (m *BackupResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: BackupResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: UpdateGraphQLSchemaResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: BackupResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: UpdateGraphQLSchemaResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field DropOperations", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Uid", wireType)
 			}
-			var msglen int
+			m.Uid = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowPb
@@ -24949,26 +28572,11 @@ func (m *BackupResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				m.Uid |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
-				return ErrInvalidLengthPb
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthPb
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.DropOperations = append(m.DropOperations, &DropOperation{})
-			if err := m.DropOperations[len(m.DropOperations)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipPb(dAtA[iNdEx:])
@@ -24990,7 +28598,7 @@ func (m *BackupResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *DropOperation) Unmarshal(dAtA []byte) error {
+func (m *BulkMeta) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -25013,17 +28621,17 @@ func (m *DropOperation) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: DropOperation: wiretype end group for non-group")
+			return fmt.Errorf("proto: BulkMeta: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: DropOperation: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: BulkMeta: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field DropOp", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field EdgeCount", wireType)
 			}
-			m.DropOp = 0
+			m.EdgeCount = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowPb
@@ -25033,16 +28641,16 @@ func (m *DropOperation) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.DropOp |= DropOperation_DropOp(b&0x7F) << shift
+				m.EdgeCount |= int64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field DropValue", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field SchemaMap", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowPb
@@ -25052,23 +28660,154 @@ func (m *DropOperation) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthPb
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthPb
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.SchemaMap == nil {
+				m.SchemaMap = make(map[string]*SchemaUpdate)
+			}
+			var mapkey string
+			var mapvalue *SchemaUpdate
+			for iNdEx < postIndex {
+				entryPreIndex := iNdEx
+				var wire uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowPb
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					wire |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				fieldNum := int32(wire >> 3)
+				if fieldNum == 1 {
+					var stringLenmapkey uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowPb
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						stringLenmapkey |= uint64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					intStringLenmapkey := int(stringLenmapkey)
+					if intStringLenmapkey < 0 {
+						return ErrInvalidLengthPb
+					}
+					postStringIndexmapkey := iNdEx + intStringLenmapkey
+					if postStringIndexmapkey < 0 {
+						return ErrInvalidLengthPb
+					}
+					if postStringIndexmapkey > l {
+						return io.ErrUnexpectedEOF
+					}
+					mapkey = string(dAtA[iNdEx:postStringIndexmapkey])
+					iNdEx = postStringIndexmapkey
+				} else if fieldNum == 2 {
+					var mapmsglen int
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowPb
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						mapmsglen |= int(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					if mapmsglen < 0 {
+						return ErrInvalidLengthPb
+					}
+					postmsgIndex := iNdEx + mapmsglen
+					if postmsgIndex < 0 {
+						return ErrInvalidLengthPb
+					}
+					if postmsgIndex > l {
+						return io.ErrUnexpectedEOF
+					}
+					mapvalue = &SchemaUpdate{}
+					if err := mapvalue.Unmarshal(dAtA[iNdEx:postmsgIndex]); err != nil {
+						return err
+					}
+					iNdEx = postmsgIndex
+				} else {
+					iNdEx = entryPreIndex
+					skippy, err := skipPb(dAtA[iNdEx:])
+					if err != nil {
+						return err
+					}
+					if (skippy < 0) || (iNdEx+skippy) < 0 {
+						return ErrInvalidLengthPb
+					}
+					if (iNdEx + skippy) > postIndex {
+						return io.ErrUnexpectedEOF
+					}
+					iNdEx += skippy
+				}
+			}
+			m.SchemaMap[mapkey] = mapvalue
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Types", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPb
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthPb
+			}
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthPb
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.DropValue = string(dAtA[iNdEx:postIndex])
+			m.Types = append(m.Types, &TypeUpdate{})
+			if err := m.Types[len(m.Types)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -25091,7 +28830,7 @@ func (m *DropOperation) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *ExportRequest) Unmarshal(dAtA []byte) error {
+func (m *DeleteNsRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -25114,10 +28853,10 @@ func (m *ExportRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: ExportRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: DeleteNsRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: ExportRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: DeleteNsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -25141,47 +28880,9 @@ func (m *ExportRequest) Unmarshal(dAtA []byte) error {
 			}
 		case 2:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ReadTs", wireType)
-			}
-			m.ReadTs = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowPb
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.ReadTs |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-		case 3:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field UnixTs", wireType)
-			}
-			m.UnixTs = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowPb
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.UnixTs |= int64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-		case 4:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Format", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Namespace", wireType)
 			}
-			var stringLen uint64
+			m.Namespace = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowPb
@@ -25191,61 +28892,66 @@ func (m *ExportRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				m.Namespace |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthPb
+		default:
+			iNdEx = preIndex
+			skippy, err := skipPb(dAtA[iNdEx:])
+			if err != nil {
+				return err
 			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
 				return ErrInvalidLengthPb
 			}
-			if postIndex > l {
+			if (iNdEx + skippy) > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Format = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 5:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Destination", wireType)
-			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowPb
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthPb
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthPb
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *TaskStatusRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowPb
 			}
-			if postIndex > l {
+			if iNdEx >= l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Destination = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 6:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field AccessKey", wireType)
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
 			}
-			var stringLen uint64
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: TaskStatusRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: TaskStatusRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TaskId", wireType)
+			}
+			m.TaskId = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowPb
@@ -25255,29 +28961,16 @@ func (m *ExportRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				m.TaskId |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthPb
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthPb
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.AccessKey = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 7:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field SecretKey", wireType)
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Cancel", wireType)
 			}
-			var stringLen uint64
+			var v int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowPb
@@ -25287,61 +28980,67 @@ func (m *ExportRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				v |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthPb
+			m.Cancel = bool(v != 0)
+		default:
+			iNdEx = preIndex
+			skippy, err := skipPb(dAtA[iNdEx:])
+			if err != nil {
+				return err
 			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
 				return ErrInvalidLengthPb
 			}
-			if postIndex > l {
+			if (iNdEx + skippy) > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.SecretKey = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 8:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field SessionToken", wireType)
-			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowPb
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthPb
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthPb
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *TaskStatusResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowPb
 			}
-			if postIndex > l {
+			if iNdEx >= l {
 				return io.ErrUnexpectedEOF
 			}
-			m.SessionToken = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 9:
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: TaskStatusResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: TaskStatusResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Anonymous", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field TaskMeta", wireType)
 			}
-			var v int
+			m.TaskMeta = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowPb
@@ -25351,17 +29050,16 @@ func (m *ExportRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				v |= int(b&0x7F) << shift
+				m.TaskMeta |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			m.Anonymous = bool(v != 0)
-		case 10:
+		case 2:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Namespace", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Progress", wireType)
 			}
-			m.Namespace = 0
+			m.Progress = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowPb
@@ -25371,7 +29069,7 @@ func (m *ExportRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.Namespace |= uint64(b&0x7F) << shift
+				m.Progress |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
@@ -25397,7 +29095,7 @@ func (m *ExportRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *ExportResponse) Unmarshal(dAtA []byte) error {
+func (m *SynonymUpdate) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -25420,17 +29118,17 @@ func (m *ExportResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: ExportResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: SynonymUpdate: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: ExportResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: SynonymUpdate: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Code", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
 			}
-			m.Code = 0
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowPb
@@ -25440,14 +29138,27 @@ func (m *ExportResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.Code |= int32(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthPb
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthPb
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Name = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Msg", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Words", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -25475,13 +29186,13 @@ func (m *ExportResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Msg = string(dAtA[iNdEx:postIndex])
+			m.Words = append(m.Words, string(dAtA[iNdEx:postIndex]))
 			iNdEx = postIndex
 		case 3:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Files", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Version", wireType)
 			}
-			var stringLen uint64
+			m.Version = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowPb
@@ -25491,24 +29202,11 @@ func (m *ExportResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				m.Version |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthPb
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthPb
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.Files = append(m.Files, string(dAtA[iNdEx:postIndex]))
-			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipPb(dAtA[iNdEx:])
@@ -25530,7 +29228,7 @@ func (m *ExportResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *BackupKey) Unmarshal(dAtA []byte) error {
+func (m *SchemaVersion) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -25553,17 +29251,17 @@ func (m *BackupKey) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: BackupKey: wiretype end group for non-group")
+			return fmt.Errorf("proto: SchemaVersion: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: BackupKey: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: SchemaVersion: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Type", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Namespace", wireType)
 			}
-			m.Type = 0
+			m.Namespace = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowPb
@@ -25573,16 +29271,16 @@ func (m *BackupKey) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.Type |= BackupKey_KeyType(b&0x7F) << shift
+				m.Namespace |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
 		case 2:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Attr", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Version", wireType)
 			}
-			var stringLen uint64
+			m.Version = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowPb
@@ -25592,29 +29290,16 @@ func (m *BackupKey) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				m.Version |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthPb
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthPb
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.Attr = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
 		case 3:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Uid", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Graphql", wireType)
 			}
-			m.Uid = 0
+			var v int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowPb
@@ -25624,33 +29309,15 @@ func (m *BackupKey) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.Uid |= uint64(b&0x7F) << shift
+				v |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
+			m.Graphql = bool(v != 0)
 		case 4:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field StartUid", wireType)
-			}
-			m.StartUid = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowPb
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.StartUid |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-		case 5:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Term", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Schema", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -25678,32 +29345,13 @@ func (m *BackupKey) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Term = string(dAtA[iNdEx:postIndex])
+			m.Schema = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 6:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Count", wireType)
-			}
-			m.Count = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowPb
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.Count |= uint32(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-		case 7:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Namespace", wireType)
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Author", wireType)
 			}
-			m.Namespace = 0
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowPb
@@ -25713,142 +29361,29 @@ func (m *BackupKey) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.Namespace |= uint64(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		default:
-			iNdEx = preIndex
-			skippy, err := skipPb(dAtA[iNdEx:])
-			if err != nil {
-				return err
-			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthPb
 			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
-			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *BackupPostingList) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowPb
-			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
-			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
-			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: BackupPostingList: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: BackupPostingList: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
-			if wireType == 0 {
-				var v uint64
-				for shift := uint(0); ; shift += 7 {
-					if shift >= 64 {
-						return ErrIntOverflowPb
-					}
-					if iNdEx >= l {
-						return io.ErrUnexpectedEOF
-					}
-					b := dAtA[iNdEx]
-					iNdEx++
-					v |= uint64(b&0x7F) << shift
-					if b < 0x80 {
-						break
-					}
-				}
-				m.Uids = append(m.Uids, v)
-			} else if wireType == 2 {
-				var packedLen int
-				for shift := uint(0); ; shift += 7 {
-					if shift >= 64 {
-						return ErrIntOverflowPb
-					}
-					if iNdEx >= l {
-						return io.ErrUnexpectedEOF
-					}
-					b := dAtA[iNdEx]
-					iNdEx++
-					packedLen |= int(b&0x7F) << shift
-					if b < 0x80 {
-						break
-					}
-				}
-				if packedLen < 0 {
-					return ErrInvalidLengthPb
-				}
-				postIndex := iNdEx + packedLen
-				if postIndex < 0 {
-					return ErrInvalidLengthPb
-				}
-				if postIndex > l {
-					return io.ErrUnexpectedEOF
-				}
-				var elementCount int
-				var count int
-				for _, integer := range dAtA[iNdEx:postIndex] {
-					if integer < 128 {
-						count++
-					}
-				}
-				elementCount = count
-				if elementCount != 0 && len(m.Uids) == 0 {
-					m.Uids = make([]uint64, 0, elementCount)
-				}
-				for iNdEx < postIndex {
-					var v uint64
-					for shift := uint(0); ; shift += 7 {
-						if shift >= 64 {
-							return ErrIntOverflowPb
-						}
-						if iNdEx >= l {
-							return io.ErrUnexpectedEOF
-						}
-						b := dAtA[iNdEx]
-						iNdEx++
-						v |= uint64(b&0x7F) << shift
-						if b < 0x80 {
-							break
-						}
-					}
-					m.Uids = append(m.Uids, v)
-				}
-			} else {
-				return fmt.Errorf("proto: wrong wireType = %d for field Uids", wireType)
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthPb
 			}
-		case 2:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Postings", wireType)
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
 			}
-			var msglen int
+			m.Author = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CreatedAt", wireType)
+			}
+			m.CreatedAt = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowPb
@@ -25858,31 +29393,66 @@ func (m *BackupPostingList) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				m.CreatedAt |= int64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
-				return ErrInvalidLengthPb
+		default:
+			iNdEx = preIndex
+			skippy, err := skipPb(dAtA[iNdEx:])
+			if err != nil {
+				return err
 			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
 				return ErrInvalidLengthPb
 			}
-			if postIndex > l {
+			if (iNdEx + skippy) > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Postings = append(m.Postings, &Posting{})
-			if err := m.Postings[len(m.Postings)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *Tombstone) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowPb
 			}
-			iNdEx = postIndex
-		case 3:
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Tombstone: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Tombstone: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field CommitTs", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Namespace", wireType)
 			}
-			m.CommitTs = 0
+			m.Namespace = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowPb
@@ -25892,92 +29462,73 @@ func (m *BackupPostingList) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.CommitTs |= uint64(b&0x7F) << shift
+				m.Namespace |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 4:
-			if wireType == 0 {
-				var v uint64
-				for shift := uint(0); ; shift += 7 {
-					if shift >= 64 {
-						return ErrIntOverflowPb
-					}
-					if iNdEx >= l {
-						return io.ErrUnexpectedEOF
-					}
-					b := dAtA[iNdEx]
-					iNdEx++
-					v |= uint64(b&0x7F) << shift
-					if b < 0x80 {
-						break
-					}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Uid", wireType)
+			}
+			m.Uid = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPb
 				}
-				m.Splits = append(m.Splits, v)
-			} else if wireType == 2 {
-				var packedLen int
-				for shift := uint(0); ; shift += 7 {
-					if shift >= 64 {
-						return ErrIntOverflowPb
-					}
-					if iNdEx >= l {
-						return io.ErrUnexpectedEOF
-					}
-					b := dAtA[iNdEx]
-					iNdEx++
-					packedLen |= int(b&0x7F) << shift
-					if b < 0x80 {
-						break
-					}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
 				}
-				if packedLen < 0 {
-					return ErrInvalidLengthPb
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Uid |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
 				}
-				postIndex := iNdEx + packedLen
-				if postIndex < 0 {
-					return ErrInvalidLengthPb
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DeletedAt", wireType)
+			}
+			m.DeletedAt = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPb
 				}
-				if postIndex > l {
+				if iNdEx >= l {
 					return io.ErrUnexpectedEOF
 				}
-				var elementCount int
-				var count int
-				for _, integer := range dAtA[iNdEx:postIndex] {
-					if integer < 128 {
-						count++
-					}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.DeletedAt |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
 				}
-				elementCount = count
-				if elementCount != 0 && len(m.Splits) == 0 {
-					m.Splits = make([]uint64, 0, elementCount)
+			}
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CreatedAt", wireType)
+			}
+			m.CreatedAt = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPb
 				}
-				for iNdEx < postIndex {
-					var v uint64
-					for shift := uint(0); ; shift += 7 {
-						if shift >= 64 {
-							return ErrIntOverflowPb
-						}
-						if iNdEx >= l {
-							return io.ErrUnexpectedEOF
-						}
-						b := dAtA[iNdEx]
-						iNdEx++
-						v |= uint64(b&0x7F) << shift
-						if b < 0x80 {
-							break
-						}
-					}
-					m.Splits = append(m.Splits, v)
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.CreatedAt |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
 				}
-			} else {
-				return fmt.Errorf("proto: wrong wireType = %d for field Splits", wireType)
 			}
 		case 5:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field UidBytes", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Predicates", wireType)
 			}
-			var byteLen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowPb
@@ -25987,26 +29538,63 @@ func (m *BackupPostingList) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				byteLen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if byteLen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthPb
 			}
-			postIndex := iNdEx + byteLen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthPb
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.UidBytes = append(m.UidBytes[:0], dAtA[iNdEx:postIndex]...)
-			if m.UidBytes == nil {
-				m.UidBytes = []byte{}
-			}
+			m.Predicates = append(m.Predicates, string(dAtA[iNdEx:postIndex]))
 			iNdEx = postIndex
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Remove", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPb
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Remove = bool(v != 0)
+		case 7:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field StartTs", wireType)
+			}
+			m.StartTs = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPb
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.StartTs |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipPb(dAtA[iNdEx:])
@@ -26028,7 +29616,7 @@ func (m *BackupPostingList) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *UpdateGraphQLSchemaRequest) Unmarshal(dAtA []byte) error {
+func (m *Trigger) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -26051,17 +29639,17 @@ func (m *UpdateGraphQLSchemaRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: UpdateGraphQLSchemaRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: Trigger: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: UpdateGraphQLSchemaRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: Trigger: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field StartTs", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
 			}
-			m.StartTs = 0
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowPb
@@ -26071,14 +29659,27 @@ func (m *UpdateGraphQLSchemaRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.StartTs |= uint64(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthPb
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthPb
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Name = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field GraphqlSchema", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Predicate", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -26106,13 +29707,13 @@ func (m *UpdateGraphQLSchemaRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.GraphqlSchema = string(dAtA[iNdEx:postIndex])
+			m.Predicate = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field DgraphPreds", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field TypeName", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowPb
@@ -26122,31 +29723,29 @@ func (m *UpdateGraphQLSchemaRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthPb
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthPb
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.DgraphPreds = append(m.DgraphPreds, &SchemaUpdate{})
-			if err := m.DgraphPreds[len(m.DgraphPreds)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.TypeName = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field DgraphTypes", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Url", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowPb
@@ -26156,81 +29755,49 @@ func (m *UpdateGraphQLSchemaRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthPb
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthPb
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.DgraphTypes = append(m.DgraphTypes, &TypeUpdate{})
-			if err := m.DgraphTypes[len(m.DgraphTypes)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.Url = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipPb(dAtA[iNdEx:])
-			if err != nil {
-				return err
-			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
-				return ErrInvalidLengthPb
-			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
-			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *UpdateGraphQLSchemaResponse) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowPb
-			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BeforeCommit", wireType)
 			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPb
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: UpdateGraphQLSchemaResponse: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: UpdateGraphQLSchemaResponse: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+			m.BeforeCommit = bool(v != 0)
+		case 6:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Uid", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Version", wireType)
 			}
-			m.Uid = 0
+			m.Version = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowPb
@@ -26240,7 +29807,7 @@ func (m *UpdateGraphQLSchemaResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.Uid |= uint64(b&0x7F) << shift
+				m.Version |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
@@ -26266,7 +29833,7 @@ func (m *UpdateGraphQLSchemaResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *BulkMeta) Unmarshal(dAtA []byte) error {
+func (m *StoredQuery) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -26289,17 +29856,17 @@ func (m *BulkMeta) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: BulkMeta: wiretype end group for non-group")
+			return fmt.Errorf("proto: StoredQuery: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: BulkMeta: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: StoredQuery: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field EdgeCount", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
 			}
-			m.EdgeCount = 0
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowPb
@@ -26309,16 +29876,29 @@ func (m *BulkMeta) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.EdgeCount |= int64(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthPb
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthPb
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Name = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field SchemaMap", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Query", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowPb
@@ -26328,126 +29908,29 @@ func (m *BulkMeta) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthPb
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthPb
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.SchemaMap == nil {
-				m.SchemaMap = make(map[string]*SchemaUpdate)
-			}
-			var mapkey string
-			var mapvalue *SchemaUpdate
-			for iNdEx < postIndex {
-				entryPreIndex := iNdEx
-				var wire uint64
-				for shift := uint(0); ; shift += 7 {
-					if shift >= 64 {
-						return ErrIntOverflowPb
-					}
-					if iNdEx >= l {
-						return io.ErrUnexpectedEOF
-					}
-					b := dAtA[iNdEx]
-					iNdEx++
-					wire |= uint64(b&0x7F) << shift
-					if b < 0x80 {
-						break
-					}
-				}
-				fieldNum := int32(wire >> 3)
-				if fieldNum == 1 {
-					var stringLenmapkey uint64
-					for shift := uint(0); ; shift += 7 {
-						if shift >= 64 {
-							return ErrIntOverflowPb
-						}
-						if iNdEx >= l {
-							return io.ErrUnexpectedEOF
-						}
-						b := dAtA[iNdEx]
-						iNdEx++
-						stringLenmapkey |= uint64(b&0x7F) << shift
-						if b < 0x80 {
-							break
-						}
-					}
-					intStringLenmapkey := int(stringLenmapkey)
-					if intStringLenmapkey < 0 {
-						return ErrInvalidLengthPb
-					}
-					postStringIndexmapkey := iNdEx + intStringLenmapkey
-					if postStringIndexmapkey < 0 {
-						return ErrInvalidLengthPb
-					}
-					if postStringIndexmapkey > l {
-						return io.ErrUnexpectedEOF
-					}
-					mapkey = string(dAtA[iNdEx:postStringIndexmapkey])
-					iNdEx = postStringIndexmapkey
-				} else if fieldNum == 2 {
-					var mapmsglen int
-					for shift := uint(0); ; shift += 7 {
-						if shift >= 64 {
-							return ErrIntOverflowPb
-						}
-						if iNdEx >= l {
-							return io.ErrUnexpectedEOF
-						}
-						b := dAtA[iNdEx]
-						iNdEx++
-						mapmsglen |= int(b&0x7F) << shift
-						if b < 0x80 {
-							break
-						}
-					}
-					if mapmsglen < 0 {
-						return ErrInvalidLengthPb
-					}
-					postmsgIndex := iNdEx + mapmsglen
-					if postmsgIndex < 0 {
-						return ErrInvalidLengthPb
-					}
-					if postmsgIndex > l {
-						return io.ErrUnexpectedEOF
-					}
-					mapvalue = &SchemaUpdate{}
-					if err := mapvalue.Unmarshal(dAtA[iNdEx:postmsgIndex]); err != nil {
-						return err
-					}
-					iNdEx = postmsgIndex
-				} else {
-					iNdEx = entryPreIndex
-					skippy, err := skipPb(dAtA[iNdEx:])
-					if err != nil {
-						return err
-					}
-					if (skippy < 0) || (iNdEx+skippy) < 0 {
-						return ErrInvalidLengthPb
-					}
-					if (iNdEx + skippy) > postIndex {
-						return io.ErrUnexpectedEOF
-					}
-					iNdEx += skippy
-				}
-			}
-			m.SchemaMap[mapkey] = mapvalue
+			m.Query = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Types", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Groups", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowPb
@@ -26457,26 +29940,43 @@ func (m *BulkMeta) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthPb
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthPb
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Types = append(m.Types, &TypeUpdate{})
-			if err := m.Types[len(m.Types)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
+			m.Groups = append(m.Groups, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Version", wireType)
+			}
+			m.Version = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPb
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Version |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipPb(dAtA[iNdEx:])
@@ -26498,7 +29998,7 @@ func (m *BulkMeta) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *DeleteNsRequest) Unmarshal(dAtA []byte) error {
+func (m *DeleteByQueryRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -26521,17 +30021,17 @@ func (m *DeleteNsRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: DeleteNsRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: DeleteByQueryRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: DeleteNsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: DeleteByQueryRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field GroupId", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Namespace", wireType)
 			}
-			m.GroupId = 0
+			m.Namespace = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowPb
@@ -26541,16 +30041,48 @@ func (m *DeleteNsRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.GroupId |= uint32(b&0x7F) << shift
+				m.Namespace |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
 		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Query", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPb
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthPb
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthPb
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Query = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Namespace", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field BatchSize", wireType)
 			}
-			m.Namespace = 0
+			m.BatchSize = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowPb
@@ -26560,7 +30092,7 @@ func (m *DeleteNsRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.Namespace |= uint64(b&0x7F) << shift
+				m.BatchSize |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
@@ -26586,7 +30118,7 @@ func (m *DeleteNsRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *TaskStatusRequest) Unmarshal(dAtA []byte) error {
+func (m *TriggerEvent) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -26609,17 +30141,115 @@ func (m *TaskStatusRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: TaskStatusRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: TriggerEvent: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: TaskStatusRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: TriggerEvent: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Trigger", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPb
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthPb
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthPb
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Trigger = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Url", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPb
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthPb
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthPb
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Url = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Payload", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPb
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthPb
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthPb
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Payload = append(m.Payload[:0], dAtA[iNdEx:postIndex]...)
+			if m.Payload == nil {
+				m.Payload = []byte{}
+			}
+			iNdEx = postIndex
+		case 4:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field TaskId", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field StartTs", wireType)
 			}
-			m.TaskId = 0
+			m.StartTs = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowPb
@@ -26629,11 +30259,69 @@ func (m *TaskStatusRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.TaskId |= uint64(b&0x7F) << shift
+				m.StartTs |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CommitTs", wireType)
+			}
+			m.CommitTs = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPb
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.CommitTs |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+			}
+			m.Id = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPb
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Id |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 7:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Remove", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPb
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
+			m.Remove = bool(v != 0)
 		default:
 			iNdEx = preIndex
 			skippy, err := skipPb(dAtA[iNdEx:])
@@ -26655,7 +30343,7 @@ func (m *TaskStatusRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *TaskStatusResponse) Unmarshal(dAtA []byte) error {
+func (m *TTLMark) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -26678,17 +30366,17 @@ func (m *TaskStatusResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: TaskStatusResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: TTLMark: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: TaskStatusResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: TTLMark: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field TaskMeta", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Ts", wireType)
 			}
-			m.TaskMeta = 0
+			m.Ts = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowPb
@@ -26698,11 +30386,50 @@ func (m *TaskStatusResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.TaskMeta |= uint64(b&0x7F) << shift
+				m.Ts |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Time", wireType)
+			}
+			m.Time = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPb
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Time |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Remove", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPb
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
+			m.Remove = bool(v != 0)
 		default:
 			iNdEx = preIndex
 			skippy, err := skipPb(dAtA[iNdEx:])
@@ -73,6 +73,21 @@ func compareValues(ag string, va, vb types.Val) (bool, error) {
 	if err != nil {
 		//Try to convert values.
 		switch {
+		case va.Tid == types.DecimalID || vb.Tid == types.DecimalID:
+			if va.Tid != types.DecimalID && !va.Tid.IsNumber() ||
+				vb.Tid != types.DecimalID && !vb.Tid.IsNumber() {
+				return false, err
+			}
+			da, err := types.ToDecimal(va)
+			if err != nil {
+				return false, err
+			}
+			db, err := types.ToDecimal(vb)
+			if err != nil {
+				return false, err
+			}
+			va = types.Val{Tid: types.DecimalID, Value: da}
+			vb = types.Val{Tid: types.DecimalID, Value: db}
 		case va.Tid == types.IntID:
 			va.Tid = types.FloatID
 			va.Value = float64(va.Value.(int64))
@@ -112,7 +127,57 @@ func compareValues(ag string, va, vb types.Val) (bool, error) {
 	return false, errors.Errorf("Invalid compare function %q", ag)
 }
 
+// applyTimeAdd adds a duration to a datetime or to another duration.
+func applyTimeAdd(a, b, c *types.Val) error {
+	switch {
+	case a.Tid == types.DateTimeID && b.Tid == types.DurationID:
+		c.Value = a.Value.(time.Time).Add(b.Value.(time.Duration))
+	case a.Tid == types.DurationID && b.Tid == types.DateTimeID:
+		c.Tid = types.DateTimeID
+		c.Value = b.Value.(time.Time).Add(a.Value.(time.Duration))
+	case a.Tid == types.DurationID && b.Tid == types.DurationID:
+		aVal, bVal := a.Value.(time.Duration), b.Value.(time.Duration)
+		if (aVal > 0 && bVal > math.MaxInt64-aVal) ||
+			(aVal < 0 && bVal < math.MinInt64-aVal) {
+			return ErrorIntOverflow
+		}
+		c.Value = aVal + bVal
+	default:
+		return errors.Errorf("Wrong types %v, %v encountered for func +", a.Tid, b.Tid)
+	}
+	return nil
+}
+
+// applyTimeSub subtracts a duration from a datetime or from another duration, or returns the
+// duration between two datetimes.
+func applyTimeSub(a, b, c *types.Val) error {
+	switch {
+	case a.Tid == types.DateTimeID && b.Tid == types.DurationID:
+		c.Value = a.Value.(time.Time).Add(-b.Value.(time.Duration))
+	case a.Tid == types.DateTimeID && b.Tid == types.DateTimeID:
+		c.Tid = types.DurationID
+		c.Value = a.Value.(time.Time).Sub(b.Value.(time.Time))
+	case a.Tid == types.DurationID && b.Tid == types.DurationID:
+		aVal, bVal := a.Value.(time.Duration), b.Value.(time.Duration)
+		if (bVal < 0 && aVal > math.MaxInt64+bVal) ||
+			(bVal > 0 && aVal < math.MinInt64+bVal) {
+			return ErrorIntOverflow
+		}
+		c.Value = aVal - bVal
+	default:
+		return errors.Errorf("Wrong types %v, %v encountered for func -", a.Tid, b.Tid)
+	}
+	return nil
+}
+
+func isTimeType(v *types.Val) bool {
+	return v.Tid == types.DateTimeID || v.Tid == types.DurationID
+}
+
 func applyAdd(a, b, c *types.Val) error {
+	if isTimeType(a) {
+		return applyTimeAdd(a, b, c)
+	}
 	vBase := getValType(a)
 	switch vBase {
 	case INT:
@@ -128,6 +193,9 @@ func applyAdd(a, b, c *types.Val) error {
 	case FLOAT:
 		c.Value = a.Value.(float64) + b.Value.(float64)
 
+	case DECIMAL:
+		c.Value = a.Value.(types.Decimal).Add(b.Value.(types.Decimal))
+
 	case DEFAULT:
 		return errors.Errorf("Wrong type %v encountered for func +", a.Tid)
 	}
@@ -135,6 +203,9 @@ func applyAdd(a, b, c *types.Val) error {
 }
 
 func applySub(a, b, c *types.Val) error {
+	if isTimeType(a) {
+		return applyTimeSub(a, b, c)
+	}
 	vBase := getValType(a)
 	switch vBase {
 	case INT:
@@ -150,6 +221,9 @@ func applySub(a, b, c *types.Val) error {
 	case FLOAT:
 		c.Value = a.Value.(float64) - b.Value.(float64)
 
+	case DECIMAL:
+		c.Value = a.Value.(types.Decimal).Sub(b.Value.(types.Decimal))
+
 	case DEFAULT:
 		return errors.Errorf("Wrong type %v encountered for func -", a.Tid)
 	}
@@ -172,6 +246,9 @@ func applyMul(a, b, c *types.Val) error {
 	case FLOAT:
 		c.Value = a.Value.(float64) * b.Value.(float64)
 
+	case DECIMAL:
+		c.Value = a.Value.(types.Decimal).Mul(b.Value.(types.Decimal))
+
 	case DEFAULT:
 		return errors.Errorf("Wrong type %v encountered for func *", a.Tid)
 	}
@@ -193,6 +270,16 @@ func applyDiv(a, b, c *types.Val) error {
 		}
 		c.Value = a.Value.(float64) / b.Value.(float64)
 
+	case DECIMAL:
+		if b.Value.(types.Decimal).Sign() == 0 {
+			return ErrorDivisionByZero
+		}
+		q, err := a.Value.(types.Decimal).Quo(b.Value.(types.Decimal))
+		if err != nil {
+			return err
+		}
+		c.Value = q
+
 	case DEFAULT:
 		return errors.Errorf("Wrong type %v encountered for func /", a.Tid)
 	}
@@ -214,6 +301,16 @@ func applyMod(a, b, c *types.Val) error {
 		}
 		c.Value = math.Mod(a.Value.(float64), b.Value.(float64))
 
+	case DECIMAL:
+		if b.Value.(types.Decimal).Sign() == 0 {
+			return ErrorDivisionByZero
+		}
+		r, err := a.Value.(types.Decimal).Rem(b.Value.(types.Decimal))
+		if err != nil {
+			return err
+		}
+		c.Value = r
+
 	case DEFAULT:
 		return errors.Errorf("Wrong type %v encountered for func %%", a.Tid)
 	}
@@ -235,6 +332,15 @@ func applyPow(a, b, c *types.Val) error {
 		}
 		c.Value = math.Pow(a.Value.(float64), b.Value.(float64))
 
+	case DECIMAL:
+		// The power of a decimal isn't exact, so it is computed with floats.
+		fa, fb := a.Value.(types.Decimal).Float64(), b.Value.(types.Decimal).Float64()
+		if fa < 0 && math.Abs(math.Ceil(fb)-fb) > 0 {
+			return ErrorFractionalPower
+		}
+		c.Value = math.Pow(fa, fb)
+		c.Tid = types.FloatID
+
 	case DEFAULT:
 		return errors.Errorf("Wrong type %v encountered for func ^", a.Tid)
 	}
@@ -261,6 +367,16 @@ func applyLog(a, b, c *types.Val) error {
 		}
 		c.Value = math.Log(a.Value.(float64)) / math.Log(b.Value.(float64))
 
+	case DECIMAL:
+		fa, fb := a.Value.(types.Decimal).Float64(), b.Value.(types.Decimal).Float64()
+		if fa < 0 || fb < 0 {
+			return ErrorNegativeLog
+		} else if fb == 1 {
+			return ErrorDivisionByZero
+		}
+		c.Value = math.Log(fa) / math.Log(fb)
+		c.Tid = types.FloatID
+
 	case DEFAULT:
 		return errors.Errorf("Wrong type %v encountered for func log", a.Tid)
 	}
@@ -309,6 +425,13 @@ func applyLn(a, res *types.Val) error {
 		}
 		res.Value = math.Log(a.Value.(float64))
 
+	case DECIMAL:
+		if a.Value.(types.Decimal).Sign() < 0 {
+			return ErrorNegativeLog
+		}
+		res.Value = math.Log(a.Value.(types.Decimal).Float64())
+		res.Tid = types.FloatID
+
 	case DEFAULT:
 		return errors.Errorf("Wrong type %v encountered for func ln", a.Tid)
 	}
@@ -325,6 +448,10 @@ func applyExp(a, res *types.Val) error {
 	case FLOAT:
 		res.Value = math.Exp(a.Value.(float64))
 
+	case DECIMAL:
+		res.Value = math.Exp(a.Value.(types.Decimal).Float64())
+		res.Tid = types.FloatID
+
 	case DEFAULT:
 		return errors.Errorf("Wrong type %v encountered for func exp", a.Tid)
 	}
@@ -332,6 +459,13 @@ func applyExp(a, res *types.Val) error {
 }
 
 func applyNeg(a, res *types.Val) error {
+	if a.Tid == types.DurationID {
+		if a.Value.(time.Duration) == math.MinInt64 {
+			return ErrorIntOverflow
+		}
+		res.Value = -a.Value.(time.Duration)
+		return nil
+	}
 	vBase := getValType(a)
 	switch vBase {
 	case INT:
@@ -344,6 +478,9 @@ func applyNeg(a, res *types.Val) error {
 	case FLOAT:
 		res.Value = -a.Value.(float64)
 
+	case DECIMAL:
+		res.Value = a.Value.(types.Decimal).Neg()
+
 	case DEFAULT:
 		return errors.Errorf("Wrong type %v encountered for func u-", a.Tid)
 	}
@@ -366,6 +503,13 @@ func applySqrt(a, res *types.Val) error {
 		}
 		res.Value = math.Sqrt(a.Value.(float64))
 
+	case DECIMAL:
+		if a.Value.(types.Decimal).Sign() < 0 {
+			return ErrorNegativeRoot
+		}
+		res.Value = math.Sqrt(a.Value.(types.Decimal).Float64())
+		res.Tid = types.FloatID
+
 	case DEFAULT:
 		return errors.Errorf("Wrong type %v encountered for func sqrt", a.Tid)
 	}
@@ -381,6 +525,9 @@ func applyFloor(a, res *types.Val) error {
 	case FLOAT:
 		res.Value = math.Floor(a.Value.(float64))
 
+	case DECIMAL:
+		res.Value = a.Value.(types.Decimal).Floor()
+
 	case DEFAULT:
 		return errors.Errorf("Wrong type %v encountered for func floor", a.Tid)
 	}
@@ -396,6 +543,9 @@ func applyCeil(a, res *types.Val) error {
 	case FLOAT:
 		res.Value = math.Ceil(a.Value.(float64))
 
+	case DECIMAL:
+		res.Value = a.Value.(types.Decimal).Ceil()
+
 	case DEFAULT:
 		return errors.Errorf("Wrong type %v encountered for fun ceil", a.Tid)
 	}
@@ -443,6 +593,7 @@ const (
 	INT valType = iota
 	FLOAT
 	DEFAULT
+	DECIMAL
 )
 
 func getValType(v *types.Val) valType {
@@ -452,6 +603,8 @@ func getValType(v *types.Val) valType {
 		vBase = INT
 	case types.FloatID:
 		vBase = FLOAT
+	case types.DecimalID:
+		vBase = DECIMAL
 	default:
 		vBase = DEFAULT
 	}
@@ -470,6 +623,18 @@ func (ag *aggregator) matchType(v, va *types.Val) error {
 			va.Tid, ag.name)
 	}
 
+	// Ints and floats are converted to decimals, to keep the decimal exact.
+	if vBase == DECIMAL || vaBase == DECIMAL {
+		for _, val := range []*types.Val{v, va} {
+			d, err := types.ToDecimal(*val)
+			if err != nil {
+				return err
+			}
+			*val = types.Val{Tid: types.DecimalID, Value: d}
+		}
+		return nil
+	}
+
 	// One of them is int and one is float
 	if vBase == INT {
 		v.Tid = types.FloatID
@@ -557,6 +722,10 @@ func (ag *aggregator) Apply(val types.Val) {
 			va.Value = va.Value.(int64) + vb.Value.(int64)
 		case va.Tid == types.FloatID && vb.Tid == types.FloatID:
 			va.Value = va.Value.(float64) + vb.Value.(float64)
+		case va.Tid == types.DecimalID && vb.Tid == types.DecimalID:
+			va.Value = va.Value.(types.Decimal).Add(vb.Value.(types.Decimal))
+		case va.Tid == types.DurationID && vb.Tid == types.DurationID:
+			va.Value = va.Value.(time.Duration) + vb.Value.(time.Duration)
 		}
 		// Skipping the else case since that means the pair cannot be summed.
 		res = va
@@ -587,6 +756,18 @@ func (ag *aggregator) divideByCount() {
 	if ag.name != "avg" || ag.count == 0 || ag.result.Value == nil {
 		return
 	}
+	if ag.result.Tid == types.DecimalID {
+		// The average of decimals is a decimal, the division is exact up to its scale.
+		avg, err := ag.result.Value.(types.Decimal).Quo(types.DecimalFromInt(int64(ag.count)))
+		if err == nil {
+			ag.result.Value = avg
+		}
+		return
+	}
+	if ag.result.Tid == types.DurationID {
+		ag.result.Value = ag.result.Value.(time.Duration) / time.Duration(ag.count)
+		return
+	}
 	var v float64
 	switch ag.result.Tid {
 	case types.IntID:
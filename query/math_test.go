@@ -19,6 +19,7 @@ package query
 import (
 	"math"
 	"testing"
+	"time"
 
 	"github.com/dgraph-io/dgraph/types"
 	"github.com/stretchr/testify/require"
@@ -378,6 +379,47 @@ func TestProcessBinary(t *testing.T) {
 	}
 }
 
+func TestProcessBinaryTime(t *testing.T) {
+	start := time.Date(2022, 1, 1, 9, 0, 0, 0, time.UTC)
+	end := time.Date(2022, 1, 1, 17, 30, 0, 0, time.UTC)
+	dateTime := func(t time.Time) *mathTree {
+		return &mathTree{Const: types.Val{Tid: types.DateTimeID, Value: t}}
+	}
+	duration := func(d time.Duration) *mathTree {
+		return &mathTree{Const: types.Val{Tid: types.DurationID, Value: d}}
+	}
+
+	tests := []struct {
+		in  *mathTree
+		out types.Val
+	}{
+		{in: &mathTree{Fn: "+", Child: []*mathTree{dateTime(start), duration(510 * time.Minute)}},
+			out: types.Val{Tid: types.DateTimeID, Value: end}},
+		{in: &mathTree{Fn: "+", Child: []*mathTree{duration(510 * time.Minute), dateTime(start)}},
+			out: types.Val{Tid: types.DateTimeID, Value: end}},
+		{in: &mathTree{Fn: "-", Child: []*mathTree{dateTime(end), duration(510 * time.Minute)}},
+			out: types.Val{Tid: types.DateTimeID, Value: start}},
+		{in: &mathTree{Fn: "-", Child: []*mathTree{dateTime(end), dateTime(start)}},
+			out: types.Val{Tid: types.DurationID, Value: 510 * time.Minute}},
+		{in: &mathTree{Fn: "+", Child: []*mathTree{duration(time.Hour), duration(time.Minute)}},
+			out: types.Val{Tid: types.DurationID, Value: 61 * time.Minute}},
+	}
+	for _, tc := range tests {
+		require.NoError(t, processBinary(tc.in))
+		require.EqualValues(t, tc.out, tc.in.Const)
+	}
+
+	errorTests := []*mathTree{
+		{Fn: "+", Child: []*mathTree{dateTime(start), dateTime(end)}},
+		{Fn: "*", Child: []*mathTree{duration(time.Hour), duration(time.Hour)}},
+		{Fn: "+", Child: []*mathTree{dateTime(start),
+			{Const: types.Val{Tid: types.IntID, Value: int64(1)}}}},
+	}
+	for _, tc := range errorTests {
+		require.Error(t, processBinary(tc))
+	}
+}
+
 func TestProcessUnary(t *testing.T) {
 	tests := []struct {
 		in  *mathTree
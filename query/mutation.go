@@ -18,6 +18,7 @@ package query
 
 import (
 	"context"
+	"sort"
 	"strings"
 	"time"
 
@@ -36,6 +37,14 @@ import (
 // ApplyMutations performs the required edge expansions and forwards the results to the
 // worker to perform the mutations.
 func ApplyMutations(ctx context.Context, m *pb.Mutations) (*api.TxnContext, error) {
+	var deleted map[uint64]uint64
+	if x.WorkerConfig.SoftDeleteRetention > 0 {
+		var err error
+		if deleted, err = deletedNodes(ctx, m); err != nil {
+			return nil, err
+		}
+	}
+
 	// In expandEdges, for non * type prredicates, we prepend the namespace directly and for
 	// * type predicates, we fetch the predicates and prepend the namespace.
 	edges, err := expandEdges(ctx, m)
@@ -48,6 +57,10 @@ func ApplyMutations(ctx context.Context, m *pb.Mutations) (*api.TxnContext, erro
 	if err != nil {
 		return nil, err
 	}
+	if len(deleted) > 0 {
+		// The tombstones are written by the transaction, so they are only kept if it commits.
+		m.Tombstones = tombstones(deleted, m.Edges)
+	}
 	tctx, err := worker.MutateOverNetwork(ctx, m)
 	if err != nil {
 		if span := otrace.FromContext(ctx); span != nil {
@@ -57,6 +70,56 @@ func ApplyMutations(ctx context.Context, m *pb.Mutations) (*api.TxnContext, erro
 	return tctx, err
 }
 
+// deletedNodes returns the namespace of each node whose predicates are all deleted by the
+// mutation, with `delete { <uid> * * . }`.
+func deletedNodes(ctx context.Context, m *pb.Mutations) (map[uint64]uint64, error) {
+	namespace, err := x.ExtractNamespace(ctx)
+	if err != nil {
+		return nil, errors.Wrapf(err, "While finding the deleted nodes")
+	}
+	isGalaxyQuery := x.IsGalaxyOperation(ctx)
+
+	deleted := make(map[uint64]uint64)
+	for _, edge := range m.Edges {
+		if edge.Op != pb.DirectedEdge_DEL || edge.Attr != x.Star {
+			continue
+		}
+		if isGalaxyQuery {
+			deleted[edge.Entity] = edge.GetNamespace()
+		} else {
+			deleted[edge.Entity] = namespace
+		}
+	}
+	return deleted, nil
+}
+
+// tombstones returns the tombstones of the deleted nodes, with the predicates deleted by the
+// expanded edges of the mutation.
+func tombstones(deleted map[uint64]uint64, edges []*pb.DirectedEdge) []*pb.Tombstone {
+	preds := make(map[uint64]map[string]struct{}, len(deleted))
+	for _, edge := range edges {
+		if _, ok := deleted[edge.Entity]; !ok || edge.Op != pb.DirectedEdge_DEL {
+			continue
+		}
+		if preds[edge.Entity] == nil {
+			preds[edge.Entity] = make(map[string]struct{})
+		}
+		preds[edge.Entity][x.ParseAttr(edge.Attr)] = struct{}{}
+	}
+
+	now := time.Now().Unix()
+	out := make([]*pb.Tombstone, 0, len(deleted))
+	for uid, ns := range deleted {
+		t := &pb.Tombstone{Namespace: ns, Uid: uid, CreatedAt: now}
+		for pred := range preds[uid] {
+			t.Predicates = append(t.Predicates, pred)
+		}
+		sort.Strings(t.Predicates)
+		out = append(out, t)
+	}
+	return out
+}
+
 func expandEdges(ctx context.Context, m *pb.Mutations) ([]*pb.DirectedEdge, error) {
 	edges := make([]*pb.DirectedEdge, 0, 2*len(m.Edges))
 	namespace, err := x.ExtractNamespace(ctx)
@@ -23,6 +23,7 @@ import (
 	"encoding/json"
 	"fmt"
 	"math"
+	"sort"
 	"strconv"
 	"strings"
 	"sync"
@@ -104,6 +105,9 @@ type encoder struct {
 
 	// buf is the buffer which stores the JSON encoded response
 	buf *bytes.Buffer
+
+	// geoWKT is set if the geo values should be encoded as WKT strings instead of GeoJSON.
+	geoWKT bool
 }
 
 type node struct {
@@ -482,7 +486,13 @@ func (enc *encoder) AddValue(fj fastJsonNode, attr uint16, v types.Val) error {
 }
 
 func (enc *encoder) AddListValue(fj fastJsonNode, attr uint16, v types.Val, list bool) error {
-	bs, err := valToBytes(v)
+	var bs []byte
+	var err error
+	if enc.geoWKT && v.Tid == types.GeoID {
+		bs, err = geoToWKTBytes(v)
+	} else {
+		bs, err = valToBytes(v)
+	}
 	if err != nil {
 		return nil // Ignore this.
 	}
@@ -684,11 +694,29 @@ func valToBytes(v types.Val) ([]byte, error) {
 		return []byte(fmt.Sprintf("\"%#x\"", v.Value)), nil
 	case types.PasswordID:
 		return []byte(fmt.Sprintf("%q", v.Value.(string))), nil
+	case types.DecimalID:
+		// Decimals are encoded as strings, so that clients don't parse them as floats.
+		return stringJsonMarshal(v.Value.(types.Decimal).String()), nil
+	case types.DurationID:
+		return stringJsonMarshal(types.FormatDuration(v.Value.(time.Duration))), nil
+	case types.IntervalID:
+		return stringJsonMarshal(v.Value.(types.Interval).String()), nil
+	case types.JSONID:
+		// The documents are stored as valid JSON, so they are embedded as they are.
+		return []byte(v.Value.(string)), nil
 	default:
 		return nil, errors.New("Unsupported types.Val.Tid")
 	}
 }
 
+func geoToWKTBytes(v types.Val) ([]byte, error) {
+	wkt, err := types.MarshalWKT(v.Value.(geom.T))
+	if err != nil {
+		return nil, err
+	}
+	return stringJsonMarshal(wkt), nil
+}
+
 func (enc *encoder) writeKey(fj fastJsonNode) error {
 	if _, err := enc.buf.WriteRune('"'); err != nil {
 		return err
@@ -711,6 +739,10 @@ func (enc *encoder) attachFacets(fj fastJsonNode, fieldName string, isList bool,
 
 	idxFieldID := enc.idForAttr(strconv.Itoa(facetIdx))
 	for _, f := range fList {
+		if f.Key == x.ListIndexFacet {
+			// The positions of the @ordered lists are only used to order them.
+			continue
+		}
 		fName := facetName(fieldName, f)
 		fVal, err := facets.ValFor(f)
 		if err != nil {
@@ -1150,6 +1182,7 @@ func (sg *SubGraph) toFastJSON(ctx context.Context, l *Latency, field gqlSchema.
 	}()
 
 	enc := newEncoder()
+	enc.geoWKT = geoFormat(ctx) == GeoFormatWKT
 	defer func() {
 		// Put encoder's arena back to arena pool.
 		arenaPool.Put(enc.arena)
@@ -1236,6 +1269,8 @@ func (sg *SubGraph) fieldName() string {
 	fieldName := sg.Attr
 	if sg.Params.Alias != "" {
 		fieldName = sg.Params.Alias
+	} else if sg.SrcFunc != nil && sg.SrcFunc.Name == "jsonpath" {
+		fieldName = fmt.Sprintf("jsonpath(%s)", sg.Attr)
 	}
 	return fieldName
 }
@@ -1300,6 +1335,44 @@ func alreadySeen(parentIds []uint64, uid uint64) bool {
 	return false
 }
 
+// listPosition returns the position of a value of an @ordered list, stored in its facets.
+func listPosition(fcs []*api.Facet) int64 {
+	for _, f := range fcs {
+		if f.Key != x.ListIndexFacet {
+			continue
+		}
+		if val, err := facets.ValFor(f); err == nil {
+			if pos, ok := val.Value.(int64); ok {
+				return pos
+			}
+		}
+	}
+	return 0
+}
+
+// orderListValues sorts the values of an @ordered list, along with their facets, by their position
+// in the list.
+func orderListValues(vals []*pb.TaskValue, fcsList []*pb.Facets) ([]*pb.TaskValue, []*pb.Facets) {
+	if len(fcsList) != len(vals) {
+		return vals, fcsList
+	}
+	idx := make([]int, len(vals))
+	pos := make([]int64, len(vals))
+	for i := range vals {
+		idx[i] = i
+		pos[i] = listPosition(fcsList[i].GetFacets())
+	}
+	sort.SliceStable(idx, func(i, j int) bool { return pos[idx[i]] < pos[idx[j]] })
+
+	sortedVals := make([]*pb.TaskValue, len(vals))
+	sortedFcs := make([]*pb.Facets, len(vals))
+	for i, j := range idx {
+		sortedVals[i] = vals[j]
+		sortedFcs[i] = fcsList[j]
+	}
+	return sortedVals, sortedFcs
+}
+
 func facetName(fieldName string, f *api.Facet) string {
 	if f.Alias != "" {
 		return f.Alias
@@ -1502,12 +1575,22 @@ func (sg *SubGraph) preTraverse(enc *encoder, uid uint64, dst fastJsonNode) erro
 				continue
 			}
 
-			if len(pc.facetsMatrix) > idx && len(pc.facetsMatrix[idx].FacetsList) > 0 {
-				// In case of Value we have only one Facets.
-				for i, fcts := range pc.facetsMatrix[idx].FacetsList {
-					if err := enc.attachFacets(dst, fieldName, pc.List, fcts.Facets, i); err != nil {
-						return err
-					}
+			var vals []*pb.TaskValue
+			if len(pc.valueMatrix) > idx {
+				vals = pc.valueMatrix[idx].Values
+			}
+			var fcsList []*pb.Facets
+			if len(pc.facetsMatrix) > idx {
+				fcsList = pc.facetsMatrix[idx].FacetsList
+			}
+			if pc.Params.Ordered {
+				vals, fcsList = orderListValues(vals, fcsList)
+			}
+
+			// In case of Value we have only one Facets.
+			for i, fcts := range fcsList {
+				if err := enc.attachFacets(dst, fieldName, pc.List, fcts.Facets, i); err != nil {
+					return err
 				}
 			}
 
@@ -1515,7 +1598,7 @@ func (sg *SubGraph) preTraverse(enc *encoder, uid uint64, dst fastJsonNode) erro
 				continue
 			}
 
-			for i, tv := range pc.valueMatrix[idx].Values {
+			for i, tv := range vals {
 				// if conversion not possible, we ignore it in the result.
 				sv, convErr := convertWithBestEffort(tv, pc.Attr)
 				if convErr != nil {
@@ -1373,7 +1373,7 @@ func cantCoerceScalar(val []byte, field gqlSchema.Field) bool {
 		if _, err := strconv.ParseInt(string(val), 0, 32); err != nil {
 			return true
 		}
-	case "String", "ID", "Boolean", "Int64", "Float", "DateTime":
+	case "String", "ID", "Boolean", "Int64", "Decimal", "Float", "DateTime":
 		// do nothing, as for these types the GraphQL schema is same as the dgraph schema.
 		// Hence, the value coming in from fastJson node should already be in the correct form.
 		// So, no need to coerce it.
@@ -26,6 +26,7 @@ import (
 	"github.com/dgraph-io/dgraph/types"
 	"github.com/dgraph-io/dgraph/x"
 	"github.com/pkg/errors"
+	geom "github.com/twpayne/go-geom"
 )
 
 // rdfBuilder is used to generate RDF from subgraph.
@@ -183,6 +184,17 @@ func (b *rdfBuilder) rdfForValueList(subject uint64, valueList *pb.ValueList, at
 }
 
 func getObjectVal(v types.Val) ([]byte, error) {
+	if v.Tid == types.GeoID {
+		// Geo values are written as WKT literals, which can be loaded back.
+		wkt, err := types.MarshalWKT(v.Value.(geom.T))
+		if err != nil {
+			return nil, err
+		}
+		return []byte(strconv.Quote(wkt) + "^^<geo:wktLiteral>"), nil
+	}
+	if v.Tid == types.JSONID {
+		return []byte(strconv.Quote(v.Value.(string)) + "^^<rdf:JSON>"), nil
+	}
 	outputval, err := valToBytes(v)
 	if err != nil {
 		return nil, err
@@ -194,8 +206,10 @@ func getObjectVal(v types.Val) ([]byte, error) {
 		return quotedNumber(outputval), nil
 	case types.FloatID:
 		return quotedNumber(outputval), nil
-	case types.GeoID:
-		return nil, errors.New("Geo id is not supported in rdf output")
+	case types.DecimalID:
+		return append(outputval, "^^<xs:decimal>"...), nil
+	case types.DurationID:
+		return append(outputval, "^^<xs:duration>"...), nil
 	default:
 		return outputval, nil
 	}
@@ -226,6 +240,9 @@ func validateSubGraphForRDF(sg *SubGraph) error {
 	if sg.SrcFunc != nil && sg.SrcFunc.Name == "checkpwd" {
 		return errors.New("chkpwd function is not supported in the rdf output format")
 	}
+	if sg.SrcFunc != nil && sg.SrcFunc.Name == "jsonpath" {
+		return errors.New("jsonpath function is not supported in the rdf output format")
+	}
 	if sg.Params.Facet != nil && !sg.Params.ExpandAll {
 		return errors.New("facets are not supported in the rdf output format")
 	}
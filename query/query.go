@@ -128,6 +128,9 @@ type params struct {
 	// FacetsOrder keeps ordering for facets. Each entry stores name of the facet key and
 	// OrderDesc(will be true if results should be ordered by desc order of key) information for it.
 	FacetsOrder []*dql.FacetOrder
+	// Ordered is true if the predicate is an @ordered list, whose results are returned in the
+	// order of the list.
+	Ordered bool
 
 	// Var is the name of the variable defined in this SubGraph
 	// (e.g. in "x as name", this would be x).
@@ -225,6 +228,7 @@ type Function struct {
 	IsCount    bool      // gt(count(friends),0)
 	IsValueVar bool      // eq(val(s), 10)
 	IsLenVar   bool      // eq(len(s), 10)
+	JSONPath   string    // eq(jsonpath(data, "$.status"), "active")
 }
 
 // SubGraph is the way to represent data. It contains both the request parameters and the response.
@@ -331,6 +335,7 @@ func (sg *SubGraph) createSrcFunction(gf *dql.Function) {
 		IsCount:    gf.IsCount,
 		IsValueVar: gf.IsValueVar,
 		IsLenVar:   gf.IsLenVar,
+		JSONPath:   gf.JSONPath,
 	}
 
 	// type function is just an alias for eq(type, "dgraph.type").
@@ -340,6 +345,7 @@ func (sg *SubGraph) createSrcFunction(gf *dql.Function) {
 		sg.SrcFunc.IsCount = false
 		sg.SrcFunc.IsValueVar = false
 		sg.SrcFunc.IsLenVar = false
+		sg.SrcFunc.JSONPath = ""
 		return
 	}
 
@@ -620,7 +626,8 @@ func treeCopy(gq *dql.GraphQuery, sg *SubGraph) error {
 		}
 
 		if gchild.Func != nil &&
-			(gchild.Func.IsAggregator() || gchild.Func.IsPasswordVerifier()) {
+			(gchild.Func.IsAggregator() || gchild.Func.IsPasswordVerifier() ||
+				gchild.Func.IsJSONPath()) {
 			if len(gchild.Children) != 0 {
 				return errors.Errorf("Node with %q cant have child attr", gchild.Func.Name)
 			}
@@ -763,8 +770,31 @@ type ContextKey int
 const (
 	// DebugKey is the key used to toggle debug mode.
 	DebugKey ContextKey = iota
+	// GeoFormatKey is the key used to set the format of the geo values in the response.
+	GeoFormatKey
 )
 
+// Formats of the geo values in a JSON response.
+const (
+	GeoFormatGeoJSON = "geojson"
+	GeoFormatWKT     = "wkt"
+)
+
+// geoFormat returns the format requested for the geo values in the response.
+func geoFormat(ctx context.Context) string {
+	// gRPC client passes the format as metadata.
+	if md, ok := metadata.FromIncomingContext(ctx); ok {
+		if len(md["geo-format"]) > 0 {
+			return strings.ToLower(md["geo-format"][0])
+		}
+	}
+	// HTTP passes it as query parameter which is attached to context.
+	if f, ok := ctx.Value(GeoFormatKey).(string); ok && f != "" {
+		return f
+	}
+	return GeoFormatGeoJSON
+}
+
 func isDebug(ctx context.Context) bool {
 	var debug bool
 
@@ -912,6 +942,10 @@ func createTaskQuery(ctx context.Context, sg *SubGraph) (*pb.Query, error) {
 		return nil, errors.Wrapf(err, "While creating query task")
 	}
 	attr := sg.Attr
+	if attr == x.VersionField {
+		// The version of the nodes is read from the predicate recording it.
+		attr = x.VersionPredicate
+	}
 	// Might be safer than just checking first byte due to i18n
 	reverse := strings.HasPrefix(attr, "~")
 	if reverse {
@@ -922,12 +956,17 @@ func createTaskQuery(ctx context.Context, sg *SubGraph) (*pb.Query, error) {
 		srcFunc = &pb.SrcFunction{}
 		srcFunc.Name = sg.SrcFunc.Name
 		srcFunc.IsCount = sg.SrcFunc.IsCount
+		srcFunc.JsonPath = sg.SrcFunc.JSONPath
 		for _, arg := range sg.SrcFunc.Args {
 			srcFunc.Args = append(srcFunc.Args, arg.Value)
 			if arg.IsValueVar {
 				return nil, errors.Errorf("Unsupported use of value var")
 			}
 		}
+		if attr == "dgraph.type" && srcFunc.Name == "eq" {
+			// The nodes of the types extending the given ones are also of the given types.
+			srcFunc.Args = withSubTypes(namespace, srcFunc.Args)
+		}
 	}
 
 	// If the lang is set to *, query all the languages.
@@ -938,6 +977,12 @@ func createTaskQuery(ctx context.Context, sg *SubGraph) (*pb.Query, error) {
 	// first is to limit how many results we want.
 	first, offset := calculatePaginationParams(sg)
 
+	facetParam := sg.Params.Facet
+	if sg.Params.Ordered {
+		// The positions of the values are needed to return them in the order of the list.
+		facetParam = withListIndexFacet(facetParam)
+	}
+
 	out := &pb.Query{
 		ReadTs:       sg.ReadTs,
 		Cache:        int32(sg.Cache),
@@ -947,7 +992,7 @@ func createTaskQuery(ctx context.Context, sg *SubGraph) (*pb.Query, error) {
 		SrcFunc:      srcFunc,
 		AfterUid:     sg.Params.AfterUID,
 		DoCount:      len(sg.Filters) == 0 && sg.Params.DoCount,
-		FacetParam:   sg.Params.Facet,
+		FacetParam:   facetParam,
 		FacetsFilter: sg.facetsFilter,
 		ExpandAll:    sg.Params.ExpandAll,
 		First:        first,
@@ -987,14 +1032,16 @@ func calculatePaginationParams(sg *SubGraph) (int32, int32) {
 	shouldExclude := false
 	if sg.SrcFunc != nil {
 		switch sg.SrcFunc.Name {
-		case "regexp", "alloftext", "allofterms", "match":
+		case "regexp", "alloftext", "allofterms", "match", "nearest", "overlaps",
+			"contains_time", "during":
 			shouldExclude = true
 		default:
 			shouldExclude = false
 		}
 	}
 
-	if len(sg.Filters) == 0 && len(sg.Params.Order) == 0 && !shouldExclude {
+	if len(sg.Filters) == 0 && len(sg.Params.Order) == 0 && len(sg.Params.FacetsOrder) == 0 &&
+		!shouldExclude {
 		if sg.Params.Count != 0 {
 			return int32(sg.Params.Count), int32(sg.Params.Offset)
 		}
@@ -1136,7 +1183,8 @@ func (fromNode *varValue) transformTo(toPath []*SubGraph) (map[uint64]types.Val,
 			if !ok || curVal.Value == nil {
 				continue
 			}
-			if curVal.Tid != types.IntID && curVal.Tid != types.FloatID {
+			if curVal.Tid != types.IntID && curVal.Tid != types.FloatID &&
+				curVal.Tid != types.DecimalID {
 				return nil, errors.Errorf("Encountered non int/float type for summing")
 			}
 			for j := 0; j < len(ul.Uids); j++ {
@@ -1223,7 +1271,7 @@ func (sg *SubGraph) valueVarAggregation(doneVars map[string]varValue, path []*Su
 		switch {
 		case len(sg.MathExp.Val) != 0:
 			it := doneVars[sg.Params.Var]
-			var isInt, isFloat bool
+			var isInt, isFloat, isDecimal bool
 			for _, v := range sg.MathExp.Val {
 				if v.Tid == types.FloatID {
 					isFloat = true
@@ -1231,8 +1279,22 @@ func (sg *SubGraph) valueVarAggregation(doneVars map[string]varValue, path []*Su
 				if v.Tid == types.IntID {
 					isInt = true
 				}
+				if v.Tid == types.DecimalID {
+					isDecimal = true
+				}
 			}
-			if isInt && isFloat {
+			if isDecimal && (isInt || isFloat) {
+				for k, v := range sg.MathExp.Val {
+					if v.Tid == types.IntID || v.Tid == types.FloatID {
+						d, err := types.ToDecimal(v)
+						if err != nil {
+							return err
+						}
+						v = types.Val{Tid: types.DecimalID, Value: d}
+					}
+					sg.MathExp.Val[k] = v
+				}
+			} else if isInt && isFloat {
 				for k, v := range sg.MathExp.Val {
 					if v.Tid == types.IntID {
 						v.Tid = types.FloatID
@@ -1624,7 +1686,8 @@ func (sg *SubGraph) populateFacetVars(doneVars map[string]varValue, sgPath []*Su
 						return err
 					}
 
-					if nVal.Tid != types.IntID && nVal.Tid != types.FloatID {
+					if nVal.Tid != types.IntID && nVal.Tid != types.FloatID &&
+						nVal.Tid != types.DecimalID {
 						return errors.Errorf("Repeated id with non int/float value for " +
 							"facet var encountered.")
 					}
@@ -2141,6 +2204,12 @@ func ProcessGraph(ctx context.Context, sg, parent *SubGraph, rch chan error) {
 				sg.DestUIDs.Uids = nil
 			}
 		default:
+			if parent != nil && sg.SrcFunc == nil {
+				if err := sg.prepareListOrder(ctx); err != nil {
+					rch <- err
+					return
+				}
+			}
 			taskQuery, err := createTaskQuery(ctx, sg)
 			if err != nil {
 				rch <- err
@@ -2164,6 +2233,13 @@ func ProcessGraph(ctx context.Context, sg, parent *SubGraph, rch chan error) {
 			sg.LangTags = result.LangMatrix
 			sg.List = result.List
 
+			if sg.SrcFunc != nil && sg.SrcFunc.Name == "nearest" {
+				if err := sg.populateDistances(result); err != nil {
+					rch <- err
+					return
+				}
+			}
+
 			if sg.Params.DoCount {
 				if len(sg.Filters) == 0 {
 					// If there is a filter, we need to do more work to get the actual count.
@@ -2381,6 +2457,30 @@ func ProcessGraph(ctx context.Context, sg, parent *SubGraph, rch chan error) {
 	rch <- childErr
 }
 
+// populateDistances stores the distances returned along with the results of the nearest
+// function in UidToVal, so that they can be used through the distance value variable.
+func (sg *SubGraph) populateDistances(result *pb.Result) error {
+	distances := make(map[uint64]types.Val)
+	if len(result.UidMatrix) == 1 && len(result.ValueMatrix) == 1 {
+		values := result.ValueMatrix[0].Values
+		if len(values) != len(result.UidMatrix[0].Uids) {
+			return errors.Errorf("Got %d distances for %d uids in nearest function",
+				len(values), len(result.UidMatrix[0].Uids))
+		}
+		for i, uid := range result.UidMatrix[0].Uids {
+			val, err := convertWithBestEffort(values[i], dql.DistanceVar)
+			if err != nil {
+				return err
+			}
+			distances[uid] = val
+		}
+	}
+	// The values aren't the ones of the predicate, they shouldn't be output.
+	sg.valueMatrix = nil
+	sg.Params.UidToVal = distances
+	return nil
+}
+
 // applyPagination applies count and offset to lists inside uidMatrix.
 func (sg *SubGraph) applyPagination(ctx context.Context) error {
 	if sg.Params.Count == 0 && sg.Params.Offset == 0 { // No pagination.
@@ -2469,6 +2569,50 @@ func (sg *SubGraph) applyOrderAndPagination(ctx context.Context) error {
 	return nil
 }
 
+// prepareListOrder marks the predicate if it's an @ordered list. Unless the query orders them in
+// another way, the uids of the list are then sorted by their position like with a facet order,
+// while the values are sorted when they are added to the output.
+func (sg *SubGraph) prepareListOrder(ctx context.Context) error {
+	if sg.Params.Ordered || sg.Params.DoCount || strings.HasPrefix(sg.Attr, "~") {
+		return nil
+	}
+	ns, err := x.ExtractNamespace(ctx)
+	if err != nil {
+		return errors.Wrapf(err, "While preparing the order of the list")
+	}
+	attr := x.NamespaceAttr(ns, sg.Attr)
+	if !schema.State().IsOrdered(ctx, attr) {
+		return nil
+	}
+	sg.Params.Ordered = true
+	typ, err := schema.State().TypeOf(attr)
+	if err != nil {
+		return err
+	}
+	if typ == types.UidID && len(sg.Params.Order) == 0 && len(sg.Params.FacetsOrder) == 0 {
+		sg.Params.FacetsOrder = []*dql.FacetOrder{{Key: x.ListIndexFacet}}
+	}
+	return nil
+}
+
+// withListIndexFacet returns the facet params with the position of the values of the @ordered
+// lists added to the requested facets.
+func withListIndexFacet(fp *pb.FacetParams) *pb.FacetParams {
+	out := &pb.FacetParams{Param: []*pb.FacetParam{{Key: x.ListIndexFacet}}}
+	if fp == nil {
+		return out
+	}
+	out.AllKeys = fp.AllKeys
+	for _, p := range fp.Param {
+		if p.Key != x.ListIndexFacet {
+			out.Param = append(out.Param, p)
+		}
+	}
+	// The keys are expected to be sorted to copy the facets.
+	sort.Slice(out.Param, func(i, j int) bool { return out.Param[i].Key < out.Param[j].Key })
+	return out
+}
+
 // createOrderForTask creates namespaced aware order for the task.
 func (sg *SubGraph) createOrderForTask(ns uint64) []*pb.Order {
 	out := []*pb.Order{}
@@ -2643,10 +2787,11 @@ func isValidArg(a string) bool {
 func isValidFuncName(f string) bool {
 	switch f {
 	case "anyofterms", "allofterms", "val", "regexp", "anyoftext", "alloftext",
-		"has", "uid", "uid_in", "anyof", "allof", "type", "match":
+		"has", "uid", "uid_in", "anyof", "allof", "type", "match",
+		"sounds_like":
 		return true
 	}
-	return isInequalityFn(f) || types.IsGeoFunc(f)
+	return isInequalityFn(f) || types.IsGeoFunc(f) || types.IsIntervalFunc(f)
 }
 
 func isInequalityFn(f string) bool {
@@ -2686,23 +2831,39 @@ func getNodeTypes(ctx context.Context, sg *SubGraph) ([]string, error) {
 	return getPredsFromVals(result.ValueMatrix), nil
 }
 
-// getPredicatesFromTypes returns the list of preds contained in the given types.
+// getPredicatesFromTypes returns the list of preds contained in the given types, including the
+// ones inherited from the types they extend.
 func getPredicatesFromTypes(namespace uint64, typeNames []string) []string {
 	var preds []string
 
 	for _, typeName := range typeNames {
-		typeDef, ok := schema.State().GetType(x.NamespaceAttr(namespace, typeName))
-		if !ok {
-			continue
-		}
-
-		for _, field := range typeDef.Fields {
+		for _, field := range schema.State().TypeFields(x.NamespaceAttr(namespace, typeName)) {
 			preds = append(preds, field.Predicate)
 		}
 	}
 	return preds
 }
 
+// withSubTypes returns the given type names along with the names of the types extending them.
+func withSubTypes(namespace uint64, typeNames []string) []string {
+	out := append(typeNames[:0:0], typeNames...)
+	seen := make(map[string]struct{}, len(typeNames))
+	for _, typeName := range typeNames {
+		seen[typeName] = struct{}{}
+	}
+	for _, typeName := range typeNames {
+		for _, subType := range schema.State().SubTypes(x.NamespaceAttr(namespace, typeName)) {
+			subType = x.ParseAttr(subType)
+			if _, ok := seen[subType]; ok {
+				continue
+			}
+			seen[subType] = struct{}{}
+			out = append(out, subType)
+		}
+	}
+	return out
+}
+
 // filterUidPredicates takes a list of predicates and returns a list of the predicates
 // that are of type uid or [uid].
 func filterUidPredicates(ctx context.Context, preds []string) ([]string, error) {
@@ -2877,6 +3038,14 @@ func (req *Request) ProcessQuery(ctx context.Context) (err error) {
 			if err := sg.populateVarMap(req.Vars, sgPath); err != nil {
 				return err
 			}
+			if sg.SrcFunc != nil && sg.SrcFunc.Name == "nearest" {
+				// The distances belong to the uids of this block, like a value variable
+				// defined by one of its children.
+				req.Vars[dql.DistanceVar] = varValue{
+					Vals: sg.Params.UidToVal,
+					path: []*SubGraph{sg},
+				}
+			}
 			// first time at the root here.
 
 			// Apply pagination at the root after @cascade.
@@ -2969,6 +3138,9 @@ func filterTypesForNamespace(namespace uint64, types []*pb.TypeUpdate) []*pb.Typ
 			continue
 		}
 		update.TypeName = typeName
+		if update.Extends != "" {
+			update.Extends = x.ParseAttr(update.Extends)
+		}
 		fields := []*pb.SchemaUpdate{}
 		// Convert field name for the current namespace.
 		for _, field := range update.Fields {
@@ -23,6 +23,7 @@ import (
 	"strings"
 	"testing"
 
+	"github.com/dgraph-io/dgo/v210/protos/api"
 	"github.com/dgraph-io/dgraph/testutil"
 	"github.com/stretchr/testify/require"
 	"google.golang.org/grpc/metadata"
@@ -3113,6 +3114,69 @@ func TestTypeFilterUnknownType(t *testing.T) {
 	require.JSONEq(t, `{"data": {"me":[]}}`, js)
 }
 
+// setEmployeeType adds an Employee type extending Person, along with a node of that type, and
+// returns a function removing them.
+func setEmployeeType(t *testing.T) func() {
+	setSchema(testSchema + `
+		employer: string .
+		type Employee extends Person {
+			employer
+		}
+	`)
+	triples := `
+		<0x888> <name> "Eve" .
+		<0x888> <employer> "Dgraph" .
+		<0x888> <dgraph.type> "Employee" .
+	`
+	require.NoError(t, addTriplesToCluster(triples))
+	return func() {
+		deleteTriplesInCluster(triples)
+		require.NoError(t, client.Alter(context.Background(), &api.Operation{
+			DropOp: api.Operation_TYPE, DropValue: "Employee"}))
+		dropPredicate("employer")
+		setSchema(testSchema)
+	}
+}
+
+func TestTypeFunctionSubType(t *testing.T) {
+	defer setEmployeeType(t)()
+
+	query := `
+		{
+			me(func: type(Person)) @filter(has(employer)) {
+				uid
+			}
+		}
+	`
+	js := processQueryNoErr(t, query)
+	require.JSONEq(t, `{"data": {"me":[{"uid":"0x888"}]}}`, js)
+
+	query = `
+		{
+			me(func: uid(0x888)) @filter(type(Person)) {
+				uid
+			}
+		}
+	`
+	js = processQueryNoErr(t, query)
+	require.JSONEq(t, `{"data": {"me":[{"uid":"0x888"}]}}`, js)
+}
+
+func TestExpandAllSubType(t *testing.T) {
+	defer setEmployeeType(t)()
+
+	// The name is inherited from Person.
+	query := `
+		{
+			me(func: uid(0x888)) {
+				expand(_all_)
+			}
+		}
+	`
+	js := processQueryNoErr(t, query)
+	require.JSONEq(t, `{"data": {"me":[{"name":"Eve", "employer":"Dgraph"}]}}`, js)
+}
+
 func TestMaxPredicateSize(t *testing.T) {
 	// Create a string that has more than than 2^16 chars.
 	var b strings.Builder
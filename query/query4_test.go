@@ -23,6 +23,7 @@ import (
 	"testing"
 
 	"github.com/dgraph-io/dgo/v210/protos/api"
+	"github.com/dgraph-io/dgraph/testutil"
 	"github.com/dgraph-io/dgraph/x"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
@@ -1561,3 +1562,48 @@ func TestNumUids(t *testing.T) {
 	require.Equal(t, metrics.NumUids["name"], uint64(16))
 	require.Equal(t, metrics.NumUids["_total"], uint64(26))
 }
+
+// updateSynonyms replaces the synonym set through the admin endpoint. A set with no words is
+// removed.
+func updateSynonyms(t *testing.T, name string, words []string) {
+	token := testutil.GrootHttpLogin(testutil.AdminUrl())
+	params := &testutil.GraphQLParams{
+		Query: `mutation updateSynonyms($sets: [SynonymSetInput!]!) {
+			updateSynonyms(input: {sets: $sets}) { response { code } }
+		}`,
+		Variables: map[string]interface{}{
+			"sets": []map[string]interface{}{{"name": name, "words": words}},
+		},
+	}
+	testutil.MakeGQLRequestWithAccessJwt(t, params, token.AccessJwt).RequireNoGraphQLErrors(t)
+}
+
+func TestAnyOfTermsWithSynonyms(t *testing.T) {
+	query := `{
+		me(func: anyofterms(alias, "Ally")) {
+			uid
+		}
+	}`
+	js := processQueryNoErr(t, query)
+	require.JSONEq(t, `{"data": {"me":[]}}`, js)
+
+	updateSynonyms(t, "alice", []string{"Alice", "Ally"})
+	defer updateSynonyms(t, "alice", []string{})
+	js = processQueryNoErr(t, query)
+	require.JSONEq(t, `{"data": {"me":[{"uid":"0x17"},{"uid":"0x18"}]}}`, js)
+}
+
+func TestAnyOfTextWithSynonyms(t *testing.T) {
+	query := `{
+		me(func: anyoftext(alias, "Ally")) {
+			uid
+		}
+	}`
+	js := processQueryNoErr(t, query)
+	require.JSONEq(t, `{"data": {"me":[]}}`, js)
+
+	updateSynonyms(t, "alice", []string{"Alice", "Ally"})
+	defer updateSynonyms(t, "alice", []string{})
+	js = processQueryNoErr(t, query)
+	require.JSONEq(t, `{"data": {"me":[{"uid":"0x17"},{"uid":"0x18"}]}}`, js)
+}
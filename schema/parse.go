@@ -75,12 +75,28 @@ func parseDirective(it *lex.ItemIterator, schema *pb.SchemaUpdate, t types.TypeI
 		schema.Upsert = true
 	case "noconflict":
 		schema.NoConflict = true
+	case "ordered":
+		if !schema.List {
+			return next.Errorf("@ordered directive can only be specified for list types."+
+				" Got: [%v] for attr: [%v]", t.Name(), schema.Predicate)
+		}
+		schema.Ordered = true
 	case "lang":
 		if t != types.StringID || schema.List {
 			return next.Errorf("@lang directive can only be specified for string type."+
 				" Got: [%v] for attr: [%v]", t.Name(), schema.Predicate)
 		}
 		schema.Lang = true
+	case "ttl":
+		if t == types.PasswordID {
+			return next.Errorf("@ttl directive can't be specified for password type."+
+				" Got: [%v] for attr: [%v]", t.Name(), schema.Predicate)
+		}
+		ttl, err := parseTTLDirective(it)
+		if err != nil {
+			return err
+		}
+		schema.Ttl = ttl
 	default:
 		return next.Errorf("Invalid index specification")
 	}
@@ -216,8 +232,15 @@ func parseIndexDirective(it *lex.ItemIterator, predicate string,
 		if !expectArg {
 			return tokenizers, next.Errorf("Expected a comma but got: %v", next)
 		}
+		tokName := strings.ToLower(next.Val)
+		if peek, ok := it.PeekOne(); ok && peek.Typ == itemLeftRound {
+			var err error
+			if tokName, err = parseTokenizerArg(it, tokName); err != nil {
+				return tokenizers, err
+			}
+		}
 		// Look for custom tokenizer.
-		tokenizer, has := tok.GetTokenizer(strings.ToLower(next.Val))
+		tokenizer, has := tok.GetTokenizer(tokName)
 		if !has {
 			return tokenizers, next.Errorf("Invalid tokenizer %s", next.Val)
 		}
@@ -246,6 +269,31 @@ func parseIndexDirective(it *lex.ItemIterator, predicate string,
 	return tokenizers, nil
 }
 
+// parseTokenizerArg parses the argument of a tokenizer like jsonpath("$.status"), and returns the
+// name of the tokenizer for the argument.
+func parseTokenizerArg(it *lex.ItemIterator, name string) (string, error) {
+	it.Next() // Consume the left round bracket.
+	if name != "jsonpath" {
+		return "", it.Item().Errorf("Tokenizer %s doesn't take an argument", name)
+	}
+	if !it.Next() || it.Item().Typ != itemQuotedText {
+		return "", it.Item().Errorf("Expected a quoted path for the jsonpath tokenizer")
+	}
+	arg := it.Item()
+	if !it.Next() || it.Item().Typ != itemRightRound {
+		return "", it.Item().Errorf("Expected ) after the path of the jsonpath tokenizer")
+	}
+	path, err := strconv.Unquote(arg.Val)
+	if err != nil {
+		return "", arg.Errorf("Invalid path %s: %v", arg.Val, err)
+	}
+	tokName, err := tok.JSONPathTokenizerName(path)
+	if err != nil {
+		return "", arg.Errorf("%v", err)
+	}
+	return tokName, nil
+}
+
 // resolveTokenizers resolves default tokenizers and verifies tokenizers definitions.
 func resolveTokenizers(updates []*pb.SchemaUpdate) error {
 	for _, schema := range updates {
@@ -312,6 +360,35 @@ func parseTypeDeclaration(it *lex.ItemIterator, ns uint64) (*pb.TypeUpdate, erro
 	typeUpdate := &pb.TypeUpdate{TypeName: x.NamespaceAttr(ns, it.Item().Val)}
 
 	it.Next()
+	if it.Item().Typ == itemText && it.Item().Val == "extends" {
+		it.Next()
+		if it.Item().Typ != itemText {
+			return nil, it.Item().Errorf("Expected name of the parent type. Got %v",
+				it.Item().Val)
+		}
+		typeUpdate.Extends = x.NamespaceAttr(ns, it.Item().Val)
+		if typeUpdate.Extends == typeUpdate.TypeName {
+			return nil, it.Item().Errorf("Type %s can't extend itself", it.Item().Val)
+		}
+		it.Next()
+	}
+	for it.Item().Typ == itemAt {
+		it.Next()
+		switch {
+		case it.Item().Typ == itemText && it.Item().Val == "strict":
+			typeUpdate.Strict = true
+		case it.Item().Typ == itemText && it.Item().Val == "ttl":
+			ttl, err := parseTTLDirective(it)
+			if err != nil {
+				return nil, err
+			}
+			typeUpdate.Ttl = ttl
+		default:
+			return nil, it.Item().Errorf("Invalid directive for type %s. Got %v",
+				x.ParseAttr(typeUpdate.TypeName), it.Item().Val)
+		}
+		it.Next()
+	}
 	if it.Item().Typ != itemLeftCurl {
 		return nil, it.Item().Errorf("Expected {. Got %v", it.Item().Val)
 	}
@@ -337,6 +414,18 @@ func parseTypeDeclaration(it *lex.ItemIterator, ns uint64) (*pb.TypeUpdate, erro
 				}
 
 				fieldSet[field.GetPredicate()] = struct{}{}
+
+				if !field.NonNullable {
+					continue
+				}
+				if !typeUpdate.Strict {
+					return nil, it.Item().Errorf("@required field %s can only be declared in"+
+						" a @strict type", x.ParseAttr(field.GetPredicate()))
+				}
+				if strings.HasPrefix(x.ParseAttr(field.GetPredicate()), "~") {
+					return nil, it.Item().Errorf("Reverse field %s can't be @required",
+						x.ParseAttr(field.GetPredicate()))
+				}
 			}
 
 			typeUpdate.Fields = fields
@@ -360,6 +449,9 @@ func parseTypeField(it *lex.ItemIterator, typeName string, ns uint64) (*pb.Schem
 	field := &pb.SchemaUpdate{Predicate: x.NamespaceAttr(ns, it.Item().Val)}
 	var list bool
 	it.Next()
+	if err := parseFieldDirectives(it, field); err != nil {
+		return nil, err
+	}
 
 	// Simplified type definitions only require the field name. If a new line is found,
 	// proceed to the next field in the type.
@@ -399,6 +491,9 @@ func parseTypeField(it *lex.ItemIterator, typeName string, ns uint64) (*pb.Schem
 			it.Next()
 		}
 	}
+	if err := parseFieldDirectives(it, field); err != nil {
+		return nil, err
+	}
 
 	if it.Item().Typ != itemNewLine {
 		return nil, it.Item().Errorf("Expected new line after field declaration. Got %v",
@@ -410,6 +505,21 @@ func parseTypeField(it *lex.ItemIterator, typeName string, ns uint64) (*pb.Schem
 	return field, nil
 }
 
+// parseFieldDirectives parses the directives following a field in a type declaration. The only
+// one is @required, which makes the field required for the nodes of the type.
+func parseFieldDirectives(it *lex.ItemIterator, field *pb.SchemaUpdate) error {
+	for it.Item().Typ == itemAt {
+		it.Next()
+		if it.Item().Typ != itemText || it.Item().Val != "required" {
+			return it.Item().Errorf("Invalid directive for field %s in type declaration. Got %v",
+				x.ParseAttr(field.Predicate), it.Item().Val)
+		}
+		field.NonNullable = true
+		it.Next()
+	}
+	return nil
+}
+
 func parseNamespace(it *lex.ItemIterator) (uint64, error) {
 	nextItems, err := it.Peek(2)
 	if err != nil {
@@ -297,6 +297,34 @@ func TestParseScalarList(t *testing.T) {
 	}, result.Preds[2])
 }
 
+func TestParseOrderedList(t *testing.T) {
+	reset()
+	result, err := Parse(`
+		steps: [string] @ordered .
+		playlist: [uid] @reverse @ordered .
+	`)
+	require.NoError(t, err)
+	require.Equal(t, 2, len(result.Preds))
+	require.EqualValues(t, &pb.SchemaUpdate{
+		Predicate: x.GalaxyAttr("steps"),
+		ValueType: 9,
+		List:      true,
+		Ordered:   true,
+	}, result.Preds[0])
+
+	require.EqualValues(t, &pb.SchemaUpdate{
+		Predicate: x.GalaxyAttr("playlist"),
+		ValueType: 7,
+		Directive: pb.SchemaUpdate_REVERSE,
+		List:      true,
+		Ordered:   true,
+	}, result.Preds[1])
+
+	_, err = Parse("step: string @ordered .")
+	require.Error(t, err)
+	require.Contains(t, err.Error(), "@ordered directive can only be specified for list types")
+}
+
 func TestParseScalarListError1(t *testing.T) {
 	reset()
 	result, err := Parse(`
@@ -509,6 +537,144 @@ func TestOldTypeFormat(t *testing.T) {
 	}, result.Types[0])
 }
 
+func TestParseStrictType(t *testing.T) {
+	reset()
+	result, err := Parse(`
+		type Person @strict {
+			name @required
+			age: int @required
+			friend
+		}
+	`)
+	require.NoError(t, err)
+	require.Equal(t, 1, len(result.Types))
+	require.Equal(t, &pb.TypeUpdate{
+		TypeName: x.GalaxyAttr("Person"),
+		Strict:   true,
+		Fields: []*pb.SchemaUpdate{
+			{
+				Predicate:   x.GalaxyAttr("name"),
+				NonNullable: true,
+			},
+			{
+				Predicate:   x.GalaxyAttr("age"),
+				NonNullable: true,
+			},
+			{
+				Predicate: x.GalaxyAttr("friend"),
+			},
+		},
+	}, result.Types[0])
+
+	_, err = Parse(`
+		type Person {
+			name @required
+		}
+	`)
+	require.Error(t, err)
+	require.Contains(t, err.Error(), "can only be declared in a @strict type")
+
+	_, err = Parse(`
+		type Person @strict {
+			<~friend> @required
+		}
+	`)
+	require.Error(t, err)
+	require.Contains(t, err.Error(), "can't be @required")
+
+	_, err = Parse(`
+		type Person @loose {
+			name
+		}
+	`)
+	require.Error(t, err)
+	require.Contains(t, err.Error(), "Invalid directive for type")
+}
+
+func TestParseTypeExtends(t *testing.T) {
+	reset()
+	result, err := Parse(`
+		type Person {
+			name
+			age
+		}
+		type Employee extends Person {
+			employer
+			name
+		}
+		type Manager extends Employee @strict {
+			reports
+		}
+	`)
+	require.NoError(t, err)
+	require.Equal(t, 3, len(result.Types))
+	require.Equal(t, "", result.Types[0].Extends)
+	require.Equal(t, x.GalaxyAttr("Person"), result.Types[1].Extends)
+	require.Equal(t, x.GalaxyAttr("Employee"), result.Types[2].Extends)
+	require.True(t, result.Types[2].Strict)
+
+	for _, typ := range result.Types {
+		State().SetType(typ.TypeName, *typ)
+	}
+	var fields []string
+	for _, field := range State().TypeFields(x.GalaxyAttr("Manager")) {
+		fields = append(fields, x.ParseAttr(field.Predicate))
+	}
+	require.Equal(t, []string{"reports", "employer", "name", "age"}, fields)
+	require.Equal(t, []string{x.GalaxyAttr("Employee"), x.GalaxyAttr("Manager")},
+		State().SubTypes(x.GalaxyAttr("Person")))
+	require.Empty(t, State().SubTypes(x.GalaxyAttr("Manager")))
+
+	_, err = Parse(`
+		type Person extends Person {
+			name
+		}
+	`)
+	require.Error(t, err)
+	require.Contains(t, err.Error(), "can't extend itself")
+}
+
+func TestParseTTL(t *testing.T) {
+	reset()
+	result, err := Parse(`
+		session: string @index(exact) @ttl(30d) .
+		event: [uid] @ttl(1d12h) .
+		token: string @ttl(PT90M) .
+		type Session @strict @ttl(7d) {
+			session
+		}
+		type WebSession extends Session {
+			event
+		}
+	`)
+	require.NoError(t, err)
+	require.Equal(t, int64(30*24*3600), result.Preds[0].Ttl)
+	require.Equal(t, int64(36*3600), result.Preds[1].Ttl)
+	require.Equal(t, int64(90*60), result.Preds[2].Ttl)
+	require.True(t, result.Types[0].Strict)
+	require.Equal(t, int64(7*24*3600), result.Types[0].Ttl)
+
+	for _, typ := range result.Types {
+		State().SetType(typ.TypeName, *typ)
+	}
+	require.Equal(t, int64(7*24*3600), State().TypeTTL(x.GalaxyAttr("WebSession")))
+
+	require.Equal(t, "30d", FormatTTL(result.Preds[0].Ttl))
+	require.Equal(t, "1d12h", FormatTTL(result.Preds[1].Ttl))
+	require.Equal(t, "1h30m", FormatTTL(result.Preds[2].Ttl))
+
+	for _, s := range []string{
+		"name: string @ttl .",
+		"name: string @ttl() .",
+		"name: string @ttl(30x) .",
+		"name: string @ttl(0s) .",
+		"name: password @ttl(1d) .",
+	} {
+		_, err = Parse(s)
+		require.Error(t, err, s)
+	}
+}
+
 func TestOldAndNewTypeFormat(t *testing.T) {
 	reset()
 	result, err := Parse(`
@@ -601,6 +767,32 @@ func TestParseTypeComments(t *testing.T) {
 	require.NoError(t, err)
 }
 
+func TestParseJSONPathIndex(t *testing.T) {
+	reset()
+	result, err := Parse(`
+		data: json @index(jsonpath("$.status"), jsonpath("$['user'].tags[0]")) .
+	`)
+	require.NoError(t, err)
+	require.Equal(t, 1, len(result.Preds))
+	require.EqualValues(t, &pb.SchemaUpdate{
+		Predicate: x.GalaxyAttr("data"),
+		ValueType: pb.Posting_JSON,
+		Directive: pb.SchemaUpdate_INDEX,
+		Tokenizer: []string{`jsonpath("$.status")`, `jsonpath("$.user.tags[0]")`},
+	}, result.Preds[0])
+
+	for _, s := range []string{
+		`data: json @index(jsonpath("$.a"), jsonpath("$['a']")) .`,
+		`data: json @index(jsonpath("a.b")) .`,
+		`data: json @index(jsonpath) .`,
+		`data: json @index(exact("$.a")) .`,
+		`data: string @index(jsonpath("$.a")) .`,
+	} {
+		_, err := Parse(s)
+		require.Error(t, err, s)
+	}
+}
+
 func TestParseWithNamespace(t *testing.T) {
 	reset()
 	result, err := Parse(`
@@ -22,6 +22,7 @@ import (
 	"encoding/hex"
 	"fmt"
 	"math"
+	"sort"
 	"sync"
 
 	"github.com/golang/glog"
@@ -60,6 +61,10 @@ func GetWriteContext(ctx context.Context) context.Context {
 func (s *state) init() {
 	s.predicate = make(map[string]*pb.SchemaUpdate)
 	s.types = make(map[string]*pb.TypeUpdate)
+	s.synonyms = make(map[string]*pb.SynonymUpdate)
+	s.synonymIdx = make(map[string][]string)
+	s.triggers = make(map[string]*pb.Trigger)
+	s.storedQueries = make(map[string]*pb.StoredQuery)
 	s.elog = trace.NewEventLog("Dgraph", "Schema")
 	s.mutSchema = make(map[string]*pb.SchemaUpdate)
 }
@@ -69,7 +74,15 @@ type state struct {
 	// Map containing predicate to type information.
 	predicate map[string]*pb.SchemaUpdate
 	types     map[string]*pb.TypeUpdate
-	elog      trace.EventLog
+	// Map containing synonym set name to its words, and the reverse lookup from a
+	// normalized word to the names of the sets it belongs to.
+	synonyms   map[string]*pb.SynonymUpdate
+	synonymIdx map[string][]string
+	// Map containing trigger name to the trigger.
+	triggers map[string]*pb.Trigger
+	// Map containing stored query name to the stored query.
+	storedQueries map[string]*pb.StoredQuery
+	elog          trace.EventLog
 	// mutSchema holds the schema update that is being applied in the background.
 	mutSchema map[string]*pb.SchemaUpdate
 }
@@ -91,6 +104,11 @@ func (s *state) DeleteAll() {
 		delete(s.types, typ)
 	}
 
+	s.synonyms = make(map[string]*pb.SynonymUpdate)
+	s.synonymIdx = make(map[string][]string)
+	s.triggers = make(map[string]*pb.Trigger)
+	s.storedQueries = make(map[string]*pb.StoredQuery)
+
 	for pred := range s.mutSchema {
 		delete(s.mutSchema, pred)
 	}
@@ -175,6 +193,21 @@ func (s *state) DeletePredsForNs(delNs uint64) {
 			delete(s.types, typ)
 		}
 	}
+	for name := range s.synonyms {
+		if x.ParseNamespace(name) == delNs {
+			s.deleteSynonym(name)
+		}
+	}
+	for name := range s.triggers {
+		if x.ParseNamespace(name) == delNs {
+			delete(s.triggers, name)
+		}
+	}
+	for name := range s.storedQueries {
+		if x.ParseNamespace(name) == delNs {
+			delete(s.storedQueries, name)
+		}
+	}
 }
 
 func logUpdate(schema *pb.SchemaUpdate, pred string) string {
@@ -277,6 +310,116 @@ func (s *state) GetType(typeName string) (pb.TypeUpdate, bool) {
 	return *typ, true
 }
 
+// TypeFields returns the fields of the given type, followed by the ones it inherits from the
+// types it extends. The fields redeclared by a type are only returned once.
+func (s *state) TypeFields(typeName string) []*pb.SchemaUpdate {
+	s.RLock()
+	defer s.RUnlock()
+
+	var fields []*pb.SchemaUpdate
+	seenFields := make(map[string]struct{})
+	seenTypes := make(map[string]struct{})
+	for name := typeName; name != ""; {
+		if _, ok := seenTypes[name]; ok {
+			break
+		}
+		seenTypes[name] = struct{}{}
+
+		typ, ok := s.types[name]
+		if !ok {
+			break
+		}
+		for _, field := range typ.Fields {
+			if _, ok := seenFields[field.Predicate]; ok {
+				continue
+			}
+			seenFields[field.Predicate] = struct{}{}
+			fields = append(fields, field)
+		}
+		name = typ.Extends
+	}
+	return fields
+}
+
+// SubTypes returns the names of the types extending the given type, either directly or through
+// other types.
+func (s *state) SubTypes(typeName string) []string {
+	s.RLock()
+	defer s.RUnlock()
+
+	var out []string
+	for name := range s.types {
+		if name != typeName && s.extends(name, typeName) {
+			out = append(out, name)
+		}
+	}
+	sort.Strings(out)
+	return out
+}
+
+// extends returns whether the type extends the parent type. The caller must hold the lock.
+func (s *state) extends(typeName, parent string) bool {
+	seen := make(map[string]struct{})
+	for name := typeName; name != ""; {
+		if _, ok := seen[name]; ok {
+			return false
+		}
+		seen[name] = struct{}{}
+
+		typ, ok := s.types[name]
+		if !ok {
+			return false
+		}
+		if typ.Extends == parent {
+			return true
+		}
+		name = typ.Extends
+	}
+	return false
+}
+
+// TypeTTL returns the ttl of the given type in seconds, or the one it inherits from the closest
+// type it extends which has a ttl. It returns zero if the nodes of the type never expire.
+func (s *state) TypeTTL(typeName string) int64 {
+	s.RLock()
+	defer s.RUnlock()
+
+	seen := make(map[string]struct{})
+	for name := typeName; name != ""; {
+		if _, ok := seen[name]; ok {
+			return 0
+		}
+		seen[name] = struct{}{}
+
+		typ, ok := s.types[name]
+		if !ok {
+			return 0
+		}
+		if typ.Ttl > 0 {
+			return typ.Ttl
+		}
+		name = typ.Extends
+	}
+	return 0
+}
+
+// PredicateTTLs returns the ttl in seconds of the predicates which have one.
+func (s *state) PredicateTTLs() map[string]int64 {
+	if s == nil {
+		return nil
+	}
+
+	s.RLock()
+	defer s.RUnlock()
+	out := make(map[string]int64)
+	for pred, su := range s.predicate {
+		if su.Ttl > 0 {
+			out[pred] = su.Ttl
+		}
+	}
+	return out
+}
+
 // TypeOf returns the schema type of predicate
 func (s *state) TypeOf(pred string) (types.TypeID, error) {
 	s.RLock()
@@ -336,6 +479,23 @@ func (s *state) Types() []string {
 	return out
 }
 
+// HasStrictTypes returns whether any of the types is @strict, in which case the mutations need
+// to be checked against the types of the nodes they change.
+func (s *state) HasStrictTypes() bool {
+	if s == nil {
+		return false
+	}
+
+	s.RLock()
+	defer s.RUnlock()
+	for _, typ := range s.types {
+		if typ.Strict {
+			return true
+		}
+	}
+	return false
+}
+
 // Tokenizer returns the tokenizer for given predicate
 func (s *state) Tokenizer(ctx context.Context, pred string) []tok.Tokenizer {
 	isWrite, _ := ctx.Value(isWrite).(bool)
@@ -454,6 +614,28 @@ func (s *state) HasNoConflict(pred string) bool {
 	return s.predicate[pred].GetNoConflict()
 }
 
+// IsOrdered returns whether the list predicate keeps its values in insertion order.
+func (s *state) IsOrdered(ctx context.Context, pred string) bool {
+	isWrite, _ := ctx.Value(isWrite).(bool)
+	s.RLock()
+	defer s.RUnlock()
+	if isWrite {
+		if schema, ok := s.mutSchema[pred]; ok {
+			return schema.Ordered
+		}
+	}
+	return s.predicate[pred].GetOrdered()
+}
+
+// IsBeingIndexed returns whether the index of the predicate is being rebuilt in the background,
+// in which case the queries can't use it yet.
+func (s *state) IsBeingIndexed(pred string) bool {
+	s.RLock()
+	defer s.RUnlock()
+	schema, ok := s.mutSchema[pred]
+	return ok && len(schema.Tokenizer) > 0
+}
+
 // IndexingInProgress checks whether indexing is going on for a given predicate.
 func (s *state) IndexingInProgress() bool {
 	s.RLock()
@@ -502,7 +684,16 @@ func LoadFromDb() error {
 	if err := LoadSchemaFromDb(); err != nil {
 		return err
 	}
-	return LoadTypesFromDb()
+	if err := LoadTypesFromDb(); err != nil {
+		return err
+	}
+	if err := LoadSynonymsFromDb(); err != nil {
+		return err
+	}
+	if err := LoadTriggersFromDb(); err != nil {
+		return err
+	}
+	return LoadStoredQueriesFromDb()
 }
 
 // LoadSchemaFromDb iterates through the DB and loads all the stored schema updates.
@@ -706,6 +897,12 @@ func initialSchemaInternal(namespace uint64, all bool) []*pb.SchemaUpdate {
 		}, &pb.SchemaUpdate{
 			Predicate: "dgraph.drop.op",
 			ValueType: pb.Posting_STRING,
+		}, &pb.SchemaUpdate{
+			// Concurrent mutations of different predicates of a node don't conflict over its
+			// version. Only the mutations checking the version do.
+			Predicate:  x.VersionPredicate,
+			ValueType:  pb.Posting_INT,
+			NoConflict: true,
 		}, &pb.SchemaUpdate{
 			Predicate: "dgraph.graphql.schema",
 			ValueType: pb.Posting_STRING,
@@ -799,11 +996,13 @@ func IsPreDefTypeChanged(update *pb.TypeUpdate) bool {
 		if original.TypeName != update.TypeName {
 			continue
 		}
-		if len(original.Fields) != len(update.Fields) {
+		if original.Strict != update.Strict || original.Extends != update.Extends ||
+			original.Ttl != update.Ttl || len(original.Fields) != len(update.Fields) {
 			return true
 		}
 		for i, field := range original.Fields {
-			if field.Predicate != update.Fields[i].Predicate {
+			if field.Predicate != update.Fields[i].Predicate ||
+				field.NonNullable != update.Fields[i].NonNullable {
 				return true
 			}
 		}
@@ -36,6 +36,7 @@ const (
 	itemLeftSquare
 	itemRightSquare
 	itemExclamationMark
+	itemQuotedText // quoted string, like the path of jsonpath("$.status")
 )
 
 func lexText(l *lex.Lexer) lex.StateFn {
@@ -79,6 +80,11 @@ Loop:
 			l.Emit(itemRightSquare)
 		case r == '!':
 			l.Emit(itemExclamationMark)
+		case r == '"':
+			if err := l.LexQuotedString(); err != nil {
+				return l.Errorf("Invalid schema: %v", err)
+			}
+			l.Emit(itemQuotedText)
 		case r == '_':
 			// Predicates can start with _.
 			return lexWord
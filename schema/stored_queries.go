@@ -0,0 +1,116 @@
+/*
+ * Copyright 2022 Dgraph Labs, Inc. and Contributors
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package schema
+
+import (
+	"bytes"
+	"encoding/hex"
+	"math"
+	"sort"
+
+	"github.com/golang/glog"
+
+	"github.com/dgraph-io/badger/v3"
+	"github.com/dgraph-io/dgraph/protos/pb"
+	"github.com/dgraph-io/dgraph/x"
+)
+
+// Stored queries are kept by every alpha, keyed by the namespaced query name, so that a client
+// can run them by name against any alpha.
+
+// SetStoredQuery sets the stored query with the given namespaced name in memory. A query with
+// no text is removed. Stored query mutations must flow through the update function, which are
+// synced to the db.
+func (s *state) SetStoredQuery(name string, sq pb.StoredQuery) {
+	s.Lock()
+	defer s.Unlock()
+	if sq.Query == "" {
+		delete(s.storedQueries, name)
+		return
+	}
+	s.storedQueries[name] = &sq
+	s.elog.Printf("Setting stored query %s, version: %d\n", name, sq.Version)
+}
+
+// StoredQuery returns the stored query with the given namespaced name, if there is one.
+func (s *state) StoredQuery(name string) (*pb.StoredQuery, bool) {
+	if s == nil {
+		return nil, false
+	}
+
+	s.RLock()
+	defer s.RUnlock()
+	sq, ok := s.storedQueries[name]
+	if !ok {
+		return nil, false
+	}
+	cp := *sq
+	return &cp, true
+}
+
+// StoredQueries returns the stored queries of the given namespace, sorted by name.
+func (s *state) StoredQueries(ns uint64) []*pb.StoredQuery {
+	if s == nil {
+		return nil
+	}
+
+	s.RLock()
+	defer s.RUnlock()
+	var out []*pb.StoredQuery
+	for name, sq := range s.storedQueries {
+		if x.ParseNamespace(name) != ns {
+			continue
+		}
+		cp := *sq
+		out = append(out, &cp)
+	}
+	sort.Slice(out, func(i, j int) bool { return out[i].Name < out[j].Name })
+	return out
+}
+
+// LoadStoredQueriesFromDb iterates through the DB and loads all the stored queries.
+func LoadStoredQueriesFromDb() error {
+	prefix := x.StoredQueryPrefix()
+	txn := pstore.NewTransactionAt(math.MaxUint64, false)
+	defer txn.Discard()
+	itr := txn.NewIterator(badger.DefaultIteratorOptions) // Need values, reversed=false.
+	defer itr.Close()
+
+	for itr.Seek(prefix); itr.Valid(); itr.Next() {
+		item := itr.Item()
+		key := item.Key()
+		if !bytes.HasPrefix(key, prefix) {
+			break
+		}
+		pk, err := x.Parse(key)
+		if err != nil {
+			glog.Errorf("Error while parsing key %s: %v", hex.Dump(key), err)
+			continue
+		}
+		attr := pk.Attr
+		var sq pb.StoredQuery
+		err = item.Value(func(val []byte) error {
+			x.Checkf(sq.Unmarshal(val), "Error while loading stored queries from db")
+			State().SetStoredQuery(attr, sq)
+			return nil
+		})
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
@@ -0,0 +1,200 @@
+/*
+ * Copyright 2022 Dgraph Labs, Inc. and Contributors
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package schema
+
+import (
+	"bytes"
+	"encoding/hex"
+	"fmt"
+	"math"
+	"sort"
+	"strings"
+
+	"github.com/golang/glog"
+
+	"github.com/dgraph-io/badger/v3"
+	"github.com/dgraph-io/dgraph/protos/pb"
+	"github.com/dgraph-io/dgraph/tok"
+	"github.com/dgraph-io/dgraph/x"
+)
+
+// Synonym sets are stored next to the types, keyed by the namespaced set name. They are applied
+// when the anyofterms and anyoftext functions are evaluated, so changing a set never requires
+// rebuilding an index.
+
+func logSynonymUpdate(syn *pb.SynonymUpdate, name string) string {
+	return fmt.Sprintf("Setting synonyms for set %s: %v, version: %d\n",
+		name, syn.Words, syn.Version)
+}
+
+// normalizeSynonym returns the term used to look up the given word. Only words made of a single
+// term can be looked up, longer phrases are only used when expanding a query.
+func normalizeSynonym(word string) (string, bool) {
+	terms, err := tok.TermTokenizer{}.Tokens(word)
+	if err != nil || len(terms) != 1 || terms[0] == "" {
+		return "", false
+	}
+	return terms[0], true
+}
+
+// SetSynonym sets the synonym set with the given namespaced name in memory. A set with no
+// words is removed. Synonym mutations must flow through the update function, which are
+// synced to the db.
+func (s *state) SetSynonym(name string, syn pb.SynonymUpdate) {
+	s.Lock()
+	defer s.Unlock()
+	s.deleteSynonym(name)
+	if len(syn.Words) == 0 {
+		return
+	}
+	s.synonyms[name] = &syn
+	ns := x.ParseNamespace(name)
+	for _, word := range syn.Words {
+		term, ok := normalizeSynonym(word)
+		if !ok {
+			continue
+		}
+		key := x.NamespaceAttr(ns, term)
+		s.synonymIdx[key] = append(s.synonymIdx[key], name)
+	}
+	s.elog.Printf(logSynonymUpdate(&syn, name))
+}
+
+// deleteSynonym removes the synonym set from memory. The caller must hold the lock.
+func (s *state) deleteSynonym(name string) {
+	old, ok := s.synonyms[name]
+	if !ok {
+		return
+	}
+	delete(s.synonyms, name)
+	ns := x.ParseNamespace(name)
+	for _, word := range old.Words {
+		term, ok := normalizeSynonym(word)
+		if !ok {
+			continue
+		}
+		key := x.NamespaceAttr(ns, term)
+		names := s.synonymIdx[key][:0]
+		for _, n := range s.synonymIdx[key] {
+			if n != name {
+				names = append(names, n)
+			}
+		}
+		if len(names) == 0 {
+			delete(s.synonymIdx, key)
+		} else {
+			s.synonymIdx[key] = names
+		}
+	}
+}
+
+// GetSynonym gets the synonym set with the given namespaced name.
+func (s *state) GetSynonym(name string) (pb.SynonymUpdate, bool) {
+	s.RLock()
+	defer s.RUnlock()
+	syn, has := s.synonyms[name]
+	if !has {
+		return pb.SynonymUpdate{}, false
+	}
+	return *syn, true
+}
+
+// Synonyms returns the synonym sets defined in the given namespace, sorted by name.
+func (s *state) Synonyms(ns uint64) []*pb.SynonymUpdate {
+	if s == nil {
+		return nil
+	}
+
+	s.RLock()
+	defer s.RUnlock()
+	var out []*pb.SynonymUpdate
+	for name, syn := range s.synonyms {
+		if x.ParseNamespace(name) != ns {
+			continue
+		}
+		cp := *syn
+		out = append(out, &cp)
+	}
+	sort.Slice(out, func(i, j int) bool { return out[i].Name < out[j].Name })
+	return out
+}
+
+// ExpandSynonyms appends the synonyms of every term in the given text, using the synonym sets
+// of the namespace. The text is returned unmodified if none of its terms has a synonym.
+func (s *state) ExpandSynonyms(ns uint64, text string) string {
+	if s == nil {
+		return text
+	}
+	terms, err := tok.TermTokenizer{}.Tokens(text)
+	if err != nil {
+		return text
+	}
+
+	s.RLock()
+	defer s.RUnlock()
+	if len(s.synonymIdx) == 0 {
+		return text
+	}
+	seen := make(map[string]struct{})
+	var extra []string
+	for _, term := range terms {
+		for _, name := range s.synonymIdx[x.NamespaceAttr(ns, term)] {
+			if _, ok := seen[name]; ok {
+				continue
+			}
+			seen[name] = struct{}{}
+			extra = append(extra, s.synonyms[name].Words...)
+		}
+	}
+	if len(extra) == 0 {
+		return text
+	}
+	return text + " " + strings.Join(extra, " ")
+}
+
+// LoadSynonymsFromDb iterates through the DB and loads all the stored synonym sets.
+func LoadSynonymsFromDb() error {
+	prefix := x.SynonymPrefix()
+	txn := pstore.NewTransactionAt(math.MaxUint64, false)
+	defer txn.Discard()
+	itr := txn.NewIterator(badger.DefaultIteratorOptions) // Need values, reversed=false.
+	defer itr.Close()
+
+	for itr.Seek(prefix); itr.Valid(); itr.Next() {
+		item := itr.Item()
+		key := item.Key()
+		if !bytes.HasPrefix(key, prefix) {
+			break
+		}
+		pk, err := x.Parse(key)
+		if err != nil {
+			glog.Errorf("Error while parsing key %s: %v", hex.Dump(key), err)
+			continue
+		}
+		attr := pk.Attr
+		var syn pb.SynonymUpdate
+		err = item.Value(func(val []byte) error {
+			x.Checkf(syn.Unmarshal(val), "Error while loading synonyms from db")
+			State().SetSynonym(attr, syn)
+			return nil
+		})
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
@@ -0,0 +1,52 @@
+/*
+ * Copyright 2022 Dgraph Labs, Inc. and Contributors
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package schema
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/dgraph-io/dgraph/protos/pb"
+	"github.com/dgraph-io/dgraph/x"
+)
+
+func TestExpandSynonyms(t *testing.T) {
+	reset()
+	name := x.GalaxyAttr("vehicles")
+	State().SetSynonym(name, pb.SynonymUpdate{Name: name, Words: []string{"car", "Automobile"}})
+
+	require.Equal(t, "red car car Automobile",
+		State().ExpandSynonyms(x.GalaxyNamespace, "red car"))
+	require.Equal(t, "automobile car Automobile",
+		State().ExpandSynonyms(x.GalaxyNamespace, "automobile"))
+	require.Equal(t, "red bike", State().ExpandSynonyms(x.GalaxyNamespace, "red bike"))
+
+	// Synonym sets are scoped to their namespace.
+	require.Equal(t, "red car", State().ExpandSynonyms(1, "red car"))
+
+	syns := State().Synonyms(x.GalaxyNamespace)
+	require.Len(t, syns, 1)
+	require.Equal(t, name, syns[0].Name)
+	require.Empty(t, State().Synonyms(1))
+
+	// A set with no words is removed.
+	State().SetSynonym(name, pb.SynonymUpdate{Name: name})
+	_, ok := State().GetSynonym(name)
+	require.False(t, ok)
+	require.Equal(t, "red car", State().ExpandSynonyms(x.GalaxyNamespace, "red car"))
+}
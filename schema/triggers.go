@@ -0,0 +1,101 @@
+/*
+ * Copyright 2022 Dgraph Labs, Inc. and Contributors
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package schema
+
+import (
+	"bytes"
+	"encoding/hex"
+	"math"
+	"sort"
+
+	"github.com/golang/glog"
+
+	"github.com/dgraph-io/badger/v3"
+	"github.com/dgraph-io/dgraph/protos/pb"
+	"github.com/dgraph-io/dgraph/x"
+)
+
+// Triggers are stored next to the synonym sets, keyed by the namespaced trigger name, so that
+// every alpha can fire them for the DQL mutations it runs.
+
+// SetTrigger sets the trigger with the given namespaced name in memory. A trigger with no url
+// is removed. Trigger mutations must flow through the update function, which are synced to
+// the db.
+func (s *state) SetTrigger(name string, tr pb.Trigger) {
+	s.Lock()
+	defer s.Unlock()
+	if tr.Url == "" {
+		delete(s.triggers, name)
+		return
+	}
+	s.triggers[name] = &tr
+	s.elog.Printf("Setting trigger %s on predicate %q, type %q: %s, version: %d\n",
+		name, tr.Predicate, tr.TypeName, tr.Url, tr.Version)
+}
+
+// Triggers returns the triggers defined in the given namespace, sorted by name.
+func (s *state) Triggers(ns uint64) []*pb.Trigger {
+	if s == nil {
+		return nil
+	}
+
+	s.RLock()
+	defer s.RUnlock()
+	var out []*pb.Trigger
+	for name, tr := range s.triggers {
+		if x.ParseNamespace(name) != ns {
+			continue
+		}
+		cp := *tr
+		out = append(out, &cp)
+	}
+	sort.Slice(out, func(i, j int) bool { return out[i].Name < out[j].Name })
+	return out
+}
+
+// LoadTriggersFromDb iterates through the DB and loads all the stored triggers.
+func LoadTriggersFromDb() error {
+	prefix := x.TriggerPrefix()
+	txn := pstore.NewTransactionAt(math.MaxUint64, false)
+	defer txn.Discard()
+	itr := txn.NewIterator(badger.DefaultIteratorOptions) // Need values, reversed=false.
+	defer itr.Close()
+
+	for itr.Seek(prefix); itr.Valid(); itr.Next() {
+		item := itr.Item()
+		key := item.Key()
+		if !bytes.HasPrefix(key, prefix) {
+			break
+		}
+		pk, err := x.Parse(key)
+		if err != nil {
+			glog.Errorf("Error while parsing key %s: %v", hex.Dump(key), err)
+			continue
+		}
+		attr := pk.Attr
+		var tr pb.Trigger
+		err = item.Value(func(val []byte) error {
+			x.Checkf(tr.Unmarshal(val), "Error while loading triggers from db")
+			State().SetTrigger(attr, tr)
+			return nil
+		})
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
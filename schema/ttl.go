@@ -0,0 +1,134 @@
+/*
+ * Copyright 2022 Dgraph Labs, Inc. and Contributors
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package schema
+
+import (
+	"math"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/pkg/errors"
+
+	"github.com/dgraph-io/dgraph/lex"
+	"github.com/dgraph-io/dgraph/types"
+)
+
+// The @ttl directive of predicates and types is stored as a number of seconds. It is written
+// like 30d or 1d12h, with the units w, d, h, m and s, but an ISO 8601 or a Go duration is also
+// accepted.
+
+var ttlUnits = []struct {
+	unit byte
+	size time.Duration
+}{
+	{'w', 7 * 24 * time.Hour},
+	{'d', 24 * time.Hour},
+	{'h', time.Hour},
+	{'m', time.Minute},
+	{'s', time.Second},
+}
+
+// ParseTTL parses the argument of the @ttl directive and returns it as a number of seconds.
+func ParseTTL(s string) (int64, error) {
+	d, err := parseTTLUnits(s)
+	if err != nil {
+		if d, err = types.ParseDuration(s); err != nil {
+			return 0, errors.Errorf("Invalid ttl: %q", s)
+		}
+	}
+	if d < time.Second {
+		return 0, errors.Errorf("The ttl %q must be at least one second", s)
+	}
+	return int64(d / time.Second), nil
+}
+
+func parseTTLUnits(s string) (time.Duration, error) {
+	invalid := errors.Errorf("Invalid ttl: %q", s)
+	if s == "" {
+		return 0, invalid
+	}
+	var total time.Duration
+	for len(s) > 0 {
+		i := strings.IndexFunc(s, func(r rune) bool { return r < '0' || r > '9' })
+		if i <= 0 {
+			return 0, invalid
+		}
+		n, err := strconv.ParseInt(s[:i], 10, 64)
+		if err != nil {
+			return 0, invalid
+		}
+		var size time.Duration
+		for _, u := range ttlUnits {
+			if s[i] == u.unit {
+				size = u.size
+			}
+		}
+		if size == 0 || n > (math.MaxInt64-int64(total))/int64(size) {
+			return 0, invalid
+		}
+		total += time.Duration(n) * size
+		s = s[i+1:]
+	}
+	return total, nil
+}
+
+// FormatTTL returns the ttl of the given number of seconds in the format of the @ttl directive,
+// like 1d12h.
+func FormatTTL(seconds int64) string {
+	d := time.Duration(seconds) * time.Second
+	var buf strings.Builder
+	// Weeks are left out, so that a ttl declared in days is returned as it was declared.
+	for _, u := range ttlUnits[1:] {
+		if n := d / u.size; n > 0 {
+			buf.WriteString(strconv.FormatInt(int64(n), 10))
+			buf.WriteByte(u.unit)
+			d -= n * u.size
+		}
+	}
+	if buf.Len() == 0 {
+		return "0s"
+	}
+	return buf.String()
+}
+
+// parseTTLDirective parses the argument of the @ttl directive. The iterator is on the name of
+// the directive, and is left on the closing round bracket.
+func parseTTLDirective(it *lex.ItemIterator) (int64, error) {
+	it.Next()
+	if it.Item().Typ != itemLeftRound {
+		return 0, it.Item().Errorf("Expected ( after @ttl. Got %v", it.Item().Val)
+	}
+	// The lexer splits a ttl like 30d into a number and a text.
+	var arg strings.Builder
+	for it.Next() {
+		item := it.Item()
+		switch item.Typ {
+		case itemRightRound:
+			ttl, err := ParseTTL(arg.String())
+			if err != nil {
+				return 0, item.Errorf("%v", err)
+			}
+			return ttl, nil
+		case itemNumber, itemText:
+			arg.WriteString(item.Val)
+		default:
+			return 0, item.Errorf("Unexpected %v in the argument of @ttl", item.Val)
+		}
+	}
+	return 0, it.Item().Errorf("Missing ) after the argument of @ttl")
+}
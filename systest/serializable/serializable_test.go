@@ -0,0 +1,105 @@
+/*
+ * Copyright 2022 Dgraph Labs, Inc. and Contributors
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package main
+
+import (
+	"context"
+	"encoding/json"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/metadata"
+
+	"github.com/dgraph-io/dgo/v210"
+	"github.com/dgraph-io/dgo/v210/protos/api"
+	"github.com/dgraph-io/dgraph/testutil"
+)
+
+const onCallQuery = `{ q(func: eq(oncall, true)) { uid } }`
+
+// setup adds two doctors on call, and returns their uids.
+func setup(t *testing.T, dg *dgo.Dgraph) (string, string) {
+	ctx := context.Background()
+	require.NoError(t, dg.Alter(ctx, &api.Operation{DropAll: true}))
+	require.NoError(t, dg.Alter(ctx, &api.Operation{Schema: `
+		name: string @index(exact) .
+		oncall: bool @index(bool) .`}))
+
+	resp, err := dg.NewTxn().Mutate(ctx, &api.Mutation{
+		SetNquads: []byte(`_:alice <name> "Alice" .
+			_:alice <oncall> "true" .
+			_:bob <name> "Bob" .
+			_:bob <oncall> "true" .`),
+		CommitNow: true,
+	})
+	require.NoError(t, err)
+	return resp.Uids["alice"], resp.Uids["bob"]
+}
+
+// leaveOnCall takes the doctor off call if another doctor is still on call.
+func leaveOnCall(t *testing.T, ctx context.Context, txn *dgo.Txn, uid string) {
+	resp, err := txn.Query(ctx, onCallQuery)
+	require.NoError(t, err)
+	var r struct {
+		Q []struct {
+			Uid string
+		}
+	}
+	require.NoError(t, json.Unmarshal(resp.Json, &r))
+	require.Len(t, r.Q, 2)
+
+	_, err = txn.Mutate(ctx, &api.Mutation{
+		SetNquads: []byte(`<` + uid + `> <oncall> "false" .`),
+	})
+	require.NoError(t, err)
+}
+
+func TestWriteSkewAborts(t *testing.T) {
+	dg, err := testutil.DgraphClient(testutil.SockAddr)
+	require.NoError(t, err)
+	alice, bob := setup(t, dg)
+
+	// Each transaction reads the doctor the other one takes off call. One of them must abort.
+	ctx := metadata.AppendToOutgoingContext(context.Background(), "serializable", "true")
+	txn1, txn2 := dg.NewTxn(), dg.NewTxn()
+	leaveOnCall(t, ctx, txn1, alice)
+	leaveOnCall(t, ctx, txn2, bob)
+	require.NoError(t, txn1.Commit(ctx))
+	require.Equal(t, dgo.ErrAborted, txn2.Commit(ctx))
+
+	resp, err := dg.NewReadOnlyTxn().Query(context.Background(), onCallQuery)
+	require.NoError(t, err)
+	testutil.CompareJSON(t, `{"q":[{"uid":"`+bob+`"}]}`, string(resp.Json))
+}
+
+func TestWriteSkewWithSnapshotIsolation(t *testing.T) {
+	dg, err := testutil.DgraphClient(testutil.SockAddr)
+	require.NoError(t, err)
+	alice, bob := setup(t, dg)
+
+	// Without serializable isolation, both transactions commit as they write different keys.
+	ctx := context.Background()
+	txn1, txn2 := dg.NewTxn(), dg.NewTxn()
+	leaveOnCall(t, ctx, txn1, alice)
+	leaveOnCall(t, ctx, txn2, bob)
+	require.NoError(t, txn1.Commit(ctx))
+	require.NoError(t, txn2.Commit(ctx))
+
+	resp, err := dg.NewReadOnlyTxn().Query(ctx, onCallQuery)
+	require.NoError(t, err)
+	testutil.CompareJSON(t, `{"q":[]}`, string(resp.Json))
+}
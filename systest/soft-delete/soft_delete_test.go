@@ -0,0 +1,156 @@
+/*
+ * Copyright 2022 Dgraph Labs, Inc. and Contributors
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package main
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"io/ioutil"
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc"
+
+	"github.com/dgraph-io/dgo/v210/protos/api"
+	"github.com/dgraph-io/dgraph/testutil"
+)
+
+const personQuery = `{ q(func: has(name)) { name age } }`
+
+func adminRequest(t *testing.T, addr, query string) *testutil.GraphQLResponse {
+	b, err := json.Marshal(&testutil.GraphQLParams{Query: query})
+	require.NoError(t, err)
+	resp, err := http.Post("http://"+addr+"/admin", "application/json", bytes.NewBuffer(b))
+	require.NoError(t, err)
+	defer resp.Body.Close()
+	b, err = ioutil.ReadAll(resp.Body)
+	require.NoError(t, err)
+
+	var gqlResp testutil.GraphQLResponse
+	require.NoError(t, json.Unmarshal(b, &gqlResp))
+	return &gqlResp
+}
+
+func deletedNodes(t *testing.T, addr string) []string {
+	resp := adminRequest(t, addr, `query { deletedNodes { uid } }`)
+	resp.RequireNoGraphQLErrors(t)
+	var data struct {
+		DeletedNodes []struct {
+			Uid string
+		}
+	}
+	require.NoError(t, json.Unmarshal(resp.Data, &data))
+	var uids []string
+	for _, node := range data.DeletedNodes {
+		uids = append(uids, node.Uid)
+	}
+	return uids
+}
+
+// deleteNode deletes the node in a transaction whose mutation is sent to the first alpha and
+// whose commit is sent to the second one.
+func deleteNode(t *testing.T, uid string) {
+	ctx := context.Background()
+	alpha1, err := grpc.Dial(testutil.SockAddr, grpc.WithInsecure())
+	require.NoError(t, err)
+	defer alpha1.Close()
+	alpha2, err := grpc.Dial(testutil.ContainerAddr("alpha2", 9080), grpc.WithInsecure())
+	require.NoError(t, err)
+	defer alpha2.Close()
+
+	resp, err := api.NewDgraphClient(alpha1).Query(ctx, &api.Request{
+		Mutations: []*api.Mutation{{DelNquads: []byte(`<` + uid + `> * * .`)}},
+	})
+	require.NoError(t, err)
+	_, err = api.NewDgraphClient(alpha2).CommitOrAbort(ctx, resp.Txn)
+	require.NoError(t, err)
+}
+
+func TestUndeleteAndPurge(t *testing.T) {
+	dg, err := testutil.DgraphClient(testutil.SockAddr)
+	require.NoError(t, err)
+	ctx := context.Background()
+	require.NoError(t, dg.Alter(ctx, &api.Operation{DropAll: true}))
+	require.NoError(t, dg.Alter(ctx, &api.Operation{Schema: `
+		name: string @index(exact) .
+		age: int .
+		type Person {
+			name
+			age
+		}`}))
+
+	resp, err := dg.NewTxn().Mutate(ctx, &api.Mutation{
+		SetNquads: []byte(`_:a <name> "Alice" .
+			_:a <age> "30" .
+			_:a <dgraph.type> "Person" .`),
+		CommitNow: true,
+	})
+	require.NoError(t, err)
+	uid := resp.Uids["a"]
+	alice := `{"q":[{"name":"Alice","age":30}]}`
+
+	// The tombstone is recorded although the commit went through another alpha.
+	deleteNode(t, uid)
+	testutil.VerifyQueryResponse(t, dg, personQuery, `{"q":[]}`)
+	require.Equal(t, []string{uid}, deletedNodes(t, testutil.SockAddrHttp))
+	require.Equal(t, []string{uid}, deletedNodes(t, testutil.ContainerAddr("alpha2", 8080)))
+
+	gqlResp := adminRequest(t, testutil.SockAddrHttp,
+		`mutation { undelete(input: {uid: "`+uid+`"}) { response { code } } }`)
+	gqlResp.RequireNoGraphQLErrors(t)
+	testutil.VerifyQueryResponse(t, dg, personQuery, alice)
+	require.Empty(t, deletedNodes(t, testutil.SockAddrHttp))
+
+	// Once purged, the deletion can no longer be undone.
+	deleteNode(t, uid)
+	gqlResp = adminRequest(t, testutil.SockAddrHttp,
+		`mutation { purgeDeletes(input: {}) { response { code } } }`)
+	gqlResp.RequireNoGraphQLErrors(t)
+	require.Empty(t, deletedNodes(t, testutil.SockAddrHttp))
+	gqlResp = adminRequest(t, testutil.SockAddrHttp,
+		`mutation { undelete(input: {uid: "`+uid+`"}) { response { code } } }`)
+	require.NotEmpty(t, gqlResp.Errors)
+	testutil.VerifyQueryResponse(t, dg, personQuery, `{"q":[]}`)
+}
+
+func TestAbortedDeleteHasNoTombstone(t *testing.T) {
+	dg, err := testutil.DgraphClient(testutil.SockAddr)
+	require.NoError(t, err)
+	ctx := context.Background()
+	require.NoError(t, dg.Alter(ctx, &api.Operation{DropAll: true}))
+
+	resp, err := dg.NewTxn().Mutate(ctx, &api.Mutation{
+		SetNquads: []byte(`_:b <name> "Bob" .`),
+		CommitNow: true,
+	})
+	require.NoError(t, err)
+
+	txn := dg.NewTxn()
+	_, err = txn.Mutate(ctx, &api.Mutation{
+		DelNquads: []byte(`<` + resp.Uids["b"] + `> * * .`),
+	})
+	require.NoError(t, err)
+	require.NoError(t, txn.Discard(ctx))
+
+	// Let the abort reach the alphas.
+	time.Sleep(time.Second)
+	require.Empty(t, deletedNodes(t, testutil.SockAddrHttp))
+	require.Empty(t, deletedNodes(t, testutil.ContainerAddr("alpha2", 8080)))
+}
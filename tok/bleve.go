@@ -21,6 +21,7 @@ import (
 
 	"github.com/blevesearch/bleve/analysis"
 	"github.com/blevesearch/bleve/analysis/analyzer/custom"
+	"github.com/blevesearch/bleve/analysis/lang/cjk"
 	"github.com/blevesearch/bleve/analysis/token/lowercase"
 	"github.com/blevesearch/bleve/analysis/token/unicodenorm"
 	"github.com/blevesearch/bleve/analysis/tokenizer/unicode"
@@ -30,8 +31,8 @@ import (
 const unicodenormName = "unicodenorm_nfkc"
 
 var (
-	bleveCache                     = registry.NewCache()
-	termAnalyzer, fulltextAnalyzer *analysis.Analyzer
+	bleveCache                                  = registry.NewCache()
+	termAnalyzer, fulltextAnalyzer, cjkAnalyzer *analysis.Analyzer
 )
 
 // setupBleve creates bleve filters and analyzers that we use for term and fulltext tokenizers.
@@ -68,6 +69,23 @@ func setupBleve() {
 			},
 		})
 	x.Check(err)
+
+	// cjk analyzer - like fulltext but also folds half-width and full-width forms. The
+	// bigrams are built afterwards so that Hangul runs can be bigrammed too, see cjk.go.
+	cjkAnalyzer, err = bleveCache.DefineAnalyzer("cjk",
+		map[string]interface{}{
+			"type":      custom.Name,
+			"tokenizer": unicode.Name,
+			"token_filters": []string{
+				cjk.WidthName,
+				lowercase.Name,
+				unicodenormName,
+			},
+		})
+	x.Check(err)
+
+	// stop words filters for the languages that bleve doesn't ship a list for.
+	setupStopwords()
 }
 
 // uniqueTerms takes a token stream and returns a string slice of unique terms.
@@ -0,0 +1,78 @@
+/*
+ * Copyright 2022 Dgraph Labs, Inc. and Contributors
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package tok
+
+import (
+	"unicode"
+	"unicode/utf8"
+
+	"github.com/blevesearch/bleve/analysis"
+	"github.com/blevesearch/bleve/analysis/lang/cjk"
+	"github.com/golang/glog"
+)
+
+// cjkLangs are the languages whose text is indexed as overlapping bigrams instead of words.
+var cjkLangs = map[string]struct{}{
+	"ja": {},
+	"ko": {},
+	"zh": {},
+}
+
+func isCJKLang(lang string) bool {
+	_, ok := cjkLangs[lang]
+	return ok
+}
+
+// analyzeCJK splits the input into words using Unicode word segmentation (UAX #29) and then
+// turns every run of CJK characters into overlapping bigrams. Isolated characters are kept as
+// unigrams, so a single character query still matches.
+func analyzeCJK(input []byte) analysis.TokenStream {
+	tokens := cjkAnalyzer.Analyze(input)
+	markHangul(tokens)
+
+	filter, err := bleveCache.TokenFilterNamed(cjk.BigramName)
+	if err != nil {
+		glog.Errorf("Error while building CJK bigrams: %s", err)
+		return tokens
+	}
+	return filter.Filter(tokens)
+}
+
+// markHangul flags Hangul words as ideographic. The Unicode tokenizer reports them as
+// alphanumeric words, which the bigram filter would otherwise leave untouched, and Korean
+// words carry particles and endings that make whole-word matching too strict.
+func markHangul(tokens analysis.TokenStream) {
+	for _, token := range tokens {
+		if token.Type == analysis.AlphaNumeric && isHangul(token.Term) {
+			token.Type = analysis.Ideographic
+		}
+	}
+}
+
+func isHangul(term []byte) bool {
+	if len(term) == 0 {
+		return false
+	}
+	for len(term) > 0 {
+		r, size := utf8.DecodeRune(term)
+		if !unicode.Is(unicode.Hangul, r) {
+			return false
+		}
+		term = term[size:]
+	}
+	return true
+}
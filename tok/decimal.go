@@ -0,0 +1,61 @@
+/*
+ * Copyright 2022 Dgraph Labs, Inc. and Contributors
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package tok
+
+import (
+	"encoding/binary"
+
+	"github.com/dgraph-io/dgraph/types"
+)
+
+// DecimalTokenizer generates sortable tokens from decimal data. Equal decimals, like 1.5 and
+// 1.50, have the same token.
+type DecimalTokenizer struct{}
+
+func (t DecimalTokenizer) Name() string { return "decimal" }
+func (t DecimalTokenizer) Type() string { return "decimal" }
+func (t DecimalTokenizer) Tokens(v interface{}) ([]string, error) {
+	return []string{encodeDecimal(v.(types.Decimal))}, nil
+}
+func (t DecimalTokenizer) Identifier() byte { return IdentDecimal }
+func (t DecimalTokenizer) IsSortable() bool { return true }
+func (t DecimalTokenizer) IsLossy() bool    { return false }
+
+// encodeDecimal encodes the decimal so that the byte order of the encoded values is the order of
+// the decimals. The first byte is 0 for negative numbers, 1 for zero and 2 for positive numbers.
+// It is followed by the exponent and the significant digits of the number, terminated by a zero
+// byte. A larger exponent means a larger magnitude since the first digit is never zero, and
+// between equal exponents the digits compare like the magnitudes. The bytes after the sign are
+// inverted for negative numbers, for which a larger magnitude is a smaller number.
+func encodeDecimal(d types.Decimal) string {
+	sign, digits, exponent := d.Normalized()
+	if sign == 0 {
+		return string([]byte{1})
+	}
+	buf := make([]byte, 5, 5+len(digits)+1)
+	buf[0] = 2
+	binary.BigEndian.PutUint32(buf[1:5], uint32(exponent)^(1<<31))
+	buf = append(buf, digits...)
+	buf = append(buf, 0)
+	if sign < 0 {
+		buf[0] = 0
+		for i := 1; i < len(buf); i++ {
+			buf[i] = ^buf[i]
+		}
+	}
+	return string(buf)
+}
@@ -0,0 +1,99 @@
+/*
+ * Copyright 2022 Dgraph Labs, Inc. and Contributors
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package tok
+
+import (
+	"strconv"
+	"strings"
+
+	"github.com/pkg/errors"
+
+	"github.com/dgraph-io/dgraph/types"
+)
+
+const jsonPathTokenizerName = "jsonpath"
+
+// JSONPathTokenizer generates tokens from the value at a path of json documents. Unlike the
+// other tokenizers, it isn't registered: there is one tokenizer per path, named like
+// jsonpath("$.status"). All the paths share the same identifier, and their tokens are prefixed by
+// the path so that they don't overlap.
+type JSONPathTokenizer struct {
+	path types.JSONPath
+}
+
+// NewJSONPathTokenizer returns the tokenizer of the given path.
+func NewJSONPathTokenizer(path string) (JSONPathTokenizer, error) {
+	p, err := types.ParseJSONPath(path)
+	if err != nil {
+		return JSONPathTokenizer{}, err
+	}
+	return JSONPathTokenizer{path: p}, nil
+}
+
+func (t JSONPathTokenizer) Name() string {
+	return jsonPathTokenizerName + "(" + strconv.Quote(t.path.String()) + ")"
+}
+func (t JSONPathTokenizer) Type() string { return "json" }
+func (t JSONPathTokenizer) Tokens(v interface{}) ([]string, error) {
+	return types.IndexJSONPathTokens(t.path, v.(string)), nil
+}
+func (t JSONPathTokenizer) Identifier() byte { return IdentJSONPath }
+func (t JSONPathTokenizer) IsSortable() bool { return false }
+func (t JSONPathTokenizer) IsLossy() bool    { return false }
+
+// Path returns the path of the json documents indexed by the tokenizer.
+func (t JSONPathTokenizer) Path() types.JSONPath { return t.path }
+
+// TokenPrefix returns the prefix shared by the tokens of the tokenizer, after the identifier.
+func (t JSONPathTokenizer) TokenPrefix() string { return types.JSONPathTokenPrefix(t.path) }
+
+// QueryTokens returns the encoded tokens of the documents whose value at the path is equal to
+// the argument.
+func (t JSONPathTokenizer) QueryTokens(arg string) []string {
+	tokens := types.QueryJSONPathTokens(t.path, arg)
+	for i := range tokens {
+		tokens[i] = encodeToken(tokens[i], t.Identifier())
+	}
+	return tokens
+}
+
+// getJSONPathTokenizer returns the tokenizer for a name like jsonpath("$.status").
+func getJSONPathTokenizer(name string) (Tokenizer, bool) {
+	arg := strings.TrimPrefix(name, jsonPathTokenizerName+"(")
+	if len(arg) == len(name) || !strings.HasSuffix(arg, ")") {
+		return nil, false
+	}
+	path, err := strconv.Unquote(strings.TrimSpace(strings.TrimSuffix(arg, ")")))
+	if err != nil {
+		return nil, false
+	}
+	t, err := NewJSONPathTokenizer(path)
+	if err != nil {
+		return nil, false
+	}
+	return t, true
+}
+
+// JSONPathTokenizerName returns the name of the tokenizer of the path, or an error if the path is
+// invalid. The name is the same for the equivalent paths.
+func JSONPathTokenizerName(path string) (string, error) {
+	t, err := NewJSONPathTokenizer(path)
+	if err != nil {
+		return "", errors.Wrapf(err, "Invalid jsonpath index")
+	}
+	return t.Name(), nil
+}
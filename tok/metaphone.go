@@ -0,0 +1,576 @@
+/*
+ * Copyright 2022 Dgraph Labs, Inc. and Contributors
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package tok
+
+import (
+	"strings"
+)
+
+// This file implements the Double Metaphone algorithm by Lawrence Philips, following the
+// original C++ implementation. Every word gets a primary code and an alternate code, which
+// differ for words that have more than one common pronunciation (e.g. of foreign origin).
+
+const metaphoneMaxLen = 4
+
+type metaphoneEncoder struct {
+	value         []rune
+	last          int
+	slavoGermanic bool
+	primary       strings.Builder
+	alternate     strings.Builder
+}
+
+// doubleMetaphone returns the primary and the alternate codes of the word.
+func doubleMetaphone(word string) (string, string) {
+	value := []rune(strings.ToUpper(strings.TrimSpace(word)))
+	if len(value) == 0 {
+		return "", ""
+	}
+	e := &metaphoneEncoder{value: value, last: len(value) - 1}
+	e.slavoGermanic = strings.ContainsAny(string(value), "WK") ||
+		strings.Contains(string(value), "CZ")
+	return e.encode()
+}
+
+func (e *metaphoneEncoder) encode() (string, string) {
+	index := 0
+	if e.contains(0, 2, "GN", "KN", "PN", "WR", "PS") {
+		// Skip the first letter when it is silent.
+		index = 1
+	}
+	for !e.complete() && index <= e.last {
+		switch e.charAt(index) {
+		case 'A', 'E', 'I', 'O', 'U', 'Y':
+			// Only a vowel at the start of the word is coded.
+			if index == 0 {
+				e.add("A")
+			}
+			index++
+		case 'B':
+			e.add("P")
+			index = e.skipDouble(index, 'B')
+		case 'Ç':
+			e.add("S")
+			index++
+		case 'C':
+			index = e.handleC(index)
+		case 'D':
+			index = e.handleD(index)
+		case 'F':
+			e.add("F")
+			index = e.skipDouble(index, 'F')
+		case 'G':
+			index = e.handleG(index)
+		case 'H':
+			index = e.handleH(index)
+		case 'J':
+			index = e.handleJ(index)
+		case 'K':
+			e.add("K")
+			index = e.skipDouble(index, 'K')
+		case 'L':
+			index = e.handleL(index)
+		case 'M':
+			e.add("M")
+			if e.charAt(index+1) == 'M' || (e.contains(index-1, 3, "UMB") &&
+				(index+1 == e.last || e.contains(index+2, 2, "ER"))) {
+				index += 2
+			} else {
+				index++
+			}
+		case 'N':
+			e.add("N")
+			index = e.skipDouble(index, 'N')
+		case 'Ñ':
+			e.add("N")
+			index++
+		case 'P':
+			if e.charAt(index+1) == 'H' {
+				e.add("F")
+				index += 2
+			} else {
+				e.add("P")
+				index = e.skipNext(index, "P", "B")
+			}
+		case 'Q':
+			e.add("K")
+			index = e.skipDouble(index, 'Q')
+		case 'R':
+			index = e.handleR(index)
+		case 'S':
+			index = e.handleS(index)
+		case 'T':
+			index = e.handleT(index)
+		case 'V':
+			e.add("F")
+			index = e.skipDouble(index, 'V')
+		case 'W':
+			index = e.handleW(index)
+		case 'X':
+			index = e.handleX(index)
+		case 'Z':
+			index = e.handleZ(index)
+		default:
+			index++
+		}
+	}
+	return e.primary.String(), e.alternate.String()
+}
+
+func (e *metaphoneEncoder) handleC(index int) int {
+	switch {
+	case e.isGermanicC(index):
+		e.add("K")
+		return index + 2
+	case index == 0 && e.contains(index, 6, "CAESAR"):
+		e.add("S")
+		return index + 2
+	case e.contains(index, 2, "CH"):
+		return e.handleCH(index)
+	case e.contains(index, 2, "CZ") && !e.contains(index-2, 4, "WICZ"):
+		e.addBoth("S", "X")
+		return index + 2
+	case e.contains(index+1, 3, "CIA"):
+		e.add("X")
+		return index + 3
+	case e.contains(index, 2, "CC") && !(index == 1 && e.charAt(0) == 'M'):
+		if e.contains(index+2, 1, "I", "E", "H") && !e.contains(index+2, 2, "HU") {
+			if (index == 1 && e.charAt(index-1) == 'A') ||
+				e.contains(index-1, 5, "UCCEE", "UCCES") {
+				e.add("KS")
+			} else {
+				e.add("X")
+			}
+			return index + 3
+		}
+		e.add("K")
+		return index + 2
+	case e.contains(index, 2, "CK", "CG", "CQ"):
+		e.add("K")
+		return index + 2
+	case e.contains(index, 2, "CI", "CE", "CY"):
+		if e.contains(index, 3, "CIO", "CIE", "CIA") {
+			e.addBoth("S", "X")
+		} else {
+			e.add("S")
+		}
+		return index + 2
+	}
+
+	e.add("K")
+	switch {
+	case e.contains(index+1, 2, " C", " Q", " G"):
+		return index + 3
+	case e.contains(index+1, 1, "C", "K", "Q") && !e.contains(index+1, 2, "CE", "CI"):
+		return index + 2
+	}
+	return index + 1
+}
+
+// isGermanicC checks for a C pronounced as K in Germanic words, like in "bacher" or "macher".
+func (e *metaphoneEncoder) isGermanicC(index int) bool {
+	if e.contains(index, 4, "CHIA") {
+		return true
+	}
+	if index <= 1 || isMetaphoneVowel(e.charAt(index-2)) || !e.contains(index-1, 3, "ACH") {
+		return false
+	}
+	c := e.charAt(index + 2)
+	return (c != 'I' && c != 'E') || e.contains(index-2, 6, "BACHER", "MACHER")
+}
+
+func (e *metaphoneEncoder) handleCH(index int) int {
+	switch {
+	case index > 0 && e.contains(index, 4, "CHAE"):
+		e.addBoth("K", "X")
+	case e.isGreekCH(index), e.isGermanicCH(index):
+		e.add("K")
+	case index == 0:
+		e.add("X")
+	case e.contains(0, 2, "MC"):
+		e.add("K")
+	default:
+		e.addBoth("X", "K")
+	}
+	return index + 2
+}
+
+// isGreekCH checks for a CH of Greek origin at the start of the word, like in "character".
+func (e *metaphoneEncoder) isGreekCH(index int) bool {
+	if index != 0 {
+		return false
+	}
+	if !e.contains(index+1, 5, "HARAC", "HARIS") &&
+		!e.contains(index+1, 3, "HOR", "HYM", "HIA", "HEM") {
+		return false
+	}
+	return !e.contains(0, 5, "CHORE")
+}
+
+// isGermanicCH checks for a CH pronounced as K, like in "orchestra" or "Schmidt".
+func (e *metaphoneEncoder) isGermanicCH(index int) bool {
+	return e.contains(0, 4, "VAN ", "VON ") || e.contains(0, 3, "SCH") ||
+		e.contains(index-2, 6, "ORCHES", "ARCHIT", "ORCHID") ||
+		e.contains(index+2, 1, "T", "S") ||
+		((e.contains(index-1, 1, "A", "O", "U", "E") || index == 0) &&
+			(e.contains(index+2, 1, "L", "R", "N", "M", "B", "H", "F", "V", "W", " ") ||
+				index+1 == e.last))
+}
+
+func (e *metaphoneEncoder) handleD(index int) int {
+	switch {
+	case e.contains(index, 2, "DG"):
+		if e.contains(index+2, 1, "I", "E", "Y") {
+			e.add("J")
+			return index + 3
+		}
+		e.add("TK")
+		return index + 2
+	case e.contains(index, 2, "DT", "DD"):
+		e.add("T")
+		return index + 2
+	}
+	e.add("T")
+	return index + 1
+}
+
+func (e *metaphoneEncoder) handleG(index int) int {
+	next := e.charAt(index + 1)
+	switch {
+	case next == 'H':
+		return e.handleGH(index)
+	case next == 'N':
+		switch {
+		case index == 1 && isMetaphoneVowel(e.charAt(0)) && !e.slavoGermanic:
+			e.addBoth("KN", "N")
+		case !e.contains(index+2, 2, "EY") && !e.slavoGermanic:
+			e.addBoth("N", "KN")
+		default:
+			e.add("KN")
+		}
+		return index + 2
+	case e.contains(index+1, 2, "LI") && !e.slavoGermanic:
+		e.addBoth("KL", "L")
+		return index + 2
+	case index == 0 && (next == 'Y' || e.contains(index+1, 2,
+		"ES", "EP", "EB", "EL", "EY", "IB", "IL", "IN", "IE", "EI", "ER")):
+		e.addBoth("K", "J")
+		return index + 2
+	case (e.contains(index+1, 2, "ER") || next == 'Y') &&
+		!e.contains(0, 6, "DANGER", "RANGER", "MANGER") &&
+		!e.contains(index-1, 1, "E", "I") && !e.contains(index-1, 3, "RGY", "OGY"):
+		e.addBoth("K", "J")
+		return index + 2
+	case e.contains(index+1, 1, "E", "I", "Y") || e.contains(index-1, 4, "AGGI", "OGGI"):
+		switch {
+		case e.contains(0, 4, "VAN ", "VON ") || e.contains(0, 3, "SCH") ||
+			e.contains(index+1, 2, "ET"):
+			e.add("K")
+		case e.contains(index+1, 3, "IER"):
+			e.add("J")
+		default:
+			e.addBoth("J", "K")
+		}
+		return index + 2
+	case next == 'G':
+		e.add("K")
+		return index + 2
+	}
+	e.add("K")
+	return index + 1
+}
+
+func (e *metaphoneEncoder) handleGH(index int) int {
+	switch {
+	case index > 0 && !isMetaphoneVowel(e.charAt(index-1)):
+		e.add("K")
+	case index == 0:
+		if e.charAt(index+2) == 'I' {
+			e.add("J")
+		} else {
+			e.add("K")
+		}
+	case (index > 1 && e.contains(index-2, 1, "B", "H", "D")) ||
+		(index > 2 && e.contains(index-3, 1, "B", "H", "D")) ||
+		(index > 3 && e.contains(index-4, 1, "B", "H")):
+		// The GH is silent, like in "hugh" or "bough".
+	case index > 2 && e.charAt(index-1) == 'U' &&
+		e.contains(index-3, 1, "C", "G", "L", "R", "T"):
+		e.add("F")
+	case e.charAt(index-1) != 'I':
+		e.add("K")
+	}
+	return index + 2
+}
+
+func (e *metaphoneEncoder) handleH(index int) int {
+	// Only an H followed by a vowel, and at the start of the word or after a vowel, is coded.
+	if (index == 0 || isMetaphoneVowel(e.charAt(index-1))) &&
+		isMetaphoneVowel(e.charAt(index+1)) {
+		e.add("H")
+		return index + 2
+	}
+	return index + 1
+}
+
+func (e *metaphoneEncoder) handleJ(index int) int {
+	if e.contains(index, 4, "JOSE") || e.contains(0, 4, "SAN ") {
+		if (index == 0 && e.charAt(index+4) == ' ') || len(e.value) == 4 ||
+			e.contains(0, 4, "SAN ") {
+			e.add("H")
+		} else {
+			e.addBoth("J", "H")
+		}
+		return index + 1
+	}
+
+	switch {
+	case index == 0:
+		e.addBoth("J", "A")
+	case isMetaphoneVowel(e.charAt(index-1)) && !e.slavoGermanic &&
+		(e.charAt(index+1) == 'A' || e.charAt(index+1) == 'O'):
+		e.addBoth("J", "H")
+	case index == e.last:
+		e.addBoth("J", "")
+	case !e.contains(index+1, 1, "L", "T", "K", "S", "N", "M", "B", "Z") &&
+		!e.contains(index-1, 1, "S", "K", "L"):
+		e.add("J")
+	}
+	return e.skipDouble(index, 'J')
+}
+
+func (e *metaphoneEncoder) handleL(index int) int {
+	if e.charAt(index+1) != 'L' {
+		e.add("L")
+		return index + 1
+	}
+	// A double L of Spanish origin, like in "cabrillo" or "gallegos", may not be pronounced.
+	if (index == len(e.value)-3 && e.contains(index-1, 4, "ILLO", "ILLA", "ALLE")) ||
+		((e.contains(len(e.value)-2, 2, "AS", "OS") || e.contains(e.last, 1, "A", "O")) &&
+			e.contains(index-1, 4, "ALLE")) {
+		e.addBoth("L", "")
+	} else {
+		e.add("L")
+	}
+	return index + 2
+}
+
+func (e *metaphoneEncoder) handleR(index int) int {
+	// A final R of French origin, like in "rogier", is not pronounced.
+	if index == e.last && !e.slavoGermanic && e.contains(index-2, 2, "IE") &&
+		!e.contains(index-4, 2, "ME", "MA") {
+		e.addBoth("", "R")
+	} else {
+		e.add("R")
+	}
+	return e.skipDouble(index, 'R')
+}
+
+func (e *metaphoneEncoder) handleS(index int) int {
+	switch {
+	case e.contains(index-1, 3, "ISL", "YSL"):
+		// The S is silent, like in "island" or "carlysle".
+		return index + 1
+	case index == 0 && e.contains(index, 5, "SUGAR"):
+		e.addBoth("X", "S")
+		return index + 1
+	case e.contains(index, 2, "SH"):
+		if e.contains(index+1, 4, "HEIM", "HOEK", "HOLM", "HOLZ") {
+			e.add("S")
+		} else {
+			e.add("X")
+		}
+		return index + 2
+	case e.contains(index, 3, "SIO", "SIA") || e.contains(index, 4, "SIAN"):
+		if e.slavoGermanic {
+			e.add("S")
+		} else {
+			e.addBoth("S", "X")
+		}
+		return index + 3
+	case (index == 0 && e.contains(index+1, 1, "M", "N", "L", "W")) ||
+		e.contains(index+1, 1, "Z"):
+		e.addBoth("S", "X")
+		return e.skipNext(index, "Z")
+	case e.contains(index, 2, "SC"):
+		return e.handleSC(index)
+	}
+
+	// A final S of French origin, like in "resnais", is not pronounced.
+	if index == e.last && e.contains(index-2, 2, "AI", "OI") {
+		e.addBoth("", "S")
+	} else {
+		e.add("S")
+	}
+	return e.skipNext(index, "S", "Z")
+}
+
+func (e *metaphoneEncoder) handleSC(index int) int {
+	switch {
+	case e.charAt(index+2) == 'H':
+		switch {
+		case e.contains(index+3, 2, "ER", "EN"):
+			e.addBoth("X", "SK")
+		case e.contains(index+3, 2, "OO", "UY", "ED", "EM"):
+			e.add("SK")
+		case index == 0 && !isMetaphoneVowel(e.charAt(3)) && e.charAt(3) != 'W':
+			e.addBoth("X", "S")
+		default:
+			e.add("X")
+		}
+	case e.contains(index+2, 1, "I", "E", "Y"):
+		e.add("S")
+	default:
+		e.add("SK")
+	}
+	return index + 3
+}
+
+func (e *metaphoneEncoder) handleT(index int) int {
+	switch {
+	case e.contains(index, 4, "TION"), e.contains(index, 3, "TIA", "TCH"):
+		e.add("X")
+		return index + 3
+	case e.contains(index, 2, "TH"), e.contains(index, 3, "TTH"):
+		if e.contains(index+2, 2, "OM", "AM") || e.contains(0, 4, "VAN ", "VON ") ||
+			e.contains(0, 3, "SCH") {
+			e.add("T")
+		} else {
+			e.addBoth("0", "T")
+		}
+		return index + 2
+	}
+	e.add("T")
+	return e.skipNext(index, "T", "D")
+}
+
+func (e *metaphoneEncoder) handleW(index int) int {
+	switch {
+	case e.contains(index, 2, "WR"):
+		e.add("R")
+		return index + 2
+	case index == 0 && (isMetaphoneVowel(e.charAt(index+1)) || e.contains(index, 2, "WH")):
+		if isMetaphoneVowel(e.charAt(index + 1)) {
+			e.addBoth("A", "F")
+		} else {
+			e.add("A")
+		}
+	case (index == e.last && isMetaphoneVowel(e.charAt(index-1))) ||
+		e.contains(index-1, 5, "EWSKI", "EWSKY", "OWSKI", "OWSKY") || e.contains(0, 3, "SCH"):
+		e.addBoth("", "F")
+	case e.contains(index, 4, "WICZ", "WITZ"):
+		e.addBoth("TS", "FX")
+		return index + 4
+	}
+	return index + 1
+}
+
+func (e *metaphoneEncoder) handleX(index int) int {
+	if index == 0 {
+		e.add("S")
+		return index + 1
+	}
+	// A final X of French origin, like in "breaux", is not pronounced.
+	if !(index == e.last &&
+		(e.contains(index-3, 3, "IAU", "EAU") || e.contains(index-2, 2, "AU", "OU"))) {
+		e.add("KS")
+	}
+	return e.skipNext(index, "C", "X")
+}
+
+func (e *metaphoneEncoder) handleZ(index int) int {
+	if e.charAt(index+1) == 'H' {
+		e.add("J")
+		return index + 2
+	}
+	if e.contains(index+1, 2, "ZO", "ZI", "ZA") ||
+		(e.slavoGermanic && index > 0 && e.charAt(index-1) != 'T') {
+		e.addBoth("S", "TS")
+	} else {
+		e.add("S")
+	}
+	return e.skipDouble(index, 'Z')
+}
+
+// add appends the same code to the primary and the alternate codes.
+func (e *metaphoneEncoder) add(code string) {
+	e.addBoth(code, code)
+}
+
+func (e *metaphoneEncoder) addBoth(primary, alternate string) {
+	appendCode(&e.primary, primary)
+	appendCode(&e.alternate, alternate)
+}
+
+func appendCode(b *strings.Builder, code string) {
+	if left := metaphoneMaxLen - b.Len(); left < len(code) {
+		code = code[:left]
+	}
+	b.WriteString(code)
+}
+
+func (e *metaphoneEncoder) complete() bool {
+	return e.primary.Len() >= metaphoneMaxLen && e.alternate.Len() >= metaphoneMaxLen
+}
+
+// charAt returns the character at the given index, or 0 if the index is out of range.
+func (e *metaphoneEncoder) charAt(index int) rune {
+	if index < 0 || index >= len(e.value) {
+		return 0
+	}
+	return e.value[index]
+}
+
+// contains checks whether the substring of the given length starting at start is one of the
+// given strings.
+func (e *metaphoneEncoder) contains(start, length int, criteria ...string) bool {
+	if start < 0 || start+length > len(e.value) {
+		return false
+	}
+	sub := string(e.value[start : start+length])
+	for _, c := range criteria {
+		if sub == c {
+			return true
+		}
+	}
+	return false
+}
+
+// skipDouble returns the index after the character at index, skipping a repetition of it.
+func (e *metaphoneEncoder) skipDouble(index int, c rune) int {
+	if e.charAt(index+1) == c {
+		return index + 2
+	}
+	return index + 1
+}
+
+// skipNext returns the index after the character at index, skipping the next character if it
+// is one of the given ones.
+func (e *metaphoneEncoder) skipNext(index int, next ...string) int {
+	if e.contains(index+1, 1, next...) {
+		return index + 2
+	}
+	return index + 1
+}
+
+func isMetaphoneVowel(c rune) bool {
+	switch c {
+	case 'A', 'E', 'I', 'O', 'U', 'Y':
+		return true
+	}
+	return false
+}
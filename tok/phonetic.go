@@ -0,0 +1,122 @@
+/*
+ * Copyright 2022 Dgraph Labs, Inc. and Contributors
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package tok
+
+import (
+	"strings"
+
+	"github.com/dgraph-io/dgraph/x"
+)
+
+// SoundexTokenizer generates a Soundex code for every word of the string data.
+type SoundexTokenizer struct{}
+
+func (t SoundexTokenizer) Name() string { return "soundex" }
+func (t SoundexTokenizer) Type() string { return "string" }
+func (t SoundexTokenizer) Tokens(v interface{}) ([]string, error) {
+	return phoneticTokens(v, func(word string) []string {
+		if code := soundex(word); code != "" {
+			return []string{code}
+		}
+		return nil
+	}), nil
+}
+func (t SoundexTokenizer) Identifier() byte { return IdentSoundex }
+func (t SoundexTokenizer) IsSortable() bool { return false }
+func (t SoundexTokenizer) IsLossy() bool    { return true }
+
+// MetaphoneTokenizer generates the primary and alternate Double Metaphone codes for every
+// word of the string data.
+type MetaphoneTokenizer struct{}
+
+func (t MetaphoneTokenizer) Name() string { return "metaphone" }
+func (t MetaphoneTokenizer) Type() string { return "string" }
+func (t MetaphoneTokenizer) Tokens(v interface{}) ([]string, error) {
+	return phoneticTokens(v, func(word string) []string {
+		primary, alternate := doubleMetaphone(word)
+		switch {
+		case primary == "":
+			return nil
+		case alternate == "" || alternate == primary:
+			return []string{primary}
+		default:
+			return []string{primary, alternate}
+		}
+	}), nil
+}
+func (t MetaphoneTokenizer) Identifier() byte { return IdentMetaphone }
+func (t MetaphoneTokenizer) IsSortable() bool { return false }
+func (t MetaphoneTokenizer) IsLossy() bool    { return true }
+
+// phoneticTokens splits the value into words and returns the unique codes of all the words.
+// Words that have no code, like the ones not written in the Latin alphabet, are skipped.
+func phoneticTokens(v interface{}, encode func(string) []string) []string {
+	str, ok := v.(string)
+	if !ok || str == "" {
+		return []string{}
+	}
+	var tokens []string
+	for _, token := range termAnalyzer.Analyze([]byte(str)) {
+		tokens = append(tokens, encode(string(token.Term))...)
+	}
+	if len(tokens) == 0 {
+		return []string{}
+	}
+	return x.RemoveDuplicates(tokens)
+}
+
+// soundexDigits holds the Soundex digit of every letter from A to Z. Vowels are coded as 0 and
+// separate consonants with the same digit, while H and W (coded as -) do not.
+const soundexDigits = "0123012-02245501262301-202"
+
+// soundex returns the American Soundex code of the word: its first letter followed by three
+// digits. Characters other than the ASCII letters are ignored.
+func soundex(word string) string {
+	code := make([]byte, 0, 4)
+	var last byte
+	for _, r := range strings.ToUpper(word) {
+		if r < 'A' || r > 'Z' {
+			continue
+		}
+		digit := soundexDigits[r-'A']
+		if len(code) == 0 {
+			code = append(code, byte(r))
+			last = digit
+			continue
+		}
+		switch digit {
+		case '0':
+			last = digit
+		case '-':
+		default:
+			if digit != last {
+				code = append(code, digit)
+				if len(code) == cap(code) {
+					return string(code)
+				}
+			}
+			last = digit
+		}
+	}
+	if len(code) == 0 {
+		return ""
+	}
+	for len(code) < cap(code) {
+		code = append(code, '0')
+	}
+	return string(code)
+}
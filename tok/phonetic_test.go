@@ -0,0 +1,94 @@
+/*
+ * Copyright 2022 Dgraph Labs, Inc. and Contributors
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package tok
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestSoundex(t *testing.T) {
+	tests := []struct {
+		word string
+		code string
+	}{
+		{"Robert", "R163"},
+		{"Rupert", "R163"},
+		{"Rubin", "R150"},
+		{"Ashcraft", "A261"},
+		{"Ashcroft", "A261"},
+		{"Tymczak", "T522"},
+		{"Pfister", "P236"},
+		{"Lee", "L000"},
+		{"O'Hara", "O600"},
+		{"1234", ""},
+	}
+	for _, test := range tests {
+		require.Equal(t, test.code, soundex(test.word), test.word)
+	}
+}
+
+func TestDoubleMetaphone(t *testing.T) {
+	tests := []struct {
+		word      string
+		primary   string
+		alternate string
+	}{
+		{"Smith", "SM0", "XMT"},
+		{"Schmidt", "XMT", "SMT"},
+		{"Jose", "HS", "HS"},
+		{"Knight", "NT", "NT"},
+		{"Catherine", "K0RN", "KTRN"},
+		{"John", "JN", "AN"},
+		{"", "", ""},
+	}
+	for _, test := range tests {
+		primary, alternate := doubleMetaphone(test.word)
+		require.Equal(t, test.primary, primary, test.word)
+		require.Equal(t, test.alternate, alternate, test.word)
+	}
+}
+
+func TestSoundexTokenizer(t *testing.T) {
+	tokenizer, has := GetTokenizer("soundex")
+	require.True(t, has)
+
+	tokens, err := BuildTokens("Robert Rupert", tokenizer)
+	require.NoError(t, err)
+	id := tokenizer.Identifier()
+	require.Equal(t, []string{encodeToken("R163", id)}, tokens)
+
+	tokens, err = BuildTokens("北京", tokenizer)
+	require.NoError(t, err)
+	require.Empty(t, tokens)
+}
+
+func TestMetaphoneTokenizer(t *testing.T) {
+	tokenizer, has := GetTokenizer("metaphone")
+	require.True(t, has)
+
+	tokens, err := BuildTokens("John Smith", tokenizer)
+	require.NoError(t, err)
+	id := tokenizer.Identifier()
+	require.Equal(t, []string{
+		encodeToken("AN", id),
+		encodeToken("JN", id),
+		encodeToken("SM0", id),
+		encodeToken("XMT", id),
+	}, tokens)
+}
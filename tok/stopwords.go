@@ -46,6 +46,9 @@ import (
 	_ "github.com/blevesearch/bleve/analysis/lang/ru"
 	_ "github.com/blevesearch/bleve/analysis/lang/sv"
 	_ "github.com/blevesearch/bleve/analysis/lang/tr"
+	"github.com/blevesearch/bleve/analysis/token/stop"
+	"github.com/blevesearch/bleve/analysis/tokenmap"
+	"github.com/dgraph-io/dgraph/x"
 	"github.com/golang/glog"
 )
 
@@ -80,15 +83,83 @@ var langStops = map[string]string{
 	"tr":  "stop_tr",
 }
 
+// cjkStops are the stop words filters of the CJK languages, which are only used by the
+// fulltext_v2 tokenizer. The fulltext tokenizer doesn't filter stop words for these languages.
+var cjkStops = map[string]string{
+	"ja": "stop_ja",
+	"ko": "stop_ko",
+	"zh": "stop_zh",
+}
+
+// customStops are stop words for languages that bleve doesn't have a list for. CJK text is
+// indexed as bigrams, so these lists only hold words of one or two characters, which are the
+// only ones that can match a token.
+var customStops = map[string][]string{
+	"ja": {
+		"の", "に", "は", "を", "た", "が", "で", "て", "と", "し", "れ", "さ", "も", "な",
+		"へ", "か", "だ", "ず", "ば", "せ", "う", "き", "つ", "ら", "ん", "お", "ある",
+		"いる", "する", "から", "こと", "い", "や", "など", "なっ", "ない", "この", "ため",
+		"その", "あっ", "よう", "また", "もの", "あり", "まで", "られ", "なる", "これ",
+		"おり", "より", "なり", "なく", "だっ", "それ", "ので", "なお", "のみ", "でき",
+		"いう", "でも", "たり", "たち", "ます", "なら", "せる", "とき", "では", "にて",
+		"ほか", "うち", "ただ", "ほど", "です", "とも", "ここ",
+	},
+	"ko": {
+		"이", "그", "저", "것", "수", "등", "및", "더", "또", "즉", "곧", "좀", "잘", "때",
+		"또는", "그것", "이것", "저것", "우리", "너희", "그들", "여기", "거기", "저기",
+		"어느", "무슨", "아니", "있다", "없다", "하다", "되다", "이다",
+	},
+	"zh": {
+		"的", "了", "和", "是", "就", "都", "而", "及", "与", "着", "或", "在", "也", "很",
+		"把", "被", "让", "给", "从", "向", "对", "将", "这", "那", "之", "其", "此", "但",
+		"并", "又", "以", "于", "为", "吗", "呢", "吧", "啊", "一个", "一些", "我们", "你们",
+		"他们", "她们", "它们", "这个", "那个", "这些", "那些", "因为", "所以", "但是",
+		"而且", "如果", "虽然", "然后", "或者", "还是", "以及", "可以", "没有", "什么",
+	},
+}
+
+// setupStopwords defines a stop words filter in the bleve cache for every list in customStops.
+func setupStopwords() {
+	for lang, words := range customStops {
+		tokens := make([]interface{}, 0, len(words))
+		for _, word := range words {
+			tokens = append(tokens, word)
+		}
+		mapName := "stop_map_" + lang
+		_, err := bleveCache.DefineTokenMap(mapName,
+			map[string]interface{}{
+				"type":   tokenmap.Name,
+				"tokens": tokens,
+			})
+		x.Check(err)
+		_, err = bleveCache.DefineTokenFilter(cjkStops[lang],
+			map[string]interface{}{
+				"type":           stop.Name,
+				"stop_token_map": mapName,
+			})
+		x.Check(err)
+	}
+}
+
 // filterStopwords filters stop words using an existing filter, imported here.
 // If the lang filter is found, the we will forward requests to it.
 // Returns filtered tokens if filter is found, otherwise returns tokens unmodified.
 func filterStopwords(lang string, input analysis.TokenStream) analysis.TokenStream {
+	return filterStopwordsWith(langStops, lang, input)
+}
+
+// filterCJKStopwords filters the stop words of the CJK languages.
+func filterCJKStopwords(lang string, input analysis.TokenStream) analysis.TokenStream {
+	return filterStopwordsWith(cjkStops, lang, input)
+}
+
+func filterStopwordsWith(stops map[string]string, lang string,
+	input analysis.TokenStream) analysis.TokenStream {
 	if len(input) == 0 {
 		return input
 	}
 	// check if we have stop words filter for this lang.
-	name, ok := langStops[lang]
+	name, ok := stops[lang]
 	if !ok {
 		return input
 	}
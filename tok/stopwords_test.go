@@ -116,3 +116,19 @@ func TestFilterStopwords(t *testing.T) {
 		require.Equal(t, tc.out, out)
 	}
 }
+
+func TestFilterCJKStopwords(t *testing.T) {
+	in := analysis.TokenStream{
+		&analysis.Token{Term: []byte("我们")},
+		&analysis.Token{Term: []byte("们的")},
+		&analysis.Token{Term: []byte("的")},
+		&analysis.Token{Term: []byte("商人")},
+	}
+	out := analysis.TokenStream{
+		&analysis.Token{Term: []byte("们的")},
+		&analysis.Token{Term: []byte("商人")},
+	}
+	// The stop words of the CJK languages are only filtered by the fulltext_v2 tokenizer.
+	require.Equal(t, in, filterStopwords("zh", in))
+	require.Equal(t, out, filterCJKStopwords("zh", in))
+}
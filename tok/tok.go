@@ -37,24 +37,30 @@ import (
 // The range 0x80 - 0xff is for custom tokenizers.
 // TODO: use these everywhere where we must ensure a system tokenizer.
 const (
-	IdentNone      = 0x0
-	IdentTerm      = 0x1
-	IdentExact     = 0x2
-	IdentExactLang = 0x3
-	IdentYear      = 0x4
-	IdentMonth     = 0x41
-	IdentDay       = 0x42
-	IdentHour      = 0x43
-	IdentGeo       = 0x5
-	IdentInt       = 0x6
-	IdentFloat     = 0x7
-	IdentFullText  = 0x8
-	IdentBool      = 0x9
-	IdentTrigram   = 0xA
-	IdentHash      = 0xB
-	IdentSha       = 0xC
-	IdentCustom    = 0x80
-	IdentDelimiter = 0x1f // ASCII 31 - Unit seperator
+	IdentNone       = 0x0
+	IdentTerm       = 0x1
+	IdentExact      = 0x2
+	IdentExactLang  = 0x3
+	IdentYear       = 0x4
+	IdentMonth      = 0x41
+	IdentDay        = 0x42
+	IdentHour       = 0x43
+	IdentGeo        = 0x5
+	IdentInt        = 0x6
+	IdentFloat      = 0x7
+	IdentFullText   = 0x8
+	IdentBool       = 0x9
+	IdentTrigram    = 0xA
+	IdentHash       = 0xB
+	IdentSha        = 0xC
+	IdentSoundex    = 0xD
+	IdentMetaphone  = 0xE
+	IdentDecimal    = 0xF
+	IdentInterval   = 0x10
+	IdentJSONPath   = 0x11
+	IdentFullTextV2 = 0x12
+	IdentCustom     = 0x80
+	IdentDelimiter  = 0x1f // ASCII 31 - Unit seperator
 )
 
 // Tokenizer defines what a tokenizer must provide.
@@ -90,6 +96,8 @@ func init() {
 	registerTokenizer(GeoTokenizer{})
 	registerTokenizer(IntTokenizer{})
 	registerTokenizer(FloatTokenizer{})
+	registerTokenizer(DecimalTokenizer{})
+	registerTokenizer(IntervalTokenizer{})
 	registerTokenizer(YearTokenizer{})
 	registerTokenizer(HourTokenizer{})
 	registerTokenizer(MonthTokenizer{})
@@ -100,7 +108,10 @@ func init() {
 	registerTokenizer(HashTokenizer{})
 	registerTokenizer(TermTokenizer{})
 	registerTokenizer(FullTextTokenizer{})
+	registerTokenizer(FullTextV2Tokenizer{})
 	registerTokenizer(Sha256Tokenizer{})
+	registerTokenizer(SoundexTokenizer{})
+	registerTokenizer(MetaphoneTokenizer{})
 	setupBleve()
 }
 
@@ -151,6 +162,10 @@ func GetTokenizerByID(id byte) (Tokenizer, bool) {
 // GetTokenizer returns tokenizer given unique name.
 func GetTokenizer(name string) (Tokenizer, bool) {
 	t, found := tokenizers[name]
+	if !found {
+		// The jsonpath tokenizers are created for their path.
+		return getJSONPathTokenizer(name)
+	}
 	return t, found
 }
 
@@ -187,6 +202,18 @@ func (t GeoTokenizer) Identifier() byte { return IdentGeo }
 func (t GeoTokenizer) IsSortable() bool { return false }
 func (t GeoTokenizer) IsLossy() bool    { return true }
 
+// IntervalTokenizer generates tokens from time interval data.
+type IntervalTokenizer struct{}
+
+func (t IntervalTokenizer) Name() string { return "interval" }
+func (t IntervalTokenizer) Type() string { return "interval" }
+func (t IntervalTokenizer) Tokens(v interface{}) ([]string, error) {
+	return types.IndexIntervalTokens(v.(types.Interval)), nil
+}
+func (t IntervalTokenizer) Identifier() byte { return IdentInterval }
+func (t IntervalTokenizer) IsSortable() bool { return false }
+func (t IntervalTokenizer) IsLossy() bool    { return true }
+
 // IntTokenizer generates tokens from integer data.
 type IntTokenizer struct{}
 
@@ -378,6 +405,32 @@ func (t FullTextTokenizer) Identifier() byte { return IdentFullText }
 func (t FullTextTokenizer) IsSortable() bool { return false }
 func (t FullTextTokenizer) IsLossy() bool    { return true }
 
+// FullTextV2Tokenizer generates full-text tokens like FullTextTokenizer, except for CJK text,
+// which is indexed as bigrams with its stop words filtered out. It has its own identifier, so
+// that the indexes built by FullTextTokenizer keep working.
+type FullTextV2Tokenizer struct{ lang string }
+
+func (t FullTextV2Tokenizer) Name() string { return "fulltext_v2" }
+func (t FullTextV2Tokenizer) Type() string { return "string" }
+func (t FullTextV2Tokenizer) Tokens(v interface{}) ([]string, error) {
+	lang := LangBase(t.lang)
+	if !isCJKLang(lang) {
+		return FullTextTokenizer{lang: t.lang}.Tokens(v)
+	}
+	str, ok := v.(string)
+	if !ok || str == "" {
+		return []string{}, nil
+	}
+	// CJK text has no spaces between words, so we index bigrams and filter stop words
+	// afterwards. There are no stemmers for these languages.
+	tokens := analyzeCJK([]byte(str))
+	tokens = filterCJKStopwords(lang, tokens)
+	return uniqueTerms(tokens), nil
+}
+func (t FullTextV2Tokenizer) Identifier() byte { return IdentFullTextV2 }
+func (t FullTextV2Tokenizer) IsSortable() bool { return false }
+func (t FullTextV2Tokenizer) IsLossy() bool    { return true }
+
 // Sha256Tokenizer generates tokens for the sha256 hash part from string data.
 type Sha256Tokenizer struct{ text string }
 
@@ -506,6 +559,13 @@ func EncodeGeoTokens(tokens []string) {
 	}
 }
 
+// EncodeIntervalTokens encodes the given list of tokens as interval tokens.
+func EncodeIntervalTokens(tokens []string) {
+	for i := 0; i < len(tokens); i++ {
+		tokens[i] = encodeToken(tokens[i], IntervalTokenizer{}.Identifier())
+	}
+}
+
 // EncodeRegexTokens encodes the given list of strings as regex tokens.
 func EncodeRegexTokens(tokens []string) {
 	for i := 0; i < len(tokens); i++ {
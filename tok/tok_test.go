@@ -23,6 +23,8 @@ import (
 	"time"
 
 	"github.com/stretchr/testify/require"
+
+	"github.com/dgraph-io/dgraph/types"
 )
 
 type encL struct {
@@ -61,6 +63,51 @@ func TestIntEncoding(t *testing.T) {
 	}
 }
 
+func TestDecimalEncoding(t *testing.T) {
+	// The decimals are in increasing order.
+	decimals := []string{"-1e20", "-123.45", "-12.5", "-12", "-1.05", "-1", "-0.5", "-0.05",
+		"0", "0.000001", "0.05", "0.5", "1", "1.05", "1.5", "12", "12.5", "100", "123.45", "1e20"}
+	var prev string
+	for i, s := range decimals {
+		d, err := types.ParseDecimal(s)
+		require.NoError(t, err)
+		encoded := encodeDecimal(d)
+		if i > 0 {
+			require.True(t, prev < encoded, "%s %v vs %s %v", decimals[i-1], []byte(prev), s,
+				[]byte(encoded))
+		}
+		prev = encoded
+	}
+
+	a, err := types.ParseDecimal("1.5")
+	require.NoError(t, err)
+	b, err := types.ParseDecimal("1.500")
+	require.NoError(t, err)
+	require.Equal(t, encodeDecimal(a), encodeDecimal(b))
+}
+
+func TestJSONPathTokenizer(t *testing.T) {
+	tokenizer, has := GetTokenizer(`jsonpath("$['status']")`)
+	require.True(t, has)
+	require.Equal(t, `jsonpath("$.status")`, tokenizer.Name())
+	require.Equal(t, "json", tokenizer.Type())
+
+	tokens, err := BuildTokens(`{"status":"active"}`, tokenizer)
+	require.NoError(t, err)
+	require.Equal(t, []string{string(IdentJSONPath) + "$.status\x00sactive"}, tokens)
+	jt := tokenizer.(JSONPathTokenizer)
+	require.Contains(t, jt.QueryTokens("active"), tokens[0])
+
+	tokens, err = BuildTokens(`{"other":"active"}`, tokenizer)
+	require.NoError(t, err)
+	require.Empty(t, tokens)
+
+	for _, name := range []string{"jsonpath", `jsonpath("status")`, `jsonpath($.status)`} {
+		_, has := GetTokenizer(name)
+		require.False(t, has, name)
+	}
+}
+
 func TestFullTextTokenizer(t *testing.T) {
 	tokenizer, has := GetTokenizer("fulltext")
 	require.True(t, has)
@@ -297,6 +344,114 @@ func TestFullTextTokenizerCJKJapanese(t *testing.T) {
 	checkSortedAndUnique(t, got)
 }
 
+func TestFullTextV2TokenizerCJKChinese(t *testing.T) {
+	tokenizer, has := GetTokenizer("fulltext_v2")
+	require.True(t, has)
+	require.NotNil(t, tokenizer)
+
+	got, err := BuildTokens("他是一个薪水很高的商人", GetTokenizerForLang(tokenizer, "zh"))
+	require.NoError(t, err)
+
+	id := tokenizer.Identifier()
+	wantToks := []string{
+		encodeToken("个薪", id),
+		encodeToken("他是", id),
+		encodeToken("商人", id),
+		encodeToken("很高", id),
+		encodeToken("是一", id),
+		encodeToken("水很", id),
+		encodeToken("的商", id),
+		encodeToken("薪水", id),
+		encodeToken("高的", id),
+	}
+	require.Equal(t, wantToks, got)
+	checkSortedAndUnique(t, got)
+}
+
+func TestFullTextV2TokenizerCJKKorean(t *testing.T) {
+	tokenizer, has := GetTokenizer("fulltext_v2")
+	require.True(t, has)
+	require.NotNil(t, tokenizer)
+
+	got, err := BuildTokens("그는 큰 급여를 가진 사업가입니다.", GetTokenizerForLang(tokenizer, "ko"))
+	require.NoError(t, err)
+
+	id := tokenizer.Identifier()
+	wantToks := []string{
+		encodeToken("가입", id),
+		encodeToken("가진", id),
+		encodeToken("그는", id),
+		encodeToken("급여", id),
+		encodeToken("니다", id),
+		encodeToken("사업", id),
+		encodeToken("업가", id),
+		encodeToken("여를", id),
+		encodeToken("입니", id),
+		encodeToken("큰", id),
+	}
+	require.Equal(t, wantToks, got)
+	checkSortedAndUnique(t, got)
+}
+
+func TestFullTextV2TokenizerCJKJapanese(t *testing.T) {
+	tokenizer, has := GetTokenizer("fulltext_v2")
+	require.True(t, has)
+	require.NotNil(t, tokenizer)
+
+	got, err := BuildTokens("彼は大きな給与を持つ実業家です", GetTokenizerForLang(tokenizer, "ja"))
+	require.NoError(t, err)
+
+	id := tokenizer.Identifier()
+	wantToks := []string{
+		encodeToken("きな", id),
+		encodeToken("つ実", id),
+		encodeToken("な給", id),
+		encodeToken("は大", id),
+		encodeToken("を持", id),
+		encodeToken("与を", id),
+		encodeToken("大き", id),
+		encodeToken("実業", id),
+		encodeToken("家で", id),
+		encodeToken("彼は", id),
+		encodeToken("持つ", id),
+		encodeToken("業家", id),
+		encodeToken("給与", id),
+	}
+	require.Equal(t, wantToks, got)
+	checkSortedAndUnique(t, got)
+}
+
+func TestFullTextV2TokenizerCJKWidth(t *testing.T) {
+	tokenizer, has := GetTokenizer("fulltext_v2")
+	require.True(t, has)
+	require.NotNil(t, tokenizer)
+
+	// Half-width katakana and full-width latin letters are folded before building bigrams.
+	got, err := BuildTokens("ｶﾀｶﾅ ＤＧＲＡＰＨ", GetTokenizerForLang(tokenizer, "ja"))
+	require.NoError(t, err)
+
+	id := tokenizer.Identifier()
+	wantToks := []string{
+		encodeToken("dgraph", id),
+		encodeToken("カタ", id),
+		encodeToken("カナ", id),
+		encodeToken("タカ", id),
+	}
+	require.Equal(t, wantToks, got)
+	checkSortedAndUnique(t, got)
+}
+
+func TestFullTextV2TokenizerCJKSingleChar(t *testing.T) {
+	tokenizer, has := GetTokenizer("fulltext_v2")
+	require.True(t, has)
+	require.NotNil(t, tokenizer)
+
+	// A query for a single character must produce the same token as the indexed value.
+	got, err := BuildTokens("猫", GetTokenizerForLang(tokenizer, "zh"))
+	require.NoError(t, err)
+	require.Equal(t, []string{encodeToken("猫", tokenizer.Identifier())}, got)
+}
+
 func TestTermTokenizeCJKChinese(t *testing.T) {
 	tokenizer, ok := GetTokenizer("term")
 	require.True(t, ok)
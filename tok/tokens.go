@@ -36,6 +36,8 @@ func GetTokenizerForLang(t Tokenizer, lang string) Tokenizer {
 		// We must return a new instance because another goroutine might be calling this
 		// with a different lang.
 		return FullTextTokenizer{lang: lang}
+	case FullTextV2Tokenizer:
+		return FullTextV2Tokenizer{lang: lang}
 	case TermTokenizer:
 		return TermTokenizer{lang: lang}
 	case ExactTokenizer:
@@ -77,3 +79,11 @@ func GetFullTextTokens(funcArgs []string, lang string) ([]string, error) {
 	}
 	return BuildTokens(funcArgs[0], FullTextTokenizer{lang: lang})
 }
+
+// GetFullTextV2Tokens returns the tokens of the fulltext_v2 tokenizer for the given value.
+func GetFullTextV2Tokens(funcArgs []string, lang string) ([]string, error) {
+	if l := len(funcArgs); l != 1 {
+		return nil, errors.Errorf("Function requires 1 arguments, but got %d", l)
+	}
+	return BuildTokens(funcArgs[0], FullTextV2Tokenizer{lang: lang})
+}
@@ -28,6 +28,15 @@ func CompareVals(op string, arg1, arg2 Val) bool {
 	noError := func(b bool, e error) bool {
 		return b && e == nil
 	}
+	if arg1.Tid != arg2.Tid && (arg1.Tid == DecimalID || arg2.Tid == DecimalID) {
+		// A decimal can be compared exactly with an int or a float converted to a decimal.
+		d1, err1 := ToDecimal(arg1)
+		d2, err2 := ToDecimal(arg2)
+		if err1 != nil || err2 != nil {
+			return false
+		}
+		arg1, arg2 = Val{Tid: DecimalID, Value: d1}, Val{Tid: DecimalID, Value: d2}
+	}
 	switch op {
 	case "ge":
 		return noError(negateRes(Less(arg1, arg2)))
@@ -22,6 +22,7 @@ import (
 	"encoding/json"
 	"math"
 	"strconv"
+	"strings"
 	"time"
 	"unsafe"
 
@@ -91,6 +92,25 @@ func Convert(from Val, toID TypeID) (Val, error) {
 				*res = w
 			case PasswordID:
 				*res = string(data)
+			case DecimalID:
+				var d Decimal
+				if err := d.UnmarshalBinary(data); err != nil {
+					return to, err
+				}
+				*res = d
+			case DurationID:
+				if len(data) < 8 {
+					return to, errors.Errorf("Invalid data for duration %v", data)
+				}
+				*res = time.Duration(binary.LittleEndian.Uint64(data))
+			case IntervalID:
+				var i Interval
+				if err := i.UnmarshalBinary(data); err != nil {
+					return to, err
+				}
+				*res = i
+			case JSONID:
+				*res = string(data)
 			default:
 				return to, cantConvert(fromID, toID)
 			}
@@ -131,13 +151,35 @@ func Convert(from Val, toID TypeID) (Val, error) {
 				}
 				*res = t
 			case GeoID:
-				var g geom.T
-				text := bytes.Replace([]byte(vc), []byte("'"), []byte("\""), -1)
-				if err := geojson.Unmarshal(text, &g); err != nil {
-					return to,
-						errors.Wrapf(err, "Error while unmarshalling: [%s] as geojson", vc)
+				g, err := parseGeo(vc)
+				if err != nil {
+					return to, err
 				}
 				*res = g
+			case DecimalID:
+				d, err := ParseDecimal(strings.TrimSpace(vc))
+				if err != nil {
+					return to, err
+				}
+				*res = d
+			case DurationID:
+				d, err := ParseDuration(vc)
+				if err != nil {
+					return to, err
+				}
+				*res = d
+			case IntervalID:
+				i, err := ParseInterval(vc)
+				if err != nil {
+					return to, err
+				}
+				*res = i
+			case JSONID:
+				j, err := ParseJSON(vc)
+				if err != nil {
+					return to, err
+				}
+				*res = j
 			case PasswordID:
 				p, err := Encrypt(vc)
 				if err != nil {
@@ -169,6 +211,8 @@ func Convert(from Val, toID TypeID) (Val, error) {
 				*res = strconv.FormatInt(vc, 10)
 			case DateTimeID:
 				*res = time.Unix(vc, 0).UTC()
+			case DecimalID:
+				*res = DecimalFromInt(vc)
 			default:
 				return to, cantConvert(fromID, toID)
 			}
@@ -202,6 +246,12 @@ func Convert(from Val, toID TypeID) (Val, error) {
 				fracSecs := vc - float64(secs)
 				nsecs := int64(fracSecs * nanoSecondsInSec)
 				*res = time.Unix(secs, nsecs).UTC()
+			case DecimalID:
+				d, err := DecimalFromFloat(vc)
+				if err != nil {
+					return to, err
+				}
+				*res = d
 			default:
 				return to, cantConvert(fromID, toID)
 			}
@@ -304,6 +354,79 @@ func Convert(from Val, toID TypeID) (Val, error) {
 				return to, cantConvert(fromID, toID)
 			}
 		}
+	case DecimalID:
+		{
+			var vc Decimal
+			if err := vc.UnmarshalBinary(data); err != nil {
+				return to, err
+			}
+			switch toID {
+			case DecimalID:
+				*res = vc
+			case BinaryID:
+				*res = data
+			case IntID:
+				i, err := vc.Int64()
+				if err != nil {
+					return to, err
+				}
+				*res = i
+			case FloatID:
+				*res = vc.Float64()
+			case BoolID:
+				*res = vc.Sign() != 0
+			case StringID, DefaultID:
+				*res = vc.String()
+			default:
+				return to, cantConvert(fromID, toID)
+			}
+		}
+	case DurationID:
+		{
+			if len(data) < 8 {
+				return to, errors.Errorf("Invalid data for duration %v", data)
+			}
+			vc := time.Duration(binary.LittleEndian.Uint64(data))
+			switch toID {
+			case DurationID:
+				*res = vc
+			case BinaryID:
+				*res = data
+			case StringID, DefaultID:
+				*res = FormatDuration(vc)
+			default:
+				return to, cantConvert(fromID, toID)
+			}
+		}
+	case IntervalID:
+		{
+			var vc Interval
+			if err := vc.UnmarshalBinary(data); err != nil {
+				return to, err
+			}
+			switch toID {
+			case IntervalID:
+				*res = vc
+			case BinaryID:
+				*res = data
+			case StringID, DefaultID:
+				*res = vc.String()
+			default:
+				return to, cantConvert(fromID, toID)
+			}
+		}
+	case JSONID:
+		{
+			vc := string(data)
+			switch toID {
+			case JSONID, StringID, DefaultID:
+				*res = vc
+			case BinaryID:
+				*res = data
+			default:
+				return to, cantConvert(fromID, toID)
+			}
+		}
 	default:
 		return to, cantConvert(fromID, toID)
 	}
@@ -435,6 +558,56 @@ func Marshal(from Val, to *Val) error {
 		default:
 			return cantConvert(fromID, toID)
 		}
+	case DecimalID:
+		vc := val.(Decimal)
+		switch toID {
+		case StringID, DefaultID:
+			*res = vc.String()
+		case BinaryID:
+			r, err := vc.MarshalBinary()
+			if err != nil {
+				return err
+			}
+			*res = r
+		default:
+			return cantConvert(fromID, toID)
+		}
+	case DurationID:
+		vc := val.(time.Duration)
+		switch toID {
+		case StringID, DefaultID:
+			*res = FormatDuration(vc)
+		case BinaryID:
+			var bs [8]byte
+			binary.LittleEndian.PutUint64(bs[:], uint64(vc))
+			*res = bs[:]
+		default:
+			return cantConvert(fromID, toID)
+		}
+	case IntervalID:
+		vc := val.(Interval)
+		switch toID {
+		case StringID, DefaultID:
+			*res = vc.String()
+		case BinaryID:
+			r, err := vc.MarshalBinary()
+			if err != nil {
+				return err
+			}
+			*res = r
+		default:
+			return cantConvert(fromID, toID)
+		}
+	case JSONID:
+		vc := val.(string)
+		switch toID {
+		case StringID, DefaultID:
+			*res = vc
+		case BinaryID:
+			*res = []byte(vc)
+		default:
+			return cantConvert(fromID, toID)
+		}
 	default:
 		return cantConvert(fromID, toID)
 	}
@@ -503,6 +676,31 @@ func ObjectValue(id TypeID, value interface{}) (*api.Value, error) {
 			return def, errors.Errorf("Expected value of type password. Got : %v", value)
 		}
 		return &api.Value{Val: &api.Value_PasswordVal{PasswordVal: v}}, nil
+	case DecimalID:
+		// There is no decimal value in the API, the string is converted back by the schema.
+		var v Decimal
+		if v, ok = value.(Decimal); !ok {
+			return def, errors.Errorf("Expected value of type decimal. Got : %v", value)
+		}
+		return &api.Value{Val: &api.Value_StrVal{StrVal: v.String()}}, nil
+	case DurationID:
+		var v time.Duration
+		if v, ok = value.(time.Duration); !ok {
+			return def, errors.Errorf("Expected value of type duration. Got : %v", value)
+		}
+		return &api.Value{Val: &api.Value_StrVal{StrVal: FormatDuration(v)}}, nil
+	case IntervalID:
+		var v Interval
+		if v, ok = value.(Interval); !ok {
+			return def, errors.Errorf("Expected value of type interval. Got : %v", value)
+		}
+		return &api.Value{Val: &api.Value_StrVal{StrVal: v.String()}}, nil
+	case JSONID:
+		var v string
+		if v, ok = value.(string); !ok {
+			return def, errors.Errorf("Expected value of type json. Got : %v", value)
+		}
+		return &api.Value{Val: &api.Value_StrVal{StrVal: v}}, nil
 	default:
 		return def, errors.Errorf("ObjectValue not available for: %v", id)
 	}
@@ -537,6 +735,16 @@ func (v Val) MarshalJSON() ([]byte, error) {
 		return json.Marshal(v.Safe().(string))
 	case PasswordID:
 		return json.Marshal(v.Value.(string))
+	case DecimalID:
+		// Decimals are encoded as strings, so that clients don't parse them as floats.
+		return json.Marshal(v.Value.(Decimal).String())
+	case DurationID:
+		return json.Marshal(FormatDuration(v.Value.(time.Duration)))
+	case IntervalID:
+		return json.Marshal(v.Value.(Interval).String())
+	case JSONID:
+		// The document is stored as valid JSON, so it's embedded as it is.
+		return []byte(v.Value.(string)), nil
 	}
 	return nil, errors.Errorf("Invalid type for MarshalJSON: %v", v.Tid)
 }
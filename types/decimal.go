@@ -0,0 +1,339 @@
+/*
+ * Copyright 2022 Dgraph Labs, Inc. and Contributors
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package types
+
+import (
+	"encoding/binary"
+	"math"
+	"math/big"
+	"strconv"
+	"strings"
+
+	"github.com/pkg/errors"
+)
+
+const (
+	// DecimalDivisionScale is the number of digits after the decimal point kept in the result of
+	// a division, which is rounded half away from zero.
+	DecimalDivisionScale = 20
+	// maxDecimalExponent limits the exponent of the parsed decimals, so that a short input like
+	// 1e1000000000 can't allocate a huge number.
+	maxDecimalExponent = 10000
+)
+
+var bigTen = big.NewInt(10)
+
+// Decimal is an arbitrary-precision decimal number, equal to unscaled * 10^-scale. The scale is
+// never negative, and it is kept by the arithmetic, so 1.50 + 1 is 2.50. The zero value is 0.
+type Decimal struct {
+	unscaled *big.Int
+	scale    int32
+}
+
+func newDecimal(unscaled *big.Int, scale int32) Decimal {
+	return Decimal{unscaled: unscaled, scale: scale}
+}
+
+// DecimalFromInt returns the decimal equal to i.
+func DecimalFromInt(i int64) Decimal {
+	return newDecimal(big.NewInt(i), 0)
+}
+
+// DecimalFromFloat returns the decimal with the shortest representation that converts back to f.
+func DecimalFromFloat(f float64) (Decimal, error) {
+	if math.IsNaN(f) || math.IsInf(f, 0) {
+		return Decimal{}, errors.Errorf("Cannot convert %v to decimal", f)
+	}
+	return ParseDecimal(strconv.FormatFloat(f, 'f', -1, 64))
+}
+
+// ParseDecimal parses a decimal like 12, -0.015 or 1.5e3.
+func ParseDecimal(s string) (Decimal, error) {
+	invalid := errors.Errorf("Invalid decimal: %q", s)
+	mantissa, exp := s, int64(0)
+	if i := strings.IndexAny(s, "eE"); i >= 0 {
+		var err error
+		if exp, err = strconv.ParseInt(s[i+1:], 10, 32); err != nil {
+			return Decimal{}, invalid
+		}
+		if exp > maxDecimalExponent || exp < -maxDecimalExponent {
+			return Decimal{}, errors.Errorf("Exponent of decimal %q is out of range", s)
+		}
+		mantissa = s[:i]
+	}
+
+	var neg bool
+	if len(mantissa) > 0 && (mantissa[0] == '-' || mantissa[0] == '+') {
+		neg = mantissa[0] == '-'
+		mantissa = mantissa[1:]
+	}
+	intPart, fracPart := mantissa, ""
+	if i := strings.IndexByte(mantissa, '.'); i >= 0 {
+		intPart, fracPart = mantissa[:i], mantissa[i+1:]
+	}
+	digits := intPart + fracPart
+	if digits == "" {
+		return Decimal{}, invalid
+	}
+	for i := 0; i < len(digits); i++ {
+		if digits[i] < '0' || digits[i] > '9' {
+			return Decimal{}, invalid
+		}
+	}
+
+	unscaled, _ := new(big.Int).SetString(digits, 10)
+	if neg {
+		unscaled.Neg(unscaled)
+	}
+	scale := int64(len(fracPart)) - exp
+	if scale < 0 {
+		unscaled.Mul(unscaled, pow10(-scale))
+		scale = 0
+	}
+	if scale > math.MaxInt32 {
+		return Decimal{}, errors.Errorf("Scale of decimal %q is out of range", s)
+	}
+	return newDecimal(unscaled, int32(scale)), nil
+}
+
+func pow10(n int64) *big.Int {
+	return new(big.Int).Exp(bigTen, big.NewInt(n), nil)
+}
+
+func (d Decimal) int() *big.Int {
+	if d.unscaled == nil {
+		return new(big.Int)
+	}
+	return d.unscaled
+}
+
+// rescale returns the unscaled value of d for the given scale, which must not be smaller than
+// the scale of d.
+func (d Decimal) rescale(scale int32) *big.Int {
+	if scale == d.scale {
+		return d.int()
+	}
+	return new(big.Int).Mul(d.int(), pow10(int64(scale-d.scale)))
+}
+
+func maxScale(a, b Decimal) int32 {
+	if a.scale > b.scale {
+		return a.scale
+	}
+	return b.scale
+}
+
+// Sign returns -1, 0 or 1 depending on the sign of d.
+func (d Decimal) Sign() int {
+	return d.int().Sign()
+}
+
+// Cmp returns -1, 0 or 1 if d is respectively less than, equal to or greater than o.
+func (d Decimal) Cmp(o Decimal) int {
+	scale := maxScale(d, o)
+	return d.rescale(scale).Cmp(o.rescale(scale))
+}
+
+// Add returns d + o.
+func (d Decimal) Add(o Decimal) Decimal {
+	scale := maxScale(d, o)
+	return newDecimal(new(big.Int).Add(d.rescale(scale), o.rescale(scale)), scale)
+}
+
+// Sub returns d - o.
+func (d Decimal) Sub(o Decimal) Decimal {
+	scale := maxScale(d, o)
+	return newDecimal(new(big.Int).Sub(d.rescale(scale), o.rescale(scale)), scale)
+}
+
+// Mul returns d * o.
+func (d Decimal) Mul(o Decimal) Decimal {
+	return newDecimal(new(big.Int).Mul(d.int(), o.int()), d.scale+o.scale)
+}
+
+// Neg returns -d.
+func (d Decimal) Neg() Decimal {
+	return newDecimal(new(big.Int).Neg(d.int()), d.scale)
+}
+
+// Quo returns d / o with DecimalDivisionScale digits after the decimal point. Trailing zeros
+// are removed from the result, as long as it keeps the scale of the operands.
+func (d Decimal) Quo(o Decimal) (Decimal, error) {
+	if o.Sign() == 0 {
+		return Decimal{}, errors.New("Division by zero")
+	}
+	scale := int32(DecimalDivisionScale)
+	if s := maxScale(d, o); s > scale {
+		scale = s
+	}
+	// d / o = (ud / uo) * 10^(so - sd), so the result for the scale is
+	// ud * 10^(scale - sd + so) / uo.
+	num := new(big.Int).Set(d.int())
+	den := new(big.Int).Set(o.int())
+	if e := int64(scale) - int64(d.scale) + int64(o.scale); e >= 0 {
+		num.Mul(num, pow10(e))
+	} else {
+		den.Mul(den, pow10(-e))
+	}
+	q, r := new(big.Int).QuoRem(num, den, new(big.Int))
+	// The quotient is truncated towards zero, round it half away from zero.
+	r.Lsh(r.Abs(r), 1)
+	if r.Cmp(den.Abs(den)) >= 0 {
+		if num.Sign()*o.Sign() < 0 {
+			q.Sub(q, big.NewInt(1))
+		} else {
+			q.Add(q, big.NewInt(1))
+		}
+	}
+	return newDecimal(q, scale).trim(maxScale(d, o)), nil
+}
+
+// Rem returns the remainder of the truncated division d / o, which has the sign of d.
+func (d Decimal) Rem(o Decimal) (Decimal, error) {
+	if o.Sign() == 0 {
+		return Decimal{}, errors.New("Division by zero")
+	}
+	scale := maxScale(d, o)
+	return newDecimal(new(big.Int).Rem(d.rescale(scale), o.rescale(scale)), scale), nil
+}
+
+// Floor returns the greatest integer that is not greater than d.
+func (d Decimal) Floor() Decimal {
+	q, r := d.quoRemScale()
+	if r.Sign() < 0 {
+		q.Sub(q, big.NewInt(1))
+	}
+	return newDecimal(q, 0)
+}
+
+// Ceil returns the least integer that is not less than d.
+func (d Decimal) Ceil() Decimal {
+	q, r := d.quoRemScale()
+	if r.Sign() > 0 {
+		q.Add(q, big.NewInt(1))
+	}
+	return newDecimal(q, 0)
+}
+
+// quoRemScale returns the integer part of d, truncated towards zero, and the remainder.
+func (d Decimal) quoRemScale() (*big.Int, *big.Int) {
+	return new(big.Int).QuoRem(d.int(), pow10(int64(d.scale)), new(big.Int))
+}
+
+// trim removes the trailing zeros after the decimal point, keeping at least minScale digits.
+func (d Decimal) trim(minScale int32) Decimal {
+	u, scale := d.int(), d.scale
+	for scale > minScale {
+		q, r := new(big.Int).QuoRem(u, bigTen, new(big.Int))
+		if r.Sign() != 0 {
+			break
+		}
+		u, scale = q, scale-1
+	}
+	return newDecimal(u, scale)
+}
+
+// Float64 returns the float closest to d.
+func (d Decimal) Float64() float64 {
+	f, _ := strconv.ParseFloat(d.String(), 64)
+	return f
+}
+
+// Int64 returns d truncated towards zero, or an error if it doesn't fit in an int64.
+func (d Decimal) Int64() (int64, error) {
+	q, _ := d.quoRemScale()
+	if !q.IsInt64() {
+		return 0, errors.Errorf("Decimal %s out of int64 range", d)
+	}
+	return q.Int64(), nil
+}
+
+// String returns d with all the digits of its scale, like -12.50.
+func (d Decimal) String() string {
+	digits := new(big.Int).Abs(d.int()).String()
+	var sb strings.Builder
+	if d.Sign() < 0 {
+		sb.WriteByte('-')
+	}
+	scale := int(d.scale)
+	if scale == 0 {
+		sb.WriteString(digits)
+		return sb.String()
+	}
+	if len(digits) <= scale {
+		digits = strings.Repeat("0", scale-len(digits)+1) + digits
+	}
+	sb.WriteString(digits[:len(digits)-scale])
+	sb.WriteByte('.')
+	sb.WriteString(digits[len(digits)-scale:])
+	return sb.String()
+}
+
+// Normalized returns the sign of d, its significant digits without the leading and trailing
+// zeros, and its exponent, such that d is equal to sign * 0.digits * 10^exponent. The
+// significant digits of zero are empty.
+func (d Decimal) Normalized() (sign int, digits string, exponent int) {
+	sign = d.Sign()
+	if sign == 0 {
+		return 0, "", 0
+	}
+	digits = new(big.Int).Abs(d.int()).String()
+	exponent = len(digits) - int(d.scale)
+	return sign, strings.TrimRight(digits, "0"), exponent
+}
+
+// MarshalBinary encodes d as its scale, followed by its unscaled value as a sign byte and the
+// big-endian bytes of its absolute value.
+func (d Decimal) MarshalBinary() ([]byte, error) {
+	abs := new(big.Int).Abs(d.int()).Bytes()
+	b := make([]byte, 5, 5+len(abs))
+	binary.BigEndian.PutUint32(b[0:4], uint32(d.scale))
+	if d.Sign() < 0 {
+		b[4] = 1
+	}
+	return append(b, abs...), nil
+}
+
+// UnmarshalBinary decodes the data encoded by MarshalBinary.
+func (d *Decimal) UnmarshalBinary(data []byte) error {
+	if len(data) < 5 || data[4] > 1 {
+		return errors.Errorf("Invalid data for decimal %v", data)
+	}
+	scale := int32(binary.BigEndian.Uint32(data[0:4]))
+	if scale < 0 {
+		return errors.Errorf("Invalid scale for decimal %d", scale)
+	}
+	u := new(big.Int).SetBytes(data[5:])
+	if data[4] == 1 {
+		u.Neg(u)
+	}
+	*d = newDecimal(u, scale)
+	return nil
+}
+
+// ToDecimal converts an int, float or decimal value to a decimal.
+func ToDecimal(v Val) (Decimal, error) {
+	switch v.Tid {
+	case IntID:
+		return DecimalFromInt(v.Value.(int64)), nil
+	case FloatID:
+		return DecimalFromFloat(v.Value.(float64))
+	case DecimalID:
+		return v.Value.(Decimal), nil
+	}
+	return Decimal{}, errors.Errorf("Cannot convert %s to decimal", v.Tid.Name())
+}
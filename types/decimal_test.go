@@ -0,0 +1,133 @@
+/*
+ * Copyright 2022 Dgraph Labs, Inc. and Contributors
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package types
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func decimal(t *testing.T, s string) Decimal {
+	d, err := ParseDecimal(s)
+	require.NoError(t, err, s)
+	return d
+}
+
+func TestParseDecimal(t *testing.T) {
+	tests := []struct {
+		in  string
+		out string
+	}{
+		{"12", "12"},
+		{"-0.015", "-0.015"},
+		{"+3.10", "3.10"},
+		{".5", "0.5"},
+		{"7.", "7"},
+		{"1.5e3", "1500"},
+		{"1.5E-3", "0.0015"},
+		{"123456789012345678901234567890.123456789", "123456789012345678901234567890.123456789"},
+	}
+	for _, test := range tests {
+		require.Equal(t, test.out, decimal(t, test.in).String(), test.in)
+	}
+
+	for _, in := range []string{"", "-", ".", "1.2.3", "1e", "abc", "1,5", "1e100000"} {
+		_, err := ParseDecimal(in)
+		require.Error(t, err, in)
+	}
+}
+
+func TestDecimalArithmetic(t *testing.T) {
+	a, b := decimal(t, "0.1"), decimal(t, "0.2")
+	require.Equal(t, "0.3", a.Add(b).String())
+	require.Equal(t, "-0.1", a.Sub(b).String())
+	require.Equal(t, "0.02", a.Mul(b).String())
+	require.Equal(t, "-0.1", a.Neg().String())
+	require.Equal(t, "2.50", decimal(t, "1.50").Add(DecimalFromInt(1)).String())
+	require.Equal(t, -1, a.Cmp(b))
+	require.Equal(t, 0, decimal(t, "1.5").Cmp(decimal(t, "1.500")))
+
+	rem, err := decimal(t, "-7.5").Rem(DecimalFromInt(2))
+	require.NoError(t, err)
+	require.Equal(t, "-1.5", rem.String())
+
+	require.Equal(t, "-3", decimal(t, "-2.5").Floor().String())
+	require.Equal(t, "-2", decimal(t, "-2.5").Ceil().String())
+	require.Equal(t, "2", decimal(t, "2.5").Floor().String())
+	require.Equal(t, "3", decimal(t, "2.5").Ceil().String())
+}
+
+func TestDecimalQuo(t *testing.T) {
+	tests := []struct {
+		a, b, out string
+	}{
+		{"1", "4", "0.25"},
+		{"10", "2", "5"},
+		{"1.50", "3", "0.50"},
+		{"1", "3", "0.33333333333333333333"},
+		{"2", "3", "0.66666666666666666667"},
+		{"-2", "3", "-0.66666666666666666667"},
+		{"2", "-3", "-0.66666666666666666667"},
+	}
+	for _, test := range tests {
+		q, err := decimal(t, test.a).Quo(decimal(t, test.b))
+		require.NoError(t, err)
+		require.Equal(t, test.out, q.String(), "%s / %s", test.a, test.b)
+	}
+
+	_, err := DecimalFromInt(1).Quo(decimal(t, "0.00"))
+	require.Error(t, err)
+}
+
+func TestDecimalConversion(t *testing.T) {
+	for _, s := range []string{"0", "-12.50", "123456789012345678901234567890.000001"} {
+		d := decimal(t, s)
+		b, err := d.MarshalBinary()
+		require.NoError(t, err)
+		var out Decimal
+		require.NoError(t, out.UnmarshalBinary(b))
+		require.Equal(t, s, out.String())
+
+		v, err := Convert(Val{Tid: BinaryID, Value: b}, DecimalID)
+		require.NoError(t, err)
+		require.Equal(t, s, v.Value.(Decimal).String())
+	}
+
+	v, err := Convert(Val{Tid: StringID, Value: []byte(" 1.25 ")}, DecimalID)
+	require.NoError(t, err)
+	b := ValueForType(BinaryID)
+	require.NoError(t, Marshal(v, &b))
+	stored := Val{Tid: DecimalID, Value: b.Value}
+	f, err := Convert(stored, FloatID)
+	require.NoError(t, err)
+	require.Equal(t, 1.25, f.Value.(float64))
+	i, err := Convert(stored, IntID)
+	require.NoError(t, err)
+	require.Equal(t, int64(1), i.Value.(int64))
+
+	d, err := DecimalFromFloat(0.1)
+	require.NoError(t, err)
+	require.Equal(t, "0.1", d.String())
+}
+
+func TestDecimalCompareMixed(t *testing.T) {
+	d := Val{Tid: DecimalID, Value: decimal(t, "1.5")}
+	require.True(t, CompareVals("gt", d, Val{Tid: IntID, Value: int64(1)}))
+	require.True(t, CompareVals("lt", d, Val{Tid: FloatID, Value: 1.75}))
+	require.False(t, CompareVals("eq", d, Val{Tid: FloatID, Value: 1.25}))
+}
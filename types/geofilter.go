@@ -54,7 +54,7 @@ type GeoQueryData struct {
 // IsGeoFunc returns if a function is of geo type.
 func IsGeoFunc(str string) bool {
 	switch str {
-	case "near", "contains", "within", "intersects":
+	case "near", "nearest", "contains", "within", "intersects":
 		return true
 	}
 
@@ -288,6 +288,80 @@ func (q GeoQueryData) isWithin(g geom.T) bool {
 			}
 			return true
 		}
+	case *geom.LineString:
+		for _, l := range q.loops {
+			if lineStringWithinLoop(geometry, l) {
+				return true
+			}
+		}
+	case *geom.MultiLineString:
+		// Each line of the multilinestring should be within some loop of q.loops.
+		if len(q.loops) == 0 {
+			return false
+		}
+		for i := 0; i < geometry.NumLineStrings(); i++ {
+			var within bool
+			for _, l := range q.loops {
+				if lineStringWithinLoop(geometry.LineString(i), l) {
+					within = true
+					break
+				}
+			}
+			if !within {
+				return false
+			}
+		}
+		return true
+	case *geom.GeometryCollection:
+		// A collection is within the query if all of its geometries are.
+		if geometry.NumGeoms() == 0 {
+			return false
+		}
+		for i := 0; i < geometry.NumGeoms(); i++ {
+			if !q.isWithin(geometry.Geom(i)) {
+				return false
+			}
+		}
+		return true
+	}
+	return false
+}
+
+// lineStringWithinLoop returns true if all the vertices of the line are inside the loop and none
+// of its edges cross the loop.
+func lineStringWithinLoop(g *geom.LineString, l *s2.Loop) bool {
+	pts, err := pointsFromLineString(g)
+	if err != nil {
+		return false
+	}
+	for _, pt := range pts {
+		if !l.ContainsPoint(pt) {
+			return false
+		}
+	}
+	return !lineStringCrossesLoop(pts, l)
+}
+
+// lineStringIntersectsLoop returns true if a vertex of the line is inside the loop or one of its
+// edges crosses the loop.
+func lineStringIntersectsLoop(g *geom.LineString, l *s2.Loop) bool {
+	pts, err := pointsFromLineString(g)
+	if err != nil {
+		return false
+	}
+	for _, pt := range pts {
+		if l.ContainsPoint(pt) {
+			return true
+		}
+	}
+	return lineStringCrossesLoop(pts, l)
+}
+
+func lineStringCrossesLoop(pts []s2.Point, l *s2.Loop) bool {
+	for i := 0; i < l.NumEdges(); i++ {
+		if polylineCrossesEdge(pts, l.Vertex(i), l.Vertex(i+1)) {
+			return true
+		}
 	}
 	return false
 }
@@ -350,6 +424,14 @@ func (q GeoQueryData) contains(g geom.T) bool {
 			return true
 		}
 
+		return false
+	case *geom.GeometryCollection:
+		// A collection contains the query if one of its geometries does.
+		for i := 0; i < v.NumGeoms(); i++ {
+			if q.contains(v.Geom(i)) {
+				return true
+			}
+		}
 		return false
 	default:
 		// We will only consider polygons for contains queries.
@@ -409,6 +491,30 @@ func (q GeoQueryData) intersects(g geom.T) bool {
 			}
 		}
 		return false
+	case *geom.LineString:
+		for _, loop := range q.loops {
+			if lineStringIntersectsLoop(v, loop) {
+				return true
+			}
+		}
+		return false
+	case *geom.MultiLineString:
+		for i := 0; i < v.NumLineStrings(); i++ {
+			for _, loop := range q.loops {
+				if lineStringIntersectsLoop(v.LineString(i), loop) {
+					return true
+				}
+			}
+		}
+		return false
+	case *geom.GeometryCollection:
+		// A collection intersects the query if one of its geometries does.
+		for i := 0; i < v.NumGeoms(); i++ {
+			if q.intersects(v.Geom(i)) {
+				return true
+			}
+		}
+		return false
 	default:
 		// A type that we don't know how to handle.
 		return false
@@ -386,6 +386,70 @@ func TestMatchesFilterWithinPolygon(t *testing.T) {
 	require.True(t, qd.MatchesFilter(multipoly))
 }
 
+func TestMatchesFilterLineString(t *testing.T) {
+	poly := geom.NewPolygon(geom.XY).MustSetCoords([][]geom.Coord{
+		{{-122, 37}, {-123, 37}, {-123, 38}, {-122, 38}, {-122, 37}},
+	})
+	data := formDataPolygon(t, poly)
+	_, within, err := queryTokens(QueryTypeWithin, data, 0.0)
+	require.NoError(t, err)
+	_, intersects, err := queryTokens(QueryTypeIntersects, data, 0.0)
+	require.NoError(t, err)
+
+	inside := geom.NewLineString(geom.XY).MustSetCoords([]geom.Coord{
+		{-122.2, 37.2}, {-122.8, 37.8}})
+	require.True(t, within.MatchesFilter(inside))
+	require.True(t, intersects.MatchesFilter(inside))
+
+	// The line crosses the polygon without any vertex inside it.
+	crossing := geom.NewLineString(geom.XY).MustSetCoords([]geom.Coord{
+		{-121.5, 37.5}, {-123.5, 37.5}})
+	require.False(t, within.MatchesFilter(crossing))
+	require.True(t, intersects.MatchesFilter(crossing))
+
+	outside := geom.NewLineString(geom.XY).MustSetCoords([]geom.Coord{
+		{-121.5, 37.5}, {-121.5, 38.5}})
+	require.False(t, within.MatchesFilter(outside))
+	require.False(t, intersects.MatchesFilter(outside))
+
+	multi := geom.NewMultiLineString(geom.XY).MustSetCoords([][]geom.Coord{
+		{{-122.2, 37.2}, {-122.8, 37.8}},
+		{{-121.5, 37.5}, {-121.5, 38.5}},
+	})
+	require.False(t, within.MatchesFilter(multi))
+	require.True(t, intersects.MatchesFilter(multi))
+}
+
+func TestMatchesFilterGeometryCollection(t *testing.T) {
+	poly := geom.NewPolygon(geom.XY).MustSetCoords([][]geom.Coord{
+		{{-122, 37}, {-123, 37}, {-123, 38}, {-122, 38}, {-122, 37}},
+	})
+	data := formDataPolygon(t, poly)
+	_, within, err := queryTokens(QueryTypeWithin, data, 0.0)
+	require.NoError(t, err)
+	_, intersects, err := queryTokens(QueryTypeIntersects, data, 0.0)
+	require.NoError(t, err)
+
+	c := geom.NewGeometryCollection()
+	require.NoError(t, c.Push(
+		geom.NewPoint(geom.XY).MustSetCoords(geom.Coord{-122.5, 37.5}),
+		geom.NewLineString(geom.XY).MustSetCoords([]geom.Coord{{-122.2, 37.2}, {-122.8, 37.8}}),
+	))
+	require.True(t, within.MatchesFilter(c))
+	require.True(t, intersects.MatchesFilter(c))
+
+	require.NoError(t, c.Push(geom.NewPoint(geom.XY).MustSetCoords(geom.Coord{-71.1, 42.3})))
+	require.False(t, within.MatchesFilter(c))
+	require.True(t, intersects.MatchesFilter(c))
+
+	p := geom.NewPoint(geom.XY).MustSetCoords(geom.Coord{-122.5, 37.5})
+	_, contains, err := queryTokens(QueryTypeContains, formDataPoint(t, p), 0.0)
+	require.NoError(t, err)
+	require.False(t, contains.MatchesFilter(c))
+	require.NoError(t, c.Push(poly))
+	require.True(t, contains.MatchesFilter(c))
+}
+
 func TestMatchesFilterNearPoint(t *testing.T) {
 	p := geom.NewPoint(geom.XY).MustSetCoords(geom.Coord{-122.082506, 37.4249518})
 	data := formDataPoint(t, p)
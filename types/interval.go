@@ -0,0 +1,265 @@
+/*
+ * Copyright 2022 Dgraph Labs, Inc. and Contributors
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package types
+
+import (
+	"math"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/pkg/errors"
+)
+
+// ParseDuration parses an ISO 8601 duration like P1DT2H30M or PT0.5S, or a Go duration like
+// 1h30m. A day is always 24 hours. Years and months are rejected, since their length depends on
+// the date they are added to.
+func ParseDuration(s string) (time.Duration, error) {
+	s = strings.TrimSpace(s)
+	rest := strings.TrimPrefix(s, "-")
+	if !strings.HasPrefix(rest, "P") {
+		d, err := time.ParseDuration(s)
+		if err != nil {
+			return 0, errors.Errorf("Invalid duration: %q", s)
+		}
+		return d, nil
+	}
+
+	invalid := errors.Errorf("Invalid duration: %q", s)
+	neg := len(rest) < len(s)
+	rest = rest[1:]
+	var total time.Duration
+	var inTime, seen bool
+	for len(rest) > 0 {
+		if rest[0] == 'T' {
+			if inTime || len(rest) == 1 {
+				return 0, invalid
+			}
+			inTime = true
+			rest = rest[1:]
+			continue
+		}
+		i := strings.IndexFunc(rest, func(r rune) bool {
+			return (r < '0' || r > '9') && r != '.' && r != ','
+		})
+		if i <= 0 {
+			return 0, invalid
+		}
+		num, unit := strings.Replace(rest[:i], ",", ".", 1), rest[i]
+		rest = rest[i+1:]
+
+		var size time.Duration
+		switch {
+		case !inTime && unit == 'W':
+			size = 7 * 24 * time.Hour
+		case !inTime && unit == 'D':
+			size = 24 * time.Hour
+		case inTime && unit == 'H':
+			size = time.Hour
+		case inTime && unit == 'M':
+			size = time.Minute
+		case inTime && unit == 'S':
+			size = time.Second
+		case !inTime && (unit == 'Y' || unit == 'M'):
+			return 0, errors.Errorf("Duration %q has years or months, which don't have a fixed "+
+				"length", s)
+		default:
+			return 0, invalid
+		}
+
+		var d time.Duration
+		if strings.Contains(num, ".") {
+			f, err := strconv.ParseFloat(num, 64)
+			if err != nil {
+				return 0, invalid
+			}
+			v := math.Round(f * float64(size))
+			if v >= math.MaxInt64 {
+				return 0, errors.Errorf("Duration %q is out of range", s)
+			}
+			d = time.Duration(v)
+		} else {
+			n, err := strconv.ParseInt(num, 10, 64)
+			if err != nil || n > math.MaxInt64/int64(size) {
+				return 0, errors.Errorf("Duration %q is out of range", s)
+			}
+			d = time.Duration(n) * size
+		}
+		if total > math.MaxInt64-d {
+			return 0, errors.Errorf("Duration %q is out of range", s)
+		}
+		total += d
+		seen = true
+	}
+	if !seen {
+		return 0, invalid
+	}
+	if neg {
+		total = -total
+	}
+	return total, nil
+}
+
+// FormatDuration returns the ISO 8601 representation of the duration, like P1DT2H30M.
+func FormatDuration(d time.Duration) string {
+	if d == 0 {
+		return "PT0S"
+	}
+	var sb strings.Builder
+	// The absolute value is kept as an uint64, so that the minimum duration can be negated.
+	abs := uint64(d)
+	if d < 0 {
+		sb.WriteByte('-')
+		abs = -abs
+	}
+	sb.WriteByte('P')
+	day := uint64(24 * time.Hour)
+	if days := abs / day; days > 0 {
+		sb.WriteString(strconv.FormatUint(days, 10) + "D")
+		abs %= day
+	}
+	if abs == 0 {
+		return sb.String()
+	}
+	sb.WriteByte('T')
+	if hours := abs / uint64(time.Hour); hours > 0 {
+		sb.WriteString(strconv.FormatUint(hours, 10) + "H")
+		abs %= uint64(time.Hour)
+	}
+	if minutes := abs / uint64(time.Minute); minutes > 0 {
+		sb.WriteString(strconv.FormatUint(minutes, 10) + "M")
+		abs %= uint64(time.Minute)
+	}
+	if abs > 0 {
+		secs := strconv.FormatUint(abs/uint64(time.Second), 10)
+		if nanos := abs % uint64(time.Second); nanos > 0 {
+			frac := strconv.FormatUint(nanos+uint64(time.Second), 10)[1:]
+			secs += "." + strings.TrimRight(frac, "0")
+		}
+		sb.WriteString(secs + "S")
+	}
+	return sb.String()
+}
+
+// Interval is a time interval, which includes its start and excludes its end.
+type Interval struct {
+	Start time.Time
+	End   time.Time
+}
+
+// ParseInterval parses an ISO 8601 time interval. The interval is given as a start and an end,
+// like 2022-01-01T09:00:00Z/2022-01-01T17:00:00Z, or as a start or an end and a duration, like
+// 2022-01-01T09:00:00Z/PT8H and PT8H/2022-01-01T17:00:00Z.
+func ParseInterval(s string) (Interval, error) {
+	parts := strings.Split(strings.TrimSpace(s), "/")
+	if len(parts) != 2 {
+		return Interval{}, errors.Errorf("Invalid interval %q, expected start/end", s)
+	}
+	isDuration := func(p string) bool {
+		return strings.HasPrefix(strings.TrimPrefix(p, "-"), "P")
+	}
+
+	var i Interval
+	switch {
+	case isDuration(parts[0]) && isDuration(parts[1]):
+		return Interval{}, errors.Errorf("Invalid interval %q, expected start/end", s)
+	case isDuration(parts[0]):
+		d, err := ParseDuration(parts[0])
+		if err != nil {
+			return Interval{}, err
+		}
+		if i.End, err = ParseTime(parts[1]); err != nil {
+			return Interval{}, err
+		}
+		i.Start = i.End.Add(-d)
+	case isDuration(parts[1]):
+		d, err := ParseDuration(parts[1])
+		if err != nil {
+			return Interval{}, err
+		}
+		if i.Start, err = ParseTime(parts[0]); err != nil {
+			return Interval{}, err
+		}
+		i.End = i.Start.Add(d)
+	default:
+		var err error
+		if i.Start, err = ParseTime(parts[0]); err != nil {
+			return Interval{}, err
+		}
+		if i.End, err = ParseTime(parts[1]); err != nil {
+			return Interval{}, err
+		}
+	}
+	if i.End.Before(i.Start) {
+		return Interval{}, errors.Errorf("Interval %q ends before it starts", s)
+	}
+	return i, nil
+}
+
+// String returns the interval as start/end, in the RFC 3339 format.
+func (i Interval) String() string {
+	return i.Start.Format(time.RFC3339Nano) + "/" + i.End.Format(time.RFC3339Nano)
+}
+
+// Overlaps returns true if the intervals have a common instant.
+func (i Interval) Overlaps(o Interval) bool {
+	return i.Start.Before(o.End) && o.Start.Before(i.End)
+}
+
+// ContainsTime returns true if t is within the interval.
+func (i Interval) ContainsTime(t time.Time) bool {
+	return !t.Before(i.Start) && t.Before(i.End)
+}
+
+// During returns true if the interval is within o.
+func (i Interval) During(o Interval) bool {
+	return !i.Start.Before(o.Start) && !i.End.After(o.End)
+}
+
+// MarshalBinary encodes the interval as the length of the encoded start, followed by the start and
+// the end encoded with time.MarshalBinary.
+func (i Interval) MarshalBinary() ([]byte, error) {
+	start, err := i.Start.MarshalBinary()
+	if err != nil {
+		return nil, err
+	}
+	end, err := i.End.MarshalBinary()
+	if err != nil {
+		return nil, err
+	}
+	b := make([]byte, 0, 1+len(start)+len(end))
+	b = append(b, byte(len(start)))
+	b = append(b, start...)
+	return append(b, end...), nil
+}
+
+// UnmarshalBinary decodes the data encoded by MarshalBinary.
+func (i *Interval) UnmarshalBinary(data []byte) error {
+	if len(data) == 0 || len(data) < 1+int(data[0]) {
+		return errors.Errorf("Invalid data for interval %v", data)
+	}
+	n := 1 + int(data[0])
+	var res Interval
+	if err := res.Start.UnmarshalBinary(data[1:n]); err != nil {
+		return err
+	}
+	if err := res.End.UnmarshalBinary(data[n:]); err != nil {
+		return err
+	}
+	*i = res
+	return nil
+}
@@ -0,0 +1,183 @@
+/*
+ * Copyright 2022 Dgraph Labs, Inc. and Contributors
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package types
+
+import (
+	"math/rand"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/dgraph-io/dgraph/protos/pb"
+)
+
+func TestParseDuration(t *testing.T) {
+	tests := []struct {
+		in  string
+		out time.Duration
+		iso string
+	}{
+		{"PT0S", 0, "PT0S"},
+		{"P1D", 24 * time.Hour, "P1D"},
+		{"P1W", 7 * 24 * time.Hour, "P7D"},
+		{"P1DT2H30M", 26*time.Hour + 30*time.Minute, "P1DT2H30M"},
+		{"PT0.5S", 500 * time.Millisecond, "PT0.5S"},
+		{"PT1,25S", 1250 * time.Millisecond, "PT1.25S"},
+		{"-PT90M", -90 * time.Minute, "-PT1H30M"},
+		{"1h30m", 90 * time.Minute, "PT1H30M"},
+		{"PT1.000000001S", time.Second + 1, "PT1.000000001S"},
+	}
+	for _, test := range tests {
+		d, err := ParseDuration(test.in)
+		require.NoError(t, err, test.in)
+		require.Equal(t, test.out, d, test.in)
+		require.Equal(t, test.iso, FormatDuration(d), test.in)
+	}
+
+	for _, in := range []string{"", "P", "PT", "P1DT", "P1H", "PT1D", "P1Y", "P2M", "P-1D",
+		"PT1S2", "P99999999999999D", "1 day"} {
+		_, err := ParseDuration(in)
+		require.Error(t, err, in)
+	}
+}
+
+func TestParseInterval(t *testing.T) {
+	start := time.Date(2022, 1, 1, 9, 0, 0, 0, time.UTC)
+	end := time.Date(2022, 1, 1, 17, 0, 0, 0, time.UTC)
+	for _, in := range []string{
+		"2022-01-01T09:00:00Z/2022-01-01T17:00:00Z",
+		"2022-01-01T09:00:00Z/PT8H",
+		"PT8H/2022-01-01T17:00:00Z",
+	} {
+		i, err := ParseInterval(in)
+		require.NoError(t, err, in)
+		require.True(t, i.Start.Equal(start), in)
+		require.True(t, i.End.Equal(end), in)
+	}
+
+	for _, in := range []string{"2022-01-01T09:00:00Z", "PT1H/PT2H",
+		"2022-01-02T00:00:00Z/2022-01-01T00:00:00Z", "2022-01-01/2022-01-02/2022-01-03"} {
+		_, err := ParseInterval(in)
+		require.Error(t, err, in)
+	}
+}
+
+func TestIntervalRelations(t *testing.T) {
+	interval := func(s string) Interval {
+		i, err := ParseInterval(s)
+		require.NoError(t, err)
+		return i
+	}
+	day := interval("2022-01-01T00:00:00Z/2022-01-02T00:00:00Z")
+	morning := interval("2022-01-01T08:00:00Z/2022-01-01T12:00:00Z")
+	afternoon := interval("2022-01-01T12:00:00Z/2022-01-01T18:00:00Z")
+	night := interval("2022-01-01T22:00:00Z/2022-01-02T06:00:00Z")
+
+	require.True(t, morning.Overlaps(day))
+	require.True(t, night.Overlaps(day))
+	require.False(t, morning.Overlaps(afternoon), "the end is excluded")
+	require.True(t, morning.During(day))
+	require.False(t, night.During(day))
+	require.True(t, afternoon.ContainsTime(afternoon.Start))
+	require.False(t, morning.ContainsTime(morning.End))
+}
+
+func TestIntervalConversion(t *testing.T) {
+	v, err := Convert(Val{Tid: StringID, Value: []byte("2022-01-01T09:00:00Z/PT8H")}, IntervalID)
+	require.NoError(t, err)
+	b := ValueForType(BinaryID)
+	require.NoError(t, Marshal(v, &b))
+	s, err := Convert(Val{Tid: IntervalID, Value: b.Value}, StringID)
+	require.NoError(t, err)
+	require.Equal(t, "2022-01-01T09:00:00Z/2022-01-01T17:00:00Z", s.Value)
+
+	v, err = Convert(Val{Tid: StringID, Value: []byte("P1DT1H")}, DurationID)
+	require.NoError(t, err)
+	require.NoError(t, Marshal(v, &b))
+	s, err = Convert(Val{Tid: DurationID, Value: b.Value}, StringID)
+	require.NoError(t, err)
+	require.Equal(t, "P1DT1H", s.Value)
+}
+
+// TestIntervalTokens checks that the index tokens of an interval always match the query tokens
+// of the intervals overlapping with it.
+func TestIntervalTokens(t *testing.T) {
+	r := rand.New(rand.NewSource(1))
+	base := time.Date(1960, 1, 1, 0, 0, 0, 0, time.UTC)
+	randomInterval := func() Interval {
+		start := base.Add(time.Duration(r.Int63n(int64(100 * 365 * 24 * time.Hour))))
+		// The lengths go from seconds to decades.
+		length := time.Duration(r.Int63n(int64(time.Second) << uint(r.Intn(30))))
+		return Interval{Start: start, End: start.Add(length)}
+	}
+	tokenSet := func(toks []string) map[string]bool {
+		m := make(map[string]bool)
+		for _, tok := range toks {
+			m[tok] = true
+		}
+		return m
+	}
+
+	var overlapping int
+	for n := 0; n < 2000; n++ {
+		a, b := randomInterval(), randomInterval()
+		if n%2 == 0 {
+			// Make half of the pairs overlap.
+			mid := a.Start.Add(a.End.Sub(a.Start) / 2)
+			b = Interval{Start: mid, End: mid.Add(b.End.Sub(b.Start))}
+		}
+		if !a.Overlaps(b) {
+			continue
+		}
+		overlapping++
+		index := tokenSet(IndexIntervalTokens(a))
+		var found bool
+		for _, tok := range queryTokensInterval(b) {
+			found = found || index[tok]
+		}
+		require.True(t, found, "%s should be found by %s", a, b)
+	}
+	require.Greater(t, overlapping, 500)
+}
+
+func TestGetIntervalTokens(t *testing.T) {
+	shift, err := ParseInterval("2022-01-01T09:00:00Z/2022-01-01T17:00:00Z")
+	require.NoError(t, err)
+
+	tests := []struct {
+		fn      string
+		args    []string
+		matches bool
+	}{
+		{"overlaps", []string{"2022-01-01T16:00:00Z", "2022-01-01T20:00:00Z"}, true},
+		{"overlaps", []string{"2022-01-01T17:00:00Z/PT1H"}, false},
+		{"during", []string{"2022-01-01T00:00:00Z", "2022-01-02T00:00:00Z"}, true},
+		{"during", []string{"2022-01-01T10:00:00Z", "2022-01-02T00:00:00Z"}, false},
+		{"contains_time", []string{"2022-01-01T12:00:00Z"}, true},
+		{"contains_time", []string{"2022-01-01T17:00:00Z"}, false},
+	}
+	for _, test := range tests {
+		toks, q, err := GetIntervalTokens(&pb.SrcFunction{Name: test.fn, Args: test.args})
+		require.NoError(t, err)
+		require.NotEmpty(t, toks)
+		require.Equal(t, test.matches, q.Matches(shift), "%s%v", test.fn, test.args)
+	}
+
+	_, _, err = GetIntervalTokens(&pb.SrcFunction{Name: "contains_time"})
+	require.Error(t, err)
+}
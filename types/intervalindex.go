@@ -0,0 +1,180 @@
+/*
+ * Copyright 2022 Dgraph Labs, Inc. and Contributors
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package types
+
+import (
+	"encoding/binary"
+	"strings"
+
+	"github.com/pkg/errors"
+
+	"github.com/dgraph-io/dgraph/protos/pb"
+	"github.com/dgraph-io/dgraph/x"
+)
+
+// The interval index splits the time in buckets. The buckets of level 0 are one minute long, and
+// each bucket of level n+1 is made of 16 buckets of level n. An interval is covered by at most
+// intervalMaxCells consecutive buckets of the finest level that allows it.
+const (
+	intervalBucketSeconds = 60
+	intervalLevelBits     = 4
+	intervalMaxLevel      = 9
+	intervalMaxCells      = 4
+)
+
+// IsIntervalFunc returns if a function is of interval type.
+func IsIntervalFunc(str string) bool {
+	switch str {
+	case "overlaps", "contains_time", "during":
+		return true
+	}
+	return false
+}
+
+// IntervalQueryData is used by the interval query filter to check the intervals found in the
+// index, which can be larger than the ones matching the query.
+type IntervalQueryData struct {
+	fn       string
+	interval Interval
+}
+
+// floorDiv divides a by b, rounding towards negative infinity for the times before 1970.
+func floorDiv(a, b int64) int64 {
+	q := a / b
+	if a%b != 0 && a < 0 {
+		q--
+	}
+	return q
+}
+
+// intervalCover returns the level and the range of buckets which cover the interval, including
+// its end.
+func intervalCover(i Interval) (level int, first, last int64) {
+	first = floorDiv(i.Start.Unix(), intervalBucketSeconds)
+	last = floorDiv(i.End.Unix(), intervalBucketSeconds)
+	for level < intervalMaxLevel && last-first >= intervalMaxCells {
+		first >>= intervalLevelBits
+		last >>= intervalLevelBits
+		level++
+	}
+	return level, first, last
+}
+
+func intervalTokens(prefix string, level int, first, last int64) []string {
+	var toks []string
+	for b := first; b <= last; b++ {
+		var buf [9]byte
+		buf[0] = byte(level)
+		binary.BigEndian.PutUint64(buf[1:], uint64(b))
+		toks = append(toks, prefix+string(buf[:]))
+	}
+	return toks
+}
+
+// intervalParentTokens returns the tokens of the buckets of the levels above the cover.
+func intervalParentTokens(prefix string, level int, first, last int64) []string {
+	var toks []string
+	for l := level + 1; l <= intervalMaxLevel; l++ {
+		first >>= intervalLevelBits
+		last >>= intervalLevelBits
+		toks = append(toks, intervalTokens(prefix, l, first, last)...)
+	}
+	return toks
+}
+
+// IndexIntervalTokens returns the tokens to index the interval. The buckets covering the
+// interval are indexed with the cover prefix, and their parents with the parent prefix.
+func IndexIntervalTokens(i Interval) []string {
+	level, first, last := intervalCover(i)
+	toks := intervalTokens(coverPrefix, level, first, last)
+	return append(toks, intervalParentTokens(parentPrefix, level, first, last)...)
+}
+
+// queryTokensInterval returns the tokens of the intervals which may overlap with the given one.
+// Two buckets overlap only if one of them contains the other, so an indexed interval overlaps the
+// query if one of its cover buckets is a cover bucket of the query or one of their parents, or if
+// one of its parents is a cover bucket of the query.
+func queryTokensInterval(i Interval) []string {
+	level, first, last := intervalCover(i)
+	toks := intervalTokens(coverPrefix, level, first, last)
+	toks = append(toks, intervalParentTokens(coverPrefix, level, first, last)...)
+	return append(toks, intervalTokens(parentPrefix, level, first, last)...)
+}
+
+// GetIntervalTokens returns the index tokens to look up for the interval function, and the data
+// used to filter the intervals found.
+func GetIntervalTokens(srcFunc *pb.SrcFunction) ([]string, *IntervalQueryData, error) {
+	x.AssertTruef(len(srcFunc.Name) > 0, "Invalid function")
+	funcName := strings.ToLower(srcFunc.Name)
+	q := &IntervalQueryData{fn: funcName}
+	switch funcName {
+	case "overlaps", "during":
+		var err error
+		switch len(srcFunc.Args) {
+		case 1:
+			q.interval, err = ParseInterval(srcFunc.Args[0])
+		case 2:
+			q.interval, err = ParseInterval(srcFunc.Args[0] + "/" + srcFunc.Args[1])
+		default:
+			return nil, nil, errors.Errorf("%s function requires 1 or 2 arguments, but got %d",
+				funcName, len(srcFunc.Args))
+		}
+		if err != nil {
+			return nil, nil, err
+		}
+	case "contains_time":
+		if len(srcFunc.Args) != 1 {
+			return nil, nil, errors.Errorf("contains_time function requires 1 argument, but got %d",
+				len(srcFunc.Args))
+		}
+		t, err := ParseTime(srcFunc.Args[0])
+		if err != nil {
+			return nil, nil, err
+		}
+		q.interval = Interval{Start: t, End: t}
+	default:
+		return nil, nil, errors.Errorf("Invalid interval function")
+	}
+	// The intervals during the query and the ones containing the time overlap with the query, so
+	// they are all found with the overlap tokens.
+	return queryTokensInterval(q.interval), q, nil
+}
+
+// Matches returns true if the interval matches the query.
+func (q *IntervalQueryData) Matches(i Interval) bool {
+	switch q.fn {
+	case "overlaps":
+		return i.Overlaps(q.interval)
+	case "during":
+		return i.During(q.interval)
+	case "contains_time":
+		return i.ContainsTime(q.interval.Start)
+	}
+	return false
+}
+
+// MatchInterval checks if the value matches the interval query.
+func MatchInterval(value *pb.TaskValue, q *IntervalQueryData) bool {
+	if len(value.Val) == 0 || TypeID(value.ValType) != IntervalID {
+		return false
+	}
+	var i Interval
+	if err := i.UnmarshalBinary(value.Val); err != nil {
+		return false
+	}
+	return q.Matches(i)
+}
@@ -0,0 +1,340 @@
+/*
+ * Copyright 2022 Dgraph Labs, Inc. and Contributors
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package types
+
+import (
+	"bytes"
+	"encoding/json"
+	"strconv"
+	"strings"
+
+	"github.com/pkg/errors"
+)
+
+// ParseJSON validates the JSON document and returns it without the insignificant spaces, which
+// is how json values are stored.
+func ParseJSON(s string) (string, error) {
+	var buf bytes.Buffer
+	if err := json.Compact(&buf, []byte(s)); err != nil {
+		return "", errors.Wrapf(err, "Invalid JSON document")
+	}
+	return buf.String(), nil
+}
+
+type jsonPathStep struct {
+	key     string
+	index   int
+	isIndex bool
+}
+
+// JSONPath is a path to a value of a JSON document, like $.address.city or $.tags[0]. Only the
+// member and the array index selectors are supported, so a path selects at most one value. A
+// negative index counts from the end of the array.
+type JSONPath struct {
+	steps []jsonPathStep
+}
+
+// ParseJSONPath parses a path like $.a.b, $['a b'] or $.items[-1].
+func ParseJSONPath(s string) (JSONPath, error) {
+	invalid := errors.Errorf("Invalid JSON path: %q", s)
+	rest := strings.TrimSpace(s)
+	if !strings.HasPrefix(rest, "$") {
+		return JSONPath{}, invalid
+	}
+	rest = rest[1:]
+
+	var p JSONPath
+	for len(rest) > 0 {
+		switch rest[0] {
+		case '.':
+			i := 1
+			for i < len(rest) && isJSONPathNameChar(rest[i]) {
+				i++
+			}
+			if i == 1 {
+				return JSONPath{}, invalid
+			}
+			p.steps = append(p.steps, jsonPathStep{key: rest[1:i]})
+			rest = rest[i:]
+		case '[':
+			end := strings.IndexByte(rest, ']')
+			if end < 0 {
+				return JSONPath{}, invalid
+			}
+			sel := strings.TrimSpace(rest[1:end])
+			if len(sel) >= 2 && (sel[0] == '\'' || sel[0] == '"') && sel[len(sel)-1] == sel[0] {
+				// Quoted names can't contain the closing bracket or escaped quotes.
+				p.steps = append(p.steps, jsonPathStep{key: sel[1 : len(sel)-1]})
+			} else {
+				idx, err := strconv.Atoi(sel)
+				if err != nil {
+					return JSONPath{}, invalid
+				}
+				p.steps = append(p.steps, jsonPathStep{index: idx, isIndex: true})
+			}
+			rest = rest[end+1:]
+		default:
+			return JSONPath{}, invalid
+		}
+	}
+	return p, nil
+}
+
+func isJSONPathNameChar(c byte) bool {
+	return c == '_' || c == '-' || (c >= 'a' && c <= 'z') || (c >= 'A' && c <= 'Z') ||
+		(c >= '0' && c <= '9')
+}
+
+// String returns the path in its canonical form, so that equivalent paths are equal strings.
+func (p JSONPath) String() string {
+	var sb strings.Builder
+	sb.WriteByte('$')
+	for _, step := range p.steps {
+		switch {
+		case step.isIndex:
+			sb.WriteString("[" + strconv.Itoa(step.index) + "]")
+		case isJSONPathName(step.key):
+			sb.WriteString("." + step.key)
+		default:
+			sb.WriteString("['" + step.key + "']")
+		}
+	}
+	return sb.String()
+}
+
+func isJSONPathName(s string) bool {
+	for i := 0; i < len(s); i++ {
+		if !isJSONPathNameChar(s[i]) {
+			return false
+		}
+	}
+	return len(s) > 0
+}
+
+// Extract returns the value of the document at the path, decoded with the numbers kept as
+// json.Number. It returns false if the document doesn't have a value at the path.
+func (p JSONPath) Extract(doc string) (interface{}, bool) {
+	dec := json.NewDecoder(strings.NewReader(doc))
+	dec.UseNumber()
+	var v interface{}
+	if err := dec.Decode(&v); err != nil {
+		return nil, false
+	}
+	for _, step := range p.steps {
+		switch cur := v.(type) {
+		case map[string]interface{}:
+			if step.isIndex {
+				return nil, false
+			}
+			var ok bool
+			if v, ok = cur[step.key]; !ok {
+				return nil, false
+			}
+		case []interface{}:
+			if !step.isIndex {
+				return nil, false
+			}
+			idx := step.index
+			if idx < 0 {
+				idx += len(cur)
+			}
+			if idx < 0 || idx >= len(cur) {
+				return nil, false
+			}
+			v = cur[idx]
+		default:
+			return nil, false
+		}
+	}
+	return v, v != nil
+}
+
+// JSONValue converts a value extracted from a JSON document to a typed value. The numbers become
+// ints if they are integral, and floats otherwise. The objects and arrays are kept as json.
+func JSONValue(v interface{}) (Val, error) {
+	switch v := v.(type) {
+	case string:
+		return Val{Tid: StringID, Value: v}, nil
+	case bool:
+		return Val{Tid: BoolID, Value: v}, nil
+	case json.Number:
+		if i, err := v.Int64(); err == nil {
+			return Val{Tid: IntID, Value: i}, nil
+		}
+		f, err := v.Float64()
+		if err != nil {
+			return Val{}, err
+		}
+		return Val{Tid: FloatID, Value: f}, nil
+	case map[string]interface{}, []interface{}:
+		b, err := json.Marshal(v)
+		if err != nil {
+			return Val{}, err
+		}
+		return Val{Tid: JSONID, Value: string(b)}, nil
+	}
+	return Val{}, errors.Errorf("Invalid JSON value: %v", v)
+}
+
+// jsonScalars returns the scalar values of v, which are v itself or the scalar elements of the
+// array v.
+func jsonScalars(v interface{}) []interface{} {
+	switch v := v.(type) {
+	case []interface{}:
+		var res []interface{}
+		for _, e := range v {
+			switch e.(type) {
+			case string, bool, json.Number:
+				res = append(res, e)
+			}
+		}
+		return res
+	case string, bool, json.Number:
+		return []interface{}{v}
+	}
+	return nil
+}
+
+// jsonValueToken returns the index token of a scalar JSON value, without the path. The numbers
+// are formatted as floats, so that 1 and 1.0 have the same token.
+func jsonValueToken(v interface{}) (string, bool) {
+	switch v := v.(type) {
+	case string:
+		return "s" + v, true
+	case bool:
+		return "b" + strconv.FormatBool(v), true
+	case json.Number:
+		f, err := v.Float64()
+		if err != nil {
+			return "", false
+		}
+		return "n" + strconv.FormatFloat(f, 'g', -1, 64), true
+	}
+	return "", false
+}
+
+// JSONPathTokenPrefix returns the prefix of the index tokens of the path, which keeps apart the
+// tokens of the different paths indexed on a predicate.
+func JSONPathTokenPrefix(p JSONPath) string {
+	return p.String() + "\x00"
+}
+
+// IndexJSONPathTokens returns the tokens to index the value of the document at the path. If the
+// value is an array, each of its scalar elements is indexed.
+func IndexJSONPathTokens(p JSONPath, doc string) []string {
+	v, ok := p.Extract(doc)
+	if !ok {
+		return nil
+	}
+	prefix := JSONPathTokenPrefix(p)
+	var toks []string
+	for _, s := range jsonScalars(v) {
+		if tok, ok := jsonValueToken(s); ok {
+			toks = append(toks, prefix+tok)
+		}
+	}
+	return toks
+}
+
+// QueryJSONPathTokens returns the tokens of the values equal to the argument. As the arguments
+// of the functions aren't typed, the argument matches a string, and the number or the bool it
+// can be parsed as.
+func QueryJSONPathTokens(p JSONPath, arg string) []string {
+	prefix := JSONPathTokenPrefix(p)
+	toks := []string{prefix + "s" + arg}
+	if f, err := strconv.ParseFloat(arg, 64); err == nil {
+		toks = append(toks, prefix+"n"+strconv.FormatFloat(f, 'g', -1, 64))
+	}
+	if b, err := strconv.ParseBool(arg); err == nil {
+		toks = append(toks, prefix+"b"+strconv.FormatBool(b))
+	}
+	return toks
+}
+
+// compareJSONScalar compares the scalar value with the argument using the comparison function.
+// Strings are compared as strings and numbers as numbers, while bools can only be equal.
+func compareJSONScalar(fn string, v interface{}, arg string) bool {
+	var cmp int
+	switch v := v.(type) {
+	case string:
+		cmp = strings.Compare(v, arg)
+	case json.Number:
+		f, err := v.Float64()
+		if err != nil {
+			return false
+		}
+		a, err := strconv.ParseFloat(arg, 64)
+		if err != nil {
+			return false
+		}
+		switch {
+		case f < a:
+			cmp = -1
+		case f > a:
+			cmp = 1
+		}
+	case bool:
+		b, err := strconv.ParseBool(arg)
+		return fn == "eq" && err == nil && b == v
+	default:
+		return false
+	}
+
+	switch fn {
+	case "eq":
+		return cmp == 0
+	case "lt":
+		return cmp < 0
+	case "le":
+		return cmp <= 0
+	case "gt":
+		return cmp > 0
+	case "ge":
+		return cmp >= 0
+	}
+	return false
+}
+
+// MatchJSONPath returns true if the value of the document at the path satisfies the comparison
+// function with the arguments. eq matches any of its arguments, and between takes the bounds as
+// its two arguments. If the value is an array, it's enough for one of its elements to match.
+func MatchJSONPath(fn string, p JSONPath, doc string, args []string) bool {
+	v, ok := p.Extract(doc)
+	if !ok {
+		return false
+	}
+	for _, s := range jsonScalars(v) {
+		switch fn {
+		case "eq":
+			for _, arg := range args {
+				if compareJSONScalar(fn, s, arg) {
+					return true
+				}
+			}
+		case "between":
+			if len(args) == 2 && compareJSONScalar("ge", s, args[0]) &&
+				compareJSONScalar("le", s, args[1]) {
+				return true
+			}
+		default:
+			if len(args) == 1 && compareJSONScalar(fn, s, args[0]) {
+				return true
+			}
+		}
+	}
+	return false
+}
@@ -0,0 +1,161 @@
+/*
+ * Copyright 2022 Dgraph Labs, Inc. and Contributors
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package types
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+const testDoc = `{"status": "active", "n": 3, "ratio": 0.5, "ok": true, "none": null,
+	"user": {"name": "alice", "tags": ["a", "b", 7]}, "a b": {"c": 1}}`
+
+func TestParseJSON(t *testing.T) {
+	v, err := Convert(Val{Tid: StringID, Value: []byte(` { "a" : [1, 2] } `)}, JSONID)
+	require.NoError(t, err)
+	require.Equal(t, `{"a":[1,2]}`, v.Value)
+
+	for _, s := range []string{"", "{", `{"a": }`, "{} {}"} {
+		_, err := Convert(Val{Tid: StringID, Value: []byte(s)}, JSONID)
+		require.Error(t, err, s)
+	}
+}
+
+func TestParseJSONPath(t *testing.T) {
+	tests := []struct {
+		in  string
+		out string
+	}{
+		{"$", "$"},
+		{"$.status", "$.status"},
+		{"$['user'].name", "$.user.name"},
+		{`$["a b"].c`, "$['a b'].c"},
+		{"$.user.tags[-1]", "$.user.tags[-1]"},
+	}
+	for _, test := range tests {
+		p, err := ParseJSONPath(test.in)
+		require.NoError(t, err, test.in)
+		require.Equal(t, test.out, p.String(), test.in)
+	}
+
+	for _, in := range []string{"", "status", "$.", "$..a", "$[*]", "$.a[", "$.a b"} {
+		_, err := ParseJSONPath(in)
+		require.Error(t, err, in)
+	}
+}
+
+func TestJSONPathExtract(t *testing.T) {
+	tests := []struct {
+		path  string
+		found bool
+		val   Val
+	}{
+		{"$.status", true, Val{Tid: StringID, Value: "active"}},
+		{"$.n", true, Val{Tid: IntID, Value: int64(3)}},
+		{"$.ratio", true, Val{Tid: FloatID, Value: 0.5}},
+		{"$.ok", true, Val{Tid: BoolID, Value: true}},
+		{"$.user.tags[-1]", true, Val{Tid: IntID, Value: int64(7)}},
+		{"$['a b']", true, Val{Tid: JSONID, Value: `{"c":1}`}},
+		{"$.user.tags", true, Val{Tid: JSONID, Value: `["a","b",7]`}},
+		{"$.none", false, Val{}},
+		{"$.missing", false, Val{}},
+		{"$.user.tags[3]", false, Val{}},
+		{"$.status.length", false, Val{}},
+	}
+	for _, test := range tests {
+		p, err := ParseJSONPath(test.path)
+		require.NoError(t, err)
+		v, found := p.Extract(testDoc)
+		require.Equal(t, test.found, found, test.path)
+		if !found {
+			continue
+		}
+		val, err := JSONValue(v)
+		require.NoError(t, err)
+		require.Equal(t, test.val, val, test.path)
+	}
+}
+
+func TestMatchJSONPath(t *testing.T) {
+	tests := []struct {
+		fn      string
+		path    string
+		args    []string
+		matches bool
+	}{
+		{"eq", "$.status", []string{"inactive", "active"}, true},
+		{"eq", "$.status", []string{"Active"}, false},
+		{"eq", "$.n", []string{"3.0"}, true},
+		{"eq", "$.ok", []string{"true"}, true},
+		{"gt", "$.n", []string{"2"}, true},
+		{"gt", "$.n", []string{"abc"}, false},
+		{"lt", "$.status", []string{"b"}, true},
+		{"between", "$.ratio", []string{"0", "1"}, true},
+		{"le", "$.ok", []string{"true"}, false},
+		{"eq", "$.user.tags", []string{"b"}, true},
+		{"ge", "$.user.tags", []string{"7"}, true},
+		{"eq", "$.user", []string{"alice"}, false},
+		{"eq", "$.missing", []string{""}, false},
+	}
+	for _, test := range tests {
+		p, err := ParseJSONPath(test.path)
+		require.NoError(t, err)
+		require.Equal(t, test.matches, MatchJSONPath(test.fn, p, testDoc, test.args),
+			"%s(%s, %v)", test.fn, test.path, test.args)
+	}
+}
+
+// TestJSONPathTokens checks that the documents are found by the eq arguments matching them.
+func TestJSONPathTokens(t *testing.T) {
+	tests := []struct {
+		path    string
+		arg     string
+		matches bool
+	}{
+		{"$.status", "active", true},
+		{"$.n", "3", true},
+		{"$.n", "3.0", true},
+		{"$.ok", "true", true},
+		{"$.user.tags", "a", true},
+		{"$.user.tags", "7", true},
+		{"$.status", "3", false},
+		{"$.user.name", "active", false},
+	}
+	for _, test := range tests {
+		p, err := ParseJSONPath(test.path)
+		require.NoError(t, err)
+		index := make(map[string]bool)
+		for _, tok := range IndexJSONPathTokens(p, testDoc) {
+			index[tok] = true
+		}
+		var found bool
+		for _, tok := range QueryJSONPathTokens(p, test.arg) {
+			found = found || index[tok]
+		}
+		require.Equal(t, test.matches, found, "%s = %s", test.path, test.arg)
+		require.Equal(t, test.matches, MatchJSONPath("eq", p, testDoc, []string{test.arg}))
+	}
+
+	// The tokens of a path don't match the values at another path.
+	status, err := ParseJSONPath("$.status")
+	require.NoError(t, err)
+	name, err := ParseJSONPath("$.user.name")
+	require.NoError(t, err)
+	require.NotEqual(t, IndexJSONPathTokens(status, `{"status": "x"}`),
+		IndexJSONPathTokens(name, `{"user": {"name": "x"}}`))
+}
@@ -0,0 +1,213 @@
+/*
+ * Copyright 2022 Dgraph Labs, Inc. and Contributors
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package types
+
+import (
+	"math"
+	"strconv"
+
+	"github.com/golang/geo/s1"
+	"github.com/golang/geo/s2"
+	"github.com/pkg/errors"
+	geom "github.com/twpayne/go-geom"
+
+	"github.com/dgraph-io/dgraph/protos/pb"
+)
+
+const (
+	// nearestStartRadius is the radius in metres of the first region searched by a nearest
+	// query. It is about the size of the smallest indexed cells.
+	nearestStartRadius = 1000
+	// nearestMaxRadius is the largest radius in metres searched using a cap around the point.
+	// Beyond it, the whole earth is searched.
+	nearestMaxRadius = 10000 * 1000
+)
+
+// NearestQuery finds the K geometries closest to a point. The index is searched in a region
+// around the point that is expanded until K geometries are found within it.
+type NearestQuery struct {
+	// K is the number of geometries to find.
+	K      int
+	pt     s2.Point
+	radius float64
+}
+
+// ParseNearest parses the arguments of the nearest function, a point and the number of results.
+func ParseNearest(srcFunc *pb.SrcFunction) (*NearestQuery, error) {
+	if len(srcFunc.Args) != 2 {
+		return nil, errors.Errorf("nearest function requires 2 arguments, but got %d",
+			len(srcFunc.Args))
+	}
+	k, err := strconv.Atoi(srcFunc.Args[1])
+	if err != nil {
+		return nil, errors.Wrapf(err, "Error while converting the number of results to int")
+	}
+	if k <= 0 {
+		return nil, errors.Errorf("Number of results must be greater than 0, got %d", k)
+	}
+	g, err := convertToGeom(srcFunc.Args[0])
+	if err != nil {
+		return nil, err
+	}
+	p, ok := g.(*geom.Point)
+	if !ok {
+		return nil, errors.Errorf("nearest function requires a point, but got %T", g)
+	}
+	return &NearestQuery{K: k, pt: pointFromPoint(p)}, nil
+}
+
+// Expand grows the searched region and returns the index tokens for it, along with its radius
+// in metres. The radius is doubled on every call, until the region covers the whole earth, in
+// which case whole is true and the query can't be expanded anymore. The tokens include the ones
+// returned by earlier calls.
+func (q *NearestQuery) Expand() (tokens []string, radius float64, whole bool) {
+	switch {
+	case q.radius == 0:
+		q.radius = nearestStartRadius
+	case q.radius < nearestMaxRadius:
+		q.radius *= 2
+	}
+	if q.radius >= nearestMaxRadius {
+		// Every geometry is indexed with its parent cells up to the min level, so looking up all
+		// the cells of that level finds all of them.
+		q.radius = math.Inf(1)
+		var cells s2.CellUnion
+		for face := 0; face < 6; face++ {
+			f := s2.CellIDFromFace(face)
+			end := f.ChildEndAtLevel(MinCellLevel)
+			for c := f.ChildBeginAtLevel(MinCellLevel); c != end; c = c.Next() {
+				cells = append(cells, c)
+			}
+		}
+		return createTokens(cells, parentPrefix), q.radius, true
+	}
+
+	l := s2.RegularLoop(q.pt, EarthAngle(q.radius), 100)
+	cover := coverLoop(l, MinCellLevel, MaxCellLevel, MaxCells)
+	parents := getParentCells(cover, MinCellLevel)
+	return parentCoverTokens(parents, cover), q.radius, false
+}
+
+// Distance returns the distance in metres from the point of the query to the closest point of
+// the geometry. The distance to a polygon containing the point is zero, and the distance to a
+// collection is the distance to its closest geometry.
+func (q *NearestQuery) Distance(g geom.T) (float64, error) {
+	var angle s1.Angle
+	switch v := g.(type) {
+	case *geom.Point:
+		angle = q.pt.Angle(pointFromPoint(v).Vector)
+	case *geom.Polygon:
+		a, err := q.polygonAngle(v)
+		if err != nil {
+			return 0, err
+		}
+		angle = a
+	case *geom.MultiPolygon:
+		angle = s1.Angle(math.Inf(1))
+		for i := 0; i < v.NumPolygons(); i++ {
+			a, err := q.polygonAngle(v.Polygon(i))
+			if err != nil {
+				return 0, err
+			}
+			if a < angle {
+				angle = a
+			}
+		}
+	case *geom.LineString:
+		a, err := q.lineStringAngle(v)
+		if err != nil {
+			return 0, err
+		}
+		angle = a
+	case *geom.MultiLineString:
+		angle = s1.Angle(math.Inf(1))
+		for i := 0; i < v.NumLineStrings(); i++ {
+			a, err := q.lineStringAngle(v.LineString(i))
+			if err != nil {
+				return 0, err
+			}
+			if a < angle {
+				angle = a
+			}
+		}
+	case *geom.GeometryCollection:
+		distance := math.Inf(1)
+		for i := 0; i < v.NumGeoms(); i++ {
+			d, err := q.Distance(v.Geom(i))
+			if err != nil {
+				return 0, err
+			}
+			distance = math.Min(distance, d)
+		}
+		return distance, nil
+	default:
+		return 0, errors.Errorf("Cannot compute the distance to a geometry of type %T", v)
+	}
+	return float64(EarthDistance(angle)), nil
+}
+
+// lineStringAngle returns the angle between the point of the query and the closest edge of the
+// line.
+func (q *NearestQuery) lineStringAngle(l *geom.LineString) (s1.Angle, error) {
+	pts, err := pointsFromLineString(l)
+	if err != nil {
+		return 0, err
+	}
+	angle := s1.Angle(math.Inf(1))
+	for i := 0; i+1 < len(pts); i++ {
+		if a := edgeAngle(q.pt, pts[i], pts[i+1]); a < angle {
+			angle = a
+		}
+	}
+	return angle, nil
+}
+
+// polygonAngle returns the angle between the point of the query and the outer ring of the
+// polygon, or zero if the polygon contains the point. Holes are ignored, as in the index.
+func (q *NearestQuery) polygonAngle(p *geom.Polygon) (s1.Angle, error) {
+	l, err := loopFromPolygon(p)
+	if err != nil {
+		return 0, err
+	}
+	if l.ContainsPoint(q.pt) {
+		return 0, nil
+	}
+	r := p.LinearRing(0)
+	angle := s1.Angle(math.Inf(1))
+	for i := 0; i+1 < r.NumCoords(); i++ {
+		a := edgeAngle(q.pt, pointFromCoord(r.Coord(i)), pointFromCoord(r.Coord(i+1)))
+		if a < angle {
+			angle = a
+		}
+	}
+	return angle, nil
+}
+
+// edgeAngle returns the angle between the point p and the closest point of the edge from a to b.
+func edgeAngle(p, a, b s2.Point) s1.Angle {
+	n := a.Cross(b.Vector)
+	// The closest point lies inside the edge if p is between the planes that are perpendicular
+	// to the edge and go through its end points.
+	if n.Norm2() > 0 && n.Cross(a.Vector).Dot(p.Vector) > 0 && b.Cross(n).Dot(p.Vector) > 0 {
+		return s1.Angle(math.Asin(math.Min(1, math.Abs(n.Normalize().Dot(p.Vector)))))
+	}
+	da, db := p.Angle(a.Vector), p.Angle(b.Vector)
+	if da < db {
+		return da
+	}
+	return db
+}
@@ -0,0 +1,102 @@
+/*
+ * Copyright 2022 Dgraph Labs, Inc. and Contributors
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package types
+
+import (
+	"math"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"github.com/twpayne/go-geom"
+
+	"github.com/dgraph-io/dgraph/protos/pb"
+)
+
+func TestParseNearestError(t *testing.T) {
+	_, err := ParseNearest(&pb.SrcFunction{Name: "nearest", Args: []string{"[0, 0]"}})
+	require.Error(t, err)
+	_, err = ParseNearest(&pb.SrcFunction{Name: "nearest", Args: []string{"[0, 0]", "0"}})
+	require.Error(t, err)
+	_, err = ParseNearest(&pb.SrcFunction{Name: "nearest",
+		Args: []string{"[[0, 0], [1, 0], [1, 1], [0, 0]]", "1"}})
+	require.Error(t, err)
+}
+
+func TestNearestExpand(t *testing.T) {
+	q, err := ParseNearest(&pb.SrcFunction{Name: "nearest", Args: []string{"[0, 0]", "5"}})
+	require.NoError(t, err)
+	require.Equal(t, 5, q.K)
+
+	tokens, radius, whole := q.Expand()
+	require.NotEmpty(t, tokens)
+	require.Equal(t, 1000.0, radius)
+	require.False(t, whole)
+
+	_, radius, whole = q.Expand()
+	require.Equal(t, 2000.0, radius)
+	require.False(t, whole)
+
+	for !whole {
+		tokens, radius, whole = q.Expand()
+	}
+	require.True(t, math.IsInf(radius, 1))
+	require.Len(t, tokens, 6*1024)
+}
+
+func TestNearestDistance(t *testing.T) {
+	q, err := ParseNearest(&pb.SrcFunction{Name: "nearest", Args: []string{"[0, 0]", "1"}})
+	require.NoError(t, err)
+	degree := EarthRadiusMeters * math.Pi / 180
+
+	d, err := q.Distance(geom.NewPoint(geom.XY).MustSetCoords(geom.Coord{1, 0}))
+	require.NoError(t, err)
+	require.InDelta(t, degree, d, 1)
+
+	// The point is inside the polygon.
+	inside := geom.NewPolygon(geom.XY).MustSetCoords([][]geom.Coord{
+		{{-1, -1}, {1, -1}, {1, 1}, {-1, 1}, {-1, -1}}})
+	d, err = q.Distance(inside)
+	require.NoError(t, err)
+	require.Equal(t, 0.0, d)
+
+	// The closest point of the polygon is in the middle of its west edge.
+	east := geom.NewPolygon(geom.XY).MustSetCoords([][]geom.Coord{
+		{{2, -1}, {3, -1}, {3, 1}, {2, 1}, {2, -1}}})
+	d, err = q.Distance(east)
+	require.NoError(t, err)
+	require.InDelta(t, 2*degree, d, 1)
+
+	multi := geom.NewMultiPolygon(geom.XY).MustSetCoords([][][]geom.Coord{
+		{{{2, -1}, {3, -1}, {3, 1}, {2, 1}, {2, -1}}},
+		{{{-5, -1}, {-4, -1}, {-4, 1}, {-5, 1}, {-5, -1}}},
+	})
+	d, err = q.Distance(multi)
+	require.NoError(t, err)
+	require.InDelta(t, 2*degree, d, 1)
+
+	// The closest point of the line is in the middle of its edge.
+	line := geom.NewLineString(geom.XY).MustSetCoords([]geom.Coord{{5, 5}, {3, -1}, {3, 1}})
+	d, err = q.Distance(line)
+	require.NoError(t, err)
+	require.InDelta(t, 3*degree, d, 1)
+
+	c := geom.NewGeometryCollection()
+	require.NoError(t, c.Push(line, geom.NewPoint(geom.XY).MustSetCoords(geom.Coord{1, 0})))
+	d, err = q.Distance(c)
+	require.NoError(t, err)
+	require.InDelta(t, degree, d, 1)
+}
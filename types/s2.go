@@ -18,6 +18,7 @@ package types
 
 import (
 	"encoding/json"
+	"strings"
 
 	"github.com/dgraph-io/dgraph/x"
 	"github.com/golang/geo/s2"
@@ -131,37 +132,45 @@ func Intersects(l1 *s2.Loop, l2 *s2.Loop) bool {
 	return intersects(l1, l2)
 }
 
-func convertToGeom(str string) (geom.T, error) {
-	// validate would ensure that we have a closed loop for all the polygons. We don't support open
-	// loop polygons.
-	closed := func(p *geom.Polygon) error {
-		coords := p.Coords()
-		if len(coords) == 0 {
-			return errors.Errorf("Got empty polygon.")
-		}
-		// Check that first ring is closed.
-		c := coords[0]
-		l := len(c)
-		if c[0][0] == c[l-1][0] && c[0][1] == c[l-1][1] {
-			return nil
-		}
-		return errors.Errorf("Last coord not same as first")
+func closedPolygon(p *geom.Polygon) error {
+	coords := p.Coords()
+	if len(coords) == 0 {
+		return errors.Errorf("Got empty polygon.")
+	}
+	// Check that first ring is closed.
+	c := coords[0]
+	l := len(c)
+	if c[0][0] == c[l-1][0] && c[0][1] == c[l-1][1] {
+		return nil
 	}
+	return errors.Errorf("Last coord not same as first")
+}
 
-	validate := func(g geom.T) (geom.T, error) {
-		switch v := g.(type) {
-		case *geom.MultiPolygon:
-			for i := 0; i < v.NumPolygons(); i++ {
-				if err := closed(v.Polygon(i)); err != nil {
-					return nil, err
-				}
-			}
-		case *geom.Polygon:
-			if err := closed(v); err != nil {
+// validate would ensure that we have a closed loop for all the polygons. We don't support open
+// loop polygons.
+func validate(g geom.T) (geom.T, error) {
+	switch v := g.(type) {
+	case *geom.MultiPolygon:
+		for i := 0; i < v.NumPolygons(); i++ {
+			if err := closedPolygon(v.Polygon(i)); err != nil {
 				return nil, err
 			}
 		}
-		return g, nil
+	case *geom.Polygon:
+		if err := closedPolygon(v); err != nil {
+			return nil, err
+		}
+	}
+	return g, nil
+}
+
+func convertToGeom(str string) (geom.T, error) {
+	if isWKT(strings.TrimSpace(str)) {
+		t, err := ParseWKT(str)
+		if err != nil {
+			return nil, err
+		}
+		return validate(t)
 	}
 
 	var g geojson.Geometry
@@ -19,6 +19,8 @@ package types
 import (
 	"log"
 
+	"github.com/golang/geo/r3"
+	"github.com/golang/geo/s1"
 	"github.com/golang/geo/s2"
 	geom "github.com/twpayne/go-geom"
 
@@ -72,6 +74,21 @@ const (
 // parents or only the cover or both depending on whether it is a within, contains or intersects
 // query.
 func indexCells(g geom.T) (parents, cover s2.CellUnion, err error) {
+	if c, ok := g.(*geom.GeometryCollection); ok {
+		// The cover of a collection is made of the covers of its geometries.
+		for i := 0; i < c.NumGeoms(); i++ {
+			_, cu, err := indexCells(c.Geom(i))
+			if err != nil {
+				return nil, nil, err
+			}
+			cover = append(cover, cu...)
+		}
+		if len(cover) == 0 {
+			return nil, nil, errors.Errorf("Cannot index an empty geometry collection")
+		}
+		cover = uniqueCells(cover)
+		return getParentCells(cover, MinCellLevel), cover, nil
+	}
 	if g.Stride() != 2 {
 		return nil, nil, errors.Errorf("Covering only available for 2D co-ordinates.")
 	}
@@ -101,6 +118,25 @@ func indexCells(g geom.T) (parents, cover s2.CellUnion, err error) {
 		// Get parents for all cells in cover.
 		parents := getParentCells(cover, MinCellLevel)
 		return parents, cover, nil
+	case *geom.LineString:
+		cover, err := coverLineString(v)
+		if err != nil {
+			return nil, nil, err
+		}
+		parents := getParentCells(cover, MinCellLevel)
+		return parents, cover, nil
+	case *geom.MultiLineString:
+		var cover s2.CellUnion
+		for i := 0; i < v.NumLineStrings(); i++ {
+			c, err := coverLineString(v.LineString(i))
+			if err != nil {
+				return nil, nil, err
+			}
+			cover = append(cover, c...)
+		}
+		cover = uniqueCells(cover)
+		parents := getParentCells(cover, MinCellLevel)
+		return parents, cover, nil
 	default:
 		return nil, nil, errors.Errorf("Cannot index geometry of type %T", v)
 	}
@@ -187,6 +223,115 @@ func loopFromRing(r *geom.LinearRing, reverse bool) *s2.Loop {
 	return s2.LoopFromPoints(pts)
 }
 
+// pointsFromLineString converts a geom.LineString to the s2 points of its vertices.
+func pointsFromLineString(l *geom.LineString) ([]s2.Point, error) {
+	n := l.NumCoords()
+	if n < 2 {
+		return nil, errors.Errorf("Can't convert line string with less than 2 pts")
+	}
+	pts := make([]s2.Point, n)
+	for i := 0; i < n; i++ {
+		pts[i] = pointFromCoord(l.Coord(i))
+	}
+	return pts, nil
+}
+
+// polylineRegion is the s2.Region of a line string, used to cover it. It is implemented here
+// with the same edge crossing primitives as the loops, so that lines and polygons match.
+type polylineRegion []s2.Point
+
+// CapBound returns a cap containing the line. By the triangle inequality, no point of an edge is
+// farther from the center than its closest end point plus half of its length.
+func (p polylineRegion) CapBound() s2.Cap {
+	var sum r3.Vector
+	for _, pt := range p {
+		sum = sum.Add(pt.Vector)
+	}
+	center := s2.Point{Vector: sum.Normalize()}
+	if sum.Norm2() == 0 {
+		center = p[0]
+	}
+	var radius s1.Angle
+	for i := 0; i+1 < len(p); i++ {
+		d := center.Angle(p[i].Vector)
+		if db := center.Angle(p[i+1].Vector); db > d {
+			d = db
+		}
+		if d += p[i].Angle(p[i+1].Vector) / 2; d > radius {
+			radius = d
+		}
+	}
+	return s2.CapFromCenterAngle(center, radius)
+}
+
+func (p polylineRegion) RectBound() s2.Rect {
+	return p.CapBound().RectBound()
+}
+
+// ContainsCell returns false, since a line has no area.
+func (p polylineRegion) ContainsCell(c s2.Cell) bool {
+	return false
+}
+
+// ContainsPoint returns false, since the line doesn't bound a region.
+func (p polylineRegion) ContainsPoint(pt s2.Point) bool {
+	return false
+}
+
+// IntersectsCell returns true if a vertex of the line is in the cell or one of its edges crosses
+// an edge of the cell.
+func (p polylineRegion) IntersectsCell(c s2.Cell) bool {
+	for _, pt := range p {
+		if c.ContainsPoint(pt) {
+			return true
+		}
+	}
+	for k := 0; k < 4; k++ {
+		if polylineCrossesEdge(p, c.Vertex(k), c.Vertex((k+1)%4)) {
+			return true
+		}
+	}
+	return false
+}
+
+// polylineCrossesEdge returns true if an edge of the line crosses the edge from a to b.
+func polylineCrossesEdge(pts []s2.Point, a, b s2.Point) bool {
+	crosser := s2.NewChainEdgeCrosser(a, b, pts[0])
+	for i := 1; i < len(pts); i++ {
+		if crosser.EdgeOrVertexChainCrossing(pts[i]) {
+			return true
+		}
+	}
+	return false
+}
+
+func coverLineString(l *geom.LineString) (s2.CellUnion, error) {
+	pts, err := pointsFromLineString(l)
+	if err != nil {
+		return nil, err
+	}
+	rc := &s2.RegionCoverer{
+		MinLevel: MinCellLevel,
+		MaxLevel: MaxCellLevel,
+		LevelMod: 0,
+		MaxCells: MaxCells,
+	}
+	return rc.Covering(polylineRegion(pts)), nil
+}
+
+// uniqueCells removes the duplicate cells from the cell union.
+func uniqueCells(cu s2.CellUnion) s2.CellUnion {
+	seen := make(map[s2.CellID]bool, len(cu))
+	out := cu[:0]
+	for _, c := range cu {
+		if !seen[c] {
+			seen[c] = true
+			out = append(out, c)
+		}
+	}
+	return out
+}
+
 // create cells for point from the minLevel to maxLevel both inclusive.
 func indexCellsForPoint(p *geom.Point, minLevel, maxLevel int) (s2.CellUnion, s2.CellUnion) {
 	if maxLevel < minLevel {
@@ -110,6 +110,53 @@ func TestIndexCellsPolygonError(t *testing.T) {
 	require.Contains(t, err.Error(), "Last coordinate not same as first")
 }
 
+func TestIndexCellsLineString(t *testing.T) {
+	l := geom.NewLineString(geom.XY).MustSetCoords([]geom.Coord{
+		{-122.5, 37.7}, {-122.3, 37.8}, {-122.1, 37.5}})
+	parents, cover, err := indexCells(l)
+	require.NoError(t, err)
+	require.NotEmpty(t, cover)
+	require.True(t, len(cover) <= MaxCells)
+	for _, c := range cover {
+		require.Contains(t, parents, c)
+	}
+	// The cells of the vertices should be part of the cover.
+	for i := 0; i < l.NumCoords(); i++ {
+		p := geom.NewPoint(geom.XY).MustSetCoords(l.Coord(i))
+		_, pc, err := indexCells(p)
+		require.NoError(t, err)
+		var found bool
+		for _, c := range cover {
+			found = found || c.Contains(pc[0])
+		}
+		require.True(t, found)
+	}
+
+	_, _, err = indexCells(geom.NewLineString(geom.XY).MustSetCoords([]geom.Coord{{-122, 37}}))
+	require.Error(t, err)
+}
+
+func TestIndexCellsGeometryCollection(t *testing.T) {
+	p := geom.NewPoint(geom.XY).MustSetCoords(geom.Coord{-122.082506, 37.4249518})
+	l := geom.NewLineString(geom.XY).MustSetCoords([]geom.Coord{{-71.1, 42.3}, {-71.0, 42.4}})
+	c := geom.NewGeometryCollection()
+	require.NoError(t, c.Push(p, l))
+
+	parents, cover, err := indexCells(c)
+	require.NoError(t, err)
+	_, pc, err := indexCells(p)
+	require.NoError(t, err)
+	_, lc, err := indexCells(l)
+	require.NoError(t, err)
+	require.Len(t, cover, len(pc)+len(lc))
+	for _, cell := range cover {
+		require.Contains(t, parents, cell)
+	}
+
+	_, _, err = indexCells(geom.NewGeometryCollection())
+	require.Error(t, err)
+}
+
 func TestKeyGeneratorPoint(t *testing.T) {
 	p := geom.NewPoint(geom.XY).MustSetCoords(geom.Coord{-122.082506, 37.4249518})
 	data, err := wkb.Marshal(p, binary.LittleEndian)
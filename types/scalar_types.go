@@ -55,6 +55,14 @@ const (
 	PasswordID = TypeID(pb.Posting_PASSWORD)
 	// StringID represents the string type.
 	StringID = TypeID(pb.Posting_STRING)
+	// DecimalID represents the arbitrary-precision decimal type.
+	DecimalID = TypeID(pb.Posting_DECIMAL)
+	// DurationID represents the duration type.
+	DurationID = TypeID(pb.Posting_DURATION)
+	// IntervalID represents the time interval type.
+	IntervalID = TypeID(pb.Posting_INTERVAL)
+	// JSONID represents the JSON document type.
+	JSONID = TypeID(pb.Posting_JSON)
 	// UndefinedID represents the undefined type.
 	UndefinedID = TypeID(100)
 )
@@ -70,6 +78,10 @@ var typeNameMap = map[string]TypeID{
 	"uid":      UidID,
 	"string":   StringID,
 	"password": PasswordID,
+	"decimal":  DecimalID,
+	"duration": DurationID,
+	"interval": IntervalID,
+	"json":     JSONID,
 }
 
 // TypeID represents the type of the data.
@@ -103,6 +115,14 @@ func (t TypeID) Name() string {
 		return "string"
 	case PasswordID:
 		return "password"
+	case DecimalID:
+		return "decimal"
+	case DurationID:
+		return "duration"
+	case IntervalID:
+		return "interval"
+	case JSONID:
+		return "json"
 	}
 	return ""
 }
@@ -187,6 +207,22 @@ func ValueForType(id TypeID) Val {
 		var p string
 		return Val{PasswordID, p}
 
+	case DecimalID:
+		var d Decimal
+		return Val{DecimalID, &d}
+
+	case DurationID:
+		var d time.Duration
+		return Val{DurationID, &d}
+
+	case IntervalID:
+		var i Interval
+		return Val{IntervalID, &i}
+
+	case JSONID:
+		var j string
+		return Val{JSONID, j}
+
 	default:
 		return Val{}
 	}
@@ -91,7 +91,7 @@ func (s byValue) Less(i, j int) bool {
 // IsSortable returns true, if tid is sortable. Otherwise it returns false.
 func IsSortable(tid TypeID) bool {
 	switch tid {
-	case DateTimeID, IntID, FloatID, StringID, DefaultID:
+	case DateTimeID, IntID, FloatID, StringID, DefaultID, DecimalID, DurationID, IntervalID:
 		return true
 	default:
 		return false
@@ -138,7 +138,8 @@ func Less(a, b Val) (bool, error) {
 	}
 	typ := a.Tid
 	switch typ {
-	case DateTimeID, UidID, IntID, FloatID, StringID, DefaultID:
+	case DateTimeID, UidID, IntID, FloatID, StringID, DefaultID, DecimalID, DurationID,
+		IntervalID:
 		// Don't do anything, we can sort values of this type.
 	default:
 		return false, errors.Errorf("Compare not supported for type: %v", a.Tid)
@@ -157,6 +158,17 @@ func less(a, b Val, cl *collate.Collator) bool {
 		return (a.Value.(int64)) < (b.Value.(int64))
 	case FloatID:
 		return (a.Value.(float64)) < (b.Value.(float64))
+	case DecimalID:
+		return a.Value.(Decimal).Cmp(b.Value.(Decimal)) < 0
+	case DurationID:
+		return a.Value.(time.Duration) < b.Value.(time.Duration)
+	case IntervalID:
+		// Intervals are ordered by their start, and then by their end.
+		ai, bi := a.Value.(Interval), b.Value.(Interval)
+		if !ai.Start.Equal(bi.Start) {
+			return ai.Start.Before(bi.Start)
+		}
+		return ai.End.Before(bi.End)
 	case UidID:
 		return (a.Value.(uint64) < b.Value.(uint64))
 	case StringID, DefaultID:
@@ -171,6 +183,15 @@ func less(a, b Val, cl *collate.Collator) bool {
 
 func mismatchedLess(a, b Val) bool {
 	x.AssertTrue(a.Tid != b.Tid)
+	if a.Tid == DecimalID || b.Tid == DecimalID {
+		// Decimals are compared with ints and floats exactly, by converting them to decimals.
+		ad, aErr := ToDecimal(a)
+		bd, bErr := ToDecimal(b)
+		if aErr == nil && bErr == nil {
+			return ad.Cmp(bd) < 0
+		}
+		return a.Tid < b.Tid
+	}
 	if (a.Tid != IntID && a.Tid != FloatID) || (b.Tid != IntID && b.Tid != FloatID) {
 		// Non-float/int are sorted arbitrarily by type.
 		return a.Tid < b.Tid
@@ -194,7 +215,8 @@ func Equal(a, b Val) (bool, error) {
 	}
 	typ := a.Tid
 	switch typ {
-	case DateTimeID, IntID, FloatID, StringID, DefaultID, BoolID:
+	case DateTimeID, IntID, FloatID, StringID, DefaultID, BoolID, DecimalID, DurationID,
+		IntervalID, JSONID:
 		// Don't do anything, we can sort values of this type.
 	default:
 		return false, errors.Errorf("Equal not supported for type: %v", a.Tid)
@@ -219,7 +241,19 @@ func equal(a, b Val) bool {
 		aVal, aOk := a.Value.(float64)
 		bVal, bOk := b.Value.(float64)
 		return aOk && bOk && aVal == bVal
-	case StringID, DefaultID:
+	case DecimalID:
+		aVal, aOk := a.Value.(Decimal)
+		bVal, bOk := b.Value.(Decimal)
+		return aOk && bOk && aVal.Cmp(bVal) == 0
+	case DurationID:
+		aVal, aOk := a.Value.(time.Duration)
+		bVal, bOk := b.Value.(time.Duration)
+		return aOk && bOk && aVal == bVal
+	case IntervalID:
+		aVal, aOk := a.Value.(Interval)
+		bVal, bOk := b.Value.(Interval)
+		return aOk && bOk && aVal.Start.Equal(bVal.Start) && aVal.End.Equal(bVal.End)
+	case StringID, DefaultID, JSONID:
 		aVal, aOk := a.Value.(string)
 		bVal, bOk := b.Value.(string)
 		return aOk && bOk && aVal == bVal
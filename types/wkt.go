@@ -0,0 +1,349 @@
+/*
+ * Copyright 2022 Dgraph Labs, Inc. and Contributors
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package types
+
+import (
+	"bytes"
+	"encoding/hex"
+	"strconv"
+	"strings"
+
+	"github.com/pkg/errors"
+	geom "github.com/twpayne/go-geom"
+	"github.com/twpayne/go-geom/encoding/geojson"
+	"github.com/twpayne/go-geom/encoding/wkb"
+	"github.com/twpayne/go-geom/encoding/wkt"
+)
+
+// parseGeo parses a geo value given as a string. The value can be GeoJSON, WKT or hex encoded
+// WKB, as produced by most GIS tools. Like the values of mutations, the polygons must be closed.
+func parseGeo(s string) (geom.T, error) {
+	s = strings.TrimSpace(s)
+	if isWKT(s) {
+		g, err := ParseWKT(s)
+		if err != nil {
+			return nil, errors.Wrapf(err, "Error while unmarshalling: [%s] as WKT", s)
+		}
+		return validate(g)
+	}
+	if isHexWKB(s) {
+		b, err := hex.DecodeString(s)
+		if err != nil {
+			return nil, err
+		}
+		g, err := wkb.Unmarshal(b)
+		if err != nil {
+			return nil, errors.Wrapf(err, "Error while unmarshalling: [%s] as WKB", s)
+		}
+		return validate(g)
+	}
+
+	var g geom.T
+	text := bytes.Replace([]byte(s), []byte("'"), []byte("\""), -1)
+	if err := geojson.Unmarshal(text, &g); err != nil {
+		return nil, errors.Wrapf(err, "Error while unmarshalling: [%s] as geojson", s)
+	}
+	return validate(g)
+}
+
+// MarshalWKT returns the WKT representation of the geo value.
+func MarshalWKT(g geom.T) (string, error) {
+	return wkt.Marshal(g)
+}
+
+var wktTypes = []string{"POINT", "LINESTRING", "POLYGON", "MULTIPOINT", "MULTILINESTRING",
+	"MULTIPOLYGON", "GEOMETRYCOLLECTION"}
+
+func isWKT(s string) bool {
+	end := strings.IndexAny(s, " (")
+	if end < 0 {
+		end = len(s)
+	}
+	word := strings.ToUpper(s[:end])
+	for _, t := range wktTypes {
+		if word == t {
+			return true
+		}
+	}
+	return false
+}
+
+// isHexWKB returns true if the string looks like hex encoded WKB, which starts with the byte
+// order, 00 for big endian and 01 for little endian.
+func isHexWKB(s string) bool {
+	if len(s) < 10 || len(s)%2 != 0 {
+		return false
+	}
+	if !strings.HasPrefix(s, "00") && !strings.HasPrefix(s, "01") {
+		return false
+	}
+	for _, c := range s {
+		if !strings.ContainsRune("0123456789abcdefABCDEF", c) {
+			return false
+		}
+	}
+	return true
+}
+
+// ParseWKT parses a 2D geometry in the Well-Known Text format, like
+// POLYGON ((30 10, 40 40, 20 40, 10 20, 30 10)).
+func ParseWKT(s string) (geom.T, error) {
+	p := &wktParser{s: s}
+	g, err := p.geometry()
+	if err != nil {
+		return nil, err
+	}
+	if p.skipSpace(); p.pos < len(p.s) {
+		return nil, errors.Errorf("Unexpected %q at position %d", p.s[p.pos:], p.pos)
+	}
+	return g, nil
+}
+
+type wktParser struct {
+	s   string
+	pos int
+}
+
+func (p *wktParser) skipSpace() {
+	for p.pos < len(p.s) && strings.IndexByte(" \t\r\n", p.s[p.pos]) >= 0 {
+		p.pos++
+	}
+}
+
+// word returns the upper cased keyword at the current position.
+func (p *wktParser) word() string {
+	p.skipSpace()
+	start := p.pos
+	for p.pos < len(p.s) {
+		c := p.s[p.pos] | 0x20
+		if c < 'a' || c > 'z' {
+			break
+		}
+		p.pos++
+	}
+	return strings.ToUpper(p.s[start:p.pos])
+}
+
+// consume skips the next character if it is c.
+func (p *wktParser) consume(c byte) bool {
+	p.skipSpace()
+	if p.pos < len(p.s) && p.s[p.pos] == c {
+		p.pos++
+		return true
+	}
+	return false
+}
+
+func (p *wktParser) expect(c byte) error {
+	if !p.consume(c) {
+		return errors.Errorf("Expected %q at position %d", c, p.pos)
+	}
+	return nil
+}
+
+// empty consumes the EMPTY keyword if it is at the current position.
+func (p *wktParser) empty() bool {
+	start := p.pos
+	if p.word() == "EMPTY" {
+		return true
+	}
+	p.pos = start
+	return false
+}
+
+// list parses a comma separated list of elements, up to the closing parenthesis.
+func (p *wktParser) list(elem func() error) error {
+	for {
+		if err := elem(); err != nil {
+			return err
+		}
+		if !p.consume(',') {
+			return p.expect(')')
+		}
+	}
+}
+
+func (p *wktParser) number() (float64, error) {
+	p.skipSpace()
+	start := p.pos
+	for p.pos < len(p.s) && strings.IndexByte("0123456789+-.eE", p.s[p.pos]) >= 0 {
+		p.pos++
+	}
+	f, err := strconv.ParseFloat(p.s[start:p.pos], 64)
+	if err != nil {
+		return 0, errors.Errorf("Invalid number at position %d", start)
+	}
+	return f, nil
+}
+
+func (p *wktParser) coord() (geom.Coord, error) {
+	x, err := p.number()
+	if err != nil {
+		return nil, err
+	}
+	y, err := p.number()
+	if err != nil {
+		return nil, err
+	}
+	return geom.Coord{x, y}, nil
+}
+
+// coords parses a parenthesized list of coordinates.
+func (p *wktParser) coords() ([]geom.Coord, error) {
+	if err := p.expect('('); err != nil {
+		return nil, err
+	}
+	var coords []geom.Coord
+	err := p.list(func() error {
+		c, err := p.coord()
+		coords = append(coords, c)
+		return err
+	})
+	return coords, err
+}
+
+// rings parses a parenthesized list of coordinate lists.
+func (p *wktParser) rings() ([][]geom.Coord, error) {
+	if err := p.expect('('); err != nil {
+		return nil, err
+	}
+	var rings [][]geom.Coord
+	err := p.list(func() error {
+		r, err := p.coords()
+		rings = append(rings, r)
+		return err
+	})
+	return rings, err
+}
+
+func (p *wktParser) geometry() (geom.T, error) {
+	typ := p.word()
+	start := p.pos
+	switch dim := p.word(); dim {
+	case "Z", "M", "ZM":
+		return nil, errors.Errorf("Only 2D geometries are supported, got %s %s", typ, dim)
+	}
+	p.pos = start
+
+	switch typ {
+	case "POINT":
+		if p.empty() {
+			return geom.NewPointEmpty(geom.XY), nil
+		}
+		if err := p.expect('('); err != nil {
+			return nil, err
+		}
+		c, err := p.coord()
+		if err != nil {
+			return nil, err
+		}
+		if err := p.expect(')'); err != nil {
+			return nil, err
+		}
+		return geom.NewPoint(geom.XY).SetCoords(c)
+	case "LINESTRING":
+		if p.empty() {
+			return geom.NewLineString(geom.XY), nil
+		}
+		coords, err := p.coords()
+		if err != nil {
+			return nil, err
+		}
+		return geom.NewLineString(geom.XY).SetCoords(coords)
+	case "POLYGON":
+		if p.empty() {
+			return geom.NewPolygon(geom.XY), nil
+		}
+		rings, err := p.rings()
+		if err != nil {
+			return nil, err
+		}
+		return geom.NewPolygon(geom.XY).SetCoords(rings)
+	case "MULTIPOINT":
+		if p.empty() {
+			return geom.NewMultiPoint(geom.XY), nil
+		}
+		if err := p.expect('('); err != nil {
+			return nil, err
+		}
+		// The points may or may not be enclosed in parentheses.
+		var coords []geom.Coord
+		err := p.list(func() error {
+			enclosed := p.consume('(')
+			c, err := p.coord()
+			if err != nil {
+				return err
+			}
+			coords = append(coords, c)
+			if enclosed {
+				return p.expect(')')
+			}
+			return nil
+		})
+		if err != nil {
+			return nil, err
+		}
+		return geom.NewMultiPoint(geom.XY).SetCoords(coords)
+	case "MULTILINESTRING":
+		if p.empty() {
+			return geom.NewMultiLineString(geom.XY), nil
+		}
+		lines, err := p.rings()
+		if err != nil {
+			return nil, err
+		}
+		return geom.NewMultiLineString(geom.XY).SetCoords(lines)
+	case "MULTIPOLYGON":
+		if p.empty() {
+			return geom.NewMultiPolygon(geom.XY), nil
+		}
+		if err := p.expect('('); err != nil {
+			return nil, err
+		}
+		var polygons [][][]geom.Coord
+		err := p.list(func() error {
+			rings, err := p.rings()
+			polygons = append(polygons, rings)
+			return err
+		})
+		if err != nil {
+			return nil, err
+		}
+		return geom.NewMultiPolygon(geom.XY).SetCoords(polygons)
+	case "GEOMETRYCOLLECTION":
+		c := geom.NewGeometryCollection()
+		if p.empty() {
+			return c, nil
+		}
+		if err := p.expect('('); err != nil {
+			return nil, err
+		}
+		err := p.list(func() error {
+			g, err := p.geometry()
+			if err != nil {
+				return err
+			}
+			return c.Push(g)
+		})
+		if err != nil {
+			return nil, err
+		}
+		return c, nil
+	default:
+		return nil, errors.Errorf("Unknown WKT geometry type %q", typ)
+	}
+}
@@ -0,0 +1,119 @@
+/*
+ * Copyright 2022 Dgraph Labs, Inc. and Contributors
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package types
+
+import (
+	"encoding/hex"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	geom "github.com/twpayne/go-geom"
+)
+
+// toWKB converts the string to a geo value and returns its WKB encoding, which is what is stored.
+func toWKB(t *testing.T, s string) []byte {
+	g, err := Convert(Val{Tid: StringID, Value: []byte(s)}, GeoID)
+	require.NoError(t, err, s)
+	b := ValueForType(BinaryID)
+	require.NoError(t, Marshal(g, &b), s)
+	return b.Value.([]byte)
+}
+
+func TestParseWKT(t *testing.T) {
+	tests := []struct {
+		wkt     string
+		geojson string
+	}{
+		{"POINT (1 2)", `{'type':'Point','coordinates':[1,2]}`},
+		{"point(-122.5 37.5)", `{'type':'Point','coordinates':[-122.5,37.5]}`},
+		{"LINESTRING (30 10, 10 30, 40 40)",
+			`{'type':'LineString','coordinates':[[30,10],[10,30],[40,40]]}`},
+		{"POLYGON ((30 10, 40 40, 20 40, 10 20, 30 10), (20 30, 35 35, 30 20, 20 30))",
+			`{'type':'Polygon','coordinates':[[[30,10],[40,40],[20,40],[10,20],[30,10]],` +
+				`[[20,30],[35,35],[30,20],[20,30]]]}`},
+		{"MULTIPOINT ((10 40), (40 30))",
+			`{'type':'MultiPoint','coordinates':[[10,40],[40,30]]}`},
+		{"MULTIPOINT (10 40, 40 30)",
+			`{'type':'MultiPoint','coordinates':[[10,40],[40,30]]}`},
+		{"MULTILINESTRING ((10 10, 20 20), (40 40, 30 30, 40 20))",
+			`{'type':'MultiLineString','coordinates':[[[10,10],[20,20]],[[40,40],[30,30],[40,20]]]}`},
+		{"MULTIPOLYGON (((30 20, 45 40, 10 40, 30 20)), ((15 5, 40 10, 10 20, 5 10, 15 5)))",
+			`{'type':'MultiPolygon','coordinates':[[[[30,20],[45,40],[10,40],[30,20]]],` +
+				`[[[15,5],[40,10],[10,20],[5,10],[15,5]]]]}`},
+		{"GEOMETRYCOLLECTION (POINT (4 6), LINESTRING (4 6, 7 10))",
+			`{'type':'GeometryCollection','geometries':[{'type':'Point','coordinates':[4,6]},` +
+				`{'type':'LineString','coordinates':[[4,6],[7,10]]}]}`},
+	}
+	for _, test := range tests {
+		require.Equal(t, toWKB(t, test.geojson), toWKB(t, test.wkt), test.wkt)
+	}
+}
+
+func TestParseWKTErrors(t *testing.T) {
+	tests := []string{
+		"POINT (1)",
+		"POINT (1 2",
+		"POINT Z (1 2 3)",
+		"LINESTRING (1 2, 3 4) extra",
+		"POLYGON (1 2, 3 4)",
+		"CIRCULARSTRING (1 0, 0 1, -1 0)",
+	}
+	for _, test := range tests {
+		_, err := ParseWKT(test)
+		require.Error(t, err, test)
+	}
+}
+
+func TestParseGeoOpenPolygon(t *testing.T) {
+	tests := []string{
+		"POLYGON ((30 10, 40 40, 20 40, 10 20))",
+		"MULTIPOLYGON (((30 10, 40 40, 20 40, 10 20, 30 10)), ((15 5, 40 10, 10 20)))",
+		`{'type':'Polygon','coordinates':[[[30,10],[40,40],[20,40],[10,20]]]}`,
+		// The WKB of POLYGON ((0 0, 1 0, 1 1)).
+		"0103000000010000000300000000000000000000000000000000000000000000000000f03f" +
+			"0000000000000000000000000000f03f000000000000f03f",
+	}
+	for _, test := range tests {
+		_, err := Convert(Val{Tid: StringID, Value: []byte(test)}, GeoID)
+		require.Error(t, err, test)
+	}
+}
+
+func TestParseHexWKB(t *testing.T) {
+	b := toWKB(t, `{'type':'LineString','coordinates':[[30,10],[10,30],[40,40]]}`)
+	require.Equal(t, b, toWKB(t, hex.EncodeToString(b)))
+
+	_, err := Convert(Val{Tid: StringID, Value: []byte("0102000000ff")}, GeoID)
+	require.Error(t, err)
+}
+
+func TestWKTRoundTrip(t *testing.T) {
+	tests := []string{
+		`{'type':'Point','coordinates':[1.5,-2.25]}`,
+		`{'type':'Polygon','coordinates':[[[30,10],[40,40],[20,40],[10,20],[30,10]]]}`,
+		`{'type':'MultiLineString','coordinates':[[[10,10],[20,20]],[[40,40],[30,30]]]}`,
+		`{'type':'GeometryCollection','geometries':[{'type':'Point','coordinates':[4,6]},` +
+			`{'type':'Polygon','coordinates':[[[0,0],[1,0],[1,1],[0,0]]]}]}`,
+	}
+	for _, test := range tests {
+		g, err := Convert(Val{Tid: StringID, Value: []byte(test)}, GeoID)
+		require.NoError(t, err)
+		wkt, err := MarshalWKT(g.Value.(geom.T))
+		require.NoError(t, err)
+		require.Equal(t, toWKB(t, test), toWKB(t, wkt), wkt)
+	}
+}
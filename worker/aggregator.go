@@ -28,10 +28,14 @@ func couldApplyAggregatorOn(agrtr string, typ types.TypeID) bool {
 			typ == types.FloatID ||
 			typ == types.DateTimeID ||
 			typ == types.StringID ||
-			typ == types.DefaultID)
+			typ == types.DefaultID ||
+			typ == types.DecimalID ||
+			typ == types.DurationID)
 	case "sum", "avg":
 		return (typ == types.IntID ||
-			typ == types.FloatID)
+			typ == types.FloatID ||
+			typ == types.DecimalID ||
+			typ == types.DurationID)
 	default:
 		return false
 	}
@@ -204,8 +204,10 @@ func (pr *BackupProcessor) WriteBackup(ctx context.Context) (*pb.BackupResponse,
 			return false
 		}
 
-		// Skip backing up the schema and type keys. They will be backed up separately.
-		if parsedKey.IsSchema() || parsedKey.IsType() {
+		// Skip backing up the schema, type, synonym, schema version, trigger and stored query
+		// keys. They will be backed up separately.
+		if parsedKey.IsSchema() || parsedKey.IsType() || parsedKey.IsSynonym() ||
+			parsedKey.IsSchemaVersion() || parsedKey.IsTrigger() || parsedKey.IsStoredQuery() {
 			return false
 		}
 		_, ok := predMap[parsedKey.Attr]
@@ -257,8 +259,12 @@ func (pr *BackupProcessor) WriteBackup(ctx context.Context) (*pb.BackupResponse,
 				glog.Errorf("error %v while parsing key %v during backup. Skip.", err, hex.EncodeToString(item.Key()))
 				continue
 			}
-			// This check makes sense only for the schema keys. The types are not stored in it.
-			if _, ok := predMap[parsedKey.Attr]; !parsedKey.IsType() && !ok {
+			// This check makes sense only for the schema keys. The types, synonyms, schema
+			// versions, triggers and stored queries are not stored in it.
+			_, ok := predMap[parsedKey.Attr]
+			if !ok && !parsedKey.IsType() && !parsedKey.IsSynonym() &&
+				!parsedKey.IsSchemaVersion() && !parsedKey.IsTrigger() &&
+				!parsedKey.IsStoredQuery() {
 				continue
 			}
 			kv := y.NewKV(tl.alloc)
@@ -282,7 +288,8 @@ func (pr *BackupProcessor) WriteBackup(ctx context.Context) (*pb.BackupResponse,
 		return writeKVList(list, cWriter)
 	}
 
-	for _, prefix := range []byte{x.ByteSchema, x.ByteType} {
+	for _, prefix := range []byte{x.ByteSchema, x.ByteType, x.ByteSynonym,
+		x.ByteSchemaVersion, x.ByteTrigger, x.ByteStoredQuery} {
 		if err := writePrefix(prefix); err != nil {
 			glog.Errorf("While writing prefix %d to backup: %v", prefix, err)
 			return &response, err
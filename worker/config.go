@@ -58,6 +58,14 @@ type Options struct {
 
 	Audit *x.LoggerConf
 
+	// SlowQuery is the configuration of the slow query log, nil if it is disabled.
+	SlowQuery *x.LoggerConf
+	// SlowQueryLatency is the latency from which a request is logged as slow, zero for no limit.
+	SlowQueryLatency time.Duration
+	// SlowQueryEdges is the number of edges from which a request is logged as slow, zero for no
+	// limit.
+	SlowQueryEdges uint64
+
 	// Define different ChangeDataCapture configurations
 	ChangeDataConf string
 }
@@ -90,14 +98,19 @@ func (opt *Options) validate() {
 		"Posting and Tmp directory cannot be the same ('%s').", opt.PostingDir)
 	x.AssertTruef(wd != td,
 		"WAL and Tmp directory cannot be the same ('%s').", opt.WALDir)
-	if opt.Audit != nil {
-		ad, err := filepath.Abs(opt.Audit.Output)
+	checkLogOutput := func(name string, conf *x.LoggerConf) {
+		if conf == nil || conf.Output == "stdout" {
+			return
+		}
+		ad, err := filepath.Abs(conf.Output)
 		x.Check(err)
 		x.AssertTruef(ad != pd,
-			"Posting directory and Audit Output cannot be the same ('%s').", opt.Audit.Output)
+			"Posting directory and %s Output cannot be the same ('%s').", name, conf.Output)
 		x.AssertTruef(ad != wd,
-			"WAL directory and Audit Output cannot be the same ('%s').", opt.Audit.Output)
+			"WAL directory and %s Output cannot be the same ('%s').", name, conf.Output)
 		x.AssertTruef(ad != td,
-			"Tmp directory and Audit Output cannot be the same ('%s').", opt.Audit.Output)
+			"Tmp directory and %s Output cannot be the same ('%s').", name, conf.Output)
 	}
+	checkLogOutput("Audit", opt.Audit)
+	checkLogOutput("Slow Query", opt.SlowQuery)
 }
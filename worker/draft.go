@@ -288,7 +288,7 @@ func newNode(store *raftwal.DiskStorage, gid uint32, id uint64, myAddr string) *
 		// to maintain quorum health.
 		applyCh:    make(chan []raftpb.Entry, 1000),
 		elog:       trace.NewEventLog("Dgraph", "ApplyCh"),
-		closer:     z.NewCloser(4), // Matches CLOSER:1
+		closer:     z.NewCloser(6), // Matches CLOSER:1
 		ops:        make(map[op]operation),
 		cdcTracker: newCDC(),
 	}
@@ -404,6 +404,79 @@ func (n *node) applyMutations(ctx context.Context, proposal *pb.Proposal) (rerr
 		return errors.New("StartTs must be provided")
 	}
 
+	if len(proposal.Mutations.Synonyms) > 0 {
+		span.Annotatef(nil, "Applying synonyms")
+		for _, syn := range proposal.Mutations.Synonyms {
+			if err := updateSynonym(*syn, proposal.Mutations.StartTs); err != nil {
+				return err
+			}
+		}
+		return nil
+	}
+
+	if len(proposal.Mutations.SchemaVersions) > 0 {
+		span.Annotatef(nil, "Applying schema versions")
+		for _, v := range proposal.Mutations.SchemaVersions {
+			if err := storeSchemaVersion(*v, proposal.Mutations.StartTs); err != nil {
+				return err
+			}
+		}
+		return nil
+	}
+
+	if len(proposal.Mutations.Triggers) > 0 {
+		span.Annotatef(nil, "Applying triggers")
+		for _, tr := range proposal.Mutations.Triggers {
+			if err := updateTrigger(*tr, proposal.Mutations.StartTs); err != nil {
+				return err
+			}
+		}
+		return nil
+	}
+
+	if len(proposal.Mutations.StoredQueries) > 0 {
+		span.Annotatef(nil, "Applying stored queries")
+		for _, sq := range proposal.Mutations.StoredQueries {
+			if err := updateStoredQuery(*sq, proposal.Mutations.StartTs); err != nil {
+				return err
+			}
+		}
+		return nil
+	}
+
+	if len(proposal.Mutations.CancelIndexing) > 0 {
+		span.Annotatef(nil, "Cancelling indexing")
+		for _, pred := range proposal.Mutations.CancelIndexing {
+			Tasks.cancelIndexing(pred)
+		}
+		return nil
+	}
+
+	if isTombstoneRemoval(proposal.Mutations) {
+		span.Annotatef(nil, "Removing tombstones")
+		for _, t := range proposal.Mutations.Tombstones {
+			if err := removeTombstone(*t, proposal.Mutations.StartTs); err != nil {
+				return err
+			}
+		}
+		return nil
+	}
+
+	if len(proposal.Mutations.TtlMarks) > 0 {
+		span.Annotatef(nil, "Applying ttl marks")
+		return applyTTLMarks(proposal.Mutations.TtlMarks, proposal.Mutations.StartTs)
+	}
+
+	if isTriggerEventRemoval(proposal.Mutations) {
+		span.Annotatef(nil, "Removing trigger events")
+		for _, ev := range proposal.Mutations.TriggerEvents {
+			if err := removeTriggerEvent(*ev, proposal.Mutations.StartTs); err != nil {
+				return err
+			}
+		}
+		return nil
+	}
+
 	if len(proposal.Mutations.Schema) > 0 || len(proposal.Mutations.Types) > 0 {
 		// MaxAssigned would ensure that everything that's committed up until this point
 		// would be picked up in building indexes. Any uncommitted txns would be cancelled
@@ -428,7 +501,9 @@ func (n *node) applyMutations(ctx context.Context, proposal *pb.Proposal) (rerr
 			n.ex.waitForActiveMutations()
 		}
 
-		if err := runSchemaMutation(ctx, proposal.Mutations.Schema, startTs); err != nil {
+		err := runSchemaMutation(ctx, proposal.Mutations.Schema, startTs,
+			proposal.Mutations.StartTs)
+		if err != nil {
 			return err
 		}
 
@@ -529,6 +604,16 @@ func (n *node) applyMutations(ctx context.Context, proposal *pb.Proposal) (rerr
 	// Discard the posting lists from cache to release memory at the end.
 	defer txn.Update()
 
+	if err := checkVersions(m, txn); err != nil {
+		return err
+	}
+	if len(m.Tombstones) > 0 {
+		addTombstones(txn, m.Tombstones)
+	}
+	if len(m.TriggerEvents) > 0 {
+		addTriggerEvents(txn, m.TriggerEvents)
+	}
+
 	process := func(edges []*pb.DirectedEdge) error {
 		var retries int
 		for _, edge := range edges {
@@ -652,8 +737,15 @@ func (n *node) applyCommitted(proposal *pb.Proposal, key uint64) error {
 			}
 			glog.Warningf("Error while calling CreateSnapshot: %v. Retrying...", err)
 		}
-		// We can now discard all invalid versions of keys below this ts.
-		pstore.SetDiscardTs(snap.ReadTs)
+		// We can now discard all invalid versions of keys below this ts, except for the ones
+		// kept for soft delete.
+		if ts, err := discardTs(snap.ReadTs); err != nil {
+			// Keep the previous discard timestamp, rather than discarding the versions which
+			// might still be needed.
+			glog.Errorf("While choosing the discard timestamp: %v", err)
+		} else {
+			pstore.SetDiscardTs(ts)
+		}
 		return nil
 	case proposal.Restore != nil:
 		// Enable draining mode for the duration of the restore processing.
@@ -1876,6 +1968,8 @@ func (n *node) InitAndStartNode() {
 		}
 	}
 	go n.processTabletSizes()
+	go n.processTTL()
+	go n.processTriggerEvents()
 	go n.processApplyCh()
 	go n.BatchAndSendMessages()
 	go n.monitorRaftMetrics()
@@ -45,6 +45,7 @@ import (
 	"github.com/dgraph-io/dgraph/ee/enc"
 	"github.com/dgraph-io/dgraph/posting"
 	"github.com/dgraph-io/dgraph/protos/pb"
+	"github.com/dgraph-io/dgraph/schema"
 	"github.com/dgraph-io/dgraph/types"
 	"github.com/dgraph-io/dgraph/types/facets"
 	"github.com/dgraph-io/dgraph/x"
@@ -78,6 +79,25 @@ type exporter struct {
 	attr      string
 	namespace uint64
 	readTs    uint64
+	ordered   bool
+}
+
+// iterate calls f with the postings of the list. The postings of an @ordered list are given in
+// their order, so that loading the export adds them in the same order.
+func (e *exporter) iterate(f func(p *pb.Posting) error) error {
+	if !e.ordered {
+		return e.pl.Iterate(e.readTs, 0, f)
+	}
+	posts, err := e.pl.OrderedPostings(e.readTs)
+	if err != nil {
+		return err
+	}
+	for _, p := range posts {
+		if err := f(p); err != nil {
+			return err
+		}
+	}
+	return nil
 }
 
 // Map from our types to RDF type. Useful when writing storage types
@@ -92,6 +112,12 @@ var rdfTypeMap = map[types.TypeID]string{
 	types.GeoID:      "geo:geojson",
 	types.BinaryID:   "xs:base64Binary",
 	types.PasswordID: "xs:password",
+	types.DecimalID:  "xs:decimal",
+	types.DurationID: "xs:duration",
+	// There is no standard type for intervals, they are converted back from strings by the
+	// schema.
+	types.IntervalID: "xs:string",
+	types.JSONID:     "rdf:JSON",
 }
 
 // UIDs like 0x1 look weird but 64-bit ones like 0x0000000000000001 are too long.
@@ -170,7 +196,7 @@ func (e *exporter) toJSON() (*bpb.KVList, error) {
 
 	continuing := false
 	mapStart := fmt.Sprintf("  {\"uid\":"+uidFmtStrJson+`,"namespace":"0x%x"`, e.uid, e.namespace)
-	err := e.pl.Iterate(e.readTs, 0, func(p *pb.Posting) error {
+	err := e.iterate(func(p *pb.Posting) error {
 		if continuing {
 			fmt.Fprint(bp, ",\n")
 		} else {
@@ -227,7 +253,7 @@ func (e *exporter) toRDF() (*bpb.KVList, error) {
 	bp := new(bytes.Buffer)
 
 	prefix := fmt.Sprintf(uidFmtStrRdf+" <%s> ", e.uid, e.attr)
-	err := e.pl.Iterate(e.readTs, 0, func(p *pb.Posting) error {
+	err := e.iterate(func(p *pb.Posting) error {
 		fmt.Fprint(bp, prefix)
 		if p.PostingType == pb.Posting_REF {
 			fmt.Fprint(bp, fmt.Sprintf(uidFmtStrRdf, p.Uid))
@@ -293,11 +319,20 @@ func (e *exporter) toRDF() (*bpb.KVList, error) {
 }
 
 func toSchema(attr string, update *pb.SchemaUpdate) *bpb.KV {
+	s := fmt.Sprintf("[%#x] %s", x.ParseNamespace(attr), FormatSchemaUpdate(attr, update))
+	//TODO(Naman): We don't need the version anymore.
+	return &bpb.KV{
+		Value:   []byte(s),
+		Version: 3, // Schema value
+	}
+}
+
+// FormatSchemaUpdate returns the schema of the predicate in the format accepted by alter,
+// without the namespace of the predicate.
+func FormatSchemaUpdate(attr string, update *pb.SchemaUpdate) string {
 	// bytes.Buffer never returns error for any of the writes. So, we don't need to check them.
-	ns, attr := x.ParseNamespaceAttr(attr)
+	attr = x.ParseAttr(attr)
 	var buf bytes.Buffer
-	x.Check2(buf.WriteString(fmt.Sprintf("[%#x]", ns)))
-	x.Check2(buf.WriteRune(' '))
 	x.Check2(buf.WriteRune('<'))
 	x.Check2(buf.WriteString(attr))
 	x.Check2(buf.WriteRune('>'))
@@ -326,28 +361,58 @@ func toSchema(attr string, update *pb.SchemaUpdate) *bpb.KV {
 	if update.GetUpsert() {
 		x.Check2(buf.WriteString(" @upsert"))
 	}
+	if update.GetOrdered() {
+		x.Check2(buf.WriteString(" @ordered"))
+	}
+	if update.GetTtl() > 0 {
+		x.Check2(buf.WriteString(fmt.Sprintf(" @ttl(%s)", schema.FormatTTL(update.GetTtl()))))
+	}
 	x.Check2(buf.WriteString(" . \n"))
-	//TODO(Naman): We don't need the version anymore.
-	return &bpb.KV{
-		Value:   buf.Bytes(),
-		Version: 3, // Schema value
+	return buf.String()
+}
+
+// toSynonyms returns the synonym set as JSON, which the updateSynonyms admin mutation takes.
+func toSynonyms(attr string, update pb.SynonymUpdate) (*bpb.KV, error) {
+	ns, name := x.ParseNamespaceAttr(attr)
+	val, err := json.Marshal(x.ExportedSynonyms{Namespace: ns, Name: name, Words: update.Words})
+	if err != nil {
+		return nil, errors.Wrapf(err, "while marshalling synonyms %s", name)
 	}
+	return &bpb.KV{
+		Value:   val,
+		Version: 3, // Synonym value
+	}, nil
 }
 
 func toType(attr string, update pb.TypeUpdate) *bpb.KV {
+	s := fmt.Sprintf("[%#x] %s", x.ParseNamespace(attr), FormatTypeUpdate(attr, &update))
+	return &bpb.KV{
+		Value:   []byte(s),
+		Version: 3, // Type value
+	}
+}
+
+// FormatTypeUpdate returns the definition of the type in the format accepted by alter, without
+// the namespace of the type.
+func FormatTypeUpdate(attr string, update *pb.TypeUpdate) string {
 	var buf bytes.Buffer
-	ns, attr := x.ParseNamespaceAttr(attr)
-	x.Check2(buf.WriteString(fmt.Sprintf("[%#x] type <%s> {\n", ns, attr)))
+	x.Check2(buf.WriteString(fmt.Sprintf("type <%s> ", x.ParseAttr(attr))))
+	if update.Extends != "" {
+		x.Check2(buf.WriteString(fmt.Sprintf("extends <%s> ", x.ParseAttr(update.Extends))))
+	}
+	if update.Strict {
+		x.Check2(buf.WriteString("@strict "))
+	}
+	if update.Ttl > 0 {
+		x.Check2(buf.WriteString(fmt.Sprintf("@ttl(%s) ", schema.FormatTTL(update.Ttl))))
+	}
+	x.Check2(buf.WriteString("{\n"))
 	for _, field := range update.Fields {
 		x.Check2(buf.WriteString(fieldToString(field)))
 	}
 
 	x.Check2(buf.WriteString("}\n"))
-
-	return &bpb.KV{
-		Value:   buf.Bytes(),
-		Version: 3, // Type value
-	}
+	return buf.String()
 }
 
 func fieldToString(update *pb.SchemaUpdate) string {
@@ -364,6 +429,9 @@ func fieldToString(update *pb.SchemaUpdate) string {
 	} else {
 		x.Check2(builder.WriteString(predicate))
 	}
+	if update.NonNullable {
+		x.Check2(builder.WriteString(" @required"))
+	}
 	x.Check2(builder.WriteString("\n"))
 	return builder.String()
 }
@@ -597,6 +665,12 @@ func exportInternal(ctx context.Context, in *pb.ExportRequest, db *badger.DB,
 		return nil, err
 	}
 
+	synonymWriter, err := exportStorage.openFile(
+		fmt.Sprintf("g%02d%s", in.GroupId, ".synonyms.gz"))
+	if err != nil {
+		return nil, err
+	}
+
 	// This stream exports only the data and the graphQL schema.
 	stream := db.NewStreamAt(in.ReadTs)
 	stream.Prefix = []byte{x.DefaultPrefix}
@@ -657,6 +731,8 @@ func exportInternal(ctx context.Context, in *pb.ExportRequest, db *badger.DB,
 			// Ignore this predicate.
 		case e.attr == "dgraph.graphql.p_query":
 			// Ignore this predicate.
+		case e.attr == x.VersionPredicate:
+			// Ignore this predicate. Importing the data gives the nodes new versions.
 		case pk.IsData() && e.attr == "dgraph.graphql.schema":
 			// Export the graphql schema.
 			pl, err := posting.ReadPostingList(key, itr)
@@ -710,6 +786,7 @@ func exportInternal(ctx context.Context, in *pb.ExportRequest, db *badger.DB,
 			if err != nil {
 				return nil, err
 			}
+			e.ordered = schema.State().IsOrdered(context.Background(), pk.Attr)
 
 			// The GraphQL layer will create a node of type "dgraph.graphql". That entry
 			// should not be exported.
@@ -795,7 +872,7 @@ func exportInternal(ctx context.Context, in *pb.ExportRequest, db *badger.DB,
 		})
 	}
 
-	// This is used to export the schema and types.
+	// This is used to export the schema, types and synonyms.
 	writePrefix := func(prefix byte) error {
 		txn := db.NewTransactionAt(in.ReadTs, false)
 		defer txn.Discard()
@@ -854,12 +931,37 @@ func exportInternal(ctx context.Context, in *pb.ExportRequest, db *badger.DB,
 				}
 				kv = toType(pk.Attr, update)
 
+			case x.ByteSynonym:
+				var update pb.SynonymUpdate
+				err := item.Value(func(val []byte) error {
+					return update.Unmarshal(val)
+				})
+				if err != nil {
+					// Let's not propagate this error. We just log this and continue onwards.
+					glog.Errorf("Unable to unmarshal synonyms: %+v. Err=%v\n", pk, err)
+					continue
+				}
+				kv, err = toSynonyms(pk.Attr, update)
+				if err != nil {
+					return err
+				}
+
 			default:
 				glog.Fatalf("Unhandled byte prefix: %v", prefix)
 			}
 
 			// Write to the appropriate writer.
-			if _, err := schemaWriter.gw.Write(kv.Value); err != nil {
+			writer := schemaWriter
+			if prefix == x.ByteSynonym {
+				writer = synonymWriter
+				if writer.hasDataBefore {
+					if _, err := writer.gw.Write([]byte(",\n")); err != nil {
+						return err
+					}
+				}
+				writer.hasDataBefore = true
+			}
+			if _, err := writer.gw.Write(kv.Value); err != nil {
 				return err
 			}
 		}
@@ -891,8 +993,19 @@ func exportInternal(ctx context.Context, in *pb.ExportRequest, db *badger.DB,
 		return nil, err
 	}
 
+	// Write the synonyms as a JSON list, like the GraphQL schema.
+	if _, err = synonymWriter.gw.Write([]byte(exportFormats["json"].pre)); err != nil {
+		return nil, err
+	}
+	if err := writePrefix(x.ByteSynonym); err != nil {
+		return nil, err
+	}
+	if _, err = synonymWriter.gw.Write([]byte(exportFormats["json"].post)); err != nil {
+		return nil, err
+	}
+
 	glog.Infof("Export DONE for group %d at timestamp %d.", in.GroupId, in.ReadTs)
-	return exportStorage.finishWriting(dataWriter, schemaWriter, gqlSchemaWriter)
+	return exportStorage.finishWriting(dataWriter, schemaWriter, gqlSchemaWriter, synonymWriter)
 }
 
 // Export request is used to trigger exports for the request list of groups.
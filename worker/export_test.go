@@ -161,6 +161,16 @@ func initTestExport(t *testing.T, schemaStr string) {
 	require.NoError(t, txn.Set(testutil.GalaxyTypeKey("Person"), val))
 	require.NoError(t, txn.CommitAt(1, nil))
 
+	require.NoError(t, updateSynonym(pb.SynonymUpdate{
+		Name:  x.GalaxyAttr("vehicles"),
+		Words: []string{"car", "truck"},
+	}, timestamp()))
+	// A removed synonym set is not exported.
+	removed := pb.SynonymUpdate{Name: x.GalaxyAttr("removed"), Words: []string{"gone"}}
+	require.NoError(t, updateSynonym(removed, timestamp()))
+	removed.Words = nil
+	require.NoError(t, updateSynonym(removed, timestamp()))
+
 	populateGraphExport(t)
 
 	// Drop age predicate after populating DB.
@@ -170,7 +180,8 @@ func initTestExport(t *testing.T, schemaStr string) {
 	require.NoError(t, txn.CommitAt(1, nil))
 }
 
-func getExportFileList(t *testing.T, bdir string) (dataFiles, schemaFiles, gqlSchema []string) {
+func getExportFileList(t *testing.T, bdir string) (dataFiles, schemaFiles, gqlSchema,
+	synonyms []string) {
 	searchDir := bdir
 	err := filepath.Walk(searchDir, func(path string, f os.FileInfo, err error) error {
 		if f.IsDir() {
@@ -180,6 +191,8 @@ func getExportFileList(t *testing.T, bdir string) (dataFiles, schemaFiles, gqlSc
 			switch {
 			case strings.Contains(path, "gql_schema"):
 				gqlSchema = append(gqlSchema, path)
+			case strings.Contains(path, "synonyms"):
+				synonyms = append(synonyms, path)
 			case strings.Contains(path, "schema"):
 				schemaFiles = append(schemaFiles, path)
 			default:
@@ -234,6 +247,23 @@ func checkExportGqlSchema(t *testing.T, gqlSchemaFiles []string) {
 	require.JSONEq(t, string(b), buf.String())
 }
 
+func checkExportSynonyms(t *testing.T, synonymFiles []string) {
+	require.Equal(t, 1, len(synonymFiles))
+	f, err := os.Open(synonymFiles[0])
+	require.NoError(t, err)
+
+	r, err := gzip.NewReader(f)
+	require.NoError(t, err)
+	var buf bytes.Buffer
+	buf.ReadFrom(r)
+	expected := []x.ExportedSynonyms{
+		{Namespace: x.GalaxyNamespace, Name: "vehicles", Words: []string{"car", "truck"}},
+	}
+	b, err := json.Marshal(expected)
+	require.NoError(t, err)
+	require.JSONEq(t, string(b), buf.String())
+}
+
 func TestExportRdf(t *testing.T) {
 	// Index the name predicate. We ensure it doesn't show up on export.
 	initTestExport(t, `
@@ -257,8 +287,9 @@ func TestExportRdf(t *testing.T) {
 		Namespace: math.MaxUint64, Format: "rdf"})
 	require.NoError(t, err)
 
-	fileList, schemaFileList, gqlSchema := getExportFileList(t, bdir)
-	require.Equal(t, len(files), len(fileList)+len(schemaFileList)+len(gqlSchema))
+	fileList, schemaFileList, gqlSchema, synonyms := getExportFileList(t, bdir)
+	require.Equal(t, len(files),
+		len(fileList)+len(schemaFileList)+len(gqlSchema)+len(synonyms))
 
 	file := fileList[0]
 	f, err := os.Open(file)
@@ -337,6 +368,7 @@ func TestExportRdf(t *testing.T) {
 
 	checkExportSchema(t, schemaFileList)
 	checkExportGqlSchema(t, gqlSchema)
+	checkExportSynonyms(t, synonyms)
 }
 
 func TestExportJson(t *testing.T) {
@@ -359,8 +391,9 @@ func TestExportJson(t *testing.T) {
 	files, err := export(context.Background(), &req)
 	require.NoError(t, err)
 
-	fileList, schemaFileList, gqlSchema := getExportFileList(t, bdir)
-	require.Equal(t, len(files), len(fileList)+len(schemaFileList)+len(gqlSchema))
+	fileList, schemaFileList, gqlSchema, synonyms := getExportFileList(t, bdir)
+	require.Equal(t, len(files),
+		len(fileList)+len(schemaFileList)+len(gqlSchema)+len(synonyms))
 
 	file := fileList[0]
 	f, err := os.Open(file)
@@ -398,6 +431,7 @@ func TestExportJson(t *testing.T) {
 
 	checkExportSchema(t, schemaFileList)
 	checkExportGqlSchema(t, gqlSchema)
+	checkExportSynonyms(t, synonyms)
 }
 
 const exportRequest = `mutation export($format: String!) {
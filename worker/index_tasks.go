@@ -0,0 +1,173 @@
+/*
+ * Copyright 2022 Dgraph Labs, Inc. and Contributors
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package worker
+
+import (
+	"context"
+	"time"
+
+	"github.com/dgryski/go-farm"
+	"github.com/golang/glog"
+
+	"github.com/dgraph-io/dgraph/protos/pb"
+	"github.com/dgraph-io/dgraph/raftwal"
+)
+
+// The indexes of a predicate are rebuilt by every replica of the group serving it, when the
+// schema update is applied. Each replica tracks its rebuild as a task of its own, which isn't run
+// by the task queue but reports its progress and status just the same. The ID of the task is
+// derived from the Raft ID of the replica, the predicate and the timestamp of the schema update,
+// so that the alpha which received the update can find the tasks of the other alphas.
+
+// indexTaskProbeTimeout bounds the time spent asking a replica whether it runs the task
+// rebuilding the indexes of a predicate, so that a replica which is down doesn't hold up the
+// response to the schema update.
+const indexTaskProbeTimeout = 500 * time.Millisecond
+
+// indexTaskId returns the ID of the task rebuilding the indexes of the predicate on the alpha
+// with the given Raft ID, after the schema update proposed at ts.
+func indexTaskId(raftId uint64, pred string, ts uint64) uint64 {
+	low := uint32(farm.Fingerprint64([]byte(pred)) ^ ts)
+	if low == 0 {
+		low = 1
+	}
+	return raftId<<32 | uint64(low)
+}
+
+// IndexTaskIds returns the IDs of the tasks rebuilding the indexes of the given predicates after
+// the schema update proposed at ts, keyed by predicate. The ID of the group leader's task is
+// preferred, and the predicates whose indexes aren't rebuilt are left out.
+func IndexTaskIds(ctx context.Context, preds []string, ts uint64) map[string]uint64 {
+	ids := make(map[string]uint64)
+	for _, pred := range preds {
+		gid, err := groups().BelongsToReadOnly(pred, 0)
+		if err != nil || gid == 0 {
+			continue
+		}
+		var members []*pb.Member
+		for _, m := range groups().members(gid) {
+			if m.Leader {
+				members = append([]*pb.Member{m}, members...)
+			} else {
+				members = append(members, m)
+			}
+		}
+		for _, m := range members {
+			id := indexTaskId(m.Id, pred, ts)
+			if probeIndexTask(ctx, id) {
+				ids[pred] = id
+				break
+			}
+		}
+	}
+	return ids
+}
+
+// probeIndexTask returns whether the alpha running the task knows about it, giving up after
+// indexTaskProbeTimeout.
+func probeIndexTask(ctx context.Context, id uint64) bool {
+	ctx, cancel := context.WithTimeout(ctx, indexTaskProbeTimeout)
+	defer cancel()
+	_, err := TaskStatusOverNetwork(ctx, &pb.TaskStatusRequest{TaskId: id})
+	return err == nil
+}
+
+// startIndexing records the rebuild of the indexes of the predicate as a running task, and
+// returns its ID along with the context which is cancelled when the task is.
+func (t *tasks) startIndexing(pred string, ts uint64) (uint64, context.Context) {
+	if t == nil {
+		return 0, context.Background()
+	}
+	ctx, cancel := context.WithCancel(context.Background())
+	id := indexTaskId(State.WALstore.Uint(raftwal.RaftId), pred, ts)
+
+	t.logMu.Lock()
+	defer t.logMu.Unlock()
+	t.log.Set(id, newTaskMeta(TaskKindIndex, TaskStatusRunning).uint64())
+	t.cancels[id] = cancel
+	t.indexing[pred] = id
+	return id, ctx
+}
+
+// finishIndexing records the end of the rebuild of the indexes of the predicate, which returned
+// err.
+func (t *tasks) finishIndexing(ctx context.Context, id uint64, pred string, err error) {
+	if t == nil {
+		return
+	}
+	var status TaskStatus
+	switch {
+	case err != nil && ctx.Err() == context.Canceled:
+		status = TaskStatusCancelled
+	case err != nil:
+		status = TaskStatusFailed
+	default:
+		status = TaskStatusSuccess
+	}
+
+	t.logMu.Lock()
+	defer t.logMu.Unlock()
+	t.log.Set(id, newTaskMeta(TaskKindIndex, status).uint64())
+	if status == TaskStatusSuccess {
+		t.progress[id] = 100
+	}
+	if cancel, ok := t.cancels[id]; ok {
+		cancel()
+		delete(t.cancels, id)
+	}
+	delete(t.indexing, pred)
+}
+
+// cancelIndexing cancels the rebuild of the indexes of the predicate, if one is running. This is
+// called by every replica when the cancellation proposed by cancelIndexTask is applied.
+func (t *tasks) cancelIndexing(pred string) {
+	if t == nil {
+		return
+	}
+	t.logMu.Lock()
+	defer t.logMu.Unlock()
+	id, ok := t.indexing[pred]
+	if !ok {
+		return
+	}
+	if cancel, ok := t.cancels[id]; ok {
+		glog.Infof("Cancelling the rebuild of the indexes of %s, task %#x", pred, id)
+		cancel()
+	}
+}
+
+// indexingPredicate returns the predicate whose indexes are rebuilt by the task. logMu must be
+// acquired before calling this function.
+func (t *tasks) indexingPredicate(id uint64) (string, bool) {
+	for pred, taskId := range t.indexing {
+		if taskId == id {
+			return pred, true
+		}
+	}
+	return "", false
+}
+
+// cancelIndexTask proposes the cancellation of the rebuild of the indexes of the predicate to
+// the group serving it, so that all the replicas stop their rebuild and keep the old schema.
+func cancelIndexTask(ctx context.Context, pred string) error {
+	m := &pb.Mutations{
+		StartTs:        State.GetTimestamp(false),
+		CancelIndexing: []string{pred},
+	}
+	_, err := MutateOverNetwork(ctx, m)
+	return err
+}
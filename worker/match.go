@@ -80,15 +80,14 @@ func matchFuzzy(query, val string, max int) bool {
 // uidsForMatch collects a list of uids that "might" match a fuzzy term based on the ngram
 // index. matchFuzzy does the actual fuzzy match.
 // Returns the list of uids even if empty, or an error otherwise.
-func uidsForMatch(attr string, arg funcArgs) (*pb.List, error) {
+func (qs *queryState) uidsForMatch(attr string, arg funcArgs) (*pb.List, error) {
 	opts := posting.ListOptions{
 		ReadTs: arg.q.ReadTs,
 		First:  int(arg.q.First),
 		AfterUid: arg.q.AfterUid,
 	}
 	uidsForNgram := func(ngram string) (*pb.List, error) {
-		key := x.IndexKey(attr, ngram)
-		pl, err := posting.GetNoStore(key, arg.q.ReadTs)
+		pl, err := qs.get(x.IndexKey(attr, ngram))
 		if err != nil {
 			return nil, err
 		}
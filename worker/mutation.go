@@ -20,6 +20,7 @@ import (
 	"bytes"
 	"context"
 	"math"
+	"sort"
 	"sync"
 	"sync/atomic"
 	"time"
@@ -102,6 +103,9 @@ func runMutation(ctx context.Context, edge *pb.DirectedEdge, txn *posting.Txn) e
 	case su.GetValueType() == pb.Posting_UID && !su.GetList():
 		// Single UID, not a list.
 		getFn = txn.Get
+	case su.GetOrdered():
+		// The positions of an ordered list depend on the values already in it.
+		getFn = txn.Get
 	case edge.Op == pb.DirectedEdge_DEL:
 		// Covers various delete cases to keep things simple.
 		getFn = txn.Get
@@ -138,7 +142,10 @@ func undoSchemaUpdate(predicate string) {
 	}
 }
 
-func runSchemaMutation(ctx context.Context, updates []*pb.SchemaUpdate, startTs uint64) error {
+// runSchemaMutation applies the schema updates proposed at taskTs, rebuilding the indexes at
+// startTs in the background.
+func runSchemaMutation(ctx context.Context, updates []*pb.SchemaUpdate,
+	startTs, taskTs uint64) error {
 	if len(updates) == 0 {
 		return nil
 	}
@@ -176,8 +183,9 @@ func runSchemaMutation(ctx context.Context, updates []*pb.SchemaUpdate, startTs
 		}
 	}
 
-	buildIndexesHelper := func(update *pb.SchemaUpdate, rebuild posting.IndexRebuild) error {
-		wrtCtx := schema.GetWriteContext(context.Background())
+	buildIndexesHelper := func(ctx context.Context, update *pb.SchemaUpdate,
+		rebuild posting.IndexRebuild) error {
+		wrtCtx := schema.GetWriteContext(ctx)
 		if err := rebuild.BuildIndexes(wrtCtx); err != nil {
 			return err
 		}
@@ -207,7 +215,8 @@ func runSchemaMutation(ctx context.Context, updates []*pb.SchemaUpdate, startTs
 	// "Too many open files" error.
 	throttle := y.NewThrottle(maxOpenFileLimit / 8)
 
-	buildIndexes := func(update *pb.SchemaUpdate, rebuild posting.IndexRebuild, c *z.Closer) {
+	buildIndexes := func(taskCtx context.Context, taskId uint64, update *pb.SchemaUpdate,
+		rebuild posting.IndexRebuild, c *z.Closer) {
 		// In case background indexing is running, we should call it here again.
 		defer stopIndexing(c)
 
@@ -218,11 +227,13 @@ func runSchemaMutation(ctx context.Context, updates []*pb.SchemaUpdate, startTs
 		wg.Wait()
 
 		x.Check(throttle.Do())
-		// undo schema changes in case re-indexing fails.
-		if err := buildIndexesHelper(update, rebuild); err != nil {
+		// undo schema changes in case re-indexing fails or is cancelled.
+		err := buildIndexesHelper(taskCtx, update, rebuild)
+		if err != nil {
 			glog.Errorf("error in building indexes, aborting :: %v\n", err)
 			undoSchemaUpdate(update.Predicate)
 		}
+		Tasks.finishIndexing(taskCtx, taskId, update.Predicate, err)
 		throttle.Done(nil)
 	}
 
@@ -279,7 +290,12 @@ func runSchemaMutation(ctx context.Context, updates []*pb.SchemaUpdate, startTs
 		}
 
 		if shouldRebuild {
-			go buildIndexes(su, rebuild, closer)
+			// The rebuild is tracked as a task, reporting the percentage done.
+			taskId, taskCtx := Tasks.startIndexing(su.Predicate, taskTs)
+			if taskId != 0 {
+				rebuild.Progress = func(percent uint64) { Tasks.setProgress(taskId, percent) }
+			}
+			go buildIndexes(taskCtx, taskId, su, rebuild, closer)
 		} else if err := updateSchema(su, rebuild.StartTs); err != nil {
 			return err
 		}
@@ -363,6 +379,100 @@ func updateType(typeName string, t pb.TypeUpdate, ts uint64) error {
 	return txn.CommitAt(ts, nil)
 }
 
+// updateSynonym stores the synonym set, whose version is the given timestamp. Like types, the
+// set is written at timestamp 1 so that it's always sent with the snapshots. A set with no words
+// is deleted.
+func updateSynonym(syn pb.SynonymUpdate, ts uint64) error {
+	syn.Version = ts
+	schema.State().SetSynonym(syn.Name, syn)
+	txn := pstore.NewTransactionAt(1, true)
+	defer txn.Discard()
+	if len(syn.Words) == 0 {
+		if err := txn.Delete(x.SynonymKey(syn.Name)); err != nil {
+			return err
+		}
+		return txn.CommitAt(1, nil)
+	}
+	data, err := syn.Marshal()
+	x.Check(err)
+	e := &badger.Entry{
+		Key:      x.SynonymKey(syn.Name),
+		Value:    data,
+		UserMeta: posting.BitSchemaPosting,
+	}
+	if err := txn.SetEntry(e.WithDiscard()); err != nil {
+		return err
+	}
+	return txn.CommitAt(1, nil)
+}
+
+func updateTrigger(tr pb.Trigger, ts uint64) error {
+	tr.Version = ts
+	schema.State().SetTrigger(tr.Name, tr)
+	txn := pstore.NewTransactionAt(ts, true)
+	defer txn.Discard()
+	if tr.Url == "" {
+		if err := txn.Delete(x.TriggerKey(tr.Name)); err != nil {
+			return err
+		}
+		return txn.CommitAt(ts, nil)
+	}
+	data, err := tr.Marshal()
+	x.Check(err)
+	e := &badger.Entry{
+		Key:      x.TriggerKey(tr.Name),
+		Value:    data,
+		UserMeta: posting.BitSchemaPosting,
+	}
+	if err := txn.SetEntry(e.WithDiscard()); err != nil {
+		return err
+	}
+	return txn.CommitAt(ts, nil)
+}
+
+func updateStoredQuery(sq pb.StoredQuery, ts uint64) error {
+	sq.Version = ts
+	schema.State().SetStoredQuery(sq.Name, sq)
+	txn := pstore.NewTransactionAt(ts, true)
+	defer txn.Discard()
+	if sq.Query == "" {
+		if err := txn.Delete(x.StoredQueryKey(sq.Name)); err != nil {
+			return err
+		}
+		return txn.CommitAt(ts, nil)
+	}
+	data, err := sq.Marshal()
+	x.Check(err)
+	e := &badger.Entry{
+		Key:      x.StoredQueryKey(sq.Name),
+		Value:    data,
+		UserMeta: posting.BitSchemaPosting,
+	}
+	if err := txn.SetEntry(e.WithDiscard()); err != nil {
+		return err
+	}
+	return txn.CommitAt(ts, nil)
+}
+
+// storeSchemaVersion stores the schema version at the given timestamp, which becomes the number
+// of the version.
+func storeSchemaVersion(v pb.SchemaVersion, ts uint64) error {
+	v.Version = ts
+	txn := pstore.NewTransactionAt(ts, true)
+	defer txn.Discard()
+	data, err := v.Marshal()
+	x.Check(err)
+	e := &badger.Entry{
+		Key:      x.SchemaVersionKey(v.Namespace, ts),
+		Value:    data,
+		UserMeta: posting.BitSchemaPosting,
+	}
+	if err := txn.SetEntry(e); err != nil {
+		return err
+	}
+	return txn.CommitAt(ts, nil)
+}
+
 func hasEdges(attr string, startTs uint64) bool {
 	pk := x.ParsedKey{Attr: attr}
 	iterOpt := badger.DefaultIteratorOptions
@@ -465,6 +575,14 @@ func ValidateAndConvert(edge *pb.DirectedEdge, su *pb.SchemaUpdate) error {
 	if isDeletePredicateEdge(edge) {
 		return nil
 	}
+	if !su.GetOrdered() {
+		for _, f := range edge.Facets {
+			if f.Key == x.ListIndexFacet {
+				return errors.Errorf("Facet %s can only be used with @ordered predicates. Edge: %v",
+					x.ListIndexFacet, edge)
+			}
+		}
+	}
 	if types.TypeID(edge.ValueType) == types.DefaultID && isStarAll(edge.Value) {
 		return nil
 	}
@@ -677,6 +795,98 @@ func populateMutationMap(src *pb.Mutations) (map[uint32]*pb.Mutations, error) {
 		}
 	}
 
+	// Synonym sets are sent to all groups, just like type definitions.
+	if len(src.Synonyms) > 0 {
+		for _, gid := range groups().KnownGroups() {
+			mu := mm[gid]
+			if mu == nil {
+				mu = &pb.Mutations{GroupId: gid}
+				mm[gid] = mu
+			}
+			mu.Synonyms = src.Synonyms
+		}
+	}
+
+	// Schema versions are sent to all groups too, so that any alpha can serve the history.
+	if len(src.SchemaVersions) > 0 {
+		for _, gid := range groups().KnownGroups() {
+			mu := mm[gid]
+			if mu == nil {
+				mu = &pb.Mutations{GroupId: gid}
+				mm[gid] = mu
+			}
+			mu.SchemaVersions = src.SchemaVersions
+		}
+	}
+
+	// Triggers are sent to all groups, so that any alpha can fire them.
+	if len(src.Triggers) > 0 {
+		for _, gid := range groups().KnownGroups() {
+			mu := mm[gid]
+			if mu == nil {
+				mu = &pb.Mutations{GroupId: gid}
+				mm[gid] = mu
+			}
+			mu.Triggers = src.Triggers
+		}
+	}
+
+	// Stored queries are sent to all groups, so that any alpha can run them.
+	if len(src.StoredQueries) > 0 {
+		for _, gid := range groups().KnownGroups() {
+			mu := mm[gid]
+			if mu == nil {
+				mu = &pb.Mutations{GroupId: gid}
+				mm[gid] = mu
+			}
+			mu.StoredQueries = src.StoredQueries
+		}
+	}
+
+	// The cancellation of an index rebuild is sent to the group serving the predicate.
+	for _, pred := range src.CancelIndexing {
+		gid, err := groups().BelongsTo(pred)
+		if err != nil {
+			return nil, err
+		}
+
+		mu := mm[gid]
+		if mu == nil {
+			mu = &pb.Mutations{GroupId: gid}
+			mm[gid] = mu
+		}
+		mu.CancelIndexing = append(mu.CancelIndexing, pred)
+	}
+
+	// Tombstones are sent to all groups, so that any alpha can undo the deletions. The ones of a
+	// transaction are written by each group when it commits.
+	if len(src.Tombstones) > 0 {
+		for _, gid := range groups().KnownGroups() {
+			mu := mm[gid]
+			if mu == nil {
+				mu = &pb.Mutations{GroupId: gid}
+				mm[gid] = mu
+			}
+			mu.Tombstones = src.Tombstones
+		}
+	}
+
+	// The events of the triggers called after the commit are written in group one, whose leader
+	// delivers them.
+	if len(src.TriggerEvents) > 0 {
+		mu := mm[1]
+		if mu == nil {
+			mu = &pb.Mutations{GroupId: 1}
+			mm[1] = mu
+		}
+		mu.TriggerEvents = src.TriggerEvents
+	}
+
+	// The version check is made by the group serving the versions, but is passed to all.
+	for _, mu := range mm {
+		mu.IfVersion = src.IfVersion
+	}
+
 	return mm, nil
 }
 
@@ -695,6 +905,11 @@ func MutateOverNetwork(ctx context.Context, m *pb.Mutations) (*api.TxnContext, e
 	if err := verifyTypes(ctx, m); err != nil {
 		return tctx, err
 	}
+	addVersionEdges(m)
+	strict, err := verifyStrictTypes(ctx, m)
+	if err != nil {
+		return tctx, err
+	}
 	mutationMap, err := populateMutationMap(m)
 	if err != nil {
 		return tctx, err
@@ -725,6 +940,9 @@ func MutateOverNetwork(ctx context.Context, m *pb.Mutations) (*api.TxnContext, e
 		}
 	}
 	close(resCh)
+	if e == nil && len(strict) > 0 {
+		registerStrictNodes(m.StartTs, strict)
+	}
 	return tctx, e
 }
 
@@ -789,6 +1007,41 @@ func verifyTypes(ctx context.Context, m *pb.Mutations) error {
 		}
 	}
 
+	return verifyTypeHierarchy(m.Types)
+}
+
+// verifyTypeHierarchy checks that the types extended by the given types exist, either in the
+// schema or in the same request, and that no type ends up extending itself.
+func verifyTypeHierarchy(types []*pb.TypeUpdate) error {
+	reqTypes := make(map[string]*pb.TypeUpdate, len(types))
+	for _, t := range types {
+		reqTypes[t.TypeName] = t
+	}
+	parentOf := func(typeName string) (string, bool) {
+		if t, ok := reqTypes[typeName]; ok {
+			return t.Extends, true
+		}
+		t, ok := schema.State().GetType(typeName)
+		return t.Extends, ok
+	}
+
+	for _, t := range types {
+		seen := map[string]struct{}{t.TypeName: {}}
+		for parent := t.Extends; parent != ""; {
+			if _, ok := seen[parent]; ok {
+				return errors.Errorf("Type %s can't extend %s as it would create a cycle",
+					x.ParseAttr(t.TypeName), x.ParseAttr(t.Extends))
+			}
+			seen[parent] = struct{}{}
+
+			next, ok := parentOf(parent)
+			if !ok {
+				return errors.Errorf("Type %s extends %s, which is not defined",
+					x.ParseAttr(t.TypeName), x.ParseAttr(parent))
+			}
+			parent = next
+		}
+	}
 	return nil
 }
 
@@ -816,6 +1069,235 @@ func typeSanityCheck(t *pb.TypeUpdate) error {
 	return nil
 }
 
+// strictNodes keeps the nodes of @strict types changed by the pending transactions, grouped by
+// namespace and keyed by the start timestamp of the transactions, so that their @required fields
+// are checked once, when the transaction is committed. A transaction can create a node in one
+// mutation and set its @required fields in a later one. Hence, the commit has to go through the
+// same alpha that ran the mutations.
+var strictNodes = struct {
+	sync.Mutex
+	txns map[uint64]*strictTxn
+}{txns: make(map[uint64]*strictTxn)}
+
+type strictTxn struct {
+	nodes      map[uint64]map[uint64]struct{}
+	lastUpdate time.Time
+}
+
+func registerStrictNodes(startTs uint64, nodes map[uint64][]uint64) {
+	strictNodes.Lock()
+	defer strictNodes.Unlock()
+
+	// Forget about the transactions that were abandoned by their clients.
+	for ts, txn := range strictNodes.txns {
+		if x.WorkerConfig.AbortOlderThan > 0 &&
+			time.Since(txn.lastUpdate) > x.WorkerConfig.AbortOlderThan {
+			delete(strictNodes.txns, ts)
+		}
+	}
+
+	txn, ok := strictNodes.txns[startTs]
+	if !ok {
+		txn = &strictTxn{nodes: make(map[uint64]map[uint64]struct{})}
+		strictNodes.txns[startTs] = txn
+	}
+	for ns, uids := range nodes {
+		if txn.nodes[ns] == nil {
+			txn.nodes[ns] = make(map[uint64]struct{})
+		}
+		for _, uid := range uids {
+			txn.nodes[ns][uid] = struct{}{}
+		}
+	}
+	txn.lastUpdate = time.Now()
+}
+
+func takeStrictNodes(startTs uint64) map[uint64][]uint64 {
+	strictNodes.Lock()
+	defer strictNodes.Unlock()
+
+	txn, ok := strictNodes.txns[startTs]
+	if !ok {
+		return nil
+	}
+	delete(strictNodes.txns, startTs)
+	out := make(map[uint64][]uint64, len(txn.nodes))
+	for ns, uids := range txn.nodes {
+		for uid := range uids {
+			out[ns] = append(out[ns], uid)
+		}
+	}
+	return out
+}
+
+// readNodeTypes adds the names of the types of the given nodes, grouped by namespace, as seen by
+// a read at readTs. A read at the start timestamp of a pending transaction sees its own deltas.
+func readNodeTypes(ctx context.Context, readTs uint64, nodes map[uint64][]uint64,
+	out map[uint64][]string) error {
+	for ns, uids := range nodes {
+		sort.Slice(uids, func(i, j int) bool { return uids[i] < uids[j] })
+		res, err := ProcessTaskOverNetwork(ctx, &pb.Query{
+			Attr:    x.NamespaceAttr(ns, "dgraph.type"),
+			UidList: &pb.List{Uids: uids},
+			ReadTs:  readTs,
+		})
+		if err != nil {
+			return errors.Wrapf(err, "cannot retrieve the types of the nodes")
+		}
+		for i, vals := range res.ValueMatrix {
+			for _, val := range vals.Values {
+				out[uids[i]] = append(out[uids[i]], x.NamespaceAttr(ns, string(val.Val)))
+			}
+		}
+	}
+	return nil
+}
+
+// nodeTypes returns the names of the types of the nodes changed by the mutation, both the ones
+// they already had in the transaction and the ones added by the mutation.
+func nodeTypes(ctx context.Context, m *pb.Mutations) (map[uint64][]string, error) {
+	out := make(map[uint64][]string)
+	nodes := make(map[uint64][]uint64)
+	seen := make(map[uint64]struct{})
+	for _, edge := range m.Edges {
+		ns, attr := x.ParseNamespaceAttr(edge.Attr)
+		if attr == "dgraph.type" && edge.Op == pb.DirectedEdge_SET {
+			out[edge.Entity] = append(out[edge.Entity], x.NamespaceAttr(ns, string(edge.Value)))
+		}
+		if _, ok := seen[edge.Entity]; !ok {
+			seen[edge.Entity] = struct{}{}
+			nodes[ns] = append(nodes[ns], edge.Entity)
+		}
+	}
+	if err := readNodeTypes(ctx, m.StartTs, nodes, out); err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// verifyStrictTypes rejects the mutation if it sets a predicate on a node of a @strict type that
+// isn't declared in any of the types of the node. It returns the nodes of strict types changed by
+// the mutation, grouped by namespace, whose @required fields are checked when the transaction is
+// committed.
+func verifyStrictTypes(ctx context.Context, m *pb.Mutations) (map[uint64][]uint64, error) {
+	if len(m.Edges) == 0 || !schema.State().HasStrictTypes() {
+		return nil, nil
+	}
+
+	typeNames, err := nodeTypes(ctx, m)
+	if err != nil {
+		return nil, err
+	}
+	allowed := make(map[uint64]map[string]struct{})
+	for uid, names := range typeNames {
+		fields := make(map[string]struct{})
+		strict := false
+		for _, typeName := range names {
+			typ, ok := schema.State().GetType(typeName)
+			if !ok {
+				continue
+			}
+			strict = strict || typ.Strict
+			for _, field := range schema.State().TypeFields(typeName) {
+				fields[field.Predicate] = struct{}{}
+			}
+		}
+		if strict {
+			allowed[uid] = fields
+		}
+	}
+	if len(allowed) == 0 {
+		return nil, nil
+	}
+
+	nodes := make(map[uint64][]uint64)
+	seen := make(map[uint64]struct{})
+	for _, edge := range m.Edges {
+		fields, ok := allowed[edge.Entity]
+		if !ok {
+			continue
+		}
+		if _, ok := seen[edge.Entity]; !ok {
+			seen[edge.Entity] = struct{}{}
+			ns := x.ParseNamespace(edge.Attr)
+			nodes[ns] = append(nodes[ns], edge.Entity)
+		}
+		if edge.Op != pb.DirectedEdge_SET || x.IsReservedPredicate(edge.Attr) {
+			continue
+		}
+		if _, ok := fields[edge.Attr]; !ok {
+			return nil, errors.Errorf("Predicate %s is not declared in the types of node %#x",
+				x.ParseAttr(edge.Attr), edge.Entity)
+		}
+	}
+	return nodes, nil
+}
+
+// verifyRequiredFields checks that the nodes of @strict types changed by the transaction have a
+// value for all the @required fields of their types, as seen by the transaction itself through
+// its own deltas.
+func verifyRequiredFields(ctx context.Context, startTs uint64, nodes map[uint64][]uint64) error {
+	// The types are read again, as the transaction might have removed some of them.
+	typeNames := make(map[uint64][]string)
+	if err := readNodeTypes(ctx, startTs, nodes, typeNames); err != nil {
+		return err
+	}
+
+	required := make(map[string]map[uint64]struct{})
+	for uid, names := range typeNames {
+		strict := false
+		for _, typeName := range names {
+			typ, ok := schema.State().GetType(typeName)
+			strict = strict || (ok && typ.Strict)
+		}
+		if !strict {
+			continue
+		}
+		for _, typeName := range names {
+			for _, field := range schema.State().TypeFields(typeName) {
+				if !field.NonNullable {
+					continue
+				}
+				if required[field.Predicate] == nil {
+					required[field.Predicate] = make(map[uint64]struct{})
+				}
+				required[field.Predicate][uid] = struct{}{}
+			}
+		}
+	}
+
+	for pred, set := range required {
+		uids := make([]uint64, 0, len(set))
+		for uid := range set {
+			uids = append(uids, uid)
+		}
+		sort.Slice(uids, func(i, j int) bool { return uids[i] < uids[j] })
+
+		res, err := ProcessTaskOverNetwork(ctx, &pb.Query{
+			Attr:    pred,
+			UidList: &pb.List{Uids: uids},
+			ReadTs:  startTs,
+		})
+		switch {
+		case err == errNonExistentTablet:
+			// Nobody has a value for the predicate yet.
+			res = &pb.Result{}
+		case err != nil:
+			return errors.Wrapf(err, "cannot retrieve the @required predicate %s",
+				x.ParseAttr(pred))
+		}
+		for i, uid := range uids {
+			hasValue := i < len(res.ValueMatrix) && len(res.ValueMatrix[i].Values) > 0
+			hasUids := i < len(res.UidMatrix) && len(res.UidMatrix[i].Uids) > 0
+			if !hasValue && !hasUids {
+				return errors.Errorf("Node %#x is missing the @required predicate %s",
+					uid, x.ParseAttr(pred))
+			}
+		}
+	}
+	return nil
+}
+
 // CommitOverNetwork makes a proxy call to Zero to commit or abort a transaction.
 func CommitOverNetwork(ctx context.Context, tc *api.TxnContext) (uint64, error) {
 	ctx, span := otrace.StartSpan(ctx, "worker.CommitOverNetwork")
@@ -828,11 +1310,27 @@ func CommitOverNetwork(ctx context.Context, tc *api.TxnContext) (uint64, error)
 		clientDiscard = true
 	}
 
+	var requiredErr error
+	if nodes := takeStrictNodes(tc.StartTs); !tc.Aborted && len(nodes) > 0 {
+		// A transaction missing @required predicates is aborted instead of being committed.
+		if requiredErr = verifyRequiredFields(ctx, tc.StartTs, nodes); requiredErr != nil {
+			span.Annotatef(nil, "Aborting the transaction: %v", requiredErr)
+			tc.Aborted = true
+		}
+	}
+
 	pl := groups().Leader(0)
 	if pl == nil {
 		return 0, conn.ErrNoConnection
 	}
 
+	if !tc.Aborted {
+		if err := addVersionPreds(tc); err != nil {
+			span.Annotatef(nil, "Aborting the transaction: %v", err)
+			tc.Aborted = true
+		}
+	}
+
 	// Do de-duplication before sending the request to zero.
 	tc.Keys = x.Unique(tc.Keys)
 	tc.Preds = x.Unique(tc.Preds)
@@ -854,6 +1352,9 @@ func CommitOverNetwork(ctx context.Context, tc *api.TxnContext) (uint64, error)
 			// The server aborted the txn (not the client)
 			ostats.Record(ctx, x.TxnAborts.M(1))
 		}
+		if requiredErr != nil {
+			return 0, requiredErr
+		}
 		return 0, dgo.ErrAborted
 	}
 	ostats.Record(ctx, x.TxnCommits.M(1))
@@ -22,6 +22,7 @@ import (
 
 	"github.com/stretchr/testify/require"
 
+	"github.com/dgraph-io/dgo/v210/protos/api"
 	"github.com/dgraph-io/dgraph/posting"
 	"github.com/dgraph-io/dgraph/protos/pb"
 	"github.com/dgraph-io/dgraph/schema"
@@ -261,3 +262,113 @@ func TestTypeSanityCheck(t *testing.T) {
 	require.Error(t, err)
 	require.Contains(t, err.Error(), "Field in type definition cannot have tokenizers")
 }
+
+func TestAddVersionEdges(t *testing.T) {
+	m := &pb.Mutations{
+		StartTs: 10,
+		Edges: []*pb.DirectedEdge{
+			{Entity: 1, Attr: x.GalaxyAttr("name"), Value: []byte("Alice")},
+			{Entity: 1, Attr: x.GalaxyAttr("age"), Value: []byte("30")},
+			{Entity: 2, Attr: x.NamespaceAttr(1, "name"), Value: []byte("Bob")},
+			{Attr: x.GalaxyAttr("friend"), Value: []byte(x.Star), Op: pb.DirectedEdge_DEL},
+		},
+	}
+	addVersionEdges(m)
+	require.Len(t, m.Edges, 6)
+
+	versions := m.Edges[4:]
+	require.Equal(t, uint64(1), versions[0].Entity)
+	require.Equal(t, x.GalaxyAttr(x.VersionPredicate), versions[0].Attr)
+	require.Equal(t, uint64(2), versions[1].Entity)
+	require.Equal(t, x.NamespaceAttr(1, x.VersionPredicate), versions[1].Attr)
+	require.Equal(t, pb.Posting_INT, versions[1].ValueType)
+
+	// The version edges are only added once.
+	addVersionEdges(m)
+	require.Len(t, m.Edges, 6)
+}
+
+func TestAddVersionEdgesStarDelete(t *testing.T) {
+	star := []byte(x.Star)
+	m := &pb.Mutations{
+		StartTs: 10,
+		Edges: []*pb.DirectedEdge{
+			// delete { <1> * * . } deletes the version along with the other predicates.
+			{Entity: 1, Attr: x.GalaxyAttr("name"), Value: star, Op: pb.DirectedEdge_DEL},
+			{Entity: 1, Attr: x.GalaxyAttr(x.VersionPredicate), Value: star,
+				Op: pb.DirectedEdge_DEL},
+			// A node deleted and set again gets a new version.
+			{Entity: 2, Attr: x.GalaxyAttr("name"), Value: star, Op: pb.DirectedEdge_DEL},
+			{Entity: 2, Attr: x.GalaxyAttr(x.VersionPredicate), Value: star,
+				Op: pb.DirectedEdge_DEL},
+			{Entity: 2, Attr: x.GalaxyAttr("name"), Value: []byte("Bob")},
+			// Deleting a single predicate of a node changes its version.
+			{Entity: 3, Attr: x.GalaxyAttr("name"), Value: star, Op: pb.DirectedEdge_DEL},
+		},
+	}
+	addVersionEdges(m)
+	require.Len(t, m.Edges, 8)
+
+	versions := m.Edges[6:]
+	require.Equal(t, uint64(2), versions[0].Entity)
+	require.Equal(t, pb.DirectedEdge_SET, versions[0].Op)
+	require.Equal(t, uint64(3), versions[1].Entity)
+	require.Equal(t, pb.DirectedEdge_SET, versions[1].Op)
+}
+
+func TestHideVersionPreds(t *testing.T) {
+	tc := &api.TxnContext{Preds: []string{
+		"1-" + x.GalaxyAttr("name"),
+		"2-" + x.GalaxyAttr(x.VersionPredicate),
+		"1-" + x.NamespaceAttr(1, x.VersionPredicate),
+	}}
+	HideVersionPreds(tc)
+	require.Equal(t, []string{"1-" + x.GalaxyAttr("name")}, tc.Preds)
+}
+
+func TestStoreSchemaVersion(t *testing.T) {
+	first, second := timestamp(), timestamp()
+	require.NoError(t, storeSchemaVersion(pb.SchemaVersion{Namespace: 0x5,
+		Schema: "name: string .\n"}, first))
+	require.NoError(t, storeSchemaVersion(pb.SchemaVersion{Namespace: 0x5, Graphql: true,
+		Schema: "type Person { name: String }"}, second))
+	// The versions of other namespaces aren't returned.
+	require.NoError(t, storeSchemaVersion(pb.SchemaVersion{Namespace: 0x6,
+		Schema: "age: int .\n"}, timestamp()))
+
+	versions, err := GetSchemaVersions(0x5)
+	require.NoError(t, err)
+	require.Len(t, versions, 2)
+	require.Equal(t, first, versions[0].Version)
+	require.Equal(t, "name: string .\n", versions[0].Schema)
+	require.False(t, versions[0].Graphql)
+	require.Equal(t, second, versions[1].Version)
+	require.True(t, versions[1].Graphql)
+}
+
+func TestCheckVersionsConflictKeys(t *testing.T) {
+	versionEdge := func(startTs uint64) *pb.Mutations {
+		m := &pb.Mutations{
+			StartTs: startTs,
+			Edges:   []*pb.DirectedEdge{{Entity: 1, Attr: x.GalaxyAttr("name"), Value: []byte("A")}},
+		}
+		addVersionEdges(m)
+		return m
+	}
+
+	// A mutation which isn't made for a version doesn't conflict over the version.
+	m := versionEdge(timestamp())
+	txn := posting.Oracle().RegisterStartTs(m.StartTs)
+	require.NoError(t, checkVersions(m, txn))
+	tc := &api.TxnContext{}
+	txn.FillContext(tc, 1)
+	require.Empty(t, tc.Keys)
+
+	m = versionEdge(timestamp())
+	m.IfVersion = m.StartTs
+	txn = posting.Oracle().RegisterStartTs(m.StartTs)
+	require.NoError(t, checkVersions(m, txn))
+	tc = &api.TxnContext{}
+	txn.FillContext(tc, 1)
+	require.Len(t, tc.Keys, 1)
+}
@@ -0,0 +1,161 @@
+/*
+ * Copyright 2022 Dgraph Labs, Inc. and Contributors
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package worker
+
+import (
+	"context"
+	"math"
+	"sort"
+
+	"github.com/pkg/errors"
+	geom "github.com/twpayne/go-geom"
+	otrace "go.opencensus.io/trace"
+
+	"github.com/dgraph-io/dgraph/algo"
+	"github.com/dgraph-io/dgraph/posting"
+	"github.com/dgraph-io/dgraph/protos/pb"
+	"github.com/dgraph-io/dgraph/tok"
+	"github.com/dgraph-io/dgraph/types"
+	"github.com/dgraph-io/dgraph/x"
+)
+
+type nearestResult struct {
+	uid      uint64
+	distance float64
+}
+
+// handleNearestFunction finds the k values closest to the point of a nearest query. The geo
+// index is looked up for a region around the point that is expanded until it contains k
+// values. The uids are returned in the uid matrix, sorted by uid, and their distances in metres
+// in the value matrix.
+func (qs *queryState) handleNearestFunction(ctx context.Context, arg funcArgs) error {
+	span := otrace.FromContext(ctx)
+	stop := x.SpanTimer(span, "handleNearestFunction")
+	defer stop()
+
+	attr := arg.q.Attr
+	nq := arg.srcFn.nearest
+	seenTokens := make(map[string]struct{})
+	seenUids := make(map[uint64]struct{})
+	var results []nearestResult
+	for {
+		if err := ctx.Err(); err != nil {
+			return err
+		}
+		tokens, radius, whole := nq.Expand()
+		tok.EncodeGeoTokens(tokens)
+
+		var uidMatrix []*pb.List
+		for _, token := range tokens {
+			if _, ok := seenTokens[token]; ok {
+				continue
+			}
+			seenTokens[token] = struct{}{}
+			pl, err := qs.get(x.IndexKey(attr, token))
+			if err != nil {
+				return err
+			}
+			uids, err := pl.Uids(posting.ListOptions{ReadTs: arg.q.ReadTs})
+			if err != nil {
+				return err
+			}
+			uidMatrix = append(uidMatrix, uids)
+		}
+
+		for _, uid := range algo.MergeSorted(uidMatrix).Uids {
+			if _, ok := seenUids[uid]; ok {
+				continue
+			}
+			seenUids[uid] = struct{}{}
+			distance, err := qs.nearestDistance(attr, uid, arg.q.ReadTs, nq)
+			if err != nil {
+				return err
+			}
+			if !math.IsInf(distance, 1) {
+				results = append(results, nearestResult{uid: uid, distance: distance})
+			}
+		}
+
+		// The values found outside of the region might be farther than values that haven't been
+		// found yet, so only the ones inside the region count.
+		var inside int
+		for _, r := range results {
+			if r.distance <= radius {
+				inside++
+			}
+		}
+		if span != nil {
+			span.Annotatef(nil, "Radius: %.0f. Found: %d. Inside: %d", radius, len(results), inside)
+		}
+		if inside >= nq.K || whole {
+			break
+		}
+	}
+
+	sort.Slice(results, func(i, j int) bool {
+		if results[i].distance == results[j].distance {
+			return results[i].uid < results[j].uid
+		}
+		return results[i].distance < results[j].distance
+	})
+	if len(results) > nq.K {
+		results = results[:nq.K]
+	}
+	sort.Slice(results, func(i, j int) bool { return results[i].uid < results[j].uid })
+
+	uids := &pb.List{Uids: make([]uint64, 0, len(results))}
+	values := &pb.ValueList{Values: make([]*pb.TaskValue, 0, len(results))}
+	for _, r := range results {
+		data := types.ValueForType(types.BinaryID)
+		if err := types.Marshal(types.Val{Tid: types.FloatID, Value: r.distance}, &data); err != nil {
+			return err
+		}
+		uids.Uids = append(uids.Uids, r.uid)
+		values.Values = append(values.Values,
+			&pb.TaskValue{ValType: types.FloatID.Enum(), Val: data.Value.([]byte)})
+	}
+	arg.out.UidMatrix = []*pb.List{uids}
+	arg.out.ValueMatrix = []*pb.ValueList{values}
+	return nil
+}
+
+// nearestDistance returns the distance in metres from the point of the query to the closest
+// value of the uid, or +Inf if it has no geo value.
+func (qs *queryState) nearestDistance(attr string, uid, readTs uint64,
+	nq *types.NearestQuery) (float64, error) {
+	pl, err := qs.get(x.DataKey(attr, uid))
+	if err != nil {
+		return 0, err
+	}
+	closest := math.Inf(1)
+	err = pl.Iterate(readTs, 0, func(p *pb.Posting) error {
+		if types.TypeID(p.ValType) != types.GeoID {
+			return nil
+		}
+		val, err := types.Convert(types.Val{Tid: types.BinaryID, Value: p.Value}, types.GeoID)
+		if err != nil {
+			return errors.Wrapf(err, "while reading the geo value of uid %#x", uid)
+		}
+		distance, err := nq.Distance(val.Value.(geom.T))
+		if err != nil {
+			return errors.Wrapf(err, "while computing the distance for uid %#x", uid)
+		}
+		closest = math.Min(closest, distance)
+		return nil
+	})
+	return closest, err
+}
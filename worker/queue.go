@@ -75,16 +75,21 @@ func TaskStatusOverNetwork(ctx context.Context, req *pb.TaskStatusRequest,
 	return client.TaskStatus(ctx, req)
 }
 
-// TaskStatus retrieves metadata for a given task ID.
+// TaskStatus retrieves metadata for a given task ID, cancelling the task first if asked to.
 func (*grpcWorker) TaskStatus(ctx context.Context, req *pb.TaskStatusRequest,
 ) (*pb.TaskStatusResponse, error) {
 	taskId := req.GetTaskId()
+	if req.GetCancel() {
+		if err := Tasks.cancel(ctx, taskId); err != nil {
+			return nil, err
+		}
+	}
 	meta, err := Tasks.get(taskId)
 	if err != nil {
 		return nil, err
 	}
 
-	resp := &pb.TaskStatusResponse{TaskMeta: meta.uint64()}
+	resp := &pb.TaskStatusResponse{TaskMeta: meta.uint64(), Progress: Tasks.getProgress(taskId)}
 	return resp, nil
 }
 
@@ -92,6 +97,11 @@ var (
 	// Tasks is a global persistent task queue.
 	// Do not use this before calling InitTasks.
 	Tasks *tasks
+
+	// RunDeleteByQuery deletes the nodes of a delete-by-query task, reporting the number of nodes
+	// deleted so far as it goes. It is set by the edgraph package, which runs the DQL queries.
+	RunDeleteByQuery func(ctx context.Context, req *pb.DeleteByQueryRequest,
+		progress func(deleted uint64)) error
 )
 
 // InitTasks initializes the global Tasks variable.
@@ -102,10 +112,13 @@ func InitTasks() {
 
 	// #nosec G404: weak RNG
 	Tasks = &tasks{
-		queue: make(chan taskRequest, 16),
-		log:   log,
-		logMu: new(sync.Mutex),
-		rng:   rand.New(rand.NewSource(time.Now().UnixNano())),
+		queue:    make(chan taskRequest, 16),
+		log:      log,
+		logMu:    new(sync.Mutex),
+		rng:      rand.New(rand.NewSource(time.Now().UnixNano())),
+		cancels:  make(map[uint64]context.CancelFunc),
+		progress: make(map[uint64]uint64),
+		indexing: make(map[string]uint64),
 	}
 
 	// Mark all pending tasks as failed.
@@ -132,12 +145,20 @@ type tasks struct {
 	logMu *sync.Mutex
 
 	rng *rand.Rand
+
+	// cancels stores the functions cancelling the running tasks which can be cancelled, progress
+	// the number of items processed (or the percentage done) by the tasks which report it, and
+	// indexing the IDs of the running index rebuilds by predicate. All are guarded by logMu.
+	cancels  map[uint64]context.CancelFunc
+	progress map[uint64]uint64
+	indexing map[string]uint64
 }
 
 // Enqueue adds a new task to the queue, waits for 3 seconds, and returns any errors that
 // may have happened in that span of time. The request must be of type:
 // - *pb.BackupRequest
 // - *pb.ExportRequest
+// - *pb.DeleteByQueryRequest
 func (t *tasks) Enqueue(req interface{}) (uint64, error) {
 	if t == nil {
 		return 0, fmt.Errorf("task queue hasn't been initialized yet")
@@ -171,6 +192,7 @@ func (t *tasks) Enqueue(req interface{}) (uint64, error) {
 // enqueue adds a new task to the queue. This must be of type:
 // - *pb.BackupRequest
 // - *pb.ExportRequest
+// - *pb.DeleteByQueryRequest
 func (t *tasks) enqueue(req interface{}) (uint64, error) {
 	var kind TaskKind
 	switch req.(type) {
@@ -178,6 +200,8 @@ func (t *tasks) enqueue(req interface{}) (uint64, error) {
 		kind = TaskKindBackup
 	case *pb.ExportRequest:
 		kind = TaskKindExport
+	case *pb.DeleteByQueryRequest:
+		kind = TaskKindDeleteByQuery
 	default:
 		err := fmt.Errorf("invalid TaskKind: %d", kind)
 		panic(err)
@@ -219,6 +243,56 @@ func (t *tasks) get(id uint64) (TaskMeta, error) {
 	return meta, nil
 }
 
+// cancel cancels a queued or running task. Only the delete-by-query tasks, which can stop between
+// two batches, and the index rebuilds can be cancelled. An index rebuild runs on all the replicas
+// of a group, so its cancellation is proposed to the group instead.
+func (t *tasks) cancel(ctx context.Context, id uint64) error {
+	if _, err := t.get(id); err != nil {
+		return err
+	}
+
+	t.logMu.Lock()
+	meta := TaskMeta(t.log.Get(id))
+	pred, indexing := t.indexingPredicate(id)
+	err := func() error {
+		if kind := meta.Kind(); kind != TaskKindDeleteByQuery && kind != TaskKindIndex {
+			return fmt.Errorf("%s tasks can't be cancelled", kind)
+		}
+		switch meta.Status() {
+		case TaskStatusQueued:
+			// The worker skips the tasks which aren't queued anymore.
+			t.log.Set(id, newTaskMeta(meta.Kind(), TaskStatusCancelled).uint64())
+		case TaskStatusRunning:
+			if cancel, ok := t.cancels[id]; ok && !indexing {
+				cancel()
+			}
+		default:
+			return fmt.Errorf("task is already done, with status %s", meta.Status())
+		}
+		return nil
+	}()
+	t.logMu.Unlock()
+
+	if err != nil || !indexing {
+		return err
+	}
+	return cancelIndexTask(ctx, pred)
+}
+
+// setProgress records the number of items the task has processed so far.
+func (t *tasks) setProgress(id, progress uint64) {
+	t.logMu.Lock()
+	defer t.logMu.Unlock()
+	t.progress[id] = progress
+}
+
+// getProgress returns the number of items the task has processed so far.
+func (t *tasks) getProgress(id uint64) uint64 {
+	t.logMu.Lock()
+	defer t.logMu.Unlock()
+	return t.progress[id]
+}
+
 // worker loops forever, running queued tasks one at a time. Any returned errors are logged.
 func (t *tasks) worker() {
 	shouldCleanup := time.NewTicker(time.Hour)
@@ -259,22 +333,29 @@ func (t *tasks) run(task taskRequest) error {
 	}
 
 	// Change the task status to Running.
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
 	t.logMu.Lock()
 	t.log.Set(task.id, newTaskMeta(meta.Kind(), TaskStatusRunning).uint64())
+	t.cancels[task.id] = cancel
 	t.logMu.Unlock()
 
 	// Run the task.
 	var status TaskStatus
-	err := task.run()
-	if err != nil {
+	err := task.run(ctx, func(progress uint64) { t.setProgress(task.id, progress) })
+	switch {
+	case err != nil && ctx.Err() == context.Canceled:
+		status = TaskStatusCancelled
+	case err != nil:
 		status = TaskStatusFailed
-	} else {
+	default:
 		status = TaskStatusSuccess
 	}
 
-	// Change the task status to Success / Failed.
+	// Change the task status to Success / Failed / Cancelled.
 	t.logMu.Lock()
 	t.log.Set(task.id, newTaskMeta(meta.Kind(), status).uint64())
+	delete(t.cancels, task.id)
 	t.logMu.Unlock()
 
 	// Return the error from the task.
@@ -290,6 +371,11 @@ func (t *tasks) cleanup() {
 	t.logMu.Lock()
 	defer t.logMu.Unlock()
 	t.log.DeleteBelow(minMeta)
+	for id := range t.progress {
+		if t.log.Get(id) == 0 {
+			delete(t.progress, id)
+		}
+	}
 }
 
 // newId generates a random unique task ID. logMu must be acquired before calling this function.
@@ -310,11 +396,12 @@ func (t *tasks) newId() uint64 {
 
 type taskRequest struct {
 	id  uint64
-	req interface{} // *pb.BackupRequest, *pb.ExportRequest
+	req interface{} // *pb.BackupRequest, *pb.ExportRequest, *pb.DeleteByQueryRequest
 }
 
-// run starts a task and blocks till it completes.
-func (t *taskRequest) run() error {
+// run starts a task and blocks till it completes, or till the context is cancelled for the
+// tasks which can be cancelled. The tasks which report their progress do it with progress.
+func (t *taskRequest) run(ctx context.Context, progress func(uint64)) error {
 	switch req := t.req.(type) {
 	case *pb.BackupRequest:
 		if err := ProcessBackupRequest(context.Background(), req); err != nil {
@@ -326,6 +413,13 @@ func (t *taskRequest) run() error {
 			return err
 		}
 		glog.Infof("task %#x: exported files: %v", t.id, files)
+	case *pb.DeleteByQueryRequest:
+		if RunDeleteByQuery == nil {
+			return fmt.Errorf("delete by query isn't available on this node")
+		}
+		if err := RunDeleteByQuery(ctx, req, progress); err != nil {
+			return err
+		}
 	default:
 		glog.Errorf(
 			"task %#x: received request of unknown type (%T)", t.id, reflect.TypeOf(t.req))
@@ -370,6 +464,8 @@ const (
 	// Reserve the zero value for errors.
 	TaskKindBackup TaskKind = iota + 1
 	TaskKindExport
+	TaskKindDeleteByQuery
+	TaskKindIndex
 )
 
 type TaskKind uint64
@@ -380,6 +476,10 @@ func (k TaskKind) String() string {
 		return "Backup"
 	case TaskKindExport:
 		return "Export"
+	case TaskKindDeleteByQuery:
+		return "DeleteByQuery"
+	case TaskKindIndex:
+		return "Index"
 	default:
 		return "Unknown"
 	}
@@ -391,6 +491,7 @@ const (
 	TaskStatusRunning
 	TaskStatusFailed
 	TaskStatusSuccess
+	TaskStatusCancelled
 )
 
 type TaskStatus uint64
@@ -405,6 +506,8 @@ func (status TaskStatus) String() string {
 		return "Failed"
 	case TaskStatusSuccess:
 		return "Success"
+	case TaskStatusCancelled:
+		return "Cancelled"
 	default:
 		return "Unknown"
 	}
@@ -143,6 +143,31 @@ func loadFromBackup(db *badger.DB, in *loadBackupInput) (uint64, uint64, error)
 	if err := db.DropPrefix([]byte{x.ByteType}); err != nil {
 		return 0, 0, err
 	}
+	if err := db.DropPrefix([]byte{x.ByteSynonym}); err != nil {
+		return 0, 0, err
+	}
+	if err := db.DropPrefix([]byte{x.ByteSchemaVersion}); err != nil {
+		return 0, 0, err
+	}
+	if err := db.DropPrefix([]byte{x.ByteTrigger}); err != nil {
+		return 0, 0, err
+	}
+	if err := db.DropPrefix([]byte{x.ByteStoredQuery}); err != nil {
+		return 0, 0, err
+	}
+	// The ttl marks don't apply to the restored data, which expires as if it was written when
+	// it was restored.
+	if err := db.DropPrefix(x.TTLMarkPrefix()); err != nil {
+		return 0, 0, err
+	}
+	// The tombstones refer to the versions of the data before the restore, which are gone.
+	if err := db.DropPrefix([]byte{x.ByteTombstone}); err != nil {
+		return 0, 0, err
+	}
+	// The trigger events left undelivered belong to the commits before the restore.
+	if err := db.DropPrefix(x.TriggerEventPrefix()); err != nil {
+		return 0, 0, err
+	}
 
 	loader := db.NewKVLoader(16)
 	var maxUid, maxNsId uint64
@@ -179,14 +204,17 @@ func loadFromBackup(db *badger.DB, in *loadBackupInput) (uint64, uint64, error)
 				return 0, 0, err
 			}
 
-			// Filter keys using the preds set. Do not do this filtering for type keys
-			// as they are meant to be in every group and their Attr value does not
-			// match a predicate name.
+			// Filter keys using the preds set. Do not do this filtering for type, synonym, schema
+			// version and trigger keys as they are meant to be in every group and their Attr
+			// value does not match a predicate name.
 			parsedKey, err := x.Parse(restoreKey)
 			if err != nil {
 				return 0, 0, errors.Wrapf(err, "could not parse key %s", hex.Dump(restoreKey))
 			}
-			if _, ok := in.preds[parsedKey.Attr]; !parsedKey.IsType() && !ok {
+			_, ok := in.preds[parsedKey.Attr]
+			if !ok && !parsedKey.IsType() && !parsedKey.IsSynonym() &&
+				!parsedKey.IsSchemaVersion() && !parsedKey.IsTrigger() &&
+				!parsedKey.IsStoredQuery() {
 				continue
 			}
 
@@ -194,9 +222,12 @@ func loadFromBackup(db *badger.DB, in *loadBackupInput) (uint64, uint64, error)
 			maxUid = x.Max(maxUid, parsedKey.Uid)
 			maxNsId = x.Max(maxNsId, namespace)
 
-			// Override the version if requested. Should not be done for type and schema predicates,
-			// which always have their version set to 1.
-			if in.restoreTs > 0 && !parsedKey.IsSchema() && !parsedKey.IsType() {
+			// Override the version if requested. Should not be done for type, synonym, schema
+			// version, trigger, stored query and schema predicates, which keep the version they
+			// were written at.
+			if in.restoreTs > 0 && !parsedKey.IsSchema() && !parsedKey.IsType() &&
+				!parsedKey.IsSynonym() && !parsedKey.IsSchemaVersion() && !parsedKey.IsTrigger() &&
+				!parsedKey.IsStoredQuery() {
 				kv.Version = in.restoreTs
 			}
 
@@ -18,15 +18,18 @@ package worker
 
 import (
 	"context"
+	"math"
 
 	"github.com/golang/protobuf/proto"
 	"github.com/pkg/errors"
 	otrace "go.opencensus.io/trace"
 
+	"github.com/dgraph-io/badger/v3"
 	"github.com/dgraph-io/dgraph/conn"
 	"github.com/dgraph-io/dgraph/protos/pb"
 	"github.com/dgraph-io/dgraph/schema"
 	"github.com/dgraph-io/dgraph/types"
+	"github.com/dgraph-io/dgraph/x"
 )
 
 var (
@@ -57,7 +60,7 @@ func getSchema(ctx context.Context, s *pb.SchemaRequest) (*pb.SchemaResult, erro
 		fields = s.Fields
 	} else {
 		fields = []string{"type", "index", "tokenizer", "reverse", "count", "list", "upsert",
-			"lang", "noconflict"}
+			"lang", "noconflict", "ordered", "ttl"}
 	}
 
 	myGid := groups().groupId()
@@ -114,6 +117,12 @@ func populateSchema(attr string, fields []string) *pb.SchemaNode {
 			schemaNode.Lang = pred.GetLang()
 		case "noconflict":
 			schemaNode.NoConflict = pred.GetNoConflict()
+		case "ordered":
+			schemaNode.Ordered = pred.GetOrdered()
+		case "ttl":
+			if pred.GetTtl() > 0 {
+				schemaNode.Ttl = schema.FormatTTL(pred.GetTtl())
+			}
 		default:
 			//pass
 		}
@@ -263,3 +272,24 @@ func GetTypes(ctx context.Context, req *pb.SchemaRequest) ([]*pb.TypeUpdate, err
 
 	return out, nil
 }
+
+// GetSchemaVersions returns the recorded versions of the schema of the namespace, oldest first.
+// The versions are stored in every group, so they are read from the local store.
+func GetSchemaVersions(namespace uint64) ([]*pb.SchemaVersion, error) {
+	txn := pstore.NewTransactionAt(math.MaxUint64, false)
+	defer txn.Discard()
+	iterOpt := badger.DefaultIteratorOptions
+	iterOpt.Prefix = x.SchemaVersionPrefix(namespace)
+	itr := txn.NewIterator(iterOpt)
+	defer itr.Close()
+
+	var out []*pb.SchemaVersion
+	for itr.Rewind(); itr.Valid(); itr.Next() {
+		var v pb.SchemaVersion
+		if err := itr.Item().Value(v.Unmarshal); err != nil {
+			return nil, errors.Wrapf(err, "while reading schema version")
+		}
+		out = append(out, &v)
+	}
+	return out, nil
+}
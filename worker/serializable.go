@@ -0,0 +1,107 @@
+/*
+ * Copyright 2022 Dgraph Labs, Inc. and Contributors
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package worker
+
+import (
+	"context"
+	"sort"
+	"sync"
+
+	"github.com/dgryski/go-farm"
+
+	"github.com/dgraph-io/dgraph/posting"
+	"github.com/dgraph-io/dgraph/schema"
+	"github.com/dgraph-io/dgraph/x"
+)
+
+// Serializable transactions send the conflict keys of what their queries read along with the keys
+// they write, and Zero aborts them if any was overwritten by a transaction committed after they
+// started. The lists read are tracked by the groups serving their predicates, and the scans of
+// a predicate, like has() or an inequality, read the whole predicate.
+
+// ReadKeys collects the conflict keys read by the queries of a serializable transaction.
+type ReadKeys struct {
+	sync.Mutex
+	keys map[string]struct{}
+}
+
+type readKeysKey struct{}
+
+// WithReadKeys returns a context whose queries collect the conflict keys they read into the
+// returned ReadKeys.
+func WithReadKeys(ctx context.Context) (context.Context, *ReadKeys) {
+	reads := &ReadKeys{keys: make(map[string]struct{})}
+	return context.WithValue(ctx, readKeysKey{}, reads), reads
+}
+
+func readKeysFromContext(ctx context.Context) *ReadKeys {
+	reads, _ := ctx.Value(readKeysKey{}).(*ReadKeys)
+	return reads
+}
+
+// Keys returns the conflict keys read so far, sorted.
+func (r *ReadKeys) Keys() []string {
+	r.Lock()
+	defer r.Unlock()
+	keys := make([]string, 0, len(r.keys))
+	for key := range r.keys {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+	return keys
+}
+
+// add adds conflict keys which were already encoded. It does nothing if the reads aren't tracked.
+func (r *ReadKeys) add(keys ...string) {
+	if r == nil {
+		return
+	}
+	r.Lock()
+	defer r.Unlock()
+	for _, key := range keys {
+		r.keys[key] = struct{}{}
+	}
+}
+
+// addKey adds the conflict key of a list read.
+func (r *ReadKeys) addKey(key []byte) {
+	if r == nil {
+		return
+	}
+	r.add(x.ReadConflictKey(farm.Fingerprint64(key)))
+}
+
+// addPredicate adds the conflict key of a predicate which was scanned.
+func (r *ReadKeys) addPredicate(attr string) {
+	if r == nil || schema.State().HasNoConflict(attr) {
+		return
+	}
+	r.add(x.ReadConflictKey(posting.PredicateFingerprint(attr)))
+}
+
+// isScan returns whether the function goes through the index of the predicate rather than
+// looking keys up, so that the whole predicate is read. Like the inequalities, the regular
+// expressions and fuzzy matches go through the trigram index.
+func isScan(srcFn *functionContext) bool {
+	switch srcFn.fnType {
+	case hasFn, regexFn, matchFn:
+		return true
+	case compareAttrFn, compareScalarFn:
+		return srcFn.fname != "eq"
+	}
+	return false
+}
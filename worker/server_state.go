@@ -42,13 +42,17 @@ const (
 	BadgerDefaults = `compression=snappy; numgoroutines=8;`
 	RaftDefaults   = `learner=false; snapshot-after-entries=10000; ` +
 		`snapshot-after-duration=30m; pending-proposals=256; idx=; group=;`
-	SecurityDefaults  = `token=; whitelist=;`
-	LudicrousDefaults = `enabled=false; concurrency=2000;`
-	CDCDefaults       = `file=; kafka=; sasl_user=; sasl_password=; ca_cert=; client_cert=; ` +
+	SecurityDefaults     = `token=; whitelist=;`
+	LudicrousDefaults    = `enabled=false; concurrency=2000;`
+	SoftDeleteDefaults   = `enabled=false; retention=168h;`
+	SerializableDefaults = `enabled=false;`
+	SlowQueryDefaults    = `latency=1s; edges=0; compress=false; days=10; size=100; output=;`
+	CDCDefaults          = `file=; kafka=; sasl_user=; sasl_password=; ca_cert=; client_cert=; ` +
 		`client_key=; sasl-mechanism=PLAIN;`
 	LimitDefaults = `mutations=allow; query-edge=1000000; normalize-node=10000; ` +
 		`mutations-nquad=1000000; disallow-drop=false; query-timeout=0ms; txn-abort-after=5m; ` +
-		` max-retries=-1;max-pending-queries=10000`
+		` max-retries=-1;max-pending-queries=10000; reindex-rate=0; idempotency-window=10m; ` +
+		`idempotency-keys=100000;`
 	ZeroLimitsDefaults = `uid-lease=0; refill-interval=30s; disable-admin-http=false;`
 	GraphQLDefaults    = `introspection=true; debug=false; extensions=true; poll-interval=1s; ` +
 		`lambda-url=;`
@@ -219,17 +219,22 @@ func doStreamSnapshot(snap *pb.Snapshot, out pb.Worker_StreamSnapshotServer) err
 			return true
 		}
 
+		// Schema versions keep the timestamp they are numbered with, and are all sent with the
+		// snapshot.
+		if item.Key()[0] == x.ByteSchemaVersion {
+			return true
+		}
 		if item.Version() != 1 {
 			return false
 		}
 
-		// Type and Schema keys always have a timestamp of 1. They all need to be sent
+		// Type, Synonym and Schema keys always have a timestamp of 1. They all need to be sent
 		// with the snapshot.
 		pk, err := x.Parse(item.Key())
 		if err != nil {
 			return false
 		}
-		return pk.IsSchema() || pk.IsType()
+		return pk.IsSchema() || pk.IsType() || pk.IsSynonym()
 	}
 
 	// Get the list of all the predicate and types at the time of the snapshot so that the receiver
@@ -0,0 +1,198 @@
+/*
+ * Copyright 2022 Dgraph Labs, Inc. and Contributors
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package worker
+
+import (
+	"context"
+	"math"
+	"sort"
+	"time"
+
+	"github.com/golang/glog"
+	"github.com/pkg/errors"
+
+	"github.com/dgraph-io/badger/v3"
+	"github.com/dgraph-io/dgraph/posting"
+	"github.com/dgraph-io/dgraph/protos/pb"
+	"github.com/dgraph-io/dgraph/x"
+)
+
+// With soft delete enabled, the deletion of all the predicates of a node is recorded as a
+// tombstone, written by its transaction. The deleted values are still there in the versions
+// before the commit, and the versions within the retention window aren't discarded, so the node
+// can be brought back by reading them at an earlier timestamp. The tombstones are stored in every
+// group, and each alpha purges the ones older than the retention window, whose versions are
+// discarded like any other.
+
+// addTombstones records the tombstones of the nodes deleted by the transaction, to be written
+// along with its deltas when it commits. Being part of the transaction, they are written by every
+// alpha of the group, whichever alpha the commit goes through, and dropped if it aborts.
+func addTombstones(txn *posting.Txn, tombstones []*pb.Tombstone) {
+	for _, t := range tombstones {
+		t.StartTs = txn.StartTs
+		data, err := t.Marshal()
+		x.Check(err)
+		txn.SetAtCommit(x.TombstoneKey(t.Namespace, t.Uid, t.StartTs), data)
+	}
+}
+
+// RemoveTombstones removes the tombstones from every group, which makes their deletions
+// permanent.
+func RemoveTombstones(ctx context.Context, tombstones []*pb.Tombstone) error {
+	for _, t := range tombstones {
+		t.Remove = true
+	}
+	m := &pb.Mutations{
+		StartTs:    State.GetTimestamp(false),
+		Tombstones: tombstones,
+	}
+	_, err := MutateOverNetwork(ctx, m)
+	return err
+}
+
+// isTombstoneRemoval returns whether the mutation removes tombstones, rather than recording the
+// ones of the nodes deleted by its transaction.
+func isTombstoneRemoval(m *pb.Mutations) bool {
+	return len(m.Tombstones) > 0 && m.Tombstones[0].Remove
+}
+
+func removeTombstone(t pb.Tombstone, ts uint64) error {
+	txn := pstore.NewTransactionAt(ts, true)
+	defer txn.Discard()
+	if err := txn.Delete(x.TombstoneKey(t.Namespace, t.Uid, t.StartTs)); err != nil {
+		return err
+	}
+	return txn.CommitAt(ts, nil)
+}
+
+// readTombstone reads a tombstone, whose deletion was committed at the version of its key.
+func readTombstone(item *badger.Item) (*pb.Tombstone, error) {
+	var t pb.Tombstone
+	if err := item.Value(t.Unmarshal); err != nil {
+		return nil, errors.Wrapf(err, "while reading tombstone")
+	}
+	t.DeletedAt = item.Version()
+	return &t, nil
+}
+
+// GetTombstones returns the tombstones of the namespace, sorted by uid and then by the time of
+// the deletion. The tombstones are stored in every group, so they are read from the local store.
+func GetTombstones(namespace uint64) ([]*pb.Tombstone, error) {
+	txn := pstore.NewTransactionAt(math.MaxUint64, false)
+	defer txn.Discard()
+	iterOpt := badger.DefaultIteratorOptions
+	iterOpt.Prefix = x.TombstonePrefix(namespace)
+	itr := txn.NewIterator(iterOpt)
+	defer itr.Close()
+
+	var out []*pb.Tombstone
+	for itr.Rewind(); itr.Valid(); itr.Next() {
+		t, err := readTombstone(itr.Item())
+		if err != nil {
+			return nil, err
+		}
+		out = append(out, t)
+	}
+	sort.SliceStable(out, func(i, j int) bool {
+		return out[i].Uid < out[j].Uid ||
+			(out[i].Uid == out[j].Uid && out[i].DeletedAt < out[j].DeletedAt)
+	})
+	return out, nil
+}
+
+// SoftDeleteFloor returns the oldest timestamp whose versions are still kept for soft delete.
+// The nodes can only be brought back as they were at this timestamp or later.
+func SoftDeleteFloor() (uint64, error) {
+	if x.WorkerConfig.SoftDeleteRetention == 0 {
+		return math.MaxUint64, nil
+	}
+	marks, err := readTTLMarks()
+	if err != nil {
+		return 0, err
+	}
+	return ttlMarkTs(marks, time.Now(), int64(x.WorkerConfig.SoftDeleteRetention/time.Second)), nil
+}
+
+// discardTs returns the timestamp below which the invalid versions can be discarded, given the
+// timestamp of the snapshot. The versions within the retention window of soft delete are kept.
+func discardTs(snapshotTs uint64) (uint64, error) {
+	if x.WorkerConfig.SoftDeleteRetention == 0 {
+		return snapshotTs, nil
+	}
+	floor, err := SoftDeleteFloor()
+	if err != nil {
+		return 0, errors.Wrapf(err, "while reading the retention window of soft delete")
+	}
+	if floor < snapshotTs {
+		return floor, nil
+	}
+	return snapshotTs, nil
+}
+
+// purgeTombstones deletes the tombstones of the deletions older than the retention window, which
+// can no longer be undone. Like the ttl marks, the tombstones are purged by every alpha on its
+// own, at the given timestamp.
+func purgeTombstones(ts uint64) error {
+	floor, err := SoftDeleteFloor()
+	if err != nil || floor == 0 {
+		return err
+	}
+
+	keys, err := expiredTombstones(floor, ts)
+	if err != nil || len(keys) == 0 {
+		return err
+	}
+	txn := pstore.NewTransactionAt(ts, true)
+	defer txn.Discard()
+	for _, key := range keys {
+		if err := txn.Delete(key); err != nil {
+			return err
+		}
+	}
+	if err := txn.CommitAt(ts, nil); err != nil {
+		return err
+	}
+	glog.Infof("Purged %d deletions older than the retention window of soft delete", len(keys))
+	return nil
+}
+
+// expiredTombstones returns the keys of the tombstones of the deletions before the floor. The
+// tombstones stored at or after the given timestamp are left for a later round.
+func expiredTombstones(floor, ts uint64) ([][]byte, error) {
+	txn := pstore.NewTransactionAt(math.MaxUint64, false)
+	defer txn.Discard()
+	iterOpt := badger.DefaultIteratorOptions
+	iterOpt.Prefix = []byte{x.ByteTombstone}
+	itr := txn.NewIterator(iterOpt)
+	defer itr.Close()
+
+	var keys [][]byte
+	for itr.Rewind(); itr.Valid(); itr.Next() {
+		item := itr.Item()
+		if item.Version() >= ts {
+			continue
+		}
+		t, err := readTombstone(item)
+		if err != nil {
+			return nil, err
+		}
+		if t.DeletedAt < floor {
+			keys = append(keys, item.KeyCopy(nil))
+		}
+	}
+	return keys, nil
+}
@@ -0,0 +1,105 @@
+/*
+ * Copyright 2022 Dgraph Labs, Inc. and Contributors
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package worker
+
+import (
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/dgraph-io/dgraph/posting"
+	"github.com/dgraph-io/dgraph/protos/pb"
+	"github.com/dgraph-io/dgraph/x"
+)
+
+// deleteNode records the tombstone of the node in a transaction, which is committed or aborted.
+// The commit timestamp is returned, or zero if the transaction is aborted.
+func deleteNode(t *testing.T, ns, uid uint64, commit bool) uint64 {
+	startTs := timestamp()
+	txn := posting.Oracle().RegisterStartTs(startTs)
+	addTombstones(txn, []*pb.Tombstone{{
+		Namespace:  ns,
+		Uid:        uid,
+		Predicates: []string{"name"},
+		CreatedAt:  time.Now().Unix(),
+	}})
+	if !commit {
+		posting.Oracle().ProcessDelta(&pb.OracleDelta{
+			MaxAssigned: atomic.LoadUint64(&ts),
+			Txns:        []*pb.TxnStatus{{StartTs: startTs}},
+		})
+		return 0
+	}
+
+	commit := commitTs(startTs)
+	writer := posting.NewTxnWriter(pstore)
+	require.NoError(t, txn.CommitToDisk(writer, commit))
+	require.NoError(t, writer.Flush())
+	return commit
+}
+
+func TestTombstonesWrittenByTransaction(t *testing.T) {
+	ns := uint64(0x40)
+	deletedAt := deleteNode(t, ns, 0x2a, true)
+	deleteNode(t, ns, 0x2b, false)
+
+	// Only the tombstone of the committed transaction is written, at its commit timestamp.
+	tombstones, err := GetTombstones(ns)
+	require.NoError(t, err)
+	require.Len(t, tombstones, 1)
+	require.Equal(t, uint64(0x2a), tombstones[0].Uid)
+	require.Equal(t, deletedAt, tombstones[0].DeletedAt)
+	require.Less(t, tombstones[0].StartTs, deletedAt)
+	require.Equal(t, []string{"name"}, tombstones[0].Predicates)
+
+	// Removing the tombstone makes the deletion permanent.
+	require.NoError(t, removeTombstone(*tombstones[0], timestamp()))
+	tombstones, err = GetTombstones(ns)
+	require.NoError(t, err)
+	require.Empty(t, tombstones)
+}
+
+func TestPurgeTombstones(t *testing.T) {
+	defer func(retention time.Duration) {
+		x.WorkerConfig.SoftDeleteRetention = retention
+	}(x.WorkerConfig.SoftDeleteRetention)
+	x.WorkerConfig.SoftDeleteRetention = time.Hour
+
+	ns := uint64(0x41)
+	old := deleteNode(t, ns, 0x2a, true)
+	// The mark tells that the timestamp after the first deletion was reached two hours ago, so
+	// the first deletion is older than the retention window.
+	mark := ttlMark{ts: timestamp(), time: time.Now().Add(-2 * time.Hour)}
+	require.NoError(t, storeTTLMark(mark))
+	recent := deleteNode(t, ns, 0x2b, true)
+
+	floor, err := SoftDeleteFloor()
+	require.NoError(t, err)
+	require.Equal(t, mark.ts, floor)
+	require.Less(t, old, floor)
+	ts, err := discardTs(recent)
+	require.NoError(t, err)
+	require.Equal(t, floor, ts)
+
+	require.NoError(t, purgeTombstones(timestamp()))
+	tombstones, err := GetTombstones(ns)
+	require.NoError(t, err)
+	require.Len(t, tombstones, 1)
+	require.Equal(t, recent, tombstones[0].DeletedAt)
+}
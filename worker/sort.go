@@ -61,6 +61,12 @@ func SortOverNetwork(ctx context.Context, q *pb.SortMessage) (*pb.SortResult, er
 		return &emptySortResult,
 			errors.Errorf("Cannot sort by unknown attribute %s", x.ParseAttr(q.Order[0].Attr))
 	}
+	// Sorting goes through the index of the predicates, so serializable transactions read them
+	// whole.
+	reads := readKeysFromContext(ctx)
+	for _, order := range q.Order {
+		reads.addPredicate(order.Attr)
+	}
 
 	if span := otrace.FromContext(ctx); span != nil {
 		span.Annotatef(nil, "worker.SortOverNetwork. Attr: %s. Group: %d",
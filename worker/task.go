@@ -19,6 +19,7 @@ package worker
 import (
 	"bytes"
 	"context"
+	"encoding/binary"
 	"sort"
 	"strconv"
 	"strings"
@@ -144,9 +145,18 @@ func ProcessTaskOverNetwork(ctx context.Context, q *pb.Query) (*pb.Result, error
 			attr, gid, q.ReadTs, groups().Node.Id)
 	}
 
+	// The keys read by serializable transactions are returned along with the result.
+	reads := readKeysFromContext(ctx)
+	q.TrackReads = reads != nil
+
 	if groups().ServesGroup(gid) {
 		// No need for a network call, as this should be run from within this instance.
-		return processTask(ctx, q, gid)
+		reply, err := processTask(ctx, q, gid)
+		if err != nil {
+			return nil, err
+		}
+		reads.add(reply.ReadKeys...)
+		return reply, nil
 	}
 
 	result, err := processWithBackupRequest(ctx, gid,
@@ -158,6 +168,7 @@ func ProcessTaskOverNetwork(ctx context.Context, q *pb.Query) (*pb.Result, error
 	}
 
 	reply := result.(*pb.Result)
+	reads.add(reply.ReadKeys...)
 	if span != nil {
 		span.Annotatef(nil, "Reply from server. len: %v gid: %v Attr: %v",
 			len(reply.UidMatrix), gid, attr)
@@ -181,6 +192,24 @@ func convertValue(attr, data string) (types.Val, error) {
 	return dst, err
 }
 
+// extractJSONPath returns the value at the path of the json document, or nil if the document
+// doesn't have a value there.
+func extractJSONPath(doc *pb.TaskValue, path types.JSONPath) (*pb.TaskValue, error) {
+	v, ok := path.Extract(string(doc.Val))
+	if !ok {
+		return nil, nil
+	}
+	val, err := types.JSONValue(v)
+	if err != nil {
+		return nil, err
+	}
+	data := types.ValueForType(types.BinaryID)
+	if err := types.Marshal(val, &data); err != nil {
+		return nil, err
+	}
+	return &pb.TaskValue{ValType: val.Tid.Enum(), Val: data.Value.([]byte)}, nil
+}
+
 // Returns nil byte on error
 func convertToType(v types.Val, typ types.TypeID) (*pb.TaskValue, error) {
 	result := &pb.TaskValue{ValType: typ.Enum(), Val: x.Nilbyte}
@@ -220,6 +249,11 @@ const (
 	uidInFn
 	customIndexFn
 	matchFn
+	phoneticFn
+	nearestFn
+	intervalFn
+	jsonPathFn
+	jsonPathCompareFn
 	standardFn = 100
 )
 
@@ -235,6 +269,11 @@ func parseFuncType(srcFunc *pb.SrcFunction) (FuncType, string) {
 		//    counting on attr, then compare the result as scalar with int
 		return compareScalarFn, fname
 	}
+	if srcFunc.JsonPath != "" && ftype == compareAttrFn {
+		// eq(jsonpath(data, "$.status"), "active") compares the values at the path of the json
+		// documents, using the index of the path if there is one.
+		return jsonPathCompareFn, fname
+	}
 	return ftype, fname
 }
 
@@ -262,10 +301,19 @@ func parseFuncTypeHelper(name string) (FuncType, string) {
 		return customIndexFn, f
 	case "match":
 		return matchFn, f
+	case "sounds_like":
+		return phoneticFn, f
+	case "nearest":
+		return nearestFn, f
+	case "jsonpath":
+		return jsonPathFn, f
 	default:
 		if types.IsGeoFunc(f) {
 			return geoFn, f
 		}
+		if types.IsIntervalFunc(f) {
+			return intervalFn, f
+		}
 		return standardFn, f
 	}
 }
@@ -280,7 +328,10 @@ func needsIndex(fnType FuncType, uidList *pb.List) bool {
 			return false
 		}
 		return true
-	case geoFn, fullTextSearchFn, standardFn, matchFn:
+	case jsonPathCompareFn:
+		// Like compareAttrFn, the filters compare the values instead of using the index.
+		return uidList == nil
+	case geoFn, fullTextSearchFn, standardFn, matchFn, phoneticFn, nearestFn, intervalFn:
 		return true
 	}
 	return false
@@ -304,14 +355,15 @@ type funcArgs struct {
 // The function tells us whether we want to fetch value posting lists or uid posting lists.
 func (srcFn *functionContext) needsValuePostings(typ types.TypeID) (bool, error) {
 	switch srcFn.fnType {
-	case aggregatorFn, passwordFn:
+	case aggregatorFn, passwordFn, jsonPathFn:
 		return true, nil
-	case compareAttrFn:
+	case compareAttrFn, jsonPathCompareFn:
 		if len(srcFn.tokens) > 0 {
 			return false, nil
 		}
 		return true, nil
-	case geoFn, regexFn, fullTextSearchFn, standardFn, hasFn, customIndexFn, matchFn:
+	case geoFn, regexFn, fullTextSearchFn, standardFn, hasFn, customIndexFn, matchFn,
+		phoneticFn, nearestFn, intervalFn:
 		// All of these require an index, hence would require fetching uid postings.
 		return false, nil
 	case uidInFn, compareScalarFn:
@@ -341,7 +393,7 @@ func (qs *queryState) handleValuePostings(ctx context.Context, args funcArgs) er
 	}
 
 	switch srcFn.fnType {
-	case notAFunction, aggregatorFn, passwordFn, compareAttrFn:
+	case notAFunction, aggregatorFn, passwordFn, compareAttrFn, jsonPathFn, jsonPathCompareFn:
 	default:
 		return errors.Errorf("Unhandled function in handleValuePostings: %s", srcFn.fname)
 	}
@@ -391,7 +443,7 @@ func (qs *queryState) handleValuePostings(ctx context.Context, args funcArgs) er
 			key := x.DataKey(q.Attr, q.UidList.Uids[i])
 
 			// Get or create the posting list for an entity, attribute combination.
-			pl, err := qs.cache.Get(key)
+			pl, err := qs.get(key)
 			if err != nil {
 				return err
 			}
@@ -469,6 +521,21 @@ func (qs *queryState) handleValuePostings(ctx context.Context, args funcArgs) er
 						}
 					}
 
+				} else if srcFn.fnType == jsonPathCompareFn {
+					// The uid is added once, even if several values of a list match.
+					if len(uidList.Uids) == 0 && types.MatchJSONPath(srcFn.fname, srcFn.jsonPath,
+						string(newValue.Val), q.SrcFunc.Args) {
+						uidList.Uids = append(uidList.Uids, q.UidList.Uids[i])
+					}
+				} else if srcFn.fnType == jsonPathFn {
+					// The documents without a value at the path have no value in the result.
+					extracted, err := extractJSONPath(newValue, srcFn.jsonPath)
+					if err != nil {
+						return err
+					}
+					if extracted != nil {
+						vl.Values = append(vl.Values, extracted)
+					}
 				} else {
 					vl.Values = append(vl.Values, newValue)
 				}
@@ -479,7 +546,7 @@ func (qs *queryState) handleValuePostings(ctx context.Context, args funcArgs) er
 			out.FacetMatrix = append(out.FacetMatrix, fcs)
 
 			switch {
-			case srcFn.fnType == aggregatorFn:
+			case srcFn.fnType == aggregatorFn || srcFn.fnType == jsonPathFn:
 				// Add an empty UID list to make later processing consistent
 				out.UidMatrix = append(out.UidMatrix, &pb.List{})
 			case srcFn.fnType == passwordFn:
@@ -609,11 +676,19 @@ func retrieveValuesAndFacets(args funcArgs, pl *posting.List, facetsTree *facets
 	var vals []types.Val
 	var fcs []*pb.Facets
 
+	// The version of a node is the commit timestamp of its version posting, not its value.
+	isVersion := x.ParseAttr(q.Attr) == x.VersionPredicate
 	err := facetsFilterValuePostingList(args, pl, facetsTree, listType, func(p *pb.Posting) {
-		vals = append(vals, types.Val{
-			Tid:   types.TypeID(p.ValType),
-			Value: p.Value,
-		})
+		if isVersion {
+			val := make([]byte, 8)
+			binary.LittleEndian.PutUint64(val, postingVersion(p))
+			vals = append(vals, types.Val{Tid: types.IntID, Value: val})
+		} else {
+			vals = append(vals, types.Val{
+				Tid:   types.TypeID(p.ValType),
+				Value: p.Value,
+			})
+		}
 		if q.FacetParam != nil {
 			fcs = append(fcs, &pb.Facets{Facets: facets.CopyFacets(p.Facets, q.FacetParam)})
 		}
@@ -743,14 +818,14 @@ func (qs *queryState) handleUidPostings(
 					key = x.DataKey(q.Attr, q.UidList.Uids[i])
 				}
 			case geoFn, regexFn, fullTextSearchFn, standardFn, customIndexFn, matchFn,
-				compareAttrFn:
+				phoneticFn, intervalFn, compareAttrFn, jsonPathCompareFn:
 				key = x.IndexKey(q.Attr, srcFn.tokens[i])
 			default:
 				return errors.Errorf("Unhandled function in handleUidPostings: %s", srcFn.fname)
 			}
 
 			// Get or create the posting list for an entity, attribute combination.
-			pl, err := qs.cache.Get(key)
+			pl, err := qs.get(key)
 			if err != nil {
 				return err
 			}
@@ -920,17 +995,31 @@ func processTask(ctx context.Context, q *pb.Query, gid uint32) (*pb.Result, erro
 	if qs.cache == nil {
 		qs.cache = posting.NoCache(q.ReadTs)
 	}
+	if q.TrackReads && !schema.State().HasNoConflict(q.Attr) {
+		qs.reads = &ReadKeys{keys: make(map[string]struct{})}
+	}
 	// For now, remove the query level cache. It is causing contention for queries with high
 	// fan-out.
 	out, err := qs.helpProcessTask(ctx, q, gid)
 	if err != nil {
 		return nil, err
 	}
+	if qs.reads != nil {
+		out.ReadKeys = qs.reads.Keys()
+	}
 	return out, nil
 }
 
 type queryState struct {
 	cache *posting.LocalCache
+	// reads collects the conflict keys read for a serializable transaction, when not nil.
+	reads *ReadKeys
+}
+
+// get returns the posting list for the key, tracking the read for serializable transactions.
+func (qs *queryState) get(key []byte) (*posting.List, error) {
+	qs.reads.addKey(key)
+	return qs.cache.Get(key)
 }
 
 func (qs *queryState) helpProcessTask(ctx context.Context, q *pb.Query, gid uint32) (
@@ -944,12 +1033,19 @@ func (qs *queryState) helpProcessTask(ctx context.Context, q *pb.Query, gid uint
 	if err != nil {
 		return nil, err
 	}
+	if isScan(srcFn) {
+		qs.reads.addPredicate(attr)
+	}
 
 	if q.Reverse && !schema.State().IsReversed(ctx, attr) {
 		return nil, errors.Errorf("Predicate %s doesn't have reverse edge", x.ParseAttr(attr))
 	}
 
 	if needsIndex(srcFn.fnType, q.UidList) && !schema.State().IsIndexed(ctx, q.Attr) {
+		if schema.State().IsBeingIndexed(q.Attr) {
+			return nil, errors.Errorf("Predicate %s is being indexed in the background. Please "+
+				"retry once its index task is done", x.ParseAttr(q.Attr))
+		}
 		return nil, errors.Errorf("Predicate %s is not indexed", x.ParseAttr(q.Attr))
 	}
 
@@ -1035,6 +1131,13 @@ func (qs *queryState) helpProcessTask(ctx context.Context, q *pb.Query, gid uint
 		}
 	}
 
+	if srcFn.fnType == nearestFn {
+		span.Annotate(nil, "handleNearestFunction")
+		if err := qs.handleNearestFunction(ctx, args); err != nil {
+			return nil, err
+		}
+	}
+
 	// We fetch the actual value for the uids, compare them to the value in the
 	// request and filter the uids only if the tokenizer IsLossy.
 	if srcFn.fnType == compareAttrFn && len(srcFn.tokens) > 0 {
@@ -1052,6 +1155,15 @@ func (qs *queryState) helpProcessTask(ctx context.Context, q *pb.Query, gid uint
 		}
 	}
 
+	// The interval index finds the intervals of the buckets around the query, so the values are
+	// checked as well.
+	if srcFn.intervalQuery != nil {
+		span.Annotate(nil, "handleIntervalFunction")
+		if err := qs.filterIntervalFunction(ctx, args); err != nil {
+			return nil, err
+		}
+	}
+
 	// For string matching functions, check the language. We are not checking here
 	// for hasFn as filtering for it has already been done in handleHasFunction.
 	if srcFn.fnType != hasFn && needsStringFiltering(srcFn, q.Langs, attr) {
@@ -1079,7 +1191,7 @@ func needsStringFiltering(srcFn *functionContext, langs []string, attr string) b
 	return langForFunc(langs) != "." &&
 		(srcFn.fnType == standardFn || srcFn.fnType == hasFn ||
 			srcFn.fnType == fullTextSearchFn || srcFn.fnType == compareAttrFn ||
-			srcFn.fnType == customIndexFn)
+			srcFn.fnType == customIndexFn || srcFn.fnType == phoneticFn)
 }
 
 func (qs *queryState) handleCompareScalarFunction(ctx context.Context, arg funcArgs) error {
@@ -1143,7 +1255,7 @@ func (qs *queryState) handleRegexFunction(ctx context.Context, arg funcArgs) err
 
 	// Prefer to use an index (fast)
 	case useIndex:
-		uids, err = uidsForRegex(attr, arg, query, &empty)
+		uids, err = qs.uidsForRegex(attr, arg, query, &empty)
 		if err != nil {
 			return err
 		}
@@ -1169,7 +1281,7 @@ func (qs *queryState) handleRegexFunction(ctx context.Context, arg funcArgs) err
 			return ctx.Err()
 		default:
 		}
-		pl, err := qs.cache.Get(x.DataKey(attr, uid))
+		pl, err := qs.get(x.DataKey(attr, uid))
 		if err != nil {
 			return err
 		}
@@ -1389,7 +1501,7 @@ func (qs *queryState) handleMatchFunction(ctx context.Context, arg funcArgs) err
 
 	case schema.State().HasTokenizer(ctx, tok.IdentTrigram, attr):
 		var err error
-		uids, err = uidsForMatch(attr, arg)
+		uids, err = qs.uidsForMatch(attr, arg)
 		if err != nil {
 			return err
 		}
@@ -1414,7 +1526,7 @@ func (qs *queryState) handleMatchFunction(ctx context.Context, arg funcArgs) err
 			return ctx.Err()
 		default:
 		}
-		pl, err := qs.cache.Get(x.DataKey(attr, uid))
+		pl, err := qs.get(x.DataKey(attr, uid))
 		if err != nil {
 			return err
 		}
@@ -1461,6 +1573,26 @@ func (qs *queryState) filterGeoFunction(ctx context.Context, arg funcArgs) error
 	stop := x.SpanTimer(span, "filterGeoFunction")
 	defer stop()
 
+	return qs.filterValues(ctx, arg, "geo", func(tv *pb.TaskValue) bool {
+		return types.MatchGeo(tv, arg.srcFn.geoQuery)
+	})
+}
+
+func (qs *queryState) filterIntervalFunction(ctx context.Context, arg funcArgs) error {
+	span := otrace.FromContext(ctx)
+	stop := x.SpanTimer(span, "filterIntervalFunction")
+	defer stop()
+
+	return qs.filterValues(ctx, arg, "interval", func(tv *pb.TaskValue) bool {
+		return types.MatchInterval(tv, arg.srcFn.intervalQuery)
+	})
+}
+
+// filterValues keeps the uids of the results which have a value for which match returns true.
+func (qs *queryState) filterValues(ctx context.Context, arg funcArgs, kind string,
+	match func(*pb.TaskValue) bool) error {
+	span := otrace.FromContext(ctx)
+
 	attr := arg.q.Attr
 	uids := algo.MergeSorted(arg.out.UidMatrix)
 	numGo, width := x.DivideAndRule(len(uids.Uids))
@@ -1474,7 +1606,7 @@ func (qs *queryState) filterGeoFunction(ctx context.Context, arg funcArgs) error
 		filtered[idx] = &pb.List{}
 		out := filtered[idx]
 		for _, uid := range uids.Uids[start:end] {
-			pl, err := qs.cache.Get(x.DataKey(attr, uid))
+			pl, err := qs.get(x.DataKey(attr, uid))
 			if err != nil {
 				return err
 			}
@@ -1482,7 +1614,7 @@ func (qs *queryState) filterGeoFunction(ctx context.Context, arg funcArgs) error
 			err = pl.Iterate(arg.q.ReadTs, 0, func(p *pb.Posting) error {
 				tv.ValType = p.ValType
 				tv.Val = p.Value
-				if types.MatchGeo(&tv, arg.srcFn.geoQuery) {
+				if match(&tv) {
 					out.Uids = append(out.Uids, uid)
 					return posting.ErrStopIteration
 				}
@@ -1516,7 +1648,7 @@ func (qs *queryState) filterGeoFunction(ctx context.Context, arg funcArgs) error
 		final.Uids = append(final.Uids, out.Uids...)
 	}
 	if span != nil && numGo > 1 {
-		span.Annotatef(nil, "Total uids after filtering geo: %d", len(final.Uids))
+		span.Annotatef(nil, "Total uids after filtering %s: %d", kind, len(final.Uids))
 	}
 	for i := 0; i < len(arg.out.UidMatrix); i++ {
 		algo.IntersectWith(arg.out.UidMatrix[i], final, arg.out.UidMatrix[i])
@@ -1580,7 +1712,7 @@ func (qs *queryState) filterStringFunction(arg funcArgs) error {
 	case fullTextSearchFn:
 		filter.tokens = arg.srcFn.tokens
 		filter.match = defaultMatch
-		filter.tokName = "fulltext"
+		filter.tokName = arg.srcFn.tokName
 		filtered = matchStrings(filtered, values, &filter)
 	case standardFn:
 		filter.tokens = arg.srcFn.tokens
@@ -1592,6 +1724,11 @@ func (qs *queryState) filterStringFunction(arg funcArgs) error {
 		filter.match = defaultMatch
 		filter.tokName = arg.q.SrcFunc.Args[0]
 		filtered = matchStrings(filtered, values, &filter)
+	case phoneticFn:
+		filter.tokens = arg.srcFn.tokens
+		filter.match = defaultMatch
+		filter.tokName = arg.srcFn.tokName
+		filtered = matchStrings(filtered, values, &filter)
 	case compareAttrFn:
 		// filter.ineqValue = arg.srcFn.ineqValue
 		filter.eqVals = arg.srcFn.eqTokens
@@ -1607,7 +1744,7 @@ func (qs *queryState) filterStringFunction(arg funcArgs) error {
 
 func (qs *queryState) getValsForUID(attr, lang string, uid, ReadTs uint64) ([]types.Val, error) {
 	key := x.DataKey(attr, uid)
-	pl, err := qs.cache.Get(key)
+	pl, err := qs.get(key)
 	if err != nil {
 		return nil, err
 	}
@@ -1638,6 +1775,9 @@ func matchRegex(value types.Val, regex *cregexp.Regexp) bool {
 type functionContext struct {
 	tokens        []string
 	geoQuery      *types.GeoQueryData
+	intervalQuery *types.IntervalQueryData
+	jsonPath      types.JSONPath
+	nearest       *types.NearestQuery
 	intersectDest bool
 	// eqTokens is used by compareAttr functions. It stores values corresponding to each
 	// function argument. There could be multiple arguments to `eq` function but only one for
@@ -1655,6 +1795,9 @@ type functionContext struct {
 	isFuncAtRoot   bool
 	isStringFn     bool
 	atype          types.TypeID
+	// tokName is the name of the full-text tokenizer used by anyoftext and alloftext, or of the
+	// phonetic tokenizer used by sounds_like.
+	tokName string
 }
 
 const (
@@ -1821,6 +1964,79 @@ func parseSrcFn(ctx context.Context, q *pb.Query) (*functionContext, error) {
 			return nil, err
 		}
 		fc.n = len(fc.tokens)
+	case intervalFn:
+		if t, err := schema.State().TypeOf(attr); err != nil || t != types.IntervalID {
+			return nil, errors.Errorf("%s function requires a predicate of interval type, got %s",
+				q.SrcFunc.Name, x.ParseAttr(attr))
+		}
+		fc.tokens, fc.intervalQuery, err = types.GetIntervalTokens(q.SrcFunc)
+		if err != nil {
+			return nil, err
+		}
+		tok.EncodeIntervalTokens(fc.tokens)
+		fc.n = len(fc.tokens)
+	case jsonPathFn:
+		if t, err := schema.State().TypeOf(attr); err != nil || t != types.JSONID {
+			return nil, errors.Errorf("jsonpath function requires a predicate of json type, got %s",
+				x.ParseAttr(attr))
+		}
+		if err = ensureArgsCount(q.SrcFunc, 1); err != nil {
+			return nil, err
+		}
+		if fc.jsonPath, err = types.ParseJSONPath(q.SrcFunc.Args[0]); err != nil {
+			return nil, err
+		}
+		fc.n = len(q.UidList.Uids)
+	case jsonPathCompareFn:
+		if t, err := schema.State().TypeOf(attr); err != nil || t != types.JSONID {
+			return nil, errors.Errorf("jsonpath function requires a predicate of json type, got %s",
+				x.ParseAttr(attr))
+		}
+		args := q.SrcFunc.Args
+		switch {
+		case f == eq && len(args) < 1:
+			return nil, errors.Errorf("eq expects atleast 1 argument.")
+		case f == between && len(args) != 2:
+			return nil, errors.Errorf("between expects exactly 2 argument.")
+		case f != eq && f != between && len(args) != 1:
+			return nil, errors.Errorf("%+v expects only 1 argument. Got: %+v", f, args)
+		}
+		if fc.jsonPath, err = types.ParseJSONPath(q.SrcFunc.JsonPath); err != nil {
+			return nil, err
+		}
+		checkRoot(q, fc)
+		if !fc.isFuncAtRoot {
+			// The filters compare the values of the documents.
+			break
+		}
+		tokenizer, found := pickJSONPathTokenizer(ctx, attr, fc.jsonPath)
+		if !found {
+			return nil, errors.Errorf("Attribute %s is not indexed with jsonpath(%q)",
+				x.ParseAttr(attr), fc.jsonPath.String())
+		}
+		if f != eq {
+			return nil, errors.Errorf("Only eq can use the jsonpath index, use %s(jsonpath()) "+
+				"in a filter instead", f)
+		}
+		for _, arg := range args {
+			fc.tokens = append(fc.tokens, tokenizer.QueryTokens(arg)...)
+		}
+		fc.n = len(fc.tokens)
+	case nearestFn:
+		if t, err := schema.State().TypeOf(attr); err != nil || t != types.GeoID {
+			return nil, errors.Errorf("nearest function requires a predicate of geo type, got %s",
+				x.ParseAttr(attr))
+		}
+		checkRoot(q, fc)
+		if !fc.isFuncAtRoot {
+			return nil, errors.Errorf("nearest function is only allowed at root")
+		}
+		if fc.nearest, err = types.ParseNearest(q.SrcFunc); err != nil {
+			return nil, err
+		}
+		// The index is looked up by handleNearestFunction, as the number of tokens depends on
+		// how far the results are.
+		fc.n = 0
 	case passwordFn:
 		if err = ensureArgsCount(q.SrcFunc, 2); err != nil {
 			return nil, err
@@ -1837,9 +2053,16 @@ func parseSrcFn(ctx context.Context, q *pb.Query) (*functionContext, error) {
 			return nil, errors.Errorf("Attribute %s is not indexed with type %s", x.ParseAttr(attr),
 				required)
 		}
-		if fc.tokens, err = getStringTokens(q.SrcFunc.Args, langForFunc(q.Langs), fnType); err != nil {
+		args := q.SrcFunc.Args
+		if f == "anyofterms" || f == "anyoftext" {
+			// Any of the synonyms of a term is a match, so they are added to the argument.
+			args = []string{schema.State().ExpandSynonyms(x.ParseNamespace(attr), args[0])}
+		}
+		if fc.tokens, err = getStringTokens(args, langForFunc(q.Langs), fnType,
+			required); err != nil {
 			return nil, err
 		}
+		fc.tokName = required
 		fc.intersectDest = needsIntersect(f)
 		fc.n = len(fc.tokens)
 	case matchFn:
@@ -1865,6 +2088,20 @@ func parseSrcFn(ctx context.Context, q *pb.Query) (*functionContext, error) {
 		fc.threshold = []int64{int64(max)}
 		fc.tokens = q.SrcFunc.Args
 		fc.n = len(fc.tokens)
+	case phoneticFn:
+		if err = ensureArgsCount(q.SrcFunc, 1); err != nil {
+			return nil, err
+		}
+		tokenizer, found := pickPhoneticTokenizer(ctx, attr)
+		if !found {
+			return nil, errors.Errorf("Attribute %s is not indexed with soundex or metaphone",
+				x.ParseAttr(attr))
+		}
+		if fc.tokens, err = tok.BuildTokens(q.SrcFunc.Args[0], tokenizer); err != nil {
+			return nil, err
+		}
+		fc.tokName = tokenizer.Name()
+		fc.n = len(fc.tokens)
 	case customIndexFn:
 		if err = ensureArgsCount(q.SrcFunc, 2); err != nil {
 			return nil, err
@@ -2242,7 +2479,7 @@ func (qs *queryState) evaluate(cp countParams, out *pb.Result) error {
 
 	countKey := x.CountKey(cp.attr, uint32(countl), cp.reverse)
 	if cp.fn == "eq" {
-		pl, err := qs.cache.Get(countKey)
+		pl, err := qs.get(countKey)
 		if err != nil {
 			return err
 		}
@@ -44,8 +44,17 @@ func verifyStringIndex(ctx context.Context, attr string, funcType FuncType) (str
 		return requiredTokenizer.Name(), false
 	}
 
+	tokenizers := schema.State().Tokenizer(ctx, attr)
+	if funcType == fullTextSearchFn {
+		// The fulltext_v2 index is preferred, since it has better tokens for CJK text.
+		for _, t := range tokenizers {
+			if t.Identifier() == tok.IdentFullTextV2 {
+				return t.Name(), true
+			}
+		}
+	}
 	id := requiredTokenizer.Identifier()
-	for _, t := range schema.State().Tokenizer(ctx, attr) {
+	for _, t := range tokenizers {
 		if t.Identifier() == id {
 			return requiredTokenizer.Name(), true
 		}
@@ -53,6 +62,24 @@ func verifyStringIndex(ctx context.Context, attr string, funcType FuncType) (str
 	return requiredTokenizer.Name(), false
 }
 
+// pickPhoneticTokenizer returns the phonetic tokenizer of the attribute. Metaphone is preferred
+// over soundex when the attribute has both indexes, since it has fewer false positives.
+func pickPhoneticTokenizer(ctx context.Context, attr string) (tok.Tokenizer, bool) {
+	if !schema.State().IsIndexed(ctx, attr) {
+		return nil, false
+	}
+	var found tok.Tokenizer
+	for _, t := range schema.State().Tokenizer(ctx, attr) {
+		switch t.Identifier() {
+		case tok.IdentMetaphone:
+			return t, true
+		case tok.IdentSoundex:
+			found = t
+		}
+	}
+	return found, found != nil
+}
+
 func verifyCustomIndex(ctx context.Context, attr string, tokenizerName string) bool {
 	if !schema.State().IsIndexed(ctx, attr) {
 		return false
@@ -65,13 +92,32 @@ func verifyCustomIndex(ctx context.Context, attr string, tokenizerName string) b
 	return false
 }
 
-// Return string tokens from function arguments. It maps function type to correct tokenizer.
+// pickJSONPathTokenizer returns the jsonpath tokenizer of the attribute for the path.
+func pickJSONPathTokenizer(ctx context.Context, attr string,
+	path types.JSONPath) (tok.JSONPathTokenizer, bool) {
+	if !schema.State().IsIndexed(ctx, attr) {
+		return tok.JSONPathTokenizer{}, false
+	}
+	for _, t := range schema.State().Tokenizer(ctx, attr) {
+		if jt, ok := t.(tok.JSONPathTokenizer); ok && jt.Path().String() == path.String() {
+			return jt, true
+		}
+	}
+	return tok.JSONPathTokenizer{}, false
+}
+
+// Return string tokens from function arguments. It maps function type to correct tokenizer,
+// using the given full-text tokenizer for the full-text search functions.
 // Note: regexp functions require regexp compilation of argument, not tokenization.
-func getStringTokens(funcArgs []string, lang string, funcType FuncType) ([]string, error) {
+func getStringTokens(funcArgs []string, lang string, funcType FuncType,
+	tokName string) ([]string, error) {
 	if lang == "." {
 		lang = "en"
 	}
 	if funcType == fullTextSearchFn {
+		if tokName == (tok.FullTextV2Tokenizer{}).Name() {
+			return tok.GetFullTextV2Tokens(funcArgs, lang)
+		}
 		return tok.GetFullTextTokens(funcArgs, lang)
 	}
 	return tok.GetTermTokens(funcArgs)
@@ -80,13 +126,28 @@ func getStringTokens(funcArgs []string, lang string, funcType FuncType) ([]strin
 func pickTokenizer(ctx context.Context, attr string, f string) (tok.Tokenizer, error) {
 	// Get the tokenizers and choose the corresponding one.
 	if !schema.State().IsIndexed(ctx, attr) {
+		if schema.State().IsBeingIndexed(attr) {
+			return nil, errors.Errorf("Attribute %s is being indexed in the background. Please "+
+				"retry once its index task is done.", attr)
+		}
 		return nil, errors.Errorf("Attribute %s is not indexed.", attr)
 	}
 
-	tokenizers := schema.State().Tokenizer(ctx, attr)
-	if tokenizers == nil {
+	all := schema.State().Tokenizer(ctx, attr)
+	if all == nil {
 		return nil, errors.Errorf("Schema state not found for %s.", attr)
 	}
+	// The jsonpath tokenizers only index a part of the values, they are used by the functions
+	// on the paths.
+	var tokenizers []tok.Tokenizer
+	for _, t := range all {
+		if _, ok := t.(tok.JSONPathTokenizer); !ok {
+			tokenizers = append(tokenizers, t)
+		}
+	}
+	if len(tokenizers) == 0 {
+		return nil, errors.Errorf("Attribute:%s does not have proper index for comparison", attr)
+	}
 	for _, t := range tokenizers {
 		// If function is eq and we found a tokenizer that's !Lossy(), lets return it
 		switch f {
@@ -150,7 +211,8 @@ func getInequalityTokens(ctx context.Context, readTs uint64, attr, f, lang strin
 
 		// Allow eq with term/fulltext tokenizers, even though they give multiple tokens.
 		case f == "eq" &&
-			(tokenizer.Identifier() == tok.IdentTerm || tokenizer.Identifier() == tok.IdentFullText):
+			(tokenizer.Identifier() == tok.IdentTerm || tokenizer.Identifier() == tok.IdentFullText ||
+				tokenizer.Identifier() == tok.IdentFullTextV2):
 			break
 
 		case len(ineqTokens) > 1:
@@ -0,0 +1,200 @@
+/*
+ * Copyright 2022 Dgraph Labs, Inc. and Contributors
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package worker
+
+import (
+	"context"
+	"math"
+	"time"
+
+	"github.com/golang/glog"
+	"github.com/pkg/errors"
+
+	"github.com/dgraph-io/badger/v3"
+	"github.com/dgraph-io/dgraph/posting"
+	"github.com/dgraph-io/dgraph/protos/pb"
+	"github.com/dgraph-io/dgraph/x"
+)
+
+// The events of the triggers called after the commit of a transaction are written by the
+// transaction itself, in group one, so that they are recorded whichever alpha the commit goes
+// through and dropped if it aborts. The leader of group one delivers the events, retrying the
+// failed ones with an exponential backoff, and removes them once delivered.
+
+const (
+	// triggerEventInterval is how often the events are looked for.
+	triggerEventInterval = time.Second
+	// triggerEventAttempts is how many times an event is delivered before giving up.
+	triggerEventAttempts = 5
+	// triggerEventRetryDelay is the delay before the first retry, doubled after every attempt.
+	triggerEventRetryDelay = time.Second
+)
+
+// CallTrigger delivers the event of a trigger called after the commit. It is set by edgraph.
+var CallTrigger func(ctx context.Context, ev *pb.TriggerEvent) error
+
+// addTriggerEvents records the events of the triggers called after the commit of the
+// transaction, to be written along with its deltas when it commits.
+func addTriggerEvents(txn *posting.Txn, events []*pb.TriggerEvent) {
+	for _, ev := range events {
+		ev.StartTs = txn.StartTs
+		data, err := ev.Marshal()
+		x.Check(err)
+		txn.SetAtCommit(x.TriggerEventKey(ev.StartTs, ev.Id), data)
+	}
+}
+
+// isTriggerEventRemoval returns whether the mutation removes delivered trigger events, rather
+// than recording the ones of its transaction.
+func isTriggerEventRemoval(m *pb.Mutations) bool {
+	return len(m.TriggerEvents) > 0 && m.TriggerEvents[0].Remove
+}
+
+func removeTriggerEvent(ev pb.TriggerEvent, ts uint64) error {
+	txn := pstore.NewTransactionAt(ts, true)
+	defer txn.Discard()
+	if err := txn.Delete(x.TriggerEventKey(ev.StartTs, ev.Id)); err != nil {
+		return err
+	}
+	return txn.CommitAt(ts, nil)
+}
+
+// removeTriggerEvents removes the delivered events through group one.
+func removeTriggerEvents(ctx context.Context, events []*pb.TriggerEvent) error {
+	removals := make([]*pb.TriggerEvent, 0, len(events))
+	for _, ev := range events {
+		removals = append(removals, &pb.TriggerEvent{StartTs: ev.StartTs, Id: ev.Id, Remove: true})
+	}
+	m := &pb.Mutations{
+		StartTs:       State.GetTimestamp(false),
+		TriggerEvents: removals,
+	}
+	_, err := MutateOverNetwork(ctx, m)
+	return err
+}
+
+// readTriggerEvents returns the events waiting to be delivered, in the order of the start
+// timestamps of their transactions. The commit timestamp of an event is the version of its key.
+func readTriggerEvents() ([]*pb.TriggerEvent, error) {
+	txn := pstore.NewTransactionAt(math.MaxUint64, false)
+	defer txn.Discard()
+	iterOpt := badger.DefaultIteratorOptions
+	iterOpt.Prefix = x.TriggerEventPrefix()
+	itr := txn.NewIterator(iterOpt)
+	defer itr.Close()
+
+	var out []*pb.TriggerEvent
+	for itr.Rewind(); itr.Valid(); itr.Next() {
+		item := itr.Item()
+		var ev pb.TriggerEvent
+		if err := item.Value(ev.Unmarshal); err != nil {
+			return nil, errors.Wrapf(err, "while reading trigger event")
+		}
+		ev.CommitTs = item.Version()
+		out = append(out, &ev)
+	}
+	return out, nil
+}
+
+type triggerRetry struct {
+	attempts int
+	delay    time.Duration
+	next     time.Time
+}
+
+// triggerOutbox keeps track of the retries of the events which failed to be delivered.
+type triggerOutbox struct {
+	retries map[string]*triggerRetry
+}
+
+func newTriggerOutbox() *triggerOutbox {
+	return &triggerOutbox{retries: make(map[string]*triggerRetry)}
+}
+
+// deliver calls the triggers of the events which aren't waiting for a retry, and returns the
+// events which are done with, either delivered or given up on.
+func (o *triggerOutbox) deliver(ctx context.Context, events []*pb.TriggerEvent, now time.Time,
+	call func(ctx context.Context, ev *pb.TriggerEvent) error) []*pb.TriggerEvent {
+	var done []*pb.TriggerEvent
+	for _, ev := range events {
+		key := string(x.TriggerEventKey(ev.StartTs, ev.Id))
+		retry := o.retries[key]
+		if retry != nil && now.Before(retry.next) {
+			continue
+		}
+		err := call(ctx, ev)
+		if err == nil {
+			delete(o.retries, key)
+			done = append(done, ev)
+			continue
+		}
+		if retry == nil {
+			retry = &triggerRetry{delay: triggerEventRetryDelay}
+			o.retries[key] = retry
+		}
+		retry.attempts++
+		if retry.attempts >= triggerEventAttempts {
+			glog.Errorf("Giving up on trigger %s for the commit at %d after %d attempts: %v",
+				x.ParseAttr(ev.Trigger), ev.CommitTs, retry.attempts, err)
+			delete(o.retries, key)
+			done = append(done, ev)
+			continue
+		}
+		glog.V(2).Infof("While calling trigger %s: %v. Retrying in %s",
+			x.ParseAttr(ev.Trigger), err, retry.delay)
+		retry.next = now.Add(retry.delay)
+		retry.delay *= 2
+	}
+	return done
+}
+
+// processTriggerEvents delivers the events of the triggers called after the commit, on the
+// leader of group one. A new leader picks up the events left by the previous one, which may
+// deliver an event more than once.
+func (n *node) processTriggerEvents() {
+	defer n.closer.Done() // CLOSER:1
+	tick := time.NewTicker(triggerEventInterval)
+	defer tick.Stop()
+
+	outbox := newTriggerOutbox()
+	for {
+		select {
+		case <-n.closer.HasBeenClosed():
+			return
+		case <-tick.C:
+			if !isGroupOneLeader() || CallTrigger == nil {
+				outbox = newTriggerOutbox()
+				continue
+			}
+			events, err := readTriggerEvents()
+			if err != nil {
+				glog.Errorf("While reading the trigger events: %v", err)
+				continue
+			}
+			if len(events) == 0 {
+				continue
+			}
+			done := outbox.deliver(n.ctx, events, time.Now(), CallTrigger)
+			if len(done) == 0 {
+				continue
+			}
+			if err := removeTriggerEvents(n.ctx, done); err != nil {
+				glog.Errorf("While removing the delivered trigger events: %v", err)
+			}
+		}
+	}
+}
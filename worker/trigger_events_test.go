@@ -0,0 +1,124 @@
+/*
+ * Copyright 2022 Dgraph Labs, Inc. and Contributors
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package worker
+
+import (
+	"context"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/pkg/errors"
+	"github.com/stretchr/testify/require"
+
+	"github.com/dgraph-io/dgraph/posting"
+	"github.com/dgraph-io/dgraph/protos/pb"
+	"github.com/dgraph-io/dgraph/x"
+)
+
+// recordTriggerEvent records the event in a transaction, which is committed or aborted. The
+// commit timestamp is returned, or zero if the transaction is aborted.
+func recordTriggerEvent(t *testing.T, ev *pb.TriggerEvent, commit bool) uint64 {
+	startTs := timestamp()
+	txn := posting.Oracle().RegisterStartTs(startTs)
+	addTriggerEvents(txn, []*pb.TriggerEvent{ev})
+	if !commit {
+		posting.Oracle().ProcessDelta(&pb.OracleDelta{
+			MaxAssigned: atomic.LoadUint64(&ts),
+			Txns:        []*pb.TxnStatus{{StartTs: startTs}},
+		})
+		return 0
+	}
+
+	commit := commitTs(startTs)
+	writer := posting.NewTxnWriter(pstore)
+	require.NoError(t, txn.CommitToDisk(writer, commit))
+	require.NoError(t, writer.Flush())
+	return commit
+}
+
+func TestTriggerEventsWrittenByTransaction(t *testing.T) {
+	committed := &pb.TriggerEvent{Trigger: x.GalaxyAttr("hook"), Payload: []byte(`{}`), Id: 1}
+	commitTs := recordTriggerEvent(t, committed, true)
+	aborted := &pb.TriggerEvent{Trigger: x.GalaxyAttr("hook"), Payload: []byte(`{}`), Id: 2}
+	recordTriggerEvent(t, aborted, false)
+
+	// Only the event of the committed transaction is written, at its commit timestamp.
+	events, err := readTriggerEvents()
+	require.NoError(t, err)
+	require.Len(t, events, 1)
+	require.Equal(t, uint64(1), events[0].Id)
+	require.Equal(t, commitTs, events[0].CommitTs)
+	require.Less(t, events[0].StartTs, commitTs)
+
+	require.NoError(t, removeTriggerEvent(*events[0], timestamp()))
+	events, err = readTriggerEvents()
+	require.NoError(t, err)
+	require.Empty(t, events)
+}
+
+func TestTriggerOutboxRetries(t *testing.T) {
+	ev := &pb.TriggerEvent{Trigger: x.GalaxyAttr("hook"), StartTs: 3, CommitTs: 4, Id: 1}
+	var calls int
+	failTwice := func(ctx context.Context, ev *pb.TriggerEvent) error {
+		calls++
+		if calls <= 2 {
+			return errors.New("unavailable")
+		}
+		return nil
+	}
+
+	outbox := newTriggerOutbox()
+	now := time.Now()
+	require.Empty(t, outbox.deliver(context.Background(), []*pb.TriggerEvent{ev}, now, failTwice))
+	// The event isn't called again before its retry delay is over.
+	require.Empty(t, outbox.deliver(context.Background(), []*pb.TriggerEvent{ev}, now, failTwice))
+	require.Equal(t, 1, calls)
+
+	now = now.Add(triggerEventRetryDelay)
+	require.Empty(t, outbox.deliver(context.Background(), []*pb.TriggerEvent{ev}, now, failTwice))
+	// The delay is doubled after every attempt.
+	now = now.Add(triggerEventRetryDelay)
+	require.Empty(t, outbox.deliver(context.Background(), []*pb.TriggerEvent{ev}, now, failTwice))
+	require.Equal(t, 2, calls)
+
+	now = now.Add(triggerEventRetryDelay)
+	done := outbox.deliver(context.Background(), []*pb.TriggerEvent{ev}, now, failTwice)
+	require.Equal(t, []*pb.TriggerEvent{ev}, done)
+	require.Equal(t, 3, calls)
+	require.Empty(t, outbox.retries)
+}
+
+func TestTriggerOutboxGivesUp(t *testing.T) {
+	ev := &pb.TriggerEvent{Trigger: x.GalaxyAttr("hook"), StartTs: 3, CommitTs: 4, Id: 1}
+	var calls int
+	fail := func(ctx context.Context, ev *pb.TriggerEvent) error {
+		calls++
+		return errors.New("unavailable")
+	}
+
+	outbox := newTriggerOutbox()
+	now := time.Now()
+	var done []*pb.TriggerEvent
+	for i := 0; i < triggerEventAttempts; i++ {
+		done = outbox.deliver(context.Background(), []*pb.TriggerEvent{ev}, now, fail)
+		now = now.Add(time.Hour)
+	}
+	require.Equal(t, triggerEventAttempts, calls)
+	require.Equal(t, []*pb.TriggerEvent{ev}, done)
+	require.Empty(t, outbox.retries)
+}
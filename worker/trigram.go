@@ -31,7 +31,7 @@ import (
 var errRegexTooWide = errors.New(
 	"regular expression is too wide-ranging and can't be executed efficiently")
 
-func uidsForRegex(attr string, arg funcArgs,
+func (qs *queryState) uidsForRegex(attr string, arg funcArgs,
 	query *cindex.Query, intersect *pb.List) (*pb.List, error) {
 	var results *pb.List
 	opts := posting.ListOptions{
@@ -44,8 +44,7 @@ func uidsForRegex(attr string, arg funcArgs,
 	}
 
 	uidsForTrigram := func(trigram string) (*pb.List, error) {
-		key := x.IndexKey(attr, trigram)
-		pl, err := posting.GetNoStore(key, arg.q.ReadTs)
+		pl, err := qs.get(x.IndexKey(attr, trigram))
 		if err != nil {
 			return nil, err
 		}
@@ -76,7 +75,7 @@ func uidsForRegex(attr string, arg funcArgs,
 			}
 			// current list of result is passed for intersection
 			var err error
-			results, err = uidsForRegex(attr, arg, sub, results)
+			results, err = qs.uidsForRegex(attr, arg, sub, results)
 			if err != nil {
 				return nil, err
 			}
@@ -99,7 +98,7 @@ func uidsForRegex(attr string, arg funcArgs,
 			if results == nil {
 				results = intersect
 			}
-			subUids, err := uidsForRegex(attr, arg, sub, intersect)
+			subUids, err := qs.uidsForRegex(attr, arg, sub, intersect)
 			if err != nil {
 				return nil, err
 			}
@@ -0,0 +1,447 @@
+/*
+ * Copyright 2022 Dgraph Labs, Inc. and Contributors
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package worker
+
+import (
+	"bytes"
+	"context"
+	"encoding/binary"
+	"math"
+	"time"
+
+	"github.com/golang/glog"
+	"github.com/pkg/errors"
+
+	"github.com/dgraph-io/badger/v3"
+	"github.com/dgraph-io/dgraph/posting"
+	"github.com/dgraph-io/dgraph/protos/pb"
+	"github.com/dgraph-io/dgraph/schema"
+	"github.com/dgraph-io/dgraph/x"
+)
+
+// The values of a predicate with a ttl expire once their posting list hasn't been written for
+// longer than the ttl, and the nodes of a type with a ttl expire once their dgraph.type hasn't
+// been written for longer than it. The timestamps of the writes don't tell when they happened,
+// so the leader of each group proposes marks of the time at which a timestamp was reached, which
+// every replica records. A value written at or before the timestamp of a mark older than the ttl
+// has expired.
+//
+// The leader of each group deletes the expired values of the predicates it serves through
+// regular transactions, so that their indexes are updated, concurrent transactions which write
+// them abort it, and the deletions are sent to CDC.
+
+const (
+	// ttlInterval is how often the expired values are looked for.
+	ttlInterval = time.Minute
+	// ttlMarkInterval is how often a ttl mark is recorded. Values are deleted at most this much
+	// later than when they expire.
+	ttlMarkInterval = 10 * time.Minute
+	// ttlBatchSize is the maximum number of nodes whose values are deleted in a transaction.
+	ttlBatchSize = 1000
+)
+
+type ttlMark struct {
+	ts   uint64
+	time time.Time
+}
+
+// processTTL periodically records a ttl mark and deletes the values which have expired. It also
+// purges the deletions older than the retention window of soft delete.
+func (n *node) processTTL() {
+	defer n.closer.Done() // CLOSER:1
+	tick := time.NewTicker(ttlInterval)
+	defer tick.Stop()
+
+	for {
+		select {
+		case <-n.closer.HasBeenClosed():
+			return
+		case <-tick.C:
+			if err := n.expireTTL(); err != nil {
+				glog.Errorf("While deleting the values with an expired ttl: %v", err)
+			}
+			if x.WorkerConfig.SoftDeleteRetention > 0 {
+				if err := purgeTombstones(posting.Oracle().MaxAssigned()); err != nil {
+					glog.Errorf("While purging the deletions of soft delete: %v", err)
+				}
+			}
+		}
+	}
+}
+
+func (n *node) expireTTL() error {
+	if !n.AmLeader() {
+		return nil
+	}
+	now := time.Unix(time.Now().Unix(), 0)
+	marks, err := readTTLMarks()
+	if err != nil {
+		return err
+	}
+	mark := ttlMark{ts: posting.Oracle().MaxAssigned(), time: now}
+	switch {
+	case mark.ts == 0:
+		// The timestamps haven't been received from Zero yet.
+	case len(marks) == 0 || (now.Sub(marks[len(marks)-1].time) >= ttlMarkInterval &&
+		mark.ts > marks[len(marks)-1].ts):
+		err := n.proposeTTLMarks(mark.ts, []*pb.TTLMark{{Ts: mark.ts, Time: now.Unix()}})
+		if err != nil {
+			return err
+		}
+		marks = append(marks, mark)
+	}
+
+	predTTLs := schema.State().PredicateTTLs()
+	typeTTLs := make(map[string]int64)
+	var maxTTL int64
+	for _, ttl := range predTTLs {
+		if ttl > maxTTL {
+			maxTTL = ttl
+		}
+	}
+	for _, name := range schema.State().Types() {
+		if ttl := schema.State().TypeTTL(name); ttl > 0 {
+			typeTTLs[name] = ttl
+			if ttl > maxTTL {
+				maxTTL = ttl
+			}
+		}
+	}
+	if retention := int64(x.WorkerConfig.SoftDeleteRetention / time.Second); retention > maxTTL {
+		// The marks are also needed to find the retention window of soft delete.
+		maxTTL = retention
+	}
+	if maxTTL > 0 {
+		cutoff := now.Add(-time.Duration(maxTTL) * time.Second)
+		if drop := ttlMarksToPrune(marks, cutoff, mark.ts); drop > 0 {
+			removals := make([]*pb.TTLMark, 0, drop)
+			for _, m := range marks[:drop] {
+				removals = append(removals, &pb.TTLMark{Ts: m.ts, Remove: true})
+			}
+			if err := n.proposeTTLMarks(mark.ts, removals); err != nil {
+				return err
+			}
+			marks = marks[drop:]
+		}
+	}
+
+	ctx := n.ctx
+	for attr, ttl := range predTTLs {
+		if !n.servesPredicate(attr) {
+			continue
+		}
+		markTs := ttlMarkTs(marks, now, ttl)
+		if markTs == 0 {
+			continue
+		}
+		err := expire(ctx, attr, func(uid, version uint64, pl *posting.List,
+			readTs uint64) ([]*pb.DirectedEdge, error) {
+			if version > markTs {
+				return nil, nil
+			}
+			return []*pb.DirectedEdge{deleteAllEdge(uid, attr)}, nil
+		})
+		if err != nil {
+			glog.Errorf("While deleting the expired values of %s: %v", x.FormatNsAttr(attr), err)
+		}
+	}
+
+	namespaces := make(map[uint64]struct{})
+	for name := range typeTTLs {
+		namespaces[x.ParseNamespace(name)] = struct{}{}
+	}
+	for ns := range namespaces {
+		attr := x.NamespaceAttr(ns, "dgraph.type")
+		if !n.servesPredicate(attr) {
+			continue
+		}
+		if err := expireTypes(ctx, attr, marks, now); err != nil {
+			glog.Errorf("While deleting the expired nodes of namespace %#x: %v", ns, err)
+		}
+	}
+	return nil
+}
+
+func (n *node) servesPredicate(attr string) bool {
+	gid, err := groups().BelongsToReadOnly(attr, 0)
+	return err == nil && gid == n.gid
+}
+
+// expireTypes deletes the nodes of the namespace of the given dgraph.type predicate whose
+// types have expired. A node with several types expires with the shortest ttl of its types.
+func expireTypes(ctx context.Context, attr string, marks []ttlMark, now time.Time) error {
+	ns := x.ParseNamespace(attr)
+	return expire(ctx, attr, func(uid, version uint64, pl *posting.List,
+		readTs uint64) ([]*pb.DirectedEdge, error) {
+		vals, err := pl.AllValues(readTs)
+		if err != nil {
+			return nil, err
+		}
+		var ttl int64
+		var typeNames []string
+		for _, val := range vals {
+			name, ok := val.Value.([]byte)
+			if !ok {
+				continue
+			}
+			typeName := x.NamespaceAttr(ns, string(name))
+			typeNames = append(typeNames, typeName)
+			if t := schema.State().TypeTTL(typeName); t > 0 && (ttl == 0 || t < ttl) {
+				ttl = t
+			}
+		}
+		if ttl == 0 {
+			return nil, nil
+		}
+		if markTs := ttlMarkTs(marks, now, ttl); markTs == 0 || version > markTs {
+			return nil, nil
+		}
+
+		edges := []*pb.DirectedEdge{deleteAllEdge(uid, attr)}
+		seen := map[string]struct{}{attr: {}}
+		for _, typeName := range typeNames {
+			for _, field := range schema.State().TypeFields(typeName) {
+				if _, ok := seen[field.Predicate]; ok || x.IsReverseAttr(field.Predicate) {
+					continue
+				}
+				seen[field.Predicate] = struct{}{}
+				edges = append(edges, deleteAllEdge(uid, field.Predicate))
+			}
+		}
+		return edges, nil
+	})
+}
+
+// expire deletes the expired values of the predicate, in batches of ttlBatchSize nodes. The
+// function is called with the posting list of each node at the start timestamp of the
+// transaction and the timestamp at which it was last written, and returns the edges deleting
+// the values of the node if they have expired.
+func expire(ctx context.Context, attr string, fn func(uid, version uint64, pl *posting.List,
+	readTs uint64) ([]*pb.DirectedEdge, error)) error {
+	for {
+		startTs := State.GetTimestamp(false)
+		if err := posting.Oracle().WaitForTs(ctx, startTs); err != nil {
+			return err
+		}
+		edges, nodes, err := findExpired(attr, startTs, fn)
+		if err != nil || nodes == 0 {
+			return err
+		}
+		if err := deleteExpired(ctx, startTs, edges); err != nil {
+			return err
+		}
+		glog.Infof("Deleted the expired values of %d nodes through %s", nodes,
+			x.FormatNsAttr(attr))
+		if nodes < ttlBatchSize {
+			return nil
+		}
+	}
+}
+
+func findExpired(attr string, readTs uint64, fn func(uid, version uint64, pl *posting.List,
+	readTs uint64) ([]*pb.DirectedEdge, error)) ([]*pb.DirectedEdge, int, error) {
+	pk := x.ParsedKey{Attr: attr}
+	iterOpt := badger.DefaultIteratorOptions
+	iterOpt.PrefetchValues = false
+	iterOpt.AllVersions = true
+	iterOpt.Prefix = pk.DataPrefix()
+
+	txn := pstore.NewTransactionAt(readTs, false)
+	defer txn.Discard()
+	it := txn.NewIterator(iterOpt)
+	defer it.Close()
+
+	var edges []*pb.DirectedEdge
+	var nodes int
+	var prevKey []byte
+	for it.Rewind(); it.Valid() && nodes < ttlBatchSize; {
+		item := it.Item()
+		if bytes.Equal(item.Key(), prevKey) {
+			it.Next()
+			continue
+		}
+		prevKey = append(prevKey[:0], item.Key()...)
+
+		// Parse the key upfront, otherwise ReadPostingList would advance the iterator.
+		pk, err := x.Parse(item.Key())
+		if err != nil {
+			return nil, 0, err
+		}
+		if pk.HasStartUid || item.IsDeletedOrExpired() ||
+			item.UserMeta()&posting.BitEmptyPosting > 0 {
+			it.Next()
+			continue
+		}
+
+		version := item.Version()
+		pl, err := posting.ReadPostingList(item.KeyCopy(nil), it)
+		if err != nil {
+			return nil, 0, err
+		}
+		if empty, err := pl.IsEmpty(readTs, 0); err != nil || empty {
+			if err != nil {
+				return nil, 0, err
+			}
+			continue
+		}
+		nodeEdges, err := fn(pk.Uid, version, pl, readTs)
+		if err != nil {
+			return nil, 0, err
+		}
+		if len(nodeEdges) > 0 {
+			edges = append(edges, nodeEdges...)
+			nodes++
+		}
+	}
+	return edges, nodes, nil
+}
+
+// deleteExpired deletes the values in a transaction at the given start timestamp. The
+// transaction aborts if any of the values was written since it started.
+func deleteExpired(ctx context.Context, startTs uint64, edges []*pb.DirectedEdge) error {
+	tctx, err := MutateOverNetwork(ctx, &pb.Mutations{StartTs: startTs, Edges: edges})
+	if err != nil {
+		tctx.Aborted = true
+		_, _ = CommitOverNetwork(ctx, tctx)
+		return err
+	}
+	_, err = CommitOverNetwork(ctx, tctx)
+	return err
+}
+
+func deleteAllEdge(uid uint64, attr string) *pb.DirectedEdge {
+	return &pb.DirectedEdge{
+		Entity:    uid,
+		Attr:      attr,
+		Namespace: x.ParseNamespace(attr),
+		Value:     []byte(x.Star),
+		ValueType: pb.Posting_DEFAULT,
+		Op:        pb.DirectedEdge_DEL,
+	}
+}
+
+// ttlMarkTs returns the timestamp of the latest mark older than the ttl, or zero if there is
+// none. The values written at or before this timestamp have expired.
+func ttlMarkTs(marks []ttlMark, now time.Time, ttl int64) uint64 {
+	cutoff := now.Add(-time.Duration(ttl) * time.Second)
+	var ts uint64
+	for _, mark := range marks {
+		if mark.time.After(cutoff) {
+			break
+		}
+		ts = mark.ts
+	}
+	return ts
+}
+
+func readTTLMarks() ([]ttlMark, error) {
+	txn := pstore.NewTransactionAt(math.MaxUint64, false)
+	defer txn.Discard()
+	iterOpt := badger.DefaultIteratorOptions
+	iterOpt.Prefix = x.TTLMarkPrefix()
+	it := txn.NewIterator(iterOpt)
+	defer it.Close()
+
+	var marks []ttlMark
+	for it.Rewind(); it.Valid(); it.Next() {
+		item := it.Item()
+		pk, err := x.Parse(item.Key())
+		if err != nil {
+			return nil, err
+		}
+		mark := ttlMark{ts: item.Version()}
+		err = item.Value(func(val []byte) error {
+			if len(val) != 8 {
+				return errors.Errorf("Invalid ttl mark %s", x.ParseAttr(pk.Attr))
+			}
+			mark.time = time.Unix(int64(binary.BigEndian.Uint64(val)), 0)
+			return nil
+		})
+		if err != nil {
+			return nil, err
+		}
+		marks = append(marks, mark)
+	}
+	return marks, nil
+}
+
+// proposeTTLMarks proposes the marks to the group, so that every replica records or removes them
+// and a new leader finds them. The removals are written at the given timestamp.
+func (n *node) proposeTTLMarks(ts uint64, marks []*pb.TTLMark) error {
+	m := &pb.Mutations{GroupId: n.gid, StartTs: ts, TtlMarks: marks}
+	return n.proposeAndWait(n.ctx, &pb.Proposal{Mutations: m})
+}
+
+// applyTTLMarks records or removes the proposed marks. The removals are written at the given
+// timestamp, which must be newer than the marks.
+func applyTTLMarks(marks []*pb.TTLMark, ts uint64) error {
+	for _, mark := range marks {
+		if mark.Remove {
+			if err := removeTTLMark(mark.Ts, ts); err != nil {
+				return err
+			}
+			continue
+		}
+		if err := storeTTLMark(ttlMark{ts: mark.Ts, time: time.Unix(mark.Time, 0)}); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func storeTTLMark(mark ttlMark) error {
+	txn := pstore.NewTransactionAt(mark.ts, true)
+	defer txn.Discard()
+	var val [8]byte
+	binary.BigEndian.PutUint64(val[:], uint64(mark.time.Unix()))
+	e := &badger.Entry{
+		Key:      x.TTLMarkKey(mark.ts),
+		Value:    val[:],
+		UserMeta: posting.BitSchemaPosting,
+	}
+	if err := txn.SetEntry(e); err != nil {
+		return err
+	}
+	return txn.CommitAt(mark.ts, nil)
+}
+
+func removeTTLMark(markTs, ts uint64) error {
+	txn := pstore.NewTransactionAt(ts, true)
+	defer txn.Discard()
+	if err := txn.Delete(x.TTLMarkKey(markTs)); err != nil {
+		return err
+	}
+	return txn.CommitAt(ts, nil)
+}
+
+// ttlMarksToPrune returns how many of the first marks are no longer needed by any ttl, keeping
+// the latest one older than the cutoff. Only the marks older than the given timestamp, at which
+// they are deleted, are pruned.
+func ttlMarksToPrune(marks []ttlMark, cutoff time.Time, ts uint64) int {
+	var keep int
+	for i, mark := range marks {
+		if mark.time.After(cutoff) {
+			break
+		}
+		keep = i
+	}
+	var drop int
+	for drop < keep && marks[drop].ts < ts {
+		drop++
+	}
+	return drop
+}
@@ -0,0 +1,101 @@
+/*
+ * Copyright 2022 Dgraph Labs, Inc. and Contributors
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package worker
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/dgraph-io/dgraph/posting"
+	"github.com/dgraph-io/dgraph/protos/pb"
+	"github.com/dgraph-io/dgraph/x"
+)
+
+func setTTLEdge(t *testing.T, attr string, uid, value uint64) {
+	edge := &pb.DirectedEdge{Entity: uid, Attr: attr, ValueId: value}
+	addEdge(t, edge, getOrCreate(x.DataKey(attr, uid)))
+}
+
+// expiredBefore returns a function deleting the values written at or before the timestamp.
+func expiredBefore(attr string, markTs uint64) func(uid, version uint64, pl *posting.List,
+	readTs uint64) ([]*pb.DirectedEdge, error) {
+	return func(uid, version uint64, pl *posting.List, readTs uint64) ([]*pb.DirectedEdge, error) {
+		if version > markTs {
+			return nil, nil
+		}
+		return []*pb.DirectedEdge{deleteAllEdge(uid, attr)}, nil
+	}
+}
+
+func TestFindExpiredAtReadTs(t *testing.T) {
+	attr := x.GalaxyAttr("ttl_friend")
+	setTTLEdge(t, attr, 1, 0x64)
+	markTs := timestamp()
+	setTTLEdge(t, attr, 2, 0x64)
+	readTs := timestamp()
+	// Neither the node written after the read timestamp nor the later write of the expired
+	// node is seen at the read timestamp.
+	setTTLEdge(t, attr, 3, 0x64)
+	setTTLEdge(t, attr, 1, 0x65)
+
+	edges, nodes, err := findExpired(attr, readTs, expiredBefore(attr, markTs))
+	require.NoError(t, err)
+	require.Equal(t, 1, nodes)
+	require.Len(t, edges, 1)
+	require.Equal(t, uint64(1), edges[0].Entity)
+	require.Equal(t, pb.DirectedEdge_DEL, edges[0].Op)
+
+	// The node has been written since, so it no longer expires.
+	_, nodes, err = findExpired(attr, timestamp(), expiredBefore(attr, markTs))
+	require.NoError(t, err)
+	require.Zero(t, nodes)
+}
+
+func TestTTLMarksAppliedByReplicas(t *testing.T) {
+	now := time.Unix(time.Now().Unix(), 0)
+	old := ttlMark{ts: timestamp(), time: now.Add(-2 * time.Hour)}
+	recent := ttlMark{ts: timestamp(), time: now.Add(-time.Minute)}
+
+	// Every replica applies the marks proposed by the leader, so that a new leader, or a replica
+	// receiving them in a snapshot, expires the same values.
+	require.NoError(t, applyTTLMarks([]*pb.TTLMark{
+		{Ts: old.ts, Time: old.time.Unix()},
+		{Ts: recent.ts, Time: recent.time.Unix()},
+	}, old.ts))
+	marks, err := readTTLMarks()
+	require.NoError(t, err)
+	require.Contains(t, marks, old)
+	require.Contains(t, marks, recent)
+	require.Equal(t, old.ts, ttlMarkTs(marks, now, int64(time.Hour/time.Second)))
+
+	// The latest mark older than the cutoff is kept, and the newer ones aren't pruned.
+	marks = []ttlMark{old, recent}
+	require.Zero(t, ttlMarksToPrune(marks, now.Add(-time.Hour), timestamp()))
+	require.Equal(t, 1, ttlMarksToPrune(marks, now, timestamp()))
+	require.Zero(t, ttlMarksToPrune(marks, now, old.ts))
+
+	require.NoError(t, applyTTLMarks([]*pb.TTLMark{
+		{Ts: old.ts, Remove: true},
+		{Ts: recent.ts, Remove: true},
+	}, timestamp()))
+	marks, err = readTTLMarks()
+	require.NoError(t, err)
+	require.NotContains(t, marks, old)
+	require.NotContains(t, marks, recent)
+}
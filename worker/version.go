@@ -0,0 +1,188 @@
+/*
+ * Copyright 2022 Dgraph Labs, Inc. and Contributors
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package worker
+
+import (
+	"encoding/binary"
+	"fmt"
+	"strings"
+
+	"github.com/dgryski/go-farm"
+	"github.com/pkg/errors"
+
+	"github.com/dgraph-io/dgo/v210/protos/api"
+	"github.com/dgraph-io/dgraph/posting"
+	"github.com/dgraph-io/dgraph/protos/pb"
+	"github.com/dgraph-io/dgraph/x"
+)
+
+// The version of a node is the commit timestamp of the latest mutation of the node. Every
+// mutation sets the version predicate of the nodes it changes, and the commit timestamp of that
+// posting is the version. The value of the posting is only the start timestamp of the mutation.
+// Only the mutations made for a version conflict with the other mutations of the node through
+// its version, so that concurrent mutations of different predicates of a node both commit.
+//
+// The version predicate is internal, so it is left out of the predicates of the transaction
+// returned to the client, and added back when the transaction commits.
+
+// addVersionEdges adds the edges touching the version of every node the mutation changes. The
+// nodes whose predicates are all deleted, with delete { <uid> * * . }, have their version deleted
+// along with the other predicates, unless the mutation sets some of their predicates again.
+func addVersionEdges(m *pb.Mutations) {
+	type node struct {
+		ns, uid uint64
+	}
+	seen := make(map[node]struct{})
+	deleted := make(map[node]struct{})
+	for _, edge := range m.Edges {
+		if x.ParseAttr(edge.Attr) != x.VersionPredicate {
+			continue
+		}
+		n := node{ns: x.ParseNamespace(edge.Attr), uid: edge.Entity}
+		if edge.Op == pb.DirectedEdge_DEL && isStarAll(edge.Value) {
+			deleted[n] = struct{}{}
+		} else {
+			seen[n] = struct{}{}
+		}
+	}
+	for _, edge := range m.Edges {
+		// Predicates dropped as a whole don't belong to a node.
+		if edge.Entity == 0 {
+			continue
+		}
+		n := node{ns: x.ParseNamespace(edge.Attr), uid: edge.Entity}
+		if _, ok := seen[n]; ok {
+			continue
+		}
+		if _, ok := deleted[n]; ok && edge.Op == pb.DirectedEdge_DEL {
+			continue
+		}
+		seen[n] = struct{}{}
+
+		val := make([]byte, 8)
+		binary.LittleEndian.PutUint64(val, m.StartTs)
+		m.Edges = append(m.Edges, &pb.DirectedEdge{
+			Entity:    n.uid,
+			Attr:      x.NamespaceAttr(n.ns, x.VersionPredicate),
+			Value:     val,
+			ValueType: pb.Posting_INT,
+			Op:        pb.DirectedEdge_SET,
+		})
+	}
+}
+
+// postingVersion returns the version recorded by a posting of the version predicate.
+func postingVersion(p *pb.Posting) uint64 {
+	if p.CommitTs > 0 {
+		return p.CommitTs
+	}
+	// The posting was written by the transaction reading it.
+	return p.StartTs
+}
+
+// nodeVersion returns the version of the node as of readTs, zero if it was never changed.
+func nodeVersion(attr string, uid, readTs uint64) (uint64, error) {
+	pl, err := posting.GetNoStore(x.DataKey(attr, uid), readTs)
+	if err != nil {
+		return 0, err
+	}
+	p, err := pl.PostingFor(readTs, nil)
+	switch {
+	case err == posting.ErrNoValue:
+		return 0, nil
+	case err != nil:
+		return 0, err
+	}
+	return postingVersion(p), nil
+}
+
+// checkVersions returns an error if any node changed by a mutation made for a version has a
+// version newer than that one. It also adds the conflict keys of the versions of those nodes, as
+// the version predicate has no conflict keys of its own, so that a concurrent mutation of the
+// node can't be committed after the version was checked. Mutations which aren't made for a
+// version add no conflict keys for the versions.
+func checkVersions(m *pb.Mutations, txn *posting.Txn) error {
+	if m.IfVersion == 0 {
+		return nil
+	}
+	for _, edge := range m.Edges {
+		if x.ParseAttr(edge.Attr) != x.VersionPredicate {
+			continue
+		}
+		version, err := nodeVersion(edge.Attr, edge.Entity, m.StartTs)
+		if err != nil {
+			return err
+		}
+		if version > m.IfVersion {
+			return errors.Errorf("Node %#x was modified at version %d, after version %d",
+				edge.Entity, version, m.IfVersion)
+		}
+		txn.AddConflictKey(farm.Fingerprint64(x.DataKey(edge.Attr, edge.Entity)))
+	}
+	return nil
+}
+
+// isVersionPred returns whether the predicate of a transaction, prefixed with the group which
+// served it, is the version predicate.
+func isVersionPred(pkey string) bool {
+	splits := strings.SplitN(pkey, "-", 2)
+	return len(splits) == 2 && x.ParseAttr(splits[1]) == x.VersionPredicate
+}
+
+// HideVersionPreds removes the version predicate from the predicates of the transaction, before
+// they are returned to the client.
+func HideVersionPreds(tc *api.TxnContext) {
+	if tc == nil {
+		return
+	}
+	preds := tc.Preds[:0]
+	for _, pkey := range tc.Preds {
+		if !isVersionPred(pkey) {
+			preds = append(preds, pkey)
+		}
+	}
+	tc.Preds = preds
+}
+
+// addVersionPreds adds the version predicate of the namespaces of the predicates of the
+// transaction, which were hidden from the client, so that Zero checks whether it was moved. The
+// version predicate is added with the group serving it now, and an error is returned if it was
+// moved after the transaction started.
+func addVersionPreds(tc *api.TxnContext) error {
+	seen := make(map[uint64]struct{})
+	for _, pkey := range tc.Preds {
+		splits := strings.SplitN(pkey, "-", 2)
+		if len(splits) < 2 || len(splits[1]) < 8 {
+			continue
+		}
+		ns := x.ParseNamespace(splits[1])
+		if _, ok := seen[ns]; ok {
+			continue
+		}
+		seen[ns] = struct{}{}
+
+		attr := x.NamespaceAttr(ns, x.VersionPredicate)
+		gid, err := groups().BelongsToReadOnly(attr, tc.StartTs)
+		if err != nil {
+			return err
+		}
+		if gid != 0 {
+			tc.Preds = append(tc.Preds, fmt.Sprintf("%d-%s", gid, attr))
+		}
+	}
+	return nil
+}
@@ -38,6 +38,11 @@ type Options struct {
 	// mutations-nquad int - maximum number of nquads that can be inserted in a mutation request
 	// BlockDropAll bool - if set to true, the drop all operation will be rejected by the server.
 	// query-timeout duration - Maximum time after which a query execution will fail.
+	// reindex-rate int - maximum number of posting lists processed per second when rebuilding an
+	//                    index, zero meaning no limit
+	// idempotency-window duration - how long the response of a request with an idempotency key is
+	//                               remembered, to be returned when the request is retried
+	// idempotency-keys int - maximum number of idempotency keys remembered
 	Limit                *z.SuperFlag
 	LimitMutationsNquad  int
 	LimitQueryEdge       uint64
@@ -45,6 +50,9 @@ type Options struct {
 	LimitNormalizeNode   int
 	QueryTimeout         time.Duration
 	MaxRetries           int64
+	LimitReindexRate     int64
+	IdempotencyWindow    time.Duration
+	IdempotencyKeys      int
 
 	// GraphQL options:
 	//
@@ -123,6 +131,18 @@ type WorkerOptions struct {
 	// LudicrousEnabled mirrors the "enabled" flag of the Ludicrous SuperFlag for usage in critical
 	// paths.
 	LudicrousEnabled bool
+	// SoftDelete options:
+	//
+	// enabled bool - record the deletion of nodes as tombstones that can be undone
+	// retention duration - how long the deleted versions are kept before being purged
+	SoftDelete *z.SuperFlag
+	// SoftDeleteRetention mirrors the "retention" flag of the SoftDelete SuperFlag. It is zero if
+	// soft delete is disabled.
+	SoftDeleteRetention time.Duration
+	// SerializableEnabled is whether serializable transactions are allowed. When they are, every
+	// transaction sends the keys it writes to Zero, so that the serializable transactions which
+	// read them abort.
+	SerializableEnabled bool
 	// Security options:
 	//
 	// whitelist string - comma separated IP addresses
@@ -19,6 +19,7 @@ package x
 import (
 	"encoding/binary"
 	"encoding/hex"
+	"fmt"
 	"math"
 	"strconv"
 	"strings"
@@ -47,8 +48,24 @@ const (
 	DefaultPrefix = byte(0x00)
 	ByteSchema    = byte(0x01)
 	ByteType      = byte(0x02)
+	ByteSynonym   = byte(0x03)
 	// ByteSplit signals that the key stores an individual part of a multi-part list.
 	ByteSplit = byte(0x04)
+	// ByteSchemaVersion indicates the key stores a version of the schema of a namespace.
+	ByteSchemaVersion = byte(0x05)
+	// ByteTTLMark indicates the key stores the time at which a timestamp was reached. These marks
+	// are used to find the values which have expired.
+	ByteTTLMark = byte(0x06)
+	// ByteTombstone indicates the key stores the tombstone of a node deleted while soft delete
+	// is enabled.
+	ByteTombstone = byte(0x07)
+	// ByteTrigger indicates the key stores a trigger of DQL mutations.
+	ByteTrigger = byte(0x08)
+	// ByteStoredQuery indicates the key stores a named DQL query.
+	ByteStoredQuery = byte(0x09)
+	// ByteTriggerEvent indicates the key stores the call of a trigger after the commit of a
+	// transaction, until it is delivered.
+	ByteTriggerEvent = byte(0x0a)
 	// ByteUnused is a constant to specify keys which need to be discarded.
 	ByteUnused = byte(0xff)
 	// GalaxyNamespace is the default namespace name.
@@ -180,6 +197,89 @@ func TypeKey(attr string) []byte {
 	return generateKey(ByteType, attr, 1+2+len(attr))
 }
 
+// SynonymKey returns synonym key for given synonym set name. Synonym keys are stored
+// separately with a unique prefix, since we need to iterate over all synonym keys.
+// The structure of a synonym key is as follows:
+//
+// byte 0: key type prefix (set to ByteSynonym)
+// byte 1-2: length of name
+// next len(attr) bytes: value of attr (the synonym set name)
+func SynonymKey(attr string) []byte {
+	return generateKey(ByteSynonym, attr, 1+2+len(attr))
+}
+
+// SchemaVersionKey returns the key of the given version of the schema of the namespace. The
+// version is zero-padded, so that the versions of a namespace are sorted by their keys.
+// The structure of a schema version key is as follows:
+//
+// byte 0: key type prefix (set to ByteSchemaVersion)
+// byte 1-8: namespace
+// byte 9-10: length of the version
+// next 20 bytes: value of the version
+func SchemaVersionKey(namespace, version uint64) []byte {
+	attr := NamespaceAttr(namespace, fmt.Sprintf("%020d", version))
+	return generateKey(ByteSchemaVersion, attr, 1+2+len(attr))
+}
+
+// TTLMarkKey returns the key of the mark of the time at which the given timestamp was reached.
+// The timestamp is zero-padded, so that the marks are sorted by their keys. The marks are kept
+// in the galaxy namespace, and the structure of a mark key is as follows:
+//
+// byte 0: key type prefix (set to ByteTTLMark)
+// byte 1-8: namespace
+// byte 9-10: length of the timestamp
+// next 20 bytes: value of the timestamp
+func TTLMarkKey(ts uint64) []byte {
+	attr := NamespaceAttr(GalaxyNamespace, fmt.Sprintf("%020d", ts))
+	return generateKey(ByteTTLMark, attr, 1+2+len(attr))
+}
+
+// TriggerKey returns the key of the trigger with the given namespaced name.
+// The structure of a trigger key is as follows:
+//
+// byte 0: key type prefix (set to ByteTrigger)
+// byte 1-2: length of name
+// next len(attr) bytes: value of attr (the trigger name)
+func TriggerKey(attr string) []byte {
+	return generateKey(ByteTrigger, attr, 1+2+len(attr))
+}
+
+// StoredQueryKey returns the key of the stored query with the given namespaced name.
+// The structure of a stored query key is as follows:
+//
+// byte 0: key type prefix (set to ByteStoredQuery)
+// byte 1-2: length of name
+// next len(attr) bytes: value of attr (the query name)
+func StoredQueryKey(attr string) []byte {
+	return generateKey(ByteStoredQuery, attr, 1+2+len(attr))
+}
+
+// TriggerEventKey returns the key of the event of a trigger called after the commit of the
+// transaction with the given start timestamp. The id tells apart the events of the transaction.
+// The structure of a trigger event key is as follows:
+//
+// byte 0: key type prefix (set to ByteTriggerEvent)
+// byte 1-8: namespace (set to GalaxyNamespace)
+// byte 9-10: length of the timestamp and the id
+// next 41 bytes: value of the timestamp and the id, separated by a dash
+func TriggerEventKey(startTs, id uint64) []byte {
+	attr := NamespaceAttr(GalaxyNamespace, fmt.Sprintf("%020d-%020d", startTs, id))
+	return generateKey(ByteTriggerEvent, attr, 1+2+len(attr))
+}
+
+// TombstoneKey returns the key of the tombstone of the deletion of the node by the transaction
+// with the given start timestamp. The uid and the timestamp are zero-padded, so that the tombstones
+// are sorted by uid. The structure of a tombstone key is as follows:
+//
+// byte 0: key type prefix (set to ByteTombstone)
+// byte 1-8: namespace
+// byte 9-10: length of the uid and the timestamp
+// next 41 bytes: value of the uid and the timestamp, separated by a dash
+func TombstoneKey(namespace, uid, startTs uint64) []byte {
+	attr := NamespaceAttr(namespace, fmt.Sprintf("%020d-%020d", uid, startTs))
+	return generateKey(ByteTombstone, attr, 1+2+len(attr))
+}
+
 // DataKey generates a data key with the given attribute and UID.
 // The structure of a data key is as follows:
 //
@@ -327,6 +427,41 @@ func (p ParsedKey) IsType() bool {
 	return p.bytePrefix == ByteType
 }
 
+// IsSynonym returns whether the key is a synonym key.
+func (p ParsedKey) IsSynonym() bool {
+	return p.bytePrefix == ByteSynonym
+}
+
+// IsSchemaVersion returns whether the key is a schema version key.
+func (p ParsedKey) IsSchemaVersion() bool {
+	return p.bytePrefix == ByteSchemaVersion
+}
+
+// IsTTLMark returns whether the key is a ttl mark key.
+func (p ParsedKey) IsTTLMark() bool {
+	return p.bytePrefix == ByteTTLMark
+}
+
+// IsTrigger returns whether the key is a trigger key.
+func (p ParsedKey) IsTrigger() bool {
+	return p.bytePrefix == ByteTrigger
+}
+
+// IsStoredQuery returns whether the key is a stored query key.
+func (p ParsedKey) IsStoredQuery() bool {
+	return p.bytePrefix == ByteStoredQuery
+}
+
+// IsTriggerEvent returns whether the key is a trigger event key.
+func (p ParsedKey) IsTriggerEvent() bool {
+	return p.bytePrefix == ByteTriggerEvent
+}
+
+// IsTombstone returns whether the key is a tombstone key.
+func (p ParsedKey) IsTombstone() bool {
+	return p.bytePrefix == ByteTombstone
+}
+
 // IsOfType checks whether the key is of the given type.
 func (p ParsedKey) IsOfType(typ byte) bool {
 	switch typ {
@@ -454,6 +589,14 @@ func (p ParsedKey) ToBackupKey() *pb.BackupKey {
 		key.Type = pb.BackupKey_SCHEMA
 	case p.IsType():
 		key.Type = pb.BackupKey_TYPE
+	case p.IsSynonym():
+		key.Type = pb.BackupKey_SYNONYM
+	case p.IsSchemaVersion():
+		key.Type = pb.BackupKey_SCHEMA_VERSION
+	case p.IsTrigger():
+		key.Type = pb.BackupKey_TRIGGER
+	case p.IsStoredQuery():
+		key.Type = pb.BackupKey_STORED_QUERY
 	}
 
 	return &key
@@ -483,6 +626,14 @@ func FromBackupKey(backupKey *pb.BackupKey) []byte {
 		key = SchemaKey(attr)
 	case pb.BackupKey_TYPE:
 		key = TypeKey(attr)
+	case pb.BackupKey_SYNONYM:
+		key = SynonymKey(attr)
+	case pb.BackupKey_SCHEMA_VERSION:
+		key = generateKey(ByteSchemaVersion, attr, 1+2+len(attr))
+	case pb.BackupKey_TRIGGER:
+		key = TriggerKey(attr)
+	case pb.BackupKey_STORED_QUERY:
+		key = StoredQueryKey(attr)
 	}
 
 	if backupKey.StartUid > 0 {
@@ -507,6 +658,57 @@ func TypePrefix() []byte {
 	return buf[:]
 }
 
+// SynonymPrefix returns the prefix for Synonym keys.
+func SynonymPrefix() []byte {
+	var buf [1]byte
+	buf[0] = ByteSynonym
+	return buf[:]
+}
+
+// SchemaVersionPrefix returns the prefix for the schema version keys of the namespace.
+func SchemaVersionPrefix(namespace uint64) []byte {
+	buf := make([]byte, 1+8)
+	buf[0] = ByteSchemaVersion
+	binary.BigEndian.PutUint64(buf[1:], namespace)
+	return buf
+}
+
+// TTLMarkPrefix returns the prefix for the ttl mark keys.
+func TTLMarkPrefix() []byte {
+	var buf [1]byte
+	buf[0] = ByteTTLMark
+	return buf[:]
+}
+
+// TriggerPrefix returns the prefix for Trigger keys.
+func TriggerPrefix() []byte {
+	var buf [1]byte
+	buf[0] = ByteTrigger
+	return buf[:]
+}
+
+// StoredQueryPrefix returns the prefix for StoredQuery keys.
+func StoredQueryPrefix() []byte {
+	var buf [1]byte
+	buf[0] = ByteStoredQuery
+	return buf[:]
+}
+
+// TriggerEventPrefix returns the prefix for the trigger event keys.
+func TriggerEventPrefix() []byte {
+	var buf [1]byte
+	buf[0] = ByteTriggerEvent
+	return buf[:]
+}
+
+// TombstonePrefix returns the prefix for the tombstone keys of the namespace.
+func TombstonePrefix(namespace uint64) []byte {
+	buf := make([]byte, 1+8)
+	buf[0] = ByteTombstone
+	binary.BigEndian.PutUint64(buf[1:], namespace)
+	return buf
+}
+
 // PredicatePrefix returns the prefix for all keys belonging to this predicate except schema key.
 func PredicatePrefix(predicate string) []byte {
 	buf := make([]byte, 1+2+len(predicate))
@@ -573,7 +775,8 @@ func Parse(key []byte) (ParsedKey, error) {
 	k = k[sz:]
 
 	switch p.bytePrefix {
-	case ByteSchema, ByteType:
+	case ByteSchema, ByteType, ByteSynonym, ByteSchemaVersion, ByteTTLMark, ByteTombstone,
+		ByteTrigger, ByteStoredQuery, ByteTriggerEvent:
 		return p, nil
 	default:
 	}
@@ -651,7 +854,8 @@ func IsDropOpKey(key []byte) (bool, error) {
 
 // These predicates appear for queries that have * as predicate in them.
 var starAllPredicateMap = map[string]struct{}{
-	"dgraph.type": {},
+	"dgraph.type":    {},
+	VersionPredicate: {},
 }
 
 var aclPredicateMap = map[string]struct{}{
@@ -671,8 +875,17 @@ var graphqlReservedPredicate = map[string]struct{}{
 	"dgraph.graphql.schema":  {},
 	"dgraph.drop.op":         {},
 	"dgraph.graphql.p_query": {},
+	VersionPredicate:         {},
 }
 
+const (
+	// VersionPredicate is the pre-defined predicate touched by every mutation of a node, whose
+	// latest commit timestamp is the version of the node.
+	VersionPredicate = "dgraph.version"
+	// VersionField is the name under which queries read the version of a node.
+	VersionField = "_version_"
+)
+
 // internalPredicateMap stores a set of Dgraph's internal predicate. An internal
 // predicate is a predicate that has a special meaning in Dgraph and its query
 // language and should not be allowed either as a user-defined predicate or as a
@@ -798,3 +1011,46 @@ func IsPreDefinedType(typ string) bool {
 func isReservedName(name string) bool {
 	return strings.HasPrefix(strings.ToLower(name), "dgraph.")
 }
+
+// The conflict keys of a transaction are fingerprints of the keys it writes, encoded in base 36.
+// Zero aborts the transaction if any was committed since it started, and records them on commit.
+// Serializable transactions also send the fingerprints of the keys they read, which Zero checks
+// but doesn't record, and every transaction sends the fingerprints of the lists it writes, which
+// Zero records but doesn't check, so that writers of different postings of a list don't conflict.
+const (
+	// ReadConflictKeyPrefix marks the conflict keys of the keys read by a transaction.
+	ReadConflictKeyPrefix = "r:"
+	// WrittenConflictKeyPrefix marks the conflict keys only recorded to detect the reads of
+	// serializable transactions.
+	WrittenConflictKeyPrefix = "w:"
+)
+
+// ConflictKey encodes the fingerprint of a key written by a transaction.
+func ConflictKey(fp uint64) string {
+	return strconv.FormatUint(fp, 36)
+}
+
+// ReadConflictKey encodes the fingerprint of a key read by a serializable transaction.
+func ReadConflictKey(fp uint64) string {
+	return ReadConflictKeyPrefix + ConflictKey(fp)
+}
+
+// WrittenConflictKey encodes the fingerprint of a list written by a transaction, which conflicts
+// with the serializable transactions which read it.
+func WrittenConflictKey(fp uint64) string {
+	return WrittenConflictKeyPrefix + ConflictKey(fp)
+}
+
+// ParseConflictKey returns the fingerprint of a conflict key, whether a transaction conflicts
+// with the ones which committed it since it started, and whether it is recorded on commit.
+func ParseConflictKey(key string) (fp uint64, check, record bool, err error) {
+	check, record = true, true
+	switch {
+	case strings.HasPrefix(key, ReadConflictKeyPrefix):
+		key, record = key[len(ReadConflictKeyPrefix):], false
+	case strings.HasPrefix(key, WrittenConflictKeyPrefix):
+		key, check = key[len(WrittenConflictKeyPrefix):], false
+	}
+	fp, err = strconv.ParseUint(key, 36, 64)
+	return fp, check, record, err
+}
@@ -17,6 +17,7 @@
 package x
 
 import (
+	"bytes"
 	"fmt"
 	"math"
 	"sort"
@@ -225,6 +226,87 @@ func TestTypeKey(t *testing.T) {
 	}
 }
 
+func TestSynonymKey(t *testing.T) {
+	for i := 0; i < 1001; i++ {
+		name := fmt.Sprintf("set:%d", i)
+
+		key := SynonymKey(NamespaceAttr(GalaxyNamespace, name))
+		pk, err := Parse(key)
+		require.NoError(t, err)
+
+		require.True(t, pk.IsSynonym())
+		require.False(t, pk.IsType())
+		require.Equal(t, name, ParseAttr(pk.Attr))
+	}
+}
+
+func TestSchemaVersionKey(t *testing.T) {
+	for _, ns := range []uint64{GalaxyNamespace, 7} {
+		for _, version := range []uint64{1, 99, math.MaxUint64} {
+			key := SchemaVersionKey(ns, version)
+			require.True(t, bytes.HasPrefix(key, SchemaVersionPrefix(ns)))
+
+			pk, err := Parse(key)
+			require.NoError(t, err)
+			require.True(t, pk.IsSchemaVersion())
+			require.False(t, pk.IsSynonym())
+			require.Equal(t, ns, ParseNamespace(pk.Attr))
+		}
+	}
+
+	// Versions of a namespace must sort in the order of their numbers.
+	require.Equal(t, -1, bytes.Compare(SchemaVersionKey(1, 99), SchemaVersionKey(1, 100)))
+}
+
+func TestTombstoneKey(t *testing.T) {
+	for _, ns := range []uint64{GalaxyNamespace, 7} {
+		key := TombstoneKey(ns, 0x2a, 100)
+		require.True(t, bytes.HasPrefix(key, TombstonePrefix(ns)))
+		require.False(t, bytes.HasPrefix(key, TombstonePrefix(ns+1)))
+
+		pk, err := Parse(key)
+		require.NoError(t, err)
+		require.True(t, pk.IsTombstone())
+		require.False(t, pk.IsTTLMark())
+		require.Equal(t, ns, ParseNamespace(pk.Attr))
+	}
+
+	// The tombstones of a node must sort by the start timestamp of the deleting transaction,
+	// before the ones of the next node.
+	require.Equal(t, -1, bytes.Compare(TombstoneKey(1, 9, 99), TombstoneKey(1, 9, 100)))
+	require.Equal(t, -1, bytes.Compare(TombstoneKey(1, 9, 100), TombstoneKey(1, 10, 1)))
+}
+
+func TestTriggerEventKey(t *testing.T) {
+	key := TriggerEventKey(100, 0x2a)
+	require.True(t, bytes.HasPrefix(key, TriggerEventPrefix()))
+	pk, err := Parse(key)
+	require.NoError(t, err)
+	require.True(t, pk.IsTriggerEvent())
+	require.False(t, pk.IsTrigger())
+
+	// The events are delivered in the order of the start timestamps of their transactions.
+	require.Equal(t, -1, bytes.Compare(TriggerEventKey(99, 2), TriggerEventKey(100, 1)))
+}
+
+func TestConflictKeys(t *testing.T) {
+	fp, check, record, err := ParseConflictKey(ConflictKey(0x2a))
+	require.NoError(t, err)
+	require.Equal(t, []interface{}{uint64(0x2a), true, true}, []interface{}{fp, check, record})
+
+	fp, check, record, err = ParseConflictKey(ReadConflictKey(0x2a))
+	require.NoError(t, err)
+	require.Equal(t, []interface{}{uint64(0x2a), true, false}, []interface{}{fp, check, record})
+
+	fp, check, record, err = ParseConflictKey(WrittenConflictKey(math.MaxUint64))
+	require.NoError(t, err)
+	require.Equal(t, []interface{}{uint64(math.MaxUint64), false, true},
+		[]interface{}{fp, check, record})
+
+	_, _, _, err = ParseConflictKey("x:1")
+	require.Error(t, err)
+}
+
 func TestBadStartUid(t *testing.T) {
 	testKey := func(key []byte) {
 		key, err := SplitKey(key, 10)
@@ -21,6 +21,14 @@ type ExportedGQLSchema struct {
 	Schema    string
 }
 
+// ExportedSynonyms is a synonym set written by export, which can be given back to the
+// updateSynonyms admin mutation of its namespace.
+type ExportedSynonyms struct {
+	Namespace uint64
+	Name      string
+	Words     []string
+}
+
 // Sensitive implements the Stringer interface to redact its contents.
 // Use this type for sensitive info such as keys, passwords, or secrets so it doesn't leak
 // as output such as logs.
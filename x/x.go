@@ -120,6 +120,9 @@ const (
 
 	// FacetDelimeter is the symbol used to distinguish predicate names from facets.
 	FacetDelimeter = "|"
+	// ListIndexFacet is the facet used to set or delete the value at a position of an @ordered
+	// list. The postings of the list keep their position in it, and it's never returned.
+	ListIndexFacet = "dgraph.index"
 
 	// GrootId is the ID of the admin user for ACLs.
 	GrootId = "groot"
@@ -319,6 +322,113 @@ func ExtractJwt(ctx context.Context) (string, error) {
 	return accessJwt[0], nil
 }
 
+// ExtractStoredQuery returns the name of the stored query to run for the request, which gRPC
+// clients send in the "stored-query" metadata. It is empty for requests which carry their query.
+func ExtractStoredQuery(ctx context.Context) string {
+	md, ok := metadata.FromIncomingContext(ctx)
+	if !ok {
+		return ""
+	}
+	name := md.Get("stored-query")
+	if len(name) == 0 {
+		return ""
+	}
+	return name[0]
+}
+
+// AttachStoredQuery adds the name of the stored query to run into the grpc context metadata.
+func AttachStoredQuery(ctx context.Context, name string) context.Context {
+	md, ok := metadata.FromIncomingContext(ctx)
+	if !ok {
+		md = metadata.New(nil)
+	}
+	md.Set("stored-query", name)
+	return metadata.NewIncomingContext(ctx, md)
+}
+
+// ExtractIfVersion returns the version the nodes of the mutation must not be newer than, which gRPC
+// clients send in the "if-version" metadata. It is zero for mutations without the check.
+func ExtractIfVersion(ctx context.Context) (uint64, error) {
+	md, ok := metadata.FromIncomingContext(ctx)
+	if !ok {
+		return 0, nil
+	}
+	version := md.Get("if-version")
+	if len(version) == 0 {
+		return 0, nil
+	}
+	v, err := strconv.ParseUint(version[0], 0, 64)
+	if err != nil {
+		return 0, errors.Wrapf(err, "while parsing if-version %q", version[0])
+	}
+	return v, nil
+}
+
+// AttachIfVersion adds the version the nodes of the mutation must not be newer than into the grpc
+// context metadata.
+func AttachIfVersion(ctx context.Context, version uint64) context.Context {
+	md, ok := metadata.FromIncomingContext(ctx)
+	if !ok {
+		md = metadata.New(nil)
+	}
+	md.Set("if-version", strconv.FormatUint(version, 10))
+	return metadata.NewIncomingContext(ctx, md)
+}
+
+// ExtractIdempotencyKey returns the idempotency key of the request, which gRPC clients send in the
+// "idempotency-key" metadata. It is empty for requests which aren't deduplicated. The keys are
+// only remembered by the Alpha which got the request, so the retries must be sent to it.
+func ExtractIdempotencyKey(ctx context.Context) string {
+	md, ok := metadata.FromIncomingContext(ctx)
+	if !ok {
+		return ""
+	}
+	key := md.Get("idempotency-key")
+	if len(key) == 0 {
+		return ""
+	}
+	return key[0]
+}
+
+// ExtractSerializable returns whether the request is part of a serializable transaction, which
+// gRPC clients send in the "serializable" metadata.
+func ExtractSerializable(ctx context.Context) (bool, error) {
+	md, ok := metadata.FromIncomingContext(ctx)
+	if !ok {
+		return false, nil
+	}
+	serializable := md.Get("serializable")
+	if len(serializable) == 0 {
+		return false, nil
+	}
+	s, err := strconv.ParseBool(serializable[0])
+	if err != nil {
+		return false, errors.Wrapf(err, "while parsing serializable %q", serializable[0])
+	}
+	return s, nil
+}
+
+// AttachSerializable marks the request as part of a serializable transaction in the grpc context
+// metadata.
+func AttachSerializable(ctx context.Context) context.Context {
+	md, ok := metadata.FromIncomingContext(ctx)
+	if !ok {
+		md = metadata.New(nil)
+	}
+	md.Set("serializable", "true")
+	return metadata.NewIncomingContext(ctx, md)
+}
+
+// AttachIdempotencyKey adds the idempotency key of the request into the grpc context metadata.
+func AttachIdempotencyKey(ctx context.Context, key string) context.Context {
+	md, ok := metadata.FromIncomingContext(ctx)
+	if !ok {
+		md = metadata.New(nil)
+	}
+	md.Set("idempotency-key", key)
+	return metadata.NewIncomingContext(ctx, md)
+}
+
 // WithLocations adds a list of locations to a GqlError and returns the same
 // GqlError (fluent style).
 func (gqlErr *GqlError) WithLocations(locs ...Location) *GqlError {